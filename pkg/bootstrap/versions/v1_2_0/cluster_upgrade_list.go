@@ -44,6 +44,7 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_debug_traceStatementTable,
 	upg_mo_debug_eventTxnActionTable,
 	upg_mo_debug_featuresTables,
+	upg_mo_scrub_history,
 }
 
 //var upg_mo_account = versions.UpgradeEntry{
@@ -64,6 +65,24 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 //	},
 //}
 
+var upg_mo_scrub_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_SCRUB_HISTORY,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			object_name varchar(128) primary key,
+			db_id bigint unsigned,
+			table_id bigint unsigned,
+			error text,
+			detected_at timestamp(6),
+			repaired bool,
+			repair_error text
+			);`, catalog.MO_CATALOG, catalog.MO_SCRUB_HISTORY),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_SCRUB_HISTORY)
+	},
+}
+
 var upg_mo_pub = versions.UpgradeEntry{
 	Schema:    catalog.MO_CATALOG,
 	TableName: catalog.MO_PUBS,
@@ -31,6 +31,10 @@ var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_mo_foreign_keys,
 	upg_system_metrics_sql_statement_duration_total,
 	upg_mo_snapshots,
+	upg_mo_binlog_positions,
+	upg_mo_column_stats,
+	upg_mo_plan_baselines,
+	upg_mo_scrub,
 	upg_sql_statement_cu,
 	upg_mysql_role_edges,
 	upg_information_schema_schema_privileges,
@@ -204,6 +208,67 @@ var upg_mo_snapshots = versions.UpgradeEntry{
 	},
 }
 
+var upg_mo_scrub = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_scrub",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    "CREATE VIEW IF NOT EXISTS mo_catalog.mo_scrub AS SELECT * FROM mo_scrub() AS mo_scrub_tmp;",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, "mo_scrub")
+		return exists, err
+	},
+}
+
+var upg_mo_binlog_positions = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_BINLOG_POSITIONS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			task_id bigint unsigned primary key,
+			upstream varchar(300),
+			binlog_file varchar(512),
+			binlog_pos bigint unsigned,
+			update_time timestamp
+			);`, catalog.MO_CATALOG, catalog.MO_BINLOG_POSITIONS),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_BINLOG_POSITIONS)
+	},
+}
+
+var upg_mo_column_stats = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_COLUMN_STATS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			table_id bigint unsigned,
+			column_name varchar(256),
+			database_name varchar(5000),
+			table_name varchar(5000),
+			histogram text,
+			mcv text,
+			analyzed_time timestamp,
+			primary key(table_id, column_name)
+			);`, catalog.MO_CATALOG, catalog.MO_COLUMN_STATS),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_COLUMN_STATS)
+	},
+}
+
+var upg_mo_plan_baselines = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_PLAN_BASELINES,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			digest varchar(64) primary key,
+			sql_text text,
+			hints text,
+			created_time timestamp
+			);`, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES)
+	},
+}
+
 var upg_sql_statement_cu = versions.UpgradeEntry{
 	Schema:    catalog.MO_SYSTEM_METRICS,
 	TableName: catalog.MO_SQL_STMT_CU,
@@ -449,7 +514,7 @@ var upg_information_schema_processlist = versions.UpgradeEntry{
 	UpgSql: fmt.Sprintf("CREATE VIEW IF NOT EXISTS %s.PROCESSLIST AS "+
 		"select node_id, conn_id, session_id, account, user, host, db, "+
 		"session_start, command, info, txn_id, statement_id, statement_type, "+
-		"query_type, sql_source_type, query_start, client_host, role, proxy_host "+
+		"query_type, sql_source_type, query_start, client_host, role, proxy_host, query_memory "+
 		"from PROCESSLIST() A", sysview.InformationDBConst),
 	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
 		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "processlist")
@@ -460,7 +525,7 @@ var upg_information_schema_processlist = versions.UpgradeEntry{
 		if exists && viewDef == fmt.Sprintf("CREATE VIEW IF NOT EXISTS %s.PROCESSLIST AS "+
 			"select node_id, conn_id, session_id, account, user, host, db, "+
 			"session_start, command, info, txn_id, statement_id, statement_type, "+
-			"query_type, sql_source_type, query_start, client_host, role, proxy_host "+
+			"query_type, sql_source_type, query_start, client_host, role, proxy_host, query_memory "+
 			"from PROCESSLIST() A", sysview.InformationDBConst) {
 			return true, nil
 		}
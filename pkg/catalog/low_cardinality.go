@@ -0,0 +1,91 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// PropLowCardinality is the table property holding the columns declared
+// LOW_CARDINALITY, encoded as a JSON array of column names. The blocks of
+// these columns are dictionary encoded when they have few distinct values.
+const PropLowCardinality = "low_cardinality"
+
+// IsLowCardinalityType reports whether a column of type oid can be declared
+// LOW_CARDINALITY.
+func IsLowCardinalityType(oid types.T) bool {
+	switch oid {
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return true
+	}
+	return false
+}
+
+// EncodeLowCardinalityColumns encodes the value of the PropLowCardinality property.
+func EncodeLowCardinalityColumns(cols []string) string {
+	data, _ := json.Marshal(cols)
+	return string(data)
+}
+
+// LowCardinalityColumnsFromProperties looks up the low cardinality columns
+// in a list of table properties.
+func LowCardinalityColumnsFromProperties(props []*plan.Property) []string {
+	for _, p := range props {
+		if p.GetKey() == PropLowCardinality {
+			var cols []string
+			if err := json.Unmarshal([]byte(p.GetValue()), &cols); err != nil {
+				return nil
+			}
+			return cols
+		}
+	}
+	return nil
+}
+
+// LowCardinalityColumnsFromDefs looks up the low cardinality columns in the
+// properties of a table definition.
+func LowCardinalityColumnsFromDefs(defs []*plan.TableDef_DefType) []string {
+	for _, def := range defs {
+		if props, ok := def.GetDef().(*plan.TableDef_DefType_Properties); ok {
+			if cols := LowCardinalityColumnsFromProperties(props.Properties.GetProperties()); len(cols) > 0 {
+				return cols
+			}
+		}
+	}
+	return nil
+}
+
+// LowCardinalityColumnPositions returns the positions in tableDef.Cols of
+// the low cardinality columns, used by the object writers.
+func LowCardinalityColumnPositions(tableDef *plan.TableDef) []uint16 {
+	cols := LowCardinalityColumnsFromDefs(tableDef.Defs)
+	if len(cols) == 0 {
+		return nil
+	}
+	positions := make([]uint16, 0, len(cols))
+	for _, col := range cols {
+		for i, colDef := range tableDef.Cols {
+			if colDef.Name == col {
+				positions = append(positions, uint16(i))
+				break
+			}
+		}
+	}
+	return positions
+}
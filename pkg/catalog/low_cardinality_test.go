@@ -0,0 +1,57 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLowCardinalityColumns(t *testing.T) {
+	require.True(t, IsLowCardinalityType(types.T_varchar))
+	require.False(t, IsLowCardinalityType(types.T_int64))
+
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{{Name: "a"}, {Name: "b,c"}, {Name: "d"}},
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{Key: SystemRelAttr_Kind, Value: SystemOrdinaryRel},
+						},
+					},
+				},
+			},
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{Key: PropLowCardinality, Value: EncodeLowCardinalityColumns([]string{"d", "b,c"})},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, []string{"d", "b,c"}, LowCardinalityColumnsFromDefs(tableDef.Defs))
+	require.Equal(t, []uint16{2, 1}, LowCardinalityColumnPositions(tableDef))
+
+	require.Nil(t, LowCardinalityColumnPositions(&plan.TableDef{Cols: tableDef.Cols}))
+	require.Nil(t, LowCardinalityColumnsFromProperties([]*plan.Property{{Key: PropLowCardinality, Value: "x"}}))
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+)
+
+// The options of ALTER TABLE ... SET MERGE_POLICY (...)
+const (
+	MergePolicyMinRows          = "min_rows"
+	MergePolicyMaxObjects       = "max_objects"
+	MergePolicyTargetObjectSize = "target_object_size"
+	MergePolicyMaxLevel         = "max_level"
+	MergePolicyReorganize       = "reorganize"
+	MergePolicyTimeColumn       = "time_column"
+	MergePolicyTimeWindow       = "time_window"
+	MergePolicySealAfter        = "seal_after"
+)
+
+// ParseMergePolicySize parses a size of the merge policy, in bytes or with
+// a unit like '128M' or '1GB'.
+func ParseMergePolicySize(ctx context.Context, s string) (uint64, error) {
+	v, err := units.RAMInBytes(strings.TrimSpace(s))
+	if err != nil || v <= 0 {
+		return 0, moerr.NewInvalidInput(ctx, "invalid merge policy size '%s'", s)
+	}
+	return uint64(v), nil
+}
+
+// MaxMergePolicyInterval bounds the intervals of the merge policy, so that
+// they stay far from the overflow of time.Duration.
+const MaxMergePolicyInterval = 100 * 365 * 24 * time.Hour
+
+// ParseMergePolicyInterval parses an interval of the merge policy like
+// '1 day' or '12 hour' into seconds. Month based units have no fixed length
+// and are not supported.
+func ParseMergePolicyInterval(ctx context.Context, s string) (int64, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) != 2 {
+		return 0, moerr.NewInvalidInput(ctx, "invalid merge policy interval '%s'", s)
+	}
+	n, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, moerr.NewInvalidInput(ctx, "invalid merge policy interval '%s'", s)
+	}
+	var unit time.Duration
+	switch strings.TrimSuffix(fields[1], "s") {
+	case "second":
+		unit = time.Second
+	case "minute":
+		unit = time.Minute
+	case "hour":
+		unit = time.Hour
+	case "day":
+		unit = 24 * time.Hour
+	case "week":
+		unit = 7 * 24 * time.Hour
+	default:
+		return 0, moerr.NewNotSupported(ctx, "merge policy interval unit '%s'", fields[1])
+	}
+	if n > int64(MaxMergePolicyInterval/unit) {
+		return 0, moerr.NewInvalidInput(ctx, "merge policy interval '%s' exceeds %d days", s, int64(MaxMergePolicyInterval/(24*time.Hour)))
+	}
+	return n * int64(unit/time.Second), nil
+}
+
+// ZoneMapMaxTime returns the max time covered by the zonemap of a DATE,
+// DATETIME or TIMESTAMP column, false if the zonemap has no value.
+func ZoneMapMaxTime(zm objectio.ZoneMap) (time.Time, bool) {
+	if !zm.IsInited() || !IsTTLColumnType(zm.GetType()) {
+		return time.Time{}, false
+	}
+	var dt types.Datetime
+	switch zm.GetType() {
+	case types.T_date:
+		dt = types.DecodeFixed[types.Date](zm.GetMaxBuf()).ToDatetime()
+	case types.T_datetime:
+		dt = types.DecodeFixed[types.Datetime](zm.GetMaxBuf())
+	default:
+		dt = types.DecodeFixed[types.Timestamp](zm.GetMaxBuf()).ToDatetime(time.Local)
+	}
+	return dt.ConvertToGoTime(time.Local), true
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMergePolicyOptions(t *testing.T) {
+	ctx := context.Background()
+	size, err := ParseMergePolicySize(ctx, "128M")
+	require.NoError(t, err)
+	require.Equal(t, uint64(128<<20), size)
+	size, err = ParseMergePolicySize(ctx, "4096")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4096), size)
+	_, err = ParseMergePolicySize(ctx, "big")
+	require.Error(t, err)
+	_, err = ParseMergePolicySize(ctx, "0")
+	require.Error(t, err)
+
+	secs, err := ParseMergePolicyInterval(ctx, "1 day")
+	require.NoError(t, err)
+	require.Equal(t, int64(86400), secs)
+	secs, err = ParseMergePolicyInterval(ctx, "12 HOURS")
+	require.NoError(t, err)
+	require.Equal(t, int64(12*3600), secs)
+	_, err = ParseMergePolicyInterval(ctx, "1 month")
+	require.Error(t, err)
+	_, err = ParseMergePolicyInterval(ctx, "-1 day")
+	require.Error(t, err)
+	_, err = ParseMergePolicyInterval(ctx, "day")
+	require.Error(t, err)
+	_, err = ParseMergePolicyInterval(ctx, "10000000000 seconds")
+	require.Error(t, err)
+}
+
+func TestZoneMapMaxTime(t *testing.T) {
+	zm := objectio.NewZM(types.T_timestamp, 0)
+	_, ok := ZoneMapMaxTime(zm)
+	require.False(t, ok)
+
+	now := time.Unix(1700000000, 0)
+	ts := types.UnixToTimestamp(now.Unix())
+	index.UpdateZM(zm, types.EncodeFixed(ts-1000))
+	index.UpdateZM(zm, types.EncodeFixed(ts))
+	max, ok := ZoneMapMaxTime(zm)
+	require.True(t, ok)
+	require.Equal(t, now.Unix(), max.Unix())
+
+	zm = objectio.NewZM(types.T_datetime, 0)
+	dt := types.DatetimeFromUnix(time.Local, now.Unix())
+	index.UpdateZM(zm, types.EncodeFixed(dt))
+	max, ok = ZoneMapMaxTime(zm)
+	require.True(t, ok)
+	require.Equal(t, now.Unix(), max.Unix())
+
+	zm = objectio.NewZM(types.T_int64, 0)
+	index.UpdateZM(zm, types.EncodeFixed(int64(1)))
+	_, ok = ZoneMapMaxTime(zm)
+	require.False(t, ok)
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ const (
 	MoIndexBTreeAlgo   = tree.INDEX_TYPE_BTREE   // used for Mocking MySQL behaviour.
 	MoIndexIvfFlatAlgo = tree.INDEX_TYPE_IVFFLAT // used for IVF flat index on Vector/Array columns
 	MOIndexMasterAlgo  = tree.INDEX_TYPE_MASTER  // used for Master Index on VARCHAR columns
+	MoIndexBloomAlgo   = tree.INDEX_TYPE_BLOOM   // used for per-block bloom filters, without hidden table
 )
 
 // ToLower is used for before comparing AlgoType and IndexAlgoParamOpType. Reason why they are strings
@@ -62,6 +64,48 @@ func IsMasterIndexAlgo(algo string) bool {
 	return _algo == MOIndexMasterAlgo.ToString()
 }
 
+func IsBloomIndexAlgo(algo string) bool {
+	_algo := ToLower(algo)
+	return _algo == MoIndexBloomAlgo.ToString()
+}
+
+// BloomIndexColumns returns the distinct columns covered by the bloom filter indexes.
+func BloomIndexColumns(indexes []*plan.IndexDef) []string {
+	var cols []string
+	seen := make(map[string]bool)
+	for _, indexDef := range indexes {
+		if !IsBloomIndexAlgo(indexDef.IndexAlgo) {
+			continue
+		}
+		for _, part := range indexDef.Parts {
+			if !seen[part] {
+				seen[part] = true
+				cols = append(cols, part)
+			}
+		}
+	}
+	return cols
+}
+
+// BloomIndexColumnPositions returns the positions in tableDef.Cols of the
+// columns covered by the bloom filter indexes, used by the object writers.
+func BloomIndexColumnPositions(tableDef *plan.TableDef) []uint16 {
+	cols := BloomIndexColumns(tableDef.Indexes)
+	if len(cols) == 0 {
+		return nil
+	}
+	positions := make([]uint16, 0, len(cols))
+	for _, col := range cols {
+		for i, colDef := range tableDef.Cols {
+			if colDef.Name == col {
+				positions = append(positions, uint16(i))
+				break
+			}
+		}
+	}
+	return positions
+}
+
 // ------------------------[START] IndexAlgoParams------------------------
 const (
 	IndexAlgoParamLists     = "lists"
@@ -171,7 +215,7 @@ func indexParamsToMap(def *tree.Index) (map[string]string, error) {
 	switch def.KeyType {
 	case tree.INDEX_TYPE_BTREE, tree.INDEX_TYPE_INVALID:
 		// do nothing
-	case tree.INDEX_TYPE_MASTER:
+	case tree.INDEX_TYPE_MASTER, tree.INDEX_TYPE_BLOOM:
 		// do nothing
 	case tree.INDEX_TYPE_IVFFLAT:
 		if def.IndexOption.AlgoParamList == 0 {
@@ -0,0 +1,211 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// PropTTL is the table property holding the row time-to-live,
+// encoded as "column,interval,unit".
+const PropTTL = "ttl"
+
+// TableTTL expires a row once the value of Column plus Interval Unit
+// is older than the current time. NULL values never expire.
+type TableTTL struct {
+	Column   string
+	Interval int64
+	Unit     string
+}
+
+// NormalizeTTLUnit maps a time unit of the TTL clause to the unit stored
+// in the table property. Composite units are not supported.
+func NormalizeTTLUnit(ctx context.Context, unit string) (string, error) {
+	unit = strings.TrimPrefix(strings.ToLower(unit), "sql_tsi_")
+	switch unit {
+	case "microsecond", "second", "minute", "hour", "day", "week", "month", "quarter", "year":
+		return unit, nil
+	}
+	return "", moerr.NewNotSupported(ctx, "TTL interval unit '%s'", unit)
+}
+
+// IsTTLColumnType reports whether a column of type oid can be used as TTL column.
+func IsTTLColumnType(oid types.T) bool {
+	return oid == types.T_date || oid == types.T_datetime || oid == types.T_timestamp
+}
+
+func (t TableTTL) String() string {
+	return t.Column + "," + strconv.FormatInt(t.Interval, 10) + "," + t.Unit
+}
+
+// ParseTableTTL decodes the value of the PropTTL property.
+func ParseTableTTL(s string) (TableTTL, bool) {
+	i := strings.LastIndexByte(s, ',')
+	if i <= 0 {
+		return TableTTL{}, false
+	}
+	j := strings.LastIndexByte(s[:i], ',')
+	if j <= 0 {
+		return TableTTL{}, false
+	}
+	interval, err := strconv.ParseInt(s[j+1:i], 10, 64)
+	if err != nil {
+		return TableTTL{}, false
+	}
+	return TableTTL{Column: s[:j], Interval: interval, Unit: s[i+1:]}, true
+}
+
+// TableTTLFromProperties looks up the TTL in a list of table properties.
+func TableTTLFromProperties(props []*plan.Property) (TableTTL, bool) {
+	for _, p := range props {
+		if p.GetKey() == PropTTL {
+			return ParseTableTTL(p.GetValue())
+		}
+	}
+	return TableTTL{}, false
+}
+
+// TableTTLFromDefs looks up the TTL in the properties of a table definition.
+func TableTTLFromDefs(defs []*plan.TableDef_DefType) (TableTTL, bool) {
+	for _, def := range defs {
+		if props, ok := def.GetDef().(*plan.TableDef_DefType_Properties); ok {
+			if ttl, ok := TableTTLFromProperties(props.Properties.GetProperties()); ok {
+				return ttl, true
+			}
+		}
+	}
+	return TableTTL{}, false
+}
+
+// Cutoff returns the time before which the TTL column is expired at now.
+// Month based units clamp the day like DATE_SUB does.
+func (t TableTTL) Cutoff(now time.Time) time.Time {
+	n := t.Interval
+	switch t.Unit {
+	case "microsecond":
+		return now.Add(-time.Duration(n) * time.Microsecond)
+	case "second":
+		return now.Add(-time.Duration(n) * time.Second)
+	case "minute":
+		return now.Add(-time.Duration(n) * time.Minute)
+	case "hour":
+		return now.Add(-time.Duration(n) * time.Hour)
+	case "day":
+		return now.AddDate(0, 0, -int(n))
+	case "week":
+		return now.AddDate(0, 0, -7*int(n))
+	case "month":
+		return subMonths(now, int(n))
+	case "quarter":
+		return subMonths(now, 3*int(n))
+	case "year":
+		return subMonths(now, 12*int(n))
+	}
+	return now
+}
+
+func subMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m-time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+// ttlBound converts cutoff into the encoding of a TTL column of type oid.
+// A value is expired if it is less than the bound. DATE and DATETIME values
+// are taken as UTC, as the filter hiding the expired rows from the readers
+// does.
+func ttlBound(oid types.T, cutoff time.Time) int64 {
+	dt := types.DatetimeFromUnixWithNsec(time.UTC, cutoff.Unix(), int64(cutoff.Nanosecond()))
+	switch oid {
+	case types.T_date:
+		return int64(dt.ToDate())
+	case types.T_datetime:
+		return int64(dt)
+	case types.T_timestamp:
+		return int64(types.UnixMicroToTimestamp(cutoff.UnixMicro()))
+	}
+	return 0
+}
+
+// ExpiredRows adds the rows of vec that are expired at cutoff to del.
+// del is not modified, a new mask is returned if any row expired.
+func ExpiredRows(vec *vector.Vector, cutoff time.Time, del *nulls.Nulls) *nulls.Nulls {
+	oid := vec.GetType().Oid
+	if !IsTTLColumnType(oid) || vec.IsConstNull() {
+		return del
+	}
+	bound := ttlBound(oid, cutoff)
+	var expired []uint64
+	add := func(i int, v int64) {
+		if v < bound && !vec.IsNull(uint64(i)) {
+			expired = append(expired, uint64(i))
+		}
+	}
+	switch oid {
+	case types.T_date:
+		for i, v := range vector.MustFixedCol[types.Date](vec) {
+			add(i, int64(v))
+		}
+	case types.T_datetime:
+		for i, v := range vector.MustFixedCol[types.Datetime](vec) {
+			add(i, int64(v))
+		}
+	case types.T_timestamp:
+		for i, v := range vector.MustFixedCol[types.Timestamp](vec) {
+			add(i, int64(v))
+		}
+	}
+	if len(expired) == 0 {
+		return del
+	}
+	var ret *nulls.Nulls
+	if del != nil {
+		ret = del.Clone()
+	} else {
+		ret = nulls.NewWithSize(vec.Length())
+	}
+	ret.Add(expired...)
+	return ret
+}
+
+// ZoneMapExpired reports whether every non-null value covered by zm is
+// expired at cutoff.
+func ZoneMapExpired(zm objectio.ZoneMap, cutoff time.Time) bool {
+	if !zm.IsInited() || !IsTTLColumnType(zm.GetType()) {
+		return false
+	}
+	bound := ttlBound(zm.GetType(), cutoff)
+	switch zm.GetType() {
+	case types.T_date:
+		return int64(types.DecodeFixed[types.Date](zm.GetMaxBuf())) < bound
+	case types.T_datetime:
+		return int64(types.DecodeFixed[types.Datetime](zm.GetMaxBuf())) < bound
+	default:
+		return int64(types.DecodeFixed[types.Timestamp](zm.GetMaxBuf())) < bound
+	}
+}
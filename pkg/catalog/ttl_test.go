@@ -0,0 +1,107 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableTTL(t *testing.T) {
+	ttl := TableTTL{Column: "a,b", Interval: 7, Unit: "day"}
+	parsed, ok := ParseTableTTL(ttl.String())
+	require.True(t, ok)
+	require.Equal(t, ttl, parsed)
+
+	_, ok = ParseTableTTL("ts,day")
+	require.False(t, ok)
+
+	props := []*plan.Property{
+		{Key: SystemRelAttr_Kind, Value: SystemOrdinaryRel},
+		{Key: PropTTL, Value: "ts,1,month"},
+	}
+	parsed, ok = TableTTLFromProperties(props)
+	require.True(t, ok)
+	require.Equal(t, TableTTL{Column: "ts", Interval: 1, Unit: "month"}, parsed)
+
+	unit, err := NormalizeTTLUnit(context.Background(), "SQL_TSI_HOUR")
+	require.NoError(t, err)
+	require.Equal(t, "hour", unit)
+	_, err = NormalizeTTLUnit(context.Background(), "DAY_HOUR")
+	require.Error(t, err)
+}
+
+func TestTableTTLCutoff(t *testing.T) {
+	now := time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		ttl  TableTTL
+		want time.Time
+	}{
+		{TableTTL{Interval: 90, Unit: "second"}, time.Date(2024, 3, 31, 9, 58, 30, 0, time.UTC)},
+		{TableTTL{Interval: 2, Unit: "day"}, time.Date(2024, 3, 29, 10, 0, 0, 0, time.UTC)},
+		{TableTTL{Interval: 1, Unit: "week"}, time.Date(2024, 3, 24, 10, 0, 0, 0, time.UTC)},
+		{TableTTL{Interval: 1, Unit: "month"}, time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{TableTTL{Interval: 2, Unit: "quarter"}, time.Date(2023, 9, 30, 10, 0, 0, 0, time.UTC)},
+		{TableTTL{Interval: 1, Unit: "year"}, time.Date(2023, 3, 31, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, c.ttl.Cutoff(now), c.ttl.String())
+	}
+}
+
+func TestExpiredRows(t *testing.T) {
+	mp := mpool.MustNewZero()
+	cutoff := time.Now().Add(-time.Hour)
+	old := types.UnixMicroToTimestamp(cutoff.Add(-time.Minute).UnixMicro())
+	fresh := types.UnixMicroToTimestamp(cutoff.Add(time.Minute).UnixMicro())
+
+	vec := vector.NewVec(types.T_timestamp.ToType())
+	defer vec.Free(mp)
+	require.NoError(t, vector.AppendFixedList(vec, []types.Timestamp{old, fresh, old, 0}, []bool{false, false, false, true}, mp))
+
+	del := nulls.NewWithSize(4)
+	del.Add(2)
+	expired := ExpiredRows(vec, cutoff, del)
+	require.Equal(t, []uint64{0, 2}, expired.ToArray())
+	// the input mask is left untouched
+	require.Equal(t, []uint64{2}, del.ToArray())
+
+	require.Nil(t, ExpiredRows(vec, cutoff.Add(-time.Hour), nil))
+
+	zm := objectio.NewZM(types.T_timestamp, 0)
+	index.UpdateZM(zm, types.EncodeFixed(old))
+	require.True(t, ZoneMapExpired(zm, cutoff))
+	index.UpdateZM(zm, types.EncodeFixed(fresh))
+	require.False(t, ZoneMapExpired(zm, cutoff))
+
+	// DATETIME values are taken as UTC
+	dtVec := vector.NewVec(types.T_datetime.ToType())
+	defer dtVec.Free(mp)
+	require.NoError(t, vector.AppendFixedList(dtVec, []types.Datetime{
+		types.DatetimeFromUnix(time.UTC, cutoff.Add(-time.Minute).Unix()),
+		types.DatetimeFromUnix(time.UTC, cutoff.Add(time.Minute).Unix()),
+	}, nil, mp))
+	require.Equal(t, []uint64{0}, ExpiredRows(dtVec, cutoff, nil).ToArray())
+}
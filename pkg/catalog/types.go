@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -94,6 +95,19 @@ func IsHiddenTable(name string) bool {
 	return strings.EqualFold(name, MOAutoIncrTable)
 }
 
+// SplitCompositeClusterByColumnName returns the columns of a composite
+// cluster by column, whose name is PrefixCBColName followed by the names of
+// the columns, each prefixed with its length in three digits.
+func SplitCompositeClusterByColumnName(s string) []string {
+	var names []string
+	for next := len(PrefixCBColName); next < len(s); {
+		strLen, _ := strconv.Atoi(s[next : next+3])
+		names = append(names, s[next+3:next+3+strLen])
+		next += strLen + 3
+	}
+	return names
+}
+
 const (
 	Meta_Length = 6
 )
@@ -128,6 +142,22 @@ const (
 
 	// MO_SNAPSHOTS
 	MO_SNAPSHOTS = "mo_snapshots"
+
+	// MO_BINLOG_POSITIONS records the upstream binlog positions of the mysql
+	// binlog connectors.
+	MO_BINLOG_POSITIONS = "mo_binlog_positions"
+
+	// MO_COLUMN_STATS holds the histograms and most common values collected
+	// by ANALYZE TABLE.
+	MO_COLUMN_STATS = "mo_column_stats"
+
+	// MO_PLAN_BASELINES holds the optimizer hints pinned to the digests of
+	// queries by CREATE PLAN BASELINE.
+	MO_PLAN_BASELINES = "mo_plan_baselines"
+
+	// MO_SCRUB_HISTORY holds the corrupted objects found by the scrubbers of
+	// the TNs, in the sys account only.
+	MO_SCRUB_HISTORY = "mo_scrub_history"
 )
 
 const (
@@ -259,6 +259,8 @@ func (s *service) registerExecutorsLocked() {
 	// streaming connector task
 	s.task.runner.RegisterExecutor(task.TaskCode_ConnectorKafkaSink,
 		moconnector.KafkaSinkConnectorExecutor(s.logger, ts, ieFactory, s.task.runner.Attach))
+	s.task.runner.RegisterExecutor(task.TaskCode_ConnectorMysqlBinlog,
+		moconnector.MysqlBinlogConnectorExecutor(s.logger, ts, ieFactory, s.task.runner.Attach))
 	s.task.runner.RegisterExecutor(task.TaskCode_MergeObject,
 		func(ctx context.Context, task task.Task) error {
 			metadata := task.GetMetadata()
@@ -58,12 +58,14 @@ const (
 	ErrWarnDataTruncated uint16 = 201
 
 	// Group 1: Internal errors
-	ErrStart            uint16 = 20100
-	ErrInternal         uint16 = 20101
-	ErrNYI              uint16 = 20102
-	ErrOOM              uint16 = 20103
-	ErrQueryInterrupted uint16 = 20104
-	ErrNotSupported     uint16 = 20105
+	ErrStart               uint16 = 20100
+	ErrInternal            uint16 = 20101
+	ErrNYI                 uint16 = 20102
+	ErrOOM                 uint16 = 20103
+	ErrQueryInterrupted    uint16 = 20104
+	ErrNotSupported        uint16 = 20105
+	ErrQueryMemoryExceeded uint16 = 20106
+	ErrQueryTimeout        uint16 = 20107
 
 	// Group 2: numeric and functions
 	ErrDivByZero                   uint16 = 20200
@@ -160,6 +162,8 @@ const (
 	ErrFKRowIsReferenced                        uint16 = 20469
 	ErrDuplicateKeyName                         uint16 = 20470
 	ErrFKNoReferencedRow2                       uint16 = 20471
+	ErrDataCorrupted                            uint16 = 20472
+	ErrKeyDoesNotExist                          uint16 = 20473
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
 	ErrRPCTimeout uint16 = 20500
@@ -288,12 +292,14 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWarnDataTruncated: {WARN_DATA_TRUNCATED, []string{MySQLDefaultSqlState}, "warning: data truncated"},
 
 	// Group 1: Internal errors
-	ErrStart:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: error code start"},
-	ErrInternal:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: %s"},
-	ErrNYI:              {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "%s is not yet implemented"},
-	ErrOOM:              {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
-	ErrQueryInterrupted: {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
-	ErrNotSupported:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrStart:               {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: error code start"},
+	ErrInternal:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: %s"},
+	ErrNYI:                 {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "%s is not yet implemented"},
+	ErrOOM:                 {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
+	ErrQueryInterrupted:    {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
+	ErrNotSupported:        {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrQueryMemoryExceeded: {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "query cancelled: memory exceeds max_query_memory of %d bytes"},
+	ErrQueryTimeout:        {ER_QUERY_TIMEOUT, []string{"HY000"}, "Query execution was interrupted, maximum statement execution time exceeded"},
 
 	// Group 2: numeric
 	ErrDivByZero:                   {ER_DIVISION_BY_ZERO, []string{MySQLDefaultSqlState}, "division by zero"},
@@ -389,6 +395,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrFKRowIsReferenced:                        {ER_ROW_IS_REFERENCED, []string{MySQLDefaultSqlState}, "Cannot delete or update a parent row: a foreign key constraint fails"},
 	ErrDuplicateKeyName:                         {ER_DUP_KEYNAME, []string{MySQLDefaultSqlState}, "Duplicate foreign key constraint name '%-.192s'"},
 	ErrFKNoReferencedRow2:                       {ER_NO_REFERENCED_ROW_2, []string{"23000"}, "Cannot add or update a child row: a foreign key constraint fails"},
+	ErrDataCorrupted:                            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "data corrupted: %s"},
+	ErrKeyDoesNotExist:                          {ER_KEY_DOES_NOT_EXIST, []string{"42000"}, "Key '%-.192s' doesn't exist in table '%-.192s'"},
 	// Group 5: rpc timeout
 	ErrRPCTimeout:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "rpc timeout"},
 	ErrClientClosed: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "client closed"},
@@ -730,6 +738,14 @@ func NewQueryInterrupted(ctx context.Context) *Error {
 	return newError(ctx, ErrQueryInterrupted)
 }
 
+func NewQueryMemoryExceeded(ctx context.Context, quota int64) *Error {
+	return newError(ctx, ErrQueryMemoryExceeded, quota)
+}
+
+func NewQueryTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrQueryTimeout)
+}
+
 func NewDivByZero(ctx context.Context) *Error {
 	return newError(ctx, ErrDivByZero)
 }
@@ -1370,6 +1386,14 @@ func NewErrFKNoReferencedRow2(ctx context.Context) *Error {
 	return newError(ctx, ErrFKNoReferencedRow2)
 }
 
+func NewDataCorrupted(ctx context.Context, msg string) *Error {
+	return newError(ctx, ErrDataCorrupted, msg)
+}
+
+func NewErrKeyDoesNotExist(ctx context.Context, key, table string) *Error {
+	return newError(ctx, ErrKeyDoesNotExist, key, table)
+}
+
 var contextFunc atomic.Value
 
 func SetContextFunc(f func() context.Context) {
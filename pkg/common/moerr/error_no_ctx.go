@@ -46,6 +46,14 @@ func NewOOMNoCtx() *Error {
 	return newError(Context(), ErrOOM)
 }
 
+func NewQueryMemoryExceededNoCtx(quota int64) *Error {
+	return newError(Context(), ErrQueryMemoryExceeded, quota)
+}
+
+func NewQueryTimeoutNoCtx() *Error {
+	return newError(Context(), ErrQueryTimeout)
+}
+
 func NewDivByZeroNoCtx() *Error {
 	return newError(Context(), ErrDivByZero)
 }
@@ -107,6 +115,10 @@ func NewFileNotFoundNoCtx(f string) *Error {
 	return newError(Context(), ErrFileNotFound, f)
 }
 
+func NewDataCorruptedNoCtx(msg string) *Error {
+	return newError(Context(), ErrDataCorrupted, msg)
+}
+
 func NewFileAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrFileAlreadyExists, f)
 }
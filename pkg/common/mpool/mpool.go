@@ -330,6 +330,11 @@ type MPool struct {
 	inUseCount int32 // number of in use call
 	pools      [NumFixedPool]fixedPool
 	details    *mpoolDetails
+	// quota caps the memory of the query the pool is used by, if set.
+	quota atomic.Pointer[queryQuota]
+	// remote is the bytes the query holds out of the pool, in its
+	// pipelines running on the other CNs.
+	remote atomic.Int64
 
 	// To remove: this thing is highly unlikely to be of any good use.
 	sels *sync.Pool
@@ -374,6 +379,60 @@ func (mp *MPool) Cap() int64 {
 	return mp.cap
 }
 
+// queryQuota is the quota of bytes of a query: the pool fails the
+// allocations taking the bytes in use, plus the bytes the query holds on
+// the other CNs, beyond limit, the bytes in use when the query started
+// plus the quota.
+type queryQuota struct {
+	quota int64
+	limit int64
+}
+
+// SetQueryQuota caps the bytes the query about to run may allocate from the
+// pool, net of its frees, at quota. The allocations beyond fail with
+// ErrQueryMemoryExceeded, which cancels the query. A quota of 0 lifts the cap.
+// The bytes held on the other CNs are reset, as they are of the last query,
+// whose pipelines may fail without reporting their release.
+func (mp *MPool) SetQueryQuota(quota int64) {
+	mp.remote.Store(0)
+	if quota <= 0 {
+		mp.quota.Store(nil)
+		return
+	}
+	mp.quota.Store(&queryQuota{
+		quota: quota,
+		limit: mp.CurrNB() + quota,
+	})
+}
+
+// AddRemoteQueryMemory adds delta to the bytes the query holds in its
+// pipelines on the other CNs, which share the quota of the query with the
+// pool. It returns ErrQueryMemoryExceeded if the query holds more than its
+// quota in all.
+func (mp *MPool) AddRemoteQueryMemory(delta int64) error {
+	remote := mp.remote.Add(delta)
+	if q := mp.quota.Load(); q != nil && delta > 0 && mp.CurrNB()+remote > q.limit {
+		return moerr.NewQueryMemoryExceededNoCtx(q.quota)
+	}
+	return nil
+}
+
+// RemoteQueryMemory returns the bytes the query holds in its pipelines on
+// the other CNs.
+func (mp *MPool) RemoteQueryMemory() int64 {
+	return mp.remote.Load()
+}
+
+// QueryMemoryHeld returns the bytes counted against the quota of the query
+// and the quota, 0 if there is no quota.
+func (mp *MPool) QueryMemoryHeld() (held int64, quota int64) {
+	q := mp.quota.Load()
+	if q == nil {
+		return 0, 0
+	}
+	return mp.CurrNB() + mp.remote.Load() - (q.limit - q.quota), q.quota
+}
+
 func (mp *MPool) destroy() {
 	if atomic.LoadInt32(&mp.inUseCount) != 0 {
 		logutil.Errorf("Mpool %s already in use", mp.tag)
@@ -580,6 +639,11 @@ func (mp *MPool) Alloc(sz int) ([]byte, error) {
 		globalStats.RecordFree("global", tempSize)
 		return nil, moerr.NewInternalErrorNoCtx("mpool out of space, alloc %d bytes, cap %d", sz, mp.cap)
 	}
+	if q := mp.quota.Load(); q != nil && mycurr+mp.remote.Load() > q.limit {
+		mp.stats.RecordFree(mp.tag, tempSize)
+		globalStats.RecordFree("global", tempSize)
+		return nil, moerr.NewQueryMemoryExceededNoCtx(q.quota)
+	}
 
 	// from fixed pool
 	if idx < NumFixedPool {
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 func TestMPool(t *testing.T) {
@@ -150,3 +152,55 @@ func TestMpoolReAllocate(t *testing.T) {
 	m.Free(d4)
 	require.Equal(t, int64(0), m.CurrNB())
 }
+
+func TestMPoolQueryQuota(t *testing.T) {
+	m, err := NewMPool("test-mpool-quota", 0, NoFixed)
+	require.NoError(t, err)
+	defer DeleteMPool(m)
+
+	// the bytes in use before the query do not count
+	before, err := m.Alloc(8 << 20)
+	require.NoError(t, err)
+	m.SetQueryQuota(1 << 20)
+
+	a, err := m.Alloc(512 << 10)
+	require.NoError(t, err)
+	_, err = m.Alloc(768 << 10)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryExceeded))
+
+	// the frees of the query make room
+	m.Free(a)
+	a, err = m.Alloc(768 << 10)
+	require.NoError(t, err)
+
+	// the bytes held on the other CNs share the quota
+	require.NoError(t, m.AddRemoteQueryMemory(128<<10))
+	_, err = m.Alloc(256 << 10)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryExceeded))
+	held, quota := m.QueryMemoryHeld()
+	require.Equal(t, int64(1<<20), quota)
+	// the allocations have a header each
+	require.InDelta(t, 896<<10, held, 64)
+	require.True(t, moerr.IsMoErrCode(m.AddRemoteQueryMemory(256<<10), moerr.ErrQueryMemoryExceeded))
+	require.NoError(t, m.AddRemoteQueryMemory(-384<<10))
+	require.Zero(t, m.RemoteQueryMemory())
+
+	// the bytes a failed remote pipeline left behind do not count against
+	// the next query
+	require.NoError(t, m.AddRemoteQueryMemory(128<<10))
+	m.SetQueryQuota(1 << 20)
+	require.Zero(t, m.RemoteQueryMemory())
+	c, err := m.Alloc(960 << 10)
+	require.NoError(t, err)
+	m.Free(c)
+
+	m.SetQueryQuota(0)
+	_, quota = m.QueryMemoryHeld()
+	require.Zero(t, quota)
+	b, err := m.Alloc(4 << 20)
+	require.NoError(t, err)
+	m.Free(b)
+	m.Free(a)
+	m.Free(before)
+	require.Zero(t, m.CurrNB())
+}
@@ -182,6 +182,10 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket" user_setting:"advanced"`
 
+	// PgPort defines which port the postgresql clients connect to. It is
+	// disabled when it is 0.
+	PgPort int64 `toml:"pg-port" user_setting:"advanced"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
@@ -265,6 +269,9 @@ type FrontendParameters struct {
 	// default 100 (MB)
 	QueryResultMaxsize uint64 `toml:"queryResultMaxsize" user_setting:"advanced"`
 
+	// default 64 (MB)
+	QueryCacheSize uint64 `toml:"queryCacheSize" user_setting:"advanced"`
+
 	AutoIncrCacheSize uint64 `toml:"autoIncrCacheSize"`
 
 	LowerCaseTableNames string `toml:"lowerCaseTableNames" user_setting:"advanced"`
@@ -389,6 +396,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.QueryResultMaxsize = 100
 	}
 
+	if fp.QueryCacheSize == 0 {
+		fp.QueryCacheSize = 64
+	}
+
 	if fp.AutoIncrCacheSize == 0 {
 		fp.AutoIncrCacheSize = 3000000
 	}
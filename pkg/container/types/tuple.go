@@ -693,98 +693,11 @@ func decodeTuple(b []byte) (Tuple, int, []T, error) {
 	var i int
 	var schema = make([]T, 0)
 	for i < len(b) {
-		var el interface{}
-		var off int
-
-		switch {
-		case b[i] == nilCode:
-			schema = append(schema, T_any)
-			el = nil
-			off = 1
-		case b[i] == int8Code:
-			schema = append(schema, T_int8)
-			el, off = decodeInt(int8Code, b[i+1:])
-			off += 1
-		case b[i] == int16Code:
-			schema = append(schema, T_int16)
-			el, off = decodeInt(int16Code, b[i+1:])
-			off += 1
-		case b[i] == int32Code:
-			schema = append(schema, T_int32)
-			el, off = decodeInt(int32Code, b[i+1:])
-			off += 1
-		case b[i] == int64Code:
-			schema = append(schema, T_int64)
-			el, off = decodeInt(int64Code, b[i+1:])
-			off += 1
-		case b[i] == uint8Code:
-			schema = append(schema, T_uint8)
-			el, off = decodeUint(uint8Code, b[i+1:])
-			off += 1
-		case b[i] == uint16Code:
-			schema = append(schema, T_uint16)
-			el, off = decodeUint(uint16Code, b[i+1:])
-			off += 1
-		case b[i] == uint32Code:
-			schema = append(schema, T_uint32)
-			el, off = decodeUint(uint32Code, b[i+1:])
-			off += 1
-		case b[i] == uint64Code:
-			schema = append(schema, T_uint64)
-			el, off = decodeUint(uint64Code, b[i+1:])
-			off += 1
-		case b[i] == trueCode:
-			schema = append(schema, T_bool)
-			el = true
-			off = 1
-		case b[i] == falseCode:
-			schema = append(schema, T_bool)
-			el = false
-			off = 1
-		case b[i] == float32Code:
-			schema = append(schema, T_float32)
-			el, off = decodeFloat32(b[i:])
-		case b[i] == float64Code:
-			schema = append(schema, T_float64)
-			el, off = decodeFloat64(b[i:])
-		case b[i] == dateCode:
-			schema = append(schema, T_date)
-			el, off = decodeInt(dateCode, b[i+1:])
-			off += 1
-		case b[i] == datetimeCode:
-			schema = append(schema, T_datetime)
-			el, off = decodeInt(datetimeCode, b[i+1:])
-			off += 1
-		case b[i] == timestampCode:
-			schema = append(schema, T_timestamp)
-			el, off = decodeInt(timestampCode, b[i+1:])
-			off += 1
-		case b[i] == timeCode:
-			schema = append(schema, T_time)
-			el, off = decodeInt(timeCode, b[i+1:])
-			off += 1
-		case b[i] == decimal64Code:
-			schema = append(schema, T_decimal64)
-			el, off = decodeDecimal64(b[i+1:])
-		case b[i] == decimal128Code:
-			schema = append(schema, T_decimal128)
-			el, off = decodeDecimal128(b[i+1:])
-		case b[i] == stringTypeCode:
-			schema = append(schema, T_varchar)
-			el, off = decodeBytes(b[i+1:])
-			off += 1
-		case b[i] == bitCode:
-			schema = append(schema, T_bit)
-			el, off = decodeUint(uint64Code, b[i+1:])
-			off += 1
-		case b[i] == enumCode:
-			schema = append(schema, T_enum)
-			//TODO: need to verify @YANGGMM
-			el, off = decodeUint(uint16Code, b[i+1:])
-			off += 1
-		default:
-			return nil, i, nil, moerr.NewInternalErrorNoCtx("unable to decode tuple element with unknown typecode %02x", b[i])
+		el, off, typ, err := decodeTupleElem(b[i:])
+		if err != nil {
+			return nil, i, nil, err
 		}
+		schema = append(schema, typ)
 		t = append(t, el)
 		i += off
 	}
@@ -792,6 +705,116 @@ func decodeTuple(b []byte) (Tuple, int, []T, error) {
 	return t, i, schema, nil
 }
 
+// decodeTupleElem decodes the first element of a packed tuple, returns the
+// element, the number of bytes it takes and its type
+func decodeTupleElem(b []byte) (el interface{}, off int, typ T, err error) {
+	switch {
+	case b[0] == nilCode:
+		typ = T_any
+		el = nil
+		off = 1
+	case b[0] == int8Code:
+		typ = T_int8
+		el, off = decodeInt(int8Code, b[1:])
+		off += 1
+	case b[0] == int16Code:
+		typ = T_int16
+		el, off = decodeInt(int16Code, b[1:])
+		off += 1
+	case b[0] == int32Code:
+		typ = T_int32
+		el, off = decodeInt(int32Code, b[1:])
+		off += 1
+	case b[0] == int64Code:
+		typ = T_int64
+		el, off = decodeInt(int64Code, b[1:])
+		off += 1
+	case b[0] == uint8Code:
+		typ = T_uint8
+		el, off = decodeUint(uint8Code, b[1:])
+		off += 1
+	case b[0] == uint16Code:
+		typ = T_uint16
+		el, off = decodeUint(uint16Code, b[1:])
+		off += 1
+	case b[0] == uint32Code:
+		typ = T_uint32
+		el, off = decodeUint(uint32Code, b[1:])
+		off += 1
+	case b[0] == uint64Code:
+		typ = T_uint64
+		el, off = decodeUint(uint64Code, b[1:])
+		off += 1
+	case b[0] == trueCode:
+		typ = T_bool
+		el = true
+		off = 1
+	case b[0] == falseCode:
+		typ = T_bool
+		el = false
+		off = 1
+	case b[0] == float32Code:
+		typ = T_float32
+		el, off = decodeFloat32(b)
+	case b[0] == float64Code:
+		typ = T_float64
+		el, off = decodeFloat64(b)
+	case b[0] == dateCode:
+		typ = T_date
+		el, off = decodeInt(dateCode, b[1:])
+		off += 1
+	case b[0] == datetimeCode:
+		typ = T_datetime
+		el, off = decodeInt(datetimeCode, b[1:])
+		off += 1
+	case b[0] == timestampCode:
+		typ = T_timestamp
+		el, off = decodeInt(timestampCode, b[1:])
+		off += 1
+	case b[0] == timeCode:
+		typ = T_time
+		el, off = decodeInt(timeCode, b[1:])
+		off += 1
+	case b[0] == decimal64Code:
+		typ = T_decimal64
+		el, off = decodeDecimal64(b[1:])
+	case b[0] == decimal128Code:
+		typ = T_decimal128
+		el, off = decodeDecimal128(b[1:])
+	case b[0] == stringTypeCode:
+		typ = T_varchar
+		el, off = decodeBytes(b[1:])
+		off += 1
+	case b[0] == bitCode:
+		typ = T_bit
+		el, off = decodeUint(uint64Code, b[1:])
+		off += 1
+	case b[0] == enumCode:
+		typ = T_enum
+		//TODO: need to verify @YANGGMM
+		el, off = decodeUint(uint16Code, b[1:])
+		off += 1
+	default:
+		return nil, 0, 0, moerr.NewInternalErrorNoCtx("unable to decode tuple element with unknown typecode %02x", b[0])
+	}
+	return
+}
+
+// SplitTuple splits a packed tuple into the packed bytes of its elements.
+// Like the tuple, the bytes of an element compare in the order of the element.
+func SplitTuple(b []byte) ([][]byte, error) {
+	var elems [][]byte
+	for i := 0; i < len(b); {
+		_, off, _, err := decodeTupleElem(b[i:])
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, b[i:i+off])
+		i += off
+	}
+	return elems, nil
+}
+
 func Unpack(b []byte) (Tuple, error) {
 	t, _, _, err := decodeTuple(b)
 	return t, err
@@ -495,3 +495,25 @@ func encodeBufToPacker(tuple Tuple, p *Packer) {
 		}
 	}
 }
+
+func TestSplitTuple(t *testing.T) {
+	mp := mpool.MustNewZero()
+	packer := NewPacker(mp)
+	defer packer.FreeMem()
+	tuple := Tuple{int64(-5), []byte{0, 1}, nil, float64(1.5), Decimal64(123)}
+	encodeBufToPacker(tuple[:2], packer)
+	packer.EncodeNull()
+	encodeBufToPacker(tuple[3:], packer)
+	elems, err := SplitTuple(packer.GetBuf())
+	require.NoError(t, err)
+	require.Len(t, elems, len(tuple))
+	for i, elem := range elems {
+		tt, err := Unpack(elem)
+		require.NoError(t, err)
+		require.Equal(t, Tuple{tuple[i]}.String(), tt.String())
+	}
+	require.Equal(t, packer.GetBuf(), bytes.Join(elems, nil))
+
+	_, err = SplitTuple([]byte{0xff})
+	require.Error(t, err)
+}
@@ -0,0 +1,244 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// MaxDictSize is the max number of distinct values of a dictionary,
+// codes are uint16
+const MaxDictSize = 1 << 16
+
+// Dict is the dictionary of a low cardinality string vector.
+//
+// A vector with a dictionary keeps its Varlena layout, so code unaware of the
+// dictionary works as usual, while operators can work on the codes of the rows
+// and the distinct values only, e.g. hash the distinct values once or evaluate
+// a filter once per distinct value.
+//
+// A Dict is immutable and its memory is not allocated from the mpool,
+// so it can be shared by vectors and dropped at any time.
+type Dict struct {
+	// values holds the distinct values, it has no null
+	values *Vector
+	// codes[i] is the position in values of row i, 0 for null rows
+	codes []uint16
+}
+
+// Values returns the distinct values, the vector must not be modified
+func (d *Dict) Values() *Vector {
+	return d.values
+}
+
+// Codes returns the codes of the rows, the codes of null rows are meaningless
+func (d *Dict) Codes() []uint16 {
+	return d.codes
+}
+
+// Len returns the number of distinct values
+func (d *Dict) Len() int {
+	return d.values.length
+}
+
+func (d *Dict) window(start, end int) *Dict {
+	return &Dict{values: d.values, codes: d.codes[start:end]}
+}
+
+// GetDict returns the dictionary of the vector, nil if the vector is not
+// dictionary encoded or has been modified since the dictionary was attached.
+//
+// Appends are caught by the number of codes, every method rewriting the rows
+// in place, e.g. Copy, SetFixedAt, Shrink and Shuffle, drops the dictionary.
+// Rows written through the slice of MustFixedCol are not tracked, drop the
+// dictionary with SetDict(nil) before doing so.
+func (v *Vector) GetDict() *Dict {
+	if v.dict == nil || v.class != FLAT || len(v.dict.codes) != v.length {
+		return nil
+	}
+	return v.dict
+}
+
+// SetDict attaches the dictionary d to the vector, d must match the rows of the vector
+func (v *Vector) SetDict(d *Dict) {
+	v.dict = d
+}
+
+// BuildDict builds the dictionary of a string vector, false is returned if
+// the vector is constant or has more than MaxDictSize distinct values.
+func BuildDict(v *Vector) (*Dict, bool) {
+	if d := v.GetDict(); d != nil {
+		return d, true
+	}
+	if v.IsConst() || !v.typ.IsVarlen() {
+		return nil, false
+	}
+
+	var (
+		vs     []types.Varlena
+		values []types.Varlena
+		area   []byte
+	)
+	ToSlice(v, &vs)
+	codes := make([]uint16, v.length)
+	positions := make(map[string]uint16)
+	for i := 0; i < v.length; i++ {
+		if v.nsp.Contains(uint64(i)) {
+			continue
+		}
+		val := vs[i].GetByteSlice(v.area)
+		code, ok := positions[string(val)]
+		if !ok {
+			if len(values) == MaxDictSize {
+				return nil, false
+			}
+			code = uint16(len(values))
+			positions[string(val)] = code
+			var varlena types.Varlena
+			varlena, area, _ = types.BuildVarlena(val, area, nil)
+			values = append(values, varlena)
+		}
+		codes[i] = code
+	}
+	return &Dict{
+		values: newReadOnlyVarlenVector(v.typ, values, area),
+		codes:  codes,
+	}, true
+}
+
+// newReadOnlyVarlenVector returns a vector not allocated from the mpool
+func newReadOnlyVarlenVector(typ types.Type, vs []types.Varlena, area []byte) *Vector {
+	v := NewVec(typ)
+	if len(vs) > 0 {
+		v.data = types.EncodeSlice(vs)
+		v.setupColFromData()
+	}
+	v.area = area
+	v.length = len(vs)
+	v.capacity = len(vs)
+	v.cantFreeData = true
+	v.cantFreeArea = true
+	return v
+}
+
+// MarshalDictWithBuffer encodes the vector as its dictionary and codes,
+// d must be the dictionary of the vector
+func (v *Vector) MarshalDictWithBuffer(d *Dict, buf *bytes.Buffer) error {
+	values, err := d.values.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	valuesLen := uint32(len(values))
+	buf.Write(types.EncodeUint32(&valuesLen))
+	buf.Write(values)
+
+	length := uint32(v.length)
+	buf.Write(types.EncodeUint32(&length))
+
+	nspData, err := v.nsp.Show()
+	if err != nil {
+		return err
+	}
+	nspLen := uint32(len(nspData))
+	buf.Write(types.EncodeUint32(&nspLen))
+	buf.Write(nspData)
+
+	buf.Write(types.EncodeSlice(d.codes))
+	buf.Write(types.EncodeBool(&v.sorted))
+	return nil
+}
+
+// UnmarshalDict decodes data encoded by MarshalDictWithBuffer. The rows of the
+// vector share the distinct values of the dictionary, and nothing refers to
+// data after decoding. Like vectors decoded by UnmarshalBinary, the vector is
+// not allocated from the mpool.
+func (v *Vector) UnmarshalDict(data []byte) error {
+	if len(data) < 4 {
+		return moerr.NewInternalErrorNoCtx("invalid dictionary vector")
+	}
+	valuesLen := types.DecodeUint32(data[:4])
+	data = data[4:]
+	// the values are copied, the input may be released after decoding
+	valuesData := make([]byte, valuesLen)
+	copy(valuesData, data[:valuesLen])
+	data = data[valuesLen:]
+	values := NewVec(types.Type{})
+	if err := values.UnmarshalBinary(valuesData); err != nil {
+		return err
+	}
+
+	length := int(types.DecodeUint32(data[:4]))
+	data = data[4:]
+
+	nspLen := types.DecodeUint32(data[:4])
+	data = data[4:]
+	nsp := nulls.NewWithSize(0)
+	if nspLen > 0 {
+		if err := nsp.Read(data[:nspLen]); err != nil {
+			return err
+		}
+		data = data[nspLen:]
+	}
+
+	codes := make([]uint16, length)
+	copy(codes, types.DecodeSlice[uint16](data[:2*length]))
+	data = data[2*length:]
+	sorted := types.DecodeBool(data[:1])
+
+	var valueVs []types.Varlena
+	ToSlice(values, &valueVs)
+	vs := make([]types.Varlena, length)
+	for i, code := range codes {
+		if nsp.Contains(uint64(i)) {
+			continue
+		}
+		if int(code) >= values.length {
+			return moerr.NewInternalErrorNoCtx("invalid dictionary code %d, %d values", code, values.length)
+		}
+		vs[i] = valueVs[code]
+	}
+
+	*v = *newReadOnlyVarlenVector(values.typ, vs, values.area)
+	v.nsp.InitWith(nsp)
+	v.sorted = sorted
+	v.dict = &Dict{values: values, codes: codes}
+	return nil
+}
+
+// unionDict copies the dictionary vector w to the empty vector v. The rows of w
+// refer to the distinct values in its area only, so the data and the area are
+// copied as they are, and v shares the dictionary of w.
+func unionDict(v, w *Vector, d *Dict, mp *mpool.MPool) (err error) {
+	if err = extend(v, w.length, mp); err != nil {
+		return err
+	}
+	sz := v.typ.TypeSize()
+	copy(v.data, w.data[:w.length*sz])
+	if len(w.area) > 0 {
+		if v.area, err = mp.Grow(v.area[:0], len(w.area)); err != nil {
+			return err
+		}
+		copy(v.area, w.area)
+	}
+	v.nsp.InitWith(&w.nsp)
+	v.length = w.length
+	v.dict = d
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newDictTestVector(t *testing.T, mp *mpool.MPool) *Vector {
+	vec := NewVec(types.T_varchar.ToType())
+	err := AppendStringList(vec,
+		[]string{"a", "bb", "", "a", "ccc", "bb", "a"},
+		[]bool{false, false, true, false, false, false, false}, mp)
+	require.NoError(t, err)
+	return vec
+}
+
+func TestBuildDict(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newDictTestVector(t, mp)
+	require.Nil(t, vec.GetDict())
+
+	d, ok := BuildDict(vec)
+	require.True(t, ok)
+	require.Equal(t, 3, d.Len())
+	require.Equal(t, []string{"a", "bb", "ccc"}, MustStrCol(d.Values()))
+	codes := d.Codes()
+	require.Equal(t, uint16(0), codes[0])
+	require.Equal(t, uint16(1), codes[1])
+	require.Equal(t, uint16(0), codes[3])
+	require.Equal(t, uint16(2), codes[4])
+
+	vec.SetDict(d)
+	require.Equal(t, d, vec.GetDict())
+	d2, ok := BuildDict(vec)
+	require.True(t, ok)
+	require.Equal(t, d, d2)
+
+	// modifying the vector drops the dictionary
+	require.NoError(t, SetBytesAt(vec, 0, []byte("x"), mp))
+	require.Nil(t, vec.GetDict())
+	vec.SetDict(d)
+	require.NoError(t, AppendBytes(vec, []byte("a"), false, mp))
+	require.Nil(t, vec.GetDict())
+
+	cv, err := NewConstBytes(types.T_varchar.ToType(), []byte("a"), 3, mp)
+	require.NoError(t, err)
+	_, ok = BuildDict(cv)
+	require.False(t, ok)
+	cv.Free(mp)
+	_, ok = BuildDict(NewVec(types.T_int32.ToType()))
+	require.False(t, ok)
+
+	vec.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestDictMarshalAndUnmarshal(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newDictTestVector(t, mp)
+	d, ok := BuildDict(vec)
+	require.True(t, ok)
+
+	var buf bytes.Buffer
+	require.NoError(t, vec.MarshalDictWithBuffer(d, &buf))
+
+	w := NewVec(types.T_varchar.ToType())
+	require.NoError(t, w.UnmarshalDict(buf.Bytes()))
+	require.Equal(t, vec.Length(), w.Length())
+	require.Equal(t, MustStrCol(vec), MustStrCol(w))
+	require.True(t, w.IsNull(2))
+	require.NotNil(t, w.GetDict())
+	require.Equal(t, MustStrCol(d.Values()), MustStrCol(w.GetDict().Values()))
+	require.Equal(t, d.Codes(), w.GetDict().Codes())
+
+	require.Error(t, w.UnmarshalDict(nil))
+
+	vec.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestDictShrinkAndShuffle(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newDictTestVector(t, mp)
+	d, ok := BuildDict(vec)
+	require.True(t, ok)
+	vec.SetDict(d)
+
+	w, err := vec.Dup(mp)
+	require.NoError(t, err)
+	require.Equal(t, d, w.GetDict())
+
+	cw, err := vec.CloneWindow(3, 5, mp)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "ccc"}, MustStrCol(cw))
+	require.Equal(t, []uint16{0, 2}, cw.GetDict().Codes())
+	cw.Free(mp)
+
+	// the rows are rewritten in place, the dictionary is dropped
+	vec.Shrink([]int64{1, 4, 6}, false)
+	require.Equal(t, []string{"bb", "ccc", "a"}, MustStrCol(vec))
+	require.Nil(t, vec.GetDict())
+
+	require.NoError(t, w.Shuffle([]int64{4, 1}, mp))
+	require.Equal(t, []string{"ccc", "bb"}, MustStrCol(w))
+	require.Nil(t, w.GetDict())
+
+	vec.Free(mp)
+	w.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestDictCopy(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newDictTestVector(t, mp)
+	d, ok := BuildDict(vec)
+	require.True(t, ok)
+	vec.SetDict(d)
+
+	// same number of rows, but row 0 is not the value of its code any more
+	require.NoError(t, vec.Copy(vec, 0, 4, mp))
+	require.Equal(t, vec.Length(), len(d.Codes()))
+	require.Nil(t, vec.GetDict())
+
+	vec.SetDict(d)
+	require.NoError(t, SetStringAt(vec, 1, "dddd", mp))
+	require.Nil(t, vec.GetDict())
+
+	vec.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestDictUnionAll(t *testing.T) {
+	mp := mpool.MustNewZero()
+	w := newDictTestVector(t, mp)
+	d, ok := BuildDict(w)
+	require.True(t, ok)
+	w.SetDict(d)
+
+	v := NewVec(types.T_varchar.ToType())
+	fn := GetUnionAllFunction(*v.GetType(), mp)
+	require.NoError(t, fn(v, w))
+	require.Equal(t, MustStrCol(w), MustStrCol(v))
+	require.True(t, v.IsNull(2))
+	require.Equal(t, d, v.GetDict())
+
+	// the dictionary can not be kept when appending to a non-empty vector
+	require.NoError(t, fn(v, w))
+	require.Equal(t, 2*w.Length(), v.Length())
+	require.Nil(t, v.GetDict())
+
+	v.Free(mp)
+	w.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
@@ -59,6 +59,9 @@ type Vector struct {
 
 	sorted bool // for some optimization
 
+	// dictionary of low cardinality strings, see GetDict
+	dict *Dict
+
 	// FIXME: Bad design! Will be deleted soon.
 	isBin bool
 }
@@ -103,6 +106,7 @@ func (v *Vector) Reset(typ types.Type) {
 	v.length = 0
 	v.nsp.Reset()
 	v.sorted = false
+	v.dict = nil
 }
 
 func (v *Vector) ResetArea() {
@@ -121,6 +125,7 @@ func (v *Vector) ResetWithNewType(t *types.Type) {
 	v.length = 0
 	v.capacity = cap(v.data) / v.typ.TypeSize()
 	v.sorted = false
+	v.dict = nil
 	if oldTyp.Oid != t.Oid {
 		v.setupColFromData()
 	}
@@ -150,6 +155,7 @@ func (v *Vector) Allocated() int {
 
 func (v *Vector) SetLength(n int) {
 	v.length = n
+	v.dict = nil
 }
 
 // Size of data, I think this function is inherently broken.  This
@@ -175,6 +181,7 @@ func (v *Vector) GetNulls() *nulls.Nulls {
 }
 
 func (v *Vector) SetNulls(nsp *nulls.Nulls) {
+	v.dict = nil
 	if nsp != nil {
 		v.nsp.InitWith(nsp)
 	} else {
@@ -242,6 +249,7 @@ func (v *Vector) CleanOnlyData() {
 	}
 	v.nsp.Reset()
 	v.sorted = false
+	v.dict = nil
 }
 
 func (v *Vector) GetStringAt(i int) string {
@@ -364,6 +372,7 @@ func SetFixedAt[T types.FixedSizeT](v *Vector, idx int, t T) error {
 		return moerr.NewInternalErrorNoCtx("vector idx out of range: %d > %d", idx, len(vacol))
 	}
 	vacol[idx] = t
+	v.dict = nil
 	return nil
 }
 
@@ -393,6 +402,7 @@ func (v *Vector) GetArea() []byte {
 }
 func (v *Vector) SetArea(a []byte) {
 	v.area = a
+	v.dict = nil
 }
 
 func GetPtrAt[T any](v *Vector, idx int64) *T {
@@ -422,6 +432,7 @@ func (v *Vector) Free(mp *mpool.MPool) {
 
 	v.nsp.Reset()
 	v.sorted = false
+	v.dict = nil
 }
 
 func (v *Vector) MarshalBinary() ([]byte, error) {
@@ -634,6 +645,7 @@ func (v *Vector) Dup(mp *mpool.MPool) (*Vector, error) {
 		typ:    v.typ,
 		length: v.length,
 		sorted: v.sorted,
+		dict:   v.GetDict(),
 	}
 	w.GetNulls().InitWith(v.GetNulls())
 
@@ -669,6 +681,7 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		}
 		return
 	}
+	v.dict = nil
 
 	switch v.typ.Oid {
 	case types.T_bool:
@@ -733,6 +746,7 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) (err error) {
 	if v.IsConst() {
 		return nil
 	}
+	v.dict = nil
 
 	switch v.typ.Oid {
 	case types.T_bool:
@@ -794,6 +808,7 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) (err error) {
 // XXX Old Copy is FUBAR.
 // Copy simply does v[vi] = w[wi]
 func (v *Vector) Copy(w *Vector, vi, wi int64, mp *mpool.MPool) error {
+	v.dict = nil
 	if w.class == CONSTANT {
 		if w.IsConstNull() {
 			v.nsp.Set(uint64(vi))
@@ -1509,6 +1524,10 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 				}
 				return nil
 			}
+			if d := w.GetDict(); d != nil && v.length == 0 && len(v.area) == 0 {
+				// keep the dictionary, the distinct values are copied only once
+				return unionDict(v, w, d, mp)
+			}
 			if err := extend(v, w.length, mp); err != nil {
 				return err
 			}
@@ -3110,6 +3129,9 @@ func (v *Vector) Window(start, end int) (*Vector, error) {
 	if v.typ.IsVarlen() {
 		w.area = v.area
 	}
+	if d := v.GetDict(); d != nil {
+		w.dict = d.window(start, end)
+	}
 	w.cantFreeData = true
 	w.cantFreeArea = true
 	return w, nil
@@ -3213,6 +3235,9 @@ func (v *Vector) CloneWindowTo(w *Vector, start, end int, mp *mpool.MPool) error
 			copy(w.data[:length], v.data[start*tlen:end*tlen])
 		}
 	}
+	if d := v.GetDict(); d != nil {
+		w.dict = d.window(start, end)
+	}
 
 	return nil
 }
@@ -3593,6 +3618,7 @@ func (v *Vector) GetMinMaxValue() (ok bool, minv, maxv []byte) {
 
 // InplaceSortAndCompact @todo optimization in the future
 func (v *Vector) InplaceSortAndCompact() {
+	v.dict = nil
 	switch v.GetType().Oid {
 	case types.T_bool:
 		col := MustFixedCol[bool](v)
@@ -3924,6 +3950,7 @@ func (v *Vector) InplaceSortAndCompact() {
 }
 
 func (v *Vector) InplaceSort() {
+	v.dict = nil
 	switch v.GetType().Oid {
 	case types.T_bool:
 		col := MustFixedCol[bool](v)
@@ -0,0 +1,677 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	gotrace "runtime/trace"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+// AzureBlobSDK is an ObjectStorage backed by Azure Blob Storage block blobs
+// Bucket is the container name
+type AzureBlobSDK struct {
+	name            string
+	endpoint        *url.URL
+	container       string
+	account         string
+	accountKey      []byte
+	sasToken        url.Values
+	client          *http.Client
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int
+	blockSize       int64
+}
+
+const (
+	azureAPIVersion = "2021-08-06"
+	// objects larger than this are uploaded by blocks
+	azureMaxPutBlobSize = 256 << 20
+	azureBlockSize      = 64 << 20
+)
+
+func NewAzureBlobSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *AzureBlobSDK, err error) {
+
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+
+	account, accountKey, sasToken, err := args.credentialsForAzure()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := args.Endpoint
+	if endpoint == "" {
+		if account == "" {
+			return nil, moerr.NewInvalidInputNoCtx("no endpoint or account name for azure blob storage")
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", account)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL.Path = strings.TrimRight(endpointURL.Path, "/")
+
+	sdk := &AzureBlobSDK{
+		name:            args.Name,
+		endpoint:        endpointURL,
+		container:       args.Bucket,
+		account:         account,
+		client:          newHTTPClient(args),
+		perfCounterSets: perfCounterSets,
+		blockSize:       azureBlockSize,
+	}
+	if accountKey != "" {
+		sdk.accountKey, err = base64.StdEncoding.DecodeString(accountKey)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("bad azure account key: %v", err)
+		}
+	}
+	if sasToken != "" {
+		sdk.sasToken, err = url.ParseQuery(strings.TrimPrefix(sasToken, "?"))
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("bad azure sas token: %v", err)
+		}
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "azure"),
+		zap.Any("arguments", args),
+	)
+
+	if !args.NoBucketValidation {
+		// validate container
+		_, err := doWithRetry(
+			"azure get container properties",
+			func() (bool, error) {
+				resp, err := sdk.do(ctx, http.MethodHead, "", url.Values{"restype": {"container"}}, nil, -1, nil)
+				if err != nil {
+					return false, err
+				}
+				resp.Body.Close()
+				return true, nil
+			},
+			maxRetryAttemps,
+			isRetryableHTTPError,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk, nil
+}
+
+var _ ObjectStorage = new(AzureBlobSDK)
+
+func (a *AzureBlobSDK) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var marker string
+	for {
+		result, err := a.listBlobs(ctx, prefix, marker)
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range result.Blobs.Blob {
+			more, err := fn(false, blob.Name, blob.Properties.ContentLength)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		for _, prefix := range result.Blobs.BlobPrefix {
+			more, err := fn(true, prefix.Name, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	return nil
+}
+
+func (a *AzureBlobSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	header, err := a.getBlobProperties(ctx, key)
+	if err != nil {
+		return
+	}
+	return strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+}
+
+func (a *AzureBlobSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := a.getBlobProperties(ctx, key)
+	if err != nil {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *AzureBlobSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	// blob storage has no per-object expiration, lifecycle policies should be used instead
+	_ = expire
+
+	if size >= 0 && size <= azureMaxPutBlobSize {
+		return a.putBlob(ctx, key, r, size)
+	}
+	return a.putBlocks(ctx, key, r)
+}
+
+func (a *AzureBlobSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	r, err = a.getBlob(ctx, key, min, max)
+	if err != nil {
+		return nil, err
+	}
+	if max == nil {
+		return r, nil
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, *max-*min),
+		closeFunc: r.Close,
+	}, nil
+}
+
+func (a *AzureBlobSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.deleteBlob(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type azureListBlobsResult struct {
+	NextMarker string `xml:"NextMarker"`
+	Blobs      struct {
+		Blob       []azureBlobItem   `xml:"Blob"`
+		BlobPrefix []azureBlobPrefix `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+}
+
+type azureBlobItem struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		ContentLength int64 `xml:"Content-Length"`
+	} `xml:"Properties"`
+}
+
+type azureBlobPrefix struct {
+	Name string `xml:"Name"`
+}
+
+func (a *AzureBlobSDK) listBlobs(ctx context.Context, prefix string, marker string) (*azureListBlobsResult, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.listBlobs")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.List.Add(1)
+	}, a.perfCounterSets...)
+	query := url.Values{
+		"restype":   {"container"},
+		"comp":      {"list"},
+		"delimiter": {"/"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if a.listMaxKeys > 0 {
+		query.Set("maxresults", strconv.Itoa(a.listMaxKeys))
+	}
+	return doWithRetry(
+		"azure list blobs",
+		func() (*azureListBlobsResult, error) {
+			resp, err := a.do(ctx, http.MethodGet, "", query, nil, -1, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			result := new(azureListBlobsResult)
+			if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+}
+
+func (a *AzureBlobSDK) getBlobProperties(ctx context.Context, key string) (http.Header, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.getBlobProperties")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Head.Add(1)
+	}, a.perfCounterSets...)
+	return doWithRetry(
+		"azure get blob properties",
+		func() (http.Header, error) {
+			resp, err := a.do(ctx, http.MethodHead, key, nil, nil, -1, nil)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body.Close()
+			return resp.Header, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+}
+
+func (a *AzureBlobSDK) putBlob(ctx context.Context, key string, r io.Reader, size int64) error {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.putBlob")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+	// not retryable because Reader may be half consumed
+	resp, err := a.do(ctx, http.MethodPut, key, nil, http.Header{
+		"X-Ms-Blob-Type": {"BlockBlob"},
+	}, size, r)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// putBlocks uploads blocks of r and commits the block list
+func (a *AzureBlobSDK) putBlocks(ctx context.Context, key string, r io.Reader) error {
+	ctx, span := trace.Start(ctx, "AzureBlobSDK.putBlocks")
+	defer span.End()
+
+	var blockIDs []string
+	buf := make([]byte, a.blockSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF && len(blockIDs) > 0 {
+			break
+		} else if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		id := make([]byte, 8)
+		binary.BigEndian.PutUint64(id, uint64(len(blockIDs)))
+		blockID := base64.StdEncoding.EncodeToString(id)
+		perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+			counter.FileService.S3.Put.Add(1)
+		}, a.perfCounterSets...)
+		_, err = doWithRetry(
+			"azure put block",
+			func() (bool, error) {
+				resp, err := a.do(ctx, http.MethodPut, key, url.Values{
+					"comp":    {"block"},
+					"blockid": {blockID},
+				}, nil, int64(n), bytes.NewReader(buf[:n]))
+				if err != nil {
+					return false, err
+				}
+				resp.Body.Close()
+				return true, nil
+			},
+			maxRetryAttemps,
+			isRetryableHTTPError,
+		)
+		if err != nil {
+			return err
+		}
+		blockIDs = append(blockIDs, blockID)
+
+		if n < len(buf) {
+			break
+		}
+	}
+
+	list := new(bytes.Buffer)
+	list.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, id := range blockIDs {
+		list.WriteString("<Latest>" + id + "</Latest>")
+	}
+	list.WriteString("</BlockList>")
+	body := list.Bytes()
+	_, err := doWithRetry(
+		"azure put block list",
+		func() (bool, error) {
+			resp, err := a.do(ctx, http.MethodPut, key, url.Values{
+				"comp": {"blocklist"},
+			}, nil, int64(len(body)), bytes.NewReader(body))
+			if err != nil {
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+	return err
+}
+
+func (a *AzureBlobSDK) getBlob(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.getBlob")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Get.Add(1)
+	}, a.perfCounterSets...)
+	var offset int64
+	if min != nil {
+		offset = *min
+	}
+	r, err := newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			return doWithRetry(
+				"azure get blob",
+				func() (io.ReadCloser, error) {
+					resp, err := a.do(ctx, http.MethodGet, key, nil, http.Header{
+						"X-Ms-Range": {httpRange(offset, max)},
+					}, -1, nil)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Body, nil
+				},
+				maxRetryAttemps,
+				isRetryableHTTPError,
+			)
+		},
+		offset,
+		isRetryableError,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (a *AzureBlobSDK) deleteBlob(ctx context.Context, key string) error {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.deleteBlob")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Delete.Add(1)
+	}, a.perfCounterSets...)
+	_, err := doWithRetry(
+		"azure delete blob",
+		func() (bool, error) {
+			resp, err := a.do(ctx, http.MethodDelete, key, nil, nil, -1, nil)
+			if err != nil {
+				if isHTTPStatus(err, http.StatusNotFound) {
+					return true, nil
+				}
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+	return err
+}
+
+// do sends a request to the container, or the blob if key is not empty
+// responses with non-2xx status are returned as *httpStatusError
+func (a *AzureBlobSDK) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	header http.Header,
+	size int64,
+	body io.Reader,
+) (*http.Response, error) {
+
+	u := *a.endpoint
+	u.Path = u.Path + "/" + a.container
+	if key != "" {
+		u.Path += "/" + key
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	if a.accountKey == nil {
+		for k, v := range a.sasToken {
+			query[k] = v
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("X-Ms-Version", azureAPIVersion)
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	if body != nil {
+		req.ContentLength = size
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	if a.accountKey != nil {
+		req.Header.Set("Authorization", "SharedKey "+a.account+":"+a.sign(req))
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHTTPResponse(resp, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusPartialContent); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sign computes the shared key signature of req
+func (a *AzureBlobSDK) sign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	buf := new(strings.Builder)
+	buf.WriteString(req.Method + "\n")
+	for _, value := range []string{
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	} {
+		buf.WriteString(value + "\n")
+	}
+
+	// canonicalized headers
+	var names []string
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		buf.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+
+	// canonicalized resource
+	buf.WriteString("/" + a.account + req.URL.EscapedPath())
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, strings.ToLower(k))
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		buf.WriteString("\n" + k + ":" + strings.Join(values, ","))
+	}
+
+	mac := hmac.New(sha256.New, a.accountKey)
+	mac.Write([]byte(buf.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// credentialsForAzure returns account name and one of shared key or sas token
+func (o ObjectStorageArguments) credentialsForAzure() (account string, key string, sasToken string, err error) {
+	account = o.AccountName
+	if account == "" {
+		account = o.KeyID
+	}
+	key = o.AccountKey
+	if key == "" {
+		key = o.KeySecret
+	}
+	sasToken = o.SASToken
+
+	if key == "" && sasToken == "" {
+		if !o.shouldLoadDefaultCredentials() {
+			// anonymous access for public containers
+			return
+		}
+		if account == "" {
+			account = os.Getenv("AZURE_STORAGE_ACCOUNT")
+		}
+		key = os.Getenv("AZURE_STORAGE_KEY")
+		sasToken = os.Getenv("AZURE_STORAGE_SAS_TOKEN")
+		if key == "" && sasToken == "" {
+			return "", "", "", moerr.NewInvalidInputNoCtx("no valid credentials")
+		}
+		logutil.Info("using azure env credentials")
+	}
+	if key != "" && account == "" {
+		return "", "", "", moerr.NewInvalidInputNoCtx("no account name for azure shared key")
+	}
+	return
+}
@@ -0,0 +1,416 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// well-known account of azurite
+	azuriteAccount = "devstoreaccount1"
+	azuriteKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// newFakeAzureBlobServer serves a subset of blob service api with path-style urls like azurite
+func newFakeAzureBlobServer(t *testing.T, account string, key string) *httptest.Server {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	require.NoError(t, err)
+	signer := &AzureBlobSDK{
+		account:    account,
+		accountKey: decodedKey,
+	}
+
+	objects := newFakeObjects()
+	var mu sync.Mutex
+	containers := make(map[string]bool)
+	blocks := make(map[string][]byte)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "SharedKey "+account+":"+signer.sign(r) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		path, ok := strings.CutPrefix(r.URL.Path, "/"+account+"/")
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		container, blob, _ := strings.Cut(path, "/")
+		query := r.URL.Query()
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if blob == "" && query.Get("restype") == "container" {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case r.Method == http.MethodPut:
+				containers[container] = true
+				w.WriteHeader(http.StatusCreated)
+			case !containers[container]:
+				w.WriteHeader(http.StatusNotFound)
+			case query.Get("comp") == "list":
+				limit, _ := strconv.Atoi(query.Get("maxresults"))
+				entries, next := objects.list(
+					container+"/"+query.Get("prefix"),
+					query.Get("delimiter"),
+					container+"/"+query.Get("marker"),
+					limit,
+				)
+				var result azureListBlobsResult
+				for _, entry := range entries {
+					name := strings.TrimPrefix(entry.name, container+"/")
+					if entry.isPrefix {
+						result.Blobs.BlobPrefix = append(result.Blobs.BlobPrefix, azureBlobPrefix{Name: name})
+						continue
+					}
+					item := azureBlobItem{Name: name}
+					item.Properties.ContentLength = int64(entry.size)
+					result.Blobs.Blob = append(result.Blobs.Blob, item)
+				}
+				result.NextMarker = strings.TrimPrefix(next, container+"/")
+				w.Header().Set("Content-Type", "application/xml")
+				_ = xml.NewEncoder(w).Encode(struct {
+					XMLName xml.Name `xml:"EnumerationResults"`
+					*azureListBlobsResult
+				}{azureListBlobsResult: &result})
+			default:
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+
+		mu.Lock()
+		exists := containers[container]
+		mu.Unlock()
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		key := container + "/" + blob
+
+		switch r.Method {
+
+		case http.MethodPut:
+			switch query.Get("comp") {
+			case "block":
+				mu.Lock()
+				blocks[key+"#"+query.Get("blockid")] = body
+				mu.Unlock()
+			case "blocklist":
+				var list struct {
+					Latest []string `xml:"Latest"`
+				}
+				if err := xml.Unmarshal(body, &list); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				var data []byte
+				mu.Lock()
+				for _, id := range list.Latest {
+					block, ok := blocks[key+"#"+id]
+					if !ok {
+						mu.Unlock()
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					data = append(data, block...)
+				}
+				mu.Unlock()
+				objects.put(key, data)
+			default:
+				if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				objects.put(key, body)
+			}
+			w.WriteHeader(http.StatusCreated)
+
+		case http.MethodHead:
+			data, ok := objects.get(key)
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusOK)
+
+		case http.MethodGet:
+			data, ok := objects.get(key)
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeRange(w, r.Header.Get("X-Ms-Range"), data)
+
+		case http.MethodDelete:
+			if !objects.delete(key) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func createAzureContainer(t *testing.T, endpoint string, container string) {
+	sdk, err := NewAzureBlobSDK(context.Background(), ObjectStorageArguments{
+		Endpoint:           endpoint,
+		Bucket:             container,
+		AccountName:        azuriteAccount,
+		AccountKey:         azuriteKey,
+		NoBucketValidation: true,
+	}, nil)
+	require.NoError(t, err)
+	resp, err := sdk.do(context.Background(), http.MethodPut, "", map[string][]string{
+		"restype": {"container"},
+	}, nil, 0, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func testAzureBlobFileService(t *testing.T, endpoint string) {
+	cacheDir := t.TempDir()
+	testFileService(t, 0, func(name string) FileService {
+		fs, err := NewS3FS(
+			context.Background(),
+			ObjectStorageArguments{
+				Name:        name,
+				Provider:    ObjectStorageProviderAzure,
+				Endpoint:    endpoint,
+				Bucket:      "test",
+				KeyPrefix:   time.Now().Format("2006-01-02.15:04:05.000000"),
+				AccountName: azuriteAccount,
+				AccountKey:  azuriteKey,
+			},
+			CacheConfig{
+				DiskPath: ptrTo(cacheDir),
+			},
+			nil,
+			true,
+			true,
+		)
+		assert.Nil(t, err)
+		return fs
+	})
+}
+
+func TestAzureBlobSDK(t *testing.T) {
+	server := newFakeAzureBlobServer(t, azuriteAccount, azuriteKey)
+	defer server.Close()
+	endpoint := server.URL + "/" + azuriteAccount
+	createAzureContainer(t, endpoint, "test")
+
+	t.Run("file service", func(t *testing.T) {
+		testAzureBlobFileService(t, endpoint)
+	})
+
+	ctx := context.Background()
+	sdk, err := NewAzureBlobSDK(ctx, ObjectStorageArguments{
+		Endpoint:    endpoint,
+		Bucket:      "test",
+		AccountName: azuriteAccount,
+		AccountKey:  azuriteKey,
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("blocks", func(t *testing.T) {
+		sdk.blockSize = 7
+		defer func() {
+			sdk.blockSize = azureBlockSize
+		}()
+		for _, size := range []int{0, 3, 7, 30} {
+			content := bytes.Repeat([]byte("x"), size)
+			key := "blocks/" + strconv.Itoa(size)
+			// unknown size is uploaded by blocks
+			require.NoError(t, sdk.Write(ctx, key, bytes.NewReader(content), -1, nil))
+			n, err := sdk.Stat(ctx, key)
+			require.NoError(t, err)
+			require.Equal(t, int64(size), n)
+			r, err := sdk.Read(ctx, key, ptrTo[int64](0), nil)
+			require.NoError(t, err)
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			require.Equal(t, content, data)
+		}
+	})
+
+	t.Run("list pages", func(t *testing.T) {
+		sdk.listMaxKeys = 2
+		defer func() {
+			sdk.listMaxKeys = 0
+		}()
+		for _, key := range []string{"list/a", "list/b", "list/c/d", "list/e"} {
+			require.NoError(t, sdk.Write(ctx, key, strings.NewReader("foo"), 3, nil))
+		}
+		var files, dirs []string
+		require.NoError(t, sdk.List(ctx, "list/", func(isPrefix bool, key string, size int64) (bool, error) {
+			if isPrefix {
+				dirs = append(dirs, key)
+			} else {
+				assert.Equal(t, int64(3), size)
+				files = append(files, key)
+			}
+			return true, nil
+		}))
+		assert.Equal(t, []string{"list/a", "list/b", "list/e"}, files)
+		assert.Equal(t, []string{"list/c/"}, dirs)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := sdk.Stat(ctx, "not-exists")
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+		_, err = sdk.Read(ctx, "not-exists", ptrTo[int64](0), nil)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+		ok, err := sdk.Exists(ctx, "not-exists")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, sdk.Delete(ctx, "not-exists"))
+	})
+
+	t.Run("bad key", func(t *testing.T) {
+		_, err := NewAzureBlobSDK(ctx, ObjectStorageArguments{
+			Endpoint:    endpoint,
+			Bucket:      "test",
+			AccountName: azuriteAccount,
+			AccountKey:  base64.StdEncoding.EncodeToString([]byte("bad")),
+		}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("config", func(t *testing.T) {
+		fs, err := NewFileService(ctx, Config{
+			Name:    "azure",
+			Backend: "AZURE",
+			S3: ObjectStorageArguments{
+				Endpoint:    endpoint,
+				Bucket:      "test",
+				AccountName: azuriteAccount,
+				AccountKey:  azuriteKey,
+			},
+			Cache: DisabledCacheConfig,
+		}, nil)
+		require.NoError(t, err)
+		defer fs.Close()
+		_, ok := fs.(*S3FS).storage.(*AzureBlobSDK)
+		assert.True(t, ok)
+	})
+
+	t.Run("container not exists", func(t *testing.T) {
+		_, err := NewAzureBlobSDK(ctx, ObjectStorageArguments{
+			Endpoint:    endpoint,
+			Bucket:      "not-exists",
+			AccountName: azuriteAccount,
+			AccountKey:  azuriteKey,
+		}, nil)
+		assert.True(t, isHTTPStatus(err, http.StatusNotFound))
+	})
+}
+
+func TestAzureBlobSDKAzurite(t *testing.T) {
+
+	// find azurite executable
+	exePath, err := exec.LookPath("azurite-blob")
+	if errors.Is(err, exec.ErrNotFound) {
+		// azurite not found in machine
+		return
+	}
+
+	// start azurite
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd := exec.CommandContext(ctx,
+		exePath,
+		"--blobHost", "127.0.0.1",
+		"--blobPort", "10000",
+		"--location", t.TempDir(),
+		"--skipApiVersionCheck",
+	)
+	err = cmd.Start()
+	assert.Nil(t, err)
+	waitListening(t, "127.0.0.1:10000")
+
+	endpoint := "http://127.0.0.1:10000/" + azuriteAccount
+	createAzureContainer(t, endpoint, "test")
+
+	t.Run("file service", func(t *testing.T) {
+		testAzureBlobFileService(t, endpoint)
+	})
+}
+
+func TestAzureCredentials(t *testing.T) {
+	args := ObjectStorageArguments{
+		KeyID:     "account",
+		KeySecret: "key",
+	}
+	account, key, sas, err := args.credentialsForAzure()
+	assert.NoError(t, err)
+	assert.Equal(t, "account", account)
+	assert.Equal(t, "key", key)
+	assert.Equal(t, "", sas)
+
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "env-account")
+	t.Setenv("AZURE_STORAGE_KEY", "")
+	t.Setenv("AZURE_STORAGE_SAS_TOKEN", "sv=foo")
+	args = ObjectStorageArguments{}
+	account, key, sas, err = args.credentialsForAzure()
+	assert.NoError(t, err)
+	assert.Equal(t, "env-account", account)
+	assert.Equal(t, "", key)
+	assert.Equal(t, "sv=foo", sas)
+
+	// anonymous
+	args = ObjectStorageArguments{
+		NoDefaultCredentials: true,
+	}
+	_, key, sas, err = args.credentialsForAzure()
+	assert.NoError(t, err)
+	assert.Equal(t, "", key+sas)
+
+	// shared key without account
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "")
+	args = ObjectStorageArguments{
+		AccountKey: "key",
+	}
+	_, _, _, err = args.credentialsForAzure()
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/fileservice/memorycache"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -37,8 +38,14 @@ type CacheConfig struct {
 	DiskCapacity         *toml.ByteSize `toml:"disk-capacity"`
 	DiskMinEvictInterval *toml.Duration `toml:"disk-min-evict-interval"`
 	DiskEvictTarget      *float64       `toml:"disk-evict-target"`
-	RemoteCacheEnabled   bool           `toml:"remote-cache-enabled"`
-	RPC                  morpc.Config   `toml:"rpc"`
+	// MemoryPolicy is the eviction policy of memory cache, lru or s3-fifo
+	MemoryPolicy CachePolicy `toml:"memory-policy"`
+	// DiskPolicy is the eviction policy of disk cache, fifo or s3-fifo
+	DiskPolicy CachePolicy `toml:"disk-policy"`
+	// ScanAdmission decides how data read by large scans are cached, admit, demote or bypass
+	ScanAdmission      ScanAdmission `toml:"scan-admission"`
+	RemoteCacheEnabled bool          `toml:"remote-cache-enabled"`
+	RPC                morpc.Config  `toml:"rpc"`
 
 	QueryClient      client.QueryClient            `json:"-"`
 	KeyRouterFactory KeyRouterFactory[pb.CacheKey] `json:"-"`
@@ -75,9 +82,84 @@ func (c *CacheConfig) setDefaults() {
 		target := 0.8
 		c.DiskEvictTarget = &target
 	}
+	if c.MemoryPolicy == "" {
+		c.MemoryPolicy = CachePolicyLRU
+	}
+	if c.DiskPolicy == "" {
+		c.DiskPolicy = CachePolicyFIFO
+	}
+	if c.ScanAdmission == "" {
+		c.ScanAdmission = ScanAdmissionAdmit
+	}
 	c.RPC.Adjust()
 }
 
+func (c *CacheConfig) validate(ctx context.Context) error {
+	switch c.MemoryPolicy {
+	case CachePolicyLRU, CachePolicyS3FIFO:
+	default:
+		return moerr.NewInvalidInput(ctx, "invalid memory cache policy: %s", c.MemoryPolicy)
+	}
+	switch c.DiskPolicy {
+	case CachePolicyFIFO, CachePolicyS3FIFO:
+	default:
+		return moerr.NewInvalidInput(ctx, "invalid disk cache policy: %s", c.DiskPolicy)
+	}
+	switch c.ScanAdmission {
+	case ScanAdmissionAdmit, ScanAdmissionDemote, ScanAdmissionBypass:
+	default:
+		return moerr.NewInvalidInput(ctx, "invalid cache scan admission: %s", c.ScanAdmission)
+	}
+	return nil
+}
+
+// CachePolicy is the eviction policy of a cache
+type CachePolicy string
+
+const (
+	// CachePolicyLRU evicts the least recently set data, for memory cache only
+	CachePolicyLRU CachePolicy = "lru"
+	// CachePolicyFIFO evicts by FIFO queues with reinsertion of read data, for disk cache only
+	CachePolicyFIFO CachePolicy = "fifo"
+	// CachePolicyS3FIFO evicts by S3-FIFO.
+	// data evicted without being read again are remembered, and kept longer if set again,
+	// so a large scan of data read only once does not flush the frequently read ones.
+	CachePolicyS3FIFO CachePolicy = "s3-fifo"
+)
+
+func (c CachePolicy) memoryCachePolicy() memorycache.Policy {
+	if c == CachePolicyS3FIFO {
+		return memorycache.PolicyS3FIFO
+	}
+	return memorycache.PolicyLRU
+}
+
+// ScanAdmission decides how data read with the LargeScan policy are cached
+type ScanAdmission string
+
+const (
+	// ScanAdmissionAdmit caches them like other data
+	ScanAdmissionAdmit ScanAdmission = "admit"
+	// ScanAdmissionDemote caches them as data to be evicted first.
+	// for the fifo disk cache policy, it's the same as admit
+	ScanAdmissionDemote ScanAdmission = "demote"
+	// ScanAdmissionBypass does not cache them
+	ScanAdmissionBypass ScanAdmission = "bypass"
+)
+
+// admit reports whether the data read with policy should be cached, and whether to demote them
+func (s ScanAdmission) admit(policy Policy) (admit bool, demote bool) {
+	if !policy.Any(LargeScan) {
+		return true, false
+	}
+	switch s {
+	case ScanAdmissionBypass:
+		return false, false
+	case ScanAdmissionDemote:
+		return true, true
+	}
+	return true, false
+}
 func (c *CacheConfig) SetRemoteCacheCallback() {
 	if !c.RemoteCacheEnabled || c.KeyRouterFactory == nil {
 		return
@@ -0,0 +1,54 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheConfigValidate(t *testing.T) {
+	ctx := context.Background()
+	var config CacheConfig
+	config.setDefaults()
+	assert.Equal(t, CachePolicyLRU, config.MemoryPolicy)
+	assert.Equal(t, CachePolicyFIFO, config.DiskPolicy)
+	assert.Equal(t, ScanAdmissionAdmit, config.ScanAdmission)
+	assert.Nil(t, config.validate(ctx))
+
+	config.MemoryPolicy = CachePolicyS3FIFO
+	config.DiskPolicy = CachePolicyS3FIFO
+	config.ScanAdmission = ScanAdmissionDemote
+	assert.Nil(t, config.validate(ctx))
+
+	for _, config := range []CacheConfig{
+		{MemoryPolicy: CachePolicyFIFO},
+		{DiskPolicy: CachePolicyLRU},
+		{ScanAdmission: "foo"},
+	} {
+		config.setDefaults()
+		assert.True(t, moerr.IsMoErrCode(config.validate(ctx), moerr.ErrInvalidInput))
+	}
+
+	_, err := NewLocalFS(ctx, "test", t.TempDir(), CacheConfig{
+		MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+		MemoryPolicy:   "foo",
+	}, nil)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
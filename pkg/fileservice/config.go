@@ -18,9 +18,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
 )
 
 const (
@@ -29,13 +31,15 @@ const (
 	diskETLFileServiceBackend = "DISK-ETL"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	azureFileServiceBackend   = "AZURE"
+	gcsFileServiceBackend     = "GCS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO|AZURE|GCS]
 	Backend string `toml:"backend"`
 	// S3 used to create fileservice using s3 as the backend
 	S3 ObjectStorageArguments `toml:"s3"`
@@ -45,6 +49,30 @@ type Config struct {
 	DataDir string `toml:"data-dir"`
 	// FixMissing inidicates the file service to try its best to fix missing files
 	FixMissing bool `toml:"fix-missing"`
+	// Encryption specifies configs for encryption at rest
+	Encryption EncryptionConfig `toml:"encryption"`
+	// Tiering specifies configs for moving cold files to another file service
+	Tiering TieringConfig `toml:"tiering"`
+}
+
+// EncryptionConfig encryption at rest config
+type EncryptionConfig struct {
+	// KeyFile is the local file holding master keys, see LocalFileKMS.
+	// encryption is disabled if empty
+	KeyFile string `toml:"key-file"`
+}
+
+// TieringConfig tiered storage config
+type TieringConfig struct {
+	// Cold is the config of the cold tier, its name is always the same as the hot tier.
+	// tiering is disabled if nil
+	Cold *Config `toml:"cold"`
+	// ColdAfter moves files not accessed for this duration to the cold tier
+	ColdAfter toml.Duration `toml:"cold-after"`
+	// ColdBefore moves files created before this time to the cold tier
+	ColdBefore time.Time `toml:"cold-before"`
+	// Interval is the interval of checking files to move
+	Interval toml.Duration `toml:"interval"`
 }
 
 // NewFileServicesFunc creates a new *FileServices
@@ -57,6 +85,12 @@ func NewFileService(
 	if cfg.Name == "" {
 		panic("empty name")
 	}
+	if cfg.Encryption.KeyFile != "" {
+		return newEncryptedFileService(ctx, cfg, perfCounterSets)
+	}
+	if cfg.Tiering.Cold != nil {
+		return newTieredFileService(ctx, cfg, perfCounterSets)
+	}
 	switch strings.ToUpper(cfg.Backend) {
 	case memFileServiceBackend:
 		return newMemFileService(cfg, perfCounterSets)
@@ -68,11 +102,67 @@ func NewFileService(
 		return newMinioFileService(ctx, cfg, perfCounterSets)
 	case s3FileServiceBackend:
 		return newS3FileService(ctx, cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		cfg.S3.Provider = ObjectStorageProviderAzure
+		return newS3FileService(ctx, cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		cfg.S3.Provider = ObjectStorageProviderGCS
+		return newS3FileService(ctx, cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtx("file service backend %s not implemented", cfg.Backend)
 	}
 }
 
+// newEncryptedFileService creates the backend with memory cache disabled and wraps it with an EncryptedFS
+func newEncryptedFileService(
+	ctx context.Context, cfg Config, perfCounterSets []*perfcounter.CounterSet,
+) (FileService, error) {
+	if strings.EqualFold(cfg.Backend, diskETLFileServiceBackend) {
+		return nil, moerr.NewNotSupportedNoCtx("encryption for %s file service", cfg.Backend)
+	}
+	kms, err := NewLocalFileKMS(cfg.Encryption.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	upstreamCfg := cfg
+	upstreamCfg.Encryption = EncryptionConfig{}
+	upstreamCfg.Cache.MemoryCapacity = ptrTo[toml.ByteSize](DisableCacheCapacity)
+	upstream, err := NewFileService(ctx, upstreamCfg, perfCounterSets)
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptedFS(upstream, kms, cfg.Cache, perfCounterSets), nil
+}
+
+// newTieredFileService creates the hot and cold tiers and wraps them with a TieredFS
+func newTieredFileService(
+	ctx context.Context, cfg Config, perfCounterSets []*perfcounter.CounterSet,
+) (FileService, error) {
+	if strings.EqualFold(cfg.Backend, diskETLFileServiceBackend) {
+		return nil, moerr.NewNotSupportedNoCtx("tiering for %s file service", cfg.Backend)
+	}
+	hotCfg := cfg
+	hotCfg.Tiering = TieringConfig{}
+	hot, err := NewFileService(ctx, hotCfg, perfCounterSets)
+	if err != nil {
+		return nil, err
+	}
+	coldCfg := *cfg.Tiering.Cold
+	coldCfg.Name = cfg.Name
+	coldCfg.Encryption = EncryptionConfig{}
+	coldCfg.Tiering = TieringConfig{}
+	cold, err := NewFileService(ctx, coldCfg, perfCounterSets)
+	if err != nil {
+		hot.Close()
+		return nil, err
+	}
+	return NewTieredFS(hot, cold, TieringPolicy{
+		ColdAfter:  cfg.Tiering.ColdAfter.Duration,
+		ColdBefore: cfg.Tiering.ColdBefore,
+		Interval:   cfg.Tiering.Interval.Duration,
+	})
+}
+
 func newMemFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
 	fs, err := NewMemoryFS(
 		cfg.Name,
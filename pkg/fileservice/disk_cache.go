@@ -36,6 +36,8 @@ import (
 
 type DiskCache struct {
 	path            string
+	policy          CachePolicy
+	scanAdmission   ScanAdmission
 	perfCounterSets []*perfcounter.CounterSet
 
 	updatingPaths struct {
@@ -50,6 +52,8 @@ func NewDiskCache(
 	ctx context.Context,
 	path string,
 	capacity int,
+	policy CachePolicy,
+	scanAdmission ScanAdmission,
 	perfCounterSets []*perfcounter.CounterSet,
 ) (ret *DiskCache, err error) {
 
@@ -60,22 +64,30 @@ func NewDiskCache(
 
 	ret = &DiskCache{
 		path:            path,
+		policy:          policy,
+		scanAdmission:   scanAdmission,
 		perfCounterSets: perfCounterSets,
-
-		cache: fifocache.New(
-			capacity,
-			func(path string, _ struct{}) {
-				err := os.Remove(path)
-				if err == nil {
-					perfcounter.Update(ctx, func(set *perfcounter.CounterSet) {
-						set.FileService.Cache.Disk.Evict.Add(1)
-					}, perfCounterSets...)
+	}
+	onEvict := func(path string, _ struct{}) {
+		err := os.Remove(path)
+		if err == nil {
+			perfcounter.Update(ctx, func(set *perfcounter.CounterSet) {
+				set.FileService.Cache.Disk.Evict.Add(1)
+				if policy == CachePolicyS3FIFO {
+					set.FileService.Cache.S3FIFO.Evict.Add(1)
+				} else {
+					set.FileService.Cache.FIFO.Evict.Add(1)
 				}
-			},
-			func(key string) uint8 {
-				return uint8(xxhash.Sum64String(key))
-			},
-		),
+			}, perfCounterSets...)
+		}
+	}
+	keyShardFunc := func(key string) uint8 {
+		return uint8(xxhash.Sum64String(key))
+	}
+	if policy == CachePolicyS3FIFO {
+		ret.cache = fifocache.NewS3FIFO(capacity, onEvict, nil, keyShardFunc)
+	} else {
+		ret.cache = fifocache.New(capacity, onEvict, keyShardFunc)
 	}
 	ret.updatingPaths.Cond = sync.NewCond(new(sync.Mutex))
 	ret.updatingPaths.m = make(map[string]bool)
@@ -134,6 +146,13 @@ func (d *DiskCache) Read(
 			c.FileService.Cache.Hit.Add(numHit)
 			c.FileService.Cache.Disk.Read.Add(numRead)
 			c.FileService.Cache.Disk.Hit.Add(numHit)
+			if d.policy == CachePolicyS3FIFO {
+				c.FileService.Cache.S3FIFO.Read.Add(numRead)
+				c.FileService.Cache.S3FIFO.Hit.Add(numHit)
+			} else {
+				c.FileService.Cache.FIFO.Read.Add(numRead)
+				c.FileService.Cache.FIFO.Hit.Add(numHit)
+			}
 			c.FileService.Cache.Disk.Error.Add(numError)
 			c.FileService.Cache.Disk.OpenIOEntryFile.Add(numOpenIOEntry)
 			c.FileService.Cache.Disk.OpenFullFile.Add(numOpenFull)
@@ -267,6 +286,17 @@ func (d *DiskCache) Update(
 		return err
 	}
 
+	admit, demote := d.scanAdmission.admit(vector.Policy)
+	var numDemote, numBypass int64
+	defer func() {
+		if numDemote > 0 || numBypass > 0 {
+			perfcounter.Update(ctx, func(c *perfcounter.CounterSet) {
+				c.FileService.Cache.ScanAdmission.Demote.Add(numDemote)
+				c.FileService.Cache.ScanAdmission.Bypass.Add(numBypass)
+			}, d.perfCounterSets...)
+		}
+	}()
+
 	// callback
 	var onWritten []OnDiskCacheWrittenFunc
 	if v := ctx.Value(CtxKeyDiskCacheCallbacks); v != nil {
@@ -286,9 +316,16 @@ func (d *DiskCache) Update(
 			// no need to update
 			continue
 		}
+		if !admit {
+			numBypass++
+			continue
+		}
+		if demote {
+			numDemote++
+		}
 
 		diskPath := d.pathForIOEntry(path.File, entry)
-		written, err := d.writeFile(ctx, diskPath, func(context.Context) (io.ReadCloser, error) {
+		written, err := d.writeFile(ctx, diskPath, demote, func(context.Context) (io.ReadCloser, error) {
 			return io.NopCloser(bytes.NewReader(entry.Data)), nil
 		})
 		if err != nil {
@@ -308,6 +345,7 @@ func (d *DiskCache) Update(
 func (d *DiskCache) writeFile(
 	ctx context.Context,
 	diskPath string,
+	demote bool,
 	openReader func(context.Context) (io.ReadCloser, error),
 ) (bool, error) {
 	var numCreate, numStat, numError, numWrite int64
@@ -330,7 +368,7 @@ func (d *DiskCache) writeFile(
 	stat, err := os.Stat(diskPath)
 	if err == nil {
 		// file exists
-		d.cache.Set(diskPath, struct{}{}, int(fileSize(stat)))
+		d.setCache(diskPath, int(fileSize(stat)), demote)
 		numStat++
 		return false, nil
 	}
@@ -382,7 +420,7 @@ func (d *DiskCache) writeFile(
 		logutil.Warn("write disk cache error", zap.Any("error", err))
 		return false, nil // ignore error
 	}
-	d.cache.Set(diskPath, struct{}{}, int(fileSize(stat)))
+	d.setCache(diskPath, int(fileSize(stat)), demote)
 
 	if err := f.Close(); err != nil {
 		numError++
@@ -403,6 +441,14 @@ func (d *DiskCache) writeFile(
 	return true, nil
 }
 
+func (d *DiskCache) setCache(diskPath string, size int, demote bool) {
+	if demote {
+		d.cache.SetDemoted(diskPath, struct{}{}, size)
+	} else {
+		d.cache.Set(diskPath, struct{}{}, size)
+	}
+}
+
 func (d *DiskCache) Flush() {
 }
 
@@ -471,8 +517,30 @@ func (d *DiskCache) SetFile(
 	path string,
 	openReader func(context.Context) (io.ReadCloser, error),
 ) error {
+	return d.setFile(ctx, path, 0, openReader)
+}
+
+// setFile caches the full file read with policy, by the scan admission
+func (d *DiskCache) setFile(
+	ctx context.Context,
+	path string,
+	policy Policy,
+	openReader func(context.Context) (io.ReadCloser, error),
+) error {
+	admit, demote := d.scanAdmission.admit(policy)
+	if !admit {
+		perfcounter.Update(ctx, func(c *perfcounter.CounterSet) {
+			c.FileService.Cache.ScanAdmission.Bypass.Add(1)
+		}, d.perfCounterSets...)
+		return nil
+	}
+	if demote {
+		perfcounter.Update(ctx, func(c *perfcounter.CounterSet) {
+			c.FileService.Cache.ScanAdmission.Demote.Add(1)
+		}, d.perfCounterSets...)
+	}
 	diskPath := d.pathForFile(path)
-	_, err := d.writeFile(ctx, diskPath, openReader)
+	_, err := d.writeFile(ctx, diskPath, demote, openReader)
 	if err != nil {
 		return err
 	}
@@ -41,7 +41,7 @@ func TestDiskCache(t *testing.T) {
 	})
 
 	// new
-	cache, err := NewDiskCache(ctx, dir, 1<<20, nil)
+	cache, err := NewDiskCache(ctx, dir, 1<<20, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 
 	// update
@@ -124,14 +124,14 @@ func TestDiskCache(t *testing.T) {
 	testRead(cache)
 
 	// new cache instance and read
-	cache, err = NewDiskCache(ctx, dir, 1<<20, nil)
+	cache, err = NewDiskCache(ctx, dir, 1<<20, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 	testRead(cache)
 
 	assert.Equal(t, 1, numWritten)
 
 	// new cache instance and update
-	cache, err = NewDiskCache(ctx, dir, 1<<20, nil)
+	cache, err = NewDiskCache(ctx, dir, 1<<20, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 	testUpdate(cache)
 
@@ -149,7 +149,7 @@ func TestDiskCacheWriteAgain(t *testing.T) {
 	var counterSet perfcounter.CounterSet
 	ctx = perfcounter.WithCounterSet(ctx, &counterSet)
 
-	cache, err := NewDiskCache(ctx, dir, 4096, nil)
+	cache, err := NewDiskCache(ctx, dir, 4096, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 
 	// update
@@ -211,7 +211,7 @@ func TestDiskCacheWriteAgain(t *testing.T) {
 func TestDiskCacheFileCache(t *testing.T) {
 	dir := t.TempDir()
 	ctx := context.Background()
-	cache, err := NewDiskCache(ctx, dir, 1<<20, nil)
+	cache, err := NewDiskCache(ctx, dir, 1<<20, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 
 	vector := IOVector{
@@ -269,7 +269,7 @@ func TestDiskCacheDirSize(t *testing.T) {
 
 	dir := t.TempDir()
 	capacity := 1 << 20
-	cache, err := NewDiskCache(ctx, dir, capacity, nil)
+	cache, err := NewDiskCache(ctx, dir, capacity, CachePolicyFIFO, ScanAdmissionAdmit, nil)
 	assert.Nil(t, err)
 
 	data := bytes.Repeat([]byte("a"), capacity/128)
@@ -327,6 +327,8 @@ func benchmarkDiskCacheWriteThenRead(
 		ctx,
 		dir,
 		10<<30,
+		CachePolicyFIFO,
+		ScanAdmissionAdmit,
 		nil,
 	)
 	if err != nil {
@@ -418,6 +420,8 @@ func benchmarkDiskCacheReadRandomOffsetAtLargeFile(
 		ctx,
 		dir,
 		8<<30,
+		CachePolicyFIFO,
+		ScanAdmissionAdmit,
 		nil,
 	)
 	if err != nil {
@@ -485,6 +489,8 @@ func BenchmarkDiskCacheMultipleIOEntries(b *testing.B) {
 		ctx,
 		dir,
 		8<<30,
+		CachePolicyFIFO,
+		ScanAdmissionAdmit,
 		nil,
 	)
 	if err != nil {
@@ -526,3 +532,61 @@ func BenchmarkDiskCacheMultipleIOEntries(b *testing.B) {
 		}
 	}
 }
+
+func TestDiskCacheScanAdmission(t *testing.T) {
+	ctx := context.Background()
+	var counterSet perfcounter.CounterSet
+	ctx = perfcounter.WithCounterSet(ctx, &counterSet)
+
+	update := func(cache *DiskCache, path string, policy Policy) {
+		err := cache.Update(ctx, &IOVector{
+			FilePath: path,
+			Entries: []IOEntry{
+				{
+					Size: 3,
+					Data: []byte("foo"),
+				},
+			},
+			Policy: policy,
+		}, false)
+		assert.Nil(t, err)
+	}
+	read := func(cache *DiskCache, path string) bool {
+		vec := &IOVector{
+			FilePath: path,
+			Entries: []IOEntry{
+				{
+					Size: 3,
+				},
+			},
+		}
+		err := cache.Read(ctx, vec)
+		assert.Nil(t, err)
+		return vec.Entries[0].done
+	}
+
+	// bypass
+	cache, err := NewDiskCache(ctx, t.TempDir(), 1<<20, CachePolicyS3FIFO, ScanAdmissionBypass, nil)
+	assert.Nil(t, err)
+	update(cache, "foo", LargeScan)
+	assert.False(t, read(cache, "foo"))
+	assert.Equal(t, int64(1), counterSet.FileService.Cache.ScanAdmission.Bypass.Load())
+	assert.Equal(t, int64(0), counterSet.FileService.Cache.Disk.WriteFile.Load())
+	update(cache, "foo", 0)
+	assert.True(t, read(cache, "foo"))
+	assert.Equal(t, int64(2), counterSet.FileService.Cache.S3FIFO.Read.Load())
+	assert.Equal(t, int64(1), counterSet.FileService.Cache.S3FIFO.Hit.Load())
+
+	// a large scan does not evict the frequently read
+	cache, err = NewDiskCache(ctx, t.TempDir(), 64*4096, CachePolicyS3FIFO, ScanAdmissionDemote, nil)
+	assert.Nil(t, err)
+	update(cache, "hot", 0)
+	assert.True(t, read(cache, "hot"))
+	assert.True(t, read(cache, "hot"))
+	for i := 0; i < 256; i++ {
+		update(cache, fmt.Sprintf("scan-%d", i), LargeScan)
+	}
+	assert.Equal(t, int64(256), counterSet.FileService.Cache.ScanAdmission.Demote.Load())
+	assert.True(t, counterSet.FileService.Cache.S3FIFO.Evict.Load() > 0)
+	assert.True(t, read(cache, "hot"))
+}
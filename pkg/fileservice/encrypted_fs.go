@@ -0,0 +1,656 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"go.uber.org/zap"
+)
+
+// EncryptedFS encrypts file contents transparently before writing to the upstream FileService
+//
+// file layout:
+//
+//	header | block 0 | block 1 | ...
+//
+// the header holds the per-file data key wrapped by a KMS master key.
+// file contents are split into blocks of _EncryptedBlockContentSize bytes, each sealed by AES-GCM
+// with the block index as nonce, so IOEntries at any offset can be served by decrypting the covering blocks.
+// the memory cache holds plaintext and lives in this layer, caches of the upstream only see ciphertext.
+type EncryptedFS struct {
+	name            string
+	upstream        FileService
+	kms             KMS
+	memCache        *MemCache
+	allocator       CacheDataAllocator
+	asyncUpdate     bool
+	perfCounterSets []*perfcounter.CounterSet
+
+	// file path -> decoded header
+	metas *fifocache.Cache[string, *encryptedFileMeta]
+}
+
+const (
+	_EncryptionTagSize          = 16
+	_EncryptionDataKeySize      = 32
+	_EncryptedBlockContentSize  = 4096
+	_EncryptedBlockSize         = _EncryptedBlockContentSize + _EncryptionTagSize
+	_EncryptedFileHeaderSize    = 512
+	_EncryptedFileVersion       = 1
+	_EncryptedFileMetaCacheSize = 65536
+)
+
+var encryptedFileMagic = []byte("MOEF")
+
+type encryptedFileMeta struct {
+	aead cipher.AEAD
+	size int64
+}
+
+var _ FileService = new(EncryptedFS)
+var _ CachingFileService = new(EncryptedFS)
+
+// NewEncryptedFS returns a FileService encrypting contents stored in upstream
+// the memory cache of upstream should be disabled since it would hold ciphertext
+func NewEncryptedFS(
+	upstream FileService,
+	kms KMS,
+	cacheConfig CacheConfig,
+	perfCounterSets []*perfcounter.CounterSet,
+) *EncryptedFS {
+	cacheConfig.setDefaults()
+	fs := &EncryptedFS{
+		name:            upstream.Name(),
+		upstream:        upstream,
+		kms:             kms,
+		perfCounterSets: perfCounterSets,
+		metas:           newEncryptedFileMetaCache(),
+	}
+	if *cacheConfig.MemoryCapacity > DisableCacheCapacity {
+		fs.memCache = NewMemCache(
+			NewMemoryCache(int64(*cacheConfig.MemoryCapacity), cacheConfig.MemoryPolicy, true, &cacheConfig.CacheCallbacks),
+			cacheConfig.ScanAdmission,
+			perfCounterSets,
+		)
+		fs.allocator = fs.memCache
+		logutil.Info("fileservice: memory cache initialized",
+			zap.Any("fs-name", fs.name),
+			zap.Any("capacity", cacheConfig.MemoryCapacity),
+		)
+	} else {
+		fs.allocator = DefaultCacheDataAllocator
+	}
+	return fs
+}
+
+func newEncryptedFileMetaCache() *fifocache.Cache[string, *encryptedFileMeta] {
+	return fifocache.New[string, *encryptedFileMeta](
+		_EncryptedFileMetaCacheSize,
+		nil,
+		func(key string) uint8 {
+			return uint8(xxhash.Sum64String(key))
+		},
+	)
+}
+
+func (e *EncryptedFS) Name() string {
+	return e.name
+}
+
+func (e *EncryptedFS) Close() {
+	e.FlushCache()
+	e.upstream.Close()
+}
+
+func (e *EncryptedFS) FlushCache() {
+	if e.memCache != nil {
+		e.memCache.Flush()
+	}
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.FlushCache()
+	}
+}
+
+func (e *EncryptedFS) SetAsyncUpdate(b bool) {
+	e.asyncUpdate = b
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.SetAsyncUpdate(b)
+	}
+}
+
+func (e *EncryptedFS) Write(ctx context.Context, vector IOVector) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := ParsePathAtService(vector.FilePath, e.name)
+	if err != nil {
+		return err
+	}
+
+	dataKey := make([]byte, _EncryptionDataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return err
+	}
+	keyID, wrapped, err := e.kms.WrapKey(ctx, dataKey)
+	if err != nil {
+		return err
+	}
+	header, err := encodeEncryptedFileHeader(ctx, keyID, wrapped)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]IOEntry, len(vector.Entries))
+	copy(entries, vector.Entries)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Offset < entries[j].Offset
+	})
+	size := int64(0)
+	for _, entry := range entries {
+		if entry.Size < 0 {
+			size = -1
+			break
+		}
+		if end := entry.Offset + entry.Size; end > size {
+			size = end
+		}
+	}
+	physicalSize := int64(-1)
+	if size >= 0 {
+		physicalSize = encryptedFileSize(size)
+	}
+
+	err = e.upstream.Write(ctx, IOVector{
+		FilePath: vector.FilePath,
+		Entries: []IOEntry{
+			{
+				Offset: 0,
+				Size:   physicalSize,
+				ReaderForWrite: &encryptingReader{
+					aead:    aead,
+					src:     bufio.NewReaderSize(newIOEntriesReader(ctx, entries), _EncryptedBlockContentSize),
+					pending: header,
+				},
+			},
+		},
+		ExpireAt: vector.ExpireAt,
+		Policy:   vector.Policy,
+	})
+	if err != nil {
+		return err
+	}
+
+	if size >= 0 {
+		e.metas.Set(path.File, &encryptedFileMeta{
+			aead: aead,
+			size: size,
+		}, 1)
+	}
+	return nil
+}
+
+func (e *EncryptedFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	allocator := e.allocator
+	if vector.Policy.Any(SkipMemoryCache) {
+		allocator = DefaultCacheDataAllocator
+	}
+	for i := range vector.Entries {
+		vector.Entries[i].allocator = allocator
+	}
+
+	for _, cache := range vector.Caches {
+		cache := cache
+		if err := readCache(ctx, cache, vector); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = cache.Update(ctx, vector, false)
+		}()
+	}
+
+	if e.memCache != nil {
+		if err := readCache(ctx, e.memCache, vector); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = e.memCache.Update(ctx, vector, e.asyncUpdate)
+		}()
+	}
+
+	return e.read(ctx, vector)
+}
+
+func (e *EncryptedFS) read(ctx context.Context, vector *IOVector) error {
+	path, err := ParsePathAtService(vector.FilePath, e.name)
+	if err != nil {
+		return err
+	}
+
+	var indexes []int
+	for i, entry := range vector.Entries {
+		if !entry.done {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	meta, err := e.getMeta(ctx, vector.FilePath, path.File, vector.Policy)
+	if err != nil {
+		return err
+	}
+
+	// map entries to the covering blocks
+	physical := IOVector{
+		FilePath: vector.FilePath,
+		Entries:  make([]IOEntry, 0, len(indexes)),
+		Policy:   vector.Policy,
+	}
+	toRead := indexes[:0]
+	for _, i := range indexes {
+		entry := &vector.Entries[i]
+		if entry.Size == 0 {
+			return moerr.NewEmptyRangeNoCtx(path.File)
+		}
+		if entry.Size < 0 {
+			entry.Size = meta.size - entry.Offset
+			if entry.Size == 0 {
+				// empty file
+				if err := fillIOEntry(entry, nil); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if entry.Offset < 0 || entry.Size < 0 || entry.Offset+entry.Size > meta.size {
+			return moerr.NewUnexpectedEOFNoCtx(path.File)
+		}
+		toRead = append(toRead, i)
+		first, last := encryptedBlockRange(entry.Offset, entry.Size)
+		begin := _EncryptedFileHeaderSize + first*_EncryptedBlockSize
+		end := _EncryptedFileHeaderSize + last*_EncryptedBlockSize + _EncryptedBlockSize
+		if fileEnd := encryptedFileSize(meta.size); end > fileEnd {
+			end = fileEnd
+		}
+		physical.Entries = append(physical.Entries, IOEntry{
+			Offset: begin,
+			Size:   end - begin,
+		})
+	}
+	if len(physical.Entries) == 0 {
+		return nil
+	}
+	if err := e.upstream.Read(ctx, &physical); err != nil {
+		return err
+	}
+
+	var buf []byte
+	for n, i := range toRead {
+		entry := &vector.Entries[i]
+		first, _ := encryptedBlockRange(entry.Offset, entry.Size)
+		buf, err = decryptBlocks(ctx, meta, first, physical.Entries[n].Data, buf[:0])
+		if err != nil {
+			return moerr.NewInternalError(ctx, "decrypt %s: %v", path.File, err)
+		}
+		start := entry.Offset - first*_EncryptedBlockContentSize
+		if err := fillIOEntry(entry, buf[start:start+entry.Size]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillIOEntry sets data to the entry. data is copied
+func fillIOEntry(entry *IOEntry, data []byte) error {
+	setData := true
+
+	if w := entry.WriterForRead; w != nil {
+		setData = false
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	if ptr := entry.ReadCloserForRead; ptr != nil {
+		setData = false
+		*ptr = io.NopCloser(bytes.NewReader(bytes.Clone(data)))
+	}
+
+	if setData || entry.ToCacheData != nil {
+		if int64(len(entry.Data)) < entry.Size {
+			entry.Data = bytes.Clone(data)
+		} else {
+			entry.Data = entry.Data[:entry.Size]
+			copy(entry.Data, data)
+		}
+	}
+
+	if err := entry.setCachedData(); err != nil {
+		return err
+	}
+	entry.done = true
+	return nil
+}
+
+func (e *EncryptedFS) getMeta(ctx context.Context, filePath string, file string, policy Policy) (*encryptedFileMeta, error) {
+	if meta, ok := e.metas.Get(file); ok {
+		return meta, nil
+	}
+
+	stat, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	size := encryptedContentSize(stat.Size)
+	if size < 0 {
+		return nil, moerr.NewInternalError(ctx, "%s is not an encrypted file", file)
+	}
+
+	vec := IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Offset: 0,
+				Size:   _EncryptedFileHeaderSize,
+			},
+		},
+		Policy: policy,
+	}
+	if err := e.upstream.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := decodeEncryptedFileHeader(ctx, vec.Entries[0].Data)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "%s: %v", file, err)
+	}
+	dataKey, err := e.kms.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &encryptedFileMeta{
+		aead: aead,
+		size: size,
+	}
+	e.metas.Set(file, meta, 1)
+	return meta, nil
+}
+
+func (e *EncryptedFS) ReadCache(ctx context.Context, vector *IOVector) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	for _, cache := range vector.Caches {
+		cache := cache
+		if err := readCache(ctx, cache, vector); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = cache.Update(ctx, vector, false)
+		}()
+	}
+
+	if e.memCache != nil {
+		if err := readCache(ctx, e.memCache, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *EncryptedFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	entries, err := e.upstream.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if !entries[i].IsDir {
+			entries[i].Size = max(encryptedContentSize(entries[i].Size), 0)
+		}
+	}
+	return entries, nil
+}
+
+func (e *EncryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	if err := e.upstream.Delete(ctx, filePaths...); err != nil {
+		return err
+	}
+	for _, filePath := range filePaths {
+		path, err := ParsePathAtService(filePath, e.name)
+		if err != nil {
+			return err
+		}
+		e.metas.Delete(path.File)
+	}
+	return nil
+}
+
+func (e *EncryptedFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	entry, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.IsDir {
+		entry.Size = max(encryptedContentSize(entry.Size), 0)
+	}
+	return entry, nil
+}
+
+func (e *EncryptedFS) PrefetchFile(ctx context.Context, filePath string) error {
+	return e.upstream.PrefetchFile(ctx, filePath)
+}
+
+// encryptedFileSize returns the physical size of a file with size bytes of contents
+// there is at least one block, so an empty file can not be forged by truncating
+func encryptedFileSize(size int64) int64 {
+	return _EncryptedFileHeaderSize + size + encryptedBlockCount(size)*_EncryptionTagSize
+}
+
+// encryptedContentSize returns the contents size of a file with physical size bytes
+// returns -1 if size is not a valid encrypted file size
+func encryptedContentSize(size int64) int64 {
+	size -= _EncryptedFileHeaderSize
+	if size < _EncryptionTagSize {
+		return -1
+	}
+	ret := size / _EncryptedBlockSize * _EncryptedBlockContentSize
+	if rem := size % _EncryptedBlockSize; rem > 0 {
+		if rem < _EncryptionTagSize {
+			return -1
+		}
+		ret += rem - _EncryptionTagSize
+	}
+	return ret
+}
+
+func encryptedBlockCount(size int64) int64 {
+	if size == 0 {
+		return 1
+	}
+	return (size + _EncryptedBlockContentSize - 1) / _EncryptedBlockContentSize
+}
+
+// encryptedBlockRange returns the first and last block index covering the range
+func encryptedBlockRange(offset, size int64) (first, last int64) {
+	first = offset / _EncryptedBlockContentSize
+	last = (offset + size - 1) / _EncryptedBlockContentSize
+	return
+}
+
+// encryptedBlockNonce binds the block index and whether it is the last block of the file
+// so blocks can not be reordered or truncated without failing authentication
+func encryptedBlockNonce(nonce []byte, index int64, final bool) []byte {
+	clear(nonce)
+	if final {
+		nonce[0] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[4:], uint64(index))
+	return nonce
+}
+
+func decryptBlocks(ctx context.Context, meta *encryptedFileMeta, first int64, data []byte, buf []byte) ([]byte, error) {
+	lastIndex := encryptedBlockCount(meta.size) - 1
+	nonce := make([]byte, meta.aead.NonceSize())
+	for index := first; len(data) > 0; index++ {
+		n := min(len(data), _EncryptedBlockSize)
+		var err error
+		buf, err = meta.aead.Open(buf, encryptedBlockNonce(nonce, index, index == lastIndex), data[:n], nil)
+		if err != nil {
+			return nil, err
+		}
+		data = data[n:]
+	}
+	return buf, nil
+}
+
+func encodeEncryptedFileHeader(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	header := make([]byte, 0, _EncryptedFileHeaderSize)
+	header = append(header, encryptedFileMagic...)
+	header = append(header, _EncryptedFileVersion)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(keyID)))
+	header = append(header, keyID...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrapped)))
+	header = append(header, wrapped...)
+	if len(header) > _EncryptedFileHeaderSize {
+		return nil, moerr.NewInternalError(ctx, "encryption header too large: %d", len(header))
+	}
+	return header[:_EncryptedFileHeaderSize], nil
+}
+
+func decodeEncryptedFileHeader(ctx context.Context, header []byte) (keyID string, wrapped []byte, err error) {
+	if len(header) != _EncryptedFileHeaderSize ||
+		!bytes.Equal(header[:len(encryptedFileMagic)], encryptedFileMagic) {
+		return "", nil, moerr.NewInternalError(ctx, "bad encryption header")
+	}
+	header = header[len(encryptedFileMagic):]
+	if header[0] != _EncryptedFileVersion {
+		return "", nil, moerr.NewInternalError(ctx, "unknown encryption version %d", header[0])
+	}
+	header = header[1:]
+	n := int(binary.BigEndian.Uint16(header))
+	header = header[2:]
+	if n > len(header) {
+		return "", nil, moerr.NewInternalError(ctx, "bad encryption header")
+	}
+	keyID = string(header[:n])
+	header = header[n:]
+	if len(header) < 2 {
+		return "", nil, moerr.NewInternalError(ctx, "bad encryption header")
+	}
+	n = int(binary.BigEndian.Uint16(header))
+	header = header[2:]
+	if n > len(header) {
+		return "", nil, moerr.NewInternalError(ctx, "bad encryption header")
+	}
+	wrapped = bytes.Clone(header[:n])
+	return
+}
+
+// encryptingReader emits the header and the sealed blocks of src
+type encryptingReader struct {
+	aead    cipher.AEAD
+	src     *bufio.Reader
+	pending []byte
+	buf     []byte
+	sealed  []byte
+	nonce   []byte
+	index   int64
+	done    bool
+}
+
+var _ io.Reader = new(encryptingReader)
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.sealNext(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *encryptingReader) sealNext() error {
+	if r.buf == nil {
+		r.buf = make([]byte, _EncryptedBlockContentSize)
+		r.sealed = make([]byte, 0, _EncryptedBlockSize)
+		r.nonce = make([]byte, r.aead.NonceSize())
+	}
+
+	n, err := io.ReadFull(r.src, r.buf)
+	final := false
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		final = true
+	} else if err != nil {
+		return err
+	} else if _, err := r.src.Peek(1); err == io.EOF {
+		final = true
+	} else if err != nil {
+		return err
+	}
+
+	r.pending = r.aead.Seal(r.sealed[:0], encryptedBlockNonce(r.nonce, r.index, final), r.buf[:n], nil)
+	r.index++
+	r.done = final
+	return nil
+}
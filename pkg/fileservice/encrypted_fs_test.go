@@ -0,0 +1,208 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedFS(t *testing.T) {
+	kms, err := NewLocalFileKMS(filepath.Join(t.TempDir(), "keys"))
+	require.NoError(t, err)
+
+	t.Run("memory fs", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			return NewEncryptedFS(upstream, kms, CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+			}, nil)
+		})
+	})
+
+	t.Run("local fs", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewLocalFS(context.Background(), name, t.TempDir(), CacheConfig{
+				MemoryCapacity:            ptrTo[toml.ByteSize](DisableCacheCapacity),
+				DiskPath:                  ptrTo(t.TempDir()),
+				DiskCapacity:              ptrTo[toml.ByteSize](1 << 20),
+				enableDiskCacheForLocalFS: true,
+			}, nil)
+			assert.Nil(t, err)
+			return NewEncryptedFS(upstream, kms, CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+			}, nil)
+		})
+	})
+}
+
+func TestEncryptedFSCiphertext(t *testing.T) {
+	ctx := context.Background()
+	kms, err := NewLocalFileKMS(filepath.Join(t.TempDir(), "keys"))
+	require.NoError(t, err)
+	upstream, err := NewMemoryFS("test", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	fs := NewEncryptedFS(upstream, kms, DisabledCacheConfig, nil)
+
+	content := bytes.Repeat([]byte("secret"), _EncryptedBlockContentSize)
+	require.NoError(t, fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Size: int64(len(content)), Data: content},
+		},
+	}))
+
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, upstream.Read(ctx, vec))
+	raw := vec.Entries[0].Data
+	require.Equal(t, encryptedFileSize(int64(len(content))), int64(len(raw)))
+	require.False(t, bytes.Contains(raw, []byte("secretsecret")))
+
+	entry, err := fs.StatFile(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), entry.Size)
+
+	// tampered file fails authentication
+	raw[len(raw)-1] ^= 1
+	require.NoError(t, upstream.Delete(ctx, "foo"))
+	require.NoError(t, upstream.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: int64(len(raw)), Data: raw}},
+	}))
+	fs = NewEncryptedFS(upstream, kms, DisabledCacheConfig, nil)
+	vec = &IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Offset: int64(len(content)) - 1, Size: 1}},
+	}
+	require.Error(t, fs.Read(ctx, vec))
+
+	// truncated at block boundary
+	raw = raw[:encryptedFileSize(_EncryptedBlockContentSize)]
+	require.NoError(t, upstream.Delete(ctx, "foo"))
+	require.NoError(t, upstream.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: int64(len(raw)), Data: raw}},
+	}))
+	fs = NewEncryptedFS(upstream, kms, DisabledCacheConfig, nil)
+	vec = &IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.Error(t, fs.Read(ctx, vec))
+}
+
+func TestEncryptedFSKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	kms, err := NewLocalFileKMS(keyFile)
+	require.NoError(t, err)
+	upstream, err := NewMemoryFS("test", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	fs := NewEncryptedFS(upstream, kms, DisabledCacheConfig, nil)
+
+	write := func(path string, data string) {
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: path,
+			Entries:  []IOEntry{{Size: int64(len(data)), Data: []byte(data)}},
+		}))
+	}
+	write("a", "before rotation")
+	_, err = kms.RotateKey(ctx)
+	require.NoError(t, err)
+	write("b", "after rotation")
+
+	// a new process loading the key file reads files wrapped by both master keys
+	kms2, err := NewLocalFileKMS(keyFile)
+	require.NoError(t, err)
+	fs2 := NewEncryptedFS(upstream, kms2, DisabledCacheConfig, nil)
+	for path, want := range map[string]string{
+		"a": "before rotation",
+		"b": "after rotation",
+	} {
+		vec := &IOVector{
+			FilePath: path,
+			Entries:  []IOEntry{{Size: -1}},
+		}
+		require.NoError(t, fs2.Read(ctx, vec))
+		require.Equal(t, want, string(vec.Entries[0].Data))
+	}
+
+	// keys rotated by another process are picked up on demand
+	_, err = kms2.RotateKey(ctx)
+	require.NoError(t, err)
+	fs = NewEncryptedFS(upstream, kms2, DisabledCacheConfig, nil)
+	write("c", "rotated elsewhere")
+	fs = NewEncryptedFS(upstream, kms, DisabledCacheConfig, nil)
+	vec := &IOVector{
+		FilePath: "c",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, fs.Read(ctx, vec))
+	require.Equal(t, "rotated elsewhere", string(vec.Entries[0].Data))
+}
+
+func TestEncryptedFileSize(t *testing.T) {
+	for _, size := range []int64{
+		0, 1,
+		_EncryptedBlockContentSize - 1,
+		_EncryptedBlockContentSize,
+		_EncryptedBlockContentSize + 1,
+		_EncryptedBlockContentSize*3 + 42,
+	} {
+		assert.Equal(t, size, encryptedContentSize(encryptedFileSize(size)))
+	}
+	assert.Equal(t, int64(-1), encryptedContentSize(0))
+	assert.Equal(t, int64(-1), encryptedContentSize(_EncryptedFileHeaderSize+_EncryptedBlockSize+1))
+}
+
+func TestNewEncryptedFileService(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFileService(ctx, Config{
+		Name:    "shared",
+		Backend: "MEM",
+		Cache: CacheConfig{
+			MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+		},
+		Encryption: EncryptionConfig{
+			KeyFile: filepath.Join(t.TempDir(), "keys"),
+		},
+	}, nil)
+	require.NoError(t, err)
+	defer fs.Close()
+	_, ok := fs.(*EncryptedFS)
+	require.True(t, ok)
+	require.Equal(t, "shared", fs.Name())
+
+	_, err = NewFileService(ctx, Config{
+		Name:    "etl",
+		Backend: "DISK-ETL",
+		DataDir: t.TempDir(),
+		Encryption: EncryptionConfig{
+			KeyFile: filepath.Join(t.TempDir(), "keys"),
+		},
+	}, nil)
+	require.Error(t, err)
+}
@@ -20,11 +20,12 @@ import (
 )
 
 // Cache implements an in-memory cache with FIFO-based eviction
-// it's mostly like the S3-fifo, only without the ghost queue part
+// it's mostly like the S3-fifo, only without the ghost queue part unless created by NewS3FIFO
 type Cache[K comparable, V any] struct {
 	capacity     int
 	capacity1    int
 	onEvict      func(K, V)
+	postGet      func(K, V)
 	keyShardFunc func(K) uint8
 
 	shards [256]struct {
@@ -37,6 +38,8 @@ type Cache[K comparable, V any] struct {
 	queue1    Queue[*_CacheItem[K, V]]
 	used2     int
 	queue2    Queue[*_CacheItem[K, V]]
+	// keys recently evicted from queue1, nil if not enabled
+	ghost *ghostQueue[K]
 }
 
 type _CacheItem[K comparable, V any] struct {
@@ -44,6 +47,8 @@ type _CacheItem[K comparable, V any] struct {
 	value V
 	size  int
 	count atomic.Int32
+	// demoted items are not admitted to queue2 by the ghost queue and leave no ghost when evicted
+	demoted bool
 }
 
 func (c *_CacheItem[K, V]) inc() {
@@ -89,31 +94,66 @@ func New[K comparable, V any](
 	return ret
 }
 
-func (c *Cache[K, V]) Set(key K, value V, size int) {
+// NewS3FIFO returns a Cache with the ghost queue of S3-FIFO.
+// items evicted from queue1 are remembered by key in the ghost queue, and set to queue2 directly if set again,
+// so one-hit items like those from a large scan stay in queue1 and do not evict the working set in queue2.
+// postGet is called with the shard read-locked, so the value will not be evicted before postGet returns.
+func NewS3FIFO[K comparable, V any](
+	capacity int,
+	onEvict func(K, V),
+	postGet func(K, V),
+	keyShardFunc func(K) uint8,
+) *Cache[K, V] {
+	ret := New(capacity, onEvict, keyShardFunc)
+	ret.postGet = postGet
+	ret.ghost = newGhostQueue[K](capacity - ret.capacity1)
+	return ret
+}
+
+// Set sets the value if not exists, reports whether the value is set
+func (c *Cache[K, V]) Set(key K, value V, size int) bool {
+	return c.set(key, value, size, false)
+}
+
+// SetDemoted sets the value if not exists, as an item not expected to be read again.
+// the item is always put to queue1, and will not be put to queue2 by the ghost queue after evicted
+func (c *Cache[K, V]) SetDemoted(key K, value V, size int) bool {
+	return c.set(key, value, size, true)
+}
+
+func (c *Cache[K, V]) set(key K, value V, size int, demoted bool) bool {
 	shard := &c.shards[c.keyShardFunc(key)]
 	shard.Lock()
 	_, ok := shard.values[key]
 	if ok {
 		// existed
 		shard.Unlock()
-		return
+		return false
 	}
 
 	item := &_CacheItem[K, V]{
-		key:   key,
-		value: value,
-		size:  size,
+		key:     key,
+		value:   value,
+		size:    size,
+		demoted: demoted,
 	}
 	shard.values[key] = item
 	shard.Unlock()
 
 	c.queueLock.Lock()
 	defer c.queueLock.Unlock()
-	c.queue1.enqueue(item)
-	c.used1 += size
+	if c.ghost != nil && c.ghost.remove(key) && !demoted {
+		// evicted from queue1 recently
+		c.queue2.enqueue(item)
+		c.used2 += size
+	} else {
+		c.queue1.enqueue(item)
+		c.used1 += size
+	}
 	if c.used1+c.used2 > c.capacity {
 		c.evict()
 	}
+	return true
 }
 
 func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
@@ -125,6 +165,9 @@ func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
 		shard.RUnlock()
 		return
 	}
+	if c.postGet != nil {
+		c.postGet(item.key, item.value)
+	}
 	shard.RUnlock()
 	item.inc()
 	return item.value, true
@@ -138,6 +181,51 @@ func (c *Cache[K, V]) Delete(key K) {
 	// we don't update queues
 }
 
+// Flush evicts all items
+func (c *Cache[K, V]) Flush() {
+	c.queueLock.Lock()
+	defer c.queueLock.Unlock()
+	for _, queue := range []*Queue[*_CacheItem[K, V]]{&c.queue1, &c.queue2} {
+		for {
+			item, ok := queue.dequeue()
+			if !ok {
+				break
+			}
+			c.deleteItem(item)
+		}
+	}
+	c.used1 = 0
+	c.used2 = 0
+	if c.ghost != nil {
+		c.ghost = newGhostQueue[K](c.ghost.capacity)
+	}
+}
+
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Used returns the total size of items in queues
+func (c *Cache[K, V]) Used() int {
+	c.queueLock.Lock()
+	defer c.queueLock.Unlock()
+	return c.used1 + c.used2
+}
+
+// deleteItem deletes the item from shard and calls onEvict, if the item is not deleted or replaced
+func (c *Cache[K, V]) deleteItem(item *_CacheItem[K, V]) {
+	shard := &c.shards[c.keyShardFunc(item.key)]
+	shard.Lock()
+	current, ok := shard.values[item.key]
+	if ok && current == item {
+		delete(shard.values, item.key)
+	}
+	shard.Unlock()
+	if ok && current == item && c.onEvict != nil {
+		c.onEvict(item.key, item.value)
+	}
+}
+
 func (c *Cache[K, V]) evict() {
 	for c.used1+c.used2 > c.capacity {
 		if c.used1 > c.capacity1 {
@@ -163,14 +251,11 @@ func (c *Cache[K, V]) evict1() {
 			c.used2 += item.size
 		} else {
 			// evict
-			shard := &c.shards[c.keyShardFunc(item.key)]
-			shard.Lock()
-			delete(shard.values, item.key)
-			shard.Unlock()
-			if c.onEvict != nil {
-				c.onEvict(item.key, item.value)
-			}
+			c.deleteItem(item)
 			c.used1 -= item.size
+			if c.ghost != nil && !item.demoted {
+				c.ghost.add(item.key, item.size)
+			}
 			return
 		}
 	}
@@ -190,13 +275,7 @@ func (c *Cache[K, V]) evict2() {
 			item.dec()
 		} else {
 			// evict
-			shard := &c.shards[c.keyShardFunc(item.key)]
-			shard.Lock()
-			delete(shard.values, item.key)
-			shard.Unlock()
-			if c.onEvict != nil {
-				c.onEvict(item.key, item.value)
-			}
+			c.deleteItem(item)
 			c.used2 -= item.size
 			return
 		}
@@ -98,3 +98,90 @@ func TestCacheEvict3(t *testing.T) {
 	assert.Equal(t, 922, cache.used2)
 	assert.Equal(t, 1024, nEvict)
 }
+
+func TestS3FIFOGhost(t *testing.T) {
+	cache := NewS3FIFO[int, int](10, nil, nil, ShardInt[int])
+	// 0 is evicted from queue1 without being read
+	for i := 0; i < 11; i++ {
+		cache.Set(i, i, 1)
+	}
+	_, ok := cache.Get(0)
+	assert.False(t, ok)
+
+	// set again, admitted to queue2 by the ghost queue
+	cache.Set(0, 0, 1)
+	assert.Equal(t, 1, cache.used2)
+
+	// a scan of one-hit items does not evict items in queue2
+	for i := 100; i < 200; i++ {
+		cache.Set(i, i, 1)
+	}
+	_, ok = cache.Get(0)
+	assert.True(t, ok)
+	assert.True(t, cache.Used() <= cache.Capacity())
+}
+
+func TestCacheSetDemoted(t *testing.T) {
+	cache := NewS3FIFO[int, int](10, nil, nil, ShardInt[int])
+	for i := 0; i < 11; i++ {
+		cache.SetDemoted(i, i, 1)
+	}
+	_, ok := cache.Get(0)
+	assert.False(t, ok)
+	// demoted items leave no ghost
+	cache.Set(0, 0, 1)
+	assert.Equal(t, 0, cache.used2)
+
+	// and are not admitted by ghost
+	cache.Set(1, 1, 1)
+	cache.SetDemoted(2, 2, 1)
+	for i := 100; i < 200; i++ {
+		cache.Set(i, i, 1)
+	}
+	cache.SetDemoted(1, 1, 1)
+	cache.SetDemoted(2, 2, 1)
+	assert.Equal(t, 0, cache.used2)
+}
+
+func TestCacheFlush(t *testing.T) {
+	var evicted []int
+	cache := NewS3FIFO[int, int](8, func(k int, _ int) {
+		evicted = append(evicted, k)
+	}, nil, ShardInt[int])
+	for i := 0; i < 4; i++ {
+		cache.Set(i, i, 1)
+	}
+	cache.Delete(3)
+	cache.Flush()
+	assert.Equal(t, []int{0, 1, 2}, evicted)
+	assert.Equal(t, 0, cache.Used())
+	_, ok := cache.Get(0)
+	assert.False(t, ok)
+}
+
+func TestCacheEvictDeleted(t *testing.T) {
+	var evicted []int
+	cache := New[int, int](2, func(k int, v int) {
+		evicted = append(evicted, v)
+	}, ShardInt[int])
+	cache.Set(1, 1, 1)
+	cache.Delete(1)
+	cache.Set(1, 2, 1)
+	cache.Set(3, 3, 1)
+	cache.Set(4, 4, 1)
+	// the deleted item is dropped without calling onEvict
+	assert.Equal(t, []int{2}, evicted)
+	_, ok := cache.Get(1)
+	assert.False(t, ok)
+}
+
+func TestCachePostGet(t *testing.T) {
+	var got []int
+	cache := NewS3FIFO[int, int](8, nil, func(k int, _ int) {
+		got = append(got, k)
+	}, ShardInt[int])
+	cache.Set(1, 1, 1)
+	cache.Get(1)
+	cache.Get(2)
+	assert.Equal(t, []int{1}, got)
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fifocache
+
+// ghostQueue is a FIFO of evicted keys, bounded by the total size of items evicted
+// not thread-safe, protected by Cache.queueLock
+type ghostQueue[K comparable] struct {
+	capacity int
+	used     int
+	// number of items in queue, including removed ones
+	length int
+	queue  Queue[*ghostItem[K]]
+	keys   map[K]*ghostItem[K]
+}
+
+type ghostItem[K comparable] struct {
+	key     K
+	size    int
+	removed bool
+}
+
+func newGhostQueue[K comparable](capacity int) *ghostQueue[K] {
+	return &ghostQueue[K]{
+		capacity: capacity,
+		queue:    *NewQueue[*ghostItem[K]](),
+		keys:     make(map[K]*ghostItem[K]),
+	}
+}
+
+func (g *ghostQueue[K]) add(key K, size int) {
+	if _, ok := g.keys[key]; ok {
+		return
+	}
+	item := &ghostItem[K]{
+		key:  key,
+		size: size,
+	}
+	g.keys[key] = item
+	g.queue.enqueue(item)
+	g.length++
+	g.used += size
+	// removed items are dropped when dequeued, also dequeue when they pile up
+	for g.used > g.capacity || g.length > len(g.keys)*2+maxQueuePartCapacity {
+		item, ok := g.queue.dequeue()
+		if !ok {
+			break
+		}
+		g.length--
+		if item.removed {
+			continue
+		}
+		delete(g.keys, item.key)
+		g.used -= item.size
+	}
+}
+
+// remove removes the key and reports whether it's in the queue
+func (g *ghostQueue[K]) remove(key K) bool {
+	item, ok := g.keys[key]
+	if !ok {
+		return false
+	}
+	item.removed = true
+	delete(g.keys, key)
+	g.used -= item.size
+	return true
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fifocache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGhostQueue(t *testing.T) {
+	g := newGhostQueue[int](3)
+	g.add(1, 1)
+	g.add(2, 1)
+	g.add(3, 1)
+	g.add(4, 1)
+	// 1 dropped
+	assert.False(t, g.remove(1))
+	assert.True(t, g.remove(2))
+	assert.False(t, g.remove(2))
+	assert.Equal(t, 2, g.used)
+
+	// removed items do not pile up
+	for i := 0; i < 10000; i++ {
+		g.add(i+10, 1)
+		g.remove(i + 10)
+	}
+	assert.True(t, g.length < maxQueuePartCapacity*2)
+}
@@ -276,7 +276,7 @@ func (f *FileWithChecksum[T]) readBlock(offset int64) (data []byte, putback PutB
 
 	expectedChecksum := crc32.Checksum(data, crcTable)
 	if checksum != expectedChecksum {
-		return nil, putback, moerr.NewInternalErrorNoCtx("checksum not match")
+		return nil, putback, moerr.NewDataCorruptedNoCtx("checksum not match")
 	}
 
 	return
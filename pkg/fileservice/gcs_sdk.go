@@ -0,0 +1,691 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	gotrace "runtime/trace"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"go.uber.org/zap"
+)
+
+// GCSSDK is an ObjectStorage backed by Google Cloud Storage JSON API
+type GCSSDK struct {
+	name            string
+	endpoint        *url.URL
+	bucket          string
+	tokenSource     gcsTokenSource
+	client          *http.Client
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int
+}
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+	gcsMetadataToken   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+func NewGCSSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *GCSSDK, err error) {
+
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := args.Endpoint
+	if endpoint == "" {
+		endpoint = gcsDefaultEndpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL.Path = strings.TrimRight(endpointURL.Path, "/")
+
+	client := newHTTPClient(args)
+	tokenSource, err := args.tokenSourceForGCS(client)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk := &GCSSDK{
+		name:            args.Name,
+		endpoint:        endpointURL,
+		bucket:          args.Bucket,
+		tokenSource:     tokenSource,
+		client:          client,
+		perfCounterSets: perfCounterSets,
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "gcs"),
+		zap.Any("arguments", args),
+	)
+
+	if !args.NoBucketValidation {
+		// validate bucket
+		_, err := doWithRetry(
+			"gcs get bucket",
+			func() (bool, error) {
+				resp, err := sdk.do(ctx, http.MethodGet, "/storage/v1/b/"+url.PathEscape(sdk.bucket), nil, nil, -1, nil)
+				if err != nil {
+					return false, err
+				}
+				resp.Body.Close()
+				return true, nil
+			},
+			maxRetryAttemps,
+			isRetryableHTTPError,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk, nil
+}
+
+var _ ObjectStorage = new(GCSSDK)
+
+func (g *GCSSDK) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var pageToken string
+	for {
+		result, err := g.listObjects(ctx, prefix, pageToken)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			size, err := strconv.ParseInt(item.Size, 10, 64)
+			if err != nil {
+				return err
+			}
+			more, err := fn(false, item.Name, size)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		for _, prefix := range result.Prefixes {
+			more, err := fn(true, prefix, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if result.NextPageToken == "" {
+			break
+		}
+		pageToken = result.NextPageToken
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	object, err := g.getObjectMetadata(ctx, key)
+	if err != nil {
+		return
+	}
+	return strconv.ParseInt(object.Size, 10, 64)
+}
+
+func (g *GCSSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := g.getObjectMetadata(ctx, key)
+	if err != nil {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (g *GCSSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.Write")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, g.perfCounterSets...)
+
+	// media uploads carry no object metadata, lifecycle rules should be used for expiration
+	_ = expire
+
+	query := url.Values{
+		"uploadType": {"media"},
+		"name":       {key},
+	}
+	header := http.Header{
+		"Content-Type": {"application/octet-stream"},
+	}
+	// not retryable because Reader may be half consumed
+	resp, err := g.do(ctx, http.MethodPost, "/upload/storage/v1/b/"+url.PathEscape(g.bucket)+"/o", query, header, size, r)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (g *GCSSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPStatus(err, http.StatusNotFound) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	r, err = g.getObject(ctx, key, min, max)
+	if err != nil {
+		return nil, err
+	}
+	if max == nil {
+		return r, nil
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, *max-*min),
+		closeFunc: r.Close,
+	}, nil
+}
+
+func (g *GCSSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := g.deleteObject(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type gcsObject struct {
+	Name string `json:"name"`
+	// int64 encoded as string
+	Size string `json:"size"`
+}
+
+type gcsListObjectsResult struct {
+	Items         []gcsObject `json:"items"`
+	Prefixes      []string    `json:"prefixes"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+func (g *GCSSDK) objectPath(key string) string {
+	return "/storage/v1/b/" + url.PathEscape(g.bucket) + "/o/" + url.PathEscape(key)
+}
+
+func (g *GCSSDK) listObjects(ctx context.Context, prefix string, pageToken string) (*gcsListObjectsResult, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.listObjects")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.List.Add(1)
+	}, g.perfCounterSets...)
+	query := url.Values{
+		"delimiter": {"/"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	if g.listMaxKeys > 0 {
+		query.Set("maxResults", strconv.Itoa(g.listMaxKeys))
+	}
+	return doWithRetry(
+		"gcs list objects",
+		func() (*gcsListObjectsResult, error) {
+			resp, err := g.do(ctx, http.MethodGet, "/storage/v1/b/"+url.PathEscape(g.bucket)+"/o", query, nil, -1, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			result := new(gcsListObjectsResult)
+			if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+}
+
+func (g *GCSSDK) getObjectMetadata(ctx context.Context, key string) (*gcsObject, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.getObjectMetadata")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Head.Add(1)
+	}, g.perfCounterSets...)
+	return doWithRetry(
+		"gcs get object metadata",
+		func() (*gcsObject, error) {
+			resp, err := g.do(ctx, http.MethodGet, g.objectPath(key), nil, nil, -1, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			object := new(gcsObject)
+			if err := json.NewDecoder(resp.Body).Decode(object); err != nil {
+				return nil, err
+			}
+			return object, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+}
+
+func (g *GCSSDK) getObject(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.getObject")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Get.Add(1)
+	}, g.perfCounterSets...)
+	var offset int64
+	if min != nil {
+		offset = *min
+	}
+	r, err := newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			return doWithRetry(
+				"gcs get object",
+				func() (io.ReadCloser, error) {
+					resp, err := g.do(ctx, http.MethodGet, g.objectPath(key), url.Values{
+						"alt": {"media"},
+					}, http.Header{
+						"Range": {httpRange(offset, max)},
+					}, -1, nil)
+					if err != nil {
+						return nil, err
+					}
+					return resp.Body, nil
+				},
+				maxRetryAttemps,
+				isRetryableHTTPError,
+			)
+		},
+		offset,
+		isRetryableError,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (g *GCSSDK) deleteObject(ctx context.Context, key string) error {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.deleteObject")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Delete.Add(1)
+	}, g.perfCounterSets...)
+	_, err := doWithRetry(
+		"gcs delete object",
+		func() (bool, error) {
+			resp, err := g.do(ctx, http.MethodDelete, g.objectPath(key), nil, nil, -1, nil)
+			if err != nil {
+				if isHTTPStatus(err, http.StatusNotFound) {
+					return true, nil
+				}
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		},
+		maxRetryAttemps,
+		isRetryableHTTPError,
+	)
+	return err
+}
+
+// do sends a request to path under the endpoint
+// responses with non-2xx status are returned as *httpStatusError
+func (g *GCSSDK) do(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	header http.Header,
+	size int64,
+	body io.Reader,
+) (*http.Response, error) {
+
+	u := *g.endpoint
+	u.RawPath = u.EscapedPath() + path
+	u.Path, _ = url.PathUnescape(u.RawPath)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.ContentLength = size
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	if g.tokenSource != nil {
+		token, err := g.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHTTPResponse(resp, http.StatusOK, http.StatusNoContent, http.StatusPartialContent); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// gcsTokenSource provides OAuth2 access tokens
+type gcsTokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticGCSTokenSource string
+
+func (s staticGCSTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// cachedGCSTokenSource caches tokens fetched by fetch until they are about to expire
+type cachedGCSTokenSource struct {
+	fetch func(ctx context.Context) (token string, expiresIn time.Duration, err error)
+
+	mu       sync.Mutex
+	token    string
+	expireAt time.Time
+}
+
+func (c *cachedGCSTokenSource) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.expireAt) {
+		return c.token, nil
+	}
+	token, expiresIn, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.token = token
+	// refresh before expiration
+	c.expireAt = time.Now().Add(expiresIn - time.Minute)
+	return token, nil
+}
+
+type gcsTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func decodeGCSTokenResponse(resp *http.Response) (string, time.Duration, error) {
+	defer resp.Body.Close()
+	if err := checkHTTPResponse(resp, http.StatusOK); err != nil {
+		return "", 0, err
+	}
+	var ret gcsTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return "", 0, err
+	}
+	if ret.AccessToken == "" {
+		return "", 0, moerr.NewInternalErrorNoCtx("empty gcs access token")
+	}
+	return ret.AccessToken, time.Duration(ret.ExpiresIn) * time.Second, nil
+}
+
+type gcsServiceAccount struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// newServiceAccountGCSTokenSource exchanges signed JWT assertions of the service account for access tokens
+func newServiceAccountGCSTokenSource(client *http.Client, content []byte) (gcsTokenSource, error) {
+	var account gcsServiceAccount
+	if err := json.Unmarshal(content, &account); err != nil {
+		return nil, moerr.NewInvalidInputNoCtx("bad gcs credentials: %v", err)
+	}
+	if account.Type != "service_account" {
+		return nil, moerr.NewInvalidInputNoCtx("unsupported gcs credentials type: %s", account.Type)
+	}
+	block, _ := pem.Decode([]byte(account.PrivateKey))
+	if block == nil {
+		return nil, moerr.NewInvalidInputNoCtx("bad gcs service account private key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("bad gcs service account private key: %v", err)
+		}
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, moerr.NewInvalidInputNoCtx("gcs service account private key is not RSA")
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	return &cachedGCSTokenSource{
+		fetch: func(ctx context.Context) (string, time.Duration, error) {
+			assertion, err := signGCSAssertion(key, account, time.Now())
+			if err != nil {
+				return "", 0, err
+			}
+			form := url.Values{
+				"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+				"assertion":  {assertion},
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, account.TokenURI, strings.NewReader(form.Encode()))
+			if err != nil {
+				return "", 0, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			resp, err := client.Do(req)
+			if err != nil {
+				return "", 0, err
+			}
+			return decodeGCSTokenResponse(resp)
+		},
+	}, nil
+}
+
+func signGCSAssertion(key *rsa.PrivateKey, account gcsServiceAccount, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iss":   account.ClientEmail,
+		"scope": gcsScope,
+		"aud":   account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// newMetadataGCSTokenSource fetches tokens of the default service account from the GCE metadata server
+func newMetadataGCSTokenSource(client *http.Client) gcsTokenSource {
+	return &cachedGCSTokenSource{
+		fetch: func(ctx context.Context) (string, time.Duration, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcsMetadataToken, nil)
+			if err != nil {
+				return "", 0, err
+			}
+			req.Header.Set("Metadata-Flavor", "Google")
+			resp, err := client.Do(req)
+			if err != nil {
+				return "", 0, err
+			}
+			return decodeGCSTokenResponse(resp)
+		},
+	}
+}
+
+// tokenSourceForGCS returns nil for anonymous access
+func (o ObjectStorageArguments) tokenSourceForGCS(client *http.Client) (gcsTokenSource, error) {
+	if o.BearerToken != "" {
+		return staticGCSTokenSource(o.BearerToken), nil
+	}
+
+	if o.CredentialsJSON != "" {
+		return newServiceAccountGCSTokenSource(client, []byte(o.CredentialsJSON))
+	}
+	if o.CredentialsFile != "" {
+		content, err := os.ReadFile(o.CredentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		return newServiceAccountGCSTokenSource(client, content)
+	}
+
+	if !o.shouldLoadDefaultCredentials() {
+		// anonymous access for public buckets and emulators
+		return nil, nil
+	}
+
+	if path := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		logutil.Info("using gcs application default credentials",
+			zap.Any("path", path),
+		)
+		return newServiceAccountGCSTokenSource(client, content)
+	}
+
+	logutil.Info("using gcs metadata server credentials")
+	return newMetadataGCSTokenSource(client), nil
+}
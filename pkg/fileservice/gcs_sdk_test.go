@@ -0,0 +1,342 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeGCSServer serves a subset of gcs json api
+// requests must carry token if it's not empty
+func newFakeGCSServer(t *testing.T, bucket string, token string) *httptest.Server {
+	objects := newFakeObjects()
+	bucketPath := "/storage/v1/b/" + bucket
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		query := r.URL.Query()
+		path := r.URL.EscapedPath()
+
+		switch {
+
+		case r.Method == http.MethodPost && path == "/upload"+bucketPath+"/o":
+			if query.Get("uploadType") != "media" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects.put(query.Get("name"), data)
+			_ = json.NewEncoder(w).Encode(gcsObject{
+				Name: query.Get("name"),
+				Size: strconv.Itoa(len(data)),
+			})
+
+		case r.Method == http.MethodGet && path == bucketPath:
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"name": bucket,
+			})
+
+		case r.Method == http.MethodGet && path == bucketPath+"/o":
+			limit, _ := strconv.Atoi(query.Get("maxResults"))
+			entries, next := objects.list(
+				query.Get("prefix"),
+				query.Get("delimiter"),
+				query.Get("pageToken"),
+				limit,
+			)
+			var result gcsListObjectsResult
+			for _, entry := range entries {
+				if entry.isPrefix {
+					result.Prefixes = append(result.Prefixes, entry.name)
+					continue
+				}
+				result.Items = append(result.Items, gcsObject{
+					Name: entry.name,
+					Size: strconv.Itoa(entry.size),
+				})
+			}
+			result.NextPageToken = next
+			_ = json.NewEncoder(w).Encode(result)
+
+		case strings.HasPrefix(path, bucketPath+"/o/"):
+			key, err := url.PathUnescape(strings.TrimPrefix(path, bucketPath+"/o/"))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			switch r.Method {
+			case http.MethodGet:
+				data, ok := objects.get(key)
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if query.Get("alt") == "media" {
+					writeRange(w, r.Header.Get("Range"), data)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(gcsObject{
+					Name: key,
+					Size: strconv.Itoa(len(data)),
+				})
+			case http.MethodDelete:
+				if !objects.delete(key) {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func testGCSFileService(t *testing.T, endpoint string, token string) {
+	cacheDir := t.TempDir()
+	testFileService(t, 0, func(name string) FileService {
+		fs, err := NewS3FS(
+			context.Background(),
+			ObjectStorageArguments{
+				Name:        name,
+				Provider:    ObjectStorageProviderGCS,
+				Endpoint:    endpoint,
+				Bucket:      "test",
+				KeyPrefix:   time.Now().Format("2006-01-02.15:04:05.000000"),
+				BearerToken: token,
+			},
+			CacheConfig{
+				DiskPath: ptrTo(cacheDir),
+			},
+			nil,
+			true,
+			true,
+		)
+		assert.Nil(t, err)
+		return fs
+	})
+}
+
+func TestGCSSDK(t *testing.T) {
+	server := newFakeGCSServer(t, "test", "token")
+	defer server.Close()
+
+	t.Run("file service", func(t *testing.T) {
+		testGCSFileService(t, server.URL, "token")
+	})
+
+	ctx := context.Background()
+	sdk, err := NewGCSSDK(ctx, ObjectStorageArguments{
+		Endpoint:    server.URL,
+		Bucket:      "test",
+		BearerToken: "token",
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("ranged read", func(t *testing.T) {
+		require.NoError(t, sdk.Write(ctx, "dir/a b", strings.NewReader("0123456789"), -1, nil))
+		r, err := sdk.Read(ctx, "dir/a b", ptrTo[int64](3), ptrTo[int64](7))
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "3456", string(data))
+		size, err := sdk.Stat(ctx, "dir/a b")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+	})
+
+	t.Run("list pages", func(t *testing.T) {
+		sdk.listMaxKeys = 2
+		defer func() {
+			sdk.listMaxKeys = 0
+		}()
+		for _, key := range []string{"list/a", "list/b", "list/c/d", "list/e"} {
+			require.NoError(t, sdk.Write(ctx, key, strings.NewReader("foo"), 3, nil))
+		}
+		var files, dirs []string
+		require.NoError(t, sdk.List(ctx, "list/", func(isPrefix bool, key string, size int64) (bool, error) {
+			if isPrefix {
+				dirs = append(dirs, key)
+			} else {
+				assert.Equal(t, int64(3), size)
+				files = append(files, key)
+			}
+			return true, nil
+		}))
+		assert.Equal(t, []string{"list/a", "list/b", "list/e"}, files)
+		assert.Equal(t, []string{"list/c/"}, dirs)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := sdk.Stat(ctx, "not-exists")
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+		_, err = sdk.Read(ctx, "not-exists", ptrTo[int64](0), nil)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+		ok, err := sdk.Exists(ctx, "not-exists")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, sdk.Delete(ctx, "not-exists"))
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		_, err := NewGCSSDK(ctx, ObjectStorageArguments{
+			Endpoint:             server.URL,
+			Bucket:               "test",
+			NoDefaultCredentials: true,
+		}, nil)
+		assert.True(t, isHTTPStatus(err, http.StatusUnauthorized))
+	})
+}
+
+func TestGCSServiceAccountCredentials(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var fetched atomic.Int64
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+
+		// verify assertion
+		parts := strings.Split(r.Form.Get("assertion"), ".")
+		require.Equal(t, 3, len(parts))
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, sum[:], signature); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var c map[string]any
+		require.NoError(t, json.Unmarshal(claims, &c))
+		assert.Equal(t, "test@example.com", c["iss"])
+		assert.Equal(t, gcsScope, c["scope"])
+
+		fetched.Add(1)
+		_ = json.NewEncoder(w).Encode(gcsTokenResponse{
+			AccessToken: "token",
+			ExpiresIn:   3600,
+		})
+	}))
+	defer tokenServer.Close()
+
+	server := newFakeGCSServer(t, "test", "token")
+	defer server.Close()
+
+	credentials, err := json.Marshal(gcsServiceAccount{
+		Type:        "service_account",
+		ClientEmail: "test@example.com",
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+		TokenURI: tokenServer.URL,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sdk, err := NewGCSSDK(ctx, ObjectStorageArguments{
+		Endpoint:        server.URL,
+		Bucket:          "test",
+		CredentialsJSON: string(credentials),
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, sdk.Write(ctx, "foo", bytes.NewReader([]byte("bar")), 3, nil))
+	ok, err := sdk.Exists(ctx, "foo")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	// token is cached
+	assert.Equal(t, int64(1), fetched.Load())
+
+	_, err = NewGCSSDK(ctx, ObjectStorageArguments{
+		Endpoint:        server.URL,
+		Bucket:          "test",
+		CredentialsJSON: `{"type":"authorized_user"}`,
+	}, nil)
+	assert.Error(t, err)
+}
+
+func TestGCSSDKFakeGCSServer(t *testing.T) {
+
+	// find fake-gcs-server executable
+	exePath, err := exec.LookPath("fake-gcs-server")
+	if errors.Is(err, exec.ErrNotFound) {
+		// fake-gcs-server not found in machine
+		return
+	}
+
+	// start fake-gcs-server
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd := exec.CommandContext(ctx,
+		exePath,
+		"-scheme", "http",
+		"-host", "127.0.0.1",
+		"-port", "4443",
+		"-backend", "memory",
+	)
+	err = cmd.Start()
+	assert.Nil(t, err)
+	waitListening(t, "127.0.0.1:4443")
+
+	endpoint := "http://127.0.0.1:4443"
+
+	// create bucket
+	resp, err := http.Post(endpoint+"/storage/v1/b", "application/json", strings.NewReader(`{"name":"test"}`))
+	require.NoError(t, err)
+	require.NoError(t, checkHTTPResponse(resp, http.StatusOK))
+	resp.Body.Close()
+
+	t.Run("file service", func(t *testing.T) {
+		testGCSFileService(t, endpoint, "")
+	})
+}
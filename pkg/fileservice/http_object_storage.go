@@ -0,0 +1,127 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// helpers for object storages implemented with plain http requests
+
+func newHTTPClient(args ObjectStorageArguments) *http.Client {
+	dialer := &net.Dialer{
+		KeepAlive: 5 * time.Second,
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       180 * time.Second,
+		MaxIdleConnsPerHost:   100,
+		MaxConnsPerHost:       1000,
+		TLSHandshakeTimeout:   3 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+	if len(args.CertFiles) > 0 {
+		// custom certs
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			panic(err)
+		}
+		for _, path := range args.CertFiles {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				logutil.Info("load cert file error",
+					zap.Any("err", err),
+				)
+				// ignore
+				continue
+			}
+			logutil.Info("file service: load cert file",
+				zap.Any("path", path),
+			)
+			pool.AppendCertsFromPEM(content)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+			RootCAs:            pool,
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+// httpStatusError is returned for responses with unexpected status code
+type httpStatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (h *httpStatusError) Error() string {
+	return fmt.Sprintf("%s %s: status %d: %s", h.Method, h.URL, h.StatusCode, h.Body)
+}
+
+func checkHTTPResponse(resp *http.Response, expected ...int) error {
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+	return &httpStatusError{
+		Method:     resp.Request.Method,
+		URL:        resp.Request.URL.Redacted(),
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+}
+
+func isHTTPStatus(err error, code int) bool {
+	var statusErr *httpStatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == code
+}
+
+func isRetryableHTTPError(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests ||
+			statusErr.StatusCode >= 500
+	}
+	return isRetryableError(err)
+}
+
+// httpRange returns the value of the Range header for [offset, max)
+func httpRange(offset int64, max *int64) string {
+	if max != nil {
+		return fmt.Sprintf("bytes=%d-%d", offset, *max-1)
+	}
+	return fmt.Sprintf("bytes=%d-", offset)
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeObjects is the in-memory store of fake object storage servers
+type fakeObjects struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeObjects() *fakeObjects {
+	return &fakeObjects{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (f *fakeObjects) put(key string, data []byte) {
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+}
+
+func (f *fakeObjects) get(key string) ([]byte, bool) {
+	f.Lock()
+	defer f.Unlock()
+	data, ok := f.objects[key]
+	return data, ok
+}
+
+func (f *fakeObjects) delete(key string) bool {
+	f.Lock()
+	defer f.Unlock()
+	_, ok := f.objects[key]
+	delete(f.objects, key)
+	return ok
+}
+
+type fakeListEntry struct {
+	isPrefix bool
+	name     string
+	size     int
+}
+
+// list returns at most limit entries after marker, and the marker of next page
+func (f *fakeObjects) list(prefix string, delimiter string, marker string, limit int) ([]fakeListEntry, string) {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for name := range f.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var entries []fakeListEntry
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		entry := fakeListEntry{
+			name: name,
+			size: len(f.objects[name]),
+		}
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				entry = fakeListEntry{
+					isPrefix: true,
+					name:     name[:len(prefix)+i+len(delimiter)],
+				}
+			}
+		}
+		if seen[entry.name] || entry.name <= marker {
+			continue
+		}
+		seen[entry.name] = true
+		if limit > 0 && len(entries) == limit {
+			return entries, entries[len(entries)-1].name
+		}
+		entries = append(entries, entry)
+	}
+	return entries, ""
+}
+
+// writeRange writes data or the requested range of data
+func writeRange(w http.ResponseWriter, value string, data []byte) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if value == "" {
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+		return
+	}
+	spec, ok := strings.CutPrefix(value, "bytes=")
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	from, to, _ := strings.Cut(spec, "-")
+	start, err := strconv.Atoi(from)
+	if err != nil || start > len(data) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	end := len(data) - 1
+	if to != "" {
+		end, err = strconv.Atoi(to)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if end >= len(data) {
+			end = len(data) - 1
+		}
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+	w.WriteHeader(http.StatusPartialContent)
+	_, _ = w.Write(data[start : end+1])
+}
+
+// waitListening waits for the emulator to accept connections
+func waitListening(t *testing.T, addr string) {
+	deadline := time.Now().Add(time.Second * 30)
+	for time.Now().Before(deadline) {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	t.Fatalf("%s not listening", addr)
+}
+
+func TestHTTPRange(t *testing.T) {
+	assert.Equal(t, "bytes=3-", httpRange(3, nil))
+	assert.Equal(t, "bytes=3-9", httpRange(3, ptrTo[int64](10)))
+}
+
+func TestIsRetryableHTTPError(t *testing.T) {
+	assert.True(t, isRetryableHTTPError(&httpStatusError{StatusCode: http.StatusServiceUnavailable}))
+	assert.True(t, isRetryableHTTPError(&httpStatusError{StatusCode: http.StatusTooManyRequests}))
+	assert.False(t, isRetryableHTTPError(&httpStatusError{StatusCode: http.StatusForbidden}))
+	assert.False(t, isRetryableHTTPError(errors.New("foo")))
+	assert.True(t, isHTTPStatus(&httpStatusError{StatusCode: http.StatusNotFound}, http.StatusNotFound))
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// KMS wraps and unwraps data keys with master keys
+type KMS interface {
+	// WrapKey encrypts dataKey with the current master key
+	// returns the id of the master key and the wrapped key
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+
+	// UnwrapKey decrypts a data key wrapped by the master key keyID
+	// master keys replaced by rotation must still be able to unwrap
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) (dataKey []byte, err error)
+}
+
+const masterKeySize = 32
+
+// LocalFileKMS is a KMS keeping master keys in a local file
+// each line of the file is "<key id>:<hex encoded 32 bytes key>", the last line is the current key
+// the file is created with a random key if not exists
+type LocalFileKMS struct {
+	path string
+
+	mu struct {
+		sync.RWMutex
+		keys      map[string]cipher.AEAD
+		currentID string
+	}
+}
+
+var _ KMS = new(LocalFileKMS)
+
+func NewLocalFileKMS(path string) (*LocalFileKMS, error) {
+	k := &LocalFileKMS{
+		path: path,
+	}
+	if err := k.Reload(); err == nil {
+		return k, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	if _, err := k.RotateKey(context.Background()); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Reload reloads the master keys from the key file
+func (k *LocalFileKMS) Reload() error {
+	f, err := os.Open(k.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	keys := make(map[string]cipher.AEAD)
+	var currentID string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok || id == "" {
+			return moerr.NewInternalErrorNoCtx("bad master key line in %s", k.path)
+		}
+		key, err := hex.DecodeString(encoded)
+		if err != nil || len(key) != masterKeySize {
+			return moerr.NewInternalErrorNoCtx("bad master key %s in %s", id, k.path)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return err
+		}
+		keys[id] = aead
+		currentID = id
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if currentID == "" {
+		return moerr.NewInternalErrorNoCtx("no master key in %s", k.path)
+	}
+
+	k.mu.Lock()
+	k.mu.keys = keys
+	k.mu.currentID = currentID
+	k.mu.Unlock()
+	return nil
+}
+
+// RotateKey appends a new random master key to the key file and makes it current
+// data keys wrapped by previous master keys can still be unwrapped
+func (k *LocalFileKMS) RotateKey(ctx context.Context) (string, error) {
+	key := make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	id := fmt.Sprintf("%d", time.Now().UnixNano())
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	f, err := os.OpenFile(k.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	if _, err := fmt.Fprintf(f, "%s:%s\n", id, hex.EncodeToString(key)); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	if k.mu.keys == nil {
+		k.mu.keys = make(map[string]cipher.AEAD)
+	}
+	k.mu.keys[id] = aead
+	k.mu.currentID = id
+	return id, nil
+}
+
+func (k *LocalFileKMS) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	k.mu.RLock()
+	id := k.mu.currentID
+	aead := k.mu.keys[id]
+	k.mu.RUnlock()
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+	return id, aead.Seal(nonce, nonce, dataKey, []byte(id)), nil
+}
+
+func (k *LocalFileKMS) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	k.mu.RLock()
+	aead, ok := k.mu.keys[keyID]
+	k.mu.RUnlock()
+	if !ok {
+		// may be rotated by another process
+		if err := k.Reload(); err != nil {
+			return nil, err
+		}
+		k.mu.RLock()
+		aead, ok = k.mu.keys[keyID]
+		k.mu.RUnlock()
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "master key %s not found", keyID)
+		}
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, moerr.NewInternalError(ctx, "bad wrapped key")
+	}
+	nonce := wrapped[:aead.NonceSize()]
+	dataKey, err := aead.Open(nil, nonce, wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "unwrap key with master key %s: %v", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -120,6 +120,9 @@ func NewLocalFS(
 
 func (l *LocalFS) initCaches(ctx context.Context, config CacheConfig) error {
 	config.setDefaults()
+	if err := config.validate(ctx); err != nil {
+		return err
+	}
 
 	if config.RemoteCacheEnabled {
 		if config.QueryClient == nil {
@@ -133,7 +136,8 @@ func (l *LocalFS) initCaches(ctx context.Context, config CacheConfig) error {
 
 	if *config.MemoryCapacity > DisableCacheCapacity { // 1 means disable
 		l.memCache = NewMemCache(
-			NewMemoryCache(int64(*config.MemoryCapacity), true, &config.CacheCallbacks),
+			NewMemoryCache(int64(*config.MemoryCapacity), config.MemoryPolicy, true, &config.CacheCallbacks),
+			config.ScanAdmission,
 			l.perfCounterSets,
 		)
 		logutil.Info("fileservice: memory cache initialized",
@@ -149,6 +153,8 @@ func (l *LocalFS) initCaches(ctx context.Context, config CacheConfig) error {
 				ctx,
 				*config.DiskPath,
 				int(*config.DiskCapacity),
+				config.DiskPolicy,
+				config.ScanAdmission,
 				l.perfCounterSets,
 			)
 			if err != nil {
@@ -171,11 +171,13 @@ func TestLocalFSWithDiskCache(t *testing.T) {
 // only memory obtained through memcache.Alloc can be set to memcache
 func TestLocalFSWithIOVectorCache(t *testing.T) {
 	memCache1 := NewMemCache(
-		NewMemoryCache(1<<20, false, nil),
+		NewMemoryCache(1<<20, CachePolicyLRU, false, nil),
+		ScanAdmissionAdmit,
 		nil,
 	)
 	memCache2 := NewMemCache(
-		NewMemoryCache(1<<20, false, nil),
+		NewMemoryCache(1<<20, CachePolicyLRU, false, nil),
+		ScanAdmissionAdmit,
 		nil,
 	)
 	caches := []IOVectorCache{memCache1, memCache2}
@@ -24,23 +24,27 @@ import (
 )
 
 type MemCache struct {
-	cache       *memorycache.Cache
-	counterSets []*perfcounter.CounterSet
+	cache         *memorycache.Cache
+	scanAdmission ScanAdmission
+	counterSets   []*perfcounter.CounterSet
 }
 
 func NewMemCache(
 	dataCache *memorycache.Cache,
+	scanAdmission ScanAdmission,
 	counterSets []*perfcounter.CounterSet,
 ) *MemCache {
 	ret := &MemCache{
-		cache:       dataCache,
-		counterSets: counterSets,
+		cache:         dataCache,
+		scanAdmission: scanAdmission,
+		counterSets:   counterSets,
 	}
 	return ret
 }
 
 func NewMemoryCache(
 	capacity int64,
+	policy CachePolicy,
 	checkOverlaps bool,
 	callbacks *CacheCallbacks,
 ) *memorycache.Cache {
@@ -73,6 +77,12 @@ func NewMemoryCache(
 	}
 
 	postEvictFn := func(key CacheKey, value memorycache.CacheData) {
+		if policy == CachePolicyS3FIFO {
+			// evictions of lru are counted by lrucache
+			perfcounter.Update(context.Background(), func(set *perfcounter.CounterSet) {
+				set.FileService.Cache.S3FIFO.Evict.Add(1)
+			})
+		}
 		if overlapChecker != nil {
 			if err := overlapChecker.Remove(key.Path, key.Offset, key.Offset+key.Sz); err != nil {
 				panic(err)
@@ -85,7 +95,7 @@ func NewMemoryCache(
 			}
 		}
 	}
-	return memorycache.NewCache(capacity, postSetFn, postGetFn, postEvictFn)
+	return memorycache.NewCache(capacity, policy.memoryCachePolicy(), postSetFn, postGetFn, postEvictFn)
 }
 
 var _ IOVectorCache = new(MemCache)
@@ -113,6 +123,14 @@ func (m *MemCache) Read(
 			c.FileService.Cache.Hit.Add(numHit)
 			c.FileService.Cache.Memory.Read.Add(numRead)
 			c.FileService.Cache.Memory.Hit.Add(numHit)
+			switch m.cache.Policy() {
+			case memorycache.PolicyS3FIFO:
+				c.FileService.Cache.S3FIFO.Read.Add(numRead)
+				c.FileService.Cache.S3FIFO.Hit.Add(numHit)
+			default:
+				c.FileService.Cache.LRU.Read.Add(numRead)
+				c.FileService.Cache.LRU.Hit.Add(numHit)
+			}
 			c.FileService.Cache.Memory.Capacity.Swap(m.cache.Capacity())
 			c.FileService.Cache.Memory.Used.Swap(m.cache.Used())
 			c.FileService.Cache.Memory.Available.Swap(m.cache.Available())
@@ -161,6 +179,17 @@ func (m *MemCache) Update(
 		return err
 	}
 
+	admit, demote := m.scanAdmission.admit(vector.Policy)
+	var numDemote, numBypass int64
+	defer func() {
+		if numDemote > 0 || numBypass > 0 {
+			perfcounter.Update(ctx, func(c *perfcounter.CounterSet) {
+				c.FileService.Cache.ScanAdmission.Demote.Add(numDemote)
+				c.FileService.Cache.ScanAdmission.Bypass.Add(numBypass)
+			}, m.counterSets...)
+		}
+	}()
+
 	for _, entry := range vector.Entries {
 		if entry.CachedData == nil {
 			continue
@@ -168,6 +197,10 @@ func (m *MemCache) Update(
 		if entry.fromCache == m {
 			continue
 		}
+		if !admit {
+			numBypass++
+			continue
+		}
 
 		key := CacheKey{
 			Path:   path.File,
@@ -175,7 +208,12 @@ func (m *MemCache) Update(
 			Sz:     entry.Size,
 		}
 
-		m.cache.Set(ctx, key, entry.CachedData)
+		if demote {
+			m.cache.SetDemoted(ctx, key, entry.CachedData)
+			numDemote++
+		} else {
+			m.cache.Set(ctx, key, entry.CachedData)
+		}
 	}
 	return nil
 }
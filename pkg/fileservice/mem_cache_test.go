@@ -46,7 +46,7 @@ func TestMemCacheLeak(t *testing.T) {
 	assert.Nil(t, err)
 
 	size := int64(4 * runtime.GOMAXPROCS(0))
-	m := NewMemCache(NewMemoryCache(size, true, nil), nil)
+	m := NewMemCache(NewMemoryCache(size, CachePolicyLRU, true, nil), ScanAdmissionAdmit, nil)
 
 	vec := &IOVector{
 		FilePath: "foo",
@@ -104,7 +104,7 @@ func TestMemCacheLeak(t *testing.T) {
 // TestHighConcurrency this test is to mainly test concurrency issue in objectCache
 // and dataOverlap-checker.
 func TestHighConcurrency(t *testing.T) {
-	m := NewMemCache(NewMemoryCache(2, true, nil), nil)
+	m := NewMemCache(NewMemoryCache(2, CachePolicyLRU, true, nil), ScanAdmissionAdmit, nil)
 	ctx := context.Background()
 
 	n := 10
@@ -142,3 +142,78 @@ func TestHighConcurrency(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestMemCacheScanAdmission(t *testing.T) {
+	ctx := context.Background()
+	var counter perfcounter.CounterSet
+	ctx = perfcounter.WithCounterSet(ctx, &counter)
+
+	fs, err := NewMemoryFS("test", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+
+	read := func(m *MemCache, path string, policy Policy) bool {
+		vec := &IOVector{
+			FilePath: path,
+			Entries: []IOEntry{
+				{
+					Size: 3,
+					ToCacheData: func(reader io.Reader, data []byte, allocator CacheDataAllocator) (memorycache.CacheData, error) {
+						cacheData := allocator.Alloc(1)
+						cacheData.Bytes()[0] = 42
+						return cacheData, nil
+					},
+				},
+			},
+			Policy: policy,
+		}
+		defer vec.Release()
+		err := m.Read(ctx, vec)
+		assert.Nil(t, err)
+		if vec.Entries[0].done {
+			return true
+		}
+		err = fs.Read(ctx, vec)
+		assert.Nil(t, err)
+		err = m.Update(ctx, vec, false)
+		assert.Nil(t, err)
+		return false
+	}
+	for i := 0; i < 32; i++ {
+		err = fs.Write(ctx, IOVector{
+			FilePath: fmt.Sprintf("%d", i),
+			Entries: []IOEntry{
+				{
+					Size: 3,
+					Data: []byte("foo"),
+				},
+			},
+		})
+		assert.Nil(t, err)
+	}
+
+	// bypass
+	m := NewMemCache(NewMemoryCache(10, CachePolicyS3FIFO, true, nil), ScanAdmissionBypass, nil)
+	assert.False(t, read(m, "0", LargeScan))
+	assert.False(t, read(m, "0", LargeScan))
+	assert.Equal(t, int64(2), counter.FileService.Cache.ScanAdmission.Bypass.Load())
+	assert.False(t, read(m, "0", 0))
+	assert.True(t, read(m, "0", 0))
+	assert.Equal(t, int64(4), counter.FileService.Cache.S3FIFO.Read.Load())
+	assert.Equal(t, int64(1), counter.FileService.Cache.S3FIFO.Hit.Load())
+
+	// demote
+	for _, policy := range []CachePolicy{CachePolicyLRU, CachePolicyS3FIFO} {
+		m = NewMemCache(NewMemoryCache(10, policy, true, nil), ScanAdmissionDemote, nil)
+		assert.False(t, read(m, "0", 0))
+		assert.True(t, read(m, "0", 0))
+		assert.True(t, read(m, "0", 0))
+		for i := 1; i < 32; i++ {
+			assert.False(t, read(m, fmt.Sprintf("%d", i), LargeScan))
+		}
+		assert.True(t, read(m, "0", 0))
+		assert.True(t, m.cache.Used() <= m.cache.Capacity())
+		m.Flush()
+		assert.Equal(t, int64(0), m.cache.Used())
+	}
+	assert.Equal(t, int64(62), counter.FileService.Cache.ScanAdmission.Demote.Load())
+}
@@ -49,7 +49,7 @@ func NewMemoryFS(
 
 	fs := &MemoryFS{
 		name:     name,
-		memCache: NewMemCache(NewMemoryCache(1<<20, true, nil), nil),
+		memCache: NewMemCache(NewMemoryCache(1<<20, CachePolicyLRU, true, nil), ScanAdmissionAdmit, nil),
 		tree: btree.NewBTreeG(func(a, b *_MemFSEntry) bool {
 			return a.FilePath < b.FilePath
 		}),
@@ -60,7 +60,8 @@ func BenchmarkMemoryFSWithMemoryCache(b *testing.B) {
 		fs, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
 		assert.Nil(b, err)
 		fs.caches = append(fs.caches, NewMemCache(
-			NewMemoryCache(128*1024*1024, true, nil),
+			NewMemoryCache(128*1024*1024, CachePolicyLRU, true, nil),
+			ScanAdmissionAdmit,
 			nil,
 		))
 		return fs
@@ -82,7 +83,8 @@ func BenchmarkMemoryFSWithMemoryCacheLowCapacity(b *testing.B) {
 		fs, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
 		assert.Nil(b, err)
 		fs.caches = append(fs.caches, NewMemCache(
-			NewMemoryCache(2*1024*1024, true, nil),
+			NewMemoryCache(2*1024*1024, CachePolicyLRU, true, nil),
+			ScanAdmissionAdmit,
 			nil,
 		))
 		return fs
@@ -17,12 +17,15 @@ package memorycache
 import (
 	"context"
 
+	"github.com/dolthub/maphash"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
 	"github.com/matrixorigin/matrixone/pkg/fileservice/memorycache/lrucache"
 	cache "github.com/matrixorigin/matrixone/pkg/pb/query"
 )
 
 func NewCache(
 	capacity int64,
+	policy Policy,
 	postSet func(key cache.CacheKey, value CacheData),
 	postGet func(key cache.CacheKey, value CacheData),
 	postEvict func(key cache.CacheKey, value CacheData),
@@ -59,10 +62,34 @@ func NewCache(
 			postEvict(key, r)
 		}
 	}
-	c.l = lrucache.New(capacity, setFunc, getFunc, evictFunc)
+	c.policy = policy
+	switch policy {
+	case PolicyS3FIFO:
+		// values are acquired before set, see Set
+		c.postSet = func(key cache.CacheKey, value *Data) {
+			if postSet != nil {
+				postSet(key, RCBytes{d: value, size: &c.size})
+			}
+		}
+		hasher := maphash.NewHasher[cache.CacheKey]()
+		c.fifo = fifocache.NewS3FIFO(
+			int(capacity),
+			evictFunc,
+			getFunc,
+			func(key cache.CacheKey) uint8 {
+				return uint8(hasher.Hash(key))
+			},
+		)
+	default:
+		c.l = lrucache.New(capacity, setFunc, getFunc, evictFunc)
+	}
 	return &c
 }
 
+func (c *Cache) Policy() Policy {
+	return c.policy
+}
+
 func (c *Cache) Alloc(n int) CacheData {
 	d := newData(n, &c.size)
 	return RCBytes{d: d, size: &c.size}
@@ -71,7 +98,13 @@ func (c *Cache) Alloc(n int) CacheData {
 func (c *Cache) Get(ctx context.Context, key cache.CacheKey) (CacheData, bool) {
 	var r RCBytes
 
-	v, ok := c.l.Get(ctx, key)
+	var v *Data
+	var ok bool
+	if c.fifo != nil {
+		v, ok = c.fifo.Get(key)
+	} else {
+		v, ok = c.l.Get(ctx, key)
+	}
 	if ok { // find the value, set the value to the return value
 		r.d = v
 		r.size = &c.size
@@ -80,13 +113,49 @@ func (c *Cache) Get(ctx context.Context, key cache.CacheKey) (CacheData, bool) {
 }
 
 func (c *Cache) Set(ctx context.Context, key cache.CacheKey, value CacheData) error {
-	if r, ok := value.(RCBytes); ok {
+	c.set(ctx, key, value, false)
+	return nil
+}
+
+// SetDemoted sets the value as data not expected to be read again, it will be evicted before others
+func (c *Cache) SetDemoted(ctx context.Context, key cache.CacheKey, value CacheData) error {
+	c.set(ctx, key, value, true)
+	return nil
+}
+
+func (c *Cache) set(ctx context.Context, key cache.CacheKey, value CacheData, demoted bool) {
+	r, ok := value.(RCBytes)
+	if !ok {
+		return
+	}
+	if c.fifo != nil {
+		// acquire before set, the value may be evicted once set
+		r.d.acquire()
+		var set bool
+		if demoted {
+			set = c.fifo.SetDemoted(key, r.d, len(r.d.Buf()))
+		} else {
+			set = c.fifo.Set(key, r.d, len(r.d.Buf()))
+		}
+		if !set {
+			r.d.release(&c.size)
+			return
+		}
+		c.postSet(key, r.d)
+		return
+	}
+	if demoted {
+		c.l.SetDemoted(ctx, key, r.d)
+	} else {
 		c.l.Set(ctx, key, r.d)
 	}
-	return nil
 }
 
 func (c *Cache) Flush() {
+	if c.fifo != nil {
+		c.fifo.Flush()
+		return
+	}
 	c.l.Flush()
 }
 
@@ -95,15 +164,21 @@ func (c *Cache) Size() int64 {
 }
 
 func (c *Cache) Capacity() int64 {
+	if c.fifo != nil {
+		return int64(c.fifo.Capacity())
+	}
 	return c.l.Capacity()
 }
 
 func (c *Cache) Used() int64 {
+	if c.fifo != nil {
+		return int64(c.fifo.Used())
+	}
 	return c.l.Used()
 }
 
 func (c *Cache) Available() int64 {
-	return c.l.Available()
+	return c.Capacity() - c.Used()
 }
 
 func (c *Cache) DeletePaths(_ context.Context, _ []string) {
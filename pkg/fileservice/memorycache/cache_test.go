@@ -24,7 +24,7 @@ import (
 
 func TestCache(t *testing.T) {
 	// test New with postSet, postGet, postEvict
-	c := NewCache(100, func(key cache.CacheKey, value CacheData) {},
+	c := NewCache(100, PolicyLRU, func(key cache.CacheKey, value CacheData) {},
 		func(key cache.CacheKey, value CacheData) {}, func(key cache.CacheKey, value CacheData) {})
 	// test Alloc and Set
 	key := cache.CacheKey{Path: "x", Sz: 1}
@@ -36,3 +36,43 @@ func TestCache(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, data, data2)
 }
+
+func TestS3FIFOCache(t *testing.T) {
+	ctx := context.TODO()
+	var evicted []cache.CacheKey
+	c := NewCache(10, PolicyS3FIFO, nil, nil, func(key cache.CacheKey, value CacheData) {
+		evicted = append(evicted, key)
+	})
+	require.Equal(t, PolicyS3FIFO, c.Policy())
+
+	key := cache.CacheKey{Path: "x", Sz: 1}
+	data := c.Alloc(1)
+	require.NoError(t, c.Set(ctx, key, data))
+	// set again is a no-op
+	require.NoError(t, c.Set(ctx, key, data))
+	for i := 0; i < 2; i++ {
+		data2, ok := c.Get(ctx, key)
+		require.True(t, ok)
+		require.Equal(t, data.Bytes(), data2.Bytes())
+		data2.Release()
+	}
+	require.Equal(t, int64(1), c.Used())
+	require.Equal(t, int64(9), c.Available())
+
+	// data read only once are evicted before the frequently read ones
+	for i := 0; i < 20; i++ {
+		d := c.Alloc(1)
+		require.NoError(t, c.SetDemoted(ctx, cache.CacheKey{Path: "y", Offset: int64(i), Sz: 1}, d))
+		d.Release()
+	}
+	data2, ok := c.Get(ctx, key)
+	require.True(t, ok)
+	data2.Release()
+	require.NotContains(t, evicted, key)
+	require.True(t, c.Used() <= c.Capacity())
+
+	data.Release()
+	c.Flush()
+	require.Equal(t, int64(0), c.Used())
+	require.Equal(t, int64(0), c.Size())
+}
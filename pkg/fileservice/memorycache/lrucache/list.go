@@ -98,3 +98,11 @@ func (l *list[K, V]) PushFront(v *lruItem[K, V]) *lruItem[K, V] {
 	l.lazyInit()
 	return l.insertValue(v, &l.root)
 }
+
+// PushBack inserts a new element e with value v at the back of list l and returns e.
+func (l *list[K, V]) PushBack(v *lruItem[K, V]) *lruItem[K, V] {
+	l.Lock()
+	defer l.Unlock()
+	l.lazyInit()
+	return l.insertValue(v, l.root.prev)
+}
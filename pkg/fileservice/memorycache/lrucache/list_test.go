@@ -36,6 +36,12 @@ func TestList(t *testing.T) {
 	if l.Back().Key != 1 {
 		t.Fatal("Back failed")
 	}
+	// test PushBack
+	l.PushBack(&lruItem[int, Bytes]{Key: 2})
+	if l.Back().Key != 2 {
+		t.Fatal("PushBack failed")
+	}
 	// test Remove
 	l.Remove(l.Back())
+	l.Remove(l.Back())
 }
@@ -77,7 +77,16 @@ func (l *LRU[K, V]) Set(ctx context.Context, key K, value V) {
 
 	h := l.hash(key)
 	s = &l.shards[h%uint64(len(l.shards))]
-	s.Set(ctx, h, key, value)
+	s.Set(ctx, h, key, value, false)
+}
+
+// SetDemoted sets the value at the back of the list, it's the first to be evicted
+func (l *LRU[K, V]) SetDemoted(ctx context.Context, key K, value V) {
+	var s *shard[K, V]
+
+	h := l.hash(key)
+	s = &l.shards[h%uint64(len(l.shards))]
+	s.Set(ctx, h, key, value, true)
 }
 
 func (l *LRU[K, V]) Get(ctx context.Context, key K) (value V, ok bool) {
@@ -36,6 +36,22 @@ func TestLRU(t *testing.T) {
 	assert.Equal(t, Bytes([]byte{43}), val)
 }
 
+func TestLRUSetDemoted(t *testing.T) {
+	ctx := context.Background()
+	var evicted []int
+	l := New[int, Bytes](2, nil, nil, func(key int, _ Bytes) {
+		evicted = append(evicted, key)
+	})
+	s := &l.shards[0]
+	s.capacity = 2
+
+	s.Set(ctx, l.hasher.Hash(1), 1, []byte{1}, false)
+	s.Set(ctx, l.hasher.Hash(2), 2, []byte{2}, true)
+	s.Set(ctx, l.hasher.Hash(3), 3, []byte{3}, false)
+	// the demoted one is evicted first
+	assert.Equal(t, []int{2}, evicted)
+}
+
 func TestLRUCallbacks(t *testing.T) {
 	ctx := context.Background()
 
@@ -58,14 +74,14 @@ func TestLRUCallbacks(t *testing.T) {
 
 	// PostSet
 	h := l.hasher.Hash(1)
-	s.Set(ctx, h, 1, []byte{42})
+	s.Set(ctx, h, 1, []byte{42}, false)
 	assert.True(t, postSetInvokedMap[1])
 	postSetInvokedMap[1] = false // resetting
 	assert.False(t, postEvictInvokedMap[1])
 
 	// PostSet and PostEvict
 	h = l.hasher.Hash(2)
-	s.Set(ctx, h, 2, []byte{44})
+	s.Set(ctx, h, 2, []byte{44}, false)
 	assert.True(t, postEvictInvokedMap[1])        //postEvictInvokedMap is updated by PostEvict
 	assert.Equal(t, []byte{42}, evictEntryMap[1]) //evictEntryMap is updated by PostEvict
 }
@@ -22,7 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 )
 
-func (s *shard[K, V]) Set(ctx context.Context, h uint64, key K, value V) {
+func (s *shard[K, V]) Set(ctx context.Context, h uint64, key K, value V, demoted bool) {
 	size := int64(len(value.Bytes()))
 	item := s.allocItem()
 	item.h = h
@@ -37,7 +37,12 @@ func (s *shard[K, V]) Set(ctx context.Context, h uint64, key K, value V) {
 	s.kv.Set(h, key, item)
 	s.size += size
 	atomic.AddInt64(s.totalSize, size)
-	s.evicts.PushFront(item)
+	if demoted {
+		// evicted first
+		s.evicts.PushBack(item)
+	} else {
+		s.evicts.PushFront(item)
+	}
 	if s.postSet != nil {
 		s.postSet(key, value)
 	}
@@ -112,6 +117,7 @@ func (s *shard[K, V]) Flush() {
 		}
 		s.freeItem(elem)
 	}
+	atomic.AddInt64(s.totalSize, -s.size)
 	s.size = 0
 	s.evicts = newList[K, V]()
 	s.kv = hashmap.New[K, lruItem[K, V]](int(s.capacity))
@@ -17,6 +17,7 @@ package memorycache
 import (
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
 	"github.com/matrixorigin/matrixone/pkg/fileservice/memorycache/lrucache"
 	cache "github.com/matrixorigin/matrixone/pkg/pb/query"
 )
@@ -30,10 +31,25 @@ type CacheData interface {
 }
 
 type Cache struct {
-	size atomic.Int64
+	size   atomic.Int64
+	policy Policy
+	// one of l and fifo is used, by the policy
 	l    *lrucache.LRU[cache.CacheKey, *Data]
+	fifo *fifocache.Cache[cache.CacheKey, *Data]
+	// called after set to fifo
+	postSet func(key cache.CacheKey, value *Data)
 }
 
+// Policy is the eviction policy of Cache
+type Policy uint8
+
+const (
+	// PolicyLRU evicts the least recently set data
+	PolicyLRU Policy = iota
+	// PolicyS3FIFO evicts by S3-FIFO, data read only once are evicted before the frequently read ones
+	PolicyS3FIFO
+)
+
 // RCBytes represents a reference counting data from cache
 // it is immutable, caller should not modify it and call Release after use
 type RCBytes struct {
@@ -39,6 +39,10 @@ type ObjectStorageArguments struct {
 	Region    string   `toml:"region"`
 	CertFiles []string `toml:"cert-files"`
 
+	// Provider selects the object storage implementation [s3|azure|gcs]
+	// if empty, it's inferred from the endpoint
+	Provider string `toml:"provider"`
+
 	// credentials
 	RoleARN         string `json:"-" toml:"role-arn"`
 	BearerToken     string `json:"-" toml:"bearer-token"`
@@ -49,8 +53,23 @@ type ObjectStorageArguments struct {
 	RoleSessionName string `json:"-" toml:"role-session-name"`
 	SecurityToken   string `json:"-" toml:"security-token"`
 	SessionToken    string `json:"-" toml:"session-token"`
+
+	// azure
+	AccountName string `toml:"account-name"`
+	AccountKey  string `json:"-" toml:"account-key"`
+	SASToken    string `json:"-" toml:"sas-token"`
+
+	// gcs service account
+	CredentialsFile string `toml:"credentials-file"`
+	CredentialsJSON string `json:"-" toml:"credentials-json"`
 }
 
+const (
+	ObjectStorageProviderS3    = "s3"
+	ObjectStorageProviderAzure = "azure"
+	ObjectStorageProviderGCS   = "gcs"
+)
+
 func (o ObjectStorageArguments) String() string {
 	bs, err := json.Marshal(o)
 	if err != nil {
@@ -95,6 +114,8 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.Region = value
 		case "cert-files":
 			o.CertFiles = strings.Split(value, ",")
+		case "provider":
+			o.Provider = strings.ToLower(value)
 
 		case "role-arn":
 			o.RoleARN = value
@@ -115,6 +136,18 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 		case "token", "session-token":
 			o.SessionToken = value
 
+		case "account", "account-name":
+			o.AccountName = value
+		case "account-key":
+			o.AccountKey = value
+		case "sas-token":
+			o.SASToken = value
+
+		case "credentials-file":
+			o.CredentialsFile = value
+		case "credentials-json":
+			o.CredentialsJSON = value
+
 		default:
 			return moerr.NewInvalidInputNoCtx("invalid S3 argument: %s", pair)
 		}
@@ -140,7 +173,7 @@ func (o *ObjectStorageArguments) validate() error {
 	}
 
 	// region
-	if o.Region == "" {
+	if o.Region == "" && o.provider() == ObjectStorageProviderS3 {
 		// try to get region from bucket
 		// only works for AWS S3
 		resp, err := http.Head("https://" + o.Bucket + ".s3.amazonaws.com")
@@ -159,6 +192,20 @@ func (o *ObjectStorageArguments) validate() error {
 	return nil
 }
 
+// provider returns the configured provider or the one inferred from endpoint
+func (o *ObjectStorageArguments) provider() string {
+	if o.Provider != "" {
+		return o.Provider
+	}
+	switch {
+	case strings.Contains(o.Endpoint, "blob.core.windows.net"):
+		return ObjectStorageProviderAzure
+	case strings.Contains(o.Endpoint, "storage.googleapis.com"):
+		return ObjectStorageProviderGCS
+	}
+	return ObjectStorageProviderS3
+}
+
 func (o *ObjectStorageArguments) shouldLoadDefaultCredentials() bool {
 
 	// default credentials enabled
@@ -54,3 +54,43 @@ func TestObjectStorageArguments(t *testing.T) {
 
 	})
 }
+
+func TestObjectStorageArgumentsProvider(t *testing.T) {
+	var args ObjectStorageArguments
+	err := args.SetFromString([]string{
+		"provider=Azure",
+		"account-name=foo",
+		"account-key=secret-key",
+		"sas-token=secret-sas",
+		"credentials-file=/path/to/file",
+		"credentials-json=secret-json",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args.provider() != ObjectStorageProviderAzure ||
+		args.AccountName != "foo" ||
+		args.AccountKey != "secret-key" ||
+		args.SASToken != "secret-sas" ||
+		args.CredentialsFile != "/path/to/file" ||
+		args.CredentialsJSON != "secret-json" {
+		t.Fatalf("got %+v", args)
+	}
+	if strings.Contains(args.String(), "secret") {
+		t.Fatal()
+	}
+
+	for endpoint, provider := range map[string]string{
+		"https://foo.blob.core.windows.net":  ObjectStorageProviderAzure,
+		"https://storage.googleapis.com":     ObjectStorageProviderGCS,
+		"https://s3.us-east-1.amazonaws.com": ObjectStorageProviderS3,
+		"":                                   ObjectStorageProviderS3,
+	} {
+		args := ObjectStorageArguments{
+			Endpoint: endpoint,
+		}
+		if args.provider() != provider {
+			t.Fatalf("endpoint %s: got %s", endpoint, args.provider())
+		}
+	}
+}
@@ -22,6 +22,9 @@ const (
 	SkipDiskCacheReads
 	SkipDiskCacheWrites
 	SkipFullFilePreloads
+	// LargeScan marks reads from large sequential scans,
+	// caches admit the data by CacheConfig.ScanAdmission
+	LargeScan
 )
 
 const (
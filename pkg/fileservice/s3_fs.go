@@ -81,6 +81,18 @@ func NewS3FS(
 	var err error
 	switch {
 
+	case args.provider() == ObjectStorageProviderAzure:
+		fs.storage, err = NewAzureBlobSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
+	case args.provider() == ObjectStorageProviderGCS:
+		fs.storage, err = NewGCSSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
 	case strings.Contains(args.Endpoint, "ctyunapi.cn"):
 		fs.storage, err = NewMinioSDK(ctx, args, perfCounterSets)
 		if err != nil {
@@ -117,6 +129,9 @@ func NewS3FS(
 
 func (s *S3FS) initCaches(ctx context.Context, config CacheConfig) error {
 	config.setDefaults()
+	if err := config.validate(ctx); err != nil {
+		return err
+	}
 
 	// Init the remote cache first, because the callback needs to be set for mem and disk cache.
 	if config.RemoteCacheEnabled {
@@ -132,7 +147,8 @@ func (s *S3FS) initCaches(ctx context.Context, config CacheConfig) error {
 	// memory cache
 	if *config.MemoryCapacity > DisableCacheCapacity {
 		s.memCache = NewMemCache(
-			NewMemoryCache(int64(*config.MemoryCapacity), true, &config.CacheCallbacks),
+			NewMemoryCache(int64(*config.MemoryCapacity), config.MemoryPolicy, true, &config.CacheCallbacks),
+			config.ScanAdmission,
 			s.perfCounterSets,
 		)
 		logutil.Info("fileservice: memory cache initialized",
@@ -148,6 +164,8 @@ func (s *S3FS) initCaches(ctx context.Context, config CacheConfig) error {
 			ctx,
 			*config.DiskPath,
 			int(*config.DiskCapacity),
+			config.DiskPolicy,
+			config.ScanAdmission,
 			s.perfCounterSets,
 		)
 		if err != nil {
@@ -723,7 +741,7 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) (err error) {
 		len(contentBytes) > 0 &&
 		s.diskCache != nil &&
 		!vector.Policy.Any(SkipDiskCacheWrites) {
-		if err := s.diskCache.SetFile(ctx, vector.FilePath, func(context.Context) (io.ReadCloser, error) {
+		if err := s.diskCache.setFile(ctx, vector.FilePath, vector.Policy, func(context.Context) (io.ReadCloser, error) {
 			return io.NopCloser(bytes.NewReader(contentBytes)), nil
 		}); err != nil {
 			return err
@@ -0,0 +1,451 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+type Tier uint8
+
+const (
+	HotTier Tier = iota
+	ColdTier
+)
+
+func (t Tier) String() string {
+	switch t {
+	case HotTier:
+		return "hot"
+	case ColdTier:
+		return "cold"
+	}
+	return "unknown"
+}
+
+// TieringPolicy decides which files should be moved to the cold tier
+type TieringPolicy struct {
+	// ColdAfter moves files not accessed for this duration, zero disables
+	ColdAfter time.Duration
+	// ColdBefore moves files created before this time, zero disables
+	ColdBefore time.Time
+	// Interval is the interval of checking files to move
+	Interval time.Duration
+}
+
+// ShouldMove reports whether a hot file should be moved to the cold tier.
+// files idle for ColdAfter since the last access, or the creation if never accessed, are moved,
+// and so are files created before ColdBefore however recently accessed.
+// lastAccess is zero if unknown, files with neither time known are kept.
+func (p TieringPolicy) ShouldMove(createdAt time.Time, lastAccess time.Time, now time.Time) bool {
+	if !p.ColdBefore.IsZero() && !createdAt.IsZero() && createdAt.Before(p.ColdBefore) {
+		return true
+	}
+	idleSince := createdAt
+	if lastAccess.After(idleSince) {
+		idleSince = lastAccess
+	}
+	if idleSince.IsZero() {
+		return false
+	}
+	return p.ColdAfter > 0 && now.Sub(idleSince) >= p.ColdAfter
+}
+
+// maxKnownColdFiles limits the files remembered to be in the cold tier, the others are probed in the hot tier first
+const maxKnownColdFiles = 1 << 20
+
+// TieredFS is a FileService storing files in a hot and a cold FileService under the same paths.
+// files are written to the hot tier and moved to the cold tier by Move.
+// reads are served by whichever tier holding the file, through the caches of that tier.
+// the tier of a file is resolved by probing the hot tier first, and remembered once found in the cold tier.
+// reads of hot files are recorded in the hot tier for the policy, see FlushAccess.
+type TieredFS struct {
+	name   string
+	hot    FileService
+	cold   FileService
+	policy TieringPolicy
+
+	// files are immutable, Move and Delete of the same file are serialized by locking the path
+	locks *IOLocks
+
+	// instance identifies the access record of this instance
+	instance string
+	cancel   context.CancelFunc
+	flushMu  sync.Mutex
+
+	mu struct {
+		sync.Mutex
+		// files known to be in the cold tier, at most maxColdFiles
+		cold         map[string]struct{}
+		maxColdFiles int
+		// last access of hot files read by this instance within ColdAfter
+		accessed map[string]time.Time
+		// the access record flushed last time
+		accessRecord string
+	}
+}
+
+var _ FileService = new(TieredFS)
+var _ CachingFileService = new(TieredFS)
+
+// NewTieredFS returns a TieredFS, cold must have the same name as hot
+func NewTieredFS(hot FileService, cold FileService, policy TieringPolicy) (*TieredFS, error) {
+	if hot.Name() != cold.Name() {
+		return nil, moerr.NewInvalidInputNoCtx("cold tier name %s not match %s", cold.Name(), hot.Name())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	fs := &TieredFS{
+		name:     hot.Name(),
+		hot:      hot,
+		cold:     cold,
+		policy:   policy,
+		locks:    NewIOLocks(),
+		instance: uuid.NewString(),
+		cancel:   cancel,
+	}
+	fs.mu.cold = make(map[string]struct{})
+	fs.mu.maxColdFiles = maxKnownColdFiles
+	fs.mu.accessed = make(map[string]time.Time)
+	if policy.ColdAfter > 0 {
+		go fs.flushAccessLoop(ctx)
+	}
+	return fs, nil
+}
+
+func (t *TieredFS) Name() string {
+	return t.name
+}
+
+func (t *TieredFS) Policy() TieringPolicy {
+	return t.policy
+}
+
+func (t *TieredFS) Close() {
+	t.cancel()
+	t.hot.Close()
+	t.cold.Close()
+}
+
+func (t *TieredFS) FlushCache() {
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if fs, ok := fs.(CachingFileService); ok {
+			fs.FlushCache()
+		}
+	}
+}
+
+func (t *TieredFS) SetAsyncUpdate(b bool) {
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if fs, ok := fs.(CachingFileService); ok {
+			fs.SetAsyncUpdate(b)
+		}
+	}
+}
+
+func (t *TieredFS) Write(ctx context.Context, vector IOVector) error {
+	path, err := ParsePathAtService(vector.FilePath, t.name)
+	if err != nil {
+		return err
+	}
+	if t.isCold(path.File) {
+		return moerr.NewFileAlreadyExistsNoCtx(vector.FilePath)
+	}
+	return t.hot.Write(ctx, vector)
+}
+
+func (t *TieredFS) Read(ctx context.Context, vector *IOVector) error {
+	path, err := ParsePathAtService(vector.FilePath, t.name)
+	if err != nil {
+		return err
+	}
+	if t.isCold(path.File) {
+		return t.cold.Read(ctx, vector)
+	}
+	err = t.hot.Read(ctx, vector)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		// may be moved by this or another instance
+		if err := t.cold.Read(ctx, vector); err != nil {
+			return err
+		}
+		t.setCold(path.File)
+		return nil
+	}
+	if err == nil {
+		t.touch(path.File)
+	}
+	return err
+}
+
+func (t *TieredFS) ReadCache(ctx context.Context, vector *IOVector) error {
+	path, err := ParsePathAtService(vector.FilePath, t.name)
+	if err != nil {
+		return err
+	}
+	if t.isCold(path.File) {
+		return t.cold.ReadCache(ctx, vector)
+	}
+	return t.hot.ReadCache(ctx, vector)
+}
+
+func (t *TieredFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	path, err := ParsePathAtService(dirPath, t.name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := t.hot.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Trim(path.File, "/") == "" {
+		// access records are internal
+		entries = slices.DeleteFunc(entries, func(entry DirEntry) bool {
+			return entry.IsDir && entry.Name == accessRecordDir
+		})
+	}
+	coldEntries, err := t.cold.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	// files being moved exist in both tiers
+	names := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		names[entry.Name] = true
+	}
+	for _, entry := range coldEntries {
+		if !names[entry.Name] {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// Delete deletes files from both tiers
+func (t *TieredFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		path, err := ParsePathAtService(filePath, t.name)
+		if err != nil {
+			return err
+		}
+		unlock := t.lockFile(path.File)
+		err = t.deleteFile(ctx, filePath)
+		t.mu.Lock()
+		delete(t.mu.cold, path.File)
+		delete(t.mu.accessed, path.File)
+		t.mu.Unlock()
+		unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TieredFS) deleteFile(ctx context.Context, filePath string) error {
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if err := fs.Delete(ctx, filePath); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TieredFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	path, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return nil, err
+	}
+	if t.isCold(path.File) {
+		return t.cold.StatFile(ctx, filePath)
+	}
+	entry, err := t.hot.StatFile(ctx, filePath)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		entry, err = t.cold.StatFile(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		t.setCold(path.File)
+		return entry, nil
+	}
+	return entry, err
+}
+
+func (t *TieredFS) PrefetchFile(ctx context.Context, filePath string) error {
+	path, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return err
+	}
+	if t.isCold(path.File) {
+		return t.cold.PrefetchFile(ctx, filePath)
+	}
+	err = t.hot.PrefetchFile(ctx, filePath)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return t.cold.PrefetchFile(ctx, filePath)
+	}
+	return err
+}
+
+// Tier returns the tier holding the file
+func (t *TieredFS) Tier(ctx context.Context, filePath string) (Tier, error) {
+	path, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return 0, err
+	}
+	if t.isCold(path.File) {
+		return ColdTier, nil
+	}
+	if _, err := t.hot.StatFile(ctx, filePath); err == nil {
+		return HotTier, nil
+	} else if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return 0, err
+	}
+	if _, err := t.cold.StatFile(ctx, filePath); err != nil {
+		return 0, err
+	}
+	t.setCold(path.File)
+	return ColdTier, nil
+}
+
+// Move moves a hot file to the cold tier, moving a cold file is a no-op.
+// the file is copied before deleted from the hot tier, so concurrent reads always find it in one of the tiers.
+func (t *TieredFS) Move(ctx context.Context, filePath string) error {
+	path, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return err
+	}
+	unlock := t.lockFile(path.File)
+	defer unlock()
+
+	if t.isCold(path.File) {
+		return nil
+	}
+
+	entry, err := t.hot.StatFile(ctx, filePath)
+	if err != nil {
+		return err
+	}
+
+	var r io.ReadCloser
+	if err := t.hot.Read(ctx, &IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size:              entry.Size,
+				ReadCloserForRead: &r,
+			},
+		},
+		Policy: SkipAllCache,
+	}); err != nil {
+		return err
+	}
+	err = t.cold.Write(ctx, IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size:           entry.Size,
+				ReaderForWrite: r,
+			},
+		},
+		Policy: SkipAllCache,
+	})
+	r.Close()
+	if moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+		// left by an interrupted move
+		coldEntry, err := t.cold.StatFile(ctx, filePath)
+		if err != nil {
+			return err
+		}
+		if coldEntry.Size != entry.Size {
+			return moerr.NewInternalErrorNoCtx("cold tier file %s size %d not match %d", filePath, coldEntry.Size, entry.Size)
+		}
+	} else if err != nil {
+		return err
+	}
+
+	t.setCold(path.File)
+	t.forgetAccess(path.File)
+	if err := t.hot.Delete(ctx, filePath); err != nil &&
+		!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
+
+	logutil.Info("fileservice: file moved to cold tier",
+		zap.Any("fs-name", t.name),
+		zap.Any("path", filePath),
+		zap.Any("size", entry.Size),
+	)
+	return nil
+}
+
+func (t *TieredFS) lockFile(file string) (unlock func()) {
+	for {
+		unlock, wait := t.locks.Lock(IOLockKey{
+			Path: file,
+		})
+		if unlock != nil {
+			return unlock
+		}
+		wait()
+	}
+}
+
+func (t *TieredFS) isCold(file string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.mu.cold[file]
+	return ok
+}
+
+func (t *TieredFS) setCold(file string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.mu.cold[file]; ok {
+		return
+	}
+	if len(t.mu.cold) >= t.mu.maxColdFiles {
+		// forget a random one, it is found by probing again
+		for evicted := range t.mu.cold {
+			delete(t.mu.cold, evicted)
+			break
+		}
+	}
+	t.mu.cold[file] = struct{}{}
+}
+
+// GetTieredFS returns the TieredFS of fs if any
+func GetTieredFS(fs FileService) (*TieredFS, bool) {
+	switch fs := fs.(type) {
+	case *TieredFS:
+		return fs, true
+	case *EncryptedFS:
+		// cipher texts are moved as is
+		return GetTieredFS(fs.upstream)
+	}
+	return nil, false
+}
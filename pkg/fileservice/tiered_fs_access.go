@@ -0,0 +1,199 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// The last access of hot files is kept in access records under accessRecordDir of the hot tier,
+// so the instance moving files sees the reads of all instances sharing the storage.
+// every instance flushes the files it read to its own record periodically, replacing the previous one.
+
+const accessRecordDir = "tiering-access"
+
+// accessFlushInterval is the interval of flushing access records,
+// accesses are seen by other instances at most this duration later
+const accessFlushInterval = time.Minute
+
+func (t *TieredFS) touch(file string) {
+	if t.policy.ColdAfter <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mu.accessed[file] = time.Now()
+}
+
+func (t *TieredFS) forgetAccess(file string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.mu.accessed, file)
+}
+
+func (t *TieredFS) flushAccessLoop(ctx context.Context) {
+	ticker := time.NewTicker(accessFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.FlushAccess(ctx); err != nil {
+				logutil.Warn("fileservice: flush access record failed",
+					zap.Any("fs-name", t.name),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// FlushAccess writes the files read by this instance to its access record,
+// and deletes the records not flushed for ColdAfter, which are left by stopped instances
+func (t *TieredFS) FlushAccess(ctx context.Context) error {
+	t.flushMu.Lock()
+	defer t.flushMu.Unlock()
+
+	now := time.Now()
+	t.mu.Lock()
+	accessed := make(map[string]int64, len(t.mu.accessed))
+	for file, at := range t.mu.accessed {
+		if t.policy.ColdAfter > 0 && now.Sub(at) >= t.policy.ColdAfter {
+			// idle anyway
+			delete(t.mu.accessed, file)
+			continue
+		}
+		accessed[file] = at.UnixMilli()
+	}
+	prev := t.mu.accessRecord
+	t.mu.Unlock()
+
+	var record string
+	if len(accessed) > 0 {
+		data, err := json.Marshal(accessed)
+		if err != nil {
+			return err
+		}
+		record = path.Join(accessRecordDir, fmt.Sprintf("%s_%d", t.instance, now.UnixNano()))
+		if err := t.hot.Write(ctx, IOVector{
+			FilePath: record,
+			Entries: []IOEntry{
+				{
+					Size: int64(len(data)),
+					Data: data,
+				},
+			},
+			Policy: SkipAllCache,
+		}); err != nil {
+			return err
+		}
+	}
+	if prev != "" {
+		if err := t.hot.Delete(ctx, prev); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+	}
+	t.mu.Lock()
+	t.mu.accessRecord = record
+	t.mu.Unlock()
+
+	if t.policy.ColdAfter <= 0 {
+		return nil
+	}
+	entries, err := t.hot.List(ctx, accessRecordDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		flushedAt, ok := parseAccessRecordName(entry.Name)
+		if entry.IsDir || !ok || now.Sub(flushedAt) < t.policy.ColdAfter {
+			continue
+		}
+		if err := t.hot.Delete(ctx, path.Join(accessRecordDir, entry.Name)); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastAccess returns the last access of hot files read by any instance sharing the storage,
+// files not read since flushed last time by other instances are absent
+func (t *TieredFS) LastAccess(ctx context.Context) (map[string]time.Time, error) {
+	entries, err := t.hot.List(ctx, accessRecordDir)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]time.Time)
+	for _, entry := range entries {
+		if _, ok := parseAccessRecordName(entry.Name); entry.IsDir || !ok {
+			continue
+		}
+		vec := &IOVector{
+			FilePath: path.Join(accessRecordDir, entry.Name),
+			Entries:  []IOEntry{{Size: -1}},
+			Policy:   SkipAllCache,
+		}
+		if err := t.hot.Read(ctx, vec); moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			// replaced by a newer one
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		var accessed map[string]int64
+		if err := json.Unmarshal(vec.Entries[0].Data, &accessed); err != nil {
+			return nil, err
+		}
+		for file, ms := range accessed {
+			if at := time.UnixMilli(ms); at.After(ret[file]) {
+				ret[file] = at
+			}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for file, at := range t.mu.accessed {
+		if at.After(ret[file]) {
+			ret[file] = at
+		}
+	}
+	return ret, nil
+}
+
+// parseAccessRecordName returns the flush time of the access record named <instance>_<unix nano>
+func parseAccessRecordName(name string) (time.Time, bool) {
+	i := strings.LastIndexByte(name, '_')
+	if i < 0 {
+		return time.Time{}, false
+	}
+	ns, err := strconv.ParseInt(name[i+1:], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ns), true
+}
@@ -0,0 +1,306 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTieredFS(t *testing.T, name string) *TieredFS {
+	hot, err := NewMemoryFS(name, CacheConfig{
+		MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+	}, nil)
+	require.NoError(t, err)
+	cold, err := NewLocalFS(context.Background(), name, t.TempDir(), DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	fs, err := NewTieredFS(hot, cold, TieringPolicy{})
+	require.NoError(t, err)
+	return fs
+}
+
+func TestTieredFS(t *testing.T) {
+	testFileService(t, 0, func(name string) FileService {
+		return newTestTieredFS(t, name)
+	})
+}
+
+func TestTieredFSMove(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestTieredFS(t, "test")
+
+	write := func(path string, data string) {
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: path,
+			Entries:  []IOEntry{{Size: int64(len(data)), Data: []byte(data)}},
+		}))
+	}
+	read := func(fs FileService, path string, offset, size int64) (string, error) {
+		vec := &IOVector{
+			FilePath: path,
+			Entries:  []IOEntry{{Offset: offset, Size: size}},
+		}
+		if err := fs.Read(ctx, vec); err != nil {
+			return "", err
+		}
+		return string(vec.Entries[0].Data), nil
+	}
+
+	write("dir/a", "0123456789")
+	write("dir/b", "foo")
+	tier, err := fs.Tier(ctx, "dir/a")
+	require.NoError(t, err)
+	require.Equal(t, HotTier, tier)
+
+	require.NoError(t, fs.Move(ctx, "dir/a"))
+	// moving again is a no-op
+	require.NoError(t, fs.Move(ctx, "dir/a"))
+	tier, err = fs.Tier(ctx, "dir/a")
+	require.NoError(t, err)
+	require.Equal(t, ColdTier, tier)
+	_, err = read(fs.hot, "dir/a", 0, -1)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+	data, err := read(fs.cold, "dir/a", 0, -1)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", data)
+
+	// transparent reads
+	data, err = read(fs, "dir/a", 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, "234", data)
+	entry, err := fs.StatFile(ctx, "dir/a")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), entry.Size)
+	entries, err := fs.List(ctx, "dir")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, "a", entries[0].Name)
+	require.Equal(t, "b", entries[1].Name)
+
+	// write-once across tiers
+	err = fs.Write(ctx, IOVector{
+		FilePath: "dir/a",
+		Entries:  []IOEntry{{Size: 1, Data: []byte("x")}},
+	})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists))
+
+	// another instance sharing the tiers finds moved files in cold tier
+	fs2, err := NewTieredFS(fs.hot, fs.cold, TieringPolicy{})
+	require.NoError(t, err)
+	data, err = read(fs2, "dir/a", 0, -1)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", data)
+	require.True(t, fs2.isCold("dir/a"))
+
+	// delete from both tiers
+	require.NoError(t, fs.Delete(ctx, "dir/a", "dir/b", "dir/not-exists"))
+	for _, f := range []FileService{fs.hot, fs.cold} {
+		entries, err := f.List(ctx, "dir")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(entries))
+	}
+	_, err = read(fs, "dir/a", 0, -1)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+}
+
+func TestTieredFSConcurrentMoveAndDelete(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestTieredFS(t, "test")
+	for i := 0; i < 100; i++ {
+		path := "foo"
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: path,
+			Entries:  []IOEntry{{Size: 3, Data: []byte("foo")}},
+		}))
+		wg := new(sync.WaitGroup)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			err := fs.Move(ctx, path)
+			if err != nil {
+				assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, fs.Delete(ctx, path))
+		}()
+		wg.Wait()
+		// no orphan in any tier
+		for _, f := range []FileService{fs.hot, fs.cold} {
+			_, err := f.StatFile(ctx, path)
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+		}
+	}
+}
+
+func TestTieringPolicy(t *testing.T) {
+	now := time.Now()
+	boundary := now.Add(-time.Hour * 24 * 30)
+	policy := TieringPolicy{
+		ColdAfter:  time.Hour * 24 * 7,
+		ColdBefore: boundary,
+	}
+	// before the boundary however recently accessed
+	assert.True(t, policy.ShouldMove(boundary.Add(-time.Second), now, now))
+	assert.False(t, TieringPolicy{ColdBefore: boundary}.ShouldMove(boundary.Add(time.Second), time.Time{}, now))
+	// idle since creation
+	assert.True(t, policy.ShouldMove(now.Add(-policy.ColdAfter), time.Time{}, now))
+	assert.False(t, policy.ShouldMove(now.Add(-time.Hour), time.Time{}, now))
+	// idle since the last access
+	assert.False(t, policy.ShouldMove(now.Add(-policy.ColdAfter*2), now.Add(-time.Hour), now))
+	assert.True(t, policy.ShouldMove(now.Add(-policy.ColdAfter*2), now.Add(-policy.ColdAfter), now))
+	assert.True(t, policy.ShouldMove(time.Time{}, now.Add(-policy.ColdAfter), now))
+	// unknown creation and access time
+	assert.False(t, policy.ShouldMove(time.Time{}, time.Time{}, now))
+	assert.False(t, TieringPolicy{}.ShouldMove(boundary, time.Time{}, now))
+}
+
+func TestTieredFSLastAccess(t *testing.T) {
+	ctx := context.Background()
+	hot, err := NewMemoryFS("test", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	cold, err := NewMemoryFS("test", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	policy := TieringPolicy{
+		ColdAfter: time.Hour,
+	}
+	// two instances sharing the storage
+	fs1, err := NewTieredFS(hot, cold, policy)
+	require.NoError(t, err)
+	fs2, err := NewTieredFS(hot, cold, policy)
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "b"} {
+		require.NoError(t, fs1.Write(ctx, IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: 1, Data: []byte(name)}},
+		}))
+	}
+	before := time.Now()
+	require.NoError(t, fs2.Read(ctx, &IOVector{
+		FilePath: "a",
+		Entries:  []IOEntry{{Size: 1}},
+	}))
+
+	// not flushed yet
+	accessed, err := fs1.LastAccess(ctx)
+	require.NoError(t, err)
+	require.Empty(t, accessed)
+
+	require.NoError(t, fs2.FlushAccess(ctx))
+	accessed, err = fs1.LastAccess(ctx)
+	require.NoError(t, err)
+	require.Len(t, accessed, 1)
+	require.False(t, accessed["a"].Before(before.Truncate(time.Millisecond)))
+
+	// the previous record is replaced
+	require.NoError(t, fs2.FlushAccess(ctx))
+	entries, err := hot.List(ctx, accessRecordDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// access records are not listed
+	entries, err = fs1.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// records of stopped instances are deleted after ColdAfter
+	require.NoError(t, hot.Write(ctx, IOVector{
+		FilePath: accessRecordDir + "/stopped_" + strconv.FormatInt(time.Now().Add(-policy.ColdAfter).UnixNano(), 10),
+		Entries:  []IOEntry{{Size: 2, Data: []byte("{}")}},
+	}))
+	require.NoError(t, fs1.FlushAccess(ctx))
+	entries, err = hot.List(ctx, accessRecordDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestTieredFSKnownColdFiles(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestTieredFS(t, "test")
+	fs.mu.maxColdFiles = 2
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: 1, Data: []byte(name)}},
+		}))
+		require.NoError(t, fs.Move(ctx, name))
+	}
+	require.Len(t, fs.mu.cold, 2)
+
+	// the forgotten file is found by probing
+	for _, name := range []string{"a", "b", "c"} {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: 1}},
+		}
+		require.NoError(t, fs.Read(ctx, vec))
+		require.Equal(t, name, string(vec.Entries[0].Data))
+		tier, err := fs.Tier(ctx, name)
+		require.NoError(t, err)
+		require.Equal(t, ColdTier, tier)
+	}
+	require.Len(t, fs.mu.cold, 2)
+}
+
+func TestNewTieredFileService(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFileService(ctx, Config{
+		Name:    "shared",
+		Backend: "MEM",
+		Cache:   DisabledCacheConfig,
+		Tiering: TieringConfig{
+			Cold: &Config{
+				Backend: "DISK",
+				DataDir: t.TempDir(),
+				Cache:   DisabledCacheConfig,
+			},
+			ColdAfter: toml.Duration{Duration: time.Hour},
+		},
+		Encryption: EncryptionConfig{
+			KeyFile: filepath.Join(t.TempDir(), "keys"),
+		},
+	}, nil)
+	require.NoError(t, err)
+	defer fs.Close()
+
+	tiered, ok := GetTieredFS(fs)
+	require.True(t, ok)
+	require.Equal(t, "shared", tiered.Name())
+	require.Equal(t, time.Hour, tiered.Policy().ColdAfter)
+
+	// cipher texts are moved
+	require.NoError(t, fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: 3, Data: []byte("foo")}},
+	}))
+	require.NoError(t, tiered.Move(ctx, "foo"))
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, fs.Read(ctx, vec))
+	require.Equal(t, "foo", string(vec.Entries[0].Data))
+}
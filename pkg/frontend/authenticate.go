@@ -833,7 +833,12 @@ var (
 		"mo_variables":                0,
 		"mo_transactions":             0,
 		"mo_cache":                    0,
+		"mo_scrub":                    0,
 		"mo_snapshots":                0,
+		"mo_binlog_positions":         0,
+		"mo_column_stats":             0,
+		"mo_plan_baselines":           0,
+		"mo_scrub_history":            0,
 	}
 	configInitVariables = map[string]int8{
 		"save_query_result":      0,
@@ -871,8 +876,13 @@ var (
 		"mo_variables":                0,
 		"mo_transactions":             0,
 		"mo_cache":                    0,
+		"mo_scrub":                    0,
 		"mo_foreign_keys":             0,
 		"mo_snapshots":                0,
+		"mo_binlog_positions":         0,
+		"mo_column_stats":             0,
+		"mo_plan_baselines":           0,
+		"mo_scrub_history":            0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = fmt.Sprintf(`create table if not exists %s (
@@ -1057,6 +1067,38 @@ var (
 			table_name  varchar(5000),
 			obj_id bigint unsigned
 			);`,
+		`create table mo_binlog_positions(
+			task_id bigint unsigned primary key,
+			upstream varchar(300),
+			binlog_file varchar(512),
+			binlog_pos bigint unsigned,
+			update_time timestamp
+			);`,
+		`create table mo_column_stats(
+			table_id bigint unsigned,
+			column_name varchar(256),
+			database_name varchar(5000),
+			table_name varchar(5000),
+			histogram text,
+			mcv text,
+			analyzed_time timestamp,
+			primary key(table_id, column_name)
+			);`,
+		`create table mo_plan_baselines(
+			digest varchar(64) primary key,
+			sql_text text,
+			hints text,
+			created_time timestamp
+			);`,
+		`create table mo_scrub_history(
+			object_name varchar(128) primary key,
+			db_id bigint unsigned,
+			table_id bigint unsigned,
+			error text,
+			detected_at timestamp(6),
+			repaired bool,
+			repair_error text
+			);`,
 		`create table mo_pubs(
     		pub_name varchar(64) primary key,
     		database_name varchar(5000),
@@ -1104,6 +1146,7 @@ var (
 		`CREATE VIEW IF NOT EXISTS mo_variables AS SELECT * FROM mo_catalog.mo_mysql_compatibility_mode;`,
 		`CREATE VIEW IF NOT EXISTS mo_transactions AS SELECT * FROM mo_transactions() AS mo_transactions_tmp;`,
 		`CREATE VIEW IF NOT EXISTS mo_cache AS SELECT * FROM mo_cache() AS mo_cache_tmp;`,
+		`CREATE VIEW IF NOT EXISTS mo_scrub AS SELECT * FROM mo_scrub() AS mo_scrub_tmp;`,
 	}
 
 	//drop tables for the tenant
@@ -1122,7 +1165,11 @@ var (
 		`drop view if exists mo_catalog.mo_variables;`,
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
+		`drop view if exists mo_catalog.mo_scrub;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
+		`drop table if exists mo_catalog.mo_binlog_positions;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
+		`drop table if exists mo_catalog.mo_plan_baselines;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
 	dropMoPubsSql                   = `drop table if exists mo_catalog.mo_pubs;`
@@ -6008,6 +6055,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		*tree.ShowTableValues, *tree.ShowNodeList, *tree.ShowRolesStmt,
 		*tree.ShowLocks, *tree.ShowFunctionOrProcedureStatus, *tree.ShowPublications, *tree.ShowSubscriptions,
 		*tree.ShowBackendServers, *tree.ShowStages, *tree.ShowConnectors, *tree.DropConnector,
+		*tree.ShowMasterStatus, *tree.ShowBinaryLogs,
 		*tree.PauseDaemonTask, *tree.CancelDaemonTask, *tree.ResumeDaemonTask:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -6058,6 +6106,10 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		typs = append(typs, PrivilegeTypeAccountAll)
 		objType = objectTypeDatabase
 		kind = privilegeKindNone
+	case *tree.CreatePlanBaseline, *tree.DropPlanBaseline:
+		typs = append(typs, PrivilegeTypeAccountAll)
+		objType = objectTypeDatabase
+		kind = privilegeKindNone
 	case *tree.TruncateTable:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeTruncate, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
@@ -8225,8 +8277,9 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 	defer span.End()
 	//create tables for the tenant
 	for _, sql := range createSqls {
-		//only the SYS tenant has the table mo_account
-		if strings.HasPrefix(sql, "create table mo_account") {
+		//only the SYS tenant has the table mo_account and mo_scrub_history
+		if strings.HasPrefix(sql, "create table mo_account") ||
+			strings.HasPrefix(sql, "create table mo_scrub_history") {
 			continue
 		}
 		err = bh.Exec(newTenantCtx, sql)
@@ -0,0 +1,1757 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/cache"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+)
+
+// The binlog stream is made from the committed logtail of the user tables.
+// It is a row based binlog with the MINIMAL row image and without the
+// checksum. Every transaction has a GTID whose gno is derived from the
+// commit timestamp, so that a replica can resume from the GTID set it has
+// executed on any CN. The changes between the snapshot of the executed GTID
+// set and the start of the stream are replayed as one transaction, and the
+// rows written into objects by the large transactions directly are read
+// from the objects. The stream stops with an error rather than skipping a
+// transaction it cannot send.
+const (
+	// binlogServerUUID is the source id of the GTIDs. It is shared by all the
+	// CNs of the cluster.
+	binlogServerUUID = "1b6c58a4-7f8e-5d0c-9a2f-6d6f5f3e0b11"
+	binlogServerID   = 1
+	binlogFileName   = "binlog.000001"
+	binlogVersion    = 4
+	binlogHeaderLen  = 19
+	binlogStartPos   = 4
+
+	// binlogFlushDelay is how long the rows stay in the buffer after the
+	// last logtail, so that the rows of a transaction on all the tables are
+	// sent in one transaction.
+	binlogFlushDelay      = 100 * time.Millisecond
+	binlogHeartbeatPeriod = 5 * time.Second
+	binlogRefreshPeriod   = 10 * time.Second
+	// binlogMaxPendingRows limits the rows buffered for a slow replica.
+	binlogMaxPendingRows = 1 << 20
+	// binlogMaxQueuedTails limits the logtail waiting to be decoded.
+	binlogMaxQueuedTails   = 4096
+	binlogMaxRowsEventSize = 1 << 20
+)
+
+// event types of the binlog
+const (
+	binlogQueryEvent             byte = 2
+	binlogRotateEvent            byte = 4
+	binlogFormatDescriptionEvent byte = 15
+	binlogXidEvent               byte = 16
+	binlogTableMapEvent          byte = 19
+	binlogHeartbeatEvent         byte = 27
+	binlogWriteRowsEventV2       byte = 30
+	binlogUpdateRowsEventV2      byte = 31
+	binlogDeleteRowsEventV2      byte = 32
+	binlogGtidEvent              byte = 33
+)
+
+const (
+	// binlogArtificialFlag marks the events which are not in the binlog file.
+	binlogArtificialFlag uint16 = 0x20
+	// binlogStmtEndFlag marks the last rows event of the statement.
+	binlogStmtEndFlag uint16 = 0x01
+	// binlogThroughGTID is the flag of COM_BINLOG_DUMP_GTID, which means the
+	// GTID set is in the request.
+	binlogThroughGTID uint16 = 0x04
+)
+
+// binlogPostHeaderLens is the post header length of the event types from 1
+// to 41 in the FORMAT_DESCRIPTION_EVENT, the same as MySQL 8.0.
+var binlogPostHeaderLens = []byte{
+	56, 13, 0, 8, 0, 18, 0, 4, 4, 4, 4, 18, 0, 0, 98, 0, 4, 26, 8, 0,
+	0, 0, 8, 8, 8, 2, 0, 0, 0, 10, 10, 10, 42, 42, 0, 18, 52, 0, 10, 40,
+	0,
+}
+
+// binlogSource is the engine which provides the logtail of the tables.
+type binlogSource interface {
+	engine.LogtailEngine
+	AddLogtailListener(l disttae.LogtailListener) (remove func())
+	LatestLogtailAppliedTime() timestamp.Timestamp
+	GetTableItem(databaseId, tableId uint64) *cache.TableItem
+}
+
+// binlogDumpRequest is the request of COM_BINLOG_DUMP and COM_BINLOG_DUMP_GTID.
+type binlogDumpRequest struct {
+	serverID uint32
+	flags    uint16
+	fileName string
+	position uint64
+	// executed is the last gno of binlogServerUUID the replica has executed.
+	executed uint64
+}
+
+func parseBinlogDump(ctx context.Context, cmd CommandType, data []byte) (*binlogDumpRequest, error) {
+	req := &binlogDumpRequest{}
+	malformed := moerr.NewInvalidInput(ctx, "malformed %s packet", cmd.String())
+	if cmd == COM_BINLOG_DUMP {
+		if len(data) < 10 {
+			return nil, malformed
+		}
+		req.position = uint64(binary.LittleEndian.Uint32(data))
+		req.flags = binary.LittleEndian.Uint16(data[4:])
+		req.serverID = binary.LittleEndian.Uint32(data[6:])
+		req.fileName = string(data[10:])
+		return req, nil
+	}
+
+	if len(data) < 10 {
+		return nil, malformed
+	}
+	req.flags = binary.LittleEndian.Uint16(data)
+	req.serverID = binary.LittleEndian.Uint32(data[2:])
+	nameLen := int(binary.LittleEndian.Uint32(data[6:]))
+	pos := 10
+	if len(data) < pos+nameLen+8 {
+		return nil, malformed
+	}
+	req.fileName = string(data[pos : pos+nameLen])
+	pos += nameLen
+	req.position = binary.LittleEndian.Uint64(data[pos:])
+	pos += 8
+	if req.flags&binlogThroughGTID == 0 {
+		return req, nil
+	}
+	if len(data) < pos+4 {
+		return nil, malformed
+	}
+	size := int(binary.LittleEndian.Uint32(data[pos:]))
+	pos += 4
+	if len(data) < pos+size {
+		return nil, malformed
+	}
+	executed, err := parseBinlogGtidSet(data[pos : pos+size])
+	if err != nil {
+		return nil, malformed
+	}
+	req.executed = executed
+	return req, nil
+}
+
+// parseBinlogGtidSet parses the encoded GTID set and returns the last gno
+// of binlogServerUUID in it.
+func parseBinlogGtidSet(data []byte) (uint64, error) {
+	var executed uint64
+	if len(data) < 8 {
+		return 0, moerr.NewInvalidInputNoCtx("gtid set")
+	}
+	sids := binary.LittleEndian.Uint64(data)
+	pos := 8
+	for i := uint64(0); i < sids; i++ {
+		if len(data) < pos+24 {
+			return 0, moerr.NewInvalidInputNoCtx("gtid set")
+		}
+		sid := uuid.UUID(data[pos : pos+16])
+		intervals := binary.LittleEndian.Uint64(data[pos+16:])
+		pos += 24
+		if uint64(len(data)-pos) < intervals*16 {
+			return 0, moerr.NewInvalidInputNoCtx("gtid set")
+		}
+		for j := uint64(0); j < intervals; j++ {
+			// the interval is [start, end)
+			end := binary.LittleEndian.Uint64(data[pos+8:])
+			pos += 16
+			if sid.String() == binlogServerUUID && end > 0 && end-1 > executed {
+				executed = end - 1
+			}
+		}
+	}
+	return executed, nil
+}
+
+// binlogGno returns the gno of the transaction committed at ts.
+func binlogGno(ts types.TS) uint64 {
+	return uint64(ts.Physical()) + uint64(ts.Logical())
+}
+
+// binlogExecutedGtidSet returns the GTID set of the transactions committed
+// before now.
+func binlogExecutedGtidSet(now time.Time) string {
+	return fmt.Sprintf("%s:1-%d", binlogServerUUID, now.UnixNano())
+}
+
+// binlogColumn is a column in the row images.
+type binlogColumn struct {
+	name     string
+	typ      types.Type
+	seqnum   int
+	nullable bool
+	mysqlTyp defines.MysqlType
+	meta     []byte
+}
+
+// binlogTable is the table described by the TABLE_MAP_EVENT.
+type binlogTable struct {
+	id     uint64
+	dbName string
+	name   string
+	def    *plan.TableDef
+	// columns are the visible columns of the table.
+	columns []binlogColumn
+	// pk is the positions of the primary key columns in the columns.
+	pk []int
+	// pkSeqnum is the seqnum of the primary key, which is the serialized
+	// column for the composite primary key. It is -1 if the table has no
+	// primary key.
+	pkSeqnum    int
+	compositePK bool
+}
+
+func newBinlogTable(id uint64, dbName, name string, def *plan.TableDef) *binlogTable {
+	t := &binlogTable{
+		id:       id,
+		dbName:   dbName,
+		name:     name,
+		def:      def,
+		pkSeqnum: -1,
+	}
+	positions := make(map[string]int)
+	for _, col := range def.Cols {
+		if col.Hidden || col.Name == catalog.Row_ID {
+			if def.Pkey != nil && col.Name == def.Pkey.PkeyColName {
+				t.pkSeqnum = int(col.Seqnum)
+			}
+			continue
+		}
+		typ := types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+		mysqlTyp, meta := binlogColumnType(typ)
+		positions[col.Name] = len(t.columns)
+		t.columns = append(t.columns, binlogColumn{
+			name:     col.Name,
+			typ:      typ,
+			seqnum:   int(col.Seqnum),
+			nullable: !col.Typ.NotNullable,
+			mysqlTyp: mysqlTyp,
+			meta:     meta,
+		})
+		if def.Pkey != nil && col.Name == def.Pkey.PkeyColName {
+			t.pkSeqnum = int(col.Seqnum)
+		}
+	}
+	if def.Pkey == nil || def.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		t.pkSeqnum = -1
+		return t
+	}
+	t.compositePK = def.Pkey.PkeyColName == catalog.CPrimaryKeyColName
+	names := def.Pkey.Names
+	if !t.compositePK {
+		names = []string{def.Pkey.PkeyColName}
+	}
+	for _, name := range names {
+		pos, ok := positions[name]
+		if !ok {
+			t.pkSeqnum, t.pk = -1, nil
+			return t
+		}
+		t.pk = append(t.pk, pos)
+	}
+	return t
+}
+
+// binlogColumnType returns the column type and the metadata in the
+// TABLE_MAP_EVENT for the type.
+func binlogColumnType(typ types.Type) (defines.MysqlType, []byte) {
+	switch typ.Oid {
+	case types.T_bool, types.T_int8, types.T_uint8:
+		return defines.MYSQL_TYPE_TINY, nil
+	case types.T_int16, types.T_uint16:
+		return defines.MYSQL_TYPE_SHORT, nil
+	case types.T_int32, types.T_uint32:
+		return defines.MYSQL_TYPE_LONG, nil
+	case types.T_int64, types.T_uint64:
+		return defines.MYSQL_TYPE_LONGLONG, nil
+	case types.T_float32:
+		return defines.MYSQL_TYPE_FLOAT, []byte{4}
+	case types.T_float64:
+		return defines.MYSQL_TYPE_DOUBLE, []byte{8}
+	case types.T_bit:
+		return defines.MYSQL_TYPE_BIT, []byte{byte(typ.Width % 8), byte(typ.Width / 8)}
+	case types.T_decimal64, types.T_decimal128:
+		return defines.MYSQL_TYPE_NEWDECIMAL, []byte{byte(typ.Width), byte(typ.Scale)}
+	case types.T_date:
+		return defines.MYSQL_TYPE_DATE, nil
+	case types.T_datetime:
+		return defines.MYSQL_TYPE_DATETIME2, []byte{byte(typ.Scale)}
+	case types.T_timestamp:
+		return defines.MYSQL_TYPE_TIMESTAMP2, []byte{byte(typ.Scale)}
+	case types.T_time:
+		return defines.MYSQL_TYPE_TIME2, []byte{byte(typ.Scale)}
+	case types.T_enum:
+		// the enum is sent as the string type with the real type in the metadata
+		return defines.MYSQL_TYPE_STRING, []byte{byte(defines.MYSQL_TYPE_ENUM), 2}
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_uuid:
+		maxLen := int64(typ.Width)
+		if typ.Oid == types.T_char || typ.Oid == types.T_varchar {
+			maxLen *= 4
+		} else if typ.Oid == types.T_uuid {
+			maxLen = 36
+		}
+		if maxLen <= math.MaxUint16 {
+			return defines.MYSQL_TYPE_VARCHAR, binary.LittleEndian.AppendUint16(nil, uint16(maxLen))
+		}
+	}
+	// the others are sent as the string in the LONGBLOB
+	return defines.MYSQL_TYPE_BLOB, []byte{4}
+}
+
+func (col *binlogColumn) unsigned() bool {
+	switch col.typ.Oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func (col *binlogColumn) numeric() bool {
+	switch col.mysqlTyp {
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_LONG,
+		defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_NEWDECIMAL,
+		defines.MYSQL_TYPE_FLOAT, defines.MYSQL_TYPE_DOUBLE:
+		return true
+	}
+	return false
+}
+
+// tableMapEvent returns the body of the TABLE_MAP_EVENT.
+func (t *binlogTable) tableMapEvent() []byte {
+	buf := appendBinlogUint48(nil, t.id)
+	buf = binary.LittleEndian.AppendUint16(buf, 1)
+	buf = append(buf, byte(len(t.dbName)))
+	buf = append(append(buf, t.dbName...), 0)
+	buf = append(buf, byte(len(t.name)))
+	buf = append(append(buf, t.name...), 0)
+	buf = appendBinlogLenEnc(buf, uint64(len(t.columns)))
+	var meta []byte
+	for _, col := range t.columns {
+		buf = append(buf, byte(col.mysqlTyp))
+		meta = append(meta, col.meta...)
+	}
+	buf = appendBinlogLenEnc(buf, uint64(len(meta)))
+	buf = append(buf, meta...)
+	buf = appendBinlogBitmap(buf, len(t.columns), func(i int) bool {
+		return t.columns[i].nullable
+	})
+
+	// the optional metadata: SIGNEDNESS, COLUMN_NAME and SIMPLE_PRIMARY_KEY
+	var numeric []int
+	for i := range t.columns {
+		if t.columns[i].numeric() {
+			numeric = append(numeric, i)
+		}
+	}
+	if len(numeric) > 0 {
+		signedness := appendBinlogBitmap(nil, len(numeric), func(i int) bool {
+			return t.columns[numeric[i]].unsigned()
+		})
+		for i := range signedness {
+			signedness[i] = reverseBinlogBits(signedness[i])
+		}
+		buf = append(buf, 1)
+		buf = appendBinlogLenEnc(buf, uint64(len(signedness)))
+		buf = append(buf, signedness...)
+	}
+	var names []byte
+	for _, col := range t.columns {
+		names = appendBinlogLenEnc(names, uint64(len(col.name)))
+		names = append(names, col.name...)
+	}
+	buf = append(buf, 4)
+	buf = appendBinlogLenEnc(buf, uint64(len(names)))
+	buf = append(buf, names...)
+	if len(t.pk) > 0 {
+		var pk []byte
+		for _, pos := range t.pk {
+			pk = appendBinlogLenEnc(pk, uint64(pos))
+		}
+		buf = append(buf, 8)
+		buf = appendBinlogLenEnc(buf, uint64(len(pk)))
+		buf = append(buf, pk...)
+	}
+	return buf
+}
+
+// binlogChange is a row inserted or deleted by a transaction.
+type binlogChange struct {
+	ts     types.TS
+	table  *binlogTable
+	delete bool
+	// key is the primary key, which pairs the delete and the insert of the
+	// updated row.
+	key string
+	// image is the row image of the row. It is the primary key columns for
+	// the deleted row.
+	image []byte
+}
+
+// changes decodes the rows in the logtail entry of the table.
+func (t *binlogTable) changes(deleted bool, bat *batch.Batch) ([]binlogChange, error) {
+	if len(bat.Vecs) < 2 {
+		return nil, nil
+	}
+	commits := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	commit := func(i int) types.TS { return commits[i] }
+	if deleted {
+		if len(bat.Vecs) < 3 {
+			return nil, nil
+		}
+		return t.deletes(bat.Vecs[2], commit)
+	}
+	return t.inserts(bat.Vecs[0].Length(), func(seqnum int) *vector.Vector {
+		if pos := seqnum + 2; pos < len(bat.Vecs) {
+			return bat.Vecs[pos]
+		}
+		return nil
+	}, commit)
+}
+
+// deletes makes the changes of the rows deleted by the primary keys.
+func (t *binlogTable) deletes(pkVec *vector.Vector, commit func(i int) types.TS) ([]binlogChange, error) {
+	// the deleted row without the primary key has no row image
+	if t.pkSeqnum < 0 {
+		return nil, nil
+	}
+	rows := pkVec.Length()
+	changes := make([]binlogChange, 0, rows)
+	for i := 0; i < rows; i++ {
+		var values []any
+		if t.compositePK {
+			tuple, err := types.Unpack(pkVec.GetBytesAt(i))
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range tuple {
+				values = append(values, v)
+			}
+		} else {
+			values = []any{binlogVectorValue(pkVec, i)}
+		}
+		image, err := t.appendRowImage(nil, t.pk, func(j int) any {
+			if j >= len(values) {
+				return nil
+			}
+			return binlogTupleValue(&t.columns[t.pk[j]], values[j])
+		})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, binlogChange{
+			ts:     commit(i),
+			table:  t,
+			delete: true,
+			key:    string(pkVec.GetRawBytesAt(i)),
+			image:  image,
+		})
+	}
+	return changes, nil
+}
+
+// inserts makes the changes of the rows inserted. column returns the
+// vector of the column with the seqnum, or nil if it is missing.
+func (t *binlogTable) inserts(rows int, column func(seqnum int) *vector.Vector, commit func(i int) types.TS) ([]binlogChange, error) {
+	vecs := make([]*vector.Vector, len(t.columns))
+	all := make([]int, len(t.columns))
+	for i := range all {
+		all[i] = i
+		vecs[i] = column(t.columns[i].seqnum)
+	}
+	var pkVec *vector.Vector
+	if t.pkSeqnum >= 0 {
+		pkVec = column(t.pkSeqnum)
+	}
+	changes := make([]binlogChange, 0, rows)
+	for i := 0; i < rows; i++ {
+		image, err := t.appendRowImage(nil, all, func(j int) any {
+			if vecs[j] == nil {
+				return nil
+			}
+			return binlogVectorValue(vecs[j], i)
+		})
+		if err != nil {
+			return nil, err
+		}
+		change := binlogChange{
+			ts:    commit(i),
+			table: t,
+			image: image,
+		}
+		if pkVec != nil {
+			change.key = string(pkVec.GetRawBytesAt(i))
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// rows makes the changes of the rows in a query result, whose columns are
+// the columns of the table in order. The key is the image of the primary
+// key, so that it pairs the rows of the same query only.
+func (t *binlogTable) rows(deleted bool, bat *batch.Batch, ts types.TS) ([]binlogChange, error) {
+	if deleted && len(t.pk) == 0 {
+		return nil, nil
+	}
+	all := make([]int, len(t.columns))
+	for i := range all {
+		all[i] = i
+	}
+	changes := make([]binlogChange, 0, bat.RowCount())
+	for i := 0; i < bat.RowCount(); i++ {
+		key, err := t.appendRowImage(nil, t.pk, func(j int) any {
+			return binlogVectorValue(bat.Vecs[t.pk[j]], i)
+		})
+		if err != nil {
+			return nil, err
+		}
+		change := binlogChange{
+			ts:     ts,
+			table:  t,
+			delete: deleted,
+			image:  key,
+		}
+		if len(t.pk) > 0 {
+			change.key = string(key)
+		}
+		if !deleted {
+			if change.image, err = t.appendRowImage(nil, all, func(j int) any {
+				return binlogVectorValue(bat.Vecs[j], i)
+			}); err != nil {
+				return nil, err
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// seqnums returns the seqnums and the types of the columns stored in the
+// objects of the table.
+func (t *binlogTable) seqnums() ([]uint16, []types.Type) {
+	var seqnums []uint16
+	var typs []types.Type
+	for _, col := range t.def.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		seqnums = append(seqnums, uint16(col.Seqnum))
+		typs = append(typs, types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+	}
+	return seqnums, typs
+}
+
+// appendRowImage appends the null bitmap and the values of the columns.
+func (t *binlogTable) appendRowImage(buf []byte, columns []int, value func(i int) any) ([]byte, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		values[i] = value(i)
+	}
+	buf = appendBinlogBitmap(buf, len(columns), func(i int) bool {
+		return values[i] == nil
+	})
+	var err error
+	for i, pos := range columns {
+		if values[i] == nil {
+			continue
+		}
+		if buf, err = appendBinlogValue(buf, &t.columns[pos], values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// binlogVectorValue returns the value in the vector, or nil for null.
+func binlogVectorValue(vec *vector.Vector, i int) any {
+	if vec.IsNull(uint64(i)) {
+		return nil
+	}
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, i)
+	case types.T_bit:
+		return vector.GetFixedAt[uint64](vec, i)
+	case types.T_int8:
+		return vector.GetFixedAt[int8](vec, i)
+	case types.T_int16:
+		return vector.GetFixedAt[int16](vec, i)
+	case types.T_int32:
+		return vector.GetFixedAt[int32](vec, i)
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, i)
+	case types.T_uint8:
+		return vector.GetFixedAt[uint8](vec, i)
+	case types.T_uint16:
+		return vector.GetFixedAt[uint16](vec, i)
+	case types.T_uint32:
+		return vector.GetFixedAt[uint32](vec, i)
+	case types.T_uint64:
+		return vector.GetFixedAt[uint64](vec, i)
+	case types.T_float32:
+		return vector.GetFixedAt[float32](vec, i)
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i)
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, i)
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, i)
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i)
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, i)
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, i)
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, i)
+	case types.T_enum:
+		return vector.GetFixedAt[types.Enum](vec, i)
+	case types.T_uuid:
+		return []byte(vector.GetFixedAt[types.Uuid](vec, i).ToString())
+	case types.T_json:
+		return []byte(types.DecodeJson(vec.GetBytesAt(i)).String())
+	case types.T_array_float32:
+		return []byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i)))
+	case types.T_array_float64:
+		return []byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i)))
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
+		types.T_text, types.T_blob:
+		return vec.GetBytesAt(i)
+	default:
+		return vec.GetRawBytesAt(i)
+	}
+}
+
+// binlogTupleValue converts the value in the serialized composite primary
+// key to the value in the vector of the column.
+func binlogTupleValue(col *binlogColumn, v any) any {
+	switch x := v.(type) {
+	case int64:
+		if col.typ.Oid == types.T_time {
+			return types.Time(x)
+		}
+	case uint16:
+		if col.typ.Oid == types.T_enum {
+			return types.Enum(x)
+		}
+	case types.Uuid:
+		return []byte(x.ToString())
+	}
+	return v
+}
+
+// appendBinlogValue appends the value of the column in the row image.
+func appendBinlogValue(buf []byte, col *binlogColumn, v any) ([]byte, error) {
+	switch x := v.(type) {
+	case bool:
+		if x {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case int8:
+		return append(buf, byte(x)), nil
+	case uint8:
+		return append(buf, x), nil
+	case int16:
+		return binary.LittleEndian.AppendUint16(buf, uint16(x)), nil
+	case uint16:
+		return binary.LittleEndian.AppendUint16(buf, x), nil
+	case int32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(x)), nil
+	case uint32:
+		return binary.LittleEndian.AppendUint32(buf, x), nil
+	case int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(x)), nil
+	case uint64:
+		if col.typ.Oid == types.T_bit {
+			n := int(col.typ.Width+7) / 8
+			var data [8]byte
+			binary.BigEndian.PutUint64(data[:], x)
+			return append(buf, data[8-n:]...), nil
+		}
+		return binary.LittleEndian.AppendUint64(buf, x), nil
+	case float32:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(x)), nil
+	case float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(x)), nil
+	case types.Decimal64:
+		return appendBinlogDecimal(buf, x.Format(col.typ.Scale), int(col.typ.Width), int(col.typ.Scale))
+	case types.Decimal128:
+		return appendBinlogDecimal(buf, x.Format(col.typ.Scale), int(col.typ.Width), int(col.typ.Scale))
+	case types.Date:
+		year, month, day, _ := x.Calendar(true)
+		return appendBinlogUint24(buf, uint32(year)*512+uint32(month)*32+uint32(day)), nil
+	case types.Datetime:
+		return appendBinlogDatetime(buf, x, int(col.typ.Scale)), nil
+	case types.Timestamp:
+		buf = binary.BigEndian.AppendUint32(buf, uint32(x.Unix()))
+		return appendBinlogFrac(buf, int64(x)%types.MicroSecsPerSec, int(col.typ.Scale)), nil
+	case types.Time:
+		return appendBinlogTime(buf, x, int(col.typ.Scale)), nil
+	case types.Enum:
+		return binary.LittleEndian.AppendUint16(buf, uint16(x)), nil
+	case []byte:
+		switch {
+		case col.mysqlTyp == defines.MYSQL_TYPE_BLOB:
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(x)))
+		case binary.LittleEndian.Uint16(col.meta) < 256:
+			buf = append(buf, byte(len(x)))
+		default:
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(len(x)))
+		}
+		return append(buf, x...), nil
+	}
+	return nil, moerr.NewNotSupportedNoCtx(fmt.Sprintf("the value %v of the column %s in the binlog", v, col.name))
+}
+
+var binlogDigitsToBytes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// appendBinlogDecimal appends the decimal in the binary format of MySQL.
+func appendBinlogDecimal(buf []byte, s string, precision, scale int) ([]byte, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	intPart = strings.TrimLeft(intPart, "0")
+	intg := precision - scale
+	if len(intPart) > intg {
+		return nil, moerr.NewInvalidInputNoCtx("decimal %s out of the precision %d", s, precision)
+	}
+	intPart = strings.Repeat("0", intg-len(intPart)) + intPart
+	if len(fracPart) < scale {
+		fracPart += strings.Repeat("0", scale-len(fracPart))
+	}
+	fracPart = fracPart[:scale]
+
+	start := len(buf)
+	appendDigits := func(digits string) error {
+		v, err := strconv.ParseUint(digits, 10, 32)
+		if err != nil {
+			return err
+		}
+		n := 4
+		if len(digits) < 9 {
+			n = binlogDigitsToBytes[len(digits)]
+		}
+		var data [4]byte
+		binary.BigEndian.PutUint32(data[:], uint32(v))
+		buf = append(buf, data[4-n:]...)
+		return nil
+	}
+	if lead := intg % 9; lead > 0 {
+		if err := appendDigits(intPart[:lead]); err != nil {
+			return nil, err
+		}
+	}
+	for i := intg % 9; i < intg; i += 9 {
+		if err := appendDigits(intPart[i : i+9]); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < scale; i += 9 {
+		if err := appendDigits(fracPart[i:min(i+9, scale)]); err != nil {
+			return nil, err
+		}
+	}
+	if start == len(buf) {
+		return buf, nil
+	}
+	if neg {
+		for i := start; i < len(buf); i++ {
+			buf[i] = ^buf[i]
+		}
+	}
+	buf[start] ^= 0x80
+	return buf, nil
+}
+
+// appendBinlogFrac appends the fractional seconds for the DATETIME2,
+// TIMESTAMP2 and TIME2.
+func appendBinlogFrac(buf []byte, micro int64, fsp int) []byte {
+	switch fsp {
+	case 1, 2:
+		return append(buf, byte(micro/10000))
+	case 3, 4:
+		return binary.BigEndian.AppendUint16(buf, uint16(micro/100))
+	case 5, 6:
+		return appendBinlogUint24BE(buf, uint32(micro))
+	}
+	return buf
+}
+
+func appendBinlogDatetime(buf []byte, dt types.Datetime, fsp int) []byte {
+	ym := uint64(dt.Year())*13 + uint64(dt.Month())
+	ymd := ym<<5 | uint64(dt.Day())
+	hms := uint64(dt.Hour())<<12 | uint64(dt.Minute())<<6 | uint64(dt.Sec())
+	v := (ymd<<17 | hms) + 0x8000000000
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], v)
+	buf = append(buf, data[3:]...)
+	return appendBinlogFrac(buf, dt.MicroSec(), fsp)
+}
+
+func appendBinlogTime(buf []byte, t types.Time, fsp int) []byte {
+	hour, minute, sec, micro, neg := t.ClockFormat()
+	hms := int64(hour)<<12 | int64(minute)<<6 | int64(sec)
+	packed := hms<<24 + int64(micro)
+	if neg {
+		packed = -packed
+	}
+	switch fsp {
+	case 5, 6:
+		v := uint64(packed + 0x800000000000)
+		var data [8]byte
+		binary.BigEndian.PutUint64(data[:], v)
+		return append(buf, data[2:]...)
+	}
+	buf = appendBinlogUint24BE(buf, uint32(0x800000+(packed>>24)))
+	frac := packed % (1 << 24)
+	switch fsp {
+	case 1, 2:
+		return append(buf, byte(int8(frac/10000)))
+	case 3, 4:
+		return binary.BigEndian.AppendUint16(buf, uint16(int16(frac/100)))
+	}
+	return buf
+}
+
+func appendBinlogUint24(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16))
+}
+
+func appendBinlogUint24BE(buf []byte, v uint32) []byte {
+	return append(buf, byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendBinlogUint48(buf []byte, v uint64) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32), byte(v>>40))
+}
+
+func appendBinlogLenEnc(buf []byte, v uint64) []byte {
+	switch {
+	case v < 251:
+		return append(buf, byte(v))
+	case v < 1<<16:
+		return binary.LittleEndian.AppendUint16(append(buf, 0xfc), uint16(v))
+	case v < 1<<24:
+		return appendBinlogUint24(append(buf, 0xfd), uint32(v))
+	}
+	return binary.LittleEndian.AppendUint64(append(buf, 0xfe), v)
+}
+
+// appendBinlogBitmap appends the bitmap of n bits, which is set if set(i)
+// returns true. The bits are in the little endian order.
+func appendBinlogBitmap(buf []byte, n int, set func(i int) bool) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, (n+7)/8)...)
+	for i := 0; i < n; i++ {
+		if set(i) {
+			buf[start+i/8] |= 1 << (i % 8)
+		}
+	}
+	return buf
+}
+
+func reverseBinlogBits(b byte) byte {
+	var r byte
+	for i := 0; i < 8; i++ {
+		r = r<<1 | (b>>i)&1
+	}
+	return r
+}
+
+// binlogWriter makes the events and tracks the position in the binlog file.
+type binlogWriter struct {
+	version string
+	pos     uint64
+}
+
+func newBinlogWriter(version string) *binlogWriter {
+	return &binlogWriter{
+		version: version,
+		pos:     binlogStartPos,
+	}
+}
+
+func makeBinlogEvent(typ byte, timestamp uint32, flags uint16, logPos uint64, body []byte) []byte {
+	size := binlogHeaderLen + len(body)
+	buf := make([]byte, 0, size)
+	buf = binary.LittleEndian.AppendUint32(buf, timestamp)
+	buf = append(buf, typ)
+	buf = binary.LittleEndian.AppendUint32(buf, binlogServerID)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(size))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(logPos))
+	buf = binary.LittleEndian.AppendUint16(buf, flags)
+	return append(buf, body...)
+}
+
+// event makes the event in the binlog file and moves the position to its end.
+func (w *binlogWriter) event(typ byte, timestamp uint32, flags uint16, body []byte) []byte {
+	w.pos += uint64(binlogHeaderLen + len(body))
+	return makeBinlogEvent(typ, timestamp, flags, w.pos, body)
+}
+
+// rotate makes the artificial ROTATE_EVENT at the beginning of the stream.
+func (w *binlogWriter) rotate() []byte {
+	body := binary.LittleEndian.AppendUint64(nil, w.pos)
+	body = append(body, binlogFileName...)
+	return makeBinlogEvent(binlogRotateEvent, 0, binlogArtificialFlag, 0, body)
+}
+
+// formatDescription makes the FORMAT_DESCRIPTION_EVENT. It always ends with
+// the checksum algorithm and the checksum, even if the checksum is off.
+func (w *binlogWriter) formatDescription(now time.Time) []byte {
+	body := binary.LittleEndian.AppendUint16(nil, binlogVersion)
+	var version [50]byte
+	copy(version[:], w.version)
+	body = append(body, version[:]...)
+	body = binary.LittleEndian.AppendUint32(body, uint32(now.Unix()))
+	body = append(body, binlogHeaderLen)
+	body = append(body, binlogPostHeaderLens...)
+	// the checksum is off
+	body = append(body, 0)
+	body = append(body, 0, 0, 0, 0)
+	event := w.event(binlogFormatDescriptionEvent, uint32(now.Unix()), 0, body)
+	binary.LittleEndian.PutUint32(event[len(event)-4:], crc32.ChecksumIEEE(event[:len(event)-4]))
+	return event
+}
+
+// heartbeat makes the HEARTBEAT_EVENT, which is not in the binlog file.
+func (w *binlogWriter) heartbeat() []byte {
+	return makeBinlogEvent(binlogHeartbeatEvent, 0, binlogArtificialFlag, w.pos, []byte(binlogFileName))
+}
+
+// transaction makes the events of the transaction with the gno.
+func (w *binlogWriter) transaction(gno uint64, changes []binlogChange) [][]byte {
+	timestamp := uint32(changes[0].ts.Physical() / int64(time.Second))
+	sid := uuid.MustParse(binlogServerUUID)
+
+	var events [][]byte
+	gtid := []byte{1}
+	gtid = append(gtid, sid[:]...)
+	gtid = binary.LittleEndian.AppendUint64(gtid, gno)
+	// the logical timestamps for the parallel applier
+	gtid = append(gtid, 2)
+	gtid = binary.LittleEndian.AppendUint64(gtid, 0)
+	gtid = binary.LittleEndian.AppendUint64(gtid, 1)
+	events = append(events, w.event(binlogGtidEvent, timestamp, 0, gtid))
+
+	begin := make([]byte, 13, 13+6)
+	begin = append(begin, 0)
+	begin = append(begin, "BEGIN"...)
+	events = append(events, w.event(binlogQueryEvent, timestamp, 0, begin))
+
+	// pair the delete and the insert of the same primary key as an update.
+	type tableChanges struct {
+		table   *binlogTable
+		deletes []binlogChange
+		updates [][2]binlogChange
+		inserts []binlogChange
+	}
+	var tables []*tableChanges
+	byTable := make(map[*binlogTable]*tableChanges)
+	for _, change := range changes {
+		tc, ok := byTable[change.table]
+		if !ok {
+			tc = &tableChanges{table: change.table}
+			byTable[change.table] = tc
+			tables = append(tables, tc)
+		}
+		if change.delete {
+			tc.deletes = append(tc.deletes, change)
+		} else {
+			tc.inserts = append(tc.inserts, change)
+		}
+	}
+	for _, tc := range tables {
+		deleted := make(map[string]int, len(tc.deletes))
+		for i, change := range tc.deletes {
+			deleted[change.key] = i
+		}
+		inserts := tc.inserts[:0]
+		for _, change := range tc.inserts {
+			if i, ok := deleted[change.key]; ok && change.key != "" {
+				tc.updates = append(tc.updates, [2]binlogChange{tc.deletes[i], change})
+				delete(deleted, change.key)
+				tc.deletes[i].image = nil
+				continue
+			}
+			inserts = append(inserts, change)
+		}
+		tc.inserts = inserts
+		deletes := tc.deletes[:0]
+		for _, change := range tc.deletes {
+			if change.image != nil {
+				deletes = append(deletes, change)
+			}
+		}
+		tc.deletes = deletes
+	}
+
+	for _, tc := range tables {
+		events = append(events, w.event(binlogTableMapEvent, timestamp, 0, tc.table.tableMapEvent()))
+	}
+
+	// all the rows events are in one statement, so that the table maps
+	// are valid for all of them.
+	var rows []struct {
+		typ  byte
+		body []byte
+	}
+	appendRows := func(typ byte, t *binlogTable, images [][]byte) {
+		var body []byte
+		for _, image := range images {
+			if body == nil {
+				body = t.rowsEventHeader(typ)
+			}
+			body = append(body, image...)
+			if len(body) >= binlogMaxRowsEventSize {
+				rows = append(rows, struct {
+					typ  byte
+					body []byte
+				}{typ, body})
+				body = nil
+			}
+		}
+		if body != nil {
+			rows = append(rows, struct {
+				typ  byte
+				body []byte
+			}{typ, body})
+		}
+	}
+	for _, tc := range tables {
+		var images [][]byte
+		for _, change := range tc.deletes {
+			images = append(images, change.image)
+		}
+		appendRows(binlogDeleteRowsEventV2, tc.table, images)
+		images = images[:0]
+		for _, pair := range tc.updates {
+			images = append(images, append(append([]byte{}, pair[0].image...), pair[1].image...))
+		}
+		appendRows(binlogUpdateRowsEventV2, tc.table, images)
+		images = images[:0]
+		for _, change := range tc.inserts {
+			images = append(images, change.image)
+		}
+		appendRows(binlogWriteRowsEventV2, tc.table, images)
+	}
+	for i, r := range rows {
+		if i == len(rows)-1 {
+			// the flags are after the table id in the post header
+			binary.LittleEndian.PutUint16(r.body[6:], binlogStmtEndFlag)
+		}
+		events = append(events, w.event(r.typ, timestamp, 0, r.body))
+	}
+
+	xid := binary.LittleEndian.AppendUint64(nil, gno)
+	events = append(events, w.event(binlogXidEvent, timestamp, 0, xid))
+	return events
+}
+
+// rowsEventHeader returns the post header and the columns of the rows event.
+func (t *binlogTable) rowsEventHeader(typ byte) []byte {
+	buf := appendBinlogUint48(nil, t.id)
+	buf = binary.LittleEndian.AppendUint16(buf, 0)
+	// the length of the extra data, including itself
+	buf = binary.LittleEndian.AppendUint16(buf, 2)
+	buf = appendBinlogLenEnc(buf, uint64(len(t.columns)))
+	pk := make(map[int]bool, len(t.pk))
+	for _, pos := range t.pk {
+		pk[pos] = true
+	}
+	switch typ {
+	case binlogDeleteRowsEventV2:
+		buf = appendBinlogBitmap(buf, len(t.columns), func(i int) bool { return pk[i] })
+	case binlogUpdateRowsEventV2:
+		buf = appendBinlogBitmap(buf, len(t.columns), func(i int) bool { return pk[i] })
+		buf = appendBinlogBitmap(buf, len(t.columns), func(i int) bool { return true })
+	default:
+		buf = appendBinlogBitmap(buf, len(t.columns), func(i int) bool { return true })
+	}
+	return buf
+}
+
+// binlogTail is the logtail of a table copied by the listener.
+type binlogTail struct {
+	tableID uint64
+	name    string
+	def     *plan.TableDef
+	ts      types.TS
+	entries []api.Entry
+}
+
+// binlogObject is an object created by a transaction.
+type binlogObject struct {
+	stats objectio.ObjectStats
+	ts    types.TS
+}
+
+// binlogDumper sends the binlog stream to a replica.
+type binlogDumper struct {
+	ses       *Session
+	source    binlogSource
+	fs        fileservice.FileService
+	accountID uint32
+	req       *binlogDumpRequest
+	writer    *binlogWriter
+	lastGno   uint64
+
+	// tails is the logtail waiting to be decoded by the dumper, so that the
+	// listener does not slow down the logtail consumer. The replica is
+	// disconnected if the dumper falls behind and the queue overflows.
+	tails    chan binlogTail
+	overflow atomic.Bool
+
+	// start is the timestamp the stream starts at. The changes committed
+	// before it are replayed from the snapshots.
+	start types.TS
+	// dbIDs and dbNames are the database of the tables in the stream.
+	dbIDs   map[uint64]uint64
+	dbNames map[uint64]string
+	tables  map[uint64]*binlogTable
+	pending []binlogChange
+	// watermark is the timestamp of the latest logtail. safe is the
+	// timestamp of the logtail before it, before which all the rows have
+	// been received.
+	watermark  types.TS
+	safe       types.TS
+	lastNotify time.Time
+}
+
+func newBinlogDumper(ses *Session, source binlogSource, fs fileservice.FileService, req *binlogDumpRequest, version string) *binlogDumper {
+	return &binlogDumper{
+		ses:       ses,
+		source:    source,
+		fs:        fs,
+		accountID: ses.GetTenantInfo().GetTenantID(),
+		req:       req,
+		writer:    newBinlogWriter(version),
+		lastGno:   req.executed,
+		tails:     make(chan binlogTail, binlogMaxQueuedTails),
+		dbIDs:     make(map[uint64]uint64),
+		dbNames:   make(map[uint64]string),
+		tables:    make(map[uint64]*binlogTable),
+	}
+}
+
+// onLogtail is the listener of the logtail. It copies the entries into the
+// queue, and leaves the decoding to the dumper.
+func (d *binlogDumper) onLogtail(item *cache.TableItem, tail *logtail.TableLogtail) {
+	if item == nil || item.AccountId != d.accountID || item.TableDef == nil || d.overflow.Load() {
+		return
+	}
+	t := binlogTail{
+		tableID: item.Id,
+		name:    item.Name,
+		def:     item.TableDef,
+	}
+	if tail.Ts != nil {
+		t.ts = types.TimestampToTS(*tail.Ts)
+	}
+	for i := range tail.Commands {
+		entry := &tail.Commands[i]
+		if entry.EntryType != api.Entry_Insert && entry.EntryType != api.Entry_Delete {
+			continue
+		}
+		t.entries = append(t.entries, copyBinlogEntry(entry))
+	}
+	select {
+	case d.tails <- t:
+	default:
+		d.overflow.Store(true)
+	}
+}
+
+// copyBinlogEntry copies the entry, whose buffers are reused after the
+// listener returns.
+func copyBinlogEntry(entry *api.Entry) api.Entry {
+	e := api.Entry{
+		EntryType: entry.EntryType,
+		TableName: entry.TableName,
+	}
+	if entry.Bat != nil {
+		e.Bat = &api.Batch{
+			Attrs: entry.Bat.Attrs,
+			Vecs:  make([]api.Vector, len(entry.Bat.Vecs)),
+		}
+		for i, vec := range entry.Bat.Vecs {
+			e.Bat.Vecs[i] = api.Vector{
+				Data:     bytes.Clone(vec.Data),
+				Type:     vec.Type,
+				Nullable: vec.Nullable,
+				Nsp:      bytes.Clone(vec.Nsp),
+				IsConst:  vec.IsConst,
+				Len:      vec.Len,
+				Area:     bytes.Clone(vec.Area),
+			}
+		}
+	}
+	return e
+}
+
+// receive decodes the logtail in the queue.
+func (d *binlogDumper) receive(ctx context.Context, now time.Time) error {
+	for n := len(d.tails); n > 0; n-- {
+		tail := <-d.tails
+		if err := d.decode(ctx, &tail); err != nil {
+			return err
+		}
+		d.lastNotify = now
+	}
+	if d.overflow.Load() {
+		return moerr.NewInternalError(ctx, "the logtail queue of the binlog replica overflows")
+	}
+	if len(d.pending) > binlogMaxPendingRows {
+		return moerr.NewInternalError(ctx, "too many rows are pending for the binlog replica")
+	}
+	return nil
+}
+
+// table returns the table in the stream with the definition.
+func (d *binlogDumper) table(id uint64, name string, def *plan.TableDef) *binlogTable {
+	t := d.tables[id]
+	if t == nil || t.def != def {
+		t = newBinlogTable(id, d.dbNames[id], name, def)
+		d.tables[id] = t
+	}
+	return t
+}
+
+// decode decodes the logtail of a table into the pending rows.
+func (d *binlogDumper) decode(ctx context.Context, tail *binlogTail) error {
+	if d.watermark.Less(&tail.ts) {
+		d.safe = d.watermark
+		d.watermark = tail.ts
+	}
+	if _, ok := d.dbNames[tail.tableID]; !ok {
+		return nil
+	}
+	t := d.table(tail.tableID, tail.name, tail.def)
+
+	// the objects created by the transactions, and the commit timestamps of
+	// the merges and the flushes, which delete the objects they replace.
+	var objects []binlogObject
+	replaced := make(map[types.TS]bool)
+	for i := range tail.entries {
+		entry := &tail.entries[i]
+		bat, err := batch.ProtoBatchToBatch(entry.Bat)
+		if err != nil {
+			return err
+		}
+		var changes []binlogChange
+		switch {
+		case logtailreplay.IsObjTable(entry.TableName):
+			if entry.EntryType == api.Entry_Insert {
+				objects = d.appendObjects(objects, replaced, bat)
+			}
+		case logtailreplay.IsMetaTable(entry.TableName):
+			if entry.EntryType == api.Entry_Insert {
+				changes, err = d.tombstones(ctx, t, bat)
+			}
+		default:
+			changes, err = t.changes(entry.EntryType == api.Entry_Delete, bat)
+		}
+		if err != nil {
+			return err
+		}
+		d.appendPending(changes)
+	}
+	for _, obj := range objects {
+		if replaced[obj.ts] {
+			continue
+		}
+		changes, err := d.objectRows(ctx, t, obj)
+		if err != nil {
+			return err
+		}
+		d.appendPending(changes)
+	}
+	return nil
+}
+
+// appendPending appends the changes after the start of the stream.
+func (d *binlogDumper) appendPending(changes []binlogChange) {
+	for _, change := range changes {
+		if d.start.Less(&change.ts) {
+			d.pending = append(d.pending, change)
+		}
+	}
+}
+
+// appendObjects appends the non-appendable objects created after the
+// start of the stream in the object entry, and records the timestamps of
+// the deleted objects.
+func (d *binlogDumper) appendObjects(objects []binlogObject, replaced map[types.TS]bool, bat *batch.Batch) []binlogObject {
+	if len(bat.Vecs) < 9 {
+		return objects
+	}
+	statsVec := bat.Vecs[2]
+	appendable := vector.MustFixedCol[bool](bat.Vecs[3])
+	createTS := vector.MustFixedCol[types.TS](bat.Vecs[7])
+	deleteTS := vector.MustFixedCol[types.TS](bat.Vecs[8])
+	for i := range appendable {
+		if !deleteTS[i].IsEmpty() {
+			replaced[deleteTS[i]] = true
+			continue
+		}
+		if appendable[i] || createTS[i].LessEq(&d.start) {
+			continue
+		}
+		objects = append(objects, binlogObject{
+			stats: objectio.ObjectStats(statsVec.GetBytesAt(i)),
+			ts:    createTS[i],
+		})
+	}
+	return objects
+}
+
+// objectRows reads the rows in the object written by a transaction.
+func (d *binlogDumper) objectRows(ctx context.Context, t *binlogTable, obj binlogObject) ([]binlogChange, error) {
+	if d.fs == nil {
+		return nil, moerr.NewInternalError(ctx, "no file service to read the objects for the binlog")
+	}
+	seqnums, typs := t.seqnums()
+	commit := func(int) types.TS { return obj.ts }
+	var changes []binlogChange
+	var err error
+	disttae.ForeachBlkInObjStatsList(false, nil, func(blk objectio.BlockInfo, _ objectio.BlockObject) bool {
+		var bat *batch.Batch
+		var release func()
+		bat, release, err = blockio.LoadColumns(ctx, seqnums, typs, d.fs, blk.MetaLocation(), nil, fileservice.Policy(0))
+		if err != nil {
+			return false
+		}
+		defer release()
+		var rows []binlogChange
+		rows, err = t.inserts(bat.RowCount(), func(seqnum int) *vector.Vector {
+			for i := range seqnums {
+				if int(seqnums[i]) == seqnum {
+					return bat.Vecs[i]
+				}
+			}
+			return nil
+		}, commit)
+		changes = append(changes, rows...)
+		return err == nil
+	}, obj.stats)
+	return changes, err
+}
+
+// tombstones reads the rows deleted by the transactions which write the
+// tombstones into objects directly. The tombstones written by TN have the
+// rows deleted before, which have been sent.
+func (d *binlogDumper) tombstones(ctx context.Context, t *binlogTable, bat *batch.Batch) ([]binlogChange, error) {
+	if len(bat.Vecs) < 8 || t.pkSeqnum < 0 {
+		return nil, nil
+	}
+	deltaLocs := bat.Vecs[6]
+	commits := vector.MustFixedCol[types.TS](bat.Vecs[7])
+	var changes []binlogChange
+	for i := range commits {
+		location := objectio.Location(deltaLocs.GetBytesAt(i))
+		if location.IsEmpty() || commits[i].LessEq(&d.start) {
+			continue
+		}
+		if d.fs == nil {
+			return nil, moerr.NewInternalError(ctx, "no file service to read the tombstones for the binlog")
+		}
+		deletes, byCN, release, err := blockio.ReadBlockDelete(ctx, location, d.fs)
+		if err != nil {
+			return nil, err
+		}
+		var rows []binlogChange
+		if byCN {
+			ts := commits[i]
+			rows, err = t.deletes(deletes.Vecs[1], func(int) types.TS { return ts })
+		}
+		release()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, rows...)
+	}
+	return changes, nil
+}
+
+// take takes the rows which can be sent, in the order of the commit timestamp.
+func (d *binlogDumper) take(now time.Time) []binlogChange {
+	limit := d.safe
+	if now.Sub(d.lastNotify) >= binlogFlushDelay {
+		limit = d.watermark
+	}
+	var taken []binlogChange
+	pending := d.pending[:0]
+	for _, change := range d.pending {
+		if change.ts.LessEq(&limit) {
+			taken = append(taken, change)
+		} else {
+			pending = append(pending, change)
+		}
+	}
+	d.pending = pending
+	// the rows of a transaction keep the order they are received
+	sort.SliceStable(taken, func(i, j int) bool {
+		return taken[i].ts.Less(&taken[j].ts)
+	})
+	return taken
+}
+
+// events makes the events of the transactions in the changes. The
+// transactions executed by the replica are skipped.
+func (d *binlogDumper) events(changes []binlogChange) [][]byte {
+	var events [][]byte
+	for start := 0; start < len(changes); {
+		end := start + 1
+		for end < len(changes) && changes[end].ts.Equal(&changes[start].ts) {
+			end++
+		}
+		gno := binlogGno(changes[start].ts)
+		if gno > d.req.executed {
+			if gno <= d.lastGno {
+				gno = d.lastGno + 1
+			}
+			d.lastGno = gno
+			events = append(events, d.writer.transaction(gno, changes[start:end])...)
+		}
+		start = end
+	}
+	return events
+}
+
+// replay returns the changes between the snapshot of the GTID set executed
+// by the replica and the start of the stream, as one transaction committed
+// at the start. The snapshot is at the physical time of the last executed
+// gno, see binlogGno. It fails if the snapshot is not kept any more.
+func (d *binlogDumper) replay(ctx context.Context) ([]binlogChange, error) {
+	if d.req.flags&binlogThroughGTID == 0 || binlogGno(d.start) <= d.req.executed {
+		return nil, nil
+	}
+	from := types.BuildTS(int64(d.req.executed), 0)
+
+	bh := d.ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	// the tables created after the snapshot are replayed from empty
+	existed := make(map[uint64]bool)
+	if d.req.executed > 0 {
+		bats, err := execForBatches(ctx, bh, getSqlForBinlogTablesAt(from))
+		if err != nil {
+			return nil, err
+		}
+		for _, bat := range bats {
+			for i := 0; i < bat.RowCount(); i++ {
+				existed[vector.GetFixedAt[uint64](bat.Vecs[0], i)] = true
+			}
+		}
+	}
+
+	ids := make([]uint64, 0, len(d.dbNames))
+	for id := range d.dbNames {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	var changes []binlogChange
+	for _, id := range ids {
+		item := d.source.GetTableItem(d.dbIDs[id], id)
+		if item == nil || item.TableDef == nil {
+			continue
+		}
+		t := d.table(id, item.Name, item.TableDef)
+		replayed, err := d.replayTable(ctx, bh, t, from, existed[id])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, replayed...)
+		if len(changes) > binlogMaxPendingRows {
+			return nil, moerr.NewInternalError(ctx, "too many rows to replay for the binlog replica")
+		}
+	}
+	return changes, nil
+}
+
+// replayTable returns the changes of the table between the snapshots.
+func (d *binlogDumper) replayTable(ctx context.Context, bh BackgroundExec, t *binlogTable, from types.TS, existed bool) ([]binlogChange, error) {
+	names := make([]string, len(t.columns))
+	for i := range t.columns {
+		names[i] = "`" + escapeIdent(t.columns[i].name) + "`"
+	}
+	scan := func(ts types.TS) string {
+		return fmt.Sprintf(binlogScanFormat, strings.Join(names, ", "),
+			escapeIdent(t.dbName), escapeIdent(t.name), binlogSnapshot(ts))
+	}
+	if !existed {
+		return d.replayRows(ctx, bh, t, false, scan(d.start))
+	}
+	inserts, err := d.replayRows(ctx, bh, t, false, scan(d.start)+" except "+scan(from))
+	if err != nil {
+		return nil, err
+	}
+	if len(t.pk) == 0 {
+		// EXCEPT drops the duplicated rows, and the deleted rows without
+		// the primary key have no row image, so the table is replayed only
+		// if all the changes are the inserts of the distinct rows.
+		before, err := d.countRows(ctx, bh, t, from)
+		if err != nil {
+			return nil, err
+		}
+		after, err := d.countRows(ctx, bh, t, d.start)
+		if err != nil {
+			return nil, err
+		}
+		if after-before != int64(len(inserts)) {
+			return nil, moerr.NewInternalError(ctx, "cannot replay the changes of %s.%s without primary key for the binlog replica",
+				t.dbName, t.name)
+		}
+		return inserts, nil
+	}
+	deletes, err := d.replayRows(ctx, bh, t, true, scan(from)+" except "+scan(d.start))
+	if err != nil {
+		return nil, err
+	}
+	return append(deletes, inserts...), nil
+}
+
+func (d *binlogDumper) replayRows(ctx context.Context, bh BackgroundExec, t *binlogTable, deleted bool, sql string) ([]binlogChange, error) {
+	bats, err := execForBatches(ctx, bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	var changes []binlogChange
+	for _, bat := range bats {
+		rows, err := t.rows(deleted, bat, d.start)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, rows...)
+	}
+	return changes, nil
+}
+
+func (d *binlogDumper) countRows(ctx context.Context, bh BackgroundExec, t *binlogTable, ts types.TS) (int64, error) {
+	sql := fmt.Sprintf(binlogCountFormat, escapeIdent(t.dbName), escapeIdent(t.name), binlogSnapshot(ts))
+	bats, err := execForBatches(ctx, bh, sql)
+	if err != nil {
+		return 0, err
+	}
+	if len(bats) == 0 || bats[0].RowCount() == 0 {
+		return 0, nil
+	}
+	return vector.GetFixedAt[int64](bats[0].Vecs[0], 0), nil
+}
+
+const (
+	binlogScanFormat  = "select %s from `%s`.`%s` {MO_TS = '%s'}"
+	binlogCountFormat = "select count(*) from `%s`.`%s` {MO_TS = '%s'}"
+)
+
+// binlogSnapshot returns the timestamp in the MO_TS hint.
+func binlogSnapshot(ts types.TS) string {
+	return fmt.Sprintf("%d-%d", ts.Physical(), ts.Logical())
+}
+
+// refreshTables subscribes the user tables of the account, and includes
+// them in the stream.
+func (d *binlogDumper) refreshTables(ctx context.Context) error {
+	bh := d.ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getSqlForBinlogTables()); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	dbIDs := make(map[uint64]uint64)
+	dbNames := make(map[uint64]string)
+	for _, er := range erArray {
+		for i := uint64(0); i < er.GetRowCount(); i++ {
+			tableID, err := er.GetUint64(ctx, i, 0)
+			if err != nil {
+				return err
+			}
+			tableName, err := er.GetString(ctx, i, 1)
+			if err != nil {
+				return err
+			}
+			dbID, err := er.GetUint64(ctx, i, 2)
+			if err != nil {
+				return err
+			}
+			dbName, err := er.GetString(ctx, i, 3)
+			if err != nil {
+				return err
+			}
+			if _, ok := sysDatabases[dbName]; ok || strings.HasPrefix(tableName, catalog.IndexTableNamePrefix) {
+				continue
+			}
+			if err = d.source.TryToSubscribeTable(ctx, dbID, tableID); err != nil {
+				logError(d.ses, d.ses.GetDebugString(),
+					"Failed to subscribe the table for the binlog",
+					zap.Uint64("table", tableID),
+					zap.Error(err))
+				continue
+			}
+			dbIDs[tableID] = dbID
+			dbNames[tableID] = dbName
+		}
+	}
+
+	d.dbIDs, d.dbNames = dbIDs, dbNames
+	for id := range d.tables {
+		if _, ok := dbNames[id]; !ok {
+			delete(d.tables, id)
+		}
+	}
+	return nil
+}
+
+func getSqlForBinlogTables() string {
+	return fmt.Sprintf("select rel_id, relname, reldatabase_id, reldatabase from %s.mo_tables where relkind = '%s'",
+		moCatalog, catalog.SystemOrdinaryRel)
+}
+
+func getSqlForBinlogTablesAt(ts types.TS) string {
+	return fmt.Sprintf("select rel_id from %s.mo_tables {MO_TS = '%s'} where relkind = '%s'",
+		moCatalog, binlogSnapshot(ts), catalog.SystemOrdinaryRel)
+}
+
+// run sends the binlog stream until the replica quits or an error occurs.
+func (d *binlogDumper) run(ctx context.Context) error {
+	remove := d.source.AddLogtailListener(d.onLogtail)
+	defer remove()
+	if err := d.refreshTables(ctx); err != nil {
+		return err
+	}
+	// the logtail after the start is received by the listener, and the
+	// changes before it are in the snapshot.
+	d.start = types.TimestampToTS(d.source.LatestLogtailAppliedTime())
+	if d.start.IsEmpty() {
+		return moerr.NewInternalError(ctx, "the logtail is not ready for the binlog")
+	}
+	replayed, err := d.replay(ctx)
+	if err != nil {
+		return err
+	}
+
+	proto := d.ses.GetMysqlProtocol()
+	now := time.Now()
+	if err = proto.sendBinlogEvent(d.writer.rotate()); err != nil {
+		return err
+	}
+	if err = proto.sendBinlogEvent(d.writer.formatDescription(now)); err != nil {
+		return err
+	}
+	for _, event := range d.events(replayed) {
+		if err = proto.sendBinlogEvent(event); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(binlogFlushDelay)
+	defer ticker.Stop()
+	lastSent, lastRefresh := now, now
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now = <-ticker.C:
+		}
+
+		if err = d.receive(ctx, now); err != nil {
+			return err
+		}
+		for _, event := range d.events(d.take(now)) {
+			if err = proto.sendBinlogEvent(event); err != nil {
+				return err
+			}
+			lastSent = now
+		}
+		if now.Sub(lastSent) >= binlogHeartbeatPeriod {
+			if err = proto.sendBinlogEvent(d.writer.heartbeat()); err != nil {
+				return err
+			}
+			lastSent = now
+		}
+		if now.Sub(lastRefresh) >= binlogRefreshPeriod {
+			if err = d.refreshTables(ctx); err != nil {
+				logError(d.ses, d.ses.GetDebugString(),
+					"Failed to refresh the tables of the binlog",
+					zap.Error(err))
+			}
+			lastRefresh = now
+		}
+	}
+}
+
+// handleBinlogDump handles COM_BINLOG_DUMP and COM_BINLOG_DUMP_GTID. The
+// connection is used for the binlog stream until it is closed.
+func handleBinlogDump(ctx context.Context, ses *Session, cmd CommandType, data []byte) error {
+	req, err := parseBinlogDump(ctx, cmd, data)
+	if err != nil {
+		return err
+	}
+	if !ses.GetTenantInfo().IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can replicate the binlog")
+	}
+	source, ok := getGlobalPu().StorageEngine.(binlogSource)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "binlog dump in the storage engine")
+	}
+	logInfof(ses.GetDebugString(), fmt.Sprintf("binlog dump from the replica %d, executed gno %d",
+		req.serverID, req.executed))
+	fs, err := fileservice.Get[fileservice.FileService](getGlobalPu().FileService, defines.SharedFileServiceName)
+	if err != nil {
+		return err
+	}
+	d := newBinlogDumper(ses, source, fs, req, makeServerVersion(getGlobalPu(), serverVersion.Load().(string)))
+	return d.run(ctx)
+}
@@ -0,0 +1,355 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/cache"
+)
+
+func Test_parseBinlogDump(t *testing.T) {
+	ctx := context.TODO()
+
+	data := binary.LittleEndian.AppendUint32(nil, 4)
+	data = binary.LittleEndian.AppendUint16(data, 0)
+	data = binary.LittleEndian.AppendUint32(data, 100)
+	data = append(data, binlogFileName...)
+	req, err := parseBinlogDump(ctx, COM_BINLOG_DUMP, data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), req.serverID)
+	require.Equal(t, uint64(4), req.position)
+	require.Equal(t, binlogFileName, req.fileName)
+
+	// the GTID set has binlogServerUUID:1-99:120-129 and another sid
+	var set []byte
+	set = binary.LittleEndian.AppendUint64(set, 2)
+	other := uuid.New()
+	set = append(set, other[:]...)
+	set = binary.LittleEndian.AppendUint64(set, 1)
+	set = binary.LittleEndian.AppendUint64(set, 1)
+	set = binary.LittleEndian.AppendUint64(set, 1000)
+	sid := uuid.MustParse(binlogServerUUID)
+	set = append(set, sid[:]...)
+	set = binary.LittleEndian.AppendUint64(set, 2)
+	set = binary.LittleEndian.AppendUint64(set, 1)
+	set = binary.LittleEndian.AppendUint64(set, 100)
+	set = binary.LittleEndian.AppendUint64(set, 120)
+	set = binary.LittleEndian.AppendUint64(set, 130)
+
+	data = binary.LittleEndian.AppendUint16(nil, binlogThroughGTID)
+	data = binary.LittleEndian.AppendUint32(data, 101)
+	data = binary.LittleEndian.AppendUint32(data, 0)
+	data = binary.LittleEndian.AppendUint64(data, 4)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(set)))
+	data = append(data, set...)
+	req, err = parseBinlogDump(ctx, COM_BINLOG_DUMP_GTID, data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(101), req.serverID)
+	require.Equal(t, uint64(129), req.executed)
+
+	_, err = parseBinlogDump(ctx, COM_BINLOG_DUMP_GTID, data[:len(data)-1])
+	require.Error(t, err)
+	_, err = parseBinlogDump(ctx, COM_BINLOG_DUMP, data[:5])
+	require.Error(t, err)
+}
+
+func Test_appendBinlogDecimal(t *testing.T) {
+	// the examples in the decimal2bin of MySQL
+	data, err := appendBinlogDecimal(nil, "1234567890.1234", 14, 4)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x81, 0x0d, 0xfb, 0x38, 0xd2, 0x04, 0xd2}, data)
+
+	data, err = appendBinlogDecimal(nil, "-1234567890.1234", 14, 4)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x7e, 0xf2, 0x04, 0xc7, 0x2d, 0xfb, 0x2d}, data)
+
+	data, err = appendBinlogDecimal(nil, "0.50", 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x80, 50}, data)
+
+	_, err = appendBinlogDecimal(nil, "1000", 3, 0)
+	require.Error(t, err)
+}
+
+func Test_appendBinlogTemporal(t *testing.T) {
+	col := &binlogColumn{typ: types.New(types.T_date, 0, 0)}
+	date, err := types.ParseDateCast("2024-02-29")
+	require.NoError(t, err)
+	data, err := appendBinlogValue(nil, col, date)
+	require.NoError(t, err)
+	require.Equal(t, appendBinlogUint24(nil, 2024*512+2*32+29), data)
+
+	// 2024-02-29 12:34:56.789 with the fsp 3
+	dt, err := types.ParseDatetime("2024-02-29 12:34:56.789", 3)
+	require.NoError(t, err)
+	data = appendBinlogDatetime(nil, dt, 3)
+	require.Len(t, data, 7)
+	ymd := uint64(2024*13+2)<<5 | 29
+	hms := uint64(12)<<12 | uint64(34)<<6 | 56
+	var packed [8]byte
+	binary.BigEndian.PutUint64(packed[:], (ymd<<17|hms)+0x8000000000)
+	require.Equal(t, packed[3:], data[:5])
+	require.Equal(t, uint16(7890), binary.BigEndian.Uint16(data[5:]))
+
+	// the TIME2 is offset by 0x800000
+	require.Equal(t, []byte{0x80, 0, 0}, appendBinlogTime(nil, types.Time(0), 0))
+	require.Equal(t, []byte{0x7f, 0xff, 0xff}, appendBinlogTime(nil, types.Time(-types.MicroSecsPerSec), 0))
+	require.Equal(t, []byte{0x80, 0x10, 0x00, 0x32}, appendBinlogTime(nil, types.Time(types.SecsPerHour*types.MicroSecsPerSec+500000), 2))
+}
+
+func newTestBinlogTable() *binlogTable {
+	def := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a", Seqnum: 0, Typ: plan.Type{Id: int32(types.T_int32), NotNullable: true}},
+			{Name: "b", Seqnum: 1, Typ: plan.Type{Id: int32(types.T_varchar), Width: 10}},
+			{Name: "__mo_rowid", Seqnum: 2, Hidden: true, Typ: plan.Type{Id: int32(types.T_Rowid)}},
+		},
+		Pkey: &plan.PrimaryKeyDef{PkeyColName: "a", Names: []string{"a"}},
+	}
+	return newBinlogTable(272515, "db", "t", def)
+}
+
+func newTestBinlogBatch(t *testing.T, mp *mpool.MPool, ts types.TS, deleted bool, rows ...[]any) *batch.Batch {
+	bat := batch.NewWithSize(4)
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_int32.ToType())
+	bat.Vecs[3] = vector.NewVec(types.T_varchar.ToType())
+	if deleted {
+		bat.Vecs = bat.Vecs[:3]
+	}
+	for _, row := range rows {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], types.Rowid{}, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], ts, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], row[0].(int32), false, mp))
+		if !deleted {
+			require.NoError(t, vector.AppendBytes(bat.Vecs[3], []byte(row[1].(string)), row[1] == "", mp))
+		}
+	}
+	bat.SetRowCount(len(rows))
+	return bat
+}
+
+func Test_binlogTable(t *testing.T) {
+	tbl := newTestBinlogTable()
+	require.Len(t, tbl.columns, 2)
+	require.Equal(t, []int{0}, tbl.pk)
+	require.Equal(t, 0, tbl.pkSeqnum)
+	require.Equal(t, []byte{40, 0}, tbl.columns[1].meta)
+
+	event := tbl.tableMapEvent()
+	require.Equal(t, appendBinlogUint48(nil, 272515), event[:6])
+	pos := 8
+	require.Equal(t, "db\x00", string(event[pos+1:pos+4]))
+	pos += 4
+	require.Equal(t, "t\x00", string(event[pos+1:pos+3]))
+	pos += 3
+	// column count, types, metadata and the nullable bitmap
+	require.Equal(t, []byte{2, 3, 15, 2, 40, 0, 0x02}, event[pos:pos+7])
+
+	mp := mpool.MustNewZero()
+	ts := types.BuildTS(time.Now().UnixNano(), 0)
+	changes, err := tbl.changes(false, newTestBinlogBatch(t, mp, ts, false, []any{int32(1), "a"}, []any{int32(2), ""}))
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	// the null bitmap, 4 bytes of a and the string with the 1 byte length
+	require.Equal(t, []byte{0, 1, 0, 0, 0, 1, 'a'}, changes[0].image)
+	require.Equal(t, []byte{0x02, 2, 0, 0, 0}, changes[1].image)
+
+	deletes, err := tbl.changes(true, newTestBinlogBatch(t, mp, ts, true, []any{int32(1)}))
+	require.NoError(t, err)
+	require.Len(t, deletes, 1)
+	require.Equal(t, []byte{0, 1, 0, 0, 0}, deletes[0].image)
+	require.Equal(t, changes[0].key, deletes[0].key)
+}
+
+func Test_binlogWriterTransaction(t *testing.T) {
+	tbl := newTestBinlogTable()
+	mp := mpool.MustNewZero()
+	ts := types.BuildTS(time.Now().UnixNano(), 1)
+	inserts, err := tbl.changes(false, newTestBinlogBatch(t, mp, ts, false, []any{int32(1), "a"}, []any{int32(2), "b"}))
+	require.NoError(t, err)
+	deletes, err := tbl.changes(true, newTestBinlogBatch(t, mp, ts, true, []any{int32(1)}, []any{int32(3)}))
+	require.NoError(t, err)
+
+	w := newBinlogWriter("8.0.30-MatrixOne")
+	rotate := w.rotate()
+	require.Equal(t, binlogRotateEvent, rotate[4])
+	require.Equal(t, uint32(0), binary.LittleEndian.Uint32(rotate[13:]))
+	fde := w.formatDescription(time.Now())
+	require.Equal(t, binlogFormatDescriptionEvent, fde[4])
+	require.Equal(t, uint32(binlogStartPos+len(fde)), binary.LittleEndian.Uint32(fde[13:]))
+
+	events := w.transaction(100, append(deletes, inserts...))
+	var typs []byte
+	pos := uint32(binlogStartPos + len(fde))
+	for _, event := range events {
+		typs = append(typs, event[4])
+		require.Equal(t, uint32(len(event)), binary.LittleEndian.Uint32(event[9:]))
+		pos += uint32(len(event))
+		require.Equal(t, pos, binary.LittleEndian.Uint32(event[13:]))
+	}
+	require.Equal(t, []byte{
+		binlogGtidEvent, binlogQueryEvent, binlogTableMapEvent,
+		binlogDeleteRowsEventV2, binlogUpdateRowsEventV2, binlogWriteRowsEventV2,
+		binlogXidEvent,
+	}, typs)
+	// the GTID of the transaction
+	require.Equal(t, uint64(100), binary.LittleEndian.Uint64(events[0][binlogHeaderLen+17:]))
+	// only the last rows event ends the statement
+	require.Equal(t, uint16(0), binary.LittleEndian.Uint16(events[3][binlogHeaderLen+6:]))
+	require.Equal(t, binlogStmtEndFlag, binary.LittleEndian.Uint16(events[5][binlogHeaderLen+6:]))
+	require.Equal(t, uint32(w.pos), pos)
+
+	heartbeat := w.heartbeat()
+	require.Equal(t, pos, binary.LittleEndian.Uint32(heartbeat[13:]))
+	require.Equal(t, uint32(w.pos), pos)
+}
+
+func Test_binlogDumperEvents(t *testing.T) {
+	tbl := newTestBinlogTable()
+	mp := mpool.MustNewZero()
+	now := time.Now().UnixNano()
+	ts1 := types.BuildTS(now, 0)
+	ts2 := types.BuildTS(now+1000, 0)
+
+	d := &binlogDumper{
+		req:    &binlogDumpRequest{executed: binlogGno(ts1)},
+		writer: newBinlogWriter("8.0.30-MatrixOne"),
+	}
+	d.lastGno = d.req.executed
+	c1, err := tbl.changes(false, newTestBinlogBatch(t, mp, ts1, false, []any{int32(1), "a"}))
+	require.NoError(t, err)
+	c2, err := tbl.changes(false, newTestBinlogBatch(t, mp, ts2, false, []any{int32(2), "b"}))
+	require.NoError(t, err)
+	d.pending = append(c2, c1...)
+	d.watermark = ts2
+	d.safe = ts1
+
+	// only the rows before the safe timestamp are taken just after the logtail
+	d.lastNotify = time.Now()
+	changes := d.take(d.lastNotify)
+	require.Len(t, changes, 1)
+	// the transaction executed by the replica is skipped
+	require.Empty(t, d.events(changes))
+
+	changes = d.take(d.lastNotify.Add(binlogFlushDelay))
+	require.Len(t, changes, 1)
+	events := d.events(changes)
+	require.Len(t, events, 5)
+	require.Equal(t, binlogGno(ts2), binary.LittleEndian.Uint64(events[0][binlogHeaderLen+17:]))
+	require.Empty(t, d.pending)
+}
+
+func Test_binlogDumperQueue(t *testing.T) {
+	tbl := newTestBinlogTable()
+	mp := mpool.MustNewZero()
+	now := time.Now().UnixNano()
+	start := types.BuildTS(now, 0)
+	ts := types.BuildTS(now+1000, 0)
+
+	d := &binlogDumper{
+		accountID: 1,
+		req:       &binlogDumpRequest{},
+		tails:     make(chan binlogTail, 1),
+		start:     start,
+		dbNames:   map[uint64]string{tbl.id: "db"},
+		tables:    make(map[uint64]*binlogTable),
+	}
+	item := &cache.TableItem{AccountId: 1, Id: tbl.id, Name: "t", TableDef: tbl.def}
+	tail := func(ts types.TS, rows ...[]any) *logtail.TableLogtail {
+		bat, err := batch.BatchToProtoBatch(newTestBinlogBatch(t, mp, ts, false, rows...))
+		require.NoError(t, err)
+		commit := ts.ToTimestamp()
+		return &logtail.TableLogtail{
+			Ts: &commit,
+			Commands: []api.Entry{{
+				EntryType: api.Entry_Insert,
+				TableName: "t",
+				Bat:       bat,
+			}},
+		}
+	}
+
+	// the entries are copied by the listener, and decoded by the dumper
+	first := tail(ts, []any{int32(1), "a"}, []any{int32(2), "b"})
+	d.onLogtail(item, first)
+	first.Commands[0].Bat.Vecs[2].Data[0] = 9
+	require.NoError(t, d.receive(context.TODO(), time.Now()))
+	require.Len(t, d.pending, 2)
+	require.Equal(t, []byte{0, 1, 0, 0, 0, 1, 'a'}, d.pending[0].image)
+	require.Equal(t, ts, d.watermark)
+
+	// the rows committed before the start are in the snapshot
+	d.pending = nil
+	d.onLogtail(item, tail(start, []any{int32(3), "c"}))
+	require.NoError(t, d.receive(context.TODO(), time.Now()))
+	require.Empty(t, d.pending)
+
+	// the logtail of the other accounts is not queued
+	d.onLogtail(&cache.TableItem{AccountId: 2, Id: tbl.id, TableDef: tbl.def}, tail(ts))
+	require.Empty(t, d.tails)
+
+	// the replica is disconnected when the queue overflows
+	d.onLogtail(item, tail(ts))
+	d.onLogtail(item, tail(ts))
+	require.Error(t, d.receive(context.TODO(), time.Now()))
+}
+
+func Test_binlogTableRows(t *testing.T) {
+	tbl := newTestBinlogTable()
+	mp := mpool.MustNewZero()
+	ts := types.BuildTS(time.Now().UnixNano(), 0)
+
+	// the query result has the columns of the table in order
+	bat := newTestBinlogBatch(t, mp, ts, false, []any{int32(1), "a"}, []any{int32(2), ""})
+	bat.Vecs = bat.Vecs[2:]
+	inserts, err := tbl.rows(false, bat, ts)
+	require.NoError(t, err)
+	require.Len(t, inserts, 2)
+	require.Equal(t, []byte{0, 1, 0, 0, 0, 1, 'a'}, inserts[0].image)
+	require.Equal(t, []byte{0x02, 2, 0, 0, 0}, inserts[1].image)
+
+	deletes, err := tbl.rows(true, bat, ts)
+	require.NoError(t, err)
+	require.Len(t, deletes, 2)
+	require.Equal(t, []byte{0, 1, 0, 0, 0}, deletes[0].image)
+	require.True(t, deletes[0].delete)
+	// the delete and the insert of the same key are paired as an update
+	require.Equal(t, inserts[0].key, deletes[0].key)
+
+	events := newBinlogWriter("8.0.30-MatrixOne").transaction(100, append(deletes[:1], inserts[0]))
+	var typs []byte
+	for _, event := range events {
+		typs = append(typs, event[4])
+	}
+	require.Contains(t, typs, binlogUpdateRowsEventV2)
+	require.NotContains(t, typs, binlogWriteRowsEventV2)
+}
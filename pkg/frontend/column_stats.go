@@ -0,0 +1,325 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+const (
+	// analyzedStatsTTL bounds how long the column stats saved by ANALYZE TABLE
+	// on another CN take to be seen.
+	analyzedStatsTTL = 5 * time.Minute
+	// analyzedStatsCacheMaxSize bounds the number of tables whose column stats
+	// are cached.
+	analyzedStatsCacheMaxSize = 8192
+)
+
+const (
+	getColumnStatsFormat    = `select column_name, histogram, mcv from mo_catalog.mo_column_stats where table_id = %d;`
+	deleteColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d and column_name = '%s';`
+	insertColumnStatsFormat = `insert into mo_catalog.mo_column_stats(
+		table_id,
+		column_name,
+		database_name,
+		table_name,
+		histogram,
+		mcv,
+		analyzed_time) values (%d, '%s', '%s', '%s', %s, %s, now());`
+	columnMCVFormat    = "select `%s`, count(*) from `%s`.`%s` where `%s` is not null group by `%s` order by count(*) desc limit %d;"
+	columnSampleFormat = "select sample(`%s`, %d rows) from `%s`.`%s`;"
+	tableRowsFormat    = "select count(*) from `%s`.`%s`;"
+)
+
+// analyzedStats holds the column stats of a table saved by ANALYZE TABLE.
+type analyzedStats struct {
+	hists    map[string]*pb.Histogram
+	mcvs     map[string]*pb.MCVList
+	loadTime time.Time
+}
+
+// analyzedStatsCache caches mo_catalog.mo_column_stats by table id, so that
+// the planner does not query it each time the stats of a table are refreshed.
+var analyzedStatsCache = struct {
+	sync.Mutex
+	tables map[uint64]*analyzedStats
+}{tables: make(map[uint64]*analyzedStats)}
+
+func getAnalyzedStats(tableID uint64) *analyzedStats {
+	analyzedStatsCache.Lock()
+	defer analyzedStatsCache.Unlock()
+	as, ok := analyzedStatsCache.tables[tableID]
+	if !ok || time.Since(as.loadTime) > analyzedStatsTTL {
+		return nil
+	}
+	return as
+}
+
+func setAnalyzedStats(tableID uint64, as *analyzedStats) {
+	analyzedStatsCache.Lock()
+	defer analyzedStatsCache.Unlock()
+	if len(analyzedStatsCache.tables) > analyzedStatsCacheMaxSize {
+		analyzedStatsCache.tables = make(map[uint64]*analyzedStats)
+	}
+	analyzedStatsCache.tables[tableID] = as
+}
+
+func invalidateAnalyzedStats(tableID uint64) {
+	analyzedStatsCache.Lock()
+	defer analyzedStatsCache.Unlock()
+	delete(analyzedStatsCache.tables, tableID)
+}
+
+// withAnalyzedStats overlays the column stats ANALYZE TABLE saved for a table
+// on the stats the engine built from the zonemaps.
+func (tcc *TxnCompilerContext) withAnalyzedStats(ctx context.Context, tableID uint64, s *pb.StatsInfo) *pb.StatsInfo {
+	ses := tcc.GetSession()
+	if s == nil || ses == nil {
+		return s
+	}
+	as := getAnalyzedStats(tableID)
+	if as == nil {
+		var err error
+		if as, err = loadAnalyzedStats(ctx, ses, tableID); err != nil {
+			logError(ses, ses.GetDebugString(),
+				"load column stats failed",
+				zap.Uint64("tableID", tableID),
+				zap.Error(err))
+		}
+		setAnalyzedStats(tableID, as)
+	}
+	return plan2.WithAnalyzedStats(s, as.hists, as.mcvs)
+}
+
+// loadAnalyzedStats reads the column stats of a table from
+// mo_catalog.mo_column_stats. It always returns a usable analyzedStats, empty
+// on error, so that a failing table is not queried over and over.
+func loadAnalyzedStats(ctx context.Context, ses FeSession, tableID uint64) (*analyzedStats, error) {
+	as := &analyzedStats{
+		hists:    make(map[string]*pb.Histogram),
+		mcvs:     make(map[string]*pb.MCVList),
+		loadTime: time.Now(),
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bats, err := execForBatches(ctx, bh, fmt.Sprintf(getColumnStatsFormat, tableID))
+	if err != nil {
+		return as, err
+	}
+	for _, bat := range bats {
+		for i := 0; i < bat.RowCount(); i++ {
+			colName := bat.Vecs[0].GetStringAt(i)
+			if !bat.Vecs[1].IsNull(uint64(i)) {
+				hist := &pb.Histogram{}
+				if err = json.Unmarshal(bat.Vecs[1].GetBytesAt(i), hist); err != nil {
+					return as, err
+				}
+				as.hists[colName] = hist
+			}
+			if !bat.Vecs[2].IsNull(uint64(i)) {
+				mcv := &pb.MCVList{}
+				if err = json.Unmarshal(bat.Vecs[2].GetBytesAt(i), mcv); err != nil {
+					return as, err
+				}
+				as.mcvs[colName] = mcv
+			}
+		}
+	}
+	return as, nil
+}
+
+// collectColumnStats builds the histograms and most common values of the
+// columns of an ANALYZE TABLE and saves them to mo_catalog.mo_column_stats.
+func collectColumnStats(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt) (err error) {
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	tblName := string(stmt.Table.ObjectName)
+	if dbName == "" || dbName == catalog.MO_CATALOG {
+		return nil
+	}
+	// leave the errors of a missing table or a view to the analyze query
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName)
+	if tableDef == nil || tableDef.TableType == catalog.SystemViewRel {
+		return nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bats, err := execForBatches(ctx, bh, fmt.Sprintf(tableRowsFormat, escapeIdent(dbName), escapeIdent(tblName)))
+	if err != nil {
+		return err
+	}
+	var rows float64
+	if len(bats) > 0 && bats[0].RowCount() > 0 {
+		rows = float64(vector.GetFixedAt[int64](bats[0].Vecs[0], 0))
+	}
+
+	hists := make(map[string]*pb.Histogram)
+	mcvs := make(map[string]*pb.MCVList)
+	for _, ident := range stmt.Cols {
+		for _, col := range tableDef.Cols {
+			typ := types.T(col.Typ.Id)
+			if !strings.EqualFold(col.Name, string(ident)) || !plan2.IsHistogramType(typ) {
+				continue
+			}
+			if rows > 0 {
+				if mcvs[col.Name], err = collectColumnMCV(ctx, bh, dbName, tblName, col.Name, typ, rows); err != nil {
+					return err
+				}
+			}
+			if hists[col.Name], err = collectColumnHistogram(ctx, bh, dbName, tblName, col.Name, typ); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	if len(hists) == 0 {
+		return nil
+	}
+
+	if err = saveColumnStats(ctx, bh, tableDef.TblId, dbName, tblName, hists, mcvs); err != nil {
+		return err
+	}
+	invalidateAnalyzedStats(tableDef.TblId)
+	sc := ses.GetTxnCompileCtx().GetStatsCache()
+	if s := sc.GetStatsInfo(tableDef.TblId, false); s != nil {
+		sc.SetStatsInfo(tableDef.TblId, plan2.WithAnalyzedStats(s, hists, mcvs))
+	}
+	return nil
+}
+
+// collectColumnMCV returns the values of a column repeated the most, along
+// with the fraction of the rows each of them accounts for.
+func collectColumnMCV(ctx context.Context, bh BackgroundExec, dbName, tblName, colName string, typ types.T, rows float64) (*pb.MCVList, error) {
+	col := escapeIdent(colName)
+	sql := fmt.Sprintf(columnMCVFormat, col, escapeIdent(dbName), escapeIdent(tblName), col, col, plan2.DefaultMCVSize)
+	bats, err := execForBatches(ctx, bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	mcv := &pb.MCVList{}
+	for _, bat := range bats {
+		for i := 0; i < bat.RowCount(); i++ {
+			cnt := vector.GetFixedAt[int64](bat.Vecs[1], i)
+			// a value seen once is not a common one
+			if cnt < 2 {
+				continue
+			}
+			mcv.Vals = append(mcv.Vals, plan2.HistogramValue(typ, bat.Vecs[0].GetRawBytesAt(i)))
+			mcv.Freqs = append(mcv.Freqs, float64(cnt)/rows)
+		}
+	}
+	return mcv, nil
+}
+
+// collectColumnHistogram builds the histogram of a column from a sample of
+// its values.
+func collectColumnHistogram(ctx context.Context, bh BackgroundExec, dbName, tblName, colName string, typ types.T) (*pb.Histogram, error) {
+	sql := fmt.Sprintf(columnSampleFormat, escapeIdent(colName), plan2.DefaultHistogramSampleRows, escapeIdent(dbName), escapeIdent(tblName))
+	bats, err := execForBatches(ctx, bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	hb := plan2.NewHistogramBuilder()
+	for _, bat := range bats {
+		vec := bat.Vecs[0]
+		for i := 0; i < bat.RowCount(); i++ {
+			if vec.IsNull(uint64(i)) {
+				continue
+			}
+			v := plan2.HistogramValue(typ, vec.GetRawBytesAt(i))
+			hb.Update(v, v, 1)
+		}
+	}
+	return hb.Build(plan2.DefaultHistogramBuckets), nil
+}
+
+func saveColumnStats(ctx context.Context, bh BackgroundExec, tableID uint64, dbName, tblName string,
+	hists map[string]*pb.Histogram, mcvs map[string]*pb.MCVList) (err error) {
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	var histJson, mcvJson string
+	for colName, hist := range hists {
+		if histJson, err = jsonOrNull(hist); err != nil {
+			return err
+		}
+		if mcvJson, err = jsonOrNull(mcvs[colName]); err != nil {
+			return err
+		}
+		if err = bh.Exec(ctx, fmt.Sprintf(deleteColumnStatsFormat, tableID, escapeText(colName))); err != nil {
+			return err
+		}
+		sql := fmt.Sprintf(insertColumnStatsFormat, tableID, escapeText(colName), escapeText(dbName), escapeText(tblName), histJson, mcvJson)
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonOrNull returns v as a quoted json string, or NULL if v is nil.
+func jsonOrNull[T any](v *T) (string, error) {
+	if v == nil {
+		return "NULL", nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return "'" + escapeText(string(buf)) + "'", nil
+}
+
+// escapeIdent escapes s for a backquoted identifier.
+func escapeIdent(s string) string {
+	return strings.ReplaceAll(s, "`", "``")
+}
+
+// escapeText escapes s for a single quoted string literal.
+func escapeText(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `'`, `''`)
+}
+
+func execForBatches(ctx context.Context, bh BackgroundExec, sql string) ([]*batch.Batch, error) {
+	bh.ClearExecResultBatches()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	return bh.GetExecResultBatches(), nil
+}
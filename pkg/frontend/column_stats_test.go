@@ -0,0 +1,44 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_columnStatsSQLEscape(t *testing.T) {
+	ctx := context.TODO()
+	db, tbl, col := "d`b", "t'; drop table x; --", "c`) from t; --"
+
+	sql := fmt.Sprintf(columnMCVFormat, escapeIdent(col), escapeIdent(db), escapeIdent(tbl), escapeIdent(col), escapeIdent(col), 10)
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, sql, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stmts))
+
+	sql = fmt.Sprintf(deleteColumnStatsFormat, 1, escapeText(`c\'`))
+	stmts, err = parsers.Parse(ctx, dialect.MYSQL, sql, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stmts))
+	del := stmts[0].(*tree.Delete)
+	require.Contains(t, tree.String(del.Where.Expr, dialect.MYSQL), `c\\'`)
+}
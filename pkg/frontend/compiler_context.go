@@ -226,6 +226,13 @@ func (tcc *TxnCompilerContext) getRelation(dbName string, tableName string, sub
 	// }
 	// logDebugf(ses.GetDebugString(), "dbName %v tableNames %v", dbName, tableNames)
 
+	// a temporary table shadows the permanent table with the same name
+	if sub == nil && ses.IfInitedTempEngine() {
+		if tmpTable, e := tcc.getTmpRelation(txnCtx, engine.GetTempTableName(dbName, tableName)); e == nil {
+			return txnCtx, tmpTable, nil
+		}
+	}
+
 	//open table
 	table, err := db.Relation(txnCtx, tableName, nil)
 	if err != nil {
@@ -650,6 +657,9 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef) (*pb.StatsInfo, error
 	}
 	if needUpdate {
 		s := table.Stats(ctx, true)
+		if sub == nil && dbName != catalog.MO_CATALOG {
+			s = tcc.withAnalyzedStats(ctx, table.GetTableID(ctx), s)
+		}
 		tcc.UpdateStatsInCache(table.GetTableID(ctx), s)
 		return s, nil
 	}
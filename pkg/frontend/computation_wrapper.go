@@ -236,6 +236,13 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, fill func
 	}
 
 	txnCtx = statistic.EnsureStatsInfoCanBeFound(txnCtx, requestCtx)
+	if deadline, ok := requestCtx.Value(statementDeadlineKey{}).(time.Time); ok {
+		// the pipelines run in the txn ctx, so the deadline of the statement
+		// is put on it until the statement ends.
+		var cancel context.CancelFunc
+		txnCtx, cancel = context.WithDeadlineCause(txnCtx, deadline, moerr.NewQueryTimeoutNoCtx())
+		context.AfterFunc(requestCtx, cancel)
+	}
 
 	// Increase the statement ID and update snapshot TS before build plan, because the
 	// snapshot TS is used when build plan.
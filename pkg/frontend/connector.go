@@ -128,10 +128,34 @@ func handleCreateConnector(ctx context.Context, ses *Session, st *tree.CreateCon
 	return nil
 }
 
-func connectorTaskMetadata() pb.TaskMetadata {
+// connectorTaskTypes are the task types of all the connectors.
+var connectorTaskTypes = []pb.TaskType{
+	pb.TaskType_TypeKafkaSinkConnector,
+	pb.TaskType_TypeMysqlBinlogConnector,
+}
+
+// queryConnectorTasks returns the daemon tasks of all the connectors.
+func queryConnectorTasks(ctx context.Context, ts taskservice.TaskService, conds ...taskservice.Condition) ([]pb.DaemonTask, error) {
+	var tasks []pb.DaemonTask
+	for _, typ := range connectorTaskTypes {
+		ret, err := ts.QueryDaemonTask(ctx,
+			append([]taskservice.Condition{taskservice.WithTaskType(taskservice.EQ, typ.String())}, conds...)...)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, ret...)
+	}
+	return tasks, nil
+}
+
+func connectorTaskMetadata(options map[string]string) pb.TaskMetadata {
+	executor := pb.TaskCode_ConnectorKafkaSink
+	if options[moconnector.OptConnectorType] == moconnector.SourceMysql {
+		executor = pb.TaskCode_ConnectorMysqlBinlog
+	}
 	return pb.TaskMetadata{
 		ID:       "-",
-		Executor: pb.TaskCode_ConnectorKafkaSink,
+		Executor: executor,
 		Options: pb.TaskOptions{
 			MaxRetryTimes: defaultConnectorTaskMaxRetryTimes,
 			RetryInterval: defaultConnectorTaskRetryInterval,
@@ -165,6 +189,12 @@ func duplicate(t pb.DaemonTask, options map[string]string) bool {
 			moconnector.OptConnectorTopic,
 			moconnector.OptConnectorServers,
 		}
+		if options[moconnector.OptConnectorType] == moconnector.SourceMysql {
+			checkFields = []string{
+				moconnector.OptConnectorType,
+				moconnector.OptConnectorAddress,
+			}
+		}
 		for _, field := range checkFields {
 			dup = dup && isSameValue(d.Connector.Options, options, field)
 		}
@@ -186,9 +216,7 @@ func createConnector(
 	if err != nil {
 		return err
 	}
-	tasks, err := ts.QueryDaemonTask(ctx,
-		taskservice.WithTaskType(taskservice.EQ,
-			pb.TaskType_TypeKafkaSinkConnector.String()),
+	tasks, err := queryConnectorTasks(ctx, ts,
 		taskservice.WithAccountID(taskservice.EQ,
 			accountID),
 	)
@@ -220,7 +248,7 @@ func createConnector(
 			},
 		},
 	}
-	if err := ts.CreateDaemonTask(ctx, connectorTaskMetadata(), details); err != nil {
+	if err := ts.CreateDaemonTask(ctx, connectorTaskMetadata(options), details); err != nil {
 		return err
 	}
 	return nil
@@ -238,8 +266,7 @@ func handleDropDynamicTable(ctx context.Context, ses *Session, st *tree.DropTabl
 	ts := getGlobalPu().TaskService
 
 	// Query all relevant tasks belonging to the current tenant
-	tasks, err := ts.QueryDaemonTask(ses.GetRequestContext(),
-		taskservice.WithTaskType(taskservice.EQ, pb.TaskType_TypeKafkaSinkConnector.String()),
+	tasks, err := queryConnectorTasks(ses.GetRequestContext(), ts,
 		taskservice.WithAccountID(taskservice.EQ, ses.GetAccountId()),
 		taskservice.WithTaskStatusCond(pb.TaskStatus_Running, pb.TaskStatus_Created, pb.TaskStatus_Paused, pb.TaskStatus_PauseRequested),
 	)
@@ -355,9 +382,7 @@ func showConnectors(ses FeSession) error {
 		return moerr.NewInternalError(ses.GetRequestContext(),
 			"task service not ready yet, please try again later.")
 	}
-	tasks, err := ts.QueryDaemonTask(ses.GetRequestContext(),
-		taskservice.WithTaskType(taskservice.EQ,
-			pb.TaskType_TypeKafkaSinkConnector.String()),
+	tasks, err := queryConnectorTasks(ses.GetRequestContext(), ts,
 		taskservice.WithAccountID(taskservice.EQ,
 			ses.GetAccountId()),
 	)
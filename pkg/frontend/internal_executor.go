@@ -254,6 +254,14 @@ func (ip *internalProtocol) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+func (ip *internalProtocol) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
+func (ip *internalProtocol) sendBinlogEvent(event []byte) error {
+	return nil
+}
+
 func (ip *internalProtocol) GetTcpConnection() goetty.IOSession {
 	return nil
 }
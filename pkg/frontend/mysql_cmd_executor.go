@@ -410,6 +410,9 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	if bat == nil {
 		return nil
 	}
+	if ses.queryCacheWriter != nil {
+		ses.queryCacheWriter.add(bat)
+	}
 
 	begin := time.Now()
 	proto := ses.GetMysqlProtocol()
@@ -1059,6 +1062,9 @@ func handleAnalyzeStmt(requestCtx context.Context, ses *Session, stmt *tree.Anal
 	// IMO, this approach is simple and future-proof
 	// Although this rewriting processing could have been handled in rewrite module,
 	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
+	if err := collectColumnStats(requestCtx, ses, stmt); err != nil {
+		return err
+	}
 	ctx := tree.NewFmtCtx(dialect.MYSQL)
 	ctx.WriteString("select ")
 	for i, ident := range stmt.Cols {
@@ -1299,6 +1305,14 @@ func handleDropSnapshot(ctx context.Context, ses *Session, ct *tree.DropSnapShot
 	return doDropSnapshot(ctx, ses, ct)
 }
 
+func handleCreatePlanBaseline(ctx context.Context, ses *Session, cpb *tree.CreatePlanBaseline) error {
+	return doCreatePlanBaseline(ctx, ses, cpb)
+}
+
+func handleDropPlanBaseline(ctx context.Context, ses *Session, dpb *tree.DropPlanBaseline) error {
+	return doDropPlanBaseline(ctx, ses, dpb)
+}
+
 // handleCreateAccount creates a new user-level tenant in the context of the tenant SYS
 // which has been initialized.
 func handleCreateAccount(ctx context.Context, ses FeSession, ca *tree.CreateAccount, proc *process.Process) error {
@@ -1835,6 +1849,53 @@ func handleShowBackendServers(ctx context.Context, ses FeSession, isLastStmt boo
 	return err
 }
 
+// doShowMasterStatus shows the current position of the binlog stream.
+func doShowMasterStatus(ses *Session) {
+	names := []string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"}
+	mrs := ses.GetMysqlResultSet()
+	for i, name := range names {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if i == 1 {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			col.SetSigned(false)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{binlogFileName, uint64(binlogStartPos), "", "", binlogExecutedGtidSet(time.Now())})
+}
+
+func handleShowMasterStatus(ses FeSession) error {
+	doShowMasterStatus(ses.(*Session))
+	return nil
+}
+
+// doShowBinaryLogs shows the binlog files. The binlog stream is made from
+// the logtail, so there is only one file.
+func doShowBinaryLogs(ses *Session) {
+	mrs := ses.GetMysqlResultSet()
+	col1 := new(MysqlColumn)
+	col1.SetName("Log_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2 := new(MysqlColumn)
+	col2.SetName("File_size")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col2.SetSigned(false)
+	col3 := new(MysqlColumn)
+	col3.SetName("Encrypted")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddRow([]interface{}{binlogFileName, uint64(binlogStartPos), "No"})
+}
+
+func handleShowBinaryLogs(ses FeSession) error {
+	doShowBinaryLogs(ses.(*Session))
+	return nil
+}
+
 func handleEmptyStmt(ctx context.Context, ses FeSession, stmt *tree.EmptyStmt) error {
 	var err error
 	return err
@@ -1884,9 +1945,9 @@ func getExplainOption(requestCtx context.Context, options []tree.OptionElem) (*e
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(requestCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -1992,6 +2053,20 @@ func buildPlan(requestCtx context.Context, ses FeSession, ctx plan2.CompilerCont
 		}
 		return ret, err
 	}
+	if ses != nil && ses.GetTenantInfo() != nil && !ses.IsBackgroundSession() {
+		switch s := stmt.(type) {
+		case *tree.Select:
+			applyPlanBaseline(requestCtx, ses.(*Session), s)
+		case *tree.ExplainStmt:
+			if sel, ok := s.Statement.(*tree.Select); ok {
+				applyPlanBaseline(requestCtx, ses.(*Session), sel)
+			}
+		case *tree.ExplainAnalyze:
+			if sel, ok := s.Statement.(*tree.Select); ok {
+				applyPlanBaseline(requestCtx, ses.(*Session), sel)
+			}
+		}
+	}
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement,
 		*tree.Update, *tree.Delete, *tree.Insert,
@@ -2131,7 +2206,11 @@ var GetComputationWrapper = func(db string, input *UserInput, user string, eng e
 		if err != nil {
 			origin = int64(0)
 		}
-		stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, input.getSql(), v.(int64), origin.(int64))
+		if ses.GetDialectType() == dialect.POSTGRESQL {
+			stmts, err = parsePostgresql(proc.Ctx, input.getSql(), v.(int64), origin.(int64))
+		} else {
+			stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, input.getSql(), v.(int64), origin.(int64))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -2295,7 +2374,9 @@ func processLoadLocal(ctx context.Context, ses FeSession, param *tree.ExternPara
 	packet, ok := msg.(*Packet)
 	if !ok {
 		proto.SetSequenceID(proto.GetSequenceId() + 1)
-		err = moerr.NewInvalidInput(ctx, "invalid packet")
+		if err = pgCopyFailError(ctx, msg); err == nil {
+			err = moerr.NewInvalidInput(ctx, "invalid packet")
+		}
 		return
 	}
 
@@ -2342,13 +2423,21 @@ func processLoadLocal(ctx context.Context, ses FeSession, param *tree.ExternPara
 		}
 		packet, ok = msg.(*Packet)
 		if !ok {
-			err = moerr.NewInvalidInput(ctx, "invalid packet")
+			if err = pgCopyFailError(ctx, msg); err == nil {
+				err = moerr.NewInvalidInput(ctx, "invalid packet")
+			}
 			seq += 1
 			proto.SetSequenceID(seq)
 			break
 		}
 		seq = uint8(packet.SequenceID + 1)
 		proto.SetSequenceID(seq)
+		// the protocol that does not end the data with an empty packet
+		// decodes the end of the data into the empty packet, e.g. the
+		// CopyDone message of the postgresql protocol.
+		if packet.Length == 0 {
+			break
+		}
 		ses.CountPayload(len(packet.Payload))
 
 		writeStart := time.Now()
@@ -2380,6 +2469,169 @@ func processLoadLocal(ctx context.Context, ses FeSession, param *tree.ExternPara
 	return
 }
 
+// applySetVarHints sets the session variables named by the SET_VAR optimizer
+// hints of a SELECT for the time of the statement, and returns the function
+// restoring their values. As in MySQL, a hint naming a variable SET_VAR does
+// not apply to, or that cannot be applied, is ignored.
+func applySetVarHints(ses *Session, stmt tree.Statement) (restore func()) {
+	var hints tree.OptimizerHints
+	if sel, ok := stmt.(*tree.Select); ok {
+		if clause, ok := sel.Select.(*tree.SelectClause); ok {
+			hints = clause.Hints
+		}
+	}
+
+	var restores []func()
+	for _, hint := range hints {
+		if hint.Name != "SET_VAR" || len(hint.Args) != 1 {
+			continue
+		}
+		name, value, ok := strings.Cut(hint.Args[0], "=")
+		if !ok {
+			continue
+		}
+		if def, _, ok := ses.GetGlobalSysVars().GetGlobalSysVar(name); !ok || !def.GetSetVarHintApplies() {
+			continue
+		}
+		old, err := ses.GetSessionVar(name)
+		if err != nil {
+			logDebugf(ses.GetDebugString(), "ignore SET_VAR hint %s: %v", hint.Args[0], err)
+			continue
+		}
+		if err = ses.SetSessionVar(name, strings.Trim(value, "'\"")); err != nil {
+			logDebugf(ses.GetDebugString(), "ignore SET_VAR hint %s: %v", hint.Args[0], err)
+			continue
+		}
+		restores = append(restores, func() {
+			_ = ses.SetSessionVar(name, old)
+		})
+	}
+	return func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+	}
+}
+
+// applyMaxQueryMemory gives the statement about to run the quota of
+// max_query_memory on the session pool, and on the pools of its pipelines
+// on the other CNs, and returns the function lifting it.
+func applyMaxQueryMemory(ses *Session, execCtx *ExecCtx) (lift func()) {
+	mp := ses.GetMemPool()
+	if mp == nil {
+		return func() {}
+	}
+	ses.queryMemoryBase.Store(mp.CurrNB())
+	val, err := ses.GetSessionVar("max_query_memory")
+	if err != nil {
+		return func() {}
+	}
+	quota, _ := val.(int64)
+	if quota <= 0 {
+		return func() {}
+	}
+	mp.SetQueryQuota(quota)
+	if execCtx.proc != nil {
+		execCtx.proc.Lim.QueryMemory = quota
+	}
+	return func() {
+		mp.SetQueryQuota(0)
+		if execCtx.proc != nil {
+			execCtx.proc.Lim.QueryMemory = 0
+		}
+	}
+}
+
+// statementDeadlineKey is the key of the deadline of the statement in its
+// request context, for its pipelines to be bound by it too.
+type statementDeadlineKey struct{}
+
+// maxExecutionTime returns how long the statement may run: the
+// MAX_EXECUTION_TIME hint of a select, or else max_execution_time, in
+// milliseconds. As in MySQL, only the selects are bounded. 0 is no limit.
+func maxExecutionTime(ses *Session, stmt tree.Statement) time.Duration {
+	sel, ok := stmt.(*tree.Select)
+	if !ok || sel.Ep != nil || sel.SelectLockInfo != nil {
+		return 0
+	}
+	if clause := firstSelectClause(sel.Select); clause != nil {
+		for _, hint := range clause.Hints {
+			if hint.Name != "MAX_EXECUTION_TIME" || len(hint.Args) != 1 {
+				continue
+			}
+			if ms, err := strconv.ParseUint(hint.Args[0], 10, 32); err == nil {
+				return time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	val, err := ses.GetSessionVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	ms, _ := val.(int64)
+	if ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// firstSelectClause returns the first query block of the select, which is
+// where MySQL takes the hints of the statement from, in the parentheses or
+// as the first select of a union.
+func firstSelectClause(stmt tree.SelectStatement) *tree.SelectClause {
+	for {
+		switch s := stmt.(type) {
+		case *tree.SelectClause:
+			return s
+		case *tree.ParenSelect:
+			if s.Select == nil {
+				return nil
+			}
+			stmt = s.Select.Select
+		case *tree.UnionClause:
+			stmt = s.Left
+		default:
+			return nil
+		}
+	}
+}
+
+// applyMaxExecutionTime bounds the statement about to run by its
+// maxExecutionTime. The context returned is cancelled with the timeout
+// error once it is exceeded, and so are the pipelines of the statement,
+// on this CN from the deadline in the context and on the others from the
+// one in the limitation of the process.
+func applyMaxExecutionTime(requestCtx context.Context, ses *Session, execCtx *ExecCtx) (context.Context, context.CancelFunc) {
+	timeout := maxExecutionTime(ses, execCtx.stmt)
+	if timeout == 0 {
+		return requestCtx, func() {}
+	}
+	deadline := time.Now().Add(timeout)
+	stmtCtx, cancel := context.WithDeadlineCause(
+		context.WithValue(requestCtx, statementDeadlineKey{}, deadline),
+		deadline,
+		moerr.NewQueryTimeoutNoCtx())
+	if execCtx.proc != nil {
+		execCtx.proc.Lim.ExecutionDeadline = deadline.UnixNano()
+	}
+	return stmtCtx, func() {
+		cancel()
+		if execCtx.proc != nil {
+			execCtx.proc.Lim.ExecutionDeadline = 0
+		}
+	}
+}
+
+// isQueryTimeout tells whether err, the error of the statement run in ctx,
+// is for ctx cancelled by the timeout of applyMaxExecutionTime. A statement
+// done without error is not timed out, even if the deadline has passed.
+func isQueryTimeout(ctx context.Context, err error) bool {
+	if err == nil || !moerr.IsMoErrCode(context.Cause(ctx), moerr.ErrQueryTimeout) {
+		return false
+	}
+	return errors.Is(err, context.DeadlineExceeded) || moerr.IsMoErrCode(err, moerr.ErrQueryTimeout)
+}
+
 func executeStmtWithResponse(requestCtx context.Context,
 	ses *Session,
 	execCtx *ExecCtx,
@@ -2398,7 +2650,15 @@ func executeStmtWithResponse(requestCtx context.Context,
 	execCtx.proto.DisableAutoFlush()
 	defer execCtx.proto.EnableAutoFlush()
 
-	err = executeStmtWithTxn(requestCtx, ses, execCtx)
+	defer applySetVarHints(ses, execCtx.stmt)()
+	defer applyMaxQueryMemory(ses, execCtx)()
+
+	stmtCtx, cancel := applyMaxExecutionTime(requestCtx, ses, execCtx)
+	defer cancel()
+	err = executeStmtWithTxn(stmtCtx, ses, execCtx)
+	if isQueryTimeout(stmtCtx, err) {
+		err = moerr.NewQueryTimeout(requestCtx)
+	}
 	if err != nil {
 		return err
 	}
@@ -3017,6 +3277,40 @@ func ExecRequest(requestCtx context.Context, ses *Session, req *Request) (resp *
 		}
 		return NewGeneralOkResponse(COM_SET_OPTION, ses.GetTxnHandler().GetServerStatus()), nil
 
+	case COM_RESET_CONNECTION:
+		err = handleResetConnection(ses)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus(), err)
+			return resp, nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_CHANGE_USER:
+		data := req.GetData().([]byte)
+		err = handleChangeUser(requestCtx, ses, data)
+		if err != nil {
+			// Like MySQL, the connection is closed after the failure of COM_CHANGE_USER,
+			// as the session has been reset and does not belong to any user.
+			if rt := ses.getRoutine(); rt != nil {
+				rt.setCancelled(true)
+			}
+			resp = NewGeneralErrorResponse(COM_CHANGE_USER, ses.GetTxnHandler().GetServerStatus(), err)
+			return resp, nil
+		}
+		return NewGeneralOkResponse(COM_CHANGE_USER, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_REGISTER_SLAVE:
+		return NewGeneralOkResponse(COM_REGISTER_SLAVE, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_BINLOG_DUMP, COM_BINLOG_DUMP_GTID:
+		data := req.GetData().([]byte)
+		err = handleBinlogDump(requestCtx, ses, req.GetCmd(), data)
+		// Like MySQL, the connection is closed after the binlog stream ends.
+		if rt := ses.getRoutine(); rt != nil {
+			rt.setCancelled(true)
+		}
+		return NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), err), nil
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), moerr.NewInternalError(requestCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
@@ -3378,6 +3672,47 @@ func handleSetOption(ctx context.Context, ses *Session, data []byte) (err error)
 	return nil
 }
 
+// handleResetConnection resets the session state for COM_RESET_CONNECTION,
+// keeping the user and the current database.
+func handleResetConnection(ses *Session) error {
+	if err := ses.ResetSession(); err != nil {
+		return err
+	}
+	return ses.InitGlobalSystemVariables()
+}
+
+// handleChangeUser resets the session state for COM_CHANGE_USER and
+// authenticates the connection as the user in the payload.
+func handleChangeUser(ctx context.Context, ses *Session, data []byte) error {
+	rt := ses.getRoutine()
+	oldTenant := ses.GetTenantInfo()
+	if err := ses.ResetSession(); err != nil {
+		return err
+	}
+	if oldTenant != nil && rt != nil {
+		if rm := ses.getRoutineManager(); rm != nil && rm.accountRoutine != nil {
+			rm.accountRoutine.deleteRoutine(int64(oldTenant.GetTenantID()), rt)
+		}
+		rt.decreaseCount(func() {
+			metric.ConnectionCounter(oldTenant.GetTenant()).Dec()
+		})
+	}
+
+	proto := ses.GetMysqlProtocol()
+	if err := proto.HandleChangeUser(ctx, data); err != nil {
+		return err
+	}
+	ses.SetDatabaseName(proto.GetDatabaseName())
+	ses.UpdateDebugString()
+
+	if rt != nil {
+		rt.increaseCount(func() {
+			metric.ConnectionCounter(ses.GetTenantInfo().GetTenant()).Inc()
+		})
+	}
+	return nil
+}
+
 func handleExecUpgrade(ctx context.Context, ses *Session, st *tree.UpgradeStatement) error {
 	retryCount := st.Retry
 	if st.Retry <= 0 {
@@ -1385,11 +1385,13 @@ func Test_getExplainOption(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_TEXT)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_JSON)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "dot"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "DOT"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_DOT)
 
 	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "???"}})
 	require.NotNil(t, err)
@@ -1483,3 +1485,135 @@ func Test_ExecRequest(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_applySetVarHints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	setGlobalPu(config.NewParameterUnit(sv, mock_frontend.NewMockEngine(ctrl), mock_frontend.NewMockTxnClient(ctrl), nil))
+	gSysVars := &GlobalSystemVariables{}
+	InitGlobalSystemVariables(gSysVars)
+	ses := NewSession(NewMysqlClientProtocol(0, ioses, 1024, sv), nil, gSysVars, true, nil)
+	ses.SetRequestContext(context.Background())
+
+	stmt, err := parsers.ParseOne(context.Background(), dialect.MYSQL,
+		"select /*+ SET_VAR(interactive_timeout = 100) SET_VAR(max_allowed_packet=2048) SET_VAR(no_such_var=1) */ 1", 1, 0)
+	require.NoError(t, err)
+
+	restore := applySetVarHints(ses, stmt)
+	val, err := ses.GetSessionVar("interactive_timeout")
+	require.NoError(t, err)
+	require.Equal(t, int64(100), val)
+	// SET_VAR does not apply to max_allowed_packet
+	val, err = ses.GetSessionVar("max_allowed_packet")
+	require.NoError(t, err)
+	require.NotEqual(t, int64(2048), val)
+
+	restore()
+	val, err = ses.GetSessionVar("interactive_timeout")
+	require.NoError(t, err)
+	require.Equal(t, int64(28800), val)
+}
+
+func Test_applyMaxQueryMemory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	setGlobalPu(config.NewParameterUnit(sv, mock_frontend.NewMockEngine(ctrl), mock_frontend.NewMockTxnClient(ctrl), nil))
+	gSysVars := &GlobalSystemVariables{}
+	InitGlobalSystemVariables(gSysVars)
+	ses := NewSession(NewMysqlClientProtocol(0, ioses, 1024, sv), nil, gSysVars, true, nil)
+	ses.SetRequestContext(context.Background())
+	execCtx := &ExecCtx{proc: testutil.NewProc()}
+	mp := ses.GetMemPool()
+
+	// no quota by default
+	lift := applyMaxQueryMemory(ses, execCtx)
+	bs, err := mp.Alloc(4 << 20)
+	require.NoError(t, err)
+	mp.Free(bs)
+	lift()
+
+	require.NoError(t, ses.SetSessionVar("max_query_memory", "1048576"))
+	lift = applyMaxQueryMemory(ses, execCtx)
+	require.Equal(t, int64(1<<20), execCtx.proc.Lim.QueryMemory)
+	ses.SetQueryInProgress(true)
+	bs, err = mp.Alloc(512 << 10)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ses.getQueryMemory(), uint64(512<<10))
+	_, err = mp.Alloc(1 << 20)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryExceeded))
+	mp.Free(bs)
+	ses.SetQueryInProgress(false)
+	require.Zero(t, ses.getQueryMemory())
+
+	lift()
+	require.Zero(t, execCtx.proc.Lim.QueryMemory)
+	bs, err = mp.Alloc(4 << 20)
+	require.NoError(t, err)
+	mp.Free(bs)
+}
+
+func Test_applyMaxExecutionTime(t *testing.T) {
+	ctx := context.TODO()
+	parse := func(sql string) tree.Statement {
+		stmt, err := mysql.ParseOne(ctx, sql, 1, 0)
+		require.NoError(t, err)
+		return stmt
+	}
+	ses := &Session{
+		feSessionImpl: feSessionImpl{gSysVars: GSysVariables},
+		sysVars:       map[string]interface{}{},
+	}
+
+	// no limit by default
+	ses.sysVars["max_execution_time"] = int64(0)
+	require.Zero(t, maxExecutionTime(ses, parse("select a from t1")))
+
+	ses.sysVars["max_execution_time"] = int64(1000)
+	require.Equal(t, time.Second, maxExecutionTime(ses, parse("select a from t1")))
+	require.Equal(t, 10*time.Millisecond, maxExecutionTime(ses, parse("select /*+ MAX_EXECUTION_TIME(10) */ a from t1")))
+	// the hint of the first query block bounds the statement
+	require.Equal(t, 10*time.Millisecond, maxExecutionTime(ses, parse("(select /*+ MAX_EXECUTION_TIME(10) */ a from t1)")))
+	require.Equal(t, 10*time.Millisecond, maxExecutionTime(ses, parse("select /*+ MAX_EXECUTION_TIME(10) */ a from t1 union select b from t2")))
+	require.Equal(t, 10*time.Millisecond, maxExecutionTime(ses, parse("(select /*+ MAX_EXECUTION_TIME(10) */ a from t1) union all (select b from t2) order by 1")))
+	require.Equal(t, time.Second, maxExecutionTime(ses, parse("select a from t1 union select /*+ MAX_EXECUTION_TIME(10) */ b from t2")))
+	// only the selects are bounded
+	require.Zero(t, maxExecutionTime(ses, parse("insert into t1 values (1)")))
+	require.Zero(t, maxExecutionTime(ses, parse("select a from t1 for update")))
+
+	execCtx := &ExecCtx{stmt: parse("select /*+ MAX_EXECUTION_TIME(1) */ a from t1"), proc: testutil.NewProc()}
+	stmtCtx, cancel := applyMaxExecutionTime(ctx, ses, execCtx)
+	require.NotZero(t, execCtx.proc.Lim.ExecutionDeadline)
+	<-stmtCtx.Done()
+	require.True(t, isQueryTimeout(stmtCtx, stmtCtx.Err()))
+	require.True(t, isQueryTimeout(stmtCtx, context.Cause(stmtCtx)))
+	// the statement done before the deadline is not timed out, nor is the
+	// one failing of its own
+	require.False(t, isQueryTimeout(stmtCtx, nil))
+	require.False(t, isQueryTimeout(stmtCtx, moerr.NewInternalError(ctx, "failed")))
+	require.True(t, moerr.IsMoErrCode(moerr.NewQueryTimeout(ctx), moerr.ErrQueryTimeout))
+	require.Equal(t, uint16(moerr.ER_QUERY_TIMEOUT), moerr.NewQueryTimeout(ctx).MySQLCode())
+	cancel()
+	require.Zero(t, execCtx.proc.Lim.ExecutionDeadline)
+
+	// a statement done in time is not timed out
+	execCtx.stmt = parse("select a from t1")
+	stmtCtx, cancel = applyMaxExecutionTime(ctx, ses, execCtx)
+	cancel()
+	require.False(t, isQueryTimeout(stmtCtx, stmtCtx.Err()))
+}
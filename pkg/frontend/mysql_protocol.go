@@ -205,6 +205,13 @@ type MysqlProtocol interface {
 
 	ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error
 
+	// HandleChangeUser switches the connection to the user in the COM_CHANGE_USER
+	// payload and authenticates it again.
+	HandleChangeUser(ctx context.Context, payload []byte) error
+
+	// sendBinlogEvent sends an event of the binlog stream to the replica.
+	sendBinlogEvent(event []byte) error
+
 	DisableAutoFlush()
 	EnableAutoFlush()
 	Flush() error
@@ -470,6 +477,16 @@ type response41 struct {
 	connectAttrs      map[string]string
 }
 
+// the payload of COM_CHANGE_USER
+type changeUserInfo struct {
+	username         string
+	authResponse     []byte
+	database         string
+	collationID      uint16
+	clientPluginName string
+	connectAttrs     map[string]string
+}
+
 // handshake response 320
 type response320 struct {
 	capabilities      uint32
@@ -1614,6 +1631,135 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse320(ctx context.Context, da
 	return true, info, nil
 }
 
+// the server analyses the payload of COM_CHANGE_USER from the client
+// return true - analysed successfully / false - failed ; changeUserInfo ; error
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, data []byte) (bool, changeUserInfo, error) {
+	var pos = 0
+	var ok bool
+	var info changeUserInfo
+
+	//string[NUL]        username
+	info.username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return false, info, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	/*
+		if capabilities & CLIENT_SECURE_CONNECTION {
+			int<1>             length of auth-response
+			string[n]          auth-response
+		} else {
+			string[NUL]        auth-response
+		}
+	*/
+	capabilities := mp.GetCapability()
+	if (capabilities & CLIENT_SECURE_CONNECTION) != 0 {
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get length of auth-response failed")
+		}
+		info.authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+	} else {
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		info.authResponse = []byte(auth)
+	}
+
+	//string[NUL]        database
+	info.database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return false, info, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	//the remaining fields are optional
+	if pos >= len(data) {
+		return true, info, nil
+	}
+
+	//int<2>             character set
+	info.collationID, pos, ok = mp.io.ReadUint16(data, pos)
+	if !ok {
+		return false, info, moerr.NewInternalError(ctx, "get character set failed")
+	}
+
+	if (capabilities&CLIENT_PLUGIN_AUTH) != 0 && pos < len(data) {
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+
+	// client connection attributes
+	info.connectAttrs = make(map[string]string)
+	if (capabilities&CLIENT_CONNECT_ATTRS) != 0 && pos < len(data) {
+		var l uint64
+		l, pos, ok = mp.readIntLenEnc(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+		}
+		endPos := pos + int(l)
+		var key, value string
+		for pos < endPos {
+			key, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return false, info, moerr.NewInternalError(ctx, "get connect-attrs key failed")
+			}
+			value, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return false, info, moerr.NewInternalError(ctx, "get connect-attrs value failed")
+			}
+			info.connectAttrs[key] = value
+		}
+	}
+
+	return true, info, nil
+}
+
+// HandleChangeUser handles the COM_CHANGE_USER from the client. The client
+// scrambles the password with the salt of the initial handshake, so the salt
+// is reused to check the new user. If the client uses an authentication method
+// other than mysql_native_password, an AuthSwitchRequest is sent to it.
+func (mp *MysqlProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) error {
+	ok, info, err := mp.analyseChangeUser(ctx, payload)
+	if !ok {
+		return err
+	}
+
+	if len(info.clientPluginName) != 0 && info.clientPluginName != AuthNativePassword {
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+	}
+
+	if info.collationID != 0 {
+		if nameAndCharset, ok2 := collationID2CharsetAndName[int(info.collationID)]; !ok2 {
+			return moerr.NewInternalError(ctx, "get collationName and charset failed")
+		} else {
+			mp.collationID = int(info.collationID)
+			mp.collationName = nameAndCharset.collationName
+			mp.charset = nameAndCharset.charset
+		}
+	}
+
+	mp.m.Lock()
+	mp.username = info.username
+	mp.database = info.database
+	mp.authResponse = info.authResponse
+	if len(info.connectAttrs) != 0 {
+		mp.connectAttrs = info.connectAttrs
+	}
+	mp.m.Unlock()
+
+	return mp.authenticateUser(ctx, info.authResponse)
+}
+
 /*
 //the server does something after receiving a handshake response320 from the client
 //like check user and password
@@ -1759,6 +1905,14 @@ func (mp *MysqlProtocolImpl) sendLocalInfileRequest(filename string) error {
 	return mp.writePackets(req, true)
 }
 
+// sendBinlogEvent sends the event with the OK header in the binlog stream.
+func (mp *MysqlProtocolImpl) sendBinlogEvent(event []byte) error {
+	data := make([]byte, HeaderOffset+1+len(event))
+	data[HeaderOffset] = defines.OKHeader
+	copy(data[HeaderOffset+1:], event)
+	return mp.writePackets(data, true)
+}
+
 func (mp *MysqlProtocolImpl) sendOKPacketWithEof(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
 	okPkt := mp.makeOKPayloadWithEof(affectedRows, lastInsertId, status, warnings, message)
 	return mp.writePackets(okPkt, true)
@@ -2703,6 +2703,96 @@ func Test_analyse41resp(t *testing.T) {
 	})
 }
 
+func Test_analyseChangeUser(t *testing.T) {
+	convey.Convey("analyse change user succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		ioses.EXPECT().Flush(gomock.Any()).AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.SetCapability(CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_PLUGIN_AUTH | CLIENT_CONNECT_ATTRS)
+
+		var data []byte = nil
+		//string[NUL]        username
+		username := "acc:abc"
+		data = append(data, []byte(username)...)
+		data = append(data, 0x0)
+		//int<1>             length of auth-response
+		//string[n]          auth-response
+		authResp := []byte{0x1, 0x2, 0x3, 0x4}
+		data = append(data, uint8(len(authResp)))
+		data = append(data, authResp...)
+		//string[NUL]        database
+		dbName := "T"
+		data = append(data, []byte(dbName)...)
+		data = append(data, 0x0)
+		//int<2>             character set
+		data = append(data, Utf8mb4CollationID, 0)
+		//string[NUL]        auth plugin name
+		data = append(data, []byte(AuthNativePassword)...)
+		data = append(data, 0x0)
+		//lenenc-int         length of all key-values
+		data = append(data, 4, 1, 'k', 1, 'v')
+
+		ok, info, err := proto.analyseChangeUser(context.TODO(), data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		convey.So(info.username, convey.ShouldEqual, username)
+		convey.So(bytes.Equal(info.authResponse, authResp), convey.ShouldBeTrue)
+		convey.So(info.database, convey.ShouldEqual, dbName)
+		convey.So(info.collationID, convey.ShouldEqual, uint16(Utf8mb4CollationID))
+		convey.So(info.clientPluginName, convey.ShouldEqual, AuthNativePassword)
+		convey.So(info.connectAttrs["k"], convey.ShouldEqual, "v")
+	})
+
+	convey.Convey("analyse change user failed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		ioses.EXPECT().Flush(gomock.Any()).AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.SetCapability(CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION)
+
+		type kase struct {
+			data []byte
+			res  bool
+		}
+
+		kases := []kase{
+			{data: []byte{}, res: false},
+			{data: []byte{'a', 'b', 'c'}, res: false},
+			{data: []byte{'a', 'b', 'c', 0}, res: false},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1}, res: false},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2}, res: false},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2, 'T'}, res: false},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2, 'T', 0}, res: true},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2, 0}, res: true},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2, 0, 45}, res: false},
+			{data: []byte{'a', 'b', 'c', 0, 2, 1, 2, 0, 45, 0}, res: true},
+		}
+
+		for _, c := range kases {
+			ok, _, _ := proto.analyseChangeUser(context.TODO(), c.data)
+			convey.So(ok, convey.ShouldEqual, c.res)
+		}
+	})
+}
+
 func Test_handleHandshake(t *testing.T) {
 	ctx := context.TODO()
 	convey.Convey("handleHandshake succ", t, func() {
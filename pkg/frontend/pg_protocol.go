@@ -0,0 +1,1318 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/fagongzi/goetty/v2/codec"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// see https://www.postgresql.org/docs/current/protocol-message-formats.html
+const (
+	pgProtocolVersion   uint32 = 196608
+	pgSSLRequestCode    uint32 = 80877103
+	pgCancelRequestCode uint32 = 80877102
+	pgGSSENCRequestCode uint32 = 80877104
+
+	// pgMaxStartupLength is the max length of the message without type byte.
+	pgMaxStartupLength = 10000
+)
+
+// the message types from the client
+const (
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgSync      byte = 'S'
+	pgMsgFlush     byte = 'H'
+	pgMsgClose     byte = 'C'
+	pgMsgTerminate byte = 'X'
+	pgMsgPassword  byte = 'p'
+	pgMsgCopyData  byte = 'd'
+	pgMsgCopyDone  byte = 'c'
+	pgMsgCopyFail  byte = 'f'
+)
+
+// the message types to the client
+const (
+	pgMsgAuthentication     byte = 'R'
+	pgMsgParameterStatus    byte = 'S'
+	pgMsgBackendKeyData     byte = 'K'
+	pgMsgReadyForQuery      byte = 'Z'
+	pgMsgRowDescription     byte = 'T'
+	pgMsgDataRow            byte = 'D'
+	pgMsgCommandComplete    byte = 'C'
+	pgMsgErrorResponse      byte = 'E'
+	pgMsgEmptyQueryResponse byte = 'I'
+	pgMsgParseComplete      byte = '1'
+	pgMsgBindComplete       byte = '2'
+	pgMsgCloseComplete      byte = '3'
+	pgMsgParameterDesc      byte = 't'
+	pgMsgNoData             byte = 'n'
+	pgMsgCopyInResponse     byte = 'G'
+)
+
+const (
+	pgAuthOk                = 0
+	pgAuthCleartextPassword = 3
+)
+
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+// the oids of the postgresql types, see pg_type.dat of postgresql.
+const (
+	pgOidBool      uint32 = 16
+	pgOidInt8      uint32 = 20
+	pgOidInt2      uint32 = 21
+	pgOidInt4      uint32 = 23
+	pgOidText      uint32 = 25
+	pgOidJson      uint32 = 114
+	pgOidFloat4    uint32 = 700
+	pgOidFloat8    uint32 = 701
+	pgOidBpchar    uint32 = 1042
+	pgOidVarchar   uint32 = 1043
+	pgOidDate      uint32 = 1082
+	pgOidTime      uint32 = 1083
+	pgOidTimestamp uint32 = 1114
+	pgOidNumeric   uint32 = 1700
+	pgOidUuid      uint32 = 2950
+)
+
+// pgServerVersion is reported to the client as the server_version.
+const pgServerVersion = "14.0"
+
+// pgEpoch is the zero of the date and the timestamp in the binary format.
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// pgMessage is the message from the postgresql client. The startup message,
+// SSLRequest and CancelRequest have no type byte and their typ is 0.
+type pgMessage struct {
+	typ     byte
+	payload []byte
+}
+
+func NewPgCodec() codec.Codec {
+	return &pgCodec{}
+}
+
+// pgCodec decodes the messages from the postgresql client. CopyData and CopyDone
+// are decoded into the Packet, so that COPY FROM STDIN reads the data in the
+// same way as LOAD DATA LOCAL of the mysql protocol. CopyDone is the empty Packet.
+type pgCodec struct {
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (interface{}, bool, error) {
+	readable := in.Readable()
+	if readable < 1 {
+		return nil, false, nil
+	}
+
+	// the length of the startup message is less than pgMaxStartupLength, so that
+	// its first byte is always 0, which is not any type of the messages.
+	typ := in.PeekN(0, 1)[0]
+	headerLen := 5
+	if typ == 0 {
+		headerLen = 4
+	}
+	if readable < headerLen {
+		return nil, false, nil
+	}
+	header := in.PeekN(0, headerLen)
+	length := int(binary.BigEndian.Uint32(header[headerLen-4:]))
+	if length < 4 || (typ == 0 && length > pgMaxStartupLength) {
+		return nil, false, moerr.NewInvalidInputNoCtx("invalid length of the postgresql message: %d", length)
+	}
+	if readable < headerLen-4+length {
+		return nil, false, nil
+	}
+
+	in.Skip(headerLen)
+	var payload []byte
+	if length > 4 {
+		in.SetMarkIndex(in.GetReadIndex() + length - 4)
+		payload = in.ReadMarkedData()
+	}
+
+	switch typ {
+	case pgMsgCopyData:
+		return &Packet{Length: int32(len(payload)), Payload: payload}, true, nil
+	case pgMsgCopyDone:
+		return &Packet{}, true, nil
+	}
+	return &pgMessage{typ: typ, payload: payload}, true, nil
+}
+
+func (c *pgCodec) Encode(data interface{}, out *buf.ByteBuf, writer io.Writer) error {
+	x := data.([]byte)
+	n, err := out.Write(x)
+	if err != nil {
+		return err
+	}
+	if n != len(x) {
+		return errorLenOfWrittenNotEqLenOfData
+	}
+	return nil
+}
+
+// pgStatement is the statement created by the Parse message. It is prepared
+// in the session as the statement from COM_STMT_PREPARE is.
+type pgStatement struct {
+	// id of the prepared statement in the session. It is 0 if the statement
+	// can not be prepared and is executed as the simple query.
+	id  uint32
+	sql string
+	// paramOrder maps the ith placeholder in the sql to the index of the
+	// parameter of the postgresql client. $1 is the parameter 0.
+	paramOrder []int
+	// paramOIDs is the types of the parameters. The types the client
+	// does not specify come from the prepared statement.
+	paramOIDs []uint32
+	columns   []Column
+}
+
+func (st *pgStatement) prepared() bool {
+	return st.id != 0
+}
+
+// pgPortal is the statement with the parameters bound by the Bind message.
+type pgPortal struct {
+	stmt          *pgStatement
+	params        []any
+	resultFormats []int16
+	// describe denotes the client has asked for the RowDescription of the
+	// portal that can not be described until it is executed.
+	describe bool
+}
+
+// pgProtocol implements the MysqlProtocol for the postgresql clients, so that
+// the queries of them run in the same way as the ones of the mysql clients.
+type pgProtocol struct {
+	ProtocolImpl
+
+	SV *config.FrontendParameters
+
+	ses *Session
+
+	username     string
+	database     string
+	authResponse []byte
+	// waitPassword denotes the server has requested the password of the client.
+	waitPassword bool
+	// secretKey is checked by the CancelRequest.
+	secretKey uint32
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// portal is the portal in execution.
+	portal *pgPortal
+	// prepared is the statement prepared by the last Parse message.
+	prepared *PrepareStmt
+	// skipUntilSync denotes the messages are discarded until Sync
+	// after an error in the extended query.
+	skipUntilSync bool
+
+	// columns is the columns of the result set in sending.
+	columns []Column
+	// describeRows denotes the RowDescription is sent before the rows. It is
+	// false for the extended query where the Describe message does it.
+	describeRows bool
+	// describePending denotes the Describe message of the portal that can not be
+	// described in advance waits for the result. NoData is sent for it if there
+	// is no result set.
+	describePending bool
+	resultFormats   []int16
+	sentRows        uint64
+
+	// mute denotes the responses are not sent, the error is kept in err.
+	mute bool
+	// err is the error sent to the client or kept during the mute.
+	err error
+
+	disableAutoFlush bool
+	bytesToFlush     int
+	maxBytesToFlush  int
+}
+
+var _ MysqlProtocol = &pgProtocol{}
+
+func NewPgProtocol(connectionID uint32, tcp goetty.IOSession, maxBytesToFlush int, SV *config.FrontendParameters) *pgProtocol {
+	return &pgProtocol{
+		ProtocolImpl: ProtocolImpl{
+			tcpConn:      tcp,
+			connectionID: connectionID,
+		},
+		SV:              SV,
+		secretKey:       rand.Uint32(),
+		statements:      make(map[string]*pgStatement),
+		portals:         make(map[string]*pgPortal),
+		describeRows:    true,
+		maxBytesToFlush: maxBytesToFlush * 1024,
+	}
+}
+
+func (p *pgProtocol) SetSession(ses *Session) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.ses = ses
+}
+
+func (p *pgProtocol) GetSession() *Session {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.ses
+}
+
+func (p *pgProtocol) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  COM_QUERY,
+		data: payload,
+	}
+}
+
+func (p *pgProtocol) GetDatabaseName() string {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.database
+}
+
+func (p *pgProtocol) SetDatabaseName(s string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.database = s
+}
+
+func (p *pgProtocol) GetUserName() string {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.username
+}
+
+func (p *pgProtocol) SetUserName(s string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.username = s
+}
+
+func (p *pgProtocol) SetSequenceID(value uint8) {}
+
+func (p *pgProtocol) GetCapability() uint32 {
+	return DefaultCapability
+}
+
+func (p *pgProtocol) SetCapability(uint32) {}
+
+func (p *pgProtocol) GetConnectAttrs() map[string]string {
+	return nil
+}
+
+func (p *pgProtocol) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	return false, nil
+}
+
+func (p *pgProtocol) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return moerr.NewNotSupported(ctx, "change user in the postgresql protocol")
+}
+
+func (p *pgProtocol) sendBinlogEvent(event []byte) error {
+	return moerr.NewNotSupportedNoCtx("binlog dump in the postgresql protocol")
+}
+
+// pgCheckPassword checks the cleartext password from the client.
+// pwd is SHA1(SHA1(password)).
+func pgCheckPassword(pwd, salt, auth []byte) bool {
+	return bytes.Equal(pwd, HashSha1(HashSha1(auth)))
+}
+
+// Authenticate checks the user and the cleartext password from the client.
+func (p *pgProtocol) Authenticate(ctx context.Context) error {
+	ses := p.GetSession()
+	if !p.SV.SkipCheckUser {
+		psw, err := ses.AuthenticateUser(p.GetUserName(), p.GetDatabaseName(), p.authResponse, nil, pgCheckPassword)
+		if err != nil {
+			return err
+		}
+		if !pgCheckPassword(psw, nil, p.authResponse) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		ses.InitGlobalSystemVariables()
+	} else {
+		tenant, err := GetTenantInfo(ctx, p.GetUserName())
+		if err != nil {
+			return err
+		}
+		ses.SetTenantInfo(tenant)
+	}
+	p.incDebugCount(1)
+	return nil
+}
+
+func (p *pgProtocol) SendPrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.prepared = stmt
+	return nil
+}
+
+// ParseExecuteData sets the parameters bound by the Bind message.
+func (p *pgProtocol) ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	var err error
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	numParams := len(dcPrepare.Prepare.ParamTypes)
+
+	portal := p.portal
+	if portal == nil {
+		return moerr.NewInternalError(ctx, "there is no portal in execution")
+	}
+	if numParams != len(portal.stmt.paramOrder) {
+		return moerr.NewInvalidInput(ctx, "expect %d parameters, but got %d", numParams, len(portal.stmt.paramOrder))
+	}
+
+	if stmt.params == nil {
+		stmt.params = proc.GetVector(types.T_text.ToType())
+		for i := 0; i < numParams; i++ {
+			err = vector.AppendBytes(stmt.params, []byte{}, false, proc.GetMPool())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, idx := range portal.stmt.paramOrder {
+		err = util.SetAnyToStringVector(proc, portal.params[idx], stmt.params, i)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pgProtocol) ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "send long data in the postgresql protocol")
+}
+
+// SendResponse sends a response to the client for the application request
+func (p *pgProtocol) SendResponse(ctx context.Context, resp *Response) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	switch resp.category {
+	case OkResponse:
+		return p.sendCommandComplete(resp.affectedRows)
+	case EoFResponse:
+		return nil
+	case ErrorResponse:
+		err := resp.data.(error)
+		if err == nil {
+			return p.sendCommandComplete(0)
+		}
+		return p.sendError(err)
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			return p.sendCommandComplete(0)
+		}
+		if mer.Mrs() == nil {
+			return p.sendCommandComplete(mer.AffectedRows())
+		}
+		mrs := mer.Mrs()
+		p.columns = mrs.Columns
+		p.sentRows = 0
+		if p.describeRows {
+			if err := p.sendRowDescription(p.columns, p.resultFormats); err != nil {
+				return err
+			}
+		}
+		if err := p.sendRows(mrs, mrs.GetRowCount()); err != nil {
+			return err
+		}
+		return p.sendCommandComplete(p.sentRows)
+	case LocalInfileRequest:
+		s, _ := resp.data.(string)
+		return p.sendLocalInfileRequest(s)
+	default:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+func (p *pgProtocol) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.sendRows(mrs, cnt)
+}
+
+func (p *pgProtocol) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.sendRows(mrs, cnt)
+}
+
+// SendColumnDefinitionPacket keeps the column, which is sent in the RowDescription.
+func (p *pgProtocol) SendColumnDefinitionPacket(ctx context.Context, column Column, cmd int) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.columns = append(p.columns, column)
+	return nil
+}
+
+// SendColumnCountPacket starts a new result set.
+func (p *pgProtocol) SendColumnCountPacket(count uint64) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.columns = make([]Column, 0, count)
+	p.sentRows = 0
+	return nil
+}
+
+// SendEOFPacketIf sends the RowDescription after the columns have been sent.
+func (p *pgProtocol) SendEOFPacketIf(warnings uint16, status uint16) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if !p.describeRows {
+		return nil
+	}
+	return p.sendRowDescription(p.columns, p.resultFormats)
+}
+
+func (p *pgProtocol) sendOKPacket(affectedRows uint64, lastInsertId uint64, status uint16, warnings uint16, message string) error {
+	return p.sendCommandComplete(affectedRows)
+}
+
+// sendEOFOrOkPacket ends the result set.
+func (p *pgProtocol) sendEOFOrOkPacket(warnings uint16, status uint16) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.sendCommandComplete(p.sentRows)
+}
+
+// sendLocalInfileRequest asks the client to send the data of COPY FROM STDIN.
+func (p *pgProtocol) sendLocalInfileRequest(filename string) error {
+	data := p.beginMessage(pgMsgCopyInResponse)
+	data = append(data, byte(pgFormatText))
+	data = binary.BigEndian.AppendUint16(data, 0)
+	if err := p.writeMessage(data); err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+func (p *pgProtocol) ResetStatistics() {}
+
+func (p *pgProtocol) GetStats() string {
+	return ""
+}
+
+func (p *pgProtocol) CalculateOutTrafficBytes(reset bool) (int64, int64) { return 0, 0 }
+
+func (p *pgProtocol) DisableAutoFlush() {
+	p.disableAutoFlush = true
+}
+
+func (p *pgProtocol) EnableAutoFlush() {
+	p.disableAutoFlush = false
+}
+
+func (p *pgProtocol) Flush() error {
+	return p.flush()
+}
+
+func (p *pgProtocol) flush() error {
+	if p.mute {
+		return nil
+	}
+	p.bytesToFlush = 0
+	return p.tcpConn.Flush(0)
+}
+
+// beginMessage makes the header of the message. The length is filled in writeMessage.
+func (p *pgProtocol) beginMessage(typ byte) []byte {
+	return append(make([]byte, 0, 64), typ, 0, 0, 0, 0)
+}
+
+func (p *pgProtocol) writeMessage(data []byte) error {
+	if p.mute {
+		return nil
+	}
+	binary.BigEndian.PutUint32(data[1:5], uint32(len(data)-1))
+	if err := p.tcpConn.Write(data, goetty.WriteOptions{}); err != nil {
+		return err
+	}
+	p.bytesToFlush += len(data)
+	if !p.disableAutoFlush && p.bytesToFlush >= p.maxBytesToFlush {
+		return p.flush()
+	}
+	return nil
+}
+
+// writeRaw writes the bytes without the message header, e.g. the answer of SSLRequest.
+func (p *pgProtocol) writeRaw(data []byte) error {
+	if err := p.tcpConn.Write(data, goetty.WriteOptions{}); err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+func (p *pgProtocol) sendAuthentication(code uint32) error {
+	data := p.beginMessage(pgMsgAuthentication)
+	data = binary.BigEndian.AppendUint32(data, code)
+	return p.writeMessage(data)
+}
+
+func (p *pgProtocol) sendParameterStatus(name, value string) error {
+	data := p.beginMessage(pgMsgParameterStatus)
+	data = appendPgString(data, name)
+	data = appendPgString(data, value)
+	return p.writeMessage(data)
+}
+
+func (p *pgProtocol) sendBackendKeyData() error {
+	data := p.beginMessage(pgMsgBackendKeyData)
+	data = binary.BigEndian.AppendUint32(data, p.connectionID)
+	data = binary.BigEndian.AppendUint32(data, p.secretKey)
+	return p.writeMessage(data)
+}
+
+// sendStartupResponse ends the startup after the authentication succeeded.
+func (p *pgProtocol) sendStartupResponse() error {
+	if err := p.sendAuthentication(pgAuthOk); err != nil {
+		return err
+	}
+	for _, kv := range [][2]string{
+		{"server_version", pgServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+	} {
+		if err := p.sendParameterStatus(kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	if err := p.sendBackendKeyData(); err != nil {
+		return err
+	}
+	return p.sendReadyForQuery()
+}
+
+func (p *pgProtocol) sendReadyForQuery() error {
+	status := byte('I')
+	if ses := p.GetSession(); ses != nil && ses.GetTxnHandler().InActiveMultiStmtTransaction() {
+		status = 'T'
+	}
+	data := p.beginMessage(pgMsgReadyForQuery)
+	data = append(data, status)
+	if err := p.writeMessage(data); err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+func (p *pgProtocol) sendEmptyMessage(typ byte) error {
+	return p.writeMessage(p.beginMessage(typ))
+}
+
+func (p *pgProtocol) sendCommandComplete(affectedRows uint64) error {
+	if p.describePending {
+		p.describePending = false
+		if err := p.sendEmptyMessage(pgMsgNoData); err != nil {
+			return err
+		}
+	}
+	data := p.beginMessage(pgMsgCommandComplete)
+	data = appendPgString(data, pgCommandTag(p.ses, affectedRows))
+	return p.writeMessage(data)
+}
+
+// sendError sends the ErrorResponse, or keeps the error during the mute.
+func (p *pgProtocol) sendError(err error) error {
+	p.err = err
+	return p.sendErrorResponse("ERROR", pgSqlState(err), err.Error())
+}
+
+func (p *pgProtocol) sendErrorResponse(severity, sqlState, msg string) error {
+	data := p.beginMessage(pgMsgErrorResponse)
+	data = append(data, 'S')
+	data = appendPgString(data, severity)
+	data = append(data, 'V')
+	data = appendPgString(data, severity)
+	data = append(data, 'C')
+	data = appendPgString(data, sqlState)
+	data = append(data, 'M')
+	data = appendPgString(data, msg)
+	data = append(data, 0)
+	return p.writeMessage(data)
+}
+
+func (p *pgProtocol) sendParameterDescription(oids []uint32) error {
+	data := p.beginMessage(pgMsgParameterDesc)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(oids)))
+	for _, oid := range oids {
+		data = binary.BigEndian.AppendUint32(data, oid)
+	}
+	return p.writeMessage(data)
+}
+
+func (p *pgProtocol) sendRowDescription(columns []Column, formats []int16) error {
+	p.describePending = false
+	data := p.beginMessage(pgMsgRowDescription)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(columns)))
+	for i, col := range columns {
+		oid := pgOidOfColumn(col)
+		data = appendPgString(data, col.Name())
+		// table oid and column attribute number
+		data = binary.BigEndian.AppendUint32(data, 0)
+		data = binary.BigEndian.AppendUint16(data, 0)
+		data = binary.BigEndian.AppendUint32(data, oid)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgTypeSize(oid)))
+		// type modifier
+		data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgResultFormat(formats, i)))
+	}
+	return p.writeMessage(data)
+}
+
+func (p *pgProtocol) sendRows(mrs *MysqlResultSet, cnt uint64) error {
+	ctx := p.ses.GetRequestContext()
+	colCnt := mrs.GetColumnCount()
+	for r := uint64(0); r < cnt; r++ {
+		data := p.beginMessage(pgMsgDataRow)
+		data = binary.BigEndian.AppendUint16(data, uint16(colCnt))
+		for i := uint64(0); i < colCnt; i++ {
+			column, err := mrs.GetColumn(ctx, i)
+			if err != nil {
+				return err
+			}
+			if isNil, err := mrs.ColumnIsNull(ctx, r, i); err != nil {
+				return err
+			} else if isNil {
+				data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+				continue
+			}
+			text, err := pgTextValue(ctx, mrs, column, r, i)
+			if err != nil {
+				return err
+			}
+			value := []byte(text)
+			if pgResultFormat(p.resultFormats, int(i)) == pgFormatBinary {
+				if value, err = pgTextToBinary(ctx, pgOidOfColumn(column), text); err != nil {
+					return err
+				}
+			}
+			data = binary.BigEndian.AppendUint32(data, uint32(len(value)))
+			data = append(data, value...)
+		}
+		if err := p.writeMessage(data); err != nil {
+			return err
+		}
+		p.sentRows++
+	}
+	return nil
+}
+
+// describePrepared returns the id, the types of the parameters and the result
+// columns of the prepared statement.
+func (p *pgProtocol) describePrepared(ctx context.Context, stmt *PrepareStmt) (uint32, []uint32, []Column, error) {
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return 0, nil, nil, moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	stmtID, err := GetPrepareStmtID(ctx, stmt.Name)
+	if err != nil {
+		return 0, nil, nil, moerr.NewInternalError(ctx, "can not get Prepare stmtID")
+	}
+	paramOIDs := make([]uint32, len(dcPrepare.Prepare.ParamTypes))
+	for i, typ := range dcPrepare.Prepare.ParamTypes {
+		paramOIDs[i] = pgOidOfEngineType(ctx, types.T(typ))
+	}
+	resultColumns := plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan)
+	columns := make([]Column, 0, len(resultColumns))
+	for _, col := range resultColumns {
+		column := new(MysqlColumn)
+		column.SetName(col.Name)
+		if err = convertEngineTypeToMysqlType(ctx, types.T(col.Typ.Id), column); err != nil {
+			return 0, nil, nil, err
+		}
+		columns = append(columns, column)
+	}
+	return uint32(stmtID), paramOIDs, columns, nil
+}
+
+// pgCommandTag makes the tag of the CommandComplete for the statement in execution.
+func pgCommandTag(ses *Session, rows uint64) string {
+	if ses == nil || ses.ast == nil {
+		return "OK"
+	}
+	stmt := ses.ast
+	if execute, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, err := ses.GetPrepareStmt(string(execute.Name)); err == nil && prepareStmt.PrepareStmt != nil {
+			stmt = prepareStmt.PrepareStmt
+		}
+	}
+	switch stmt.(type) {
+	case *tree.Insert, *tree.Replace:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", rows)
+	case *tree.Load:
+		return fmt.Sprintf("COPY %d", rows)
+	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement:
+		return fmt.Sprintf("SELECT %d", rows)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	}
+	return strings.ToUpper(stmt.GetStatementType())
+}
+
+// pgSqlState returns the SQLSTATE of the error.
+func pgSqlState(err error) string {
+	if moerr.IsMoErrCode(err, moerr.ErrSyntaxError) || moerr.IsMoErrCode(err, moerr.ErrParseError) {
+		return "42601"
+	}
+	if moErr, ok := err.(*moerr.Error); ok && len(moErr.SqlState()) == 5 {
+		return moErr.SqlState()
+	}
+	return "XX000"
+}
+
+func pgOidOfColumn(col Column) uint32 {
+	unsigned := !col.IsSigned()
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgOidBool
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_YEAR:
+		return pgOidInt2
+	case defines.MYSQL_TYPE_SHORT:
+		if unsigned {
+			return pgOidInt4
+		}
+		return pgOidInt2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if unsigned {
+			return pgOidInt8
+		}
+		return pgOidInt4
+	case defines.MYSQL_TYPE_LONGLONG:
+		if unsigned {
+			return pgOidNumeric
+		}
+		return pgOidInt8
+	case defines.MYSQL_TYPE_BIT, defines.MYSQL_TYPE_DECIMAL:
+		return pgOidNumeric
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgOidFloat4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgOidFloat8
+	case defines.MYSQL_TYPE_STRING:
+		return pgOidBpchar
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		return pgOidVarchar
+	case defines.MYSQL_TYPE_DATE:
+		return pgOidDate
+	case defines.MYSQL_TYPE_TIME:
+		return pgOidTime
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return pgOidTimestamp
+	case defines.MYSQL_TYPE_JSON:
+		return pgOidJson
+	case defines.MYSQL_TYPE_UUID:
+		return pgOidUuid
+	default:
+		return pgOidText
+	}
+}
+
+// pgOidOfEngineType returns the oid of the type of the parameter in the prepared statement.
+func pgOidOfEngineType(ctx context.Context, typ types.T) uint32 {
+	col := new(MysqlColumn)
+	if err := convertEngineTypeToMysqlType(ctx, typ, col); err != nil {
+		return pgOidText
+	}
+	return pgOidOfColumn(col)
+}
+
+func pgTypeSize(oid uint32) int16 {
+	switch oid {
+	case pgOidBool:
+		return 1
+	case pgOidInt2:
+		return 2
+	case pgOidInt4, pgOidFloat4, pgOidDate:
+		return 4
+	case pgOidInt8, pgOidFloat8, pgOidTime, pgOidTimestamp:
+		return 8
+	case pgOidUuid:
+		return 16
+	default:
+		return -1
+	}
+}
+
+// pgResultFormat returns the format of the ith column. No format means all the
+// columns are in text, one format applies to all the columns.
+func pgResultFormat(formats []int16, i int) int16 {
+	switch {
+	case len(formats) == 0:
+		return pgFormatText
+	case len(formats) == 1:
+		return formats[0]
+	case i < len(formats):
+		return formats[i]
+	default:
+		return pgFormatText
+	}
+}
+
+// pgTextValue returns the value in the text format of postgresql.
+func pgTextValue(ctx context.Context, mrs *MysqlResultSet, column Column, r, i uint64) (string, error) {
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return "", err
+		}
+		if b, ok := value.(bool); ok {
+			if b {
+				return "t", nil
+			}
+			return "f", nil
+		}
+		return mrs.GetString(ctx, r, i)
+	case defines.MYSQL_TYPE_BIT:
+		value, err := mrs.GetUint64(ctx, r, i)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(value, 10), nil
+	case defines.MYSQL_TYPE_DATE:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return "", err
+		}
+		if d, ok := value.(types.Date); ok {
+			return d.String(), nil
+		}
+		return mrs.GetString(ctx, r, i)
+	default:
+		return mrs.GetString(ctx, r, i)
+	}
+}
+
+// pgTextToBinary converts the value in the text format into the binary format of the type.
+func pgTextToBinary(ctx context.Context, oid uint32, text string) ([]byte, error) {
+	var data []byte
+	switch oid {
+	case pgOidBool:
+		if text == "t" {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case pgOidInt2, pgOidInt4, pgOidInt8:
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid integer '%s'", text)
+		}
+		switch oid {
+		case pgOidInt2:
+			return binary.BigEndian.AppendUint16(data, uint16(v)), nil
+		case pgOidInt4:
+			return binary.BigEndian.AppendUint32(data, uint32(v)), nil
+		default:
+			return binary.BigEndian.AppendUint64(data, uint64(v)), nil
+		}
+	case pgOidFloat4:
+		v, err := strconv.ParseFloat(text, 32)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid float '%s'", text)
+		}
+		return binary.BigEndian.AppendUint32(data, math.Float32bits(float32(v))), nil
+	case pgOidFloat8:
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid double '%s'", text)
+		}
+		return binary.BigEndian.AppendUint64(data, math.Float64bits(v)), nil
+	case pgOidNumeric:
+		return appendPgNumeric(ctx, data, text)
+	case pgOidDate:
+		t, err := time.Parse("2006-01-02", text)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid date '%s'", text)
+		}
+		days := int32(t.Sub(pgEpoch).Hours() / 24)
+		return binary.BigEndian.AppendUint32(data, uint32(days)), nil
+	case pgOidTimestamp:
+		t, err := time.Parse("2006-01-02 15:04:05.999999", text)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid timestamp '%s'", text)
+		}
+		return binary.BigEndian.AppendUint64(data, uint64(t.Sub(pgEpoch).Microseconds())), nil
+	case pgOidTime:
+		t, err := time.Parse("15:04:05.999999", text)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid time '%s'", text)
+		}
+		micros := t.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)).Microseconds()
+		return binary.BigEndian.AppendUint64(data, uint64(micros)), nil
+	case pgOidUuid:
+		v, err := hex.DecodeString(strings.ReplaceAll(text, "-", ""))
+		if err != nil || len(v) != 16 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid uuid '%s'", text)
+		}
+		return v, nil
+	default:
+		return []byte(text), nil
+	}
+}
+
+// appendPgNumeric appends the decimal in the binary format of the numeric,
+// which is the digits in base 10000 with the weight of the first digit.
+func appendPgNumeric(ctx context.Context, data []byte, text string) ([]byte, error) {
+	s := text
+	sign := uint16(0)
+	if strings.HasPrefix(s, "-") {
+		sign = 0x4000
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(intPart)+len(fracPart) == 0 || strings.Trim(intPart+fracPart, "0123456789") != "" {
+		return nil, moerr.NewInvalidInput(ctx, "invalid numeric '%s'", text)
+	}
+	dscale := len(fracPart)
+	intPart = strings.TrimLeft(intPart, "0")
+	if pad := len(intPart) % 4; pad != 0 {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; pad != 0 {
+		fracPart += strings.Repeat("0", 4-pad)
+	}
+	digitsOf := func(s string) []uint16 {
+		digits := make([]uint16, 0, len(s)/4)
+		for j := 0; j < len(s); j += 4 {
+			d, _ := strconv.Atoi(s[j : j+4])
+			digits = append(digits, uint16(d))
+		}
+		return digits
+	}
+	digits := append(digitsOf(intPart), digitsOf(fracPart)...)
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = 0
+	}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(digits)))
+	data = binary.BigEndian.AppendUint16(data, uint16(int16(weight)))
+	data = binary.BigEndian.AppendUint16(data, sign)
+	data = binary.BigEndian.AppendUint16(data, uint16(dscale))
+	for _, d := range digits {
+		data = binary.BigEndian.AppendUint16(data, d)
+	}
+	return data, nil
+}
+
+// pgDecodeNumeric converts the numeric in the binary format into the text.
+func pgDecodeNumeric(ctx context.Context, data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", moerr.NewInvalidInput(ctx, "invalid numeric parameter")
+	}
+	ndigits := int(binary.BigEndian.Uint16(data[0:2]))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:4])))
+	sign := binary.BigEndian.Uint16(data[4:6])
+	dscale := int(binary.BigEndian.Uint16(data[6:8]))
+	if len(data) < 8+ndigits*2 {
+		return "", moerr.NewInvalidInput(ctx, "invalid numeric parameter")
+	}
+	var intPart, fracPart strings.Builder
+	for j := 0; j <= weight || j < ndigits; j++ {
+		d := 0
+		if j < ndigits {
+			d = int(binary.BigEndian.Uint16(data[8+j*2:]))
+		}
+		if j <= weight {
+			if intPart.Len() == 0 {
+				intPart.WriteString(strconv.Itoa(d))
+			} else {
+				fmt.Fprintf(&intPart, "%04d", d)
+			}
+		} else {
+			fmt.Fprintf(&fracPart, "%04d", d)
+		}
+	}
+	// the digits before the first one are zero when the weight is negative
+	frac := strings.Repeat("0000", max(0, -weight-1)) + fracPart.String()
+	if len(frac) < dscale {
+		frac += strings.Repeat("0", dscale-len(frac))
+	}
+	frac = frac[:dscale]
+	s := intPart.String()
+	if s == "" {
+		s = "0"
+	}
+	if sign == 0x4000 {
+		s = "-" + s
+	}
+	if dscale > 0 {
+		s += "." + frac
+	}
+	return s, nil
+}
+
+// pgDecodeParam converts the parameter from the Bind message into the value
+// to be set into the prepared statement. nil is the NULL.
+func pgDecodeParam(ctx context.Context, oid uint32, format int16, data []byte) (any, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if format == pgFormatText {
+		if oid == pgOidBool {
+			switch string(data) {
+			case "t":
+				return "true", nil
+			case "f":
+				return "false", nil
+			}
+		}
+		return string(data), nil
+	}
+
+	invalid := func() error {
+		return moerr.NewInvalidInput(ctx, "invalid binary parameter of type %d", oid)
+	}
+	switch oid {
+	case pgOidBool:
+		if len(data) != 1 {
+			return nil, invalid()
+		}
+		return data[0] != 0, nil
+	case pgOidInt2:
+		if len(data) != 2 {
+			return nil, invalid()
+		}
+		return int16(binary.BigEndian.Uint16(data)), nil
+	case pgOidInt4:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		return int32(binary.BigEndian.Uint32(data)), nil
+	case pgOidInt8:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		return int64(binary.BigEndian.Uint64(data)), nil
+	case pgOidFloat4:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(data)), nil
+	case pgOidFloat8:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+	case pgOidNumeric:
+		return pgDecodeNumeric(ctx, data)
+	case pgOidDate:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		days := int32(binary.BigEndian.Uint32(data))
+		return pgEpoch.AddDate(0, 0, int(days)).Format("2006-01-02"), nil
+	case pgOidTimestamp:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		micros := int64(binary.BigEndian.Uint64(data))
+		return pgEpoch.Add(time.Duration(micros) * time.Microsecond).Format("2006-01-02 15:04:05.999999"), nil
+	case pgOidUuid:
+		if len(data) != 16 {
+			return nil, invalid()
+		}
+		var u types.Uuid
+		copy(u[:], data)
+		return u.ToString(), nil
+	default:
+		return string(data), nil
+	}
+}
+
+// pgReplacePlaceholders replaces the $n placeholders in the sql with '?' of the
+// prepared statement, and returns the index of the parameter of each placeholder.
+func pgReplacePlaceholders(sql string) (string, []int) {
+	var sb strings.Builder
+	var order []int
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// skip the quoted string or identifier
+			j := i + 1
+			for j < len(sql) {
+				if sql[j] == '\\' && c != '`' {
+					j += 2
+					continue
+				}
+				if sql[j] == c {
+					if j+1 < len(sql) && sql[j+1] == c {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			j = min(j+1, len(sql))
+			sb.WriteString(sql[i:j])
+			i = j
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql) - i
+			}
+			sb.WriteString(sql[i : i+j])
+			i += j
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				j = len(sql) - i
+			} else {
+				j += 4
+			}
+			sb.WriteString(sql[i : i+j])
+			i += j
+		case c == '$' && i+1 < len(sql) && isDigit(sql[i+1]) && (i == 0 || !isIdentifierChar(sql[i-1])):
+			j := i + 1
+			for j < len(sql) && isDigit(sql[j]) {
+				j++
+			}
+			n, _ := strconv.Atoi(sql[i+1 : j])
+			if n == 0 {
+				sb.WriteString(sql[i:j])
+			} else {
+				sb.WriteByte('?')
+				order = append(order, n-1)
+			}
+			i = j
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), order
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parsePostgresql parses the sql from the postgresql clients with the
+// postgresql grammar. The error of the sql it can not parse is a syntax error.
+func parsePostgresql(ctx context.Context, sql string, lower int64, useOrigin int64) ([]tree.Statement, error) {
+	stmts, err := parsers.Parse(ctx, dialect.POSTGRESQL, sql, lower, useOrigin)
+	if err != nil {
+		if _, ok := err.(*moerr.Error); !ok {
+			err = moerr.NewSyntaxError(ctx, "%s", err.Error())
+		}
+		return nil, err
+	}
+	return stmts, nil
+}
+
+// pgCopyFailError returns the error of the CopyFail message with which the
+// client aborts COPY FROM STDIN, or nil if the message is not CopyFail.
+func pgCopyFailError(ctx context.Context, msg interface{}) error {
+	if m, ok := msg.(*pgMessage); ok && m.typ == pgMsgCopyFail {
+		reason, _, _ := readPgString(m.payload, 0)
+		return moerr.NewInvalidInput(ctx, "COPY from stdin failed: %s", reason)
+	}
+	return nil
+}
+
+// appendPgString appends the null-terminated string.
+func appendPgString(data []byte, s string) []byte {
+	data = append(data, s...)
+	return append(data, 0)
+}
+
+// readPgString reads the null-terminated string.
+func readPgString(data []byte, pos int) (string, int, bool) {
+	end := bytes.IndexByte(data[pos:], 0)
+	if end < 0 {
+		return "", 0, false
+	}
+	return string(data[pos : pos+end]), pos + end + 1, true
+}
+
+func readPgUint16(data []byte, pos int) (uint16, int, bool) {
+	if pos+2 > len(data) {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint16(data[pos:]), pos + 2, true
+}
+
+func readPgUint32(data []byte, pos int) (uint32, int, bool) {
+	if pos+4 > len(data) {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint32(data[pos:]), pos + 4, true
+}
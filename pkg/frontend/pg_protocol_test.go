@@ -0,0 +1,325 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	goetty_buf "github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func makePgMessage(typ byte, payload []byte) []byte {
+	var data []byte
+	if typ != 0 {
+		data = append(data, typ)
+	}
+	data = binary.BigEndian.AppendUint32(data, uint32(len(payload)+4))
+	return append(data, payload...)
+}
+
+func Test_pgCodec(t *testing.T) {
+	convey.Convey("decode the postgresql messages", t, func() {
+		codec := NewPgCodec()
+		in := goetty_buf.NewByteBuf(1024)
+
+		startup := binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+		startup = appendPgString(startup, "user")
+		startup = appendPgString(startup, "dump")
+		startup = append(startup, 0)
+		in.MustWrite(makePgMessage(0, startup))
+		in.MustWrite(makePgMessage(pgMsgQuery, appendPgString(nil, "select 1")))
+		in.MustWrite(makePgMessage(pgMsgCopyData, []byte("1\ta\n")))
+		in.MustWrite(makePgMessage(pgMsgCopyDone, nil))
+		// half of a message
+		in.MustWrite(makePgMessage(pgMsgSync, nil)[:3])
+
+		msg, ok, err := codec.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*pgMessage).typ, convey.ShouldEqual, 0)
+		convey.So(msg.(*pgMessage).payload, convey.ShouldResemble, startup)
+
+		msg, ok, err = codec.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*pgMessage).typ, convey.ShouldEqual, pgMsgQuery)
+		convey.So(string(msg.(*pgMessage).payload), convey.ShouldEqual, "select 1\x00")
+
+		msg, ok, err = codec.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*Packet).Length, convey.ShouldEqual, 4)
+		convey.So(string(msg.(*Packet).Payload), convey.ShouldEqual, "1\ta\n")
+
+		msg, ok, err = codec.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*Packet).Length, convey.ShouldEqual, 0)
+
+		_, ok, err = codec.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("decode the message with invalid length", t, func() {
+		codec := NewPgCodec()
+		in := goetty_buf.NewByteBuf(1024)
+		in.MustWrite([]byte{pgMsgQuery, 0, 0, 0, 1})
+		_, _, err := codec.Decode(in)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_pgReplacePlaceholders(t *testing.T) {
+	kases := []struct {
+		sql   string
+		want  string
+		order []int
+	}{
+		{"select * from t where a = $1 and b = $2", "select * from t where a = ? and b = ?", []int{0, 1}},
+		{"select $2, $1, $2", "select ?, ?, ?", []int{1, 0, 1}},
+		{"select '$1', \"$2\", $3", "select '$1', \"$2\", ?", []int{2}},
+		{"select 'it''s $1' -- $2\n, $1", "select 'it''s $1' -- $2\n, ?", []int{0}},
+		{"select /* $1 */ a$1 from t", "select /* $1 */ a$1 from t", nil},
+		{"select 1", "select 1", nil},
+	}
+	for _, kase := range kases {
+		sql, order := pgReplacePlaceholders(kase.sql)
+		require.Equal(t, kase.want, sql)
+		require.Equal(t, kase.order, order)
+	}
+}
+
+func Test_parsePostgresql(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		sql  string
+		want string
+		err  bool
+	}{
+		{
+			sql:  "COPY t FROM STDIN",
+			want: "load data local infile stdin into table t fields terminated by \t lines terminated by \n",
+		},
+		{
+			sql:  "copy db.t (a, b) from stdin with (format csv, header true);",
+			want: "load data local infile stdin into table db.t fields terminated by , enclosed by \" lines terminated by \n ignore 1 lines (a, b)",
+		},
+		{
+			sql:  "copy t from stdin csv delimiter '|'",
+			want: "load data local infile stdin into table t fields terminated by | enclosed by \" lines terminated by \n",
+		},
+		{
+			sql: "copy t from stdin with (format binary)",
+			err: true,
+		},
+		{
+			sql: "copy t from stdin with (delimiter '||')",
+			err: true,
+		},
+	}
+	for _, kase := range kases {
+		stmts, err := parsePostgresql(ctx, kase.sql, 1, 0)
+		if kase.err {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stmts))
+		require.Equal(t, kase.want, tree.String(stmts[0], dialect.MYSQL))
+	}
+
+	// the sql out of the postgresql grammar is not parsed by the mysql one.
+	_, err := parsePostgresql(ctx, "select 1", 1, 0)
+	require.Error(t, err)
+	require.Equal(t, "42601", pgSqlState(err))
+}
+
+func Test_pgNumeric(t *testing.T) {
+	ctx := context.TODO()
+	for _, s := range []string{"0", "1", "-1", "12345.678", "0.0001", "10000", "-0.50", "123456789012345678901234567890"} {
+		data, err := appendPgNumeric(ctx, nil, s)
+		require.NoError(t, err)
+		got, err := pgDecodeNumeric(ctx, data)
+		require.NoError(t, err)
+		require.Equal(t, s, got)
+	}
+
+	// 12345.678 is 1 2345 . 6780 with the weight 1
+	data, err := appendPgNumeric(ctx, nil, "12345.678")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 3, 0, 1, 0, 0, 0, 3, 0, 1, 0x09, 0x29, 0x1a, 0x7c}, data)
+
+	_, err = appendPgNumeric(ctx, nil, "1e10")
+	require.Error(t, err)
+}
+
+func Test_pgDecodeParam(t *testing.T) {
+	ctx := context.TODO()
+
+	v, err := pgDecodeParam(ctx, pgOidInt4, pgFormatText, []byte("42"))
+	require.NoError(t, err)
+	require.Equal(t, "42", v)
+
+	v, err = pgDecodeParam(ctx, pgOidBool, pgFormatText, []byte("t"))
+	require.NoError(t, err)
+	require.Equal(t, "true", v)
+
+	v, err = pgDecodeParam(ctx, pgOidInt4, pgFormatText, nil)
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	v, err = pgDecodeParam(ctx, pgOidInt4, pgFormatBinary, []byte{0xff, 0xff, 0xff, 0xfe})
+	require.NoError(t, err)
+	require.Equal(t, int32(-2), v)
+
+	v, err = pgDecodeParam(ctx, pgOidInt8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1<<40))
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<40), v)
+
+	_, err = pgDecodeParam(ctx, pgOidInt8, pgFormatBinary, []byte{1})
+	require.Error(t, err)
+
+	for _, kase := range []struct {
+		oid  uint32
+		text string
+	}{
+		{pgOidDate, "2024-02-29"},
+		{pgOidDate, "1999-12-31"},
+		{pgOidTimestamp, "2024-02-29 12:34:56.789"},
+		{pgOidFloat8, "1.5"},
+		{pgOidUuid, "0d5d0f04-7d29-4e2a-9f5b-6d7a3c5e1b2a"},
+	} {
+		data, err := pgTextToBinary(ctx, kase.oid, kase.text)
+		require.NoError(t, err)
+		v, err = pgDecodeParam(ctx, kase.oid, pgFormatBinary, data)
+		require.NoError(t, err)
+		if kase.oid == pgOidFloat8 {
+			require.Equal(t, 1.5, v)
+		} else {
+			require.Equal(t, kase.text, v)
+		}
+	}
+}
+
+func Test_pgResultFormat(t *testing.T) {
+	require.Equal(t, pgFormatText, pgResultFormat(nil, 3))
+	require.Equal(t, pgFormatBinary, pgResultFormat([]int16{pgFormatBinary}, 3))
+	require.Equal(t, pgFormatBinary, pgResultFormat([]int16{pgFormatText, pgFormatBinary}, 1))
+	require.Equal(t, pgFormatText, pgResultFormat([]int16{pgFormatText, pgFormatBinary}, 2))
+}
+
+func Test_pgProtocol_sendMessages(t *testing.T) {
+	convey.Convey("send the postgresql messages", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var written [][]byte
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+			written = append(written, append([]byte(nil), msg.([]byte)...))
+			return nil
+		}).AnyTimes()
+		ioses.EXPECT().Flush(gomock.Any()).Return(nil).AnyTimes()
+
+		pro := NewPgProtocol(1001, ioses, 1024, nil)
+
+		columns := []Column{new(MysqlColumn), new(MysqlColumn)}
+		columns[0].SetName("a")
+		columns[0].SetColumnType(defines.MYSQL_TYPE_LONG)
+		columns[0].SetSigned(true)
+		columns[1].SetName("b")
+		columns[1].SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		convey.So(pro.sendRowDescription(columns, nil), convey.ShouldBeNil)
+		convey.So(written, convey.ShouldHaveLength, 1)
+		desc := written[0]
+		convey.So(desc[0], convey.ShouldEqual, pgMsgRowDescription)
+		convey.So(binary.BigEndian.Uint32(desc[1:5]), convey.ShouldEqual, len(desc)-1)
+		convey.So(binary.BigEndian.Uint16(desc[5:7]), convey.ShouldEqual, 2)
+		convey.So(string(desc[7:9]), convey.ShouldEqual, "a\x00")
+		// table oid(4) and attribute number(2) are followed by the type oid
+		convey.So(binary.BigEndian.Uint32(desc[15:19]), convey.ShouldEqual, pgOidInt4)
+
+		written = nil
+		pro.mute = true
+		convey.So(pro.sendError(moerr.NewInvalidInputNoCtx("bad")), convey.ShouldBeNil)
+		convey.So(written, convey.ShouldBeEmpty)
+		convey.So(pro.err, convey.ShouldNotBeNil)
+
+		pro.mute = false
+		convey.So(pro.sendErrorResponse("ERROR", "22023", "bad"), convey.ShouldBeNil)
+		convey.So(written, convey.ShouldHaveLength, 1)
+		convey.So(string(written[0][5:]), convey.ShouldEqual, "SERROR\x00VERROR\x00C22023\x00Mbad\x00\x00")
+
+		written = nil
+		pro.describePending = true
+		convey.So(pro.sendCommandComplete(0), convey.ShouldBeNil)
+		convey.So(written, convey.ShouldHaveLength, 2)
+		convey.So(written[0][0], convey.ShouldEqual, pgMsgNoData)
+		convey.So(written[1][0], convey.ShouldEqual, pgMsgCommandComplete)
+		convey.So(string(written[1][5:]), convey.ShouldEqual, "OK\x00")
+	})
+}
+
+func Test_pgStartupWithoutTLS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var written [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		written = append(written, append([]byte(nil), msg.([]byte)...))
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Flush(gomock.Any()).Return(nil).AnyTimes()
+
+	pro := NewPgProtocol(1001, ioses, 1024, nil)
+	routine := &Routine{}
+	routine.setSession(&Session{timestampMap: map[TS]time.Time{}})
+	prm := &pgRoutineManager{}
+
+	startup := binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+	startup = append(startup, "user\x00u1\x00\x00"...)
+	msg := &pgMessage{payload: startup}
+
+	// the client on plain TCP is not asked for its password in cleartext
+	err := prm.handleStartup(context.TODO(), ioses, routine, pro, msg)
+	require.Error(t, err)
+	require.False(t, pro.waitPassword)
+	require.Len(t, written, 1)
+	require.Equal(t, pgMsgErrorResponse, written[0][0])
+	require.Contains(t, string(written[0]), "C28000\x00")
+
+	written = nil
+	pro.SetTlsEstablished()
+	require.NoError(t, prm.handleStartup(context.TODO(), ioses, routine, pro, msg))
+	require.True(t, pro.waitPassword)
+	require.Len(t, written, 1)
+	require.Equal(t, pgMsgAuthentication, written[0][0])
+	require.Equal(t, uint32(pgAuthCleartextPassword), binary.BigEndian.Uint32(written[0][5:]))
+}
@@ -0,0 +1,584 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+)
+
+// pgRoutineManager serves the connections of the postgresql clients. The
+// routines and the sessions are kept in the RoutineManager with the ones of
+// the mysql clients, so that they can be killed and listed in the same way.
+type pgRoutineManager struct {
+	*RoutineManager
+}
+
+func newPgRoutineManager(rm *RoutineManager) *pgRoutineManager {
+	return &pgRoutineManager{RoutineManager: rm}
+}
+
+func (prm *pgRoutineManager) Created(rs goetty.IOSession) {
+	logutil.Debugf("get the postgresql connection from %s", rs.RemoteAddress())
+	createdStart := time.Now()
+	connID, err := prm.getConnID()
+	if err != nil {
+		logutil.Errorf("failed to get connection ID from HAKeeper: %v", err)
+		return
+	}
+	pro := NewPgProtocol(connID, rs, int(getGlobalPu().SV.MaxBytesInOutbufToFlush), getGlobalPu().SV)
+	routine := NewRoutine(prm.getCtx(), pro, getGlobalPu().SV, rs)
+	v2.CreatedRoutineCounter.Inc()
+
+	ses := NewSession(routine.getProtocol(), nil, GSysVariables, true, nil)
+	cancelCtx := routine.getCancelRoutineCtx()
+	if prm.baseService != nil {
+		cancelCtx = context.WithValue(cancelCtx, defines.NodeIDKey{}, prm.baseService.ID())
+	}
+	ses.SetRequestContext(cancelCtx)
+	ses.SetConnectContext(cancelCtx)
+	ses.SetFromRealUser(true)
+	ses.SetDialectType(dialect.POSTGRESQL)
+	ses.setRoutineManager(prm.RoutineManager)
+	ses.setRoutine(routine)
+	ses.clientAddr = pro.Peer()
+
+	ses.timestampMap[TSCreatedStart] = createdStart
+	defer func() {
+		ses.timestampMap[TSCreatedEnd] = time.Now()
+		v2.CreatedDurationHistogram.Observe(ses.timestampMap[TSCreatedEnd].Sub(ses.timestampMap[TSCreatedStart]).Seconds())
+	}()
+
+	routine.setSession(ses)
+	pro.SetSession(ses)
+
+	// unlike the mysql protocol, the client starts the conversation with the
+	// startup message, so nothing is sent here.
+	prm.setRoutine(rs, connID, routine)
+}
+
+func (prm *pgRoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	ctx, span := trace.Start(prm.getCtx(), "pgRoutineManager.Handler",
+		trace.WithKind(trace.SpanKindStatement))
+	defer span.End()
+	routine := prm.getRoutine(rs)
+	if routine == nil {
+		err := moerr.NewInternalError(ctx, "routine does not exist")
+		logutil.Errorf("%s error:%v", getConnectionInfo(rs), err)
+		return err
+	}
+	routine.updateGoroutineId()
+	routine.setInProcessRequest(true)
+	defer routine.setInProcessRequest(false)
+	pro := routine.getProtocol().(*pgProtocol)
+
+	message, ok := msg.(*pgMessage)
+	if !ok {
+		// CopyData and CopyDone out of COPY FROM STDIN are ignored as postgresql does.
+		return nil
+	}
+
+	if !pro.IsEstablished() {
+		return prm.handleStartup(ctx, rs, routine, pro, message)
+	}
+	return prm.handleMessage(ctx, routine, pro, message)
+}
+
+// handleStartup handles the messages before the authentication has been done.
+func (prm *pgRoutineManager) handleStartup(ctx context.Context, rs goetty.IOSession, routine *Routine, pro *pgProtocol, msg *pgMessage) error {
+	ses := routine.getSession()
+	if msg.typ == pgMsgPassword && pro.waitPassword {
+		return prm.handlePassword(ctx, routine, pro, msg)
+	}
+	code, pos, ok := readPgUint32(msg.payload, 0)
+	if msg.typ != 0 || !ok {
+		return moerr.NewInvalidInput(ctx, "invalid postgresql startup message")
+	}
+
+	switch code {
+	case pgSSLRequestCode:
+		if pro.IsTlsEstablished() || prm.getTlsConfig() == nil {
+			return pro.writeRaw([]byte{'N'})
+		}
+		if err := pro.writeRaw([]byte{'S'}); err != nil {
+			return err
+		}
+		ts := ses.timestampMap
+		ts[TSUpgradeTLSStart] = time.Now()
+		tlsConn := tls.Server(rs.RawConn(), prm.getTlsConfig())
+		newCtx, cancelFun := context.WithTimeout(ctx, 20*time.Second)
+		defer cancelFun()
+		if err := tlsConn.HandshakeContext(newCtx); err != nil {
+			logError(ses, ses.GetDebugString(),
+				"Failed to upgrade to TLS",
+				zap.Error(err))
+			return err
+		}
+		rs.UseConn(tlsConn)
+		pro.SetTlsEstablished()
+		ts[TSUpgradeTLSEnd] = time.Now()
+		v2.UpgradeTLSDurationHistogram.Observe(ts[TSUpgradeTLSEnd].Sub(ts[TSUpgradeTLSStart]).Seconds())
+		return nil
+
+	case pgGSSENCRequestCode:
+		return pro.writeRaw([]byte{'N'})
+
+	case pgCancelRequestCode:
+		connID, pos, ok := readPgUint32(msg.payload, pos)
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "invalid postgresql cancel request")
+		}
+		secretKey, _, ok := readPgUint32(msg.payload, pos)
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "invalid postgresql cancel request")
+		}
+		if rt := prm.getRoutineByConnID(connID); rt != nil {
+			if target, ok := rt.getProtocol().(*pgProtocol); ok && target.secretKey == secretKey {
+				logutil.Infof("cancel the query on the postgresql connection %d", connID)
+				rt.killQuery(false, "")
+			}
+		}
+		// the connection of the CancelRequest is closed without any response.
+		return moerr.GetMysqlClientQuit()
+
+	case pgProtocolVersion:
+		for pos < len(msg.payload) && msg.payload[pos] != 0 {
+			var name, value string
+			if name, pos, ok = readPgString(msg.payload, pos); !ok {
+				return moerr.NewInvalidInput(ctx, "invalid postgresql startup message")
+			}
+			if value, pos, ok = readPgString(msg.payload, pos); !ok {
+				return moerr.NewInvalidInput(ctx, "invalid postgresql startup message")
+			}
+			switch name {
+			case "user":
+				pro.SetUserName(value)
+			case "database":
+				pro.SetDatabaseName(value)
+			}
+		}
+		if pro.GetUserName() == "" {
+			err := moerr.NewInvalidInput(ctx, "no user name in the postgresql startup message")
+			_ = pro.sendErrorResponse("FATAL", "28000", err.Error())
+			_ = pro.flush()
+			return err
+		}
+		// the password is sent in cleartext, which only TLS keeps from being
+		// seen on the wire.
+		if !pro.IsTlsEstablished() {
+			err := moerr.NewInvalidInput(ctx, "the postgresql connection without SSL can not authenticate by the password")
+			_ = pro.sendErrorResponse("FATAL", "28000", err.Error())
+			_ = pro.flush()
+			return err
+		}
+		ses.timestampMap[TSEstablishStart] = time.Now()
+		pro.waitPassword = true
+		if err := pro.sendAuthentication(pgAuthCleartextPassword); err != nil {
+			return err
+		}
+		return pro.flush()
+
+	default:
+		err := moerr.NewNotSupported(ctx, "postgresql protocol version %d.%d", code>>16, code&0xffff)
+		_ = pro.sendErrorResponse("FATAL", "0A000", err.Error())
+		_ = pro.flush()
+		return err
+	}
+}
+
+// handlePassword authenticates the client with the cleartext password sent
+// over TLS.
+func (prm *pgRoutineManager) handlePassword(ctx context.Context, routine *Routine, pro *pgProtocol, msg *pgMessage) error {
+	ses := routine.getSession()
+	ts := ses.timestampMap
+	pro.waitPassword = false
+	pro.authResponse = []byte(strings.TrimRight(string(msg.payload), "\x00"))
+
+	ts[TSAuthenticateStart] = time.Now()
+	if err := pro.Authenticate(ctx); err != nil {
+		_, _, errMsg := RewriteError(err, pro.GetUserName())
+		_ = pro.sendErrorResponse("FATAL", "28P01", errMsg)
+		_ = pro.flush()
+		return err
+	}
+	ts[TSAuthenticateEnd] = time.Now()
+	pro.SetEstablished()
+
+	dbName := pro.GetDatabaseName()
+	if dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+	if err := pro.sendStartupResponse(); err != nil {
+		return err
+	}
+	ts[TSEstablishEnd] = time.Now()
+	v2.EstablishDurationHistogram.Observe(ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).Seconds())
+	logInfof(ses.GetDebugString(), fmt.Sprintf("mo accept postgresql connection, time cost of Created: %s, Establish: %s, Authenticate: %s",
+		ts[TSCreatedEnd].Sub(ts[TSCreatedStart]).String(),
+		ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).String(),
+		ts[TSAuthenticateEnd].Sub(ts[TSAuthenticateStart]).String()))
+
+	prm.sessionManager.AddSession(ses)
+	return nil
+}
+
+// handleMessage handles the messages of the simple query and the extended query.
+func (prm *pgRoutineManager) handleMessage(ctx context.Context, routine *Routine, pro *pgProtocol, msg *pgMessage) error {
+	// after an error in the extended query, the messages until Sync are discarded.
+	if pro.skipUntilSync && msg.typ != pgMsgSync && msg.typ != pgMsgTerminate {
+		return nil
+	}
+
+	switch msg.typ {
+	case pgMsgQuery:
+		return prm.handleQuery(ctx, routine, pro, msg.payload)
+	case pgMsgParse:
+		return prm.handleParse(ctx, routine, pro, msg.payload)
+	case pgMsgBind:
+		return prm.handleBind(ctx, pro, msg.payload)
+	case pgMsgDescribe:
+		return prm.handleDescribe(ctx, pro, msg.payload)
+	case pgMsgExecute:
+		return prm.handleExecute(ctx, routine, pro, msg.payload)
+	case pgMsgClose:
+		return prm.handleClose(ctx, routine, pro, msg.payload)
+	case pgMsgSync:
+		pro.skipUntilSync = false
+		return pro.sendReadyForQuery()
+	case pgMsgFlush:
+		return pro.flush()
+	case pgMsgTerminate:
+		return moerr.GetMysqlClientQuit()
+	case pgMsgCopyFail:
+		// it is out of COPY FROM STDIN.
+		return nil
+	default:
+		return prm.sendExtendedError(pro, moerr.NewNotSupported(ctx, "postgresql message '%c'", msg.typ))
+	}
+}
+
+// execute runs the request in the session. The responses are discarded if
+// mute is true, and the error of the request is returned in execErr.
+func (prm *pgRoutineManager) execute(routine *Routine, pro *pgProtocol, req *Request, mute bool) (execErr error, err error) {
+	pro.err = nil
+	pro.mute = mute
+	err = routine.handleRequest(req)
+	pro.mute = false
+	return pro.err, err
+}
+
+func (prm *pgRoutineManager) handleQuery(ctx context.Context, routine *Routine, pro *pgProtocol, payload []byte) error {
+	query := strings.TrimRight(string(payload), "\x00")
+	if strings.TrimSpace(query) == "" {
+		if err := pro.sendEmptyMessage(pgMsgEmptyQueryResponse); err != nil {
+			return err
+		}
+		return pro.sendReadyForQuery()
+	}
+	pro.describeRows = true
+	pro.resultFormats = nil
+	if _, err := prm.execute(routine, pro, &Request{cmd: COM_QUERY, data: []byte(query)}, false); err != nil {
+		return err
+	}
+	return pro.sendReadyForQuery()
+}
+
+// sendExtendedError sends the error of the extended query, and discards the
+// messages until Sync.
+func (prm *pgRoutineManager) sendExtendedError(pro *pgProtocol, err error) error {
+	pro.skipUntilSync = true
+	return pro.sendError(err)
+}
+
+func (prm *pgRoutineManager) handleParse(ctx context.Context, routine *Routine, pro *pgProtocol, payload []byte) error {
+	name, pos, ok := readPgString(payload, 0)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql parse message"))
+	}
+	query, pos, ok := readPgString(payload, pos)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql parse message"))
+	}
+	numOIDs, pos, ok := readPgUint16(payload, pos)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql parse message"))
+	}
+	oids := make([]uint32, numOIDs)
+	for i := range oids {
+		if oids[i], pos, ok = readPgUint32(payload, pos); !ok {
+			return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql parse message"))
+		}
+	}
+
+	if err := prm.closeStatement(routine, pro, name); err != nil {
+		return err
+	}
+
+	sql, order := pgReplacePlaceholders(query)
+	stmt := &pgStatement{sql: sql, paramOrder: order}
+	numParams := len(oids)
+	for _, idx := range order {
+		numParams = max(numParams, idx+1)
+	}
+	stmt.paramOIDs = make([]uint32, numParams)
+	copy(stmt.paramOIDs, oids)
+
+	if strings.TrimSpace(sql) != "" {
+		pro.prepared = nil
+		execErr, err := prm.execute(routine, pro, &Request{cmd: COM_STMT_PREPARE, data: []byte(sql)}, true)
+		if err != nil {
+			return err
+		}
+		if execErr == nil && pro.prepared != nil {
+			id, paramOIDs, columns, err := pro.describePrepared(ctx, pro.prepared)
+			if err != nil {
+				return prm.sendExtendedError(pro, err)
+			}
+			stmt.id = id
+			stmt.columns = columns
+			for i, idx := range order {
+				if i < len(paramOIDs) && stmt.paramOIDs[idx] == 0 {
+					stmt.paramOIDs[idx] = paramOIDs[i]
+				}
+			}
+		} else if len(order) != 0 {
+			if execErr == nil {
+				execErr = moerr.NewInternalError(ctx, "can not prepare the statement")
+			}
+			return prm.sendExtendedError(pro, execErr)
+		}
+		// the statement without parameters that can not be prepared, e.g. the ddl,
+		// is executed as the simple query.
+	}
+	for i := range stmt.paramOIDs {
+		if stmt.paramOIDs[i] == 0 {
+			stmt.paramOIDs[i] = pgOidText
+		}
+	}
+	pro.statements[name] = stmt
+	return pro.sendEmptyMessage(pgMsgParseComplete)
+}
+
+// closeStatement deallocates the statement in the session.
+func (prm *pgRoutineManager) closeStatement(routine *Routine, pro *pgProtocol, name string) error {
+	stmt, ok := pro.statements[name]
+	if !ok {
+		return nil
+	}
+	delete(pro.statements, name)
+	if !stmt.prepared() {
+		return nil
+	}
+	data := binary.LittleEndian.AppendUint32(nil, stmt.id)
+	_, err := prm.execute(routine, pro, &Request{cmd: COM_STMT_CLOSE, data: data}, true)
+	return err
+}
+
+func (prm *pgRoutineManager) handleBind(ctx context.Context, pro *pgProtocol, payload []byte) error {
+	invalid := func() error {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql bind message"))
+	}
+	portalName, pos, ok := readPgString(payload, 0)
+	if !ok {
+		return invalid()
+	}
+	stmtName, pos, ok := readPgString(payload, pos)
+	if !ok {
+		return invalid()
+	}
+	stmt, ok := pro.statements[stmtName]
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "prepared statement '%s' does not exist", stmtName))
+	}
+
+	numFormats, pos, ok := readPgUint16(payload, pos)
+	if !ok {
+		return invalid()
+	}
+	paramFormats := make([]int16, numFormats)
+	for i := range paramFormats {
+		var v uint16
+		if v, pos, ok = readPgUint16(payload, pos); !ok {
+			return invalid()
+		}
+		paramFormats[i] = int16(v)
+	}
+
+	numParams, pos, ok := readPgUint16(payload, pos)
+	if !ok {
+		return invalid()
+	}
+	if int(numParams) != len(stmt.paramOIDs) {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "expect %d parameters, but got %d", len(stmt.paramOIDs), numParams))
+	}
+	params := make([]any, numParams)
+	for i := range params {
+		var length uint32
+		if length, pos, ok = readPgUint32(payload, pos); !ok {
+			return invalid()
+		}
+		var data []byte
+		if int32(length) >= 0 {
+			if pos+int(length) > len(payload) {
+				return invalid()
+			}
+			data = payload[pos : pos+int(length)]
+			pos += int(length)
+		}
+		value, err := pgDecodeParam(ctx, stmt.paramOIDs[i], pgResultFormat(paramFormats, i), data)
+		if err != nil {
+			return prm.sendExtendedError(pro, err)
+		}
+		params[i] = value
+	}
+
+	numResultFormats, pos, ok := readPgUint16(payload, pos)
+	if !ok {
+		return invalid()
+	}
+	resultFormats := make([]int16, numResultFormats)
+	for i := range resultFormats {
+		var v uint16
+		if v, pos, ok = readPgUint16(payload, pos); !ok {
+			return invalid()
+		}
+		resultFormats[i] = int16(v)
+	}
+
+	pro.portals[portalName] = &pgPortal{
+		stmt:          stmt,
+		params:        params,
+		resultFormats: resultFormats,
+	}
+	return pro.sendEmptyMessage(pgMsgBindComplete)
+}
+
+func (prm *pgRoutineManager) handleDescribe(ctx context.Context, pro *pgProtocol, payload []byte) error {
+	if len(payload) < 1 {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql describe message"))
+	}
+	name, _, ok := readPgString(payload, 1)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql describe message"))
+	}
+
+	switch payload[0] {
+	case 'S':
+		stmt, ok := pro.statements[name]
+		if !ok {
+			return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "prepared statement '%s' does not exist", name))
+		}
+		if err := pro.sendParameterDescription(stmt.paramOIDs); err != nil {
+			return err
+		}
+		if len(stmt.columns) == 0 {
+			return pro.sendEmptyMessage(pgMsgNoData)
+		}
+		return pro.sendRowDescription(stmt.columns, nil)
+	case 'P':
+		portal, ok := pro.portals[name]
+		if !ok {
+			return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "portal '%s' does not exist", name))
+		}
+		if !portal.stmt.prepared() {
+			// the result of the statement is unknown until it is executed.
+			portal.describe = true
+			return nil
+		}
+		if len(portal.stmt.columns) == 0 {
+			return pro.sendEmptyMessage(pgMsgNoData)
+		}
+		return pro.sendRowDescription(portal.stmt.columns, portal.resultFormats)
+	default:
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql describe message"))
+	}
+}
+
+func (prm *pgRoutineManager) handleExecute(ctx context.Context, routine *Routine, pro *pgProtocol, payload []byte) error {
+	name, _, ok := readPgString(payload, 0)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql execute message"))
+	}
+	portal, ok := pro.portals[name]
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "portal '%s' does not exist", name))
+	}
+
+	var req *Request
+	if portal.stmt.prepared() {
+		req = &Request{cmd: COM_STMT_EXECUTE, data: binary.LittleEndian.AppendUint32(nil, portal.stmt.id)}
+		pro.describeRows = false
+	} else {
+		if strings.TrimSpace(portal.stmt.sql) == "" {
+			return pro.sendEmptyMessage(pgMsgEmptyQueryResponse)
+		}
+		req = &Request{cmd: COM_QUERY, data: []byte(portal.stmt.sql)}
+		pro.describeRows = portal.describe
+		pro.describePending = portal.describe
+	}
+	pro.portal = portal
+	pro.resultFormats = portal.resultFormats
+	defer func() {
+		pro.portal = nil
+		pro.resultFormats = nil
+		pro.describeRows = true
+		pro.describePending = false
+	}()
+
+	execErr, err := prm.execute(routine, pro, req, false)
+	if err != nil {
+		return err
+	}
+	if execErr != nil {
+		pro.skipUntilSync = true
+	}
+	return nil
+}
+
+func (prm *pgRoutineManager) handleClose(ctx context.Context, routine *Routine, pro *pgProtocol, payload []byte) error {
+	if len(payload) < 1 {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql close message"))
+	}
+	name, _, ok := readPgString(payload, 1)
+	if !ok {
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql close message"))
+	}
+
+	switch payload[0] {
+	case 'S':
+		if err := prm.closeStatement(routine, pro, name); err != nil {
+			return err
+		}
+	case 'P':
+		delete(pro.portals, name)
+	default:
+		return prm.sendExtendedError(pro, moerr.NewInvalidInput(ctx, "invalid postgresql close message"))
+	}
+	return pro.sendEmptyMessage(pgMsgCloseComplete)
+}
@@ -0,0 +1,246 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// planBaselinesTTL bounds how long the baselines created or dropped on
+// another CN take to be seen.
+const planBaselinesTTL = time.Minute
+
+const (
+	getPlanBaselinesSql       = `select digest, hints from mo_catalog.mo_plan_baselines;`
+	checkPlanBaselineFormat   = `select digest from mo_catalog.mo_plan_baselines where digest = '%s';`
+	deletePlanBaselineFormat  = `delete from mo_catalog.mo_plan_baselines where digest = '%s';`
+	insertPlanBaselinesFormat = `insert into mo_catalog.mo_plan_baselines(
+		digest,
+		sql_text,
+		hints,
+		created_time) values ('%s', '%s', '%s', now());`
+)
+
+// planBaselines holds the baselines of an account: the optimizer hints pinned
+// to the digests of its queries.
+type planBaselines struct {
+	hints    map[string]tree.OptimizerHints
+	loadTime time.Time
+}
+
+// planBaselineCache caches mo_catalog.mo_plan_baselines by account id, so that
+// it is not queried for each query.
+var planBaselineCache = struct {
+	sync.Mutex
+	accounts map[uint32]*planBaselines
+}{accounts: make(map[uint32]*planBaselines)}
+
+func getPlanBaselines(accountID uint32) *planBaselines {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	pb, ok := planBaselineCache.accounts[accountID]
+	if !ok || time.Since(pb.loadTime) > planBaselinesTTL {
+		return nil
+	}
+	return pb
+}
+
+func setPlanBaselines(accountID uint32, pb *planBaselines) {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	planBaselineCache.accounts[accountID] = pb
+}
+
+func invalidatePlanBaselines(accountID uint32) {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	delete(planBaselineCache.accounts, accountID)
+}
+
+// selectDigest returns the digest a query is pinned by: the one of its text
+// without the literals and hints, so that the baseline also applies to the
+// query run with other values or prepared, and of the database it runs in,
+// where the same text may query other tables.
+func selectDigest(db string, stmt *tree.Select) (string, error) {
+	text, err := mysql.NormalizeSQL(tree.StringWithOpts(stmt, dialect.MYSQL, tree.WithQuoteString(true)))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(db + "\x00" + text))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// applyPlanBaseline gives a query without optimizer hints the ones of the
+// baseline of its digest, if there is one.
+func applyPlanBaseline(ctx context.Context, ses *Session, stmt *tree.Select) {
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok || len(clause.Hints) > 0 {
+		return
+	}
+	accountID := ses.GetAccountId()
+	pb := getPlanBaselines(accountID)
+	if pb == nil {
+		var err error
+		if pb, err = loadPlanBaselines(ctx, ses); err != nil {
+			logError(ses, ses.GetDebugString(),
+				"load plan baselines failed",
+				zap.Error(err))
+		}
+		setPlanBaselines(accountID, pb)
+	}
+	if len(pb.hints) == 0 {
+		return
+	}
+	digest, err := selectDigest(ses.GetDatabaseName(), stmt)
+	if err != nil {
+		return
+	}
+	if hints, ok := pb.hints[digest]; ok {
+		clause.Hints = hints
+	}
+}
+
+// loadPlanBaselines reads the baselines of the account of ses. It always
+// returns a usable planBaselines, empty on error, so that a failing account
+// is not queried over and over.
+func loadPlanBaselines(ctx context.Context, ses FeSession) (*planBaselines, error) {
+	pb := &planBaselines{
+		hints:    make(map[string]tree.OptimizerHints),
+		loadTime: time.Now(),
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bats, err := execForBatches(ctx, bh, getPlanBaselinesSql)
+	if err != nil {
+		return pb, err
+	}
+	for _, bat := range bats {
+		for i := 0; i < bat.RowCount(); i++ {
+			pb.hints[bat.Vecs[0].GetStringAt(i)] = mysql.ParseOptimizerHints(bat.Vecs[1].GetStringAt(i))
+		}
+	}
+	return pb, nil
+}
+
+// doCreatePlanBaseline pins the hints of a query, or the join order the
+// optimizer picks for it now if it has none, to its digest.
+func doCreatePlanBaseline(ctx context.Context, ses *Session, stmt *tree.CreatePlanBaseline) (err error) {
+	if err = doCheckRole(ctx, ses); err != nil {
+		return err
+	}
+	clause, ok := stmt.Select.Select.(*tree.SelectClause)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "plan baseline of a query which is not a single select")
+	}
+	hints := clause.Hints
+	if len(hints) == 0 {
+		hint, err := plan2.CaptureJoinOrder(ses.GetTxnCompileCtx(), stmt.Select)
+		if err != nil {
+			return err
+		}
+		if hint == nil {
+			return moerr.NewInvalidInput(ctx, "query has neither optimizer hints nor joins to pin")
+		}
+		hints = tree.OptimizerHints{hint}
+	}
+	digest, err := selectDigest(ses.GetDatabaseName(), stmt.Select)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	// a new baseline replaces the one of the digest
+	if err = bh.Exec(ctx, fmt.Sprintf(deletePlanBaselineFormat, digest)); err != nil {
+		return err
+	}
+	sql := fmt.Sprintf(insertPlanBaselinesFormat, digest,
+		quotePlanBaselineText(tree.StringWithOpts(stmt.Select, dialect.MYSQL, tree.WithQuoteString(true))),
+		quotePlanBaselineText(tree.String(hints, dialect.MYSQL)))
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	invalidatePlanBaselines(ses.GetAccountId())
+	return nil
+}
+
+// doDropPlanBaseline drops the baseline of the digest of a query, or of the
+// digest given.
+func doDropPlanBaseline(ctx context.Context, ses *Session, stmt *tree.DropPlanBaseline) (err error) {
+	if err = doCheckRole(ctx, ses); err != nil {
+		return err
+	}
+	digest := stmt.Digest
+	if stmt.Select != nil {
+		if digest, err = selectDigest(ses.GetDatabaseName(), stmt.Select); err != nil {
+			return err
+		}
+	}
+	digest = quotePlanBaselineText(digest)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	bats, err := execForBatches(ctx, bh, fmt.Sprintf(checkPlanBaselineFormat, digest))
+	if err != nil {
+		return err
+	}
+	if len(bats) == 0 || bats[0].RowCount() == 0 {
+		if stmt.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "plan baseline %s does not exist", digest)
+	}
+	if err = bh.Exec(ctx, fmt.Sprintf(deletePlanBaselineFormat, digest)); err != nil {
+		return err
+	}
+	invalidatePlanBaselines(ses.GetAccountId())
+	return nil
+}
+
+// quotePlanBaselineText escapes s for a string literal.
+func quotePlanBaselineText(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `'`, `''`)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_applyPlanBaseline(t *testing.T) {
+	ctx := context.TODO()
+	parse := func(sql string) *tree.Select {
+		stmt, err := mysql.ParseOne(ctx, sql, 1, 0)
+		require.NoError(t, err)
+		return stmt.(*tree.Select)
+	}
+	hintsOf := func(stmt *tree.Select) string {
+		return tree.String(stmt.Select.(*tree.SelectClause).Hints, dialect.MYSQL)
+	}
+
+	// the literals and hints do not change the digest
+	digest, err := selectDigest("db1", parse("select a from t1, t2 where t1.a = t2.b and t1.c = 'x'"))
+	require.NoError(t, err)
+	other, err := selectDigest("db1", parse("SELECT /*+ DOP(2) */ a FROM t1, t2 WHERE t1.a = t2.b AND t1.c = 'y'"))
+	require.NoError(t, err)
+	require.Equal(t, digest, other)
+	other, err = selectDigest("db1", parse("select a from t1, t2 where t1.a = t2.b and t1.d = 'x'"))
+	require.NoError(t, err)
+	require.NotEqual(t, digest, other)
+	// the same text in another database may query another table
+	other, err = selectDigest("db2", parse("select a from t1, t2 where t1.a = t2.b and t1.c = 'x'"))
+	require.NoError(t, err)
+	require.NotEqual(t, digest, other)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetDatabaseName("db1")
+	ses.SetAccountId(42)
+	setPlanBaselines(42, &planBaselines{
+		hints:    map[string]tree.OptimizerHints{digest: mysql.ParseOptimizerHints("JOIN_ORDER(t2, t1)")},
+		loadTime: time.Now(),
+	})
+	defer invalidatePlanBaselines(42)
+
+	stmt := parse("select a from t1, t2 where t1.a = t2.b and t1.c = 'z'")
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "JOIN_ORDER(t2, t1)", hintsOf(stmt))
+
+	// the hints of the query win over the baseline
+	stmt = parse("select /*+ HASH_JOIN(t1) */ a from t1, t2 where t1.a = t2.b and t1.c = 'z'")
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "HASH_JOIN(t1)", hintsOf(stmt))
+
+	// the baseline of db1 does not pin the query in db2
+	ses.SetDatabaseName("db2")
+	stmt = parse("select a from t1, t2 where t1.a = t2.b and t1.c = 'z'")
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "", hintsOf(stmt))
+	ses.SetDatabaseName("db1")
+
+	stmt = parse("select a from t1 where a = 1")
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "", hintsOf(stmt))
+
+	require.Equal(t, `it''s a \\`, quotePlanBaselineText(`it's a \`))
+}
@@ -448,6 +448,14 @@ func (fp *FakeProtocol) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+func (fp *FakeProtocol) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
+func (fp *FakeProtocol) sendBinlogEvent(event []byte) error {
+	return nil
+}
+
 func (fp *FakeProtocol) GetTcpConnection() goetty.IOSession {
 	return fp.ioses
 }
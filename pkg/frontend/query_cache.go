@@ -0,0 +1,367 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/cache"
+)
+
+const (
+	// queryCacheTTL bounds how long a result is served. The logtail of
+	// a table stops once it has not been read for a while, and with it
+	// the invalidation of the results computed from the table, so the
+	// TTL is kept well under that.
+	queryCacheTTL = 10 * time.Minute
+
+	// a result takes at most 1/queryCacheEntryShare of the cache, so that
+	// a large one does not evict all the others.
+	queryCacheEntryShare = 4
+)
+
+// queryCacheSource is the engine which notifies the commits to the tables
+// the cached results are computed from.
+type queryCacheSource interface {
+	AddLogtailListener(l disttae.LogtailListener) (remove func())
+}
+
+type queryCacheEntry struct {
+	key        string
+	tables     []uint64
+	batches    [][]byte
+	size       int64
+	createTime time.Time
+	elem       *list.Element
+}
+
+// queryCache holds the results of the queries, shared by the sessions of
+// the CN. The results of a table are dropped as soon as the logtail of
+// a commit to it is received.
+type queryCache struct {
+	sync.Mutex
+	maxSize int64
+	size    int64
+	entries map[string]*queryCacheEntry
+	// byTable is the keys of the results computed from a table
+	byTable map[uint64]map[string]struct{}
+	// lastChange is the commit ts of the last logtail of a table, to tell
+	// the results read before it
+	lastChange map[uint64]timestamp.Timestamp
+	lastSweep  time.Time
+	// lru is the entries, the most recently used first
+	lru *list.List
+}
+
+func newQueryCache(maxSize int64) *queryCache {
+	return &queryCache{
+		maxSize:    maxSize,
+		entries:    make(map[string]*queryCacheEntry),
+		byTable:    make(map[uint64]map[string]struct{}),
+		lastChange: make(map[uint64]timestamp.Timestamp),
+		lastSweep:  time.Now(),
+		lru:        list.New(),
+	}
+}
+
+var globalQueryCache struct {
+	once  sync.Once
+	cache *queryCache
+}
+
+// getQueryCache returns the query cache of the CN, or nil if the storage
+// engine does not notify the commits.
+func getQueryCache() *queryCache {
+	globalQueryCache.once.Do(func() {
+		pu := getGlobalPu()
+		source, ok := pu.StorageEngine.(queryCacheSource)
+		if !ok {
+			return
+		}
+		qc := newQueryCache(int64(pu.SV.QueryCacheSize) << 20)
+		source.AddLogtailListener(qc.onLogtail)
+		globalQueryCache.cache = qc
+	})
+	return globalQueryCache.cache
+}
+
+// onLogtail drops the results computed from the table of the logtail.
+func (qc *queryCache) onLogtail(_ *cache.TableItem, tail *logtail.TableLogtail) {
+	if len(tail.Commands) == 0 {
+		return
+	}
+	tableID := tail.Table.GetTbId()
+	qc.Lock()
+	defer qc.Unlock()
+	if ts := tail.GetTs(); ts != nil && ts.Greater(qc.lastChange[tableID]) {
+		qc.lastChange[tableID] = *ts
+	}
+	for key := range qc.byTable[tableID] {
+		qc.removeLocked(qc.entries[key])
+	}
+}
+
+// get returns the result of key, or nil if it is not cached.
+func (qc *queryCache) get(key string) [][]byte {
+	qc.Lock()
+	defer qc.Unlock()
+	e, ok := qc.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Since(e.createTime) > queryCacheTTL {
+		qc.removeLocked(e)
+		return nil
+	}
+	qc.lru.MoveToFront(e.elem)
+	return e.batches
+}
+
+// put caches the result of key, computed from the tables at the snapshot
+// ts, unless one of the tables has changed since.
+func (qc *queryCache) put(key string, tables []uint64, snapshot timestamp.Timestamp, batches [][]byte, size int64) {
+	qc.Lock()
+	defer qc.Unlock()
+	qc.sweepLocked()
+	if size > qc.maxSize/queryCacheEntryShare ||
+		time.Since(snapshot.ToStdTime()) > queryCacheTTL {
+		return
+	}
+	for _, t := range tables {
+		if ts, ok := qc.lastChange[t]; ok && ts.GreaterEq(snapshot) {
+			return
+		}
+	}
+
+	if e, ok := qc.entries[key]; ok {
+		qc.removeLocked(e)
+	}
+	if batches == nil {
+		// an empty result is cached too
+		batches = [][]byte{}
+	}
+	e := &queryCacheEntry{
+		key:        key,
+		tables:     tables,
+		batches:    batches,
+		size:       size,
+		createTime: time.Now(),
+	}
+	e.elem = qc.lru.PushFront(e)
+	qc.entries[key] = e
+	for _, t := range tables {
+		keys, ok := qc.byTable[t]
+		if !ok {
+			keys = make(map[string]struct{})
+			qc.byTable[t] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	qc.size += size
+	for qc.size > qc.maxSize {
+		qc.removeLocked(qc.lru.Back().Value.(*queryCacheEntry))
+	}
+}
+
+func (qc *queryCache) removeLocked(e *queryCacheEntry) {
+	qc.lru.Remove(e.elem)
+	delete(qc.entries, e.key)
+	for _, t := range e.tables {
+		delete(qc.byTable[t], e.key)
+		if len(qc.byTable[t]) == 0 {
+			delete(qc.byTable, t)
+		}
+	}
+	qc.size -= e.size
+}
+
+// sweepLocked forgets the changes of the tables older than the results
+// which may still be put.
+func (qc *queryCache) sweepLocked() {
+	if time.Since(qc.lastSweep) < queryCacheTTL {
+		return
+	}
+	qc.lastSweep = time.Now()
+	for t, ts := range qc.lastChange {
+		if time.Since(ts.ToStdTime()) > queryCacheTTL {
+			delete(qc.lastChange, t)
+		}
+	}
+}
+
+// queryCacheWriter collects the result of a query to save it to the cache.
+type queryCacheWriter struct {
+	qc       *queryCache
+	key      string
+	tables   []uint64
+	snapshot timestamp.Timestamp
+	batches  [][]byte
+	size     int64
+	// overflow is set once the result is too large to be cached
+	overflow bool
+}
+
+func (w *queryCacheWriter) add(bat *batch.Batch) {
+	if w.overflow {
+		return
+	}
+	data, err := bat.MarshalBinary()
+	if err == nil {
+		w.batches = append(w.batches, data)
+		w.size += int64(len(data))
+	}
+	if err != nil || w.size > w.qc.maxSize/queryCacheEntryShare {
+		w.overflow = true
+		w.batches = nil
+	}
+}
+
+func (w *queryCacheWriter) save() {
+	if !w.overflow {
+		w.qc.put(w.key, w.tables, w.snapshot, w.batches, w.size)
+	}
+}
+
+// prepareQueryCache returns the cached result of the select of execCtx, or
+// else the writer to cache its result with. It returns neither if the query
+// cache is off for it or its result cannot be cached.
+func prepareQueryCache(ses *Session, execCtx *ExecCtx, stmt *tree.Select) ([][]byte, *queryCacheWriter) {
+	if !queryCacheEnabled(ses, stmt) {
+		return nil, nil
+	}
+	if stmt.Ep != nil || stmt.SelectLockInfo != nil ||
+		ses.GetTenantInfo() == nil || ses.IsBackgroundSession() ||
+		openSaveQueryResult(ses) ||
+		ses.GetTxnHandler().InMultiStmtTransactionMode() {
+		return nil, nil
+	}
+	cw, ok := execCtx.cw.(*TxnComputationWrapper)
+	if !ok || cw.plan.GetQuery() == nil {
+		return nil, nil
+	}
+	tables, ok := plan2.ResultCacheTables(cw.plan.GetQuery())
+	if !ok {
+		return nil, nil
+	}
+	for _, db := range tables {
+		if _, isSysDb := sysDatabases[db]; isSysDb {
+			return nil, nil
+		}
+	}
+	_, txnOp, err := ses.GetTxnHandler().GetTxnOperator()
+	if err != nil || txnOp == nil {
+		return nil, nil
+	}
+	qc := getQueryCache()
+	if qc == nil {
+		return nil, nil
+	}
+
+	tableIDs := make([]uint64, 0, len(tables))
+	for t := range tables {
+		tableIDs = append(tableIDs, t)
+	}
+	sort.Slice(tableIDs, func(i, j int) bool { return tableIDs[i] < tableIDs[j] })
+	key := queryCacheKey(ses, stmt, tableIDs, cw.paramVals)
+	if cached := qc.get(key); cached != nil {
+		return cached, nil
+	}
+	return nil, &queryCacheWriter{
+		qc:       qc,
+		key:      key,
+		tables:   tableIDs,
+		snapshot: txnOp.SnapshotTS(),
+	}
+}
+
+// queryCacheEnabled tells whether query_cache_type and the SQL_CACHE or
+// SQL_NO_CACHE option of stmt let its result be cached.
+func queryCacheEnabled(ses *Session, stmt *tree.Select) bool {
+	val, err := ses.GetSessionVar("query_cache_type")
+	if err != nil {
+		return false
+	}
+	var option string
+	if clause, ok := stmt.Select.(*tree.SelectClause); ok {
+		option = clause.Option
+	}
+	mode, _ := val.(string)
+	switch strings.ToUpper(mode) {
+	case "ON":
+		return option != "sql_no_cache"
+	case "DEMAND":
+		return option == "sql_cache"
+	}
+	return false
+}
+
+// queryCacheSessionVars are the variables the result of a query depends on,
+// the way the values are computed, converted or sent.
+var queryCacheSessionVars = []string{
+	"time_zone",
+	"sql_mode",
+	"div_precision_increment",
+	"character_set_results",
+	"lower_case_table_names",
+}
+
+// queryCacheKey identifies the result of stmt: the same statement with the
+// same parameters, run by the same account and role in the same database on
+// the same tables, with the same queryCacheSessionVars. The ids of the
+// tables tell a table from the one replacing it after a drop or truncate.
+func queryCacheKey(ses *Session, stmt *tree.Select, tables []uint64, params []any) string {
+	h := sha256.New()
+	tenant := ses.GetTenantInfo()
+	fmt.Fprintf(h, "%d/%d/%s/%s/%v",
+		tenant.GetTenantID(), tenant.GetDefaultRoleID(), ses.GetDatabaseName(),
+		tree.StringWithOpts(stmt, dialect.MYSQL, tree.WithQuoteString(true)), tables)
+	for _, name := range queryCacheSessionVars {
+		val, _ := ses.GetSessionVar(name)
+		fmt.Fprintf(h, "/%s=%v", name, val)
+	}
+	for _, p := range params {
+		fmt.Fprintf(h, "/%T:%v", p, p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sendCachedResult sends a result of the query cache to the client.
+func sendCachedResult(ses *Session, batches [][]byte) error {
+	output := ses.GetOutputCallback()
+	for _, data := range batches {
+		bat := batch.NewWithSize(0)
+		if err := bat.UnmarshalBinary(data); err != nil {
+			return err
+		}
+		if err := output(bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+)
+
+func Test_queryCache(t *testing.T) {
+	now := func() timestamp.Timestamp {
+		return timestamp.Timestamp{PhysicalTime: time.Now().UnixNano()}
+	}
+	commit := func(qc *queryCache, tableID uint64, ts timestamp.Timestamp) {
+		qc.onLogtail(nil, &logtail.TableLogtail{
+			Ts:       &ts,
+			Table:    &api.TableID{TbId: tableID},
+			Commands: []api.Entry{{TableId: tableID}},
+		})
+	}
+
+	qc := newQueryCache(100)
+	snapshot := now()
+	qc.put("a", []uint64{1, 2}, snapshot, [][]byte{[]byte("a")}, 10)
+	qc.put("b", []uint64{2}, snapshot, nil, 10)
+	require.Equal(t, [][]byte{[]byte("a")}, qc.get("a"))
+	require.NotNil(t, qc.get("b"))
+	require.Nil(t, qc.get("c"))
+
+	// a commit to a table drops the results computed from it
+	commit(qc, 1, now())
+	require.Nil(t, qc.get("a"))
+	require.NotNil(t, qc.get("b"))
+	commit(qc, 2, now())
+	require.Nil(t, qc.get("b"))
+	require.Zero(t, qc.size)
+
+	// nor is a result read before the last commit cached
+	qc.put("a", []uint64{1}, snapshot, [][]byte{[]byte("a")}, 10)
+	require.Nil(t, qc.get("a"))
+	qc.put("a", []uint64{1}, now(), [][]byte{[]byte("a")}, 10)
+	require.NotNil(t, qc.get("a"))
+
+	// the least recently used results are evicted beyond the size
+	qc.put("b", []uint64{3}, now(), [][]byte{[]byte("b")}, 20)
+	qc.put("c", []uint64{3}, now(), [][]byte{[]byte("c")}, 25)
+	require.NotNil(t, qc.get("a"))
+	qc.put("d", []uint64{3}, now(), [][]byte{[]byte("d")}, 25)
+	qc.put("e", []uint64{3}, now(), [][]byte{[]byte("e")}, 25)
+	require.Nil(t, qc.get("b"))
+	require.NotNil(t, qc.get("a"))
+	require.LessOrEqual(t, qc.size, int64(100))
+
+	// too large a result is not cached
+	qc.put("f", []uint64{3}, now(), [][]byte{[]byte("f")}, 30)
+	require.Nil(t, qc.get("f"))
+
+	// nor is a result served after the TTL
+	qc.entries["a"].createTime = time.Now().Add(-queryCacheTTL - time.Second)
+	require.Nil(t, qc.get("a"))
+}
+
+func Test_queryCacheWriter(t *testing.T) {
+	mp := testutil.TestUtilMp
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	for i := int64(0); i < 3; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], i, false, mp))
+	}
+	bat.SetRowCount(3)
+	defer bat.Clean(mp)
+
+	qc := newQueryCache(1 << 20)
+	w := &queryCacheWriter{qc: qc, key: "k", tables: []uint64{1}, snapshot: timestamp.Timestamp{PhysicalTime: time.Now().UnixNano()}}
+	w.add(bat)
+	w.add(bat)
+	w.save()
+
+	cached := qc.get("k")
+	require.Len(t, cached, 2)
+	got := batch.NewWithSize(0)
+	require.NoError(t, got.UnmarshalBinary(cached[1]))
+	require.Equal(t, 3, got.RowCount())
+	require.Equal(t, []int64{0, 1, 2}, vector.MustFixedCol[int64](got.Vecs[0]))
+
+	// a result larger than its share of the cache is dropped
+	w = &queryCacheWriter{qc: newQueryCache(16), key: "k"}
+	w.add(bat)
+	require.True(t, w.overflow)
+	require.Nil(t, w.batches)
+}
+
+func Test_queryCacheEnabled(t *testing.T) {
+	parse := func(sql string) *tree.Select {
+		stmt, err := mysql.ParseOne(context.TODO(), sql, 1, 0)
+		require.NoError(t, err)
+		return stmt.(*tree.Select)
+	}
+	ses := &Session{
+		feSessionImpl: feSessionImpl{gSysVars: GSysVariables},
+		sysVars:       map[string]interface{}{},
+	}
+
+	cases := []struct {
+		mode string
+		sql  string
+		want bool
+	}{
+		{"OFF", "select sql_cache a from t1", false},
+		{"ON", "select a from t1", true},
+		{"ON", "select sql_no_cache a from t1", false},
+		{"DEMAND", "select a from t1", false},
+		{"DEMAND", "select sql_cache a from t1", true},
+	}
+	for _, c := range cases {
+		ses.sysVars["query_cache_type"] = c.mode
+		require.Equal(t, c.want, queryCacheEnabled(ses, parse(c.sql)), c)
+	}
+}
+
+func Test_queryCacheKey(t *testing.T) {
+	stmt, err := mysql.ParseOne(context.TODO(), "select date_format(ts, '%Y') from t1", 1, 0)
+	require.NoError(t, err)
+	sel := stmt.(*tree.Select)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, TenantID: sysAccountID})
+	ses.SetDatabaseName("db1")
+
+	key := queryCacheKey(ses, sel, []uint64{1}, nil)
+	require.Equal(t, key, queryCacheKey(ses, sel, []uint64{1}, nil))
+
+	// the sessions with the variables changing the result do not share it
+	for _, c := range []struct {
+		name string
+		val  interface{}
+	}{
+		{"time_zone", "+08:00"},
+		{"sql_mode", "ANSI_QUOTES"},
+		{"div_precision_increment", int64(8)},
+		{"character_set_results", "latin1"},
+	} {
+		old, err := ses.GetSessionVar(c.name)
+		require.NoError(t, err)
+		ses.SetSysVar(c.name, c.val)
+		require.NotEqual(t, key, queryCacheKey(ses, sel, []uint64{1}, nil), c.name)
+		ses.SetSysVar(c.name, old)
+		require.Equal(t, key, queryCacheKey(ses, sel, []uint64{1}, nil), c.name)
+	}
+}
@@ -80,15 +80,27 @@ func executeResultRowStmt(requestCtx context.Context, ses *Session, execCtx *Exe
 			return
 		}
 
+		cached, writer := prepareQueryCache(ses, execCtx, statement)
+		if cached != nil {
+			err = sendCachedResult(ses, cached)
+			return
+		}
+
 		runBegin := time.Now()
 		/*
 			Step 2: Start pipeline
 			Producing the data row and sending the data row
 		*/
 		// todo: add trace
-		if _, err = execCtx.runner.Run(0); err != nil {
+		ses.queryCacheWriter = writer
+		_, err = execCtx.runner.Run(0)
+		ses.queryCacheWriter = nil
+		if err != nil {
 			return
 		}
+		if writer != nil {
+			writer.save()
+		}
 
 		// only log if run time is longer than 1s
 		if time.Since(runBegin) > time.Second {
@@ -346,6 +346,16 @@ func execInFrontend(requestCtx context.Context,
 		if err = handleShowBackendServers(requestCtx, ses, execCtx.isLastStmt); err != nil {
 			return
 		}
+	case *tree.ShowMasterStatus:
+
+		if err = handleShowMasterStatus(ses); err != nil {
+			return
+		}
+	case *tree.ShowBinaryLogs:
+
+		if err = handleShowBinaryLogs(ses); err != nil {
+			return
+		}
 	case *tree.SetTransaction:
 
 		//TODO: handle set transaction
@@ -373,6 +383,14 @@ func execInFrontend(requestCtx context.Context,
 		if err = handleDropSnapshot(requestCtx, ses, st); err != nil {
 			return
 		}
+	case *tree.CreatePlanBaseline:
+		if err = handleCreatePlanBaseline(requestCtx, ses, st); err != nil {
+			return
+		}
+	case *tree.DropPlanBaseline:
+		if err = handleDropPlanBaseline(requestCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.UpgradeStatement:
 		//TODO: invalidate privilege cache
 		if err = handleExecUpgrade(requestCtx, ses, st); err != nil {
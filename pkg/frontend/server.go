@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"strings"
 	"sync/atomic"
@@ -44,6 +45,11 @@ type MOServer struct {
 	app         goetty.NetApplication
 	rm          *RoutineManager
 	readTimeout time.Duration
+
+	// pgAddr is the address the postgresql clients connect to.
+	pgAddr string
+	// pgApp serves the postgresql clients. It is nil if the pg-port is 0.
+	pgApp goetty.NetApplication
 }
 
 // BaseService is an interface which indicates that the instance is
@@ -69,10 +75,22 @@ func (mo *MOServer) GetRoutineManager() *RoutineManager {
 
 func (mo *MOServer) Start() error {
 	logutil.Infof("Server Listening on : %s ", mo.addr)
-	return mo.app.Start()
+	if err := mo.app.Start(); err != nil {
+		return err
+	}
+	if mo.pgApp != nil {
+		logutil.Infof("Server Listening on : %s for postgresql clients", mo.pgAddr)
+		return mo.pgApp.Start()
+	}
+	return nil
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -127,7 +145,9 @@ func NewMOServer(
 		addresses,
 		rm.Handler,
 		goetty.WithAppLogger(logutil.GetGlobalLogger()),
-		goetty.WithAppHandleSessionFunc(mo.handleMessage),
+		goetty.WithAppHandleSessionFunc(func(rs goetty.IOSession) error {
+			return mo.handleMessage(rs, mo.rm.Handler)
+		}),
 		goetty.WithAppSessionOptions(
 			goetty.WithSessionCodec(codec),
 			goetty.WithSessionLogger(logutil.GetGlobalLogger()),
@@ -139,6 +159,27 @@ func NewMOServer(
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
+	if pu.SV.PgPort != 0 {
+		prm := newPgRoutineManager(rm)
+		mo.pgAddr = fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort)
+		mo.pgApp, err = goetty.NewApplicationWithListenAddress(
+			[]string{mo.pgAddr},
+			prm.Handler,
+			goetty.WithAppLogger(logutil.GetGlobalLogger()),
+			goetty.WithAppHandleSessionFunc(func(rs goetty.IOSession) error {
+				return mo.handleMessage(rs, prm.Handler)
+			}),
+			goetty.WithAppSessionOptions(
+				goetty.WithSessionCodec(NewPgCodec()),
+				goetty.WithSessionLogger(logutil.GetGlobalLogger()),
+				goetty.WithSessionRWBUfferSize(DefaultRpcBufferSize, DefaultRpcBufferSize),
+				goetty.WithSessionAllocator(NewSessionAllocator(pu))),
+			goetty.WithAppSessionAware(prm),
+			goetty.WithReadTimeout(pu.SV.SessionTimeout.Duration))
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+	}
 	err = initVarByConfig(ctx, pu)
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
@@ -147,8 +188,8 @@ func NewMOServer(
 	return mo
 }
 
-// handleMessage receives the message from the client and executes it
-func (mo *MOServer) handleMessage(rs goetty.IOSession) error {
+// handleMessage receives the message from the client and executes it by the handler
+func (mo *MOServer) handleMessage(rs goetty.IOSession, handler func(goetty.IOSession, interface{}, uint64) error) error {
 	received := uint64(0)
 	option := goetty.ReadOptions{Timeout: mo.readTimeout}
 	for {
@@ -165,7 +206,7 @@ func (mo *MOServer) handleMessage(rs goetty.IOSession) error {
 
 		received++
 
-		err = mo.rm.Handler(rs, msg, received)
+		err = handler(rs, msg, received)
 		if err != nil {
 			if skipClientQuit(err.Error()) {
 				return nil
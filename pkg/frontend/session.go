@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -32,11 +34,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/query"
 	"github.com/matrixorigin/matrixone/pkg/pb/status"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -56,6 +60,10 @@ var MaxPrepareNumberInOneSession int = 100000
 // TODO: this variable should be configure by set variable
 const MoDefaultErrorCount = 64
 
+// tempTableSpillDir is the directory under os.TempDir() holding the rows of
+// temporary tables spilled from memory, one sub directory per session.
+const tempTableSpillDir = "mo-temp-tables"
+
 type ShowStatementType int
 
 const (
@@ -237,6 +245,18 @@ type Session struct {
 	proxyAddr  string
 
 	disableTrace bool
+
+	// dialectType is the sql dialect of the client. The sql from the
+	// postgresql clients is parsed with the postgresql dialect.
+	dialectType dialect.DialectType
+
+	// queryMemoryBase is the bytes in use of the session pool when the
+	// running query started.
+	queryMemoryBase atomic.Int64
+
+	// queryCacheWriter collects the result of the select running to save it
+	// to the query cache.
+	queryCacheWriter *queryCacheWriter
 }
 
 func (ses *Session) SendRows() int64 {
@@ -575,7 +595,7 @@ func (ses *Session) Close() {
 	ses.errInfo = nil
 	ses.cache = nil
 	ses.debugStr = ""
-	ses.tempTablestorage = nil
+	ses.closeTempTableStorage()
 	ses.tStmt = nil
 	ses.ast = nil
 	ses.rs = nil
@@ -622,6 +642,51 @@ func (ses *Session) Clear() {
 	ses.feSessionImpl.Clear()
 }
 
+// ResetSession brings the session back to the state of a newly established
+// connection without closing the network connection. It is used by
+// COM_RESET_CONNECTION and COM_CHANGE_USER, which are sent by the connection
+// pools to recycle the connections.
+//
+// The active transaction is rolled back. The session variables, the user defined
+// variables, the prepared statements, the temporary tables and the cached plans
+// are all discarded.
+func (ses *Session) ResetSession() error {
+	txnHandler := ses.GetTxnHandler()
+	err := txnHandler.TxnRollback()
+	txnHandler.ClearOptionBits(OPTION_BEGIN | OPTION_NOT_AUTOCOMMIT)
+	txnHandler.SetOptionBits(OPTION_AUTOCOMMIT)
+	txnHandler.SetServerStatus(SERVER_STATUS_AUTOCOMMIT)
+	if err != nil {
+		return err
+	}
+
+	ses.mu.Lock()
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	ses.userDefinedVars = make(map[string]*UserDefinedVar)
+	for _, stmt := range ses.prepareStmts {
+		stmt.Close()
+	}
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.lastStmtId = 0
+	ses.lastInsertID = 0
+	ses.seqCurValues = make(map[uint64]string)
+	ses.seqLastValue = new(string)
+	ses.planCache.clean()
+	ses.InitTempEngine = false
+	ses.closeTempTableStorage()
+	if ee, ok := ses.storage.(*engine.EntireEngine); ok {
+		ee.TempEngine = nil
+	}
+	ses.mu.Unlock()
+	txnHandler.SetTempEngine(nil)
+
+	ses.statsCache = plan2.NewStatsCache()
+	ses.InvalidatePrivilegeCache()
+	ses.ClearExportParam()
+	ses.Clear()
+	return nil
+}
+
 func (ses *Session) GetIncBlockIdx() int {
 	ses.blockIdx++
 	return ses.blockIdx
@@ -748,10 +813,20 @@ func (ses *Session) SetTempTableStorage(ck clock.Clock) (*metadata.TNService, er
 		Shards:            shards,
 	}
 
-	ms, err := memorystorage.NewMemoryStorage(
+	// rows beyond tmp_table_size are spilled to a session private directory,
+	// which is removed when the session is closed.
+	memLimit := int64(16777216)
+	if v, err := ses.GetSessionVar("tmp_table_size"); err == nil {
+		if size, ok := v.(int64); ok {
+			memLimit = size
+		}
+	}
+	ms, err := memorystorage.NewSpillableMemoryStorage(
 		mpool.MustNewZeroNoFixed(),
 		ck,
 		memoryengine.RandomIDGenerator,
+		filepath.Join(os.TempDir(), tempTableSpillDir, uid.String()),
+		memLimit,
 	)
 	if err != nil {
 		return nil, err
@@ -760,6 +835,18 @@ func (ses *Session) SetTempTableStorage(ck clock.Clock) (*metadata.TNService, er
 	return &tnStore, nil
 }
 
+// closeTempTableStorage releases the storage of the temporary tables,
+// including the spilled rows on local disk.
+func (ses *Session) closeTempTableStorage() {
+	if ses.tempTablestorage == nil {
+		return
+	}
+	if err := ses.tempTablestorage.Close(context.Background()); err != nil {
+		logutil.Errorf("close temporary table storage failed: %v", err)
+	}
+	ses.tempTablestorage = nil
+}
+
 func (ses *Session) GetPrivilegeCache() *privilegeCache {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1697,6 +1784,22 @@ func (ses *Session) GetFromRealUser() bool {
 	return ses.fromRealUser
 }
 
+func (ses *Session) SetDialectType(dt dialect.DialectType) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.dialectType = dt
+}
+
+// GetDialectType returns the sql dialect of the session. It is mysql by default.
+func (ses *Session) GetDialectType() dialect.DialectType {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.dialectType == dialect.INVALID {
+		return dialect.MYSQL
+	}
+	return ses.dialectType
+}
+
 func changeVersion(ctx context.Context, ses *Session, db string) error {
 	var err error
 	if _, ok := bannedCatalogDatabases[db]; ok {
@@ -1847,7 +1950,21 @@ func (ses *Session) StatusSession() *status.Session {
 		Role:          roleName,
 		FromProxy:     ses.fromProxy,
 		ProxyHost:     ses.proxyAddr,
+		QueryMemory:   ses.getQueryMemory(),
+	}
+}
+
+// getQueryMemory returns the bytes the running query holds in the session
+// pool and in its pipelines on the other CNs, 0 if there is no query running.
+func (ses *Session) getQueryMemory() uint64 {
+	if !ses.GetQueryInProgress() || ses.pool == nil {
+		return 0
 	}
+	used := ses.pool.CurrNB() - ses.queryMemoryBase.Load() + ses.pool.RemoteQueryMemory()
+	if used > 0 {
+		return uint64(used)
+	}
+	return 0
 }
 
 func uuid2Str(uid uuid.UUID) string {
@@ -732,6 +732,60 @@ func TestSetTempTableStorage(t *testing.T) {
 	assert.Equal(t, defines.TEMPORARY_TABLE_TN_ADDR, tnStore.TxnServiceAddress)
 }
 
+func TestSession_ResetSession(t *testing.T) {
+	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		eng := mock_frontend.NewMockEngine(ctrl)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		setGlobalPu(config.NewParameterUnit(sv, eng, txnClient, nil))
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		session := NewSession(proto, nil, gSysVars, true, nil)
+		session.SetRequestContext(context.Background())
+		session.SetConnectContext(context.Background())
+		return session
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	gSysVars := &GlobalSystemVariables{}
+	InitGlobalSystemVariables(gSysVars)
+
+	ses := genSession(ctrl, gSysVars)
+	assert.NoError(t, ses.SetUserDefinedVar("a", 1, "set @a = 1"))
+	assert.NoError(t, ses.SetSessionVar("autocommit", 0))
+	assert.NoError(t, ses.SetPrepareStmt("stmt1", &PrepareStmt{Name: "stmt1"}))
+	ses.SetLastInsertID(10)
+	ck := clock.NewHLCClock(func() int64 {
+		return time.Now().Unix()
+	}, math.MaxInt)
+	_, err := ses.SetTempTableStorage(ck)
+	assert.NoError(t, err)
+	ses.EnableInitTempEngine()
+
+	assert.NoError(t, ses.ResetSession())
+
+	_, udv, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, udv)
+	autocommit, err := ses.GetSessionVar("autocommit")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), autocommit)
+	assert.Empty(t, ses.GetPrepareStmts())
+	assert.Equal(t, uint64(0), ses.GetLastInsertID())
+	assert.False(t, ses.IfInitedTempEngine())
+	assert.True(t, ses.GetTxnHandler().OptionBitsIsSet(OPTION_AUTOCOMMIT))
+	assert.False(t, ses.GetTxnHandler().InActiveTransaction())
+}
+
 func Test_doSelectGlobalSystemVariable(t *testing.T) {
 	convey.Convey("select global system variable fail", t, func() {
 		ctrl := gomock.NewController(t)
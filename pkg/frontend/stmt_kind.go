@@ -172,6 +172,8 @@ func statementCanBeExecutedInUncommittedTransaction(ses FeSession, stmt tree.Sta
 		*tree.ShowSubscriptions,
 		*tree.ShowCreatePublications,
 		*tree.ShowBackendServers,
+		*tree.ShowMasterStatus,
+		*tree.ShowBinaryLogs,
 		*tree.ShowAccountUpgrade,
 		*tree.ShowConnectors:
 		return true, nil
@@ -1403,6 +1403,27 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("query_result_maxsize", 0, 18446744073709551615),
 		Default:           uint64(100),
 	},
+	// the bytes a query may allocate, net of its frees, before it is
+	// cancelled. 0 for no limit.
+	"max_query_memory": {
+		Name:              "max_query_memory",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("max_query_memory", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
+	// whether the results of the queries are served from and saved to the
+	// query cache shared by the sessions: ON for all but SQL_NO_CACHE ones,
+	// DEMAND for SQL_CACHE ones only.
+	"query_cache_type": {
+		Name:              "query_cache_type",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("query_cache_type", "OFF", "ON", "DEMAND"),
+		Default:           "OFF",
+	},
 	//whether TN does primary key uniqueness check against transaction's workspace or not.
 	"mo_pk_check_by_dn": {
 		Name:              "mo_pk_check_by_dn",
@@ -3532,6 +3553,70 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("gtid_purged"),
 		Default:           "",
 	},
+	"log_bin": {
+		Name:              "log_bin",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("log_bin"),
+		Default:           int64(1),
+	},
+	"binlog_format": {
+		Name:              "binlog_format",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("binlog_format", "ROW"),
+		Default:           "ROW",
+	},
+	"binlog_row_image": {
+		Name:              "binlog_row_image",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("binlog_row_image", "MINIMAL"),
+		Default:           "MINIMAL",
+	},
+	"binlog_checksum": {
+		Name:              "binlog_checksum",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("binlog_checksum", "NONE"),
+		Default:           "NONE",
+	},
+	"gtid_mode": {
+		Name:              "gtid_mode",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("gtid_mode", "OFF", "OFF_PERMISSIVE", "ON_PERMISSIVE", "ON"),
+		Default:           "ON",
+	},
+	"enforce_gtid_consistency": {
+		Name:              "enforce_gtid_consistency",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("enforce_gtid_consistency", "OFF", "ON", "WARN"),
+		Default:           "ON",
+	},
+	"server_id": {
+		Name:              "server_id",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("server_id", 0, 4294967295, false),
+		Default:           int64(binlogServerID),
+	},
+	"server_uuid": {
+		Name:              "server_uuid",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("server_uuid"),
+		Default:           binlogServerUUID,
+	},
 	"transaction_operator_open_log": {
 		Name:              "transaction_operator_open_log",
 		Scope:             ScopeSession,
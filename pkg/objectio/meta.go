@@ -305,6 +305,92 @@ func (bf BloomFilter) GetObjectBloomFilter() []byte {
 	return bf.GetBloomFilter(bf.BlockCount())
 }
 
+// GetColumnBloomFilter returns the bloom filter of the column seqnum in the
+// block BlockID, or nil if the column has no bloom filter in the object.
+func (bf BloomFilter) GetColumnBloomFilter(BlockID uint32, seqnum uint16) []byte {
+	if len(bf) < blockCountLen {
+		return nil
+	}
+	cnt := bf.BlockCount()
+	if cnt < 2 {
+		return nil
+	}
+	area := bf.GetBloomFilter(cnt - 1)
+	if len(area) < columnBFMagicLen+blockCountLen ||
+		types.DecodeUint32(area[:columnBFMagicLen]) != columnBFMagic {
+		return nil
+	}
+	index := BlockIndex(area[columnBFMagicLen:])
+	// data blocks + object bloom filter + column bloom filters
+	if index.BlockCount() != cnt-2 || BlockID >= index.BlockCount() {
+		return nil
+	}
+	offset, length := index.BlockMetaPos(BlockID)
+	entries := area[offset : offset+length]
+	for len(entries) >= columnBFHeaderLen {
+		colSeqnum := types.DecodeUint16(entries[:2])
+		n := types.DecodeUint32(entries[2:columnBFHeaderLen])
+		entries = entries[columnBFHeaderLen:]
+		if colSeqnum == seqnum {
+			return entries[:n]
+		}
+		entries = entries[n:]
+	}
+	return nil
+}
+
+const (
+	columnBFMagic     = uint32(0x4643424d) // "MBCF"
+	columnBFMagicLen  = 4
+	columnBFHeaderLen = 2 + 4
+)
+
+type columnBloomFilter struct {
+	seqnum uint16
+	data   []byte
+}
+
+// encodeColumnBloomFilters encodes the column bloom filters of the blocks as
+// magic | block index | [seqnum | length | data]... for each block. It
+// returns nil if no block has column bloom filters.
+func encodeColumnBloomFilters(blocks []blockData) []byte {
+	found := false
+	for _, block := range blocks {
+		if len(block.columnBFs) > 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+	var buf bytes.Buffer
+	magic := columnBFMagic
+	buf.Write(types.EncodeUint32(&magic))
+	index := BuildBlockIndex(uint32(len(blocks)))
+	index.SetBlockCount(uint32(len(blocks)))
+	start := columnBFMagicLen + index.Length()
+	for i, block := range blocks {
+		n := uint32(0)
+		for _, cbf := range block.columnBFs {
+			n += columnBFHeaderLen + uint32(len(cbf.data))
+		}
+		index.SetBlockMetaPos(uint32(i), start, n)
+		start += n
+	}
+	buf.Write(index)
+	for _, block := range blocks {
+		for _, cbf := range block.columnBFs {
+			seqnum := cbf.seqnum
+			length := uint32(len(cbf.data))
+			buf.Write(types.EncodeUint16(&seqnum))
+			buf.Write(types.EncodeUint32(&length))
+			buf.Write(cbf.data)
+		}
+	}
+	return buf.Bytes()
+}
+
 type ZoneMapArea []byte
 
 func (zma ZoneMapArea) BlockCount() uint32 {
@@ -0,0 +1,285 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// ThrottleFunc is called with the number of bytes before they are read,
+// it blocks to limit the read rate
+type ThrottleFunc = func(ctx context.Context, bytes int64) error
+
+// VerifyObject reads every extent of the object bypassing the caches, and checks
+// the object header, the object meta (v1 to v3), bloom filters, zone map areas
+// and column data against each other and against the object stats.
+// Block checksums of the file service, if any, are verified by the reads.
+//
+// A moerr.ErrDataCorrupted error is returned if the object is corrupted or missing,
+// other errors are returned as they are. throttle can be nil.
+func VerifyObject(
+	ctx context.Context,
+	fs fileservice.FileService,
+	stats *ObjectStats,
+	throttle ThrottleFunc,
+) (err error) {
+	v := &objectVerifier{
+		ctx:      ctx,
+		fs:       fs,
+		name:     stats.ObjectName().String(),
+		stats:    stats,
+		throttle: throttle,
+	}
+	defer func() {
+		// decoding corrupted meta may go out of bounds
+		if r := recover(); r != nil {
+			err = v.corrupted("%v", r)
+		}
+	}()
+	return v.verify()
+}
+
+type objectVerifier struct {
+	ctx      context.Context
+	fs       fileservice.FileService
+	name     string
+	stats    *ObjectStats
+	throttle ThrottleFunc
+}
+
+func (v *objectVerifier) corrupted(format string, args ...any) error {
+	return moerr.NewDataCorrupted(v.ctx, fmt.Sprintf("object %s: %s", v.name, fmt.Sprintf(format, args...)))
+}
+
+func (v *objectVerifier) verify() error {
+	entry, err := v.fs.StatFile(v.ctx, v.name)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return v.corrupted("missing")
+	} else if err != nil {
+		return err
+	}
+	if size := v.stats.Size(); size > 0 && entry.Size != int64(size) {
+		return v.corrupted("size %d, expected %d", entry.Size, size)
+	}
+
+	bufs, err := v.read(NewExtent(compress.None, 0, HeaderSize, HeaderSize))
+	if err != nil {
+		return err
+	}
+	header := Header(bufs[0])
+	if magic := types.DecodeUint64(header[:8]); magic != Magic {
+		return v.corrupted("bad magic %x", magic)
+	}
+	if version := types.DecodeUint16(header[8:10]); version != Version {
+		return v.corrupted("bad version %d", version)
+	}
+	metaExtent := header.Extent()
+	if expected := v.stats.Extent(); expected.Length() > 0 && !bytes.Equal(metaExtent, expected) {
+		return v.corrupted("meta extent %s, expected %s", metaExtent.String(), expected.String())
+	}
+
+	if bufs, err = v.read(metaExtent); err != nil {
+		return err
+	}
+	h, err := v.checkIOEntry(bufs[0], IOET_ObjMeta)
+	if err != nil {
+		return err
+	}
+	obj, err := Decode(bufs[0])
+	if err != nil {
+		return v.corrupted("decode meta: %v", err)
+	}
+	meta := obj.(ObjectMeta)
+
+	var dataMetas []ObjectDataMeta
+	if h.Version == IOET_ObjectMeta_V1 {
+		dataMetas = append(dataMetas, meta.MustDataMeta())
+	} else {
+		if dataMeta, ok := meta.DataMeta(); ok {
+			dataMetas = append(dataMetas, dataMeta)
+		}
+		if tombstoneMeta, ok := meta.TombstoneMeta(); ok {
+			dataMetas = append(dataMetas, tombstoneMeta)
+		}
+		for i := uint16(0); i < meta.SubMetaCount(); i++ {
+			subMeta, _ := meta.SubMeta(i)
+			dataMetas = append(dataMetas, subMeta)
+		}
+	}
+	if len(dataMetas) == 0 {
+		return v.corrupted("no data meta")
+	}
+
+	if blkCnt := v.stats.BlkCnt(); blkCnt > 0 {
+		if cnt := dataMetas[0].BlockCount(); cnt != blkCnt {
+			return v.corrupted("%d blocks, expected %d", cnt, blkCnt)
+		}
+		if rows := dataMetas[0].BlockHeader().Rows(); rows != v.stats.Rows() {
+			return v.corrupted("%d rows, expected %d", rows, v.stats.Rows())
+		}
+	}
+	for _, dataMeta := range dataMetas {
+		if err = v.verifyDataMeta(dataMeta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *objectVerifier) verifyDataMeta(meta ObjectDataMeta) error {
+	header := meta.BlockHeader()
+	if short := v.stats.ObjectName().Short(); !short.Equal(header.ShortName()[:]) {
+		return v.corrupted("meta of another object %s", header.BlockID().String())
+	}
+
+	for _, index := range []struct {
+		typ    uint16
+		extent Extent
+	}{
+		{IOET_BF, header.BFExtent()},
+		{IOET_ZM, header.ZoneMapArea()},
+	} {
+		if index.extent.Length() == 0 {
+			continue
+		}
+		bufs, err := v.read(index.extent)
+		if err != nil {
+			return err
+		}
+		if _, err = v.checkIOEntry(bufs[0], index.typ); err != nil {
+			return err
+		}
+	}
+
+	start := uint32(header.StartID())
+	for id := start; id < start+meta.BlockCount(); id++ {
+		blk := meta.GetBlockMeta(id)
+		if uint32(blk.GetID()) != id {
+			return v.corrupted("block %d, expected %d", blk.GetID(), id)
+		}
+		if err := v.verifyBlock(blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *objectVerifier) verifyBlock(blk BlockObject) error {
+	var extents []Extent
+	for seqnum := uint16(0); seqnum < blk.GetMetaColumnCount(); seqnum++ {
+		// dropped columns have no data
+		if ext := blk.ColumnMeta(seqnum).Location(); ext.Length() > 0 {
+			extents = append(extents, ext)
+		}
+	}
+	if len(extents) == 0 {
+		return nil
+	}
+	bufs, err := v.read(extents...)
+	if err != nil {
+		return err
+	}
+	for _, buf := range bufs {
+		if _, err = v.checkIOEntry(buf, IOET_ColData); err != nil {
+			return err
+		}
+		obj, err := Decode(buf)
+		if err != nil {
+			return v.corrupted("decode column of block %d: %v", blk.GetID(), err)
+		}
+		if rows := obj.(*vector.Vector).Length(); rows != int(blk.GetRows()) {
+			return v.corrupted("%d rows in column of block %d, expected %d", rows, blk.GetID(), blk.GetRows())
+		}
+	}
+	return nil
+}
+
+// checkIOEntry checks the io entry header before decoding,
+// Decode panics on unknown headers
+func (v *objectVerifier) checkIOEntry(buf []byte, typ uint16) (h IOEntryHeader, err error) {
+	if len(buf) < IOEntryHeaderSize {
+		return h, v.corrupted("short io entry")
+	}
+	h = *DecodeIOEntryHeader(buf)
+	if h.Type != typ {
+		return h, v.corrupted("%s, expected type %d", h.String(), typ)
+	}
+	if _, ok := ioEntryCodecs[h]; !ok {
+		return h, v.corrupted("unknown %s", h.String())
+	}
+	return
+}
+
+// read reads and decompresses the extents
+func (v *objectVerifier) read(extents ...Extent) ([][]byte, error) {
+	vec := &fileservice.IOVector{
+		FilePath: v.name,
+		Entries:  make([]fileservice.IOEntry, len(extents)),
+		Policy:   fileservice.SkipAllCache,
+	}
+	var size int64
+	for i, ext := range extents {
+		vec.Entries[i] = fileservice.IOEntry{
+			Offset: int64(ext.Offset()),
+			Size:   int64(ext.Length()),
+		}
+		size += int64(ext.Length())
+	}
+	if v.throttle != nil {
+		if err := v.throttle(v.ctx, size); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.fs.Read(v.ctx, vec); err != nil {
+		switch {
+		case moerr.IsMoErrCode(err, moerr.ErrFileNotFound):
+			return nil, v.corrupted("missing")
+		case moerr.IsMoErrCode(err, moerr.ErrUnexpectedEOF),
+			moerr.IsMoErrCode(err, moerr.ErrEmptyRange):
+			return nil, v.corrupted("truncated")
+		}
+		// block checksums of the file service do not match if it is ErrDataCorrupted
+		return nil, err
+	}
+
+	bufs := make([][]byte, len(extents))
+	for i, ext := range extents {
+		data := vec.Entries[i].Data
+		if len(data) != int(ext.Length()) {
+			return nil, v.corrupted("extent %s truncated", ext.String())
+		}
+		if ext.Alg() == compress.None {
+			bufs[i] = data
+			continue
+		}
+		decompressed, err := compress.Decompress(data, make([]byte, ext.OriginSize()), compress.Lz4)
+		if err != nil {
+			return nil, v.corrupted("decompress extent %s: %v", ext.String(), err)
+		}
+		if len(decompressed) != int(ext.OriginSize()) {
+			return nil, v.corrupted("extent %s decompressed to %d bytes", ext.String(), len(decompressed))
+		}
+		bufs[i] = decompressed
+	}
+	return bufs, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestObject(t *testing.T, fs fileservice.FileService) (ObjectStats, []byte) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	bat := NewBatch([]types.Type{
+		types.T_int32.ToType(),
+		types.T_varchar.ToType(),
+	}, true, 100, mp)
+	defer bat.Clean(mp)
+
+	writer, err := NewObjectWriter(BuildObjectName(NewSegmentid(), 0), fs, 0, nil)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = writer.Write(bat)
+		require.NoError(t, err)
+	}
+	_, err = writer.WriteEnd(ctx)
+	require.NoError(t, err)
+	stats := writer.GetObjectStats()[SchemaData]
+
+	vec := &fileservice.IOVector{
+		FilePath: stats.ObjectName().String(),
+		Entries:  []fileservice.IOEntry{{Size: -1}},
+	}
+	require.NoError(t, fs.Read(ctx, vec))
+	return stats, vec.Entries[0].Data
+}
+
+func TestVerifyObject(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS("memory", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	stats, data := writeTestObject(t, fs)
+	name := stats.ObjectName().String()
+
+	var throttled int64
+	require.NoError(t, VerifyObject(ctx, fs, &stats, func(_ context.Context, n int64) error {
+		throttled += n
+		return nil
+	}))
+	require.Greater(t, throttled, int64(0))
+	require.LessOrEqual(t, throttled, int64(stats.Size()))
+
+	rewrite := func(data []byte) {
+		require.NoError(t, fs.Delete(ctx, name))
+		require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: int64(len(data)), Data: data}},
+		}))
+	}
+	corrupt := func(offset uint32) []byte {
+		corrupted := append([]byte(nil), data...)
+		corrupted[offset] ^= 0xff
+		return corrupted
+	}
+
+	// the first column of the first block
+	rewrite(corrupt(HeaderSize + 1))
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+
+	// object header
+	rewrite(corrupt(0))
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+
+	// object meta
+	metaExtent := Header(data).Extent()
+	rewrite(corrupt(metaExtent.Offset() + metaExtent.Length()/2))
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+
+	// truncated
+	rewrite(data[:len(data)-FooterSize-1])
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+
+	// missing
+	require.NoError(t, fs.Delete(ctx, name))
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+
+	// throttle errors are returned as they are
+	rewrite(data)
+	err = VerifyObject(ctx, fs, &stats, func(ctx context.Context, _ int64) error {
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestVerifyObjectChecksum(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	fs, err := fileservice.NewLocalFS(ctx, "local", dir, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	stats, _ := writeTestObject(t, fs)
+	require.NoError(t, VerifyObject(ctx, fs, &stats, nil))
+
+	// flip a bit on disk, the block checksum of the local file service does not match
+	path := filepath.Join(dir, stats.ObjectName().String())
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	content[len(content)/2] ^= 0x1
+	require.NoError(t, os.WriteFile(path, content, 0644))
+	err = VerifyObject(ctx, fs, &stats, nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDataCorrupted), "%v", err)
+}
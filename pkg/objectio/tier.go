@@ -0,0 +1,117 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// Objects keep their names after being moved to the cold tier,
+// the tier holding an object is resolved by the TieredFS under ObjectFS.
+
+type TieringCandidate struct {
+	Name string
+	// CreatedAt is the creation time of the object
+	// if zero, the time encoded in the object name is used
+	CreatedAt time.Time
+}
+
+// Tiered returns the tiered file service, nil if tiering is not enabled
+func (o *ObjectFS) Tiered() *fileservice.TieredFS {
+	fs, ok := fileservice.GetTieredFS(o.Service)
+	if !ok {
+		return nil
+	}
+	return fs
+}
+
+// ObjectTier returns the tier holding the object
+func (o *ObjectFS) ObjectTier(ctx context.Context, name string) (fileservice.Tier, error) {
+	fs := o.Tiered()
+	if fs == nil {
+		return fileservice.HotTier, nil
+	}
+	return fs.Tier(ctx, name)
+}
+
+// MoveColdObjects moves candidates selected by the tiering policy to the cold tier
+// objects deleted concurrently are skipped
+func (o *ObjectFS) MoveColdObjects(
+	ctx context.Context,
+	candidates []TieringCandidate,
+	now time.Time,
+) (moved int, err error) {
+	fs := o.Tiered()
+	if fs == nil {
+		return 0, nil
+	}
+	policy := fs.Policy()
+	// reads of all instances sharing the storage
+	lastAccess, err := fs.LastAccess(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, candidate := range candidates {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		createdAt := candidate.CreatedAt
+		if createdAt.IsZero() {
+			createdAt, _ = ObjectCreatedAt(candidate.Name)
+		}
+		if !policy.ShouldMove(createdAt, lastAccess[candidate.Name], now) {
+			continue
+		}
+		tier, err := fs.Tier(ctx, candidate.Name)
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			continue
+		} else if err != nil {
+			return moved, err
+		}
+		if tier == fileservice.ColdTier {
+			continue
+		}
+		if err := fs.Move(ctx, candidate.Name); moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			continue
+		} else if err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return
+}
+
+// ObjectCreatedAt returns the creation time encoded in the segment id of the object name
+func ObjectCreatedAt(name string) (time.Time, bool) {
+	segment, _, ok := strings.Cut(name, "_")
+	if !ok {
+		return time.Time{}, false
+	}
+	id, err := uuid.Parse(segment)
+	if err != nil || id.Version() != 7 {
+		return time.Time{}, false
+	}
+	// the first 48 bits of UUIDv7 is unix timestamp in milliseconds
+	var ms [8]byte
+	copy(ms[2:], id[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(ms[:]))), true
+}
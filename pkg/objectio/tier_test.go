@@ -0,0 +1,140 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectCreatedAt(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	name := BuildObjectName(NewSegmentid(), 1)
+	createdAt, ok := ObjectCreatedAt(name.String())
+	require.True(t, ok)
+	require.False(t, createdAt.Before(before))
+	require.False(t, createdAt.After(time.Now()))
+
+	_, ok = ObjectCreatedAt("ckp/meta_0-0_1.ckp")
+	require.False(t, ok)
+}
+
+func TestMoveColdObjects(t *testing.T) {
+	ctx := context.Background()
+	hot, err := fileservice.NewMemoryFS("shared", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	cold, err := fileservice.NewMemoryFS("shared", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	tiered, err := fileservice.NewTieredFS(hot, cold, fileservice.TieringPolicy{
+		ColdAfter: time.Hour,
+	})
+	require.NoError(t, err)
+	fs := NewObjectFS(tiered, "")
+
+	var names []string
+	for i := 0; i < 3; i++ {
+		name := BuildObjectName(NewSegmentid(), uint16(i)).String()
+		require.NoError(t, tiered.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: 3, Data: []byte("foo")}},
+		}))
+		names = append(names, name)
+	}
+	candidates := []TieringCandidate{
+		{Name: names[0]},
+		{Name: names[1]},
+		// deleted
+		{Name: BuildObjectName(NewSegmentid(), 0).String()},
+	}
+
+	// not cold yet
+	moved, err := fs.MoveColdObjects(ctx, candidates, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 0, moved)
+
+	moved, err = fs.MoveColdObjects(ctx, candidates, time.Now().Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Equal(t, 2, moved)
+	for i, name := range names {
+		tier, err := fs.ObjectTier(ctx, name)
+		require.NoError(t, err)
+		if i < 2 {
+			require.Equal(t, fileservice.ColdTier, tier)
+		} else {
+			require.Equal(t, fileservice.HotTier, tier)
+		}
+	}
+
+	// already moved
+	moved, err = fs.MoveColdObjects(ctx, candidates, time.Now().Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Equal(t, 0, moved)
+
+	// not tiered
+	moved, err = NewObjectFS(hot, "").MoveColdObjects(ctx, candidates, time.Now().Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Equal(t, 0, moved)
+}
+
+func TestMoveColdObjectsAccessed(t *testing.T) {
+	ctx := context.Background()
+	hot, err := fileservice.NewMemoryFS("shared", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	cold, err := fileservice.NewMemoryFS("shared", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	policy := fileservice.TieringPolicy{
+		ColdAfter: time.Hour,
+	}
+	tiered, err := fileservice.NewTieredFS(hot, cold, policy)
+	require.NoError(t, err)
+	// another instance sharing the storage
+	reader, err := fileservice.NewTieredFS(hot, cold, policy)
+	require.NoError(t, err)
+
+	createdAt := time.Now().Add(-time.Hour * 2)
+	var candidates []TieringCandidate
+	for i := 0; i < 2; i++ {
+		name := BuildObjectName(NewSegmentid(), uint16(i)).String()
+		require.NoError(t, tiered.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: 3, Data: []byte("foo")}},
+		}))
+		candidates = append(candidates, TieringCandidate{
+			Name:      name,
+			CreatedAt: createdAt,
+		})
+	}
+	require.NoError(t, reader.Read(ctx, &fileservice.IOVector{
+		FilePath: candidates[0].Name,
+		Entries:  []fileservice.IOEntry{{Size: 3}},
+	}))
+	require.NoError(t, reader.FlushAccess(ctx))
+
+	// the object read recently is kept
+	fs := NewObjectFS(tiered, "")
+	moved, err := fs.MoveColdObjects(ctx, candidates, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	tier, err := fs.ObjectTier(ctx, candidates[0].Name)
+	require.NoError(t, err)
+	require.Equal(t, fileservice.HotTier, tier)
+	tier, err = fs.ObjectTier(ctx, candidates[1].Name)
+	require.NoError(t, err)
+	require.Equal(t, fileservice.ColdTier, tier)
+}
@@ -38,6 +38,7 @@ const (
 	IOET_ObjectMeta_V2  = 2
 	IOET_ObjectMeta_V3  = 3
 	IOET_ColumnData_V1  = 1
+	IOET_ColumnData_V2  = 2 // dictionary encoded
 	IOET_BloomFilter_V1 = 1
 	IOET_BloomFilter_V2 = 2
 	IOET_ZoneMap_V1     = 1
@@ -53,6 +54,7 @@ func init() {
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V2}, nil, DecodeObjectMetaV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V3}, nil, DecodeObjectMetaV3)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V1}, EncodeColumnDataV1, DecodeColumnDataV1)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V2}, nil, DecodeColumnDataV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V2}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ZM, IOET_ZoneMap_V1}, nil, nil)
@@ -70,6 +72,16 @@ func DecodeColumnDataV1(buf []byte) (ioe any, err error) {
 	return vec, err
 }
 
+// DecodeColumnDataV2 decodes a dictionary encoded column, the returned
+// vector keeps the dictionary, see vector.GetDict
+func DecodeColumnDataV2(buf []byte) (ioe any, err error) {
+	vec := vector.NewVec(types.Type{})
+	if err = vec.UnmarshalDict(buf); err != nil {
+		return
+	}
+	return vec, err
+}
+
 func DecodeObjectMetaV1(buf []byte) (ioe any, err error) {
 	return objectMetaV1(buf), nil
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -48,6 +49,7 @@ type objectWriterV1 struct {
 	bloomFilter       []byte
 	objStats          []ObjectStats
 	sortKeySeqnum     uint16
+	dictColumns       []uint16
 	appendable        bool
 	originSize        uint32
 	size              uint32
@@ -58,6 +60,8 @@ type blockData struct {
 	seqnums     *Seqnums
 	data        [][]byte
 	bloomFilter []byte
+	// bloom filters of the columns other than the primary key
+	columnBFs []columnBloomFilter
 }
 
 type WriterType int8
@@ -215,6 +219,15 @@ func (w *objectWriterV1) WriteBF(blkIdx int, seqnum uint16, buf []byte) (err err
 	return
 }
 
+// WriteColumnBF records the bloom filter of a non primary key column. Unlike
+// WriteBF, a block can have several column bloom filters, they are looked up
+// by seqnum with BloomFilter.GetColumnBloomFilter.
+func (w *objectWriterV1) WriteColumnBF(blkIdx int, seqnum uint16, buf []byte) (err error) {
+	block := &w.blocks[SchemaData][blkIdx]
+	block.columnBFs = append(block.columnBFs, columnBloomFilter{seqnum: seqnum, data: buf})
+	return
+}
+
 func (w *objectWriterV1) SetAppendable() {
 	w.appendable = true
 }
@@ -223,6 +236,37 @@ func (w *objectWriterV1) SetSortKeySeqnum(seqnum uint16) {
 	w.sortKeySeqnum = seqnum
 }
 
+// SetDictColumns sets the columns of the data blocks, by their index in the
+// written batch, which are dictionary encoded when they have few distinct values.
+func (w *objectWriterV1) SetDictColumns(idxes []uint16) {
+	w.dictColumns = idxes
+}
+
+func (w *objectWriterV1) isDictColumn(idx int) bool {
+	for _, i := range w.dictColumns {
+		if int(i) == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeColumnData encodes a column of a block, a dictionary column is encoded
+// as its distinct values and the codes of the rows if it has at most half as
+// many distinct values as rows.
+func encodeColumnData(buf *bytes.Buffer, vec *vector.Vector, dict bool) error {
+	if dict && vec.Length() > 0 {
+		if d, ok := vector.BuildDict(vec); ok && d.Len() <= vec.Length()/2 {
+			h := IOEntryHeader{IOET_ColData, IOET_ColumnData_V2}
+			buf.Write(EncodeIOEntryHeader(&h))
+			return vec.MarshalDictWithBuffer(d, buf)
+		}
+	}
+	h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
+	buf.Write(EncodeIOEntryHeader(&h))
+	return vec.MarshalBinaryWithBuffer(buf)
+}
+
 func (w *objectWriterV1) WriteObjectMetaBF(buf []byte) (err error) {
 	w.bloomFilter = buf
 	return
@@ -333,9 +377,16 @@ func (w *objectWriterV1) prepareBloomFilter(blocks []blockData, blockCount uint3
 	buf := new(bytes.Buffer)
 	h := IOEntryHeader{IOET_BF, IOET_BloomFilter_CurrVer}
 	buf.Write(EncodeIOEntryHeader(&h))
+	// the column bloom filters are appended as one more entry after the
+	// object bloom filter, so the layout stays readable for old readers
+	columnBFs := encodeColumnBloomFilters(blocks)
+	entryCount := blockCount + 1
+	if columnBFs != nil {
+		entryCount++
+	}
 	bloomFilterStart := uint32(0)
-	bloomFilterIndex := BuildBlockIndex(blockCount + 1)
-	bloomFilterIndex.SetBlockCount(blockCount + 1)
+	bloomFilterIndex := BuildBlockIndex(entryCount)
+	bloomFilterIndex.SetBlockCount(entryCount)
 	bloomFilterStart += bloomFilterIndex.Length()
 	for i, block := range blocks {
 		n := uint32(len(block.bloomFilter))
@@ -343,11 +394,16 @@ func (w *objectWriterV1) prepareBloomFilter(blocks []blockData, blockCount uint3
 		bloomFilterStart += n
 	}
 	bloomFilterIndex.SetBlockMetaPos(blockCount, bloomFilterStart, uint32(len(w.bloomFilter)))
+	bloomFilterStart += uint32(len(w.bloomFilter))
+	if columnBFs != nil {
+		bloomFilterIndex.SetBlockMetaPos(blockCount+1, bloomFilterStart, uint32(len(columnBFs)))
+	}
 	buf.Write(bloomFilterIndex)
 	for _, block := range blocks {
 		buf.Write(block.bloomFilter)
 	}
 	buf.Write(w.bloomFilter)
+	buf.Write(columnBFs)
 	length := uint32(len(buf.Bytes()))
 	extent := NewExtent(compress.None, offset, length, length)
 	return buf.Bytes(), extent, nil
@@ -603,9 +659,8 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 			logutil.Debugf("%s unmatched length, expect %d, get %d", attr, rows, vec.Length())
 		}
 		buf.Reset()
-		h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
-		buf.Write(EncodeIOEntryHeader(&h))
-		err := vec.MarshalBinaryWithBuffer(&buf)
+		dict := blocks == &w.blocks[SchemaData] && w.isDictColumn(i)
+		err := encodeColumnData(&buf, vec, dict)
 		if err != nil {
 			return 0, err
 		}
@@ -368,3 +368,124 @@ func newBatch2(mp *mpool.MPool) *batch.Batch {
 	}
 	return NewBatch(types, false, int(40000*2), mp)
 }
+
+func TestColumnBloomFilter(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	name := "1.blk"
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.Nil(t, err)
+	defer service.Close()
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		blk, err := objectWriter.Write(bat)
+		assert.Nil(t, err)
+		assert.Nil(t, objectWriter.WriteBF(int(blk.GetID()), 0, []byte("pk")))
+		assert.Nil(t, objectWriter.WriteColumnBF(int(blk.GetID()), 1, []byte(fmt.Sprintf("c1-%d", i))))
+		assert.Nil(t, objectWriter.WriteColumnBF(int(blk.GetID()), 3, []byte(fmt.Sprintf("c3-%d", i))))
+	}
+	blocks, err := objectWriter.WriteEnd(ctx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, len(blocks))
+	objectReader, err := NewObjectReaderWithStr(name, service)
+	assert.Nil(t, err)
+	metaExtent := NewExtent(1, blocks[0].GetExtent().Offset(), blocks[0].GetExtent().Length(), blocks[0].GetExtent().OriginSize())
+	objectReader.CacheMetaExtent(&metaExtent)
+	metaHeader, err := objectReader.ReadMeta(ctx, mp)
+	assert.Nil(t, err)
+	meta, _ := metaHeader.DataMeta()
+	extent := meta.BlockHeader().BFExtent()
+	bf, err := ReadBloomFilter(ctx, name, &extent, fileservice.SkipMemoryCache, service)
+	assert.Nil(t, err)
+
+	// the primary key bloom filters are not affected
+	assert.Equal(t, []byte("pk"), bf.GetBloomFilter(0))
+	assert.Equal(t, []byte("pk"), bf.GetBloomFilter(1))
+
+	assert.Equal(t, []byte("c1-0"), bf.GetColumnBloomFilter(0, 1))
+	assert.Equal(t, []byte("c3-0"), bf.GetColumnBloomFilter(0, 3))
+	assert.Equal(t, []byte("c1-1"), bf.GetColumnBloomFilter(1, 1))
+	assert.Equal(t, []byte("c3-1"), bf.GetColumnBloomFilter(1, 3))
+	assert.Nil(t, bf.GetColumnBloomFilter(0, 2))
+	assert.Nil(t, bf.GetColumnBloomFilter(2, 1))
+}
+
+func TestDictColumnData(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	name := "1.blk"
+	mp := mpool.MustNewZero()
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.Nil(t, err)
+	defer service.Close()
+
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < 100; i++ {
+		low := []byte(fmt.Sprintf("v%d", i%3))
+		high := []byte(fmt.Sprintf("v%d", i))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[0], low, i == 10, mp))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[1], high, false, mp))
+		assert.Nil(t, vector.AppendBytes(bat.Vecs[2], low, false, mp))
+	}
+	bat.SetRowCount(100)
+	defer bat.Clean(mp)
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+	assert.Nil(t, err)
+	// column 1 has too many distinct values, column 2 is not a dictionary column
+	objectWriter.SetDictColumns([]uint16{0, 1})
+	_, err = objectWriter.Write(bat)
+	assert.Nil(t, err)
+	blocks, err := objectWriter.WriteEnd(ctx)
+	assert.Nil(t, err)
+
+	objectReader, err := NewObjectReaderWithStr(name, service)
+	assert.Nil(t, err)
+	metaExtent := NewExtent(1, blocks[0].GetExtent().Offset(), blocks[0].GetExtent().Length(), blocks[0].GetExtent().OriginSize())
+	objectReader.CacheMetaExtent(&metaExtent)
+	typs := []types.Type{types.T_varchar.ToType(), types.T_varchar.ToType(), types.T_varchar.ToType()}
+	ioVec, err := objectReader.ReadOneBlock(ctx, []uint16{0, 1, 2}, typs, 0, mp)
+	assert.Nil(t, err)
+	defer ioVec.Release()
+
+	versions := []uint16{IOET_ColumnData_V2, IOET_ColumnData_V1, IOET_ColumnData_V1}
+	for i := range versions {
+		data := ioVec.Entries[i].CachedData.Bytes()
+		assert.Equal(t, versions[i], DecodeIOEntryHeader(data).Version)
+		obj, err := Decode(data)
+		assert.Nil(t, err)
+		vec := obj.(*vector.Vector)
+		assert.Equal(t, vector.MustStrCol(bat.Vecs[i]), vector.MustStrCol(vec))
+		assert.Equal(t, i == 0, vec.GetDict() != nil)
+	}
+	obj, err := Decode(ioVec.Entries[0].CachedData.Bytes())
+	assert.Nil(t, err)
+	vec := obj.(*vector.Vector)
+	assert.True(t, vec.IsNull(10))
+	assert.Equal(t, 3, vec.GetDict().Len())
+}
@@ -128,6 +128,27 @@ func NewRenameColumnReq(did, tid uint64, oldname, newname string, seqnum uint32)
 		},
 	}
 }
+
+func NewUpdatePolicyReq(did, tid uint64, policy *plan.AlterTableMergePolicy) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdatePolicy,
+		Operation: &AlterTableReq_UpdatePolicy{
+			&AlterTablePolicy{
+				MinRowsQuailifed: policy.MinRows,
+				MaxObjOnerun:     policy.MaxObjects,
+				TargetObjectSize: policy.TargetObjectSize,
+				MaxLevel:         policy.MaxLevel,
+				Reorganize:       policy.Reorganize,
+				TimeColumn:       policy.TimeColumn,
+				TimeWindow:       policy.TimeWindow,
+				SealAfter:        policy.SealAfter,
+			},
+		},
+	}
+}
+
 func (m *SyncLogTailReq) MarshalBinary() ([]byte, error) {
 	return m.Marshal()
 }
@@ -1071,14 +1071,25 @@ func (m *MetadataCkp) GetBat() *Batch {
 }
 
 type AlterTablePolicy struct {
-	MinRowsQuailifed     uint32      `protobuf:"varint,1,opt,name=min_rows_quailifed,json=minRowsQuailifed,proto3" json:"min_rows_quailifed,omitempty"`
-	MaxObjOnerun         uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
-	MaxRowsMergedObj     uint32      `protobuf:"varint,3,opt,name=max_rows_merged_obj,json=maxRowsMergedObj,proto3" json:"max_rows_merged_obj,omitempty"`
-	Hints                []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
-	MinCnMergeSize       uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	MinRowsQuailifed uint32      `protobuf:"varint,1,opt,name=min_rows_quailifed,json=minRowsQuailifed,proto3" json:"min_rows_quailifed,omitempty"`
+	MaxObjOnerun     uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
+	MaxRowsMergedObj uint32      `protobuf:"varint,3,opt,name=max_rows_merged_obj,json=maxRowsMergedObj,proto3" json:"max_rows_merged_obj,omitempty"`
+	Hints            []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
+	MinCnMergeSize   uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	// the merged objects are cut at this size, 0 for the default
+	TargetObjectSize uint64 `protobuf:"varint,6,opt,name=target_object_size,json=targetObjectSize,proto3" json:"target_object_size,omitempty"`
+	// objects are merged only with objects of the same level,
+	// and objects at max_level are not merged any more
+	MaxLevel uint32 `protobuf:"varint,7,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	// merge the objects overlapping on the sort key
+	Reorganize bool `protobuf:"varint,8,opt,name=reorganize,proto3" json:"reorganize,omitempty"`
+	// time window compaction, windows are in seconds
+	TimeColumn           string   `protobuf:"bytes,9,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	TimeWindow           int64    `protobuf:"varint,10,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	SealAfter            int64    `protobuf:"varint,11,opt,name=seal_after,json=sealAfter,proto3" json:"seal_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AlterTablePolicy) Reset()         { *m = AlterTablePolicy{} }
@@ -1149,6 +1160,48 @@ func (m *AlterTablePolicy) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *AlterTablePolicy) GetTargetObjectSize() uint64 {
+	if m != nil {
+		return m.TargetObjectSize
+	}
+	return 0
+}
+
+func (m *AlterTablePolicy) GetMaxLevel() uint32 {
+	if m != nil {
+		return m.MaxLevel
+	}
+	return 0
+}
+
+func (m *AlterTablePolicy) GetReorganize() bool {
+	if m != nil {
+		return m.Reorganize
+	}
+	return false
+}
+
+func (m *AlterTablePolicy) GetTimeColumn() string {
+	if m != nil {
+		return m.TimeColumn
+	}
+	return ""
+}
+
+func (m *AlterTablePolicy) GetTimeWindow() int64 {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
+}
+
+func (m *AlterTablePolicy) GetSealAfter() int64 {
+	if m != nil {
+		return m.SealAfter
+	}
+	return 0
+}
+
 type AlterTableConstraint struct {
 	Constraints          []byte   `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1720,6 +1773,12 @@ type SchemaExtra struct {
 	MaxRowsMergedObj     uint32      `protobuf:"varint,7,opt,name=max_rows_merged_obj,json=maxRowsMergedObj,proto3" json:"max_rows_merged_obj,omitempty"`
 	Hints                []MergeHint `protobuf:"varint,8,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
 	MinCnMergeSize       uint64      `protobuf:"varint,9,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	TargetObjectSize     uint64      `protobuf:"varint,10,opt,name=target_object_size,json=targetObjectSize,proto3" json:"target_object_size,omitempty"`
+	MaxLevel             uint32      `protobuf:"varint,11,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Reorganize           bool        `protobuf:"varint,12,opt,name=reorganize,proto3" json:"reorganize,omitempty"`
+	TimeColumn           string      `protobuf:"bytes,13,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	TimeWindow           int64       `protobuf:"varint,14,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	SealAfter            int64       `protobuf:"varint,15,opt,name=seal_after,json=sealAfter,proto3" json:"seal_after,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1821,6 +1880,48 @@ func (m *SchemaExtra) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *SchemaExtra) GetTargetObjectSize() uint64 {
+	if m != nil {
+		return m.TargetObjectSize
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetMaxLevel() uint32 {
+	if m != nil {
+		return m.MaxLevel
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetReorganize() bool {
+	if m != nil {
+		return m.Reorganize
+	}
+	return false
+}
+
+func (m *SchemaExtra) GetTimeColumn() string {
+	if m != nil {
+		return m.TimeColumn
+	}
+	return ""
+}
+
+func (m *SchemaExtra) GetTimeWindow() int64 {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetSealAfter() int64 {
+	if m != nil {
+		return m.SealAfter
+	}
+	return 0
+}
+
 // Int64Map mainly used in unit test
 type Int64Map struct {
 	M                    map[int64]int64 `protobuf:"bytes,1,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -2290,152 +2391,159 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x23, 0xc7,
-	0x11, 0xd6, 0xf0, 0xcd, 0x1a, 0x3e, 0x46, 0x2d, 0x79, 0x4d, 0xcb, 0x8e, 0x56, 0x19, 0x3b, 0xb6,
-	0xbc, 0xf6, 0x6a, 0x11, 0xd9, 0x49, 0xec, 0xc5, 0xc2, 0x86, 0x44, 0xd9, 0x2b, 0x26, 0x2b, 0x51,
-	0x19, 0x71, 0xd7, 0x80, 0x11, 0x60, 0xd0, 0x9c, 0x69, 0x51, 0xb3, 0x9c, 0xe9, 0xee, 0xed, 0x69,
-	0xea, 0xe1, 0x6b, 0xe2, 0x3f, 0x90, 0x5b, 0x6e, 0xf6, 0x29, 0x87, 0x5c, 0x73, 0xce, 0xd9, 0x47,
-	0x07, 0x79, 0x27, 0x40, 0x60, 0x6c, 0x00, 0x23, 0x41, 0x2e, 0xf9, 0x09, 0x41, 0x77, 0xcf, 0x90,
-	0x94, 0x56, 0x76, 0x9c, 0x20, 0x80, 0x2f, 0x44, 0xd7, 0x57, 0x55, 0xdd, 0x55, 0xd5, 0xd5, 0x55,
-	0x35, 0x84, 0x3a, 0xe6, 0xd1, 0x06, 0x17, 0x4c, 0x32, 0x54, 0xc4, 0x3c, 0x5a, 0xb9, 0x39, 0x8a,
-	0xe4, 0xf1, 0x64, 0xb8, 0x11, 0xb0, 0xe4, 0xd6, 0x88, 0x8d, 0xd8, 0x2d, 0xcd, 0x1b, 0x4e, 0x8e,
-	0x34, 0xa5, 0x09, 0xbd, 0x32, 0x3a, 0x2b, 0x6d, 0x19, 0x25, 0x24, 0x95, 0x38, 0xe1, 0x19, 0x00,
-	0x3c, 0xc6, 0xd4, 0xac, 0xdd, 0xef, 0x41, 0x73, 0xb0, 0x7f, 0x10, 0xd1, 0x91, 0x47, 0x1e, 0x4d,
-	0x48, 0x2a, 0xd1, 0x73, 0x50, 0xe7, 0x58, 0xe0, 0x84, 0x48, 0x22, 0x3a, 0xd6, 0x9a, 0xb5, 0x5e,
-	0xf7, 0x66, 0xc0, 0xed, 0xda, 0x47, 0x1f, 0x5f, 0xb7, 0x3e, 0xfb, 0xf8, 0xfa, 0x82, 0xfb, 0x4b,
-	0x0b, 0x5a, 0xb9, 0x66, 0xca, 0x19, 0x4d, 0x09, 0xea, 0x40, 0x35, 0x95, 0x4c, 0x90, 0xde, 0x4e,
-	0xa6, 0x98, 0x93, 0xe8, 0x45, 0x68, 0xa5, 0x44, 0x9c, 0x44, 0x01, 0xd9, 0x0a, 0x43, 0x41, 0xd2,
-	0xb4, 0x53, 0xd0, 0x02, 0x97, 0x50, 0xbd, 0xc3, 0x31, 0x16, 0x61, 0x6f, 0xa7, 0x53, 0x5c, 0xb3,
-	0xd6, 0x4b, 0x5e, 0x4e, 0x2a, 0xb3, 0x04, 0xe1, 0x71, 0x14, 0xe0, 0xde, 0x4e, 0xa7, 0xa4, 0x79,
-	0x33, 0x00, 0xad, 0x02, 0xc4, 0x6c, 0x74, 0x98, 0xa9, 0x96, 0x35, 0x7b, 0x0e, 0x99, 0x33, 0xfb,
-	0x36, 0x38, 0x83, 0xfd, 0x43, 0x29, 0xe6, 0xed, 0xd6, 0x7b, 0xcb, 0x89, 0xa0, 0x87, 0x72, 0xea,
-	0xf2, 0x14, 0x98, 0xd3, 0xfd, 0x85, 0x05, 0x95, 0x07, 0x24, 0x90, 0x4c, 0x20, 0x04, 0xa5, 0x10,
-	0x4b, 0xac, 0xa5, 0x1b, 0x9e, 0x5e, 0xa3, 0x55, 0x28, 0xc9, 0x73, 0x4e, 0xb4, 0x6b, 0xf6, 0x26,
-	0x6c, 0xe8, 0x28, 0x0f, 0xce, 0x39, 0xf1, 0x34, 0x8e, 0x56, 0xa0, 0x46, 0x27, 0x71, 0x8c, 0x87,
-	0x31, 0xd1, 0xde, 0xd5, 0xbc, 0x29, 0x8d, 0x1c, 0x28, 0xd2, 0x94, 0x6b, 0xc7, 0x1a, 0x9e, 0x5a,
-	0xa2, 0x67, 0xa0, 0x16, 0xa5, 0x7e, 0xc0, 0x68, 0x2a, 0xb5, 0x43, 0x35, 0xaf, 0x1a, 0xa5, 0x5d,
-	0x45, 0x2a, 0xe1, 0x98, 0xd0, 0x4e, 0x65, 0xcd, 0x5a, 0x6f, 0x7a, 0x6a, 0xa9, 0xcc, 0xc1, 0x82,
-	0xe0, 0x4e, 0xd5, 0x98, 0xa3, 0xd6, 0xee, 0xf7, 0xa1, 0xbc, 0x8d, 0x65, 0x70, 0x8c, 0x56, 0xa0,
-	0x8c, 0xa5, 0x14, 0x69, 0xc7, 0x5a, 0x2b, 0xae, 0xd7, 0xb7, 0x4b, 0x9f, 0xfc, 0xf5, 0xfa, 0x82,
-	0x67, 0x20, 0xf4, 0x2d, 0x28, 0x9d, 0x90, 0x40, 0x5d, 0x47, 0x71, 0xdd, 0xde, 0xb4, 0x37, 0x54,
-	0xa6, 0x19, 0x17, 0x33, 0x39, 0xcd, 0x76, 0x1f, 0x40, 0x75, 0xa0, 0xec, 0xec, 0xed, 0xa0, 0x25,
-	0x28, 0x87, 0x43, 0x3f, 0x0a, 0xb5, 0xeb, 0x25, 0xaf, 0x14, 0x0e, 0x7b, 0xa1, 0x02, 0xa5, 0x06,
-	0x0b, 0x06, 0x94, 0x0a, 0xfc, 0x26, 0x34, 0x38, 0x16, 0x32, 0x92, 0x11, 0xa3, 0x8a, 0x67, 0x6e,
-	0xd4, 0x9e, 0x62, 0xbd, 0xd0, 0xfd, 0xa9, 0x05, 0xad, 0xc3, 0x73, 0x1a, 0xdc, 0x63, 0xa3, 0x01,
-	0x8e, 0x62, 0x8f, 0x3c, 0x42, 0x37, 0xa1, 0x1a, 0x50, 0xff, 0x18, 0x9f, 0x10, 0x7d, 0x82, 0xbd,
-	0xb9, 0xbc, 0x31, 0xcb, 0xdf, 0x41, 0xbe, 0xf2, 0x2a, 0x01, 0xdd, 0xc5, 0x27, 0x24, 0x13, 0x3f,
-	0xc5, 0x54, 0x66, 0x71, 0xff, 0x42, 0xf1, 0xf7, 0x30, 0x95, 0xc8, 0x85, 0xb2, 0x9c, 0x5e, 0x80,
-	0xbd, 0xd9, 0xd0, 0x0e, 0x67, 0xae, 0x79, 0x86, 0xe5, 0xfe, 0x08, 0xda, 0x17, 0x6c, 0x4a, 0xb9,
-	0x72, 0x25, 0x18, 0x73, 0x3f, 0x66, 0x01, 0x56, 0x96, 0x67, 0x49, 0x62, 0x07, 0x63, 0x7e, 0x2f,
-	0x83, 0xd0, 0x8b, 0x50, 0x0b, 0x58, 0x92, 0x60, 0x1a, 0xe6, 0xd1, 0x04, 0xbd, 0xf9, 0x3b, 0x54,
-	0x8a, 0x73, 0x6f, 0xca, 0x73, 0xdf, 0x82, 0xc5, 0x03, 0x41, 0x14, 0x19, 0xc9, 0xf7, 0x44, 0x24,
-	0x49, 0x37, 0x09, 0xd1, 0xcb, 0x00, 0x44, 0xc9, 0xf9, 0x71, 0x94, 0x4a, 0x7d, 0x4f, 0x17, 0xd5,
-	0xeb, 0x9a, 0x7b, 0x2f, 0x4a, 0xa5, 0xfb, 0xcf, 0x02, 0x94, 0x35, 0x88, 0x5e, 0xcb, 0x95, 0x74,
-	0xd6, 0x29, 0x93, 0x5a, 0x9b, 0xcb, 0x33, 0x25, 0xf3, 0xab, 0xf3, 0xcf, 0xa8, 0xab, 0xa5, 0x4a,
-	0x2b, 0xed, 0xe5, 0xec, 0xb2, 0xaa, 0x9a, 0xee, 0x85, 0xe8, 0x3a, 0xd8, 0x2a, 0x8f, 0x87, 0x38,
-	0x25, 0xb3, 0xeb, 0x82, 0x1c, 0xea, 0x85, 0xe8, 0x1b, 0x00, 0x46, 0x97, 0xe2, 0x84, 0xe8, 0x5c,
-	0xad, 0x7b, 0x75, 0x8d, 0xec, 0xe3, 0x84, 0xa0, 0xe7, 0xa1, 0x39, 0xd5, 0xd7, 0x12, 0x65, 0x2d,
-	0xd1, 0xc8, 0x41, 0x2d, 0xf4, 0x2c, 0xd4, 0x8f, 0xa2, 0x7c, 0x8b, 0x8a, 0x16, 0xa8, 0x29, 0x40,
-	0x33, 0x9f, 0x83, 0xe2, 0x10, 0x4b, 0x9d, 0xc5, 0xb9, 0xff, 0x3a, 0x85, 0x3d, 0x05, 0xa3, 0xe7,
-	0xa1, 0xc5, 0xc7, 0x7e, 0x70, 0x4c, 0x82, 0xb1, 0x3f, 0x3c, 0xf7, 0x25, 0xed, 0xd4, 0xd6, 0xac,
-	0xf5, 0xb2, 0x67, 0xf3, 0x71, 0x57, 0x81, 0xdb, 0xe7, 0x03, 0xea, 0xee, 0x41, 0x7d, 0xea, 0x37,
-	0x02, 0xa8, 0xf4, 0x68, 0x4a, 0x84, 0x74, 0x16, 0xd4, 0x7a, 0x87, 0xc4, 0x44, 0x12, 0xc7, 0x52,
-	0xeb, 0xfb, 0x3c, 0xc4, 0x92, 0x38, 0x05, 0x54, 0x87, 0xf2, 0x56, 0x2c, 0x89, 0x70, 0x8a, 0x68,
-	0x11, 0x9a, 0x87, 0x9c, 0x04, 0x11, 0x8e, 0x33, 0xc9, 0x92, 0xfb, 0x13, 0x0b, 0x40, 0x6f, 0xce,
-	0x59, 0x44, 0x25, 0x7a, 0x05, 0x2a, 0x49, 0x44, 0x7d, 0x99, 0x7e, 0x69, 0x6e, 0x96, 0x93, 0x88,
-	0x0e, 0x52, 0x2d, 0x8c, 0xcf, 0x94, 0x70, 0xe1, 0x4b, 0x85, 0xf1, 0xd9, 0x20, 0xcd, 0x5d, 0x2f,
-	0x5e, 0xe9, 0xba, 0x31, 0x03, 0x4b, 0x1c, 0xb3, 0x51, 0x77, 0xcc, 0xbf, 0x36, 0x33, 0x3e, 0xb4,
-	0xc0, 0xde, 0x23, 0x12, 0xab, 0x1b, 0xfd, 0x3a, 0xed, 0xf8, 0xdc, 0x02, 0x47, 0x5f, 0x9a, 0x7e,
-	0xb9, 0x07, 0x2c, 0x8e, 0x82, 0x73, 0xf4, 0x2a, 0x20, 0x65, 0x8c, 0x60, 0xa7, 0xa9, 0xff, 0x68,
-	0x82, 0xa3, 0x38, 0x3a, 0x22, 0xa6, 0x4a, 0x35, 0x3d, 0x27, 0x89, 0xa8, 0xc7, 0x4e, 0xd3, 0x1f,
-	0xe6, 0x38, 0x7a, 0x01, 0x5a, 0xca, 0x1a, 0x36, 0x7c, 0xe8, 0x33, 0x4a, 0xc4, 0x84, 0x6a, 0xab,
-	0x9a, 0x5e, 0x23, 0xc1, 0x67, 0xfd, 0xe1, 0xc3, 0xbe, 0xc6, 0xd0, 0x4d, 0x58, 0x52, 0x52, 0x7a,
-	0xcf, 0x84, 0x88, 0x11, 0x09, 0x95, 0x86, 0x36, 0x4b, 0x6d, 0x8a, 0xcf, 0xd4, 0xa6, 0x7b, 0x9a,
-	0xd1, 0x1f, 0x3e, 0x44, 0x2f, 0x40, 0xf9, 0x38, 0xa2, 0x32, 0xed, 0x94, 0xd6, 0x8a, 0xeb, 0xad,
-	0xcd, 0x96, 0xb6, 0x5b, 0xb3, 0x77, 0x23, 0x2a, 0x3d, 0xc3, 0x44, 0x2f, 0xc3, 0xa2, 0x32, 0x34,
-	0xa0, 0x66, 0x4b, 0x3f, 0x8d, 0x3e, 0x20, 0x59, 0xcf, 0x6a, 0x25, 0x11, 0xed, 0x52, 0xad, 0x71,
-	0x18, 0x7d, 0x40, 0xdc, 0x37, 0x60, 0x79, 0xe6, 0xa7, 0x2e, 0xfe, 0x02, 0xab, 0x3c, 0x5c, 0x03,
-	0x3b, 0x98, 0x52, 0x69, 0xd6, 0x85, 0xe6, 0x21, 0xf7, 0x26, 0x2c, 0xce, 0x6b, 0x26, 0x09, 0xa1,
-	0x52, 0xb5, 0xd7, 0xc0, 0x2c, 0xf3, 0x06, 0x9d, 0x91, 0xee, 0x1e, 0x3c, 0x35, 0x13, 0xf7, 0x88,
-	0x7a, 0x9d, 0x7a, 0xa9, 0xea, 0x05, 0x8b, 0x43, 0xf3, 0x5c, 0x33, 0x1d, 0x16, 0x87, 0xfa, 0xb5,
-	0x3e, 0x03, 0x35, 0x4a, 0x4e, 0x0d, 0xcb, 0xb4, 0xf3, 0x2a, 0x25, 0xa7, 0x8a, 0xe5, 0x52, 0x58,
-	0xba, 0xbc, 0x5d, 0x97, 0xc5, 0xff, 0xdb, 0x66, 0xaa, 0xf8, 0xa6, 0x6a, 0x38, 0xa1, 0x01, 0xf1,
-	0xe9, 0x24, 0xc9, 0xa2, 0x6f, 0xe7, 0xd8, 0xfe, 0x24, 0x71, 0xc3, 0xf9, 0xf3, 0xb6, 0xc2, 0xb0,
-	0xcb, 0xe2, 0x49, 0x42, 0xd1, 0x0b, 0x50, 0x09, 0xf4, 0x2a, 0xcb, 0xcf, 0x86, 0xe9, 0xc9, 0x5d,
-	0x16, 0xef, 0x90, 0x23, 0x2f, 0xe3, 0xa1, 0x97, 0xa0, 0x1d, 0xe9, 0x2a, 0xe1, 0x73, 0x96, 0xea,
-	0xce, 0xa4, 0x2d, 0x28, 0x7b, 0x2d, 0x03, 0x1f, 0x64, 0xa8, 0x7b, 0x08, 0xd7, 0x2e, 0x9c, 0x72,
-	0x90, 0x77, 0x32, 0xf4, 0x26, 0x34, 0x67, 0xad, 0x2e, 0x24, 0x47, 0xd3, 0xf7, 0xa0, 0xcf, 0x9b,
-	0xca, 0x6d, 0x9f, 0xab, 0x73, 0x67, 0x5d, 0x71, 0x87, 0x1c, 0xb9, 0xef, 0xcf, 0x5f, 0xf1, 0x8e,
-	0x60, 0x3c, 0xb3, 0xfd, 0x3a, 0xd8, 0x31, 0x1b, 0x45, 0x01, 0x8e, 0xfd, 0x28, 0x3c, 0xcb, 0xf2,
-	0x18, 0x32, 0xa8, 0x17, 0x9e, 0x3d, 0x11, 0x96, 0xc2, 0x93, 0x61, 0xf9, 0xbc, 0x04, 0xcd, 0xf9,
-	0x7b, 0x78, 0x74, 0xa1, 0xfc, 0x5b, 0x17, 0xcb, 0xff, 0xb4, 0xb1, 0x17, 0xe6, 0x1a, 0xbb, 0x0b,
-	0xa5, 0x71, 0x44, 0x4d, 0x33, 0xc8, 0x13, 0x5a, 0xef, 0xf8, 0x83, 0x88, 0x86, 0x9e, 0xe6, 0xa1,
-	0x37, 0x01, 0x70, 0x18, 0xfa, 0x59, 0xa4, 0x4b, 0xda, 0xf3, 0xce, 0x4c, 0xf2, 0xe2, 0x9d, 0xec,
-	0x2e, 0x78, 0x75, 0x3c, 0xbd, 0xa0, 0x3b, 0x60, 0x87, 0x82, 0xf1, 0x5c, 0xb7, 0xac, 0x75, 0x9f,
-	0xb9, 0xa4, 0x3b, 0x0b, 0xca, 0xee, 0x82, 0x07, 0xe1, 0x2c, 0x44, 0x6f, 0x43, 0x43, 0xe8, 0xdc,
-	0xf2, 0x4d, 0x4f, 0xaf, 0x68, 0xf5, 0x95, 0x4b, 0xea, 0x73, 0xd9, 0xbc, 0xbb, 0xe0, 0xd9, 0x62,
-	0x2e, 0xb9, 0xdf, 0x86, 0xd6, 0x44, 0xf7, 0x01, 0x3f, 0x7f, 0x16, 0xa6, 0xf5, 0x5c, 0xbb, 0xb4,
-	0x45, 0xf6, 0x7e, 0x76, 0x17, 0xbc, 0xa6, 0x91, 0xcf, 0x1f, 0xd4, 0x1d, 0xb0, 0xf3, 0x0d, 0x52,
-	0x29, 0x74, 0x3f, 0x7a, 0xd2, 0xfe, 0xd9, 0xbb, 0x55, 0xf6, 0x67, 0x1b, 0xa4, 0x52, 0xa0, 0x3b,
-	0x90, 0x6d, 0xe7, 0x73, 0x5d, 0xc2, 0x3a, 0x75, 0xad, 0xff, 0xd4, 0x25, 0x7d, 0x53, 0xdf, 0x76,
-	0x17, 0xbc, 0x86, 0x91, 0xce, 0xea, 0xdd, 0x36, 0x34, 0x55, 0xd8, 0xa7, 0xc9, 0xd4, 0x01, 0xad,
-	0xfd, 0xec, 0x93, 0x91, 0x9f, 0xe6, 0x9f, 0xda, 0x03, 0x5f, 0xcc, 0x5b, 0xc8, 0x22, 0x18, 0xb0,
-	0xb8, 0x63, 0x5f, 0x79, 0x75, 0xd3, 0xe7, 0xab, 0xae, 0x4e, 0xe4, 0xc4, 0xb6, 0x0d, 0x75, 0xc6,
-	0x89, 0xd0, 0xc3, 0x8f, 0xfb, 0xaf, 0x02, 0xd8, 0x87, 0xc1, 0x31, 0x49, 0xf0, 0x3b, 0x67, 0x52,
-	0x60, 0xf4, 0x22, 0xb4, 0x29, 0x39, 0x93, 0x6a, 0x57, 0x3f, 0x25, 0x8f, 0x54, 0x7a, 0x9a, 0x04,
-	0x6e, 0x2a, 0xb8, 0xcb, 0xe2, 0x43, 0x0d, 0xea, 0x91, 0x41, 0x30, 0xce, 0x49, 0xe8, 0x9b, 0x11,
-	0x55, 0x4d, 0x4e, 0x6a, 0x64, 0x30, 0xe0, 0x56, 0x36, 0xa3, 0xb6, 0x4c, 0x7e, 0xf8, 0xc1, 0x31,
-	0xa6, 0x23, 0x12, 0x66, 0xd3, 0x73, 0xd3, 0xa0, 0x5d, 0x03, 0x5e, 0x28, 0x2e, 0xa5, 0x8b, 0xc5,
-	0xe5, 0xea, 0xd6, 0x50, 0xfe, 0xca, 0xad, 0xa1, 0xf2, 0xd5, 0x5b, 0x43, 0xf5, 0x3f, 0xb5, 0x86,
-	0xda, 0x7f, 0xdd, 0x1a, 0xea, 0x57, 0xb6, 0x86, 0x10, 0x6a, 0x3d, 0x2a, 0xbf, 0xfb, 0xfa, 0x1e,
-	0xe6, 0xc8, 0x05, 0x2b, 0xc9, 0xa6, 0x46, 0x33, 0x00, 0xe6, 0x9c, 0x8d, 0x3d, 0x33, 0x3f, 0x5a,
-	0xc9, 0xca, 0xeb, 0x50, 0x31, 0x84, 0xfa, 0x7c, 0x18, 0x93, 0x73, 0x7d, 0x21, 0x45, 0x4f, 0x2d,
-	0xd1, 0x32, 0x94, 0x4f, 0x70, 0x3c, 0x31, 0x95, 0xb7, 0xe8, 0x19, 0xe2, 0x76, 0xe1, 0x0d, 0xcb,
-	0x7d, 0x00, 0x8d, 0x81, 0xc0, 0x34, 0xdd, 0x21, 0xa9, 0xaa, 0x83, 0xe8, 0x1a, 0x54, 0xd8, 0xf0,
-	0x61, 0x2f, 0x2b, 0x48, 0x65, 0x2f, 0xa3, 0x14, 0x3e, 0x8c, 0xc7, 0x0a, 0x37, 0xa5, 0x33, 0xa3,
-	0x14, 0x2e, 0xd8, 0xa9, 0xc2, 0x8b, 0x06, 0x37, 0x94, 0xfb, 0x63, 0x0b, 0xec, 0xed, 0x78, 0xac,
-	0xf7, 0x56, 0x1e, 0xbc, 0x32, 0xf3, 0xe0, 0x69, 0xd3, 0xed, 0x67, 0xcc, 0xcc, 0x89, 0xec, 0x83,
-	0xc4, 0x4a, 0x56, 0xee, 0x5e, 0xe5, 0x4a, 0xd9, 0xb8, 0xf2, 0xd2, 0xbc, 0x2b, 0xf6, 0xe6, 0xa2,
-	0x19, 0xf0, 0xe7, 0x5c, 0x98, 0xf7, 0x6e, 0x17, 0x50, 0x7e, 0xce, 0x11, 0x11, 0xdb, 0x8c, 0x8d,
-	0x23, 0x3a, 0x42, 0x9b, 0x50, 0x4b, 0x30, 0xe7, 0x11, 0x1d, 0xa5, 0x99, 0x49, 0xce, 0x65, 0x93,
-	0x32, 0x5b, 0xa6, 0x72, 0xee, 0xaf, 0x0a, 0xe0, 0xe8, 0xbb, 0xe9, 0xea, 0xc1, 0xde, 0x58, 0x77,
-	0xe5, 0xa7, 0xd2, 0x53, 0x50, 0x91, 0xc3, 0x78, 0x56, 0x67, 0xcb, 0x72, 0x18, 0x3f, 0x31, 0x5b,
-	0x17, 0x2f, 0xcf, 0xd6, 0xdf, 0x81, 0x5a, 0x2a, 0xb1, 0x90, 0xbe, 0x1e, 0x2e, 0xbe, 0x70, 0x7c,
-	0xca, 0xec, 0xaa, 0x6a, 0xd9, 0x41, 0xaa, 0x9a, 0xc8, 0x2c, 0x37, 0xd3, 0x4e, 0x79, 0xad, 0xb8,
-	0xde, 0xf0, 0x20, 0xc9, 0xb3, 0x32, 0xd5, 0x1f, 0x36, 0x82, 0x60, 0x99, 0x4b, 0x54, 0xb4, 0x84,
-	0x9d, 0x61, 0x5a, 0xe4, 0xdb, 0x50, 0x1d, 0x9a, 0xc8, 0x64, 0xd5, 0xf1, 0xe2, 0x05, 0xcd, 0x02,
-	0xe7, 0xe5, 0x72, 0xea, 0xd8, 0x6c, 0xa9, 0x3e, 0x99, 0x74, 0x59, 0x6c, 0x78, 0x90, 0x41, 0xf7,
-	0x58, 0xa0, 0xee, 0x8d, 0x08, 0xa1, 0x33, 0xbb, 0xee, 0xa9, 0xa5, 0xfb, 0xb3, 0x02, 0xb4, 0x74,
-	0x00, 0x07, 0x38, 0x1d, 0xff, 0xdf, 0xc3, 0xf7, 0x34, 0x54, 0xc3, 0xe1, 0x7c, 0x69, 0xa8, 0x84,
-	0x43, 0xcd, 0x70, 0xa1, 0x29, 0x59, 0xf6, 0xd8, 0xe6, 0x42, 0x64, 0x4b, 0xa6, 0x8d, 0xd1, 0x01,
-	0xd8, 0x80, 0x25, 0x92, 0xca, 0x28, 0xd1, 0x51, 0x4a, 0x48, 0xe2, 0x4f, 0x52, 0x3c, 0x32, 0xdd,
-	0xa6, 0xe4, 0x2d, 0x4e, 0x59, 0x7b, 0x24, 0xb9, 0xaf, 0x18, 0xca, 0x16, 0x1c, 0x04, 0x6c, 0x42,
-	0xa5, 0x32, 0xd3, 0x14, 0x84, 0x7a, 0x86, 0xf4, 0x42, 0x65, 0xcb, 0x24, 0x25, 0x42, 0xf1, 0x6a,
-	0x9a, 0x57, 0x51, 0xa4, 0x61, 0x08, 0x66, 0x5a, 0x73, 0xdd, 0x30, 0x14, 0xd9, 0x0b, 0x6f, 0x7c,
-	0x58, 0x80, 0x4a, 0x9f, 0x77, 0x59, 0x48, 0x50, 0x15, 0x8a, 0xfb, 0x8c, 0x3b, 0x0b, 0x68, 0x11,
-	0x1a, 0x7d, 0x7e, 0x97, 0xc8, 0xec, 0x2b, 0xd5, 0xf9, 0x7b, 0x15, 0x39, 0x60, 0xf7, 0xf9, 0x81,
-	0xc8, 0x52, 0xd0, 0xf9, 0x47, 0x15, 0xd9, 0x4a, 0xef, 0x20, 0xa2, 0x23, 0xe7, 0xd3, 0x36, 0x6a,
-	0x40, 0xb5, 0xcf, 0xdf, 0x8d, 0x27, 0xe9, 0xb1, 0xf3, 0xeb, 0xb6, 0xd1, 0x9f, 0x7d, 0xd9, 0x38,
-	0xbf, 0x69, 0xa3, 0x16, 0xd4, 0xfb, 0xbc, 0x47, 0x53, 0x4e, 0x02, 0xe9, 0xfc, 0xb6, 0x8d, 0x96,
-	0xa1, 0xdd, 0xe7, 0x5b, 0x61, 0xf8, 0x2e, 0x9e, 0xc4, 0xf2, 0x40, 0x4b, 0xfd, 0xae, 0x8d, 0x9a,
-	0x50, 0xeb, 0xf3, 0x6d, 0x1c, 0x8c, 0x27, 0xdc, 0xf9, 0x7d, 0xdb, 0x1c, 0x3a, 0x10, 0x38, 0x20,
-	0x87, 0x1c, 0x53, 0xe7, 0x0f, 0x6d, 0xb4, 0x04, 0xad, 0x3e, 0x3f, 0x94, 0x4c, 0xe0, 0x11, 0xd1,
-	0x01, 0x71, 0xfe, 0xd8, 0x46, 0x4f, 0x03, 0xea, 0xf3, 0xbb, 0x31, 0x1b, 0xe2, 0x78, 0xee, 0xd0,
-	0x3f, 0xb5, 0xd1, 0x35, 0x58, 0x54, 0x87, 0x4a, 0x22, 0x02, 0xc2, 0x65, 0x66, 0xfa, 0x9f, 0xdb,
-	0x08, 0x41, 0x53, 0xb9, 0xac, 0x48, 0x7d, 0x13, 0xce, 0x5f, 0xda, 0x37, 0x7e, 0x6e, 0x41, 0x7d,
-	0x3a, 0x7c, 0x20, 0x1b, 0xaa, 0x3d, 0x7a, 0x82, 0xe3, 0x28, 0x74, 0x16, 0x50, 0x13, 0xea, 0xd3,
-	0x11, 0xc3, 0xb1, 0x50, 0x0b, 0x60, 0x36, 0x35, 0x38, 0x05, 0xd4, 0x06, 0x7b, 0x6e, 0x0c, 0x30,
-	0x9f, 0x7a, 0xf7, 0xe7, 0x3b, 0xb9, 0x53, 0x42, 0xcb, 0xe0, 0xe4, 0x50, 0xde, 0xaf, 0x9d, 0x32,
-	0x72, 0xa0, 0x71, 0x7f, 0xae, 0xeb, 0x3a, 0x15, 0x85, 0xcc, 0xf7, 0x54, 0x47, 0x05, 0xbe, 0x31,
-	0x6d, 0x92, 0xea, 0xbc, 0xda, 0x8d, 0xbb, 0x50, 0x9f, 0xd6, 0x76, 0x54, 0x83, 0xd2, 0xd6, 0x44,
-	0x32, 0x63, 0xe5, 0x3e, 0x33, 0xdf, 0x96, 0xa9, 0x63, 0xa1, 0x06, 0xd4, 0xb6, 0xa3, 0x91, 0x31,
-	0xa9, 0x80, 0x96, 0xa0, 0xdd, 0x65, 0x54, 0x46, 0x74, 0xc2, 0x26, 0xa9, 0xfe, 0x67, 0xc0, 0x29,
-	0x6e, 0xbf, 0xf5, 0xc9, 0xe3, 0x55, 0xeb, 0xd3, 0xc7, 0xab, 0xd6, 0x67, 0x8f, 0x57, 0x17, 0x3e,
-	0xfa, 0xdb, 0xaa, 0xf5, 0xfe, 0xab, 0x73, 0x7f, 0xfe, 0x25, 0x58, 0x8a, 0xe8, 0x8c, 0x89, 0x68,
-	0x14, 0xd1, 0x9c, 0xa0, 0xe4, 0x16, 0x1f, 0x8f, 0x6e, 0xf1, 0xe1, 0x2d, 0xcc, 0xa3, 0x61, 0x45,
-	0xff, 0xcb, 0xf7, 0xda, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x44, 0xd8, 0xa2, 0x43, 0x14,
-	0x00, 0x00,
+	// 2421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xe7, 0xec, 0x7b, 0x6b, 0xf6, 0x31, 0x6c, 0xc9, 0xf2, 0x9a, 0xf6, 0x5f, 0xe2, 0x7f, 0xec,
+	0xd8, 0xf4, 0x43, 0x14, 0x42, 0x3b, 0x89, 0x6d, 0x18, 0x36, 0xf8, 0xb0, 0xc5, 0x4d, 0x44, 0x2d,
+	0x33, 0x5c, 0xd9, 0x80, 0x11, 0x60, 0xd0, 0x3b, 0xd3, 0x5c, 0x8e, 0x76, 0xa6, 0x7b, 0xd4, 0xd3,
+	0xcb, 0x87, 0xaf, 0x89, 0xbf, 0x40, 0x6e, 0xb9, 0xd9, 0xa7, 0x1c, 0x72, 0x0b, 0x72, 0x0c, 0x72,
+	0xf6, 0xd1, 0x41, 0xde, 0x09, 0x10, 0x18, 0x0e, 0x10, 0x24, 0xc8, 0x97, 0x08, 0xba, 0x7a, 0x66,
+	0x77, 0x49, 0x29, 0x92, 0x1c, 0x04, 0xf0, 0x65, 0xd1, 0xf5, 0xab, 0xc7, 0x54, 0x75, 0x57, 0x57,
+	0x55, 0x2f, 0x34, 0x69, 0x1a, 0xad, 0xa7, 0x52, 0x28, 0x41, 0xca, 0x34, 0x8d, 0x56, 0xae, 0x8f,
+	0x23, 0x75, 0x34, 0x1d, 0xad, 0x07, 0x22, 0xb9, 0x31, 0x16, 0x63, 0x71, 0x03, 0x79, 0xa3, 0xe9,
+	0x21, 0x52, 0x48, 0xe0, 0xca, 0xe8, 0xac, 0x74, 0x55, 0x94, 0xb0, 0x4c, 0xd1, 0x24, 0xcd, 0x01,
+	0x48, 0x63, 0xca, 0xcd, 0xda, 0xfd, 0x0e, 0xb4, 0x87, 0xb7, 0xf7, 0x23, 0x3e, 0xf6, 0xd8, 0xbd,
+	0x29, 0xcb, 0x14, 0x79, 0x06, 0x9a, 0x29, 0x95, 0x34, 0x61, 0x8a, 0xc9, 0x9e, 0xb5, 0x6a, 0xad,
+	0x35, 0xbd, 0x39, 0xf0, 0x66, 0xe3, 0x93, 0x4f, 0xaf, 0x59, 0x5f, 0x7c, 0x7a, 0x6d, 0xc9, 0xfd,
+	0x85, 0x05, 0x9d, 0x42, 0x33, 0x4b, 0x05, 0xcf, 0x18, 0xe9, 0x41, 0x3d, 0x53, 0x42, 0xb2, 0xfe,
+	0x4e, 0xae, 0x58, 0x90, 0xe4, 0x79, 0xe8, 0x64, 0x4c, 0x1e, 0x47, 0x01, 0xdb, 0x0c, 0x43, 0xc9,
+	0xb2, 0xac, 0x57, 0x42, 0x81, 0x0b, 0x28, 0x5a, 0x38, 0xa2, 0x32, 0xec, 0xef, 0xf4, 0xca, 0xab,
+	0xd6, 0x5a, 0xc5, 0x2b, 0x48, 0xed, 0x96, 0x64, 0x69, 0x1c, 0x05, 0xb4, 0xbf, 0xd3, 0xab, 0x20,
+	0x6f, 0x0e, 0x90, 0xab, 0x00, 0xb1, 0x18, 0x1f, 0xe4, 0xaa, 0x55, 0x64, 0x2f, 0x20, 0x0b, 0x6e,
+	0xbf, 0x09, 0xce, 0xf0, 0xf6, 0x81, 0x92, 0x8b, 0x7e, 0xa3, 0x6d, 0x35, 0x95, 0xfc, 0x40, 0xcd,
+	0x42, 0x9e, 0x01, 0x0b, 0xba, 0x3f, 0xb3, 0xa0, 0xf6, 0x3e, 0x0b, 0x94, 0x90, 0x84, 0x40, 0x25,
+	0xa4, 0x8a, 0xa2, 0x74, 0xcb, 0xc3, 0x35, 0xb9, 0x0a, 0x15, 0x75, 0x96, 0x32, 0x0c, 0xcd, 0xde,
+	0x80, 0x75, 0xdc, 0xe5, 0xe1, 0x59, 0xca, 0x3c, 0xc4, 0xc9, 0x0a, 0x34, 0xf8, 0x34, 0x8e, 0xe9,
+	0x28, 0x66, 0x18, 0x5d, 0xc3, 0x9b, 0xd1, 0xc4, 0x81, 0x32, 0xcf, 0x52, 0x0c, 0xac, 0xe5, 0xe9,
+	0x25, 0x79, 0x0a, 0x1a, 0x51, 0xe6, 0x07, 0x82, 0x67, 0x0a, 0x03, 0x6a, 0x78, 0xf5, 0x28, 0xdb,
+	0xd6, 0xa4, 0x16, 0x8e, 0x19, 0xef, 0xd5, 0x56, 0xad, 0xb5, 0xb6, 0xa7, 0x97, 0xda, 0x1d, 0x2a,
+	0x19, 0xed, 0xd5, 0x8d, 0x3b, 0x7a, 0xed, 0x7e, 0x17, 0xaa, 0x5b, 0x54, 0x05, 0x47, 0x64, 0x05,
+	0xaa, 0x54, 0x29, 0x99, 0xf5, 0xac, 0xd5, 0xf2, 0x5a, 0x73, 0xab, 0xf2, 0xd9, 0x5f, 0xaf, 0x2d,
+	0x79, 0x06, 0x22, 0xdf, 0x80, 0xca, 0x31, 0x0b, 0xf4, 0x71, 0x94, 0xd7, 0xec, 0x0d, 0x7b, 0x5d,
+	0x67, 0x9a, 0x09, 0x31, 0x97, 0x43, 0xb6, 0xfb, 0x3e, 0xd4, 0x87, 0xda, 0xcf, 0xfe, 0x0e, 0xb9,
+	0x04, 0xd5, 0x70, 0xe4, 0x47, 0x21, 0x86, 0x5e, 0xf1, 0x2a, 0xe1, 0xa8, 0x1f, 0x6a, 0x50, 0x21,
+	0x58, 0x32, 0xa0, 0xd2, 0xe0, 0xff, 0x43, 0x2b, 0xa5, 0x52, 0x45, 0x2a, 0x12, 0x5c, 0xf3, 0xcc,
+	0x89, 0xda, 0x33, 0xac, 0x1f, 0xba, 0x3f, 0xb6, 0xa0, 0x73, 0x70, 0xc6, 0x83, 0x5b, 0x62, 0x3c,
+	0xa4, 0x51, 0xec, 0xb1, 0x7b, 0xe4, 0x3a, 0xd4, 0x03, 0xee, 0x1f, 0xd1, 0x63, 0x86, 0x5f, 0xb0,
+	0x37, 0x2e, 0xaf, 0xcf, 0xf3, 0x77, 0x58, 0xac, 0xbc, 0x5a, 0xc0, 0x77, 0xe9, 0x31, 0xcb, 0xc5,
+	0x4f, 0x28, 0x57, 0xbd, 0xd2, 0xc3, 0xc5, 0x3f, 0xa0, 0x5c, 0x11, 0x17, 0xaa, 0x6a, 0x76, 0x00,
+	0xf6, 0x46, 0x0b, 0x03, 0xce, 0x43, 0xf3, 0x0c, 0xcb, 0xfd, 0x01, 0x74, 0xcf, 0xf9, 0x94, 0xa5,
+	0x3a, 0x94, 0x60, 0x92, 0xfa, 0xb1, 0x08, 0xa8, 0xf6, 0x3c, 0x4f, 0x12, 0x3b, 0x98, 0xa4, 0xb7,
+	0x72, 0x88, 0x3c, 0x0f, 0x8d, 0x40, 0x24, 0x09, 0xe5, 0x61, 0xb1, 0x9b, 0x80, 0xc6, 0xdf, 0xe5,
+	0x4a, 0x9e, 0x79, 0x33, 0x9e, 0xfb, 0x36, 0x2c, 0xef, 0x4b, 0xa6, 0xc9, 0x48, 0x7d, 0x20, 0x23,
+	0xc5, 0xb6, 0x93, 0x90, 0xbc, 0x08, 0xc0, 0xb4, 0x9c, 0x1f, 0x47, 0x99, 0xea, 0x59, 0xf7, 0xa9,
+	0x37, 0x91, 0x7b, 0x2b, 0xca, 0x94, 0xfb, 0xaf, 0x12, 0x54, 0x11, 0x24, 0xaf, 0x16, 0x4a, 0x98,
+	0x75, 0xda, 0xa5, 0xce, 0xc6, 0xe5, 0xb9, 0x92, 0xf9, 0xc5, 0xfc, 0x6b, 0xb2, 0x62, 0xa9, 0xd3,
+	0x0a, 0xa3, 0x9c, 0x1f, 0x56, 0x1d, 0xe9, 0x7e, 0x48, 0xae, 0x81, 0xad, 0xf3, 0x78, 0x44, 0x33,
+	0x36, 0x3f, 0x2e, 0x28, 0xa0, 0x7e, 0x48, 0xfe, 0x0f, 0xc0, 0xe8, 0x72, 0x9a, 0x30, 0xcc, 0xd5,
+	0xa6, 0xd7, 0x44, 0xe4, 0x36, 0x4d, 0x18, 0x79, 0x16, 0xda, 0x33, 0x7d, 0x94, 0xa8, 0xa2, 0x44,
+	0xab, 0x00, 0x51, 0xe8, 0x69, 0x68, 0x1e, 0x46, 0x85, 0x89, 0x1a, 0x0a, 0x34, 0x34, 0x80, 0xcc,
+	0x67, 0xa0, 0x3c, 0xa2, 0x0a, 0xb3, 0xb8, 0x88, 0x1f, 0x53, 0xd8, 0xd3, 0x30, 0x79, 0x16, 0x3a,
+	0xe9, 0xc4, 0x0f, 0x8e, 0x58, 0x30, 0xf1, 0x47, 0x67, 0xbe, 0xe2, 0xbd, 0xc6, 0xaa, 0xb5, 0x56,
+	0xf5, 0xec, 0x74, 0xb2, 0xad, 0xc1, 0xad, 0xb3, 0x21, 0x77, 0xf7, 0xa0, 0x39, 0x8b, 0x9b, 0x00,
+	0xd4, 0xfa, 0x3c, 0x63, 0x52, 0x39, 0x4b, 0x7a, 0xbd, 0xc3, 0x62, 0xa6, 0x98, 0x63, 0xe9, 0xf5,
+	0x9d, 0x34, 0xa4, 0x8a, 0x39, 0x25, 0xd2, 0x84, 0xea, 0x66, 0xac, 0x98, 0x74, 0xca, 0x64, 0x19,
+	0xda, 0x07, 0x29, 0x0b, 0x22, 0x1a, 0xe7, 0x92, 0x15, 0xf7, 0x47, 0x16, 0x00, 0x1a, 0x4f, 0x45,
+	0xc4, 0x15, 0x79, 0x19, 0x6a, 0x49, 0xc4, 0x7d, 0x95, 0x3d, 0x34, 0x37, 0xab, 0x49, 0xc4, 0x87,
+	0x19, 0x0a, 0xd3, 0x53, 0x2d, 0x5c, 0x7a, 0xa8, 0x30, 0x3d, 0x1d, 0x66, 0x45, 0xe8, 0xe5, 0x07,
+	0x86, 0x6e, 0xdc, 0xa0, 0x8a, 0xc6, 0x62, 0xbc, 0x3d, 0x49, 0xbf, 0x36, 0x37, 0x3e, 0xb6, 0xc0,
+	0xde, 0x63, 0x8a, 0xea, 0x13, 0xfd, 0x3a, 0xfd, 0xf8, 0x79, 0x19, 0x1c, 0x3c, 0x34, 0xbc, 0xb9,
+	0xfb, 0x22, 0x8e, 0x82, 0x33, 0xf2, 0x0a, 0x10, 0xed, 0x8c, 0x14, 0x27, 0x99, 0x7f, 0x6f, 0x4a,
+	0xa3, 0x38, 0x3a, 0x64, 0xa6, 0x4a, 0xb5, 0x3d, 0x27, 0x89, 0xb8, 0x27, 0x4e, 0xb2, 0xef, 0x17,
+	0x38, 0x79, 0x0e, 0x3a, 0xda, 0x1b, 0x31, 0xba, 0xeb, 0x0b, 0xce, 0xe4, 0x94, 0xa3, 0x57, 0x6d,
+	0xaf, 0x95, 0xd0, 0xd3, 0xc1, 0xe8, 0xee, 0x00, 0x31, 0x72, 0x1d, 0x2e, 0x69, 0x29, 0xb4, 0x99,
+	0x30, 0x39, 0x66, 0xa1, 0xd6, 0xe8, 0x95, 0x73, 0xa3, 0xf4, 0x54, 0x1b, 0xdd, 0x43, 0xc6, 0x60,
+	0x74, 0x97, 0x3c, 0x07, 0xd5, 0xa3, 0x88, 0xab, 0xac, 0x57, 0x59, 0x2d, 0xaf, 0x75, 0x36, 0x3a,
+	0xe8, 0x37, 0xb2, 0x77, 0x23, 0xae, 0x3c, 0xc3, 0x24, 0x2f, 0xc2, 0xb2, 0x76, 0x34, 0xe0, 0xc6,
+	0xa4, 0x9f, 0x45, 0x1f, 0xb1, 0xbc, 0x67, 0x75, 0x92, 0x88, 0x6f, 0x73, 0xd4, 0x38, 0x88, 0x3e,
+	0x62, 0x3a, 0x26, 0x45, 0xe5, 0x98, 0x29, 0xfd, 0x59, 0x16, 0x28, 0x23, 0x5b, 0x43, 0x59, 0xc7,
+	0x70, 0x06, 0xc8, 0x40, 0xe9, 0xa7, 0xa1, 0xa9, 0xbd, 0x8d, 0xd9, 0x31, 0x8b, 0xf1, 0x12, 0xb5,
+	0xbd, 0x46, 0x42, 0x4f, 0x6f, 0x69, 0x5a, 0xb7, 0x48, 0xc9, 0x84, 0x1c, 0x53, 0xae, 0x4d, 0x34,
+	0xb0, 0xa3, 0x2c, 0x20, 0xfa, 0xf6, 0xeb, 0xf3, 0xf0, 0x03, 0x11, 0x4f, 0x13, 0xde, 0x6b, 0xe2,
+	0xd5, 0x04, 0x0d, 0x6d, 0x23, 0x32, 0x13, 0x38, 0x89, 0x78, 0x28, 0x4e, 0x7a, 0xb0, 0x6a, 0xad,
+	0x95, 0x8d, 0xc0, 0x07, 0x88, 0xe8, 0xf2, 0x90, 0x31, 0x1a, 0xfb, 0xf4, 0x50, 0x8f, 0x0e, 0x36,
+	0xf2, 0x9b, 0x1a, 0xd9, 0xd4, 0x80, 0xfb, 0x3a, 0x5c, 0x9e, 0x9f, 0x19, 0x36, 0x32, 0x49, 0xf5,
+	0x9d, 0x5a, 0x05, 0x3b, 0x98, 0x51, 0x59, 0xde, 0x51, 0x17, 0x21, 0xf7, 0x3a, 0x2c, 0x2f, 0x6a,
+	0x26, 0x09, 0xe3, 0x4a, 0x8f, 0x0a, 0x81, 0x59, 0x16, 0xc3, 0x46, 0x4e, 0xba, 0x7b, 0xf0, 0xc4,
+	0x5c, 0xdc, 0x63, 0xba, 0xd2, 0xe0, 0x52, 0xd7, 0x3e, 0x11, 0x87, 0xa6, 0xf4, 0xe4, 0x3a, 0x22,
+	0x0e, 0xb1, 0xf2, 0x3c, 0x05, 0x0d, 0xce, 0x4e, 0x0c, 0xcb, 0x8c, 0x26, 0x75, 0xce, 0x4e, 0x34,
+	0xcb, 0xe5, 0x70, 0xe9, 0xa2, 0xb9, 0x6d, 0x11, 0xff, 0x77, 0xc6, 0x74, 0x23, 0xc9, 0xf4, 0xa0,
+	0xc5, 0x03, 0xe6, 0xf3, 0x69, 0x92, 0x67, 0x92, 0x5d, 0x60, 0xb7, 0xa7, 0x89, 0x1b, 0x2e, 0x7e,
+	0x6f, 0x33, 0x0c, 0xf3, 0xed, 0x7f, 0x0e, 0x6a, 0xf9, 0xd1, 0x58, 0x79, 0xeb, 0xc2, 0xf9, 0x62,
+	0x5b, 0xc4, 0x3b, 0xec, 0xd0, 0xcb, 0x79, 0xe4, 0x05, 0xe8, 0x46, 0x58, 0xf1, 0xfc, 0x54, 0x64,
+	0xd8, 0x65, 0xd1, 0x83, 0xaa, 0xd7, 0x31, 0xf0, 0x7e, 0x8e, 0xba, 0x07, 0x70, 0xe5, 0xdc, 0x57,
+	0xf6, 0x8b, 0xae, 0x4c, 0xde, 0x80, 0xf6, 0xbc, 0x6d, 0x87, 0xec, 0x70, 0x76, 0xb7, 0xf1, 0x7b,
+	0x33, 0xb9, 0xad, 0x33, 0xfd, 0xdd, 0x79, 0x87, 0xdf, 0x61, 0x87, 0xee, 0x87, 0x8b, 0x47, 0xbc,
+	0x23, 0x45, 0x3a, 0x4f, 0x9d, 0x58, 0x8c, 0xa3, 0x80, 0xc6, 0x7e, 0x14, 0x9e, 0xe6, 0x77, 0x12,
+	0x72, 0xa8, 0x1f, 0x9e, 0xde, 0xb7, 0x2d, 0xa5, 0xfb, 0xb7, 0xe5, 0xef, 0x15, 0x68, 0x2f, 0x9e,
+	0xc3, 0xbd, 0x73, 0xad, 0xcc, 0x3a, 0xdf, 0xca, 0x66, 0x43, 0x4a, 0x69, 0x61, 0x48, 0x71, 0xa1,
+	0x32, 0x89, 0xb8, 0x69, 0x6c, 0xc5, 0xe5, 0x44, 0x8b, 0xdf, 0x8b, 0x78, 0xe8, 0x21, 0x8f, 0xbc,
+	0x01, 0x40, 0xc3, 0xb0, 0xb8, 0x04, 0x15, 0x8c, 0xbc, 0x37, 0x97, 0x3c, 0x7f, 0x26, 0xbb, 0x4b,
+	0x5e, 0x93, 0x16, 0x04, 0x79, 0x0b, 0xec, 0x50, 0x8a, 0xb4, 0xd0, 0xad, 0xa2, 0xee, 0x53, 0x17,
+	0x74, 0xe7, 0x9b, 0xb2, 0xbb, 0xe4, 0x41, 0x38, 0xa3, 0xc8, 0x3b, 0xd0, 0x92, 0x98, 0x5b, 0xbe,
+	0x99, 0x4f, 0x6a, 0xa8, 0xbe, 0x72, 0x41, 0x7d, 0x21, 0x9b, 0x77, 0x97, 0x3c, 0x5b, 0xce, 0x49,
+	0xf2, 0x0e, 0x74, 0xa6, 0xd8, 0xd3, 0xfc, 0xe2, 0x5a, 0x98, 0x36, 0x7a, 0xe5, 0x82, 0x89, 0xfc,
+	0xfe, 0xec, 0x2e, 0x79, 0x6d, 0x23, 0x9f, 0x03, 0xda, 0xff, 0xc2, 0x40, 0xa6, 0x64, 0xaf, 0xf1,
+	0x40, 0xff, 0xe7, 0xf7, 0x56, 0xfb, 0x9f, 0x1b, 0xc8, 0x94, 0x24, 0x6f, 0x41, 0x6e, 0xce, 0x4f,
+	0xb1, 0x1c, 0x63, 0x01, 0xb1, 0x37, 0x9e, 0xb8, 0xa0, 0x6f, 0x6a, 0xf5, 0xee, 0x92, 0xd7, 0x32,
+	0xd2, 0x86, 0x26, 0x5b, 0xd0, 0xd6, 0xdb, 0x3e, 0x4b, 0x26, 0xac, 0x2e, 0xf6, 0xc6, 0xd3, 0xf7,
+	0xef, 0xfc, 0x2c, 0xff, 0xb4, 0x0d, 0x7a, 0x3e, 0x6f, 0x21, 0xdf, 0xc1, 0x40, 0xc4, 0x3d, 0xfb,
+	0x81, 0x47, 0x37, 0xbb, 0xbe, 0xfa, 0xe8, 0x64, 0x41, 0x6c, 0xd9, 0xd0, 0x14, 0x29, 0x93, 0x38,
+	0xc8, 0xb9, 0xbf, 0xac, 0x80, 0x7d, 0x10, 0x1c, 0xb1, 0x84, 0xbe, 0x7b, 0xaa, 0x24, 0x25, 0xcf,
+	0x43, 0x97, 0xb3, 0x53, 0xa5, 0xad, 0xfa, 0x19, 0xbb, 0xa7, 0xd3, 0xd3, 0x24, 0x70, 0x5b, 0xc3,
+	0xdb, 0x22, 0x3e, 0x40, 0x10, 0xc7, 0x1f, 0x29, 0xd2, 0x94, 0x85, 0xbe, 0x19, 0xb7, 0xf5, 0x14,
+	0xa8, 0xc7, 0x1f, 0x03, 0x6e, 0xe6, 0xf3, 0x76, 0xc7, 0xe4, 0x87, 0x1f, 0x1c, 0x51, 0x3e, 0x66,
+	0x61, 0xfe, 0x12, 0x68, 0x1b, 0x74, 0xdb, 0x80, 0xe7, 0x8a, 0x4b, 0xe5, 0x7c, 0x71, 0x79, 0x70,
+	0x9b, 0xab, 0x3e, 0x76, 0x9b, 0xab, 0x3d, 0x7e, 0x9b, 0xab, 0x3f, 0xaa, 0xcd, 0x35, 0xbe, 0x72,
+	0x9b, 0x6b, 0x7e, 0x85, 0x36, 0x07, 0x8f, 0xd3, 0xe6, 0xec, 0x87, 0xb6, 0xb9, 0xd6, 0xa3, 0xda,
+	0x5c, 0xfb, 0x51, 0x6d, 0xae, 0xf3, 0x88, 0x36, 0xd7, 0xbd, 0xd8, 0xe6, 0x42, 0x68, 0xf4, 0xb9,
+	0xfa, 0xf6, 0x6b, 0x7b, 0x34, 0x25, 0x2e, 0x58, 0x49, 0x3e, 0xcd, 0x9b, 0xc1, 0xbc, 0xe0, 0xac,
+	0xef, 0x99, 0xb9, 0xde, 0x4a, 0x56, 0x5e, 0x83, 0x9a, 0x21, 0xf4, 0xb3, 0x6e, 0xc2, 0xce, 0x30,
+	0xb9, 0xca, 0x9e, 0x5e, 0x92, 0xcb, 0x50, 0x3d, 0xa6, 0xf1, 0xd4, 0x74, 0x91, 0xb2, 0x67, 0x88,
+	0x37, 0x4b, 0xaf, 0x5b, 0xee, 0xfb, 0xd0, 0x1a, 0x4a, 0xca, 0xb3, 0x1d, 0x96, 0xe9, 0x9a, 0x4e,
+	0xae, 0x40, 0x4d, 0x8c, 0xee, 0xf6, 0xf3, 0xe2, 0x5a, 0xf5, 0x72, 0x4a, 0xe3, 0xa3, 0x78, 0xa2,
+	0x71, 0xd3, 0x06, 0x72, 0x4a, 0xe3, 0x52, 0x9c, 0x68, 0xbc, 0x6c, 0x70, 0x43, 0xb9, 0x3f, 0xb4,
+	0xc0, 0xde, 0x8a, 0x27, 0x68, 0x5b, 0x47, 0xf0, 0xf2, 0x3c, 0x82, 0x27, 0xcd, 0x14, 0x36, 0x67,
+	0xe6, 0x41, 0xe4, 0x0f, 0x45, 0x2b, 0x59, 0xb9, 0xf9, 0xa0, 0x50, 0xaa, 0x26, 0x94, 0x17, 0x16,
+	0x43, 0xb1, 0x37, 0x96, 0xcd, 0xc3, 0x6b, 0x21, 0x84, 0xc5, 0xe8, 0x76, 0x81, 0x14, 0xdf, 0x39,
+	0x64, 0x72, 0x4b, 0x88, 0x49, 0xc4, 0xc7, 0x64, 0x03, 0x1a, 0x09, 0x4d, 0xd3, 0x88, 0x8f, 0xb3,
+	0xdc, 0x25, 0xe7, 0xa2, 0x4b, 0xb9, 0x2f, 0x33, 0x39, 0xf7, 0x57, 0x25, 0x70, 0x30, 0xcf, 0xb6,
+	0xf1, 0xc1, 0x65, 0xbc, 0x7b, 0xe0, 0x13, 0xf6, 0x09, 0xa8, 0xa9, 0x51, 0x3c, 0xef, 0x19, 0x55,
+	0x35, 0x8a, 0xef, 0x7b, 0xf3, 0x94, 0x2f, 0xbe, 0x79, 0xbe, 0x05, 0x8d, 0x4c, 0x51, 0xa9, 0x7c,
+	0x1c, 0xfa, 0xfe, 0xe3, 0x58, 0x9b, 0xfb, 0x55, 0x47, 0xd9, 0x61, 0xa6, 0x93, 0x6c, 0x7e, 0xcf,
+	0xb2, 0x5e, 0x75, 0xb5, 0xbc, 0xd6, 0xf2, 0x20, 0x29, 0x6e, 0x58, 0x86, 0x0f, 0x4e, 0xc9, 0xa8,
+	0x2a, 0x24, 0x6a, 0x28, 0x61, 0xe7, 0x18, 0x8a, 0x7c, 0x13, 0xea, 0x23, 0xb3, 0x33, 0x79, 0xa5,
+	0x3f, 0x7f, 0x40, 0xf3, 0x8d, 0xf3, 0x0a, 0x39, 0xfd, 0xd9, 0x7c, 0xa9, 0x9f, 0xb2, 0x58, 0xe2,
+	0x5b, 0x1e, 0xe4, 0xd0, 0x2d, 0x11, 0xe8, 0x73, 0x63, 0x52, 0xe6, 0xc3, 0x9f, 0x5e, 0xba, 0x3f,
+	0x29, 0x41, 0x07, 0x37, 0x70, 0x48, 0xb3, 0xc9, 0xff, 0x7c, 0xfb, 0x9e, 0x84, 0x7a, 0x38, 0x5a,
+	0x2c, 0x73, 0xb5, 0x70, 0x84, 0x0c, 0x17, 0xda, 0x4a, 0xe4, 0x85, 0x63, 0x61, 0x8b, 0x6c, 0x25,
+	0xd0, 0x19, 0xdc, 0x80, 0x75, 0xb8, 0xc4, 0x32, 0x15, 0x25, 0xb8, 0x4b, 0x09, 0x4b, 0xfc, 0x69,
+	0x46, 0xc7, 0xc5, 0x74, 0xbc, 0x3c, 0x63, 0xed, 0xb1, 0xe4, 0x8e, 0x66, 0x68, 0x5f, 0x68, 0x10,
+	0x88, 0x29, 0x57, 0xda, 0x4d, 0x53, 0xdc, 0x9a, 0x39, 0xd2, 0x0f, 0xb5, 0x2f, 0xd3, 0x8c, 0x49,
+	0xcd, 0x6b, 0x20, 0xaf, 0xa6, 0x49, 0xc3, 0x90, 0xc2, 0x8c, 0x19, 0x4d, 0xc3, 0xd0, 0x64, 0x3f,
+	0x7c, 0xe9, 0xe3, 0x12, 0xd4, 0x06, 0xe9, 0xb6, 0x08, 0x19, 0xa9, 0x43, 0xf9, 0xb6, 0x48, 0x9d,
+	0x25, 0xb2, 0x0c, 0xad, 0x41, 0x7a, 0x93, 0xa9, 0xfc, 0xdf, 0x03, 0xe7, 0x1f, 0x75, 0xe2, 0x80,
+	0x3d, 0x48, 0xf7, 0x65, 0x9e, 0x82, 0xce, 0x3f, 0xeb, 0xc4, 0xd6, 0x7a, 0xfa, 0xaf, 0x33, 0xe7,
+	0xf3, 0x2e, 0x69, 0x41, 0x7d, 0x90, 0xbe, 0x17, 0x4f, 0xb3, 0x23, 0xe7, 0xd7, 0x5d, 0xa3, 0x3f,
+	0x7f, 0x71, 0x3a, 0xbf, 0xe9, 0x92, 0x0e, 0x34, 0x07, 0x69, 0x9f, 0x67, 0x29, 0x0b, 0x94, 0xf3,
+	0xdb, 0x2e, 0xb9, 0x0c, 0xdd, 0x41, 0xba, 0x19, 0x86, 0xef, 0xd1, 0x69, 0xac, 0xf6, 0x51, 0xea,
+	0x77, 0x5d, 0xd2, 0x86, 0xc6, 0x20, 0xdd, 0xa2, 0xc1, 0x64, 0x9a, 0x3a, 0xbf, 0xef, 0x9a, 0x8f,
+	0x0e, 0x25, 0x0d, 0xd8, 0x41, 0x4a, 0xb9, 0xf3, 0x87, 0x2e, 0xb9, 0x04, 0x9d, 0x41, 0x7a, 0xa0,
+	0x84, 0xa4, 0x63, 0x86, 0x1b, 0xe2, 0xfc, 0xb1, 0x4b, 0x9e, 0x04, 0x32, 0x48, 0x6f, 0xc6, 0x62,
+	0x44, 0xe3, 0x85, 0x8f, 0xfe, 0xa9, 0x4b, 0xae, 0xc0, 0xb2, 0xfe, 0xa8, 0x62, 0x32, 0x60, 0xa9,
+	0xca, 0x5d, 0xff, 0x73, 0x97, 0x10, 0x68, 0x0f, 0x52, 0x43, 0xe2, 0x49, 0x38, 0x7f, 0xe9, 0xbe,
+	0xf4, 0x53, 0x0b, 0x9a, 0xb3, 0x41, 0x8a, 0xd8, 0x50, 0xef, 0xf3, 0x63, 0x1a, 0x47, 0xa1, 0xb3,
+	0x44, 0xda, 0xd0, 0x9c, 0x8d, 0x4b, 0x8e, 0x45, 0x3a, 0x00, 0xf3, 0x09, 0xc8, 0x29, 0x91, 0x2e,
+	0xd8, 0x0b, 0x23, 0x8d, 0x79, 0x82, 0xdf, 0x59, 0x9c, 0x4a, 0x9c, 0x0a, 0xb9, 0x0c, 0x4e, 0x01,
+	0x15, 0xb3, 0x87, 0x53, 0x25, 0x0e, 0xb4, 0xee, 0x2c, 0x4c, 0x10, 0x4e, 0x4d, 0x23, 0x8b, 0xf3,
+	0x81, 0xa3, 0x37, 0xbe, 0x35, 0x6b, 0xf8, 0xfa, 0x7b, 0x8d, 0x97, 0x6e, 0x42, 0x73, 0xd6, 0xa7,
+	0x48, 0x03, 0x2a, 0x9b, 0x53, 0x25, 0x8c, 0x97, 0xb7, 0x85, 0x79, 0xf3, 0x67, 0x8e, 0x45, 0x5a,
+	0xd0, 0xd8, 0x8a, 0xc6, 0xc6, 0xa5, 0x12, 0xb9, 0x04, 0xdd, 0x6d, 0xc1, 0x55, 0xc4, 0xa7, 0x62,
+	0x9a, 0xe1, 0x3f, 0x36, 0x4e, 0x79, 0xeb, 0xed, 0xcf, 0xbe, 0xbc, 0x6a, 0x7d, 0xfe, 0xe5, 0x55,
+	0xeb, 0x8b, 0x2f, 0xaf, 0x2e, 0x7d, 0xf2, 0xb7, 0xab, 0xd6, 0x87, 0xaf, 0x2c, 0xfc, 0x29, 0x9b,
+	0x50, 0x25, 0xa3, 0x53, 0x21, 0xa3, 0x71, 0xc4, 0x0b, 0x82, 0xb3, 0x1b, 0xe9, 0x64, 0x7c, 0x23,
+	0x1d, 0xdd, 0xa0, 0x69, 0x34, 0xaa, 0xe1, 0xbf, 0xaf, 0xaf, 0xfe, 0x7b, 0x00, 0xe1, 0x06, 0x51,
+	0xaf, 0xdb, 0x15, 0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3184,6 +3292,43 @@ func (m *AlterTablePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SealAfter != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SealAfter))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeWindow != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TimeWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TimeColumn) > 0 {
+		i -= len(m.TimeColumn)
+		copy(dAtA[i:], m.TimeColumn)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TimeColumn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Reorganize {
+		i--
+		if m.Reorganize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxLevel != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxLevel))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TargetObjectSize != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TargetObjectSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
@@ -3743,6 +3888,43 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SealAfter != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SealAfter))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TimeWindow != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TimeWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TimeColumn) > 0 {
+		i -= len(m.TimeColumn)
+		copy(dAtA[i:], m.TimeColumn)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TimeColumn)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Reorganize {
+		i--
+		if m.Reorganize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxLevel != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxLevel))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetObjectSize != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TargetObjectSize))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
@@ -4522,6 +4704,25 @@ func (m *AlterTablePolicy) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	if m.TargetObjectSize != 0 {
+		n += 1 + sovApi(uint64(m.TargetObjectSize))
+	}
+	if m.MaxLevel != 0 {
+		n += 1 + sovApi(uint64(m.MaxLevel))
+	}
+	if m.Reorganize {
+		n += 2
+	}
+	l = len(m.TimeColumn)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TimeWindow != 0 {
+		n += 1 + sovApi(uint64(m.TimeWindow))
+	}
+	if m.SealAfter != 0 {
+		n += 1 + sovApi(uint64(m.SealAfter))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4817,6 +5018,25 @@ func (m *SchemaExtra) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	if m.TargetObjectSize != 0 {
+		n += 1 + sovApi(uint64(m.TargetObjectSize))
+	}
+	if m.MaxLevel != 0 {
+		n += 1 + sovApi(uint64(m.MaxLevel))
+	}
+	if m.Reorganize {
+		n += 2
+	}
+	l = len(m.TimeColumn)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TimeWindow != 0 {
+		n += 1 + sovApi(uint64(m.TimeWindow))
+	}
+	if m.SealAfter != 0 {
+		n += 1 + sovApi(uint64(m.SealAfter))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7083,6 +7303,134 @@ func (m *AlterTablePolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectSize", wireType)
+			}
+			m.TargetObjectSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetObjectSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevel", wireType)
+			}
+			m.MaxLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorganize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reorganize = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			m.TimeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAfter", wireType)
+			}
+			m.SealAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8469,6 +8817,134 @@ func (m *SchemaExtra) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectSize", wireType)
+			}
+			m.TargetObjectSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetObjectSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevel", wireType)
+			}
+			m.MaxLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorganize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reorganize = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			m.TimeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAfter", wireType)
+			}
+			m.SealAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
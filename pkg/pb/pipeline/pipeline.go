@@ -41,6 +41,10 @@ func (m *Message) SetCheckSum(sum uint32) {
 	m.Checksum = sum
 }
 
+func (m *Message) SetQueryMemory(nb uint64) {
+	m.QueryMemory = nb
+}
+
 func (m *Message) SetSequence(s uint64) {
 	m.Sequence = s
 }
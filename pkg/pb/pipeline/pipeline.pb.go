@@ -161,6 +161,7 @@ type Message struct {
 	BatchCnt             uint64   `protobuf:"varint,9,opt,name=batch_cnt,json=batchCnt,proto3" json:"batch_cnt,omitempty"`
 	Checksum             uint32   `protobuf:"varint,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	Sequence             uint64   `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	QueryMemory          uint64   `protobuf:"varint,12,opt,name=query_memory,json=queryMemory,proto3" json:"query_memory,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,6 +277,13 @@ func (m *Message) GetSequence() uint64 {
 	return 0
 }
 
+func (m *Message) GetQueryMemory() uint64 {
+	if m != nil {
+		return m.QueryMemory
+	}
+	return 0
+}
+
 type Connector struct {
 	PipelineId           int32    `protobuf:"varint,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
 	ConnectorIndex       int32    `protobuf:"varint,2,opt,name=connector_index,json=connectorIndex,proto3" json:"connector_index,omitempty"`
@@ -3934,6 +3942,7 @@ type Source struct {
 	TableDef               *plan.TableDef            `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
 	Timestamp              *timestamp.Timestamp      `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	RuntimeFilterProbeList []*plan.RuntimeFilterSpec `protobuf:"bytes,10,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	LateMaterialize        bool                      `protobuf:"varint,11,opt,name=late_materialize,json=lateMaterialize,proto3" json:"late_materialize,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
 	XXX_unrecognized       []byte                    `json:"-"`
 	XXX_sizecache          int32                     `json:"-"`
@@ -4042,6 +4051,13 @@ func (m *Source) GetRuntimeFilterProbeList() []*plan.RuntimeFilterSpec {
 	return nil
 }
 
+func (m *Source) GetLateMaterialize() bool {
+	if m != nil {
+		return m.LateMaterialize
+	}
+	return false
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -4127,6 +4143,9 @@ type ProcessLimitation struct {
 	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
 	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	QueryMemory          int64    `protobuf:"varint,6,opt,name=query_memory,json=queryMemory,proto3" json:"query_memory,omitempty"`
+	ExecutionDeadline    int64    `protobuf:"varint,7,opt,name=execution_deadline,json=executionDeadline,proto3" json:"execution_deadline,omitempty"`
+	QueryMemoryHeld      int64    `protobuf:"varint,8,opt,name=query_memory_held,json=queryMemoryHeld,proto3" json:"query_memory_held,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4200,6 +4219,27 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetQueryMemory() int64 {
+	if m != nil {
+		return m.QueryMemory
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetExecutionDeadline() int64 {
+	if m != nil {
+		return m.ExecutionDeadline
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetQueryMemoryHeld() int64 {
+	if m != nil {
+		return m.QueryMemoryHeld
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Sql                  string             `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
@@ -4753,289 +4793,293 @@ func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
 	// 4543 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x8f, 0x24, 0xc7,
-	0x52, 0xee, 0xef, 0xea, 0xe8, 0x9e, 0x99, 0x9e, 0xdc, 0xaf, 0xf6, 0x7a, 0xbd, 0x3b, 0x5b, 0xf6,
-	0xda, 0xe3, 0xb5, 0x77, 0xd6, 0x1e, 0x63, 0x78, 0xe2, 0x61, 0xfc, 0x66, 0x67, 0xd6, 0x8f, 0xc6,
-	0xbb, 0xb3, 0x43, 0xce, 0xac, 0x2c, 0x7c, 0xa0, 0xa8, 0xa9, 0xca, 0xee, 0x29, 0x4f, 0x75, 0x65,
-	0x6d, 0x7d, 0xec, 0xce, 0xec, 0x89, 0x0b, 0x17, 0x24, 0x4e, 0x4f, 0x02, 0x21, 0x04, 0x42, 0x48,
-	0x1c, 0x38, 0x20, 0x21, 0x10, 0x77, 0x8e, 0x9c, 0x10, 0x42, 0xdc, 0x41, 0xe6, 0x2f, 0x20, 0x6e,
-	0x0f, 0xa1, 0x88, 0xcc, 0xac, 0xaa, 0xee, 0xe9, 0x59, 0x7f, 0x60, 0x61, 0x4b, 0xcf, 0xb7, 0xcc,
-	0x88, 0xc8, 0xac, 0xcc, 0xf8, 0xca, 0xc8, 0x8c, 0x28, 0x58, 0x8e, 0x83, 0x58, 0x84, 0x41, 0x24,
-	0x36, 0xe2, 0x44, 0x66, 0x92, 0x59, 0xa6, 0x7f, 0xf5, 0xce, 0x24, 0xc8, 0x8e, 0xf2, 0xc3, 0x0d,
-	0x4f, 0x4e, 0xef, 0x4e, 0xe4, 0x44, 0xde, 0x25, 0x82, 0xc3, 0x7c, 0x4c, 0x3d, 0xea, 0x50, 0x4b,
-	0x0d, 0xbc, 0x0a, 0x71, 0xe8, 0x46, 0xba, 0xbd, 0x92, 0x05, 0x53, 0x91, 0x66, 0xee, 0x34, 0x36,
-	0xc8, 0x50, 0x7a, 0xc7, 0xaa, 0x6d, 0xff, 0x6d, 0x1d, 0x3a, 0x0f, 0x45, 0x9a, 0xba, 0x13, 0xc1,
-	0x6c, 0x68, 0xa4, 0x81, 0x3f, 0xac, 0xad, 0xd5, 0xd6, 0x97, 0x37, 0x07, 0x1b, 0xc5, 0x5a, 0xf6,
-	0x33, 0x37, 0xcb, 0x53, 0x8e, 0x48, 0xa4, 0xf1, 0xa6, 0xfe, 0xb0, 0x3e, 0x4f, 0xf3, 0x50, 0x64,
-	0x47, 0xd2, 0xe7, 0x88, 0x64, 0x03, 0x68, 0x88, 0x24, 0x19, 0x36, 0xd6, 0x6a, 0xeb, 0x7d, 0x8e,
-	0x4d, 0xc6, 0xa0, 0xe9, 0xbb, 0x99, 0x3b, 0x6c, 0x12, 0x88, 0xda, 0xec, 0x75, 0x58, 0x8e, 0x13,
-	0xe9, 0x39, 0x41, 0x34, 0x96, 0x0e, 0x61, 0x5b, 0x84, 0xed, 0x23, 0x74, 0x14, 0x8d, 0xe5, 0x0e,
-	0x52, 0x0d, 0xa1, 0xe3, 0x46, 0x6e, 0x78, 0x9a, 0x8a, 0x61, 0x9b, 0xd0, 0xa6, 0xcb, 0x96, 0xa1,
-	0x1e, 0xf8, 0xc3, 0xce, 0x5a, 0x6d, 0xbd, 0xc9, 0xeb, 0x81, 0x8f, 0xdf, 0xc8, 0xf3, 0xc0, 0x1f,
-	0x5a, 0xea, 0x1b, 0xd8, 0x66, 0xaf, 0x40, 0xf7, 0xd0, 0xcd, 0xbc, 0x23, 0xc7, 0x8b, 0xb2, 0x61,
-	0x97, 0x48, 0x2d, 0x02, 0x6c, 0x47, 0x19, 0xbb, 0x0a, 0x96, 0x77, 0x24, 0xbc, 0xe3, 0x34, 0x9f,
-	0x0e, 0x61, 0xad, 0xb6, 0xbe, 0xc4, 0x8b, 0x3e, 0xe2, 0x52, 0xf1, 0x24, 0x17, 0x91, 0x27, 0x86,
-	0x3d, 0x35, 0xce, 0xf4, 0xed, 0xc7, 0xd0, 0xdd, 0x96, 0x51, 0x24, 0xbc, 0x4c, 0x26, 0xec, 0x06,
-	0xf4, 0x0c, 0x0f, 0x1c, 0xcd, 0xbb, 0x16, 0x07, 0x03, 0x1a, 0xf9, 0xec, 0x4d, 0x58, 0xf1, 0x0c,
-	0xb5, 0x13, 0x44, 0xbe, 0x38, 0x21, 0xe6, 0xb5, 0xf8, 0x72, 0x01, 0x1e, 0x21, 0xd4, 0xfe, 0xab,
-	0x3a, 0x74, 0xf6, 0x8f, 0xf2, 0xf1, 0x38, 0x14, 0xec, 0x75, 0x58, 0xd2, 0xcd, 0x6d, 0x19, 0x8e,
-	0xfc, 0x13, 0x3d, 0xef, 0x2c, 0x90, 0xad, 0x41, 0x4f, 0x03, 0x0e, 0x4e, 0x63, 0xa1, 0xa7, 0xad,
-	0x82, 0x66, 0xe7, 0x79, 0x18, 0x44, 0x24, 0x93, 0x06, 0x9f, 0x05, 0xce, 0x51, 0xb9, 0x27, 0x24,
-	0xa6, 0x59, 0x2a, 0x97, 0xbe, 0xb6, 0x15, 0x06, 0x4f, 0x05, 0x17, 0x93, 0xed, 0x28, 0x23, 0x61,
-	0xb5, 0x78, 0x15, 0xc4, 0x36, 0xe1, 0x52, 0xaa, 0x86, 0x38, 0x89, 0x1b, 0x4d, 0x44, 0xea, 0xe4,
-	0x41, 0x94, 0xfd, 0xf2, 0x2f, 0x0d, 0xdb, 0x6b, 0x8d, 0xf5, 0x26, 0xbf, 0xa0, 0x91, 0x9c, 0x70,
-	0x8f, 0x09, 0xc5, 0xde, 0x85, 0x8b, 0x73, 0x63, 0xd4, 0x90, 0xce, 0x5a, 0x63, 0xbd, 0xc1, 0xd9,
-	0xcc, 0x90, 0x11, 0x62, 0xec, 0x7f, 0xaf, 0x83, 0xb5, 0x13, 0xa4, 0x31, 0x8a, 0x91, 0x5d, 0x81,
-	0xce, 0x38, 0x8f, 0xbc, 0x92, 0xf5, 0x6d, 0xec, 0x8e, 0x7c, 0xf6, 0x6b, 0xb0, 0x12, 0x4a, 0xcf,
-	0x0d, 0x9d, 0x82, 0xcb, 0xc3, 0xfa, 0x5a, 0x63, 0xbd, 0xb7, 0x79, 0xa1, 0xd4, 0xd9, 0x42, 0x8a,
-	0x7c, 0x99, 0x68, 0x4b, 0xa9, 0x7e, 0x08, 0x83, 0x44, 0x4c, 0x65, 0x26, 0x2a, 0xc3, 0x1b, 0x34,
-	0x9c, 0x95, 0xc3, 0x3f, 0x4d, 0xdc, 0x78, 0x57, 0xfa, 0x82, 0xaf, 0x28, 0xda, 0x72, 0xf8, 0x7b,
-	0x15, 0x46, 0x88, 0x89, 0x13, 0xf8, 0x27, 0x0e, 0x7d, 0x60, 0xd8, 0x5c, 0x6b, 0xac, 0xb7, 0xca,
-	0x5d, 0x89, 0xc9, 0xc8, 0x3f, 0x79, 0x80, 0x18, 0xf6, 0x3e, 0x5c, 0x9e, 0x1f, 0xa2, 0x66, 0x1d,
-	0xb6, 0x68, 0xcc, 0x85, 0x99, 0x31, 0x9c, 0x50, 0xec, 0x26, 0xf4, 0xcd, 0xa0, 0x0c, 0x35, 0xa0,
-	0xad, 0x64, 0x92, 0x56, 0x34, 0xe0, 0x0a, 0x74, 0x82, 0xd4, 0x49, 0x83, 0xe8, 0x98, 0x4c, 0xc5,
-	0xe2, 0xed, 0x20, 0xdd, 0x0f, 0xa2, 0x63, 0xf6, 0x32, 0x58, 0x89, 0xf0, 0x14, 0xc6, 0x22, 0x4c,
-	0x27, 0x11, 0x1e, 0xa2, 0xec, 0xd7, 0xa0, 0xf5, 0x50, 0x24, 0x13, 0x41, 0x56, 0x10, 0x44, 0xc7,
-	0xfb, 0x9e, 0x1b, 0x11, 0x7b, 0x2d, 0x5e, 0xf4, 0xed, 0xbf, 0xaf, 0xc1, 0xd2, 0xc3, 0x3c, 0xcc,
-	0x82, 0xad, 0x64, 0x92, 0x8b, 0x69, 0x94, 0xa1, 0x01, 0xee, 0x04, 0x69, 0xa6, 0x29, 0xa9, 0xcd,
-	0xd6, 0xa1, 0xfb, 0xd3, 0x44, 0xe6, 0xf1, 0xfd, 0x93, 0xd8, 0x08, 0x00, 0x36, 0xc8, 0x37, 0x21,
-	0x84, 0x97, 0x48, 0xf6, 0x0e, 0xf4, 0x1e, 0x25, 0xbe, 0x48, 0xee, 0x9d, 0x12, 0x6d, 0xe3, 0x0c,
-	0x6d, 0x15, 0xcd, 0xae, 0x41, 0x77, 0x5f, 0xc4, 0x6e, 0xe2, 0xa2, 0x64, 0x50, 0x5d, 0xbb, 0xbc,
-	0x04, 0xa0, 0xd3, 0x20, 0xe2, 0x91, 0xaf, 0xd5, 0xd4, 0x74, 0xed, 0x09, 0x74, 0xb7, 0x26, 0x93,
-	0x44, 0x4c, 0xdc, 0x8c, 0x3c, 0x88, 0x8c, 0x69, 0xb9, 0x0d, 0x5e, 0x97, 0x31, 0x79, 0x29, 0xdc,
-	0x40, 0x5d, 0x6d, 0x00, 0xdb, 0xec, 0x3a, 0x34, 0x85, 0x5a, 0x4f, 0x6d, 0x6e, 0x3d, 0x04, 0x67,
-	0x97, 0xa1, 0xed, 0xc9, 0x68, 0x1c, 0x4c, 0xb4, 0x6f, 0xd3, 0x3d, 0xfb, 0x8f, 0x1a, 0xd0, 0xa2,
-	0xcd, 0xa1, 0x0f, 0x8a, 0x84, 0xf0, 0x1d, 0xf1, 0xd4, 0x0d, 0x0d, 0x17, 0x11, 0x70, 0xff, 0xa9,
-	0x1b, 0xe2, 0x4a, 0x83, 0xc3, 0xdc, 0x3b, 0x16, 0xea, 0xab, 0x4d, 0x6e, 0xba, 0x88, 0x89, 0x34,
-	0xa6, 0xa1, 0x30, 0xba, 0xcb, 0xd6, 0xa0, 0x85, 0x9f, 0x4e, 0x49, 0x9b, 0x66, 0xd7, 0xa4, 0x10,
-	0x48, 0x81, 0xfa, 0x90, 0x92, 0xee, 0x14, 0x14, 0xa8, 0x0f, 0x5c, 0x21, 0xd8, 0x9b, 0xd0, 0x74,
-	0x27, 0x93, 0x94, 0x2c, 0x73, 0xc6, 0x26, 0x0a, 0xee, 0x70, 0x22, 0x60, 0x1f, 0x40, 0x57, 0x49,
-	0x19, 0xa9, 0x3b, 0x44, 0x7d, 0xa5, 0xe2, 0xf5, 0xab, 0x0a, 0xc0, 0x4b, 0x4a, 0x94, 0x4f, 0x90,
-	0x6a, 0xff, 0xa1, 0xd5, 0xab, 0x04, 0x30, 0x1b, 0xfa, 0x71, 0x22, 0xb6, 0xc2, 0x50, 0x7a, 0xfb,
-	0xc1, 0x73, 0xa1, 0x3d, 0xf3, 0x0c, 0x8c, 0xbd, 0x01, 0xcb, 0x7b, 0x6e, 0x92, 0x05, 0x6e, 0xc8,
-	0x45, 0x9a, 0x87, 0x59, 0x4a, 0x7e, 0xb8, 0xcf, 0xe7, 0xa0, 0x6c, 0x03, 0xd8, 0x0c, 0xe4, 0x80,
-	0x36, 0x0e, 0x6b, 0x8d, 0xf5, 0x25, 0xbe, 0x00, 0x63, 0xff, 0x57, 0x1d, 0xda, 0xa3, 0x28, 0x15,
-	0x09, 0x1d, 0x00, 0xee, 0x78, 0x2c, 0xbc, 0x4c, 0x28, 0xef, 0xd1, 0xe4, 0x45, 0x1f, 0x37, 0x70,
-	0x20, 0x3f, 0x4d, 0x82, 0x4c, 0xec, 0xbf, 0xaf, 0x15, 0xa2, 0x04, 0xb0, 0xdb, 0xb0, 0xea, 0xfa,
-	0xbe, 0x63, 0xa8, 0x9d, 0x44, 0x3e, 0x4b, 0x49, 0x4c, 0x16, 0x5f, 0x71, 0x7d, 0x7f, 0x4b, 0xc3,
-	0xb9, 0x7c, 0x96, 0xb2, 0x9b, 0xd0, 0x48, 0xc4, 0x98, 0xd4, 0xa3, 0xb7, 0xb9, 0xa2, 0x44, 0xf1,
-	0xe8, 0xf0, 0x73, 0xe1, 0x65, 0x5c, 0x8c, 0x39, 0xe2, 0xd8, 0x45, 0x68, 0xb9, 0x59, 0x96, 0x28,
-	0x79, 0x75, 0xb9, 0xea, 0xb0, 0x0d, 0xb8, 0x10, 0xe3, 0xfa, 0xb3, 0x40, 0x46, 0x4e, 0xe6, 0x1e,
-	0x86, 0x78, 0xc2, 0xa4, 0xda, 0x99, 0xae, 0x16, 0xa8, 0x03, 0xc4, 0x8c, 0xfc, 0x14, 0xdd, 0xef,
-	0x3c, 0x7d, 0xe4, 0x4e, 0x85, 0x12, 0x5b, 0x97, 0x5f, 0x98, 0x1d, 0xb1, 0x8b, 0x28, 0xf6, 0x1a,
-	0x2c, 0x95, 0x63, 0x02, 0xff, 0x84, 0x64, 0xd5, 0xe2, 0xfd, 0x02, 0x88, 0xe7, 0xcc, 0x25, 0x68,
-	0x07, 0xa9, 0x23, 0x22, 0x9f, 0x04, 0x65, 0xf1, 0x56, 0x90, 0xde, 0x8f, 0x7c, 0xf6, 0x36, 0x74,
-	0xd5, 0x57, 0x7c, 0x31, 0xa6, 0x03, 0xb4, 0xb7, 0xb9, 0xac, 0x35, 0x0d, 0xc1, 0x3b, 0x62, 0xcc,
-	0xad, 0x4c, 0xb7, 0xec, 0x57, 0xa1, 0xb5, 0x95, 0x24, 0xee, 0x29, 0xed, 0x15, 0x1b, 0xc3, 0x1a,
-	0xf9, 0x35, 0xd5, 0xb1, 0x3d, 0x68, 0x3c, 0x74, 0x63, 0x76, 0x0b, 0xea, 0xd3, 0x98, 0x30, 0xbd,
-	0xcd, 0x4b, 0x15, 0x35, 0x73, 0xe3, 0x8d, 0x87, 0xf1, 0xfd, 0x28, 0x4b, 0x4e, 0x79, 0x7d, 0x1a,
-	0x5f, 0xfd, 0x00, 0x3a, 0xba, 0x8b, 0xb1, 0xc6, 0xb1, 0x38, 0x25, 0xf1, 0x75, 0x39, 0x36, 0xf1,
-	0x03, 0x4f, 0xdd, 0x30, 0x37, 0xe7, 0xa1, 0xea, 0xfc, 0x6a, 0xfd, 0x47, 0x35, 0xfb, 0xf7, 0x5b,
-	0x60, 0xed, 0x88, 0x50, 0xe0, 0xbe, 0xd0, 0xf8, 0x0f, 0x52, 0x2d, 0xf6, 0xfa, 0x41, 0x8a, 0x3a,
-	0x59, 0x15, 0x9b, 0x36, 0xc7, 0x19, 0x18, 0xd2, 0x28, 0xcf, 0x4b, 0xb3, 0x08, 0x2d, 0xf1, 0x19,
-	0x18, 0xda, 0xed, 0xe8, 0x9e, 0xb2, 0xdb, 0x26, 0x05, 0x15, 0xa6, 0x8b, 0x98, 0x5d, 0x8d, 0x69,
-	0x29, 0x8c, 0xee, 0xb2, 0x6b, 0x00, 0x89, 0x7c, 0xe6, 0x04, 0x3e, 0x89, 0x40, 0x79, 0x71, 0x2b,
-	0x91, 0xcf, 0x46, 0x3e, 0xb2, 0xff, 0x1c, 0x3d, 0xe8, 0x7c, 0x6d, 0x3d, 0xb0, 0xce, 0xd7, 0x83,
-	0x5f, 0x81, 0x61, 0x45, 0x0f, 0x30, 0x1e, 0x71, 0x82, 0xc8, 0xa1, 0x50, 0x89, 0x84, 0xde, 0xe2,
-	0xe5, 0x9c, 0x14, 0xae, 0x8c, 0xa2, 0x7b, 0x74, 0x00, 0x6b, 0xed, 0x86, 0x17, 0x68, 0xf7, 0x42,
-	0x63, 0xe9, 0x2d, 0x36, 0x96, 0x7b, 0x00, 0xfb, 0x62, 0x32, 0x15, 0x51, 0xf6, 0xd0, 0x8d, 0x87,
-	0x7d, 0x52, 0x04, 0xbb, 0x54, 0x04, 0x23, 0xbd, 0x8d, 0x92, 0x48, 0x69, 0x45, 0x65, 0x14, 0x9e,
-	0x8a, 0x9e, 0x1b, 0x39, 0x59, 0x92, 0x47, 0x9e, 0x9b, 0x89, 0xe1, 0x12, 0x7d, 0xaa, 0xe7, 0xb9,
-	0xd1, 0x81, 0x06, 0x55, 0x34, 0x7a, 0xb9, 0xaa, 0xd1, 0x6f, 0xc0, 0x4a, 0x9c, 0x04, 0x53, 0x37,
-	0x39, 0x75, 0x8e, 0xc5, 0x29, 0x09, 0x63, 0x45, 0x05, 0x5e, 0x1a, 0xfc, 0x89, 0x38, 0x1d, 0xf9,
-	0x27, 0x57, 0x3f, 0x84, 0x95, 0xb9, 0x05, 0x7c, 0x2d, 0x3d, 0xfc, 0xc7, 0x1a, 0x74, 0xf7, 0x12,
-	0xa1, 0xbd, 0xd0, 0x0d, 0xe8, 0xa5, 0xde, 0x91, 0x98, 0xba, 0x24, 0x25, 0x3d, 0x03, 0x28, 0x10,
-	0x0a, 0x67, 0xd6, 0xce, 0xea, 0x2f, 0xb6, 0x33, 0x5c, 0x07, 0x2e, 0xbb, 0x41, 0xc6, 0x85, 0xcd,
-	0xd2, 0xb9, 0x34, 0xab, 0xce, 0x65, 0x0d, 0xfa, 0x47, 0x6e, 0xea, 0xb8, 0x79, 0x26, 0x1d, 0x4f,
-	0x86, 0xa4, 0x91, 0x16, 0x87, 0x23, 0x37, 0xdd, 0xca, 0x33, 0xb9, 0x2d, 0x43, 0x3c, 0xb7, 0x82,
-	0xd4, 0xc9, 0x63, 0x1f, 0x79, 0xd8, 0x56, 0xe7, 0x56, 0x90, 0x3e, 0xa6, 0xbe, 0xfd, 0x6f, 0x75,
-	0x80, 0x07, 0xd2, 0x3b, 0x3e, 0x70, 0x93, 0x89, 0xc8, 0x30, 0x98, 0x30, 0x8a, 0xa9, 0x4d, 0xaa,
-	0x93, 0x29, 0x75, 0x64, 0x9b, 0x70, 0xd9, 0xf0, 0xd4, 0x93, 0x21, 0x05, 0x36, 0x4a, 0xb3, 0x34,
-	0x5f, 0x98, 0xc6, 0xaa, 0x98, 0x96, 0xd4, 0x8a, 0x6d, 0x96, 0x72, 0xc0, 0x31, 0xd9, 0x69, 0x3c,
-	0x7b, 0xfe, 0xd2, 0x49, 0xb6, 0x54, 0x0e, 0x3c, 0x38, 0x8d, 0xd9, 0xbb, 0x70, 0x29, 0x11, 0xe3,
-	0x44, 0xa4, 0x47, 0x4e, 0x96, 0x56, 0x3f, 0xd3, 0xa4, 0xcf, 0xac, 0x6a, 0xe4, 0x41, 0x5a, 0x7c,
-	0xe5, 0x5d, 0xb8, 0x34, 0x0e, 0xc2, 0x4c, 0x24, 0xf3, 0x0b, 0x53, 0x31, 0xc3, 0xaa, 0x42, 0x56,
-	0xd7, 0xf5, 0x2a, 0xd0, 0xd5, 0x49, 0x19, 0x95, 0xe6, 0x49, 0x37, 0x24, 0x36, 0x1c, 0x86, 0x02,
-	0xcf, 0x8c, 0xed, 0x23, 0x8c, 0x54, 0x77, 0xc4, 0x58, 0x47, 0x5b, 0x25, 0x80, 0xd9, 0xd0, 0x7c,
-	0x28, 0x7d, 0x75, 0x1a, 0x2e, 0x6f, 0x2e, 0x6f, 0xd0, 0x25, 0x0c, 0x79, 0x88, 0x50, 0x4e, 0x38,
-	0x7b, 0x17, 0xda, 0x08, 0x79, 0x14, 0xb3, 0x0d, 0xe8, 0x64, 0xc4, 0xdb, 0x54, 0xbb, 0xc3, 0x8b,
-	0xa5, 0x15, 0x94, 0x8c, 0xe7, 0x86, 0x08, 0xa5, 0x7c, 0x88, 0x33, 0xea, 0xb3, 0x4a, 0x75, 0x6c,
-	0x0e, 0x2b, 0x85, 0xa2, 0x3d, 0x8e, 0x82, 0x27, 0xb9, 0x60, 0x1f, 0xc1, 0x6a, 0x9c, 0x08, 0x27,
-	0x20, 0x98, 0x93, 0x1f, 0x3b, 0x5e, 0xa6, 0xae, 0x17, 0xf4, 0x09, 0xe4, 0x6e, 0x39, 0xe2, 0x78,
-	0x3b, 0x3b, 0xe1, 0xcb, 0xf1, 0x4c, 0xdf, 0xfe, 0x0c, 0xae, 0x14, 0x14, 0xfb, 0xc2, 0x93, 0x91,
-	0xef, 0x26, 0xa7, 0xe4, 0x13, 0xe6, 0xe6, 0x4e, 0xbf, 0xce, 0xdc, 0xfb, 0x34, 0xf7, 0x5f, 0x36,
-	0x60, 0xf9, 0x51, 0xb4, 0x93, 0xc7, 0x61, 0x80, 0x76, 0xfa, 0x89, 0x32, 0x23, 0xa5, 0xbe, 0xb5,
-	0xaa, 0xfa, 0xae, 0xc3, 0x40, 0x7f, 0x05, 0x65, 0xe7, 0xc9, 0x3c, 0x32, 0xfa, 0xb4, 0xac, 0xe0,
-	0xdb, 0x32, 0xdc, 0x46, 0x28, 0xfb, 0x10, 0x2e, 0xe5, 0xb4, 0x73, 0x45, 0x89, 0x17, 0x3c, 0x47,
-	0x2c, 0x8e, 0x30, 0x99, 0x22, 0xc4, 0xa1, 0x48, 0x46, 0x81, 0xe6, 0x0d, 0xe8, 0x95, 0xc3, 0x8d,
-	0x0d, 0x41, 0x41, 0x48, 0x2b, 0x91, 0x91, 0xe3, 0x9b, 0x25, 0x93, 0xd3, 0x50, 0x21, 0xfb, 0xb2,
-	0x2c, 0x77, 0x82, 0x7e, 0xfc, 0xb7, 0x61, 0x75, 0x86, 0x92, 0x56, 0xa1, 0x02, 0xb0, 0x3b, 0xa5,
-	0x70, 0x67, 0xb7, 0x5f, 0xed, 0xe2, 0x7a, 0x94, 0xb7, 0x5b, 0x91, 0xb3, 0x50, 0x6d, 0xab, 0xc1,
-	0x24, 0x92, 0x89, 0xd0, 0x9a, 0x67, 0x05, 0xe9, 0x88, 0xfa, 0x57, 0x77, 0xe1, 0xe2, 0xa2, 0x59,
-	0x16, 0xb8, 0xac, 0xb5, 0xaa, 0xcb, 0x9a, 0x8b, 0x2c, 0x4b, 0xf7, 0xf5, 0x18, 0x7a, 0x1f, 0xe7,
-	0xcf, 0x9f, 0x9f, 0x7e, 0x4c, 0xf6, 0xc1, 0xfa, 0x50, 0xdb, 0xa5, 0x49, 0xea, 0xbc, 0xb6, 0x8b,
-	0xf1, 0xf0, 0xde, 0x31, 0xba, 0x2d, 0x9a, 0xa3, 0xcb, 0x75, 0x0f, 0xa7, 0xde, 0x3b, 0x3e, 0x58,
-	0x68, 0xc8, 0x0a, 0x61, 0xff, 0x71, 0x03, 0x9a, 0xbf, 0x29, 0x83, 0xa8, 0x1a, 0x13, 0xd7, 0xce,
-	0x8d, 0x89, 0xeb, 0xb3, 0x31, 0x31, 0xdd, 0x66, 0x42, 0x27, 0xc4, 0xf0, 0x5d, 0xf9, 0xbe, 0x4e,
-	0x22, 0xc2, 0x07, 0x18, 0xc1, 0xbf, 0x0c, 0x16, 0x4a, 0x9e, 0x50, 0xea, 0xfe, 0xd5, 0xf1, 0xa4,
-	0x42, 0x99, 0xe0, 0xbe, 0x75, 0x4e, 0x70, 0x5f, 0xc4, 0xd1, 0xed, 0xf3, 0xe3, 0xe8, 0x6e, 0x28,
-	0xc6, 0xa8, 0x85, 0x91, 0xaf, 0xc3, 0xe3, 0xea, 0x34, 0x16, 0x22, 0xb7, 0x65, 0xe4, 0xb3, 0xb7,
-	0x00, 0x92, 0x60, 0x72, 0xa4, 0x29, 0xad, 0xb3, 0x37, 0x21, 0xc2, 0x12, 0x29, 0x87, 0x97, 0x93,
-	0x3c, 0xca, 0x82, 0xa9, 0x70, 0xb4, 0x7f, 0x3a, 0xcc, 0x83, 0xd0, 0x57, 0x3b, 0xe8, 0x9a, 0x10,
-	0x1c, 0x47, 0x72, 0x45, 0xa6, 0x04, 0xb1, 0x1f, 0x0b, 0x8f, 0x5f, 0x4e, 0xaa, 0xa0, 0x7b, 0x38,
-	0x8e, 0x76, 0x7a, 0x0d, 0xd0, 0xb5, 0x1f, 0x39, 0x32, 0x72, 0xe2, 0x63, 0x3a, 0xad, 0x2d, 0x6e,
-	0x21, 0xe4, 0x51, 0xb4, 0x77, 0x8c, 0x7e, 0x0d, 0x2f, 0x89, 0x3a, 0x5c, 0xef, 0xcd, 0x85, 0xeb,
-	0xf6, 0x5f, 0xd7, 0xc1, 0xda, 0x8a, 0xb2, 0xe0, 0x1b, 0x4b, 0xe7, 0x32, 0xb4, 0x13, 0x0a, 0xc1,
-	0xb5, 0x6c, 0x74, 0xaf, 0xe0, 0x7f, 0xf3, 0xcb, 0xf8, 0xdf, 0xfa, 0x4a, 0xfc, 0x6f, 0x7f, 0x65,
-	0xfe, 0x77, 0x5e, 0xc4, 0xff, 0x59, 0x5e, 0x59, 0x2f, 0xe4, 0x55, 0x77, 0x9e, 0x57, 0x7f, 0xda,
-	0x00, 0xeb, 0x81, 0x18, 0x67, 0x3f, 0x68, 0xf2, 0xf7, 0x51, 0x93, 0xff, 0xb5, 0x01, 0x5d, 0x8e,
-	0xcb, 0xfb, 0x9e, 0x89, 0xe7, 0x2d, 0x00, 0x62, 0xfe, 0x79, 0x32, 0x22, 0xd1, 0xd0, 0xfd, 0x95,
-	0xbd, 0x0d, 0x3d, 0xc5, 0x7e, 0x45, 0xdb, 0x39, 0x43, 0xab, 0xa4, 0x73, 0x70, 0x56, 0xa8, 0xd6,
-	0x57, 0x16, 0x6a, 0xf7, 0x1b, 0x0b, 0x15, 0xbe, 0x0d, 0xa1, 0xf6, 0x5e, 0x28, 0xd4, 0xfe, 0xbc,
-	0x50, 0xff, 0xb0, 0x01, 0x4b, 0x24, 0xd4, 0x7d, 0x31, 0xfd, 0xff, 0xf7, 0x51, 0x73, 0xf2, 0x68,
-	0x7d, 0x75, 0x79, 0x7c, 0x4b, 0xee, 0xea, 0x85, 0xf2, 0xb0, 0xbe, 0x0d, 0x79, 0x74, 0x5f, 0x28,
-	0x0f, 0x38, 0x57, 0x1e, 0xdf, 0xc9, 0x99, 0xf1, 0x83, 0x3c, 0xe6, 0xe5, 0xf1, 0xf3, 0x3a, 0x58,
-	0xdf, 0x89, 0x69, 0x7c, 0x37, 0xc7, 0xf7, 0xf7, 0x8e, 0xff, 0x7f, 0xd6, 0x00, 0xd8, 0x0f, 0xa2,
-	0x49, 0x28, 0x7e, 0x08, 0x0a, 0xbe, 0x8f, 0x41, 0xc1, 0x3f, 0xd7, 0xc1, 0x7a, 0xe8, 0x26, 0xc7,
-	0xbf, 0x20, 0xf6, 0xf1, 0x1a, 0x74, 0x64, 0x54, 0xb5, 0x86, 0x2a, 0x5d, 0x5b, 0x46, 0xff, 0x77,
-	0x85, 0xff, 0xbd, 0x1a, 0x74, 0xf6, 0x12, 0xe9, 0xe7, 0xde, 0xac, 0xe6, 0xd6, 0xce, 0xd7, 0xdc,
-	0xfa, 0xac, 0xe6, 0x16, 0x9c, 0x69, 0x9c, 0xc7, 0x99, 0xd9, 0x25, 0x34, 0xe7, 0x97, 0xf0, 0x27,
-	0x35, 0xe8, 0xd2, 0x9b, 0x04, 0x09, 0xb5, 0x14, 0x50, 0x6d, 0x46, 0x40, 0xc5, 0x67, 0xea, 0xe7,
-	0x7d, 0xe6, 0x85, 0xca, 0xda, 0xf8, 0x46, 0xca, 0x6a, 0xff, 0xac, 0x06, 0x4b, 0xf4, 0x60, 0xf4,
-	0x71, 0x1e, 0x79, 0xf4, 0x16, 0xbd, 0xf8, 0x8d, 0x63, 0x0d, 0x9a, 0x89, 0xc8, 0xcc, 0xe2, 0xfa,
-	0xea, 0x33, 0xdb, 0x32, 0xdc, 0x11, 0x63, 0x4e, 0x18, 0x54, 0x30, 0x37, 0x99, 0xa4, 0x0b, 0x9e,
-	0x32, 0x08, 0x8e, 0xfb, 0x8e, 0xdd, 0xc4, 0x9d, 0xa6, 0x26, 0x39, 0xa5, 0x7a, 0x8c, 0x41, 0x93,
-	0xde, 0x1a, 0x5b, 0x74, 0x45, 0xa7, 0xb6, 0xfd, 0x0f, 0x75, 0xe8, 0xfe, 0x86, 0x9b, 0x1e, 0xd1,
-	0x3a, 0xcb, 0xa4, 0x15, 0xea, 0x6f, 0x35, 0x69, 0xa5, 0x5f, 0x1b, 0x08, 0x89, 0xfa, 0xa0, 0xdf,
-	0x9b, 0x08, 0x89, 0xc3, 0xab, 0x06, 0xd4, 0x38, 0xd7, 0x80, 0x9a, 0x67, 0x32, 0x5a, 0x5f, 0x62,
-	0x08, 0x6b, 0xd0, 0x42, 0xcd, 0x4e, 0x17, 0x18, 0x81, 0x42, 0xcc, 0x69, 0x6c, 0x67, 0x4e, 0x63,
-	0x6f, 0xc3, 0x2a, 0x2d, 0x79, 0x2a, 0x92, 0x89, 0xf0, 0xf5, 0xc3, 0xb6, 0xba, 0xda, 0xad, 0x20,
-	0x82, 0xf2, 0x9d, 0xbe, 0x7a, 0xd2, 0x7e, 0x07, 0x18, 0xd1, 0xba, 0x61, 0x28, 0xe9, 0xa1, 0x26,
-	0x15, 0x61, 0xaa, 0x6d, 0x60, 0x80, 0x98, 0x2d, 0x8d, 0xd8, 0x17, 0x61, 0x6a, 0x6f, 0xc1, 0xa5,
-	0xfb, 0x27, 0x99, 0x48, 0x22, 0x37, 0xdc, 0x75, 0xa7, 0x62, 0x73, 0x5b, 0x86, 0xea, 0x31, 0xcc,
-	0x30, 0xb9, 0x56, 0x32, 0x19, 0x05, 0x5d, 0x2d, 0x01, 0x50, 0x1d, 0xfb, 0x16, 0xf4, 0xc6, 0x41,
-	0x28, 0x1c, 0x39, 0x1e, 0xa7, 0xca, 0x9d, 0xa8, 0x16, 0xa9, 0x43, 0x83, 0xeb, 0x9e, 0xfd, 0x3f,
-	0x75, 0xe8, 0x9b, 0x4f, 0xed, 0x7b, 0xee, 0x79, 0x6a, 0xf3, 0x0a, 0x74, 0x69, 0xb6, 0x34, 0x78,
-	0x2e, 0x48, 0x77, 0x1a, 0xdc, 0x42, 0x00, 0x65, 0xd5, 0xb6, 0x60, 0xb5, 0xf2, 0x29, 0x27, 0x93,
-	0x99, 0x1b, 0x6a, 0xf5, 0xa9, 0xe4, 0x5b, 0x2a, 0x24, 0x7c, 0x05, 0x3b, 0x8f, 0xa8, 0x7d, 0x80,
-	0xd4, 0xa8, 0x96, 0xc5, 0x53, 0xd8, 0x19, 0xb5, 0x44, 0x0c, 0xfb, 0x29, 0xac, 0xe0, 0x6e, 0x37,
-	0xd5, 0xbb, 0x2a, 0xed, 0x57, 0x09, 0xf6, 0x46, 0xf9, 0x89, 0x85, 0x3c, 0xe3, 0x4b, 0xd1, 0x0c,
-	0x0b, 0x5f, 0x05, 0xf0, 0x12, 0x41, 0x22, 0x78, 0x12, 0xd2, 0x7b, 0x6b, 0x97, 0x77, 0x15, 0x64,
-	0xff, 0x49, 0x58, 0xec, 0x94, 0x8c, 0x51, 0x25, 0xb9, 0x68, 0xa7, 0xe4, 0x42, 0xee, 0x40, 0x4f,
-	0x26, 0xc1, 0x24, 0x88, 0xd4, 0xc3, 0x9d, 0xb5, 0x60, 0xb5, 0xa0, 0x08, 0xe8, 0x19, 0xcf, 0x86,
-	0xb6, 0x32, 0x70, 0x12, 0xf4, 0x9c, 0x53, 0x54, 0x18, 0xdb, 0x03, 0xd8, 0xcf, 0x12, 0xe1, 0x4e,
-	0x89, 0xfb, 0x6f, 0x42, 0x27, 0x3b, 0x0c, 0xe9, 0x51, 0xbe, 0xb6, 0xf0, 0x51, 0xbe, 0x9d, 0x1d,
-	0xe2, 0x67, 0x2a, 0xf2, 0xac, 0x53, 0xaa, 0x59, 0xf7, 0x50, 0x7c, 0x61, 0x30, 0x0d, 0x32, 0x5d,
-	0x94, 0xa1, 0x3a, 0xf6, 0xcf, 0x6b, 0x00, 0xfb, 0xee, 0x34, 0x56, 0xee, 0x81, 0xfd, 0x04, 0x7a,
-	0x29, 0xf5, 0x54, 0x86, 0x5f, 0xd5, 0xe6, 0x54, 0xf8, 0x58, 0x92, 0xea, 0xa6, 0x8a, 0x71, 0xd3,
-	0xa2, 0x4d, 0xf9, 0x05, 0x35, 0x43, 0x62, 0xf2, 0x5a, 0x2d, 0x43, 0x40, 0x39, 0x97, 0x5b, 0xb0,
-	0xac, 0x09, 0x62, 0x91, 0x78, 0x22, 0x52, 0x0b, 0xaa, 0xf1, 0x25, 0x05, 0xdd, 0x53, 0x40, 0xf6,
-	0x5e, 0x41, 0xe6, 0xc9, 0x30, 0x9f, 0x46, 0x8b, 0xf2, 0xcf, 0x7a, 0xc8, 0xb6, 0x22, 0xb0, 0x37,
-	0xcd, 0x56, 0x68, 0x21, 0x16, 0x34, 0xf1, 0x7b, 0x83, 0x97, 0x58, 0x0f, 0x3a, 0x7a, 0xd6, 0x41,
-	0x8d, 0x2d, 0x41, 0x97, 0xac, 0x8f, 0x70, 0x75, 0xfb, 0x0f, 0x56, 0xa0, 0x37, 0x8a, 0xd2, 0x2c,
-	0xc9, 0x3d, 0x93, 0xa7, 0xd3, 0x49, 0xfa, 0x16, 0x25, 0xe9, 0x75, 0x82, 0x43, 0x6d, 0x83, 0x12,
-	0x1c, 0x6f, 0x40, 0xd3, 0x8d, 0xb2, 0x40, 0xbf, 0x2c, 0x56, 0x0a, 0x34, 0xcc, 0xfd, 0x83, 0x13,
-	0x9e, 0xdd, 0x81, 0x8e, 0xae, 0xe6, 0xd0, 0x27, 0xf2, 0xc2, 0x52, 0x10, 0x43, 0xc3, 0x36, 0xc0,
-	0xf2, 0x75, 0x99, 0x89, 0x8e, 0xa0, 0x2a, 0x53, 0x9b, 0x02, 0x14, 0x5e, 0xd0, 0xb0, 0x9b, 0xd0,
-	0x70, 0x27, 0x13, 0xd2, 0x52, 0xca, 0x84, 0x19, 0x52, 0xaa, 0x02, 0xe0, 0x88, 0x63, 0x77, 0xf5,
-	0x71, 0xfe, 0xb9, 0x0c, 0x22, 0xf2, 0x3e, 0x33, 0x73, 0x9a, 0x67, 0x23, 0x75, 0xac, 0xd3, 0xc1,
-	0x75, 0x17, 0xba, 0xa9, 0x98, 0x06, 0x6a, 0x40, 0x77, 0x7e, 0x80, 0x09, 0xea, 0xb9, 0x95, 0x9a,
-	0xf0, 0xfe, 0x03, 0xe8, 0xa5, 0x14, 0x6a, 0xaa, 0x21, 0x60, 0xde, 0xde, 0x8b, 0x21, 0x45, 0x1c,
-	0xca, 0x21, 0x2d, 0x63, 0xd2, 0xbb, 0xd0, 0x9d, 0xba, 0xc9, 0xb1, 0x1a, 0xd4, 0x9b, 0xff, 0x8e,
-	0x09, 0x8e, 0xb8, 0x35, 0x35, 0x61, 0x92, 0x0d, 0x4d, 0xa2, 0xed, 0x1b, 0xcd, 0x37, 0xb4, 0x8a,
-	0xdf, 0x88, 0x63, 0x6f, 0x43, 0x27, 0x56, 0x51, 0x00, 0xa5, 0xe0, 0x7a, 0x9b, 0xab, 0x25, 0x99,
-	0x0e, 0x0f, 0xb8, 0xa1, 0x60, 0xbf, 0x0e, 0xcb, 0x2a, 0x83, 0x34, 0xd6, 0x87, 0x22, 0x65, 0xe6,
-	0x66, 0x8a, 0x0d, 0x66, 0xce, 0x4c, 0xbe, 0x94, 0xcd, 0x1c, 0xa1, 0x9b, 0xda, 0xfd, 0xd3, 0xf1,
-	0x4c, 0x59, 0xbb, 0x19, 0xf9, 0x16, 0x27, 0x1b, 0xef, 0x1e, 0x15, 0x87, 0xdc, 0x8f, 0x61, 0x49,
-	0x68, 0x37, 0xe4, 0xa4, 0x9e, 0x1b, 0x0d, 0x07, 0x34, 0xec, 0xf2, 0x59, 0x2f, 0x85, 0x06, 0xcf,
-	0xfb, 0xa2, 0xea, 0x7c, 0xd7, 0xa1, 0xad, 0x32, 0x0d, 0xc3, 0x55, 0x1a, 0x55, 0xa9, 0x85, 0x53,
-	0x19, 0x0d, 0xae, 0xf1, 0xec, 0xde, 0x5c, 0x86, 0xe0, 0x58, 0x9c, 0x0e, 0x19, 0x8d, 0x19, 0x9e,
-	0xf7, 0xec, 0x3f, 0x93, 0x3b, 0xf8, 0x44, 0x9c, 0xe2, 0xf6, 0xca, 0xcc, 0xca, 0xf0, 0xc2, 0xfc,
-	0xf6, 0x8a, 0xb4, 0x0a, 0xef, 0x16, 0x19, 0x15, 0x76, 0x7f, 0x36, 0xd3, 0x43, 0x29, 0x8b, 0xe1,
-	0x45, 0x1a, 0xfa, 0xf2, 0x82, 0xa1, 0x2a, 0x3f, 0xc4, 0x57, 0xe2, 0xb9, 0x84, 0xd1, 0x3b, 0x60,
-	0xc9, 0xc4, 0xc7, 0xc8, 0xe7, 0x74, 0x78, 0x89, 0x2c, 0x7e, 0x55, 0xa7, 0x79, 0x55, 0x3d, 0x0e,
-	0xc5, 0x3a, 0x1d, 0xa9, 0x3a, 0xec, 0x0e, 0xf4, 0xe3, 0x44, 0x7e, 0x2e, 0xbc, 0x4c, 0xb9, 0xe5,
-	0xcb, 0x67, 0xeb, 0x78, 0x34, 0x9e, 0xbc, 0x74, 0xe9, 0x76, 0xaf, 0x9c, 0xe7, 0x76, 0x4b, 0x3f,
-	0x39, 0xa4, 0xa8, 0x41, 0x75, 0x2a, 0x5e, 0xf5, 0x65, 0x02, 0x1b, 0xaf, 0x8a, 0xf1, 0x47, 0xfa,
-	0x71, 0x90, 0xa4, 0xd9, 0xf0, 0xaa, 0x2a, 0x6b, 0xd2, 0x5d, 0x1c, 0x11, 0xa4, 0x0f, 0xdc, 0x34,
-	0x1b, 0xbe, 0x62, 0x2a, 0xa1, 0xb0, 0x87, 0xbc, 0x55, 0xb1, 0x33, 0x69, 0xf4, 0xb5, 0x79, 0xde,
-	0x16, 0xef, 0x85, 0x3a, 0x88, 0x26, 0xfd, 0xff, 0x08, 0x56, 0xd4, 0x98, 0xd2, 0x3c, 0x5f, 0x9d,
-	0xd7, 0xd7, 0x99, 0x37, 0x29, 0xbe, 0x94, 0xcc, 0x3c, 0x51, 0x15, 0x13, 0xa0, 0x6b, 0x52, 0x13,
-	0x5c, 0x5f, 0x38, 0x41, 0xe1, 0xc4, 0xd4, 0x04, 0xc5, 0x9b, 0xca, 0x6d, 0x68, 0xfb, 0xaa, 0x0a,
-	0xe1, 0xc6, 0x19, 0xe7, 0xa4, 0xb3, 0xe4, 0x5c, 0x53, 0xb0, 0xb7, 0xa0, 0x43, 0x79, 0x4b, 0x19,
-	0x0f, 0xd7, 0xe6, 0x95, 0x55, 0xe5, 0x1b, 0x79, 0x3b, 0x54, 0x79, 0xc7, 0xb7, 0xa1, 0x63, 0x82,
-	0xea, 0x9b, 0xf3, 0x46, 0xab, 0x83, 0x6b, 0x6e, 0x28, 0xd8, 0x2d, 0x68, 0x51, 0x40, 0x35, 0xb4,
-	0xe7, 0x9d, 0x9e, 0xf2, 0xe8, 0x0a, 0x4b, 0x4e, 0x89, 0xce, 0x4d, 0x65, 0x65, 0xaf, 0x9d, 0x71,
-	0x4a, 0xc5, 0xa1, 0xca, 0x21, 0x2d, 0x0f, 0xd8, 0xdf, 0x81, 0xab, 0xd5, 0x6c, 0xa2, 0x49, 0x35,
-	0xea, 0x88, 0xe2, 0x75, 0x9a, 0xe5, 0xe6, 0x02, 0x45, 0x9e, 0x4d, 0x4a, 0xf2, 0x2b, 0xf1, 0x39,
-	0xd9, 0xca, 0x0f, 0x8a, 0x83, 0x11, 0x7d, 0xce, 0xf0, 0xd6, 0x99, 0x65, 0x15, 0x47, 0xab, 0x39,
-	0x2e, 0xe9, 0x44, 0xfe, 0x11, 0xf4, 0xc7, 0xf9, 0xf3, 0xe7, 0xa7, 0xfa, 0x42, 0x30, 0x7c, 0x83,
-	0xc6, 0x55, 0xa2, 0xa7, 0x4a, 0x72, 0x8c, 0xf7, 0xc6, 0x95, 0x4c, 0xd9, 0x15, 0xe8, 0x78, 0x91,
-	0xe3, 0xfa, 0x7e, 0x32, 0x7c, 0x53, 0x25, 0xc7, 0xbc, 0x68, 0xcb, 0xf7, 0x29, 0xc9, 0x28, 0x63,
-	0x41, 0xb5, 0x6b, 0x4e, 0xe0, 0x0f, 0xd7, 0xd5, 0x11, 0x6d, 0x40, 0x23, 0x9f, 0xaa, 0x4c, 0xdd,
-	0xc4, 0x0d, 0x43, 0x11, 0x22, 0xc1, 0x5b, 0xba, 0xca, 0x54, 0x83, 0x46, 0x3e, 0xbb, 0x09, 0xfd,
-	0xa9, 0x7b, 0xe2, 0x18, 0xc8, 0xf0, 0xb6, 0xaa, 0x04, 0x9c, 0xba, 0x27, 0x7b, 0x1a, 0x84, 0x6a,
-	0xae, 0x0a, 0x3b, 0x48, 0xd9, 0xde, 0x9e, 0x57, 0xf3, 0xe2, 0xb6, 0xc4, 0xbb, 0x81, 0x69, 0xda,
-	0x1f, 0x40, 0x7f, 0x8b, 0xca, 0x6d, 0x83, 0x94, 0xcc, 0xf5, 0x16, 0x34, 0x8b, 0x9b, 0x5c, 0xe1,
-	0x07, 0x88, 0xe2, 0xb9, 0x18, 0x45, 0x63, 0xc9, 0x09, 0x6d, 0xff, 0xac, 0x01, 0xed, 0x7d, 0x99,
-	0x27, 0x9e, 0xf8, 0xf2, 0xea, 0x86, 0x57, 0x01, 0xca, 0x1a, 0x15, 0x9d, 0x34, 0x54, 0xf5, 0x0e,
-	0x84, 0xae, 0x5e, 0x12, 0x1b, 0x14, 0xe2, 0x15, 0x97, 0xc4, 0x22, 0xe5, 0xad, 0xea, 0xff, 0x54,
-	0x87, 0x58, 0x95, 0xa7, 0x47, 0xbe, 0x7c, 0x16, 0x21, 0xab, 0x5a, 0xe4, 0x1c, 0xc0, 0x80, 0x46,
-	0x3e, 0x95, 0x3c, 0x19, 0x02, 0x92, 0x85, 0x8a, 0x2b, 0xfb, 0x06, 0x48, 0x12, 0x31, 0x57, 0xf7,
-	0xce, 0x39, 0x57, 0xf7, 0xdb, 0x50, 0x94, 0x5c, 0xe8, 0x83, 0xfc, 0xfc, 0x92, 0x8c, 0x4d, 0xe8,
-	0x16, 0x15, 0xd8, 0xfa, 0x10, 0xbf, 0xb8, 0x51, 0xd6, 0x64, 0x1f, 0x98, 0x16, 0x2f, 0xc9, 0x16,
-	0xdc, 0x3b, 0xe3, 0x44, 0x1e, 0x8a, 0x6f, 0xf0, 0xc8, 0xbe, 0x87, 0xe3, 0xe8, 0xde, 0x19, 0x83,
-	0xb5, 0x2b, 0x7d, 0x92, 0x13, 0x5e, 0x4e, 0xa6, 0x5e, 0x9c, 0xeb, 0xb8, 0x8a, 0xda, 0xba, 0xa0,
-	0x5a, 0x49, 0x40, 0x17, 0x54, 0x13, 0x7f, 0x1a, 0xea, 0x02, 0x83, 0x6d, 0xf4, 0xae, 0xb1, 0x7b,
-	0x1a, 0x4a, 0xd7, 0xd7, 0x5c, 0x37, 0x5d, 0xa4, 0xa6, 0x08, 0x55, 0x95, 0x36, 0x51, 0xdb, 0xfe,
-	0x9b, 0x1a, 0xac, 0xee, 0x25, 0xd2, 0x13, 0x69, 0xfa, 0x00, 0x9d, 0xb6, 0x4b, 0x47, 0x35, 0x83,
-	0x26, 0xdd, 0x4d, 0x54, 0xe1, 0x25, 0xb5, 0x51, 0x0b, 0x54, 0xa1, 0x76, 0x11, 0xa3, 0x36, 0xb8,
-	0x2a, 0xdd, 0xa6, 0x10, 0xb5, 0x40, 0xd3, 0xc0, 0x46, 0x05, 0x4d, 0xb7, 0x9a, 0x5b, 0xb0, 0x5c,
-	0x56, 0x2f, 0xd1, 0x0c, 0xba, 0x82, 0xb9, 0x80, 0xd2, 0x2c, 0x37, 0xa0, 0x97, 0x08, 0x17, 0x8f,
-	0x32, 0x9a, 0xa6, 0x45, 0x34, 0xa0, 0x40, 0x38, 0x8f, 0xfd, 0xe7, 0x75, 0xe8, 0xe9, 0xf5, 0x12,
-	0x97, 0x14, 0x47, 0x6a, 0x05, 0x47, 0x06, 0xd0, 0xc0, 0x8b, 0x88, 0x62, 0x11, 0x36, 0xd9, 0x1d,
-	0x68, 0x84, 0xc1, 0x54, 0x87, 0x9e, 0xaf, 0xcc, 0xc4, 0x37, 0xb3, 0xbb, 0xe6, 0x48, 0x87, 0x37,
-	0x96, 0x3c, 0x0a, 0x4e, 0x1c, 0x14, 0x8f, 0x5e, 0xa3, 0x85, 0x00, 0xd4, 0x01, 0xdc, 0xa4, 0xeb,
-	0x51, 0x29, 0x83, 0x51, 0xdc, 0x25, 0xde, 0xd5, 0x90, 0x91, 0x4f, 0xc5, 0xb8, 0x91, 0x1b, 0xa7,
-	0x47, 0x32, 0xd3, 0x2a, 0x5b, 0xf4, 0xd1, 0x27, 0xa5, 0x22, 0x4d, 0x55, 0xf1, 0xd6, 0x58, 0x6a,
-	0xb5, 0xbd, 0x54, 0x0d, 0x15, 0x09, 0x4b, 0x36, 0xda, 0x4b, 0xcb, 0x0e, 0x5e, 0x76, 0x5d, 0x6d,
-	0xe1, 0x4e, 0x24, 0x7d, 0x51, 0x3e, 0x0c, 0xb5, 0xf8, 0xc0, 0x60, 0x50, 0x6d, 0x48, 0x85, 0xfe,
-	0xbb, 0x06, 0xbd, 0xca, 0x54, 0x54, 0x73, 0x9f, 0x8a, 0xc4, 0xdc, 0x71, 0xb1, 0x8d, 0xb0, 0x23,
-	0xa9, 0xab, 0x68, 0xbb, 0x9c, 0xda, 0x08, 0x4b, 0x64, 0x28, 0x8c, 0x2a, 0x61, 0x1b, 0xed, 0x50,
-	0x87, 0xda, 0xaa, 0xf6, 0x50, 0x3f, 0x0a, 0xf4, 0x4b, 0xa0, 0xda, 0xb4, 0xef, 0x66, 0xee, 0xa1,
-	0x9b, 0x9a, 0xd7, 0x8a, 0xa2, 0x8f, 0xba, 0xf8, 0x54, 0x24, 0xb8, 0x16, 0xcd, 0x0f, 0xd3, 0x45,
-	0x36, 0x93, 0xe9, 0x3c, 0x97, 0x91, 0x2a, 0x87, 0xe8, 0x73, 0x0b, 0x01, 0x9f, 0xc9, 0x88, 0x86,
-	0x69, 0xa6, 0x92, 0xe5, 0x76, 0xb9, 0xe9, 0xa2, 0xaf, 0x79, 0x92, 0x0b, 0x3c, 0x61, 0x54, 0x0a,
-	0xac, 0xcb, 0x3b, 0xd4, 0x1f, 0xf9, 0xf6, 0xdf, 0xb5, 0xc0, 0xda, 0xd3, 0xcc, 0x64, 0x3b, 0xb0,
-	0x54, 0xd4, 0xfc, 0x2f, 0xbe, 0x95, 0xed, 0xcd, 0x37, 0xe8, 0x56, 0xd6, 0x8f, 0x2b, 0xbd, 0xf9,
-	0x3f, 0x07, 0xea, 0x67, 0xfe, 0x1c, 0xb8, 0x06, 0x8d, 0x27, 0xc9, 0xe9, 0x6c, 0xc1, 0xc4, 0x5e,
-	0xe8, 0x46, 0x1c, 0xc1, 0xec, 0x3d, 0xe8, 0x21, 0x27, 0x9c, 0x94, 0xfc, 0xac, 0xbe, 0xd1, 0x54,
-	0x7f, 0xda, 0x20, 0x38, 0x07, 0x24, 0xd2, 0xbe, 0x78, 0x03, 0x2c, 0xef, 0x28, 0x08, 0xfd, 0x44,
-	0x44, 0xfa, 0x42, 0xce, 0xce, 0x2e, 0x99, 0x17, 0x34, 0xec, 0x27, 0x54, 0x64, 0x63, 0x6e, 0x62,
-	0x4a, 0x33, 0xda, 0xf3, 0x6f, 0x05, 0x95, 0xbb, 0x1a, 0x5f, 0xa9, 0x90, 0x93, 0x8b, 0x2e, 0xeb,
-	0xec, 0x3a, 0xd5, 0x3a, 0x3b, 0x55, 0x94, 0x5e, 0xdc, 0x82, 0x28, 0x14, 0xa3, 0xa0, 0x46, 0x21,
-	0xc8, 0xbd, 0x74, 0x8b, 0x18, 0x0d, 0xbd, 0xcb, 0x1b, 0xd0, 0x44, 0xed, 0xd4, 0x17, 0x9a, 0xca,
-	0xb2, 0x8d, 0x47, 0xe3, 0x84, 0xa7, 0x9f, 0x4a, 0xf2, 0xf4, 0xc8, 0x51, 0xee, 0x1f, 0x4d, 0xa1,
-	0xa7, 0x0b, 0x5a, 0xf3, 0xf4, 0x68, 0x07, 0x0f, 0x00, 0x54, 0xdb, 0x5b, 0xb0, 0x6c, 0x36, 0xa9,
-	0x6b, 0x87, 0xfa, 0xaa, 0xcc, 0xcf, 0x40, 0x55, 0xe9, 0xd0, 0x47, 0x30, 0xc8, 0xf3, 0xc0, 0x4f,
-	0x9d, 0x4c, 0x9a, 0xa2, 0xfc, 0xe1, 0x12, 0xed, 0xbf, 0x72, 0x45, 0x78, 0x9c, 0x07, 0xfe, 0x81,
-	0xd4, 0x65, 0xf9, 0x4b, 0x44, 0x6f, 0xba, 0xf4, 0xfb, 0x09, 0x3d, 0x17, 0xe2, 0xc8, 0x65, 0x55,
-	0xd6, 0x49, 0x00, 0x44, 0xe2, 0xc9, 0xa8, 0x8b, 0xf7, 0xbd, 0x28, 0xd3, 0x85, 0x86, 0xa0, 0x41,
-	0xdb, 0x51, 0x66, 0x7f, 0x04, 0xfd, 0xaa, 0xfa, 0xb0, 0xae, 0x2e, 0xcb, 0x1f, 0xbc, 0xc4, 0x00,
-	0xda, 0xbb, 0x32, 0x99, 0xba, 0xe1, 0xa0, 0x86, 0x6d, 0x55, 0x80, 0x3a, 0xa8, 0xb3, 0x3e, 0x58,
-	0xe6, 0xbc, 0x1f, 0x34, 0xec, 0x1f, 0x83, 0x65, 0xfe, 0x51, 0xa0, 0x37, 0x3b, 0x34, 0x6f, 0xf2,
-	0xe8, 0xca, 0x5c, 0x2d, 0x04, 0xd0, 0x69, 0x67, 0x7e, 0x9d, 0xa9, 0x97, 0xbf, 0xce, 0xd8, 0xbf,
-	0x05, 0xfd, 0xea, 0xd6, 0xcc, 0xbd, 0xbb, 0x56, 0xde, 0xbb, 0x17, 0x8c, 0xa2, 0x27, 0x99, 0x44,
-	0x4e, 0x9d, 0xca, 0xc1, 0x61, 0x21, 0x00, 0x3f, 0x73, 0xfb, 0x77, 0xa1, 0xad, 0x7e, 0x13, 0x62,
-	0xab, 0xb0, 0xf4, 0x38, 0x3a, 0x8e, 0xe4, 0xb3, 0x48, 0x01, 0x06, 0x2f, 0xb1, 0x0b, 0xb0, 0x62,
-	0x76, 0xab, 0xff, 0x47, 0x1a, 0xd4, 0xd8, 0x00, 0xfa, 0xf4, 0x26, 0x67, 0x20, 0x75, 0x76, 0x0d,
-	0x86, 0x7b, 0x89, 0x88, 0xdd, 0x44, 0xec, 0xc8, 0x48, 0xec, 0xca, 0x2c, 0x18, 0x9f, 0x1a, 0x6c,
-	0xe3, 0xf6, 0xc7, 0xd0, 0x56, 0x3f, 0x2b, 0x55, 0xbe, 0xa0, 0x00, 0x83, 0x97, 0xd8, 0x0a, 0xf4,
-	0x3e, 0x75, 0x83, 0x2c, 0x88, 0x26, 0xbb, 0xe2, 0x24, 0x1b, 0xd4, 0x98, 0x05, 0x4d, 0xbc, 0x00,
-	0x0c, 0xea, 0x6c, 0x19, 0x40, 0x4f, 0x72, 0x3f, 0xf2, 0x07, 0x8d, 0x7b, 0xdb, 0xff, 0xf4, 0xc5,
-	0xf5, 0xda, 0xbf, 0x7c, 0x71, 0xbd, 0xf6, 0x1f, 0x5f, 0x5c, 0x7f, 0xe9, 0x2f, 0xfe, 0xf3, 0x7a,
-	0xed, 0xb3, 0xf7, 0x2a, 0xff, 0x5f, 0x4d, 0xdd, 0x2c, 0x09, 0x4e, 0xd4, 0xe3, 0x91, 0xe9, 0x44,
-	0xe2, 0x6e, 0x7c, 0x3c, 0xb9, 0x1b, 0x1f, 0xde, 0x35, 0x9a, 0x71, 0xd8, 0xa6, 0x3f, 0xac, 0xde,
-	0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x65, 0x13, 0x00, 0xd5, 0x35, 0x00, 0x00,
+	0x52, 0xee, 0xae, 0xfe, 0xa8, 0x8e, 0xee, 0xe9, 0xee, 0xc9, 0xfd, 0x2a, 0xaf, 0xd7, 0xeb, 0x71,
+	0xd9, 0x6b, 0x8f, 0xd7, 0xde, 0x59, 0x7b, 0x8c, 0xe1, 0x89, 0x87, 0xf1, 0x9b, 0x9d, 0x59, 0xbf,
+	0xd7, 0xbc, 0x9d, 0xdd, 0x21, 0x67, 0x56, 0x16, 0x3e, 0x50, 0xd4, 0x54, 0x65, 0x77, 0xd7, 0x9b,
+	0xea, 0xaa, 0xda, 0xaa, 0x6a, 0xef, 0xcc, 0x9e, 0xb8, 0x70, 0x41, 0xe2, 0x84, 0x04, 0x42, 0x08,
+	0x84, 0x90, 0x38, 0x20, 0x6e, 0x20, 0xae, 0x88, 0x23, 0x27, 0x84, 0x80, 0x3b, 0xc8, 0xfc, 0x05,
+	0xc4, 0xed, 0x21, 0x14, 0x91, 0x99, 0x55, 0xd5, 0x3d, 0x3d, 0xeb, 0x0f, 0x2c, 0x6c, 0x09, 0x9f,
+	0x3a, 0xe3, 0x23, 0x3f, 0x2a, 0x22, 0x32, 0x32, 0x32, 0x23, 0x1a, 0xfa, 0x49, 0x90, 0x88, 0x30,
+	0x88, 0xc4, 0x56, 0x92, 0xc6, 0x79, 0xcc, 0x4c, 0x0d, 0x5f, 0xbf, 0x33, 0x09, 0xf2, 0xe9, 0xfc,
+	0x78, 0xcb, 0x8b, 0x67, 0x77, 0x27, 0xf1, 0x24, 0xbe, 0x4b, 0x0c, 0xc7, 0xf3, 0x31, 0x41, 0x04,
+	0x50, 0x4b, 0x76, 0xbc, 0x0e, 0x49, 0xe8, 0x46, 0xaa, 0x3d, 0xc8, 0x83, 0x99, 0xc8, 0x72, 0x77,
+	0x96, 0x68, 0x62, 0x18, 0x7b, 0x27, 0xb2, 0x6d, 0xff, 0x4b, 0x1d, 0xda, 0xfb, 0x22, 0xcb, 0xdc,
+	0x89, 0x60, 0x36, 0x18, 0x59, 0xe0, 0x5b, 0xb5, 0x8d, 0xda, 0x66, 0x7f, 0x7b, 0xb8, 0x55, 0xac,
+	0xe5, 0x30, 0x77, 0xf3, 0x79, 0xc6, 0x91, 0x88, 0x3c, 0xde, 0xcc, 0xb7, 0xea, 0xcb, 0x3c, 0xfb,
+	0x22, 0x9f, 0xc6, 0x3e, 0x47, 0x22, 0x1b, 0x82, 0x21, 0xd2, 0xd4, 0x32, 0x36, 0x6a, 0x9b, 0x3d,
+	0x8e, 0x4d, 0xc6, 0xa0, 0xe1, 0xbb, 0xb9, 0x6b, 0x35, 0x08, 0x45, 0x6d, 0xf6, 0x3a, 0xf4, 0x93,
+	0x34, 0xf6, 0x9c, 0x20, 0x1a, 0xc7, 0x0e, 0x51, 0x9b, 0x44, 0xed, 0x21, 0x76, 0x14, 0x8d, 0xe3,
+	0x3d, 0xe4, 0xb2, 0xa0, 0xed, 0x46, 0x6e, 0x78, 0x96, 0x09, 0xab, 0x45, 0x64, 0x0d, 0xb2, 0x3e,
+	0xd4, 0x03, 0xdf, 0x6a, 0x6f, 0xd4, 0x36, 0x1b, 0xbc, 0x1e, 0xf8, 0x38, 0xc7, 0x7c, 0x1e, 0xf8,
+	0x96, 0x29, 0xe7, 0xc0, 0x36, 0x7b, 0x09, 0x3a, 0xc7, 0x6e, 0xee, 0x4d, 0x1d, 0x2f, 0xca, 0xad,
+	0x0e, 0xb1, 0x9a, 0x84, 0xd8, 0x8d, 0x72, 0x76, 0x1d, 0x4c, 0x6f, 0x2a, 0xbc, 0x93, 0x6c, 0x3e,
+	0xb3, 0x60, 0xa3, 0xb6, 0xb9, 0xc6, 0x0b, 0x18, 0x69, 0x99, 0x78, 0x32, 0x17, 0x91, 0x27, 0xac,
+	0xae, 0xec, 0xa7, 0x61, 0xf6, 0x2a, 0xf4, 0x9e, 0xcc, 0x45, 0x7a, 0xe6, 0xcc, 0xc4, 0x2c, 0x4e,
+	0xcf, 0xac, 0x1e, 0xd1, 0xbb, 0x84, 0xdb, 0x27, 0x94, 0xfd, 0x18, 0x3a, 0xbb, 0x71, 0x14, 0x09,
+	0x2f, 0x8f, 0x53, 0xf6, 0x0a, 0x74, 0xb5, 0x98, 0x1c, 0x25, 0xde, 0x26, 0x07, 0x8d, 0x1a, 0xf9,
+	0xec, 0x4d, 0x18, 0x78, 0x9a, 0xdb, 0x09, 0x22, 0x5f, 0x9c, 0x92, 0x7c, 0x9b, 0xbc, 0x5f, 0xa0,
+	0x47, 0x88, 0xb5, 0xff, 0xa2, 0x0e, 0xed, 0xc3, 0xe9, 0x7c, 0x3c, 0x0e, 0x05, 0x7b, 0x1d, 0xd6,
+	0x54, 0x73, 0x37, 0x0e, 0x47, 0xfe, 0xa9, 0x1a, 0x77, 0x11, 0xc9, 0x36, 0xa0, 0xab, 0x10, 0x47,
+	0x67, 0x89, 0x50, 0xc3, 0x56, 0x51, 0x8b, 0xe3, 0xec, 0x07, 0x11, 0xa9, 0xcd, 0xe0, 0x8b, 0xc8,
+	0x25, 0x2e, 0xf7, 0xd4, 0x6a, 0x9c, 0xe3, 0x72, 0x69, 0xb6, 0x9d, 0x30, 0xf8, 0x4c, 0x70, 0x31,
+	0xd9, 0x8d, 0x72, 0xd2, 0x67, 0x93, 0x57, 0x51, 0x6c, 0x1b, 0xae, 0x64, 0xb2, 0x8b, 0x93, 0xba,
+	0xd1, 0x44, 0x64, 0xce, 0x3c, 0x88, 0xf2, 0x5f, 0xfc, 0x05, 0xab, 0xb5, 0x61, 0x6c, 0x36, 0xf8,
+	0x25, 0x45, 0xe4, 0x44, 0x7b, 0x4c, 0x24, 0xf6, 0x2e, 0x5c, 0x5e, 0xea, 0x23, 0xbb, 0xb4, 0x37,
+	0x8c, 0x4d, 0x83, 0xb3, 0x85, 0x2e, 0x23, 0xa4, 0xd8, 0xff, 0x56, 0x07, 0x73, 0x2f, 0xc8, 0x12,
+	0xd4, 0x34, 0xbb, 0x06, 0xed, 0xf1, 0x3c, 0xf2, 0x4a, 0xd1, 0xb7, 0x10, 0x1c, 0xf9, 0xec, 0x57,
+	0x60, 0x10, 0xc6, 0x9e, 0x1b, 0x3a, 0x85, 0x94, 0xad, 0xfa, 0x86, 0xb1, 0xd9, 0xdd, 0xbe, 0x54,
+	0x9a, 0x75, 0xa1, 0x45, 0xde, 0x27, 0xde, 0x52, 0xab, 0x1f, 0xc2, 0x30, 0x15, 0xb3, 0x38, 0x17,
+	0x95, 0xee, 0x06, 0x75, 0x67, 0x65, 0xf7, 0x4f, 0x52, 0x37, 0x79, 0x18, 0xfb, 0x82, 0x0f, 0x24,
+	0x6f, 0xd9, 0xfd, 0xbd, 0x8a, 0x20, 0xc4, 0xc4, 0x09, 0xfc, 0x53, 0x87, 0x26, 0xb0, 0x1a, 0x1b,
+	0xc6, 0x66, 0xb3, 0xfc, 0x2a, 0x31, 0x19, 0xf9, 0xa7, 0x0f, 0x90, 0xc2, 0xde, 0x87, 0xab, 0xcb,
+	0x5d, 0xe4, 0xa8, 0x56, 0x93, 0xfa, 0x5c, 0x5a, 0xe8, 0xc3, 0x89, 0x84, 0xc6, 0xaa, 0x3b, 0xe5,
+	0x67, 0x89, 0xdc, 0x44, 0x4d, 0xde, 0xcd, 0x2a, 0x16, 0x70, 0x0d, 0xda, 0x41, 0xe6, 0x64, 0x41,
+	0x74, 0x42, 0xbb, 0xc9, 0xe4, 0xad, 0x20, 0x3b, 0x0c, 0xa2, 0x13, 0xf6, 0x22, 0x98, 0xa9, 0xf0,
+	0x24, 0xc5, 0x24, 0x4a, 0x3b, 0x15, 0x1e, 0x92, 0xec, 0xd7, 0xa0, 0xb9, 0x2f, 0xd2, 0x89, 0xa0,
+	0x8d, 0x12, 0x44, 0x27, 0x87, 0x9e, 0x1b, 0x91, 0x78, 0x4d, 0x5e, 0xc0, 0xf6, 0xdf, 0xd4, 0x60,
+	0x6d, 0x7f, 0x1e, 0xe6, 0xc1, 0x4e, 0x3a, 0x99, 0x8b, 0x59, 0x94, 0xe3, 0x1e, 0xdd, 0x0b, 0xb2,
+	0x5c, 0x71, 0x52, 0x9b, 0x6d, 0x42, 0xe7, 0xc7, 0x69, 0x3c, 0x4f, 0xee, 0x9f, 0x26, 0x5a, 0x01,
+	0xb0, 0x45, 0xee, 0x0b, 0x31, 0xbc, 0x24, 0xb2, 0x77, 0xa0, 0xfb, 0x28, 0xf5, 0x45, 0x7a, 0xef,
+	0x8c, 0x78, 0x8d, 0x73, 0xbc, 0x55, 0x32, 0xbb, 0x01, 0x9d, 0x43, 0x91, 0xb8, 0xa9, 0x8b, 0x9a,
+	0x41, 0x73, 0xed, 0xf0, 0x12, 0x81, 0x7e, 0x85, 0x98, 0x47, 0xbe, 0x32, 0x53, 0x0d, 0xda, 0x13,
+	0xe8, 0xec, 0x4c, 0x26, 0xa9, 0x98, 0xb8, 0x39, 0x39, 0x99, 0x38, 0xa1, 0xe5, 0x1a, 0xbc, 0x1e,
+	0x27, 0xe4, 0xc8, 0xf0, 0x03, 0xea, 0xf2, 0x03, 0xb0, 0xcd, 0x6e, 0x42, 0x43, 0xc8, 0xf5, 0xd4,
+	0x96, 0xd6, 0x43, 0x78, 0x76, 0x15, 0x5a, 0x5e, 0x1c, 0x8d, 0x83, 0x89, 0x72, 0x7f, 0x0a, 0xb2,
+	0xff, 0xc0, 0x80, 0x26, 0x7d, 0x1c, 0xba, 0xa9, 0x48, 0x08, 0xdf, 0x11, 0x9f, 0xb9, 0xa1, 0x96,
+	0x22, 0x22, 0xee, 0x7f, 0xe6, 0x86, 0xb8, 0xd2, 0xe0, 0x78, 0xee, 0x9d, 0x08, 0x39, 0x6b, 0x83,
+	0x6b, 0x10, 0x29, 0x91, 0xa2, 0x18, 0x92, 0xa2, 0x40, 0xb6, 0x01, 0x4d, 0x9c, 0x3a, 0x23, 0x6b,
+	0x5a, 0x5c, 0x93, 0x24, 0x20, 0x07, 0xda, 0x43, 0x66, 0x35, 0xab, 0x1c, 0x68, 0x0f, 0x5c, 0x12,
+	0xd8, 0x9b, 0xd0, 0x70, 0x27, 0x93, 0xcc, 0x6a, 0x2d, 0xef, 0x89, 0x42, 0x3a, 0x9c, 0x18, 0xd8,
+	0x07, 0xd0, 0x91, 0x5a, 0x46, 0xee, 0x36, 0x71, 0x5f, 0xab, 0x1c, 0x0c, 0x55, 0x03, 0xe0, 0x25,
+	0x27, 0xea, 0x27, 0xc8, 0x94, 0xff, 0x50, 0xe6, 0x55, 0x22, 0x98, 0x0d, 0xbd, 0x24, 0x15, 0x3b,
+	0x61, 0x18, 0x7b, 0x87, 0xc1, 0x33, 0xa1, 0x9c, 0xf7, 0x02, 0x8e, 0xbd, 0x01, 0xfd, 0x03, 0x37,
+	0xcd, 0x03, 0x37, 0xe4, 0x22, 0x9b, 0x87, 0x79, 0x46, 0xae, 0xba, 0xc7, 0x97, 0xb0, 0x6c, 0x0b,
+	0xd8, 0x02, 0xe6, 0x88, 0x3e, 0x1c, 0x36, 0x8c, 0xcd, 0x35, 0xbe, 0x82, 0x62, 0xff, 0x67, 0x1d,
+	0x5a, 0xa3, 0x28, 0x13, 0x29, 0x9d, 0x11, 0xee, 0x78, 0x2c, 0xbc, 0x5c, 0x48, 0xef, 0xd1, 0xe0,
+	0x05, 0x8c, 0x1f, 0x70, 0x14, 0x7f, 0x92, 0x06, 0xb9, 0x38, 0x7c, 0x5f, 0x19, 0x44, 0x89, 0x60,
+	0xb7, 0x61, 0xdd, 0xf5, 0x7d, 0x47, 0x73, 0x3b, 0x69, 0xfc, 0x34, 0x23, 0x35, 0x99, 0x7c, 0xe0,
+	0xfa, 0xfe, 0x8e, 0xc2, 0xf3, 0xf8, 0x69, 0xc6, 0x5e, 0x05, 0x23, 0x15, 0x63, 0x32, 0x8f, 0xee,
+	0xf6, 0x40, 0xaa, 0xe2, 0xd1, 0xf1, 0xcf, 0x84, 0x97, 0x73, 0x31, 0xe6, 0x48, 0x63, 0x97, 0xa1,
+	0xe9, 0xe6, 0x79, 0x2a, 0xf5, 0xd5, 0xe1, 0x12, 0x60, 0x5b, 0x70, 0x29, 0xc1, 0xf5, 0xe7, 0x41,
+	0x1c, 0x39, 0xb9, 0x7b, 0x1c, 0xe2, 0x09, 0x93, 0x29, 0x67, 0xba, 0x5e, 0x90, 0x8e, 0x90, 0x32,
+	0xf2, 0x33, 0x74, 0xbf, 0xcb, 0xfc, 0x91, 0x3b, 0x13, 0x52, 0x6d, 0x1d, 0x7e, 0x69, 0xb1, 0xc7,
+	0x43, 0x24, 0xb1, 0xd7, 0x60, 0xad, 0xec, 0x13, 0xf8, 0xa7, 0xa4, 0xab, 0x26, 0xef, 0x15, 0x48,
+	0x3c, 0x67, 0xae, 0x40, 0x2b, 0xc8, 0x1c, 0x11, 0xf9, 0xa4, 0x28, 0x93, 0x37, 0x83, 0xec, 0x7e,
+	0xe4, 0xb3, 0xb7, 0xa1, 0x23, 0x67, 0xf1, 0xc5, 0x98, 0xce, 0xd8, 0xee, 0x76, 0x5f, 0x59, 0x1a,
+	0xa2, 0xf7, 0xc4, 0x98, 0x9b, 0xb9, 0x6a, 0xd9, 0x2f, 0x43, 0x73, 0x27, 0x4d, 0xdd, 0x33, 0xfa,
+	0x56, 0x6c, 0x58, 0x35, 0xf2, 0x6b, 0x12, 0xb0, 0x3d, 0x30, 0xf6, 0xdd, 0x84, 0xdd, 0x82, 0xfa,
+	0x2c, 0x21, 0x4a, 0x77, 0xfb, 0x4a, 0xc5, 0xcc, 0xdc, 0x64, 0x6b, 0x3f, 0xb9, 0x1f, 0xe5, 0xe9,
+	0x19, 0xaf, 0xcf, 0x92, 0xeb, 0x1f, 0x40, 0x5b, 0x81, 0x18, 0x8e, 0x9c, 0x88, 0x33, 0x52, 0x5f,
+	0x87, 0x63, 0x13, 0x27, 0xf8, 0xcc, 0x0d, 0xe7, 0xfa, 0x3c, 0x94, 0xc0, 0x2f, 0xd7, 0x7f, 0x50,
+	0xb3, 0x7f, 0xa7, 0x09, 0xe6, 0x9e, 0x08, 0x05, 0x7e, 0x17, 0x6e, 0xfe, 0xa3, 0x4c, 0xa9, 0xbd,
+	0x7e, 0x94, 0xa1, 0x4d, 0x56, 0xd5, 0xa6, 0xb6, 0xe3, 0x02, 0x0e, 0x79, 0xa4, 0xe7, 0xa5, 0x51,
+	0x84, 0xd2, 0xf8, 0x02, 0x0e, 0xf7, 0xed, 0xe8, 0x9e, 0xdc, 0xb7, 0x0d, 0x8a, 0x3b, 0x34, 0x88,
+	0x94, 0x87, 0x8a, 0xd2, 0x94, 0x14, 0x05, 0xb2, 0x1b, 0x00, 0x69, 0xfc, 0xd4, 0x09, 0x7c, 0x52,
+	0x81, 0xf4, 0xe2, 0x66, 0x1a, 0x3f, 0x1d, 0xf9, 0x28, 0xfe, 0x0b, 0xec, 0xa0, 0xfd, 0x95, 0xed,
+	0xc0, 0xbc, 0xd8, 0x0e, 0x7e, 0x09, 0xac, 0xb2, 0x0f, 0x45, 0x29, 0x4e, 0x10, 0x39, 0x14, 0x4d,
+	0x91, 0xd2, 0x9b, 0xbc, 0x1c, 0x93, 0xc2, 0x95, 0x51, 0x74, 0x0f, 0x89, 0xda, 0xba, 0xe1, 0x39,
+	0xd6, 0xbd, 0x72, 0xb3, 0x74, 0x57, 0x6f, 0x96, 0x7b, 0x00, 0x87, 0x62, 0x32, 0x13, 0x51, 0xbe,
+	0xef, 0x26, 0x56, 0x8f, 0x0c, 0xc1, 0x2e, 0x0d, 0x41, 0x6b, 0x6f, 0xab, 0x64, 0x92, 0x56, 0x51,
+	0xe9, 0x85, 0xa7, 0xa2, 0xe7, 0x46, 0x4e, 0x9e, 0xce, 0x23, 0xcf, 0xcd, 0x85, 0xb5, 0x46, 0x53,
+	0x75, 0x3d, 0x37, 0x3a, 0x52, 0xa8, 0x8a, 0x45, 0xf7, 0xab, 0x16, 0xfd, 0x06, 0x0c, 0x92, 0x34,
+	0x98, 0xb9, 0xe9, 0x99, 0x73, 0x22, 0xce, 0x48, 0x19, 0x03, 0x19, 0x78, 0x29, 0xf4, 0x4f, 0xc5,
+	0xd9, 0xc8, 0x3f, 0xbd, 0xfe, 0x21, 0x0c, 0x96, 0x16, 0xf0, 0x95, 0xec, 0xf0, 0xef, 0x6b, 0xd0,
+	0x39, 0x48, 0x85, 0xf2, 0x42, 0xaf, 0x40, 0x37, 0xf3, 0xa6, 0x62, 0xe6, 0x92, 0x96, 0xd4, 0x08,
+	0x20, 0x51, 0xa8, 0x9c, 0xc5, 0x7d, 0x56, 0x7f, 0xfe, 0x3e, 0xc3, 0x75, 0xe0, 0xb2, 0x0d, 0xda,
+	0x5c, 0xd8, 0x2c, 0x9d, 0x4b, 0xa3, 0xea, 0x5c, 0x36, 0xa0, 0x37, 0x75, 0x33, 0xc7, 0x9d, 0xe7,
+	0xb1, 0xe3, 0xc5, 0x21, 0x59, 0xa4, 0xc9, 0x61, 0xea, 0x66, 0x3b, 0xf3, 0x3c, 0xde, 0x8d, 0x43,
+	0x3c, 0xb7, 0x82, 0xcc, 0x99, 0x27, 0x3e, 0xca, 0xb0, 0x45, 0x64, 0x33, 0xc8, 0x1e, 0x13, 0x6c,
+	0xff, 0x6b, 0x1d, 0xe0, 0x41, 0xec, 0x9d, 0x1c, 0xb9, 0xe9, 0x44, 0xe4, 0x18, 0x4c, 0x68, 0xc3,
+	0x54, 0x5b, 0xaa, 0x9d, 0x4b, 0x73, 0x64, 0xdb, 0x70, 0x55, 0xcb, 0xd4, 0x8b, 0x43, 0x0a, 0x6c,
+	0xa4, 0x65, 0x29, 0xb9, 0x30, 0x45, 0x95, 0x31, 0x2d, 0x99, 0x15, 0xdb, 0x86, 0x41, 0xb5, 0x4f,
+	0x7e, 0x96, 0x2c, 0x9e, 0xbf, 0x74, 0x92, 0xad, 0x95, 0x1d, 0x8f, 0xce, 0x12, 0xf6, 0x2e, 0x5c,
+	0x49, 0xc5, 0x38, 0x15, 0xd9, 0xd4, 0xc9, 0xb3, 0xea, 0x34, 0x0d, 0x9a, 0x66, 0x5d, 0x11, 0x8f,
+	0xb2, 0x62, 0x96, 0x77, 0xe1, 0xca, 0x38, 0x08, 0x73, 0x91, 0x2e, 0x2f, 0x4c, 0xc6, 0x0c, 0xeb,
+	0x92, 0x58, 0x5d, 0xd7, 0xcb, 0x40, 0xb7, 0x2b, 0xb9, 0xa9, 0x94, 0x4c, 0x3a, 0x21, 0x89, 0xe1,
+	0x38, 0x14, 0x78, 0x66, 0xec, 0x4e, 0x31, 0x52, 0xdd, 0x13, 0x63, 0x15, 0x6d, 0x95, 0x08, 0x66,
+	0x43, 0x63, 0x3f, 0xf6, 0xe5, 0x69, 0xd8, 0xdf, 0xee, 0x6f, 0x61, 0xbf, 0x2d, 0x94, 0x21, 0x62,
+	0x39, 0xd1, 0xec, 0x87, 0xd0, 0x42, 0xcc, 0xa3, 0x84, 0x6d, 0x41, 0x3b, 0x27, 0xd9, 0x66, 0xca,
+	0x1d, 0x5e, 0x2e, 0x77, 0x41, 0x29, 0x78, 0xae, 0x99, 0x50, 0xcb, 0xc7, 0x38, 0xa2, 0x3a, 0xab,
+	0x24, 0x60, 0x73, 0x18, 0x14, 0x86, 0xf6, 0x38, 0x0a, 0x9e, 0xcc, 0x05, 0xfb, 0x08, 0xd6, 0x93,
+	0x54, 0x38, 0x01, 0xe1, 0x9c, 0xf9, 0x89, 0xe3, 0xe5, 0xf2, 0x7a, 0x41, 0x53, 0xa0, 0x74, 0xcb,
+	0x1e, 0x27, 0xbb, 0xf9, 0x29, 0xef, 0x27, 0x0b, 0xb0, 0xfd, 0x29, 0x5c, 0x2b, 0x38, 0x0e, 0x85,
+	0x17, 0x47, 0xbe, 0x9b, 0x9e, 0x91, 0x4f, 0x58, 0x1a, 0x3b, 0xfb, 0x2a, 0x63, 0x1f, 0xd2, 0xd8,
+	0x7f, 0x6e, 0x40, 0xff, 0x51, 0xb4, 0x37, 0x4f, 0xc2, 0x00, 0xf7, 0xe9, 0x4f, 0xe5, 0x36, 0x92,
+	0xe6, 0x5b, 0xab, 0x9a, 0xef, 0x26, 0x0c, 0xd5, 0x2c, 0xa8, 0x3b, 0x2f, 0x9e, 0x47, 0xda, 0x9e,
+	0xfa, 0x12, 0xbf, 0x1b, 0x87, 0xbb, 0x88, 0x65, 0x1f, 0xc2, 0x95, 0x39, 0x7d, 0xb9, 0xe4, 0xc4,
+	0x3b, 0xa0, 0x23, 0x56, 0x47, 0x98, 0x4c, 0x32, 0x62, 0x57, 0x64, 0x43, 0x1c, 0xee, 0xce, 0xb2,
+	0xbb, 0xde, 0x43, 0x50, 0x30, 0xd2, 0x4a, 0xe2, 0xc8, 0xf1, 0xf5, 0x92, 0xc9, 0x69, 0xc8, 0x90,
+	0xbd, 0x1f, 0x97, 0x5f, 0x82, 0x7e, 0xfc, 0x37, 0x60, 0x7d, 0x81, 0x93, 0x56, 0x21, 0x03, 0xb0,
+	0x3b, 0xa5, 0x72, 0x17, 0x3f, 0xbf, 0x0a, 0xe2, 0x7a, 0xa4, 0xb7, 0x1b, 0xc4, 0x8b, 0x58, 0xb5,
+	0x57, 0x83, 0x49, 0x14, 0xa7, 0x42, 0x59, 0x9e, 0x19, 0x64, 0x23, 0x82, 0xaf, 0x3f, 0x84, 0xcb,
+	0xab, 0x46, 0x59, 0xe1, 0xb2, 0x36, 0xaa, 0x2e, 0x6b, 0x29, 0xb2, 0x2c, 0xdd, 0xd7, 0x63, 0xe8,
+	0x7e, 0x3c, 0x7f, 0xf6, 0xec, 0xec, 0x63, 0xda, 0x1f, 0xac, 0x07, 0xb5, 0x87, 0x34, 0x48, 0x9d,
+	0xd7, 0x1e, 0x62, 0x3c, 0x7c, 0x70, 0x82, 0x6e, 0x8b, 0xc6, 0xe8, 0x70, 0x05, 0xe1, 0xd0, 0x07,
+	0x27, 0x47, 0x2b, 0x37, 0xb2, 0x24, 0xd8, 0x7f, 0x68, 0x40, 0xe3, 0xd7, 0xe2, 0x20, 0xaa, 0xc6,
+	0xc4, 0xb5, 0x0b, 0x63, 0xe2, 0xfa, 0x62, 0x4c, 0x4c, 0xb7, 0x99, 0xd0, 0x09, 0x31, 0x7c, 0x97,
+	0xbe, 0xaf, 0x9d, 0x8a, 0xf0, 0x01, 0x46, 0xf0, 0x2f, 0x82, 0xe9, 0xc5, 0x8a, 0x24, 0xef, 0x5f,
+	0x6d, 0x2f, 0x0e, 0x1f, 0x54, 0x83, 0xfb, 0xe6, 0x05, 0xc1, 0x7d, 0x11, 0x47, 0xb7, 0x2e, 0x8e,
+	0xa3, 0x3b, 0xa1, 0x18, 0xa3, 0x15, 0x46, 0xbe, 0xd5, 0xae, 0x72, 0xd1, 0x30, 0x26, 0x12, 0x77,
+	0xe3, 0xc8, 0x67, 0x6f, 0x01, 0xa4, 0xc1, 0x64, 0xaa, 0x38, 0xcd, 0xf3, 0x37, 0x21, 0xa2, 0x12,
+	0x2b, 0x87, 0x17, 0xd3, 0x79, 0x94, 0x07, 0x33, 0xe1, 0x28, 0xff, 0x74, 0x3c, 0x0f, 0x42, 0x5f,
+	0x7e, 0x41, 0x47, 0x87, 0xe0, 0xd8, 0x93, 0x4b, 0x36, 0xa9, 0x88, 0xc3, 0x44, 0x78, 0xfc, 0x6a,
+	0x5a, 0x45, 0xdd, 0xc3, 0x7e, 0xf4, 0xa5, 0x37, 0x00, 0x5d, 0xfb, 0xd4, 0x89, 0x23, 0x27, 0x39,
+	0xa1, 0xd3, 0xda, 0xe4, 0x26, 0x62, 0x1e, 0x45, 0x07, 0x27, 0xe8, 0xd7, 0xf0, 0x92, 0xa8, 0xc2,
+	0xf5, 0xee, 0x52, 0xb8, 0x6e, 0xff, 0x65, 0x1d, 0xcc, 0x9d, 0x28, 0x0f, 0xbe, 0xb6, 0x76, 0xae,
+	0x42, 0x2b, 0xa5, 0x10, 0x5c, 0xe9, 0x46, 0x41, 0x85, 0xfc, 0x1b, 0x5f, 0x24, 0xff, 0xe6, 0x97,
+	0x92, 0x7f, 0xeb, 0x4b, 0xcb, 0xbf, 0xfd, 0x3c, 0xf9, 0x2f, 0xca, 0xca, 0x7c, 0xae, 0xac, 0x3a,
+	0xcb, 0xb2, 0xfa, 0x63, 0x03, 0xcc, 0x07, 0x62, 0x9c, 0x7f, 0x6f, 0xc9, 0xdf, 0x45, 0x4b, 0xfe,
+	0x67, 0x03, 0x3a, 0x1c, 0x97, 0xf7, 0x1d, 0x53, 0xcf, 0x5b, 0x00, 0x24, 0xfc, 0x8b, 0x74, 0x44,
+	0xaa, 0x39, 0x22, 0x3d, 0xbd, 0x0d, 0x5d, 0x29, 0x7e, 0xc9, 0xdb, 0x3e, 0xc7, 0x2b, 0xb5, 0x73,
+	0x74, 0x5e, 0xa9, 0xe6, 0x97, 0x56, 0x6a, 0xe7, 0x6b, 0x2b, 0x15, 0xbe, 0x09, 0xa5, 0x76, 0x9f,
+	0xab, 0xd4, 0xde, 0xb2, 0x52, 0x7f, 0xcf, 0x80, 0x35, 0x52, 0xea, 0xa1, 0x98, 0xfd, 0xdf, 0xfb,
+	0xa8, 0x25, 0x7d, 0x34, 0xbf, 0xbc, 0x3e, 0xbe, 0x21, 0x77, 0xf5, 0x5c, 0x7d, 0x98, 0xdf, 0x84,
+	0x3e, 0x3a, 0xcf, 0xd5, 0x07, 0x5c, 0xa8, 0x8f, 0x6f, 0xe5, 0xcc, 0xf8, 0x5e, 0x1f, 0xcb, 0xfa,
+	0xf8, 0x79, 0x1d, 0xcc, 0x6f, 0x65, 0x6b, 0x7c, 0x3b, 0xc7, 0xf7, 0x77, 0x4e, 0xfe, 0x7f, 0x62,
+	0x00, 0x1c, 0x06, 0xd1, 0x24, 0x14, 0xdf, 0x07, 0x05, 0xdf, 0xc5, 0xa0, 0xe0, 0x1f, 0xeb, 0x60,
+	0xee, 0xbb, 0xe9, 0xc9, 0xff, 0x93, 0xfd, 0xf1, 0x1a, 0xb4, 0xe3, 0xa8, 0xba, 0x1b, 0xaa, 0x7c,
+	0xad, 0x38, 0xfa, 0xdf, 0x1b, 0xfc, 0x6f, 0xd7, 0xa0, 0x7d, 0x90, 0xc6, 0xfe, 0xdc, 0x5b, 0xb4,
+	0xdc, 0xda, 0xc5, 0x96, 0x5b, 0x5f, 0xb4, 0xdc, 0x42, 0x32, 0xc6, 0x45, 0x92, 0x59, 0x5c, 0x42,
+	0x63, 0x79, 0x09, 0x7f, 0x54, 0x83, 0x0e, 0xbd, 0x49, 0x90, 0x52, 0x4b, 0x05, 0xd5, 0x16, 0x14,
+	0x54, 0x4c, 0x53, 0xbf, 0x68, 0x9a, 0xe7, 0x1a, 0xab, 0xf1, 0xb5, 0x8c, 0xd5, 0xfe, 0xfd, 0x1a,
+	0xac, 0xd1, 0x83, 0xd1, 0xc7, 0xf3, 0xc8, 0xa3, 0xb7, 0xe8, 0xd5, 0x6f, 0x1c, 0x1b, 0xd0, 0x48,
+	0x45, 0xae, 0x17, 0xd7, 0x93, 0xd3, 0xec, 0xc6, 0x21, 0x3e, 0xf8, 0x11, 0x05, 0x0d, 0xcc, 0x4d,
+	0x27, 0xd9, 0x8a, 0xa7, 0x0c, 0xc2, 0xe3, 0x77, 0x63, 0x4a, 0x6c, 0x96, 0xe9, 0xe4, 0x94, 0x84,
+	0x30, 0xd1, 0x45, 0x6f, 0x8d, 0x4d, 0xba, 0xa2, 0x53, 0xdb, 0xfe, 0xdb, 0x3a, 0x74, 0x7e, 0xe2,
+	0x66, 0x53, 0x5a, 0x67, 0x99, 0xb4, 0x42, 0xfb, 0xad, 0x26, 0xad, 0xd4, 0x6b, 0x03, 0x11, 0xd1,
+	0x1e, 0xac, 0x7a, 0x49, 0xc4, 0xee, 0xd5, 0x0d, 0x64, 0x5c, 0xb8, 0x81, 0x1a, 0xe7, 0x32, 0x5a,
+	0x5f, 0xb0, 0x11, 0x36, 0xa0, 0x89, 0x96, 0x9d, 0xad, 0xd8, 0x04, 0x92, 0xb0, 0x64, 0xb1, 0xed,
+	0x25, 0x8b, 0xbd, 0x0d, 0xeb, 0xb4, 0xe4, 0x19, 0xe6, 0x35, 0x7d, 0xf5, 0xb0, 0x2d, 0xaf, 0x76,
+	0x03, 0x24, 0x50, 0xbe, 0xd3, 0x97, 0x4f, 0xda, 0xef, 0x00, 0x23, 0x5e, 0x17, 0x73, 0x51, 0xf8,
+	0x50, 0x93, 0x89, 0x30, 0x53, 0x7b, 0x60, 0x88, 0x94, 0x1d, 0x45, 0x38, 0x14, 0x61, 0x66, 0xef,
+	0xc0, 0x95, 0xfb, 0xa7, 0xb9, 0x48, 0x23, 0x37, 0xc4, 0x87, 0x8e, 0x6d, 0x7c, 0x2f, 0xa4, 0xc7,
+	0x30, 0x2d, 0xe4, 0x5a, 0x29, 0x64, 0x54, 0x74, 0xb5, 0x04, 0x40, 0x02, 0xf6, 0x2d, 0xe8, 0x8e,
+	0x83, 0x50, 0x38, 0xf1, 0x78, 0x9c, 0x49, 0x77, 0x22, 0x5b, 0x64, 0x0e, 0x06, 0x57, 0x90, 0xfd,
+	0xdf, 0x75, 0xe8, 0xe9, 0xa9, 0x30, 0x05, 0x7b, 0x81, 0xd9, 0xbc, 0x04, 0x1d, 0x1a, 0x2d, 0xc3,
+	0xcc, 0x5a, 0x9d, 0x46, 0x30, 0x11, 0x41, 0x59, 0xb5, 0x1d, 0x58, 0xaf, 0x4c, 0xe5, 0xe4, 0x71,
+	0xee, 0x86, 0x96, 0xb1, 0x9c, 0x6f, 0xa9, 0xb0, 0xf0, 0x01, 0x02, 0x8f, 0xa8, 0x7d, 0x84, 0xdc,
+	0x68, 0x96, 0xc5, 0x53, 0xd8, 0x39, 0xb3, 0x44, 0x0a, 0xfb, 0x31, 0x0c, 0xf0, 0x6b, 0xb7, 0xe5,
+	0xbb, 0x2a, 0x7d, 0xaf, 0x54, 0xec, 0x2b, 0xe5, 0x14, 0x2b, 0x65, 0xc6, 0xd7, 0xa2, 0x2a, 0x88,
+	0x9b, 0xdc, 0x4b, 0x05, 0xa9, 0xe0, 0x49, 0x48, 0xef, 0xad, 0x1d, 0xde, 0x91, 0x98, 0xc3, 0x27,
+	0x61, 0xf1, 0xa5, 0xb4, 0x19, 0x65, 0x92, 0x8b, 0xbe, 0x94, 0x5c, 0xc8, 0x1d, 0xe8, 0xc6, 0x69,
+	0x30, 0x09, 0x22, 0xf9, 0x70, 0x67, 0xae, 0x58, 0x2d, 0x48, 0x06, 0x7a, 0xc6, 0xb3, 0xa1, 0x25,
+	0x37, 0x38, 0x29, 0x7a, 0xc9, 0x29, 0x4a, 0x8a, 0xed, 0x01, 0x1c, 0xe6, 0xa9, 0x70, 0x67, 0x24,
+	0xfd, 0x37, 0xa1, 0x9d, 0x1f, 0x87, 0xf4, 0x28, 0x5f, 0x5b, 0xf9, 0x28, 0xdf, 0xca, 0x8f, 0x71,
+	0x9a, 0x8a, 0x3e, 0xeb, 0x94, 0x6a, 0x56, 0x10, 0xaa, 0x2f, 0x0c, 0x66, 0x41, 0xae, 0x8a, 0x32,
+	0x24, 0x60, 0xff, 0xbc, 0x06, 0x70, 0xe8, 0xce, 0x12, 0xe9, 0x1e, 0xd8, 0x8f, 0xa0, 0x9b, 0x11,
+	0x24, 0x33, 0xfc, 0xb2, 0x7c, 0xa7, 0x22, 0xc7, 0x92, 0x55, 0x35, 0x65, 0x8c, 0x9b, 0x15, 0x6d,
+	0xca, 0x2f, 0xc8, 0x11, 0x52, 0x9d, 0xd7, 0x6a, 0x6a, 0x06, 0xca, 0xb9, 0xdc, 0x82, 0xbe, 0x62,
+	0x48, 0x44, 0xea, 0x89, 0x48, 0x2e, 0xa8, 0xc6, 0xd7, 0x24, 0xf6, 0x40, 0x22, 0xd9, 0x7b, 0x05,
+	0x9b, 0x17, 0x87, 0xf3, 0x59, 0xb4, 0x2a, 0xff, 0xac, 0xba, 0xec, 0x4a, 0x06, 0x7b, 0x5b, 0x7f,
+	0x0a, 0x2d, 0xc4, 0x84, 0x06, 0xce, 0x37, 0x7c, 0x81, 0x75, 0xa1, 0xad, 0x46, 0x1d, 0xd6, 0xd8,
+	0x1a, 0x74, 0x68, 0xf7, 0x11, 0xad, 0x6e, 0xff, 0xee, 0x00, 0xba, 0xa3, 0x28, 0xcb, 0xd3, 0xb9,
+	0xa7, 0xf3, 0x74, 0x2a, 0x49, 0xdf, 0xa4, 0x24, 0xbd, 0x4a, 0x70, 0xc8, 0xcf, 0xc0, 0x26, 0x7b,
+	0x03, 0x1a, 0x6e, 0x94, 0x07, 0xea, 0x65, 0xb1, 0x52, 0xa0, 0xa1, 0xef, 0x1f, 0x9c, 0xe8, 0xec,
+	0x0e, 0xb4, 0x55, 0x35, 0x87, 0x3a, 0x91, 0x57, 0x96, 0x82, 0x68, 0x1e, 0xb6, 0x05, 0xa6, 0xaf,
+	0xca, 0x4c, 0xac, 0xe6, 0xf2, 0xd0, 0xba, 0x00, 0x85, 0x17, 0x3c, 0x98, 0x09, 0x73, 0x27, 0x13,
+	0xab, 0xa5, 0x33, 0x61, 0x9a, 0x95, 0xaa, 0x00, 0x38, 0xd2, 0xd8, 0x5d, 0x75, 0x9c, 0xff, 0x2c,
+	0x0e, 0x22, 0xcb, 0x5c, 0x1e, 0x53, 0x3f, 0x1b, 0xc9, 0x63, 0x1d, 0x5b, 0xd8, 0x21, 0x13, 0xb3,
+	0x40, 0x76, 0xe8, 0x2c, 0x77, 0xd0, 0x41, 0x3d, 0x96, 0x2f, 0xc9, 0x16, 0xfb, 0x00, 0xba, 0x19,
+	0x85, 0x9a, 0xb2, 0x0b, 0xe8, 0xb7, 0xf7, 0xa2, 0x4b, 0x11, 0x87, 0x72, 0xc8, 0x8a, 0x36, 0xce,
+	0x33, 0x73, 0xd3, 0x13, 0xd9, 0xa9, 0xbb, 0x3c, 0x8f, 0x0e, 0x8e, 0xb8, 0x39, 0x53, 0x2d, 0x4c,
+	0x66, 0x10, 0x6f, 0x4f, 0x5b, 0xbe, 0xe6, 0x95, 0xf2, 0x46, 0x1a, 0x7b, 0x1b, 0xda, 0x89, 0x8c,
+	0x02, 0x28, 0x05, 0xd7, 0xdd, 0x5e, 0x2f, 0xd9, 0x54, 0x78, 0xc0, 0x35, 0x07, 0xfb, 0x55, 0xe8,
+	0xcb, 0x0c, 0xd2, 0x58, 0x1d, 0x8a, 0x94, 0x99, 0x5b, 0x28, 0x36, 0x58, 0x38, 0x33, 0xf9, 0x5a,
+	0x5e, 0x05, 0xd9, 0xb6, 0x72, 0xff, 0x74, 0x3c, 0x5b, 0x83, 0x65, 0xfd, 0x16, 0x27, 0x1b, 0xef,
+	0x4c, 0x75, 0x93, 0xfd, 0x10, 0xd6, 0x84, 0x72, 0x43, 0x4e, 0x86, 0x35, 0x2e, 0x43, 0xea, 0x76,
+	0xf5, 0xbc, 0x97, 0xc2, 0x0d, 0xcf, 0x7b, 0xa2, 0x02, 0xb1, 0x4d, 0x68, 0xc9, 0x4c, 0x83, 0xb5,
+	0x4e, 0xbd, 0x2a, 0xe5, 0x72, 0x32, 0xa3, 0xc1, 0x15, 0x9d, 0xdd, 0x5b, 0xca, 0x10, 0xe0, 0xa3,
+	0x3b, 0xa3, 0x3e, 0xd6, 0x45, 0xcf, 0xfe, 0x0b, 0xb9, 0x03, 0xcc, 0x82, 0x6c, 0x03, 0x94, 0x99,
+	0x15, 0xeb, 0xd2, 0xf2, 0xe7, 0x15, 0x69, 0x15, 0xde, 0x29, 0x32, 0x2a, 0xec, 0xfe, 0x62, 0xa6,
+	0x87, 0x52, 0x16, 0xd6, 0x65, 0xea, 0xfa, 0xe2, 0x8a, 0xae, 0x32, 0x3f, 0xc4, 0x07, 0xc9, 0x22,
+	0x82, 0xbd, 0x03, 0x66, 0x8c, 0xd5, 0x33, 0xce, 0xf1, 0x99, 0x75, 0x85, 0x76, 0xfc, 0xba, 0x4a,
+	0xf3, 0xca, 0x7a, 0x1c, 0x8a, 0x75, 0xda, 0xb1, 0x04, 0xd8, 0x1d, 0x2c, 0xed, 0x88, 0x31, 0xff,
+	0x2b, 0xdd, 0xf2, 0xd5, 0xf3, 0x75, 0x3c, 0x8a, 0x4e, 0x5e, 0xba, 0x74, 0xbb, 0xd7, 0x2e, 0x72,
+	0xbb, 0xa5, 0x9f, 0xb4, 0x28, 0x6a, 0x90, 0x40, 0xc5, 0xab, 0xbe, 0x48, 0x68, 0x05, 0x51, 0xfc,
+	0x91, 0x7d, 0x1c, 0xa4, 0x59, 0x6e, 0x5d, 0x97, 0x65, 0x4d, 0x0a, 0xc4, 0x1e, 0x41, 0xf6, 0xc0,
+	0xcd, 0x72, 0xeb, 0x25, 0x5d, 0x09, 0x85, 0x10, 0xca, 0x56, 0xc6, 0xce, 0x64, 0xd1, 0x37, 0x96,
+	0x65, 0x5b, 0xbc, 0x17, 0xaa, 0x20, 0x1a, 0x9b, 0xec, 0x23, 0x18, 0xc8, 0x3e, 0xe5, 0xf6, 0x7c,
+	0x79, 0xd9, 0x5e, 0x17, 0xde, 0xa4, 0xf8, 0x5a, 0x5a, 0x05, 0xcb, 0x01, 0xd0, 0x35, 0xc9, 0x01,
+	0x6e, 0xae, 0x1c, 0xa0, 0x70, 0x62, 0x6b, 0x69, 0x15, 0x64, 0xb7, 0xa1, 0xe5, 0xcb, 0x2a, 0x84,
+	0x57, 0xce, 0x39, 0x27, 0x95, 0x25, 0xe7, 0x8a, 0x83, 0xbd, 0x05, 0x6d, 0xca, 0x5b, 0xc6, 0x89,
+	0xb5, 0xb1, 0x6c, 0xac, 0x32, 0xdf, 0xc8, 0x5b, 0x21, 0xfd, 0xe2, 0xa6, 0xd5, 0x41, 0xf5, 0xab,
+	0xcb, 0x9b, 0x56, 0x05, 0xd7, 0x5c, 0x73, 0xb0, 0x5b, 0xd0, 0xa4, 0x80, 0xca, 0xb2, 0x97, 0x9d,
+	0x9e, 0xf4, 0xe8, 0x92, 0x4a, 0x4e, 0x89, 0xce, 0x4d, 0xb9, 0xcb, 0x5e, 0x3b, 0xe7, 0x94, 0x8a,
+	0x43, 0x95, 0x43, 0x56, 0xb4, 0xd9, 0x6f, 0xc2, 0xf5, 0x6a, 0x36, 0x51, 0xa7, 0x1a, 0x55, 0x44,
+	0xf1, 0x3a, 0x8d, 0xf2, 0xea, 0x0a, 0x43, 0x5e, 0x4c, 0x4a, 0xf2, 0x6b, 0xc9, 0x6a, 0x02, 0x2d,
+	0x4b, 0x1e, 0x68, 0xe8, 0x73, 0xac, 0x5b, 0xe7, 0x96, 0x55, 0x1c, 0xad, 0xfa, 0xb8, 0xc4, 0x36,
+	0xfb, 0x01, 0xf4, 0xc6, 0x98, 0xfe, 0x52, 0x17, 0x02, 0xeb, 0x8d, 0x8d, 0xda, 0x62, 0xf4, 0x54,
+	0x49, 0x8e, 0xf1, 0xee, 0xb8, 0x04, 0xb0, 0x16, 0xcf, 0x8b, 0x1c, 0xd7, 0xf7, 0x53, 0xeb, 0x4d,
+	0x99, 0x1c, 0xf3, 0xa2, 0x1d, 0xdf, 0xa7, 0x24, 0x63, 0x9c, 0x08, 0xaa, 0x5d, 0xc3, 0x0c, 0xfa,
+	0xa6, 0x3c, 0xa2, 0x35, 0x6a, 0xe4, 0x23, 0x03, 0x86, 0xee, 0x61, 0x28, 0x30, 0x51, 0x6d, 0xbd,
+	0x25, 0x19, 0x34, 0x6a, 0xe4, 0x63, 0xcd, 0xc3, 0xcc, 0x3d, 0x75, 0x34, 0xc6, 0xba, 0x4d, 0x1c,
+	0xdd, 0x99, 0x7b, 0x7a, 0xa0, 0x50, 0x68, 0xe6, 0xb2, 0xb0, 0x83, 0x8c, 0xed, 0xed, 0x65, 0x33,
+	0x2f, 0x6e, 0x4b, 0xbc, 0x13, 0xe8, 0xa6, 0xfd, 0x01, 0xf4, 0x76, 0xa8, 0x22, 0x37, 0xc8, 0x68,
+	0xbb, 0xde, 0x82, 0x46, 0x71, 0x93, 0x2b, 0xfc, 0x00, 0x71, 0x3c, 0x13, 0x58, 0xd5, 0xcb, 0x89,
+	0x6c, 0xff, 0x9d, 0x01, 0xad, 0xc3, 0x78, 0x9e, 0x7a, 0xe2, 0x8b, 0xab, 0x1b, 0x5e, 0x06, 0x28,
+	0x6b, 0x54, 0x54, 0xd2, 0x50, 0xd6, 0x3b, 0x10, 0xb9, 0x7a, 0x49, 0x34, 0x28, 0xc4, 0x2b, 0x2e,
+	0x89, 0x45, 0xca, 0x5b, 0xd6, 0xff, 0x49, 0x80, 0x44, 0x35, 0xcf, 0xa6, 0x7e, 0xfc, 0x14, 0x0b,
+	0x9a, 0xe8, 0xe4, 0x6e, 0x70, 0xd0, 0xa8, 0x91, 0x4f, 0x25, 0x4f, 0x9a, 0x81, 0x74, 0x21, 0xe3,
+	0xca, 0x9e, 0x46, 0x92, 0x46, 0xf4, 0xd5, 0xbd, 0x7d, 0xc1, 0xd5, 0xfd, 0x36, 0x14, 0x25, 0x17,
+	0x96, 0xb9, 0x32, 0xfa, 0x2b, 0xe8, 0x6c, 0x1b, 0x3a, 0x45, 0x91, 0xb6, 0x3a, 0xc4, 0x2f, 0x6f,
+	0x15, 0x98, 0xad, 0x23, 0xdd, 0xe2, 0x25, 0xdb, 0x8a, 0x7b, 0x67, 0x92, 0xc6, 0xc7, 0xe2, 0x6b,
+	0x3c, 0xb2, 0x1f, 0x60, 0x3f, 0x92, 0xd7, 0x5b, 0x30, 0x0c, 0xf1, 0xfc, 0x99, 0xb9, 0xb9, 0x48,
+	0x03, 0x37, 0xc4, 0xfb, 0x81, 0x2a, 0xc3, 0x41, 0xfc, 0x7e, 0x89, 0xb6, 0x13, 0x30, 0xb1, 0xb2,
+	0x15, 0x55, 0x8a, 0xf7, 0x98, 0x99, 0x97, 0xcc, 0x55, 0x08, 0x46, 0x6d, 0x55, 0x9e, 0x2d, 0x95,
+	0xa5, 0xca, 0xb3, 0x49, 0x94, 0x06, 0x61, 0xa8, 0x8d, 0x8e, 0x38, 0x71, 0xcf, 0xc2, 0xd8, 0xf5,
+	0x95, 0x82, 0x34, 0x88, 0xdc, 0x14, 0xcc, 0xca, 0x2a, 0x28, 0x6a, 0xdb, 0x7f, 0x55, 0x87, 0xf5,
+	0x83, 0x34, 0xf6, 0x44, 0x96, 0x3d, 0x40, 0xff, 0xee, 0xd2, 0xa9, 0xce, 0xa0, 0x41, 0xd7, 0x18,
+	0x59, 0xa3, 0x49, 0x6d, 0x34, 0x18, 0x59, 0xf6, 0x5d, 0x84, 0xb3, 0x06, 0x97, 0x85, 0xe0, 0x14,
+	0xcd, 0x16, 0x64, 0xea, 0x68, 0x54, 0xc8, 0x74, 0x01, 0xba, 0x05, 0xfd, 0xb2, 0xd0, 0x89, 0x46,
+	0x50, 0xc5, 0xce, 0x05, 0x96, 0x46, 0x79, 0x05, 0xba, 0xa9, 0x70, 0xf1, 0xd4, 0xa3, 0x61, 0x9a,
+	0xc4, 0x03, 0x12, 0x45, 0xe3, 0x2c, 0xd7, 0x89, 0xb7, 0x88, 0xa3, 0x5a, 0x27, 0xce, 0xee, 0x00,
+	0x13, 0xa7, 0xc2, 0x9b, 0xd3, 0x54, 0xbe, 0x70, 0x7d, 0xdc, 0x67, 0x64, 0x51, 0x06, 0x5f, 0x2f,
+	0x28, 0x7b, 0x8a, 0x80, 0x57, 0xd4, 0xea, 0x88, 0xce, 0x54, 0x84, 0xb2, 0xde, 0xdd, 0xe0, 0x83,
+	0xca, 0xb0, 0x3f, 0x11, 0xa1, 0x6f, 0xff, 0x69, 0x1d, 0xba, 0x4a, 0x5a, 0xa4, 0x23, 0xa9, 0x8f,
+	0x5a, 0xa1, 0x8f, 0x21, 0x18, 0x78, 0x63, 0x92, 0x0a, 0xc2, 0x26, 0xbb, 0x03, 0x46, 0x18, 0xcc,
+	0x54, 0x8c, 0xfc, 0xd2, 0x42, 0x20, 0xb6, 0x28, 0x73, 0x8e, 0x7c, 0x78, 0xb5, 0x9a, 0x47, 0xc1,
+	0xa9, 0x83, 0x76, 0xa4, 0x24, 0x64, 0x22, 0x02, 0x8d, 0x15, 0x45, 0xec, 0x7a, 0x54, 0x73, 0xa1,
+	0x77, 0xd8, 0x1a, 0xef, 0x28, 0xcc, 0xc8, 0xa7, 0xaa, 0xe1, 0xc8, 0x4d, 0xb2, 0x69, 0x9c, 0xab,
+	0xbd, 0x55, 0xc0, 0xe8, 0x3c, 0x33, 0x91, 0x65, 0xb2, 0xca, 0x6c, 0x1c, 0x5b, 0xed, 0x65, 0xe7,
+	0x79, 0x28, 0xa9, 0xe4, 0x4c, 0xba, 0x59, 0x09, 0xe0, 0xad, 0xdc, 0x55, 0xae, 0xc8, 0x89, 0x62,
+	0x5f, 0x94, 0x2f, 0x58, 0x4d, 0x3e, 0xd4, 0x14, 0x34, 0x5a, 0x7a, 0x63, 0xf9, 0xaf, 0x1a, 0x74,
+	0x2b, 0x43, 0xd1, 0xff, 0x07, 0x32, 0x91, 0xea, 0xcb, 0x38, 0xb6, 0x11, 0x37, 0x8d, 0x55, 0xb9,
+	0x6f, 0x87, 0x53, 0x1b, 0x71, 0x69, 0x1c, 0x0a, 0x6d, 0xc8, 0xd8, 0x46, 0x87, 0xa1, 0xee, 0x04,
+	0xb4, 0x6c, 0x5f, 0xbd, 0x5e, 0xf4, 0x4a, 0xa4, 0xfc, 0x68, 0xfc, 0x9b, 0xc3, 0xb1, 0x9b, 0xe9,
+	0x67, 0x95, 0x02, 0xc6, 0x9d, 0xf0, 0x99, 0x48, 0x71, 0x2d, 0x4a, 0x1e, 0x1a, 0x44, 0x31, 0xd3,
+	0x1e, 0x7f, 0x16, 0x2b, 0xcb, 0xe8, 0x71, 0x13, 0x11, 0x9f, 0xc6, 0x11, 0x75, 0x53, 0x42, 0x25,
+	0x33, 0xe8, 0x70, 0x0d, 0xa2, 0x53, 0x94, 0xa6, 0x12, 0xc8, 0x5c, 0x5d, 0x87, 0xb7, 0x09, 0x1e,
+	0xf9, 0xf6, 0x5f, 0x37, 0xc1, 0x3c, 0x50, 0xc2, 0x64, 0x7b, 0xb0, 0x56, 0xfc, 0x39, 0x61, 0xf5,
+	0xf5, 0xf1, 0x60, 0xb9, 0x41, 0xd7, 0xc7, 0x5e, 0x52, 0x81, 0x96, 0xff, 0xe2, 0x50, 0x3f, 0xf7,
+	0x17, 0x87, 0x1b, 0x60, 0x3c, 0x49, 0xcf, 0x16, 0x2b, 0x3b, 0x0e, 0x42, 0x37, 0xe2, 0x88, 0x66,
+	0xef, 0x41, 0x17, 0x25, 0xe1, 0x64, 0x74, 0x20, 0x58, 0x8d, 0xe5, 0x00, 0x44, 0x1e, 0x14, 0x1c,
+	0x90, 0x49, 0xb6, 0xf1, 0xea, 0xe5, 0x4d, 0x83, 0xd0, 0x4f, 0x45, 0xa4, 0x5e, 0x0e, 0xd8, 0xf9,
+	0x25, 0xf3, 0x82, 0x87, 0xfd, 0x88, 0xaa, 0x81, 0xf4, 0x95, 0x51, 0x5a, 0x46, 0x6b, 0xf9, 0x51,
+	0xa3, 0x72, 0xa9, 0xe4, 0x83, 0x0a, 0x3b, 0xf9, 0xc6, 0xb2, 0x20, 0xb0, 0x5d, 0x2d, 0x08, 0x94,
+	0xd5, 0xf3, 0xc5, 0x75, 0x8d, 0x62, 0x46, 0x8a, 0xbe, 0x24, 0x81, 0x9c, 0x5b, 0xa7, 0x08, 0x26,
+	0xd1, 0xb7, 0xbd, 0x01, 0x0d, 0xb4, 0x4e, 0x75, 0xf3, 0xaa, 0x2c, 0x5b, 0xfb, 0x53, 0x4e, 0x74,
+	0xfa, 0x83, 0xcc, 0x3c, 0x9b, 0x3a, 0xf2, 0x9c, 0xc2, 0xad, 0xd0, 0x55, 0x95, 0xb7, 0xf3, 0x6c,
+	0xba, 0x17, 0x3f, 0x95, 0x66, 0x7b, 0x0b, 0xfa, 0xfa, 0x23, 0x55, 0x91, 0x53, 0x4f, 0xd6, 0x23,
+	0x6a, 0xac, 0xac, 0x71, 0xfa, 0x08, 0x86, 0xf8, 0x8f, 0x98, 0xcc, 0xc9, 0x63, 0xfd, 0xef, 0x01,
+	0x6b, 0x6d, 0xc3, 0x58, 0xbc, 0xcb, 0x3c, 0x9e, 0x07, 0xfe, 0x51, 0xac, 0xfe, 0x3f, 0xb0, 0x46,
+	0xfc, 0x1a, 0xa4, 0xbf, 0xd2, 0xd0, 0xbb, 0x26, 0xf6, 0xec, 0xd3, 0x14, 0x26, 0x21, 0x90, 0x88,
+	0x47, 0xb8, 0xfa, 0x97, 0x01, 0xfe, 0xd3, 0x66, 0xa0, 0x1e, 0x10, 0x24, 0x6a, 0x37, 0xca, 0xed,
+	0x8f, 0xa0, 0x57, 0x35, 0x1f, 0xd6, 0x51, 0xff, 0x1f, 0x18, 0xbe, 0xc0, 0x00, 0x5a, 0x0f, 0xe3,
+	0x74, 0xe6, 0x86, 0xc3, 0x1a, 0xb6, 0x65, 0xa5, 0xec, 0xb0, 0xce, 0x7a, 0x60, 0xea, 0xc0, 0x64,
+	0x68, 0xd8, 0x3f, 0x04, 0x53, 0xff, 0x99, 0x02, 0x97, 0x42, 0xdb, 0x9b, 0xce, 0x13, 0xb9, 0x5d,
+	0x4d, 0x44, 0xd0, 0xb1, 0xac, 0xff, 0x06, 0x54, 0x2f, 0xff, 0x06, 0x64, 0xff, 0x3a, 0xf4, 0xaa,
+	0x9f, 0xa6, 0x1f, 0x08, 0x6a, 0xe5, 0x03, 0xc1, 0x8a, 0x5e, 0x38, 0xcd, 0x38, 0x8d, 0x67, 0x4e,
+	0xe5, 0xd8, 0x32, 0x11, 0x81, 0xd3, 0xdc, 0xfe, 0x2d, 0x68, 0xc9, 0xbf, 0x3c, 0xb1, 0x75, 0x58,
+	0x7b, 0x1c, 0x9d, 0x44, 0xf1, 0xd3, 0x48, 0x22, 0x86, 0x2f, 0xb0, 0x4b, 0x30, 0xd0, 0x5f, 0xab,
+	0xfe, 0x5b, 0x35, 0xac, 0xb1, 0x21, 0xf4, 0xe8, 0xf1, 0x50, 0x63, 0xea, 0xec, 0x06, 0x58, 0x07,
+	0xa9, 0x48, 0xdc, 0x54, 0xec, 0xc5, 0x91, 0x78, 0x18, 0xe7, 0xc1, 0xf8, 0x4c, 0x53, 0x8d, 0xdb,
+	0x1f, 0x43, 0x4b, 0xfe, 0xf1, 0xaa, 0x32, 0x83, 0x44, 0x0c, 0x5f, 0x60, 0x03, 0xe8, 0x7e, 0xe2,
+	0x06, 0x79, 0x10, 0x4d, 0x1e, 0x8a, 0x53, 0x7c, 0x22, 0x31, 0xa1, 0x81, 0x37, 0x95, 0x61, 0x9d,
+	0xf5, 0x01, 0xd4, 0x20, 0xf7, 0x23, 0x7f, 0x68, 0xdc, 0xdb, 0xfd, 0x87, 0xcf, 0x6f, 0xd6, 0xfe,
+	0xe9, 0xf3, 0x9b, 0xb5, 0x7f, 0xff, 0xfc, 0xe6, 0x0b, 0x7f, 0xf6, 0x1f, 0x37, 0x6b, 0x9f, 0xbe,
+	0x57, 0xf9, 0x2f, 0xd9, 0xcc, 0xcd, 0xd3, 0xe0, 0x54, 0xbe, 0x72, 0x69, 0x20, 0x12, 0x77, 0x93,
+	0x93, 0xc9, 0xdd, 0xe4, 0xf8, 0xae, 0xb6, 0x8c, 0xe3, 0x16, 0xfd, 0x5b, 0xec, 0xfd, 0xff, 0x19,
+	0x00, 0x59, 0x22, 0x35, 0x03, 0xa1, 0x36, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -5062,6 +5106,11 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueryMemory != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.QueryMemory))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.Sequence))
 		i--
@@ -9206,6 +9255,16 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LateMaterialize {
+		i--
+		if m.LateMaterialize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.RuntimeFilterProbeList) > 0 {
 		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9383,6 +9442,21 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueryMemoryHeld != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.QueryMemoryHeld))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutionDeadline != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.ExecutionDeadline))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QueryMemory != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.QueryMemory))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -9909,6 +9983,9 @@ func (m *Message) ProtoSize() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovPipeline(uint64(m.Sequence))
 	}
+	if m.QueryMemory != 0 {
+		n += 1 + sovPipeline(uint64(m.QueryMemory))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11632,6 +11709,9 @@ func (m *Source) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if m.LateMaterialize {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11689,6 +11769,15 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.QueryMemory != 0 {
+		n += 1 + sovPipeline(uint64(m.QueryMemory))
+	}
+	if m.ExecutionDeadline != 0 {
+		n += 1 + sovPipeline(uint64(m.ExecutionDeadline))
+	}
+	if m.QueryMemoryHeld != 0 {
+		n += 1 + sovPipeline(uint64(m.QueryMemoryHeld))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12220,6 +12309,25 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMemory", wireType)
+			}
+			m.QueryMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMemory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -23602,6 +23710,26 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateMaterialize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LateMaterialize = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -23933,6 +24061,63 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMemory", wireType)
+			}
+			m.QueryMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMemory |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDeadline", wireType)
+			}
+			m.ExecutionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMemoryHeld", wireType)
+			}
+			m.QueryMemoryHeld = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMemoryHeld |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -743,6 +743,66 @@ func (Node_FillType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{56, 3}
 }
 
+type IndexHint_HintType int32
+
+const (
+	IndexHint_USE    IndexHint_HintType = 0
+	IndexHint_IGNORE IndexHint_HintType = 1
+	IndexHint_FORCE  IndexHint_HintType = 2
+)
+
+var IndexHint_HintType_name = map[int32]string{
+	0: "USE",
+	1: "IGNORE",
+	2: "FORCE",
+}
+
+var IndexHint_HintType_value = map[string]int32{
+	"USE":    0,
+	"IGNORE": 1,
+	"FORCE":  2,
+}
+
+func (x IndexHint_HintType) String() string {
+	return proto.EnumName(IndexHint_HintType_name, int32(x))
+}
+
+func (IndexHint_HintType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
+}
+
+type IndexHint_HintScope int32
+
+const (
+	// no FOR clause, the hint applies to every scope
+	IndexHint_ALL      IndexHint_HintScope = 0
+	IndexHint_JOIN     IndexHint_HintScope = 1
+	IndexHint_ORDER_BY IndexHint_HintScope = 2
+	IndexHint_GROUP_BY IndexHint_HintScope = 3
+)
+
+var IndexHint_HintScope_name = map[int32]string{
+	0: "ALL",
+	1: "JOIN",
+	2: "ORDER_BY",
+	3: "GROUP_BY",
+}
+
+var IndexHint_HintScope_value = map[string]int32{
+	"ALL":      0,
+	"JOIN":     1,
+	"ORDER_BY": 2,
+	"GROUP_BY": 3,
+}
+
+func (x IndexHint_HintScope) String() string {
+	return proto.EnumName(IndexHint_HintScope_name, int32(x))
+}
+
+func (IndexHint_HintScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 1}
+}
+
 type Query_StatementType int32
 
 const (
@@ -780,7 +840,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{67, 0}
 }
 
 type TransationControl_TclType int32
@@ -808,7 +868,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -836,7 +896,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
 }
 
 type DataControl_DclType int32
@@ -885,7 +945,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{74, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -1009,7 +1069,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -1043,7 +1103,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{83, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1074,7 +1134,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{95, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1132,7 +1192,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{116, 0}
 }
 
 type Type struct {
@@ -6032,10 +6092,17 @@ type Node struct {
 	SendMsgList []*MsgHeader `protobuf:"bytes,55,rep,name=send_msg_list,json=sendMsgList,proto3" json:"send_msg_list,omitempty"`
 	RecvMsgList []*MsgHeader `protobuf:"bytes,56,rep,name=recv_msg_list,json=recvMsgList,proto3" json:"recv_msg_list,omitempty"`
 	// table_scan timestamp
-	ScanTS               *timestamp.Timestamp `protobuf:"bytes,57,opt,name=scanTS,proto3" json:"scanTS,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ScanTS *timestamp.Timestamp `protobuf:"bytes,57,opt,name=scanTS,proto3" json:"scanTS,omitempty"`
+	// TABLE_SCAN: USE/FORCE/IGNORE INDEX hints given on the table
+	IndexHints []*IndexHint `protobuf:"bytes,58,rep,name=index_hints,json=indexHints,proto3" json:"index_hints,omitempty"`
+	// hints the planner could not honor, reported by EXPLAIN
+	HintWarnings []string `protobuf:"bytes,59,rep,name=hint_warnings,json=hintWarnings,proto3" json:"hint_warnings,omitempty"`
+	// TABLE_SCAN: read the filter columns first and the other ones only for
+	// the rows passing the filter
+	LateMaterialize      bool     `protobuf:"varint,60,opt,name=late_materialize,json=lateMaterialize,proto3" json:"late_materialize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
@@ -6456,6 +6523,90 @@ func (m *Node) GetScanTS() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Node) GetIndexHints() []*IndexHint {
+	if m != nil {
+		return m.IndexHints
+	}
+	return nil
+}
+
+func (m *Node) GetHintWarnings() []string {
+	if m != nil {
+		return m.HintWarnings
+	}
+	return nil
+}
+
+func (m *Node) GetLateMaterialize() bool {
+	if m != nil {
+		return m.LateMaterialize
+	}
+	return false
+}
+
+type IndexHint struct {
+	Type                 IndexHint_HintType  `protobuf:"varint,1,opt,name=type,proto3,enum=plan.IndexHint_HintType" json:"type,omitempty"`
+	Scope                IndexHint_HintScope `protobuf:"varint,2,opt,name=scope,proto3,enum=plan.IndexHint_HintScope" json:"scope,omitempty"`
+	IndexNames           []string            `protobuf:"bytes,3,rep,name=index_names,json=indexNames,proto3" json:"index_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *IndexHint) Reset()         { *m = IndexHint{} }
+func (m *IndexHint) String() string { return proto.CompactTextString(m) }
+func (*IndexHint) ProtoMessage()    {}
+func (*IndexHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
+}
+func (m *IndexHint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexHint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexHint.Merge(m, src)
+}
+func (m *IndexHint) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexHint proto.InternalMessageInfo
+
+func (m *IndexHint) GetType() IndexHint_HintType {
+	if m != nil {
+		return m.Type
+	}
+	return IndexHint_USE
+}
+
+func (m *IndexHint) GetScope() IndexHint_HintScope {
+	if m != nil {
+		return m.Scope
+	}
+	return IndexHint_ALL
+}
+
+func (m *IndexHint) GetIndexNames() []string {
+	if m != nil {
+		return m.IndexNames
+	}
+	return nil
+}
+
 type ExternScan struct {
 	Type                 int32    `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
 	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -6473,7 +6624,7 @@ func (m *ExternScan) Reset()         { *m = ExternScan{} }
 func (m *ExternScan) String() string { return proto.CompactTextString(m) }
 func (*ExternScan) ProtoMessage()    {}
 func (*ExternScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *ExternScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6571,7 +6722,7 @@ func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6685,7 +6836,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6754,7 +6905,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6804,7 +6955,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6875,7 +7026,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6943,7 +7094,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6990,7 +7141,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7048,7 +7199,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7169,7 +7320,9 @@ type Query struct {
 	// load Tag
 	LoadTag bool `protobuf:"varint,6,opt,name=loadTag,proto3" json:"loadTag,omitempty"`
 	// detectSqls are sqls detect fk self refer constraint
-	DetectSqls           []string `protobuf:"bytes,7,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	DetectSqls []string `protobuf:"bytes,7,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	// degree of parallelism pinned by the DOP optimizer hint, 0 if not set
+	Dop                  int32    `protobuf:"varint,8,opt,name=dop,proto3" json:"dop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7179,7 +7332,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7257,6 +7410,13 @@ func (m *Query) GetDetectSqls() []string {
 	return nil
 }
 
+func (m *Query) GetDop() int32 {
+	if m != nil {
+		return m.Dop
+	}
+	return 0
+}
+
 type TransationControl struct {
 	// TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
@@ -7275,7 +7435,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7379,7 +7539,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7426,7 +7586,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7473,7 +7633,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7528,7 +7688,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7651,7 +7811,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7707,7 +7867,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7860,7 +8020,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8140,7 +8300,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8197,7 +8357,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8266,7 +8426,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8328,7 +8488,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8403,7 +8563,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8469,7 +8629,7 @@ func (m *ForeignKeyInfo) Reset()         { *m = ForeignKeyInfo{} }
 func (m *ForeignKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyInfo) ProtoMessage()    {}
 func (*ForeignKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *ForeignKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8561,7 +8721,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8694,7 +8854,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8758,7 +8918,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8830,7 +8990,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8908,7 +9068,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8979,7 +9139,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9050,7 +9210,7 @@ func (m *AlterTableAlterReIndex) Reset()         { *m = AlterTableAlterReIndex{}
 func (m *AlterTableAlterReIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterReIndex) ProtoMessage()    {}
 func (*AlterTableAlterReIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *AlterTableAlterReIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9120,7 +9280,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9181,7 +9341,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9217,6 +9377,110 @@ func (m *AlterTableComment) GetNewComment() string {
 	return ""
 }
 
+type AlterTableMergePolicy struct {
+	MinRows          uint32 `protobuf:"varint,1,opt,name=min_rows,json=minRows,proto3" json:"min_rows,omitempty"`
+	MaxObjects       uint32 `protobuf:"varint,2,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
+	TargetObjectSize uint64 `protobuf:"varint,3,opt,name=target_object_size,json=targetObjectSize,proto3" json:"target_object_size,omitempty"`
+	MaxLevel         uint32 `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Reorganize       bool   `protobuf:"varint,5,opt,name=reorganize,proto3" json:"reorganize,omitempty"`
+	TimeColumn       string `protobuf:"bytes,6,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	// time_window and seal_after are in seconds
+	TimeWindow           int64    `protobuf:"varint,7,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	SealAfter            int64    `protobuf:"varint,8,opt,name=seal_after,json=sealAfter,proto3" json:"seal_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableMergePolicy) Reset()         { *m = AlterTableMergePolicy{} }
+func (m *AlterTableMergePolicy) String() string { return proto.CompactTextString(m) }
+func (*AlterTableMergePolicy) ProtoMessage()    {}
+func (*AlterTableMergePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
+}
+func (m *AlterTableMergePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableMergePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableMergePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableMergePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableMergePolicy.Merge(m, src)
+}
+func (m *AlterTableMergePolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableMergePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableMergePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableMergePolicy proto.InternalMessageInfo
+
+func (m *AlterTableMergePolicy) GetMinRows() uint32 {
+	if m != nil {
+		return m.MinRows
+	}
+	return 0
+}
+
+func (m *AlterTableMergePolicy) GetMaxObjects() uint32 {
+	if m != nil {
+		return m.MaxObjects
+	}
+	return 0
+}
+
+func (m *AlterTableMergePolicy) GetTargetObjectSize() uint64 {
+	if m != nil {
+		return m.TargetObjectSize
+	}
+	return 0
+}
+
+func (m *AlterTableMergePolicy) GetMaxLevel() uint32 {
+	if m != nil {
+		return m.MaxLevel
+	}
+	return 0
+}
+
+func (m *AlterTableMergePolicy) GetReorganize() bool {
+	if m != nil {
+		return m.Reorganize
+	}
+	return false
+}
+
+func (m *AlterTableMergePolicy) GetTimeColumn() string {
+	if m != nil {
+		return m.TimeColumn
+	}
+	return ""
+}
+
+func (m *AlterTableMergePolicy) GetTimeWindow() int64 {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
+}
+
+func (m *AlterTableMergePolicy) GetSealAfter() int64 {
+	if m != nil {
+		return m.SealAfter
+	}
+	return 0
+}
+
 type AlterTableName struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -9229,7 +9493,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9286,7 +9550,7 @@ func (m *AlterAddColumn) Reset()         { *m = AlterAddColumn{} }
 func (m *AlterAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterAddColumn) ProtoMessage()    {}
 func (*AlterAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9355,7 +9619,7 @@ func (m *AlterDropColumn) Reset()         { *m = AlterDropColumn{} }
 func (m *AlterDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterDropColumn) ProtoMessage()    {}
 func (*AlterDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *AlterDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9424,7 +9688,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9557,6 +9821,7 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_DropColumn
 	//	*AlterTable_Action_AlterReindex
 	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_AlterMergePolicy
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -9567,7 +9832,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{95, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9632,17 +9897,21 @@ type AlterTable_Action_AlterReindex struct {
 type AlterTable_Action_AddPartition struct {
 	AddPartition *AlterTableAddPartition `protobuf:"bytes,10,opt,name=addPartition,proto3,oneof" json:"addPartition,omitempty"`
 }
+type AlterTable_Action_AlterMergePolicy struct {
+	AlterMergePolicy *AlterTableMergePolicy `protobuf:"bytes,11,opt,name=alter_merge_policy,json=alterMergePolicy,proto3,oneof" json:"alter_merge_policy,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()     {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()             {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()            {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()         {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()        {}
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()        {}
+func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AlterMergePolicy) isAlterTable_Action_Action() {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -9721,6 +9990,13 @@ func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAlterMergePolicy() *AlterTableMergePolicy {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterMergePolicy); ok {
+		return x.AlterMergePolicy
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -9734,6 +10010,7 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_DropColumn)(nil),
 		(*AlterTable_Action_AlterReindex)(nil),
 		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_AlterMergePolicy)(nil),
 	}
 }
 
@@ -9762,7 +10039,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9888,7 +10165,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9951,7 +10228,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10015,7 +10292,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10085,7 +10362,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10151,7 +10428,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10233,7 +10510,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10283,7 +10560,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10358,7 +10635,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10456,7 +10733,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10518,7 +10795,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10572,7 +10849,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10623,7 +10900,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{106}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10701,7 +10978,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{107}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10770,7 +11047,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{108}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10824,7 +11101,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{109}
+	return fileDescriptor_2d655ab2f7683c23, []int{111}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10871,7 +11148,7 @@ func (m *OtherDCL) Reset()         { *m = OtherDCL{} }
 func (m *OtherDCL) String() string { return proto.CompactTextString(m) }
 func (*OtherDCL) ProtoMessage()    {}
 func (*OtherDCL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{110}
+	return fileDescriptor_2d655ab2f7683c23, []int{112}
 }
 func (m *OtherDCL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10919,7 +11196,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{111}
+	return fileDescriptor_2d655ab2f7683c23, []int{113}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10973,7 +11250,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{112}
+	return fileDescriptor_2d655ab2f7683c23, []int{114}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11019,7 +11296,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{113}
+	return fileDescriptor_2d655ab2f7683c23, []int{115}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11069,7 +11346,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114}
+	return fileDescriptor_2d655ab2f7683c23, []int{116}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11193,6 +11470,8 @@ func init() {
 	proto.RegisterEnum("plan.Node_JoinType", Node_JoinType_name, Node_JoinType_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
 	proto.RegisterEnum("plan.Node_FillType", Node_FillType_name, Node_FillType_value)
+	proto.RegisterEnum("plan.IndexHint_HintType", IndexHint_HintType_name, IndexHint_HintType_value)
+	proto.RegisterEnum("plan.IndexHint_HintScope", IndexHint_HintScope_name, IndexHint_HintScope_value)
 	proto.RegisterEnum("plan.Query_StatementType", Query_StatementType_name, Query_StatementType_value)
 	proto.RegisterEnum("plan.TransationControl_TclType", TransationControl_TclType_name, TransationControl_TclType_value)
 	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
@@ -11261,6 +11540,7 @@ func init() {
 	proto.RegisterType((*PartitionPrune)(nil), "plan.PartitionPrune")
 	proto.RegisterType((*OriginTableMessageForFuzzy)(nil), "plan.OriginTableMessageForFuzzy")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*IndexHint)(nil), "plan.IndexHint")
 	proto.RegisterType((*ExternScan)(nil), "plan.ExternScan")
 	proto.RegisterType((*LockTarget)(nil), "plan.LockTarget")
 	proto.RegisterType((*PreInsertUkCtx)(nil), "plan.PreInsertUkCtx")
@@ -11295,6 +11575,7 @@ func init() {
 	proto.RegisterType((*AlterTableAlterReIndex)(nil), "plan.AlterTableAlterReIndex")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
 	proto.RegisterType((*AlterTableComment)(nil), "plan.AlterTableComment")
+	proto.RegisterType((*AlterTableMergePolicy)(nil), "plan.AlterTableMergePolicy")
 	proto.RegisterType((*AlterTableName)(nil), "plan.AlterTableName")
 	proto.RegisterType((*AlterAddColumn)(nil), "plan.AlterAddColumn")
 	proto.RegisterType((*AlterDropColumn)(nil), "plan.AlterDropColumn")
@@ -11327,665 +11608,680 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 10524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x4d, 0x8f, 0x1b, 0x49,
-	0x96, 0x58, 0xf1, 0x9b, 0x7c, 0xfc, 0xa8, 0xac, 0x50, 0x49, 0xa2, 0xd4, 0x6a, 0xa9, 0x3a, 0xfb,
-	0x4b, 0xad, 0xe9, 0x91, 0xba, 0xa5, 0xfe, 0xde, 0x99, 0x9d, 0x61, 0xb1, 0x28, 0x15, 0x5b, 0x2c,
-	0xb2, 0x26, 0xc9, 0x92, 0xba, 0x67, 0x61, 0x24, 0x92, 0xcc, 0x64, 0x55, 0x76, 0x25, 0x33, 0xd9,
-	0x99, 0x49, 0x55, 0x55, 0x03, 0x0b, 0x8c, 0x6d, 0xc0, 0x8b, 0x35, 0xe0, 0x83, 0x61, 0x60, 0x2f,
-	0xb6, 0x81, 0xf1, 0x1e, 0x17, 0xf6, 0xc9, 0x06, 0xd6, 0xf0, 0xc5, 0x07, 0xfb, 0xb0, 0x36, 0x0c,
-	0xdb, 0x80, 0x0f, 0x0b, 0x7f, 0x60, 0x6d, 0x8c, 0x2f, 0xbe, 0xed, 0x61, 0xfd, 0x03, 0x8c, 0xf7,
-	0x22, 0x32, 0x33, 0x92, 0x64, 0x8d, 0xba, 0x7b, 0x67, 0x61, 0xfb, 0x52, 0x95, 0xf1, 0xde, 0x8b,
-	0xef, 0x88, 0xf7, 0x15, 0x2f, 0x82, 0x00, 0x73, 0xc7, 0x70, 0xef, 0xcf, 0x7d, 0x2f, 0xf4, 0x58,
-	0x1e, 0xbf, 0x6f, 0xfe, 0xf0, 0xd8, 0x0e, 0x4f, 0x16, 0xe3, 0xfb, 0x13, 0x6f, 0xf6, 0xe0, 0xd8,
-	0x3b, 0xf6, 0x1e, 0x10, 0x72, 0xbc, 0x98, 0x52, 0x8a, 0x12, 0xf4, 0xc5, 0x33, 0xdd, 0x04, 0xc7,
-	0x9b, 0x9c, 0x8a, 0xef, 0xcd, 0xd0, 0x9e, 0x59, 0x41, 0x68, 0xcc, 0xe6, 0x1c, 0xa0, 0xfe, 0x71,
-	0x06, 0xf2, 0xa3, 0x8b, 0xb9, 0xc5, 0x1a, 0x90, 0xb5, 0xcd, 0x66, 0x66, 0x27, 0x73, 0xb7, 0xa0,
-	0x65, 0x6d, 0x93, 0xed, 0x40, 0xd5, 0xf5, 0xc2, 0xfe, 0xc2, 0x71, 0x8c, 0xb1, 0x63, 0x35, 0xb3,
-	0x3b, 0x99, 0xbb, 0x65, 0x4d, 0x06, 0xb1, 0x57, 0xa0, 0x62, 0x2c, 0x42, 0x4f, 0xb7, 0xdd, 0x89,
-	0xdf, 0xcc, 0x11, 0xbe, 0x8c, 0x80, 0xae, 0x3b, 0xf1, 0xd9, 0x36, 0x14, 0xce, 0x6c, 0x33, 0x3c,
-	0x69, 0xe6, 0xa9, 0x44, 0x9e, 0x40, 0x68, 0x30, 0x31, 0x1c, 0xab, 0x59, 0xe0, 0x50, 0x4a, 0x20,
-	0x34, 0xa4, 0x4a, 0x8a, 0x3b, 0x99, 0xbb, 0x15, 0x8d, 0x27, 0xd8, 0x6d, 0x00, 0xcb, 0x5d, 0xcc,
-	0x5e, 0x18, 0xce, 0xc2, 0x0a, 0x9a, 0x25, 0x42, 0x49, 0x10, 0xf5, 0x27, 0x50, 0x99, 0x05, 0xc7,
-	0xfb, 0x96, 0x61, 0x5a, 0x3e, 0xbb, 0x0e, 0xa5, 0x59, 0x70, 0xac, 0x87, 0xc6, 0xb1, 0xe8, 0x42,
-	0x71, 0x16, 0x1c, 0x8f, 0x8c, 0x63, 0x76, 0x03, 0xca, 0x84, 0xb8, 0x98, 0xf3, 0x3e, 0x14, 0x34,
-	0x24, 0xc4, 0x1e, 0xab, 0x7f, 0x5e, 0x80, 0x52, 0xcf, 0x0e, 0x2d, 0xdf, 0x70, 0xd8, 0x35, 0x28,
-	0xda, 0x81, 0xbb, 0x70, 0x1c, 0xca, 0x5e, 0xd6, 0x44, 0x8a, 0x5d, 0x83, 0x82, 0xfd, 0xc9, 0x0b,
-	0xc3, 0xe1, 0x79, 0xf7, 0x37, 0x34, 0x9e, 0x64, 0x4d, 0x28, 0xda, 0xef, 0x7f, 0x84, 0x88, 0x9c,
-	0x40, 0x88, 0x34, 0x61, 0x1e, 0x3d, 0x44, 0x4c, 0x3e, 0xc6, 0x50, 0x9a, 0x30, 0x1f, 0x7d, 0x80,
-	0x18, 0xec, 0x7d, 0x8e, 0x30, 0x94, 0xc6, 0x5a, 0x16, 0x54, 0x0b, 0x0e, 0x40, 0x1d, 0x6b, 0x59,
-	0x44, 0xb5, 0x2c, 0x78, 0x2d, 0x25, 0x81, 0x10, 0x69, 0xc2, 0xf0, 0x5a, 0xca, 0x31, 0x26, 0xae,
-	0x65, 0xc1, 0x6b, 0xa9, 0xec, 0x64, 0xee, 0xe6, 0x09, 0xc3, 0x6b, 0xd9, 0x86, 0xbc, 0x89, 0x70,
-	0xd8, 0xc9, 0xdc, 0xcd, 0xec, 0x6f, 0x68, 0x94, 0x42, 0x68, 0x80, 0xd0, 0x2a, 0x0e, 0x30, 0x42,
-	0x03, 0x01, 0x1d, 0x23, 0xb4, 0x86, 0xa3, 0x81, 0xd0, 0xb1, 0x80, 0x4e, 0x11, 0x5a, 0xdf, 0xc9,
-	0xdc, 0xcd, 0x22, 0x14, 0x53, 0xec, 0x26, 0x94, 0x4c, 0x23, 0xb4, 0x10, 0xd1, 0x10, 0x5d, 0x8e,
-	0x00, 0x88, 0xc3, 0x15, 0x87, 0xb8, 0x4d, 0xd1, 0xe9, 0x08, 0xc0, 0x54, 0xa8, 0x22, 0x59, 0x84,
-	0x57, 0x04, 0x5e, 0x06, 0xb2, 0x0f, 0xa1, 0x66, 0x5a, 0x13, 0x7b, 0x66, 0x38, 0xbc, 0x4f, 0x5b,
-	0x3b, 0x99, 0xbb, 0xd5, 0x87, 0x9b, 0xf7, 0x69, 0x4f, 0xc4, 0x98, 0xfd, 0x0d, 0x2d, 0x45, 0xc6,
-	0x3e, 0x81, 0xba, 0x48, 0xbf, 0xff, 0x90, 0x06, 0x96, 0x51, 0x3e, 0x25, 0x95, 0xef, 0xfd, 0x87,
-	0x9f, 0xec, 0x6f, 0x68, 0x69, 0x42, 0xf6, 0x06, 0xd4, 0xe2, 0x2d, 0x82, 0x19, 0xaf, 0x88, 0x56,
-	0xa5, 0xa0, 0xd8, 0xad, 0xaf, 0x02, 0xcf, 0x45, 0x82, 0x6d, 0x31, 0x6e, 0x11, 0x80, 0xed, 0x00,
-	0x98, 0xd6, 0xd4, 0x58, 0x38, 0x21, 0xa2, 0xaf, 0x8a, 0x01, 0x94, 0x60, 0xec, 0x36, 0x54, 0x16,
-	0x73, 0xec, 0xe5, 0x33, 0xc3, 0x69, 0x5e, 0x13, 0x04, 0x09, 0x08, 0x4b, 0xc7, 0x75, 0x8e, 0xd8,
-	0xeb, 0x62, 0x76, 0x23, 0x00, 0xee, 0x15, 0x3b, 0xd8, 0xb5, 0xdd, 0x66, 0x93, 0xd6, 0x29, 0x4f,
-	0xb0, 0x5b, 0x90, 0x0b, 0xfc, 0x49, 0xf3, 0x06, 0xf5, 0x12, 0x78, 0x2f, 0x3b, 0xe7, 0x73, 0x5f,
-	0x43, 0xf0, 0x6e, 0x09, 0x0a, 0xb4, 0x67, 0xd4, 0x5b, 0x50, 0x3e, 0x34, 0x7c, 0x63, 0xa6, 0x59,
-	0x53, 0xa6, 0x40, 0x6e, 0xee, 0x05, 0x62, 0xb7, 0xe0, 0xa7, 0xda, 0x83, 0xe2, 0x33, 0xc3, 0x47,
-	0x1c, 0x83, 0xbc, 0x6b, 0xcc, 0x2c, 0x42, 0x56, 0x34, 0xfa, 0xc6, 0x1d, 0x12, 0x5c, 0x04, 0xa1,
-	0x35, 0x13, 0xac, 0x40, 0xa4, 0x10, 0x7e, 0xec, 0x78, 0x63, 0xb1, 0x13, 0xca, 0x9a, 0x48, 0xa9,
-	0x7f, 0x23, 0x03, 0xc5, 0xb6, 0xe7, 0x60, 0x71, 0xd7, 0xa1, 0xe4, 0x5b, 0x8e, 0x9e, 0x54, 0x57,
-	0xf4, 0x2d, 0xe7, 0xd0, 0x0b, 0x10, 0x31, 0xf1, 0x38, 0x82, 0xef, 0xcd, 0xe2, 0xc4, 0x23, 0x44,
-	0xd4, 0x80, 0x9c, 0xd4, 0x80, 0x1b, 0x50, 0x0e, 0xc7, 0x8e, 0x4e, 0xf0, 0x3c, 0xc1, 0x4b, 0xe1,
-	0xd8, 0xe9, 0x23, 0xea, 0x3a, 0x94, 0xcc, 0x31, 0xc7, 0x14, 0x08, 0x53, 0x34, 0xc7, 0x88, 0x50,
-	0x3f, 0x85, 0x8a, 0x66, 0x9c, 0x89, 0x66, 0x5c, 0x85, 0x22, 0x16, 0x20, 0xb8, 0x5c, 0x5e, 0x2b,
-	0x84, 0x63, 0xa7, 0x6b, 0x22, 0x18, 0x1b, 0x61, 0x9b, 0xd4, 0x86, 0xbc, 0x56, 0x98, 0x78, 0x4e,
-	0xd7, 0x54, 0x47, 0x00, 0x6d, 0xcf, 0xf7, 0xbf, 0x77, 0x17, 0xb6, 0xa1, 0x60, 0x5a, 0xf3, 0xf0,
-	0x84, 0x33, 0x08, 0x8d, 0x27, 0xd4, 0x7b, 0x50, 0xc6, 0x79, 0xe9, 0xd9, 0x41, 0xc8, 0x6e, 0x43,
-	0xde, 0xb1, 0x83, 0xb0, 0x99, 0xd9, 0xc9, 0x2d, 0xcd, 0x1a, 0xc1, 0xd5, 0x1d, 0x28, 0x1f, 0x18,
-	0xe7, 0xcf, 0x70, 0xe6, 0xb0, 0x34, 0x9a, 0x42, 0x31, 0x25, 0x62, 0x3e, 0x6b, 0x00, 0x23, 0xc3,
-	0x3f, 0xb6, 0x42, 0xe2, 0x67, 0x7f, 0x91, 0x81, 0xea, 0x70, 0x31, 0xfe, 0x7a, 0x61, 0xf9, 0x17,
-	0xd8, 0xe6, 0xbb, 0x90, 0x0b, 0x2f, 0xe6, 0x94, 0xa3, 0xf1, 0xf0, 0x1a, 0x2f, 0x5e, 0xc2, 0xdf,
-	0xc7, 0x4c, 0x1a, 0x92, 0x60, 0x27, 0x5c, 0xcf, 0xb4, 0xa2, 0x31, 0x28, 0x68, 0x45, 0x4c, 0x76,
-	0x4d, 0x14, 0x0a, 0xde, 0x5c, 0xcc, 0x42, 0xd6, 0x9b, 0xb3, 0x1d, 0x28, 0x4c, 0x4e, 0x6c, 0xc7,
-	0xa4, 0x09, 0x48, 0xb7, 0x99, 0x23, 0x70, 0x96, 0x7c, 0xef, 0x4c, 0x0f, 0xec, 0x6f, 0x22, 0x26,
-	0x5f, 0xf2, 0xbd, 0xb3, 0xa1, 0xfd, 0x8d, 0xa5, 0x8e, 0x84, 0xa4, 0x01, 0x28, 0x0e, 0xdb, 0xad,
-	0x5e, 0x4b, 0x53, 0x36, 0xf0, 0xbb, 0xf3, 0x45, 0x77, 0x38, 0x1a, 0x2a, 0x19, 0xd6, 0x00, 0xe8,
-	0x0f, 0x46, 0xba, 0x48, 0x67, 0x59, 0x11, 0xb2, 0xdd, 0xbe, 0x92, 0x43, 0x1a, 0x84, 0x77, 0xfb,
-	0x4a, 0x9e, 0x95, 0x20, 0xd7, 0xea, 0x7f, 0xa9, 0x14, 0xe8, 0xa3, 0xd7, 0x53, 0x8a, 0xea, 0x1f,
-	0x65, 0xa1, 0x32, 0x18, 0x7f, 0x65, 0x4d, 0x42, 0xec, 0x33, 0xae, 0x52, 0xcb, 0x7f, 0x61, 0xf9,
-	0xd4, 0xed, 0x9c, 0x26, 0x52, 0xd8, 0x11, 0x73, 0x4c, 0x9d, 0xcb, 0x69, 0x59, 0x73, 0x4c, 0x74,
-	0x93, 0x13, 0x6b, 0x66, 0x50, 0xe7, 0x90, 0x8e, 0x52, 0xb8, 0x2b, 0xbc, 0xf1, 0x57, 0xd4, 0xbd,
-	0x9c, 0x86, 0x9f, 0xec, 0x0e, 0x54, 0x79, 0x19, 0xf2, 0xfa, 0x02, 0x0e, 0x5a, 0x5e, 0x7c, 0x45,
-	0x79, 0xf1, 0x51, 0x4e, 0x2a, 0x95, 0x23, 0x85, 0x04, 0xe3, 0xa0, 0xbe, 0x58, 0xd1, 0xde, 0xf8,
-	0x2b, 0x8e, 0x2d, 0xf3, 0x15, 0xed, 0x8d, 0xbf, 0x22, 0xd4, 0x0f, 0x60, 0x2b, 0x58, 0x8c, 0x83,
-	0x89, 0x6f, 0xcf, 0x43, 0xdb, 0x73, 0x39, 0x4d, 0x85, 0x68, 0x14, 0x19, 0x41, 0xc4, 0x77, 0xa1,
-	0x3c, 0x5f, 0x8c, 0x75, 0xdb, 0x9d, 0x7a, 0xc4, 0xdc, 0xab, 0x0f, 0xeb, 0x7c, 0x62, 0x0e, 0x17,
-	0xe3, 0xae, 0x3b, 0xf5, 0xb4, 0xd2, 0x9c, 0x7f, 0xa8, 0x6f, 0x41, 0x49, 0xc0, 0x50, 0x7a, 0x87,
-	0x96, 0x6b, 0xb8, 0xa1, 0x1e, 0x8b, 0xfd, 0x32, 0x07, 0x74, 0x4d, 0xf5, 0x1f, 0x64, 0x40, 0x19,
-	0x4a, 0xd5, 0x1c, 0x58, 0xa1, 0xb1, 0x96, 0x2b, 0xbc, 0x0a, 0x60, 0x4c, 0x26, 0xde, 0x82, 0x17,
-	0xc3, 0x17, 0x4f, 0x45, 0x40, 0xba, 0xa6, 0x3c, 0x36, 0xb9, 0xd4, 0xd8, 0xbc, 0x06, 0xb5, 0x28,
-	0x9f, 0xb4, 0xa1, 0xab, 0x02, 0x16, 0x8d, 0x4e, 0xb0, 0x48, 0xed, 0xea, 0x52, 0xb0, 0xe0, 0xdb,
-	0xfa, 0x6f, 0x67, 0xa1, 0xfc, 0x78, 0xe1, 0x4e, 0xb0, 0x69, 0xec, 0x75, 0xc8, 0x4f, 0x17, 0xee,
-	0x84, 0x9a, 0x15, 0x8b, 0x86, 0x78, 0x45, 0x68, 0x84, 0xc4, 0xbd, 0x66, 0xf8, 0xc7, 0xb8, 0x47,
-	0x57, 0xf6, 0x1a, 0xc2, 0xd5, 0x7f, 0x9e, 0xe1, 0x25, 0x3e, 0x76, 0x8c, 0x63, 0x56, 0x86, 0x7c,
-	0x7f, 0xd0, 0xef, 0x28, 0x1b, 0xac, 0x06, 0xe5, 0x6e, 0x7f, 0xd4, 0xd1, 0xfa, 0xad, 0x9e, 0x92,
-	0xa1, 0x85, 0x3b, 0x6a, 0xed, 0xf6, 0x3a, 0x4a, 0x16, 0x31, 0xcf, 0x06, 0xbd, 0xd6, 0xa8, 0xdb,
-	0xeb, 0x28, 0x79, 0x8e, 0xd1, 0xba, 0xed, 0x91, 0x52, 0x66, 0x0a, 0xd4, 0x0e, 0xb5, 0xc1, 0xde,
-	0x51, 0xbb, 0xa3, 0xf7, 0x8f, 0x7a, 0x3d, 0x45, 0x61, 0x57, 0x60, 0x33, 0x86, 0x0c, 0x38, 0x70,
-	0x07, 0xb3, 0x3c, 0x6b, 0x69, 0x2d, 0xed, 0x89, 0xf2, 0x53, 0x56, 0x86, 0x5c, 0xeb, 0xc9, 0x13,
-	0xe5, 0x17, 0xb8, 0x07, 0x2a, 0xcf, 0xbb, 0x7d, 0xfd, 0x59, 0xab, 0x77, 0xd4, 0x51, 0x7e, 0x91,
-	0x8d, 0xd2, 0x03, 0x6d, 0xaf, 0xa3, 0x29, 0xbf, 0xc8, 0xb3, 0x2d, 0xa8, 0xfd, 0x7c, 0xd0, 0xef,
-	0x1c, 0xb4, 0x0e, 0x0f, 0xa9, 0x21, 0xbf, 0x28, 0xab, 0x7f, 0x92, 0x87, 0x3c, 0xf6, 0x84, 0xa9,
-	0xc9, 0x7e, 0x8f, 0xbb, 0x88, 0x1b, 0x6e, 0x37, 0xff, 0x27, 0x7f, 0x76, 0x67, 0x83, 0xef, 0xf4,
-	0xd7, 0x20, 0xe7, 0xd8, 0x21, 0x4d, 0x54, 0xbc, 0x4a, 0x84, 0x0e, 0xb4, 0xbf, 0xa1, 0x21, 0x8e,
-	0xdd, 0x86, 0x0c, 0xdf, 0xf2, 0xd5, 0x87, 0x0d, 0xb1, 0x8c, 0x84, 0xcc, 0xd8, 0xdf, 0xd0, 0x32,
-	0x73, 0x76, 0x0b, 0x32, 0x2f, 0xc4, 0xfe, 0xaf, 0x71, 0x3c, 0x97, 0x1a, 0x88, 0x7d, 0xc1, 0x76,
-	0x20, 0x37, 0xf1, 0xb8, 0x86, 0x13, 0xe3, 0x39, 0x0f, 0xc5, 0xf2, 0x27, 0x9e, 0xc3, 0x5e, 0x87,
-	0x9c, 0x6f, 0x9c, 0xd1, 0x5e, 0x89, 0xa7, 0x2b, 0x66, 0xd2, 0x48, 0xe4, 0x1b, 0x67, 0xd8, 0x88,
-	0x29, 0xed, 0x98, 0xb8, 0x11, 0xd1, 0x7c, 0x63, 0x35, 0x53, 0xb6, 0x03, 0x99, 0x33, 0xda, 0x33,
-	0xb1, 0x50, 0x7f, 0x6e, 0xbb, 0xa6, 0x77, 0x36, 0x9c, 0x5b, 0x13, 0xa4, 0x38, 0x63, 0x6f, 0x42,
-	0x2e, 0x58, 0x8c, 0x69, 0xcf, 0x54, 0x1f, 0x6e, 0xad, 0x70, 0x3f, 0xac, 0x28, 0x58, 0x8c, 0xd9,
-	0x5b, 0x90, 0x9f, 0x78, 0xbe, 0x2f, 0xf6, 0x8d, 0x12, 0x35, 0x38, 0x62, 0xfc, 0xa8, 0xe4, 0x20,
-	0x1e, 0x2b, 0x0c, 0x49, 0x47, 0x8a, 0x89, 0x12, 0xce, 0x8b, 0x15, 0x86, 0xec, 0x0d, 0xc1, 0xce,
-	0x6b, 0x72, 0xab, 0x23, 0x66, 0x8f, 0xe5, 0x20, 0x16, 0x27, 0x69, 0x66, 0x9c, 0x93, 0x06, 0x15,
-	0x13, 0x45, 0x5c, 0x1e, 0xdb, 0x34, 0x33, 0xce, 0xd9, 0x1b, 0x90, 0x7b, 0x61, 0x4d, 0x48, 0x99,
-	0x8a, 0x6b, 0x13, 0x93, 0xf4, 0x8c, 0xba, 0x87, 0x68, 0x94, 0x5b, 0xc6, 0xe2, 0x1c, 0xb7, 0xdd,
-	0x26, 0x97, 0x30, 0xc6, 0xe2, 0xbc, 0x6b, 0x22, 0x07, 0x73, 0xcd, 0x17, 0xa4, 0x4d, 0x65, 0x34,
-	0xfc, 0x44, 0x4d, 0x3e, 0xb0, 0x1c, 0x6b, 0x12, 0xda, 0x2f, 0xec, 0xf0, 0x82, 0x54, 0xa8, 0x8c,
-	0x26, 0x83, 0x76, 0x8b, 0x90, 0xb7, 0xce, 0xe7, 0xbe, 0xfa, 0x10, 0x20, 0xa9, 0x07, 0x4b, 0x72,
-	0x2c, 0x37, 0xd2, 0x10, 0x1c, 0xcb, 0x45, 0x0e, 0x60, 0x1a, 0xa1, 0x41, 0xcb, 0xa7, 0xa6, 0xd1,
-	0xb7, 0x7a, 0x03, 0x2a, 0xb1, 0xea, 0xc5, 0x6a, 0x90, 0x31, 0x04, 0xe7, 0xcd, 0x18, 0xea, 0x5d,
-	0xd4, 0x84, 0x22, 0xe5, 0x2a, 0x8d, 0xc3, 0x54, 0xc4, 0x8f, 0x33, 0x63, 0xf5, 0x47, 0x50, 0xd3,
-	0xac, 0x60, 0xe1, 0x84, 0x6d, 0xcf, 0xd9, 0xb3, 0xa6, 0xec, 0x5d, 0x80, 0x38, 0x1d, 0x08, 0x01,
-	0x99, 0x2c, 0xa6, 0x3d, 0x6b, 0xaa, 0x49, 0x78, 0xf5, 0xf7, 0xf2, 0xa4, 0x6a, 0xec, 0x71, 0x19,
-	0x2f, 0x84, 0x79, 0x46, 0x12, 0xe6, 0x31, 0xeb, 0xca, 0xa6, 0x15, 0x9a, 0x13, 0xdb, 0x34, 0x2d,
-	0x37, 0x52, 0x5c, 0x78, 0x0a, 0x47, 0xdf, 0x70, 0x8e, 0x69, 0x85, 0x37, 0x1e, 0xb2, 0xa8, 0xd2,
-	0xd9, 0xdc, 0xb7, 0x82, 0x80, 0x8b, 0x4c, 0xc3, 0x39, 0x8e, 0x36, 0x5b, 0xe1, 0xd7, 0x6d, 0xb6,
-	0x1b, 0x50, 0x76, 0xbd, 0x50, 0x27, 0xb3, 0xa2, 0x48, 0x75, 0x94, 0x84, 0xfd, 0xc4, 0xde, 0x86,
-	0x92, 0x50, 0x08, 0xc5, 0x2a, 0x17, 0x7b, 0x71, 0x8f, 0x03, 0xb5, 0x08, 0xcb, 0x9a, 0xa8, 0x5f,
-	0xcc, 0x66, 0x96, 0x1b, 0x46, 0x22, 0x42, 0x24, 0xd9, 0x0f, 0xa0, 0xe2, 0xb9, 0x3a, 0xd7, 0x1a,
-	0xc5, 0x32, 0x17, 0xeb, 0x69, 0xe0, 0x1e, 0x11, 0x54, 0x2b, 0x7b, 0xe2, 0x0b, 0x9b, 0xe2, 0x78,
-	0x67, 0xfa, 0xc4, 0xf0, 0x4d, 0x5a, 0xea, 0x65, 0xad, 0xe4, 0x78, 0x67, 0x6d, 0xc3, 0x37, 0xb9,
-	0xc8, 0xfc, 0xda, 0x5d, 0xcc, 0x68, 0x79, 0xd7, 0x35, 0x91, 0x62, 0xb7, 0xa0, 0x32, 0x71, 0x16,
-	0x41, 0x68, 0xf9, 0xbb, 0x17, 0xdc, 0x0e, 0xd0, 0x12, 0x00, 0xb6, 0x6b, 0xee, 0xdb, 0x33, 0xc3,
-	0xbf, 0xa0, 0xb5, 0x5c, 0xd6, 0xa2, 0x24, 0xaa, 0x2a, 0xf3, 0x53, 0xdb, 0x3c, 0xe7, 0xc6, 0x80,
-	0xc6, 0x13, 0x48, 0x7f, 0x42, 0xa6, 0x5a, 0x40, 0xcb, 0xb5, 0xac, 0x45, 0x49, 0x9a, 0x07, 0xfa,
-	0xa4, 0x35, 0x5b, 0xd1, 0x44, 0x2a, 0xa5, 0xef, 0x6d, 0x5d, 0xaa, 0xef, 0xb1, 0x94, 0xbe, 0xf7,
-	0x35, 0x94, 0xc4, 0x08, 0x22, 0xc7, 0xc7, 0x35, 0x9d, 0x66, 0x87, 0x9c, 0xe3, 0x23, 0x9c, 0xbd,
-	0x0e, 0x75, 0xcf, 0xb7, 0x8f, 0x6d, 0x57, 0x0f, 0x42, 0xdf, 0x76, 0x8f, 0xc5, 0xda, 0xa8, 0x71,
-	0xe0, 0x90, 0x60, 0x28, 0xa6, 0x70, 0xf6, 0x74, 0x63, 0x6c, 0x3b, 0xb8, 0x77, 0x72, 0xc2, 0x0a,
-	0x5e, 0x38, 0x4e, 0x8b, 0x83, 0xd4, 0x01, 0x94, 0xa3, 0xf1, 0xfe, 0x8d, 0xd4, 0xa9, 0xfe, 0x16,
-	0x54, 0xbb, 0xae, 0x69, 0x9d, 0x0f, 0x48, 0xf2, 0xb2, 0x77, 0x81, 0x4d, 0x7c, 0xcb, 0x08, 0x2d,
-	0xdd, 0x3a, 0x0f, 0x7d, 0x43, 0xe7, 0x96, 0x32, 0xb7, 0x52, 0x15, 0x8e, 0xe9, 0x20, 0x62, 0x84,
-	0x70, 0xf5, 0xbf, 0x64, 0xa0, 0x7e, 0xc8, 0x27, 0xe2, 0xa9, 0x75, 0xb1, 0xc7, 0x75, 0xf9, 0x49,
-	0xb4, 0x89, 0xf2, 0x1a, 0x7d, 0xb3, 0xdb, 0x50, 0x9d, 0x9f, 0x5a, 0x17, 0x7a, 0x4a, 0xef, 0xad,
-	0x20, 0xa8, 0x4d, 0xdb, 0xe5, 0x1d, 0x28, 0x7a, 0x54, 0xbb, 0x90, 0x03, 0x82, 0x7d, 0x4a, 0xcd,
-	0xd2, 0x04, 0x01, 0x53, 0xa1, 0x1e, 0x17, 0x25, 0x4b, 0x72, 0x51, 0x18, 0x4d, 0xd7, 0x36, 0x14,
-	0x10, 0x15, 0x34, 0x0b, 0x3b, 0x39, 0x54, 0x5e, 0x29, 0xc1, 0xde, 0x83, 0xfa, 0xc4, 0x9b, 0xcd,
-	0xf5, 0x28, 0xbb, 0x90, 0x08, 0xe9, 0x6d, 0x5e, 0x45, 0x92, 0x43, 0x5e, 0x96, 0xfa, 0x07, 0x39,
-	0x28, 0x53, 0x1b, 0xc4, 0x4e, 0xb7, 0xcd, 0xf3, 0x68, 0xa7, 0x57, 0xb4, 0x82, 0x6d, 0x22, 0xfb,
-	0x7b, 0x15, 0xc0, 0x46, 0x12, 0x5d, 0xda, 0xef, 0x15, 0x82, 0x44, 0x4d, 0x99, 0x1b, 0x7e, 0x18,
-	0x34, 0x73, 0xbc, 0x29, 0x94, 0xc0, 0x25, 0xb8, 0x70, 0xed, 0xaf, 0x17, 0xbc, 0xf5, 0x65, 0x4d,
-	0xa4, 0xd8, 0x5d, 0x50, 0x78, 0x61, 0x34, 0xe8, 0xb2, 0x2a, 0xd2, 0x20, 0x38, 0x8d, 0x79, 0xa4,
-	0xeb, 0x71, 0x1a, 0xeb, 0x1c, 0x65, 0x00, 0xdf, 0xed, 0x40, 0xa0, 0x0e, 0x42, 0xe4, 0x7d, 0x5c,
-	0x4a, 0xef, 0xe3, 0x26, 0x94, 0x5e, 0xd8, 0x81, 0x8d, 0xb3, 0x5a, 0xe6, 0x3b, 0x43, 0x24, 0xa5,
-	0x69, 0xa8, 0xbc, 0x6c, 0x1a, 0xe2, 0x6e, 0x1b, 0xce, 0x31, 0x57, 0x02, 0xa3, 0x6e, 0xb7, 0x9c,
-	0x63, 0x8f, 0xbd, 0x0f, 0x57, 0x13, 0xb4, 0xe8, 0x0d, 0xb9, 0x44, 0xc8, 0xea, 0xd7, 0x58, 0x4c,
-	0x49, 0x3d, 0x22, 0x2d, 0xfd, 0x1e, 0x6c, 0x49, 0x59, 0xe6, 0xa8, 0x02, 0x04, 0xc4, 0x06, 0x2a,
-	0xda, 0x66, 0x4c, 0x4e, 0x9a, 0x41, 0xa0, 0xfe, 0x9b, 0x2c, 0xd4, 0x1f, 0x7b, 0xbe, 0x65, 0x1f,
-	0xbb, 0xc9, 0xaa, 0x5b, 0xd1, 0x15, 0xa3, 0x95, 0x98, 0x95, 0x56, 0xe2, 0x1d, 0xa8, 0x4e, 0x79,
-	0x46, 0x3d, 0x1c, 0x73, 0x13, 0x32, 0xaf, 0x81, 0x00, 0x8d, 0xc6, 0x0e, 0xee, 0xc0, 0x88, 0x80,
-	0x32, 0xe7, 0x29, 0x73, 0x94, 0x09, 0xd9, 0x3f, 0xfb, 0x8c, 0x18, 0xa1, 0x69, 0x39, 0x56, 0xc8,
-	0xa7, 0xa7, 0xf1, 0xf0, 0x55, 0xa1, 0x33, 0xc8, 0x6d, 0xba, 0xaf, 0x59, 0xd3, 0x16, 0xa9, 0x10,
-	0xc8, 0x17, 0xf7, 0x88, 0x5c, 0xe4, 0x15, 0x4c, 0xb4, 0xf8, 0x2d, 0xf3, 0xf2, 0xdd, 0xae, 0x8e,
-	0xa0, 0x12, 0x83, 0x51, 0x1f, 0xd4, 0x3a, 0x42, 0x07, 0xdc, 0x60, 0x55, 0x28, 0xb5, 0x5b, 0xc3,
-	0x76, 0x6b, 0xaf, 0xa3, 0x64, 0x10, 0x35, 0xec, 0x8c, 0xb8, 0xde, 0x97, 0x65, 0x9b, 0x50, 0xc5,
-	0xd4, 0x5e, 0xe7, 0x71, 0xeb, 0xa8, 0x37, 0x52, 0x72, 0xac, 0x0e, 0x95, 0xfe, 0x40, 0x6f, 0xb5,
-	0x47, 0xdd, 0x41, 0x5f, 0xc9, 0xab, 0x3f, 0x85, 0x72, 0xfb, 0xc4, 0x9a, 0x9c, 0x5e, 0x36, 0x8a,
-	0x64, 0x82, 0x59, 0x93, 0x53, 0xa1, 0xc3, 0x2d, 0x99, 0x60, 0xd6, 0xe4, 0x54, 0x7d, 0x06, 0xb5,
-	0x76, 0xc4, 0xa7, 0x2f, 0x2b, 0xe5, 0x21, 0x34, 0x68, 0xf3, 0x4d, 0xc6, 0xd1, 0xee, 0xcb, 0xae,
-	0xd9, 0x7d, 0x35, 0xa4, 0x69, 0x8f, 0xc5, 0xf6, 0xfb, 0x10, 0xaa, 0x87, 0xbe, 0x37, 0xb7, 0xfc,
-	0x90, 0x8a, 0x55, 0x20, 0x77, 0x6a, 0x5d, 0x88, 0x52, 0xf1, 0x33, 0x31, 0x52, 0xb3, 0xb2, 0x91,
-	0xfa, 0x10, 0xca, 0x51, 0xb6, 0x6f, 0x9d, 0xe7, 0x27, 0xc8, 0xc5, 0x28, 0x8f, 0x6d, 0x05, 0x58,
-	0xd9, 0x7d, 0x80, 0x79, 0x0c, 0x10, 0x0a, 0x41, 0xa4, 0x9d, 0x8a, 0xc2, 0x35, 0x89, 0x42, 0xfd,
-	0x8b, 0x1c, 0x34, 0x0e, 0x0d, 0x3f, 0xb4, 0x71, 0x72, 0xf8, 0x30, 0xbc, 0x0d, 0x79, 0x5a, 0xf2,
-	0xdc, 0x1e, 0xbe, 0x12, 0xab, 0xb6, 0x9c, 0x86, 0x24, 0x3b, 0x11, 0xb0, 0xcf, 0xa0, 0x31, 0x8f,
-	0xc0, 0x3a, 0xf1, 0x73, 0x3e, 0x36, 0xcb, 0x59, 0x68, 0xcc, 0xeb, 0x73, 0x39, 0xc9, 0x7e, 0x0c,
-	0xdb, 0xe9, 0xbc, 0x56, 0x10, 0x24, 0x7c, 0x54, 0x9e, 0xac, 0x2b, 0xa9, 0x8c, 0x9c, 0x8c, 0xb5,
-	0x61, 0x2b, 0xc9, 0x3e, 0xf1, 0x9c, 0xc5, 0xcc, 0x0d, 0x84, 0xae, 0x7d, 0x6d, 0xa9, 0xf6, 0x36,
-	0xc7, 0x6a, 0xca, 0x7c, 0x09, 0xc2, 0x54, 0xa8, 0xc5, 0xb0, 0xfe, 0x62, 0x46, 0x5b, 0x22, 0xaf,
-	0xa5, 0x60, 0xec, 0x11, 0x40, 0x9c, 0x0e, 0x9a, 0x45, 0x1a, 0xcf, 0xe5, 0xfe, 0x75, 0x43, 0x6b,
-	0xa6, 0x49, 0x64, 0xa8, 0x11, 0x20, 0x33, 0xf0, 0xed, 0xf0, 0x64, 0x46, 0x5c, 0x2c, 0xa7, 0x25,
-	0x00, 0x62, 0x96, 0x81, 0x8e, 0x26, 0x5b, 0x9c, 0x45, 0x30, 0xb4, 0x86, 0x1d, 0x0c, 0x17, 0xe3,
-	0xb8, 0x5c, 0x14, 0x83, 0x49, 0x2f, 0x67, 0xc1, 0xb1, 0x30, 0x6c, 0x93, 0x16, 0x1e, 0x04, 0xc7,
-	0xec, 0x21, 0x5c, 0x4d, 0x88, 0x12, 0xfe, 0x1b, 0x34, 0x81, 0x38, 0x77, 0x32, 0x7c, 0x31, 0x13,
-	0x0e, 0xd4, 0xcf, 0xa1, 0x9e, 0x9a, 0x9d, 0x97, 0x0a, 0xe4, 0x1b, 0x50, 0xc6, 0xff, 0x28, 0x8e,
-	0xc5, 0x02, 0x2c, 0x61, 0x7a, 0x18, 0xfa, 0xaa, 0x05, 0xca, 0xf2, 0x58, 0xb3, 0x37, 0xc8, 0xd9,
-	0x43, 0x93, 0xb2, 0xea, 0xb4, 0x89, 0x50, 0x68, 0xbb, 0xaf, 0x4e, 0x62, 0x96, 0x5a, 0xbd, 0x32,
-	0x59, 0xea, 0x3f, 0xca, 0x4a, 0x6d, 0xc6, 0x11, 0x67, 0x6f, 0xca, 0xcb, 0x4f, 0xda, 0xb8, 0xc9,
-	0x98, 0x91, 0xc4, 0x79, 0x07, 0x14, 0xcf, 0x37, 0x6d, 0xd7, 0x20, 0xe7, 0x13, 0x1f, 0xee, 0x2c,
-	0x29, 0x70, 0x9b, 0x02, 0x7e, 0x28, 0xc0, 0x68, 0x00, 0x98, 0x56, 0x6c, 0xcb, 0x0b, 0x4b, 0x5c,
-	0x06, 0xc9, 0xd2, 0x29, 0x9f, 0x96, 0x4e, 0x6f, 0x43, 0xc5, 0xb1, 0x82, 0x40, 0x0f, 0x4f, 0x0c,
-	0x97, 0xe4, 0x77, 0xba, 0xd3, 0x65, 0x44, 0x8e, 0x4e, 0x0c, 0x17, 0x09, 0x6d, 0x57, 0x17, 0xde,
-	0xfa, 0xe2, 0x2a, 0xa1, 0xed, 0x92, 0x8d, 0x83, 0x72, 0x7f, 0x7b, 0xdd, 0xc4, 0x0a, 0xb1, 0xc8,
-	0x56, 0xe7, 0x55, 0x7d, 0x15, 0x4a, 0xcf, 0x6c, 0xeb, 0x4c, 0xf0, 0xb2, 0x17, 0xb6, 0x75, 0x16,
-	0xf1, 0x32, 0xfc, 0x56, 0xff, 0x73, 0x19, 0xca, 0x44, 0xbc, 0x77, 0xb9, 0x93, 0xef, 0xbb, 0x18,
-	0x00, 0x3b, 0x42, 0x4e, 0xe5, 0xd7, 0x98, 0x1d, 0x5c, 0x6a, 0xbd, 0x0a, 0x20, 0xc9, 0x50, 0xae,
-	0x11, 0x54, 0xc2, 0x58, 0x74, 0xa2, 0xe6, 0x4c, 0x8a, 0x59, 0xf0, 0xb5, 0x23, 0x7c, 0x42, 0x09,
-	0x80, 0xdd, 0xe7, 0x7a, 0x2d, 0xf9, 0x2c, 0x4a, 0x32, 0x63, 0xa1, 0x3e, 0x44, 0x66, 0x2e, 0x29,
-	0xbb, 0x98, 0x20, 0xfd, 0xc0, 0xf2, 0x83, 0x68, 0x3b, 0xd5, 0xb5, 0x28, 0x89, 0x1c, 0x0d, 0x95,
-	0x27, 0x61, 0x96, 0x46, 0xdb, 0x57, 0xd6, 0xfe, 0x34, 0x22, 0x60, 0x77, 0xa1, 0x44, 0x22, 0xdb,
-	0x42, 0x09, 0x2e, 0xb1, 0xce, 0x48, 0x99, 0xd2, 0x22, 0x34, 0x7b, 0x07, 0x0a, 0xd3, 0x53, 0xeb,
-	0x22, 0x68, 0xd6, 0x65, 0x96, 0x90, 0x92, 0x85, 0x1a, 0xa7, 0x60, 0x6f, 0x40, 0xc3, 0xb7, 0xa6,
-	0x3a, 0xb9, 0xfd, 0x50, 0x78, 0x07, 0xcd, 0x06, 0xc9, 0xe6, 0x9a, 0x6f, 0x4d, 0xdb, 0x08, 0x1c,
-	0x8d, 0x9d, 0x80, 0xbd, 0x05, 0x45, 0x92, 0x4a, 0xa8, 0xf6, 0x4b, 0x35, 0x47, 0x22, 0x4e, 0x13,
-	0x58, 0xf6, 0x10, 0x2a, 0x09, 0xdb, 0xb8, 0x4a, 0x1d, 0xda, 0x5e, 0xe2, 0x47, 0xc4, 0xc6, 0xb5,
-	0x84, 0x8c, 0xbd, 0x0f, 0x20, 0x0c, 0x12, 0x7d, 0x7c, 0x41, 0x8e, 0xf4, 0x6a, 0x6c, 0xb0, 0x49,
-	0x02, 0x50, 0x36, 0x5b, 0xde, 0x86, 0x02, 0x4a, 0x89, 0xa0, 0x79, 0x9d, 0x5a, 0xb3, 0x95, 0x16,
-	0x21, 0xd4, 0x3b, 0xc2, 0xb3, 0xbb, 0x50, 0xc6, 0xc5, 0xa5, 0xe3, 0x14, 0x36, 0x65, 0x0b, 0x4d,
-	0xac, 0x44, 0xd4, 0xd2, 0xac, 0xb3, 0xe1, 0xd7, 0x0e, 0xbb, 0x07, 0x79, 0xd3, 0x9a, 0x06, 0xcd,
-	0x1b, 0x54, 0xe2, 0x35, 0x69, 0x2e, 0x51, 0x71, 0xd8, 0xb3, 0xa6, 0x5c, 0xb4, 0x20, 0x0d, 0xdb,
-	0x87, 0x06, 0x2e, 0xbd, 0x87, 0xa4, 0x78, 0xe3, 0x90, 0x37, 0x6f, 0x52, 0xae, 0xd7, 0x96, 0x72,
-	0xf5, 0x05, 0x11, 0x4d, 0x50, 0xc7, 0x0d, 0xfd, 0x0b, 0xad, 0xee, 0xca, 0x30, 0x76, 0x13, 0xca,
-	0x76, 0xd0, 0xf3, 0x26, 0xa7, 0x96, 0xd9, 0x7c, 0x85, 0x9f, 0xbd, 0x45, 0x69, 0xf6, 0x29, 0xd4,
-	0x69, 0x31, 0x62, 0x12, 0x2b, 0x6f, 0xde, 0x92, 0x45, 0xde, 0x48, 0x46, 0x69, 0x69, 0x4a, 0x54,
-	0xb7, 0xec, 0x40, 0x0f, 0xad, 0xd9, 0xdc, 0xf3, 0xd1, 0xb6, 0x7b, 0x95, 0x1b, 0x3c, 0x76, 0x30,
-	0x8a, 0x40, 0xc8, 0xe7, 0xe3, 0x63, 0x3f, 0xdd, 0x9b, 0x4e, 0x03, 0x2b, 0x6c, 0xde, 0xa6, 0xbd,
-	0xd6, 0x88, 0x4e, 0xff, 0x06, 0x04, 0x25, 0xa5, 0x34, 0xd0, 0xcd, 0x0b, 0xd7, 0x98, 0xd9, 0x93,
-	0xe6, 0x1d, 0x6e, 0x42, 0xda, 0xc1, 0x1e, 0x07, 0xc8, 0x56, 0xdc, 0x8e, 0x6c, 0xc5, 0xdd, 0x7c,
-	0x42, 0x56, 0x1c, 0xb5, 0xe7, 0xc3, 0x25, 0xb9, 0x9f, 0x5a, 0xe8, 0x92, 0x82, 0xb0, 0xbf, 0x21,
-	0x8b, 0xff, 0xdd, 0x02, 0xe4, 0x4c, 0x6b, 0x7a, 0xf3, 0xa7, 0xc0, 0x56, 0x47, 0xf2, 0x65, 0x4a,
-	0x48, 0x41, 0x28, 0x21, 0x9f, 0x65, 0x3f, 0xc9, 0xa8, 0x9f, 0x42, 0x3d, 0xb5, 0x2d, 0xd7, 0x2a,
-	0x53, 0xdc, 0xa8, 0x30, 0x66, 0xc2, 0x2f, 0xc2, 0x13, 0xea, 0xbf, 0xcf, 0x41, 0x6d, 0xdf, 0x08,
-	0x4e, 0x0e, 0x8c, 0xf9, 0x30, 0x34, 0xc2, 0x00, 0xc7, 0xf6, 0xc4, 0x08, 0x4e, 0x66, 0xc6, 0x9c,
-	0xbb, 0xc7, 0x33, 0xdc, 0x11, 0x23, 0x60, 0x43, 0xfb, 0x1b, 0x0b, 0x67, 0x15, 0x93, 0x03, 0xf7,
-	0xf0, 0xa9, 0x38, 0x66, 0x89, 0xd3, 0xc8, 0x07, 0x82, 0x93, 0xc5, 0x74, 0xea, 0x58, 0x82, 0x5f,
-	0x45, 0x49, 0xf6, 0x06, 0xd4, 0xc5, 0x27, 0x99, 0x6f, 0xe7, 0xe2, 0xcc, 0x35, 0x0d, 0x64, 0x8f,
-	0xa0, 0x2a, 0x00, 0xa3, 0x88, 0x6b, 0x35, 0x62, 0xc7, 0x58, 0x82, 0xd0, 0x64, 0x2a, 0xf6, 0x33,
-	0xb8, 0x2a, 0x25, 0x1f, 0x7b, 0xfe, 0xc1, 0xc2, 0x09, 0xed, 0x76, 0x5f, 0xe8, 0xca, 0xaf, 0xac,
-	0x64, 0x4f, 0x48, 0xb4, 0xf5, 0x39, 0xd3, 0xad, 0x3d, 0xb0, 0x5d, 0xa1, 0x49, 0xa4, 0x81, 0x4b,
-	0x54, 0xc6, 0x39, 0xf1, 0xbe, 0x34, 0x95, 0x71, 0x8e, 0x2b, 0x5d, 0x00, 0x0e, 0xac, 0xf0, 0xc4,
-	0x33, 0x49, 0x93, 0x88, 0x57, 0xfa, 0x50, 0x46, 0x69, 0x69, 0x4a, 0x1c, 0x4e, 0x34, 0xe3, 0x27,
-	0x6e, 0x48, 0xe6, 0x52, 0x4e, 0x8b, 0x92, 0x28, 0x17, 0x7c, 0xc3, 0x3d, 0xb6, 0x82, 0x66, 0x75,
-	0x27, 0x77, 0x37, 0xa3, 0x89, 0x94, 0xfa, 0xd7, 0xb3, 0x50, 0xe0, 0x33, 0xf9, 0x0a, 0x54, 0xc6,
-	0x8e, 0x37, 0x39, 0xd5, 0xdd, 0xc5, 0x2c, 0xf2, 0x9d, 0x13, 0x00, 0x55, 0x2b, 0x32, 0x73, 0x02,
-	0xee, 0x63, 0xcd, 0x68, 0xf4, 0x8d, 0x45, 0x7a, 0x8b, 0x10, 0xeb, 0xca, 0x11, 0x54, 0xa4, 0xb0,
-	0x11, 0xbe, 0x77, 0x46, 0xab, 0x21, 0x4f, 0x88, 0x28, 0x49, 0xee, 0x79, 0x12, 0x31, 0x98, 0xa9,
-	0x40, 0xb8, 0x32, 0x01, 0xda, 0x6e, 0xb8, 0xec, 0xd1, 0x2b, 0xae, 0x78, 0xf4, 0xd8, 0x6d, 0x40,
-	0x23, 0x6a, 0x62, 0x0d, 0x5c, 0xab, 0xdd, 0xa7, 0x11, 0x2e, 0x6b, 0x12, 0x84, 0x7d, 0x14, 0xaf,
-	0x45, 0xea, 0x91, 0x70, 0xa5, 0x0a, 0xe6, 0x29, 0xaf, 0x5a, 0x2d, 0x45, 0xa7, 0x3e, 0x07, 0xd0,
-	0xbc, 0xb3, 0xc0, 0x0a, 0x49, 0xbd, 0xba, 0x4e, 0xcd, 0x4f, 0x9d, 0x8a, 0x79, 0x67, 0x87, 0x5e,
-	0x10, 0x1d, 0x2e, 0x66, 0xe3, 0xc3, 0xc5, 0x58, 0x13, 0xcb, 0xad, 0xd7, 0xc4, 0xd4, 0x07, 0x50,
-	0x42, 0x11, 0x6b, 0x84, 0x06, 0x7b, 0x43, 0x78, 0x19, 0xb9, 0x8a, 0x25, 0xfc, 0x9f, 0x49, 0xad,
-	0xc2, 0xef, 0xf8, 0x20, 0x6a, 0x09, 0xe5, 0x79, 0x4d, 0xf2, 0x72, 0xc4, 0xac, 0x5a, 0x14, 0xc8,
-	0x85, 0xb6, 0xfa, 0x5f, 0x33, 0x50, 0x1d, 0xf8, 0x26, 0x8a, 0x81, 0xe1, 0xdc, 0x9a, 0xbc, 0x54,
-	0x37, 0x44, 0x29, 0xee, 0x39, 0x8e, 0x11, 0x6b, 0x56, 0x28, 0xc5, 0x23, 0x00, 0x7b, 0x1f, 0xf2,
-	0x53, 0xc7, 0x38, 0xa6, 0xfe, 0xc4, 0x36, 0xa3, 0x54, 0x7c, 0xf4, 0xfd, 0xd8, 0x31, 0x8e, 0x35,
-	0x22, 0x55, 0x7f, 0x27, 0xae, 0x9f, 0x4e, 0x19, 0xe4, 0xb3, 0x85, 0x0d, 0x3a, 0xcf, 0x1a, 0xb6,
-	0x95, 0x0c, 0x2b, 0x43, 0x7e, 0xaf, 0x33, 0x6c, 0x73, 0x4b, 0x11, 0x6d, 0xc6, 0xa1, 0xfe, 0xb8,
-	0xab, 0x0d, 0x47, 0x4a, 0x9e, 0x0e, 0xc8, 0x08, 0xd0, 0x6b, 0x0d, 0x47, 0x4a, 0x99, 0x01, 0x14,
-	0x8f, 0xfa, 0xdd, 0x9f, 0x1d, 0x75, 0x14, 0x45, 0xfd, 0x4f, 0x19, 0x80, 0xc4, 0x05, 0xce, 0x7e,
-	0x00, 0xd5, 0x33, 0x4a, 0xe9, 0xd2, 0xd9, 0x88, 0xdc, 0x47, 0xe0, 0x68, 0xd2, 0x30, 0x7e, 0x28,
-	0x19, 0x0c, 0x28, 0x49, 0x57, 0x0f, 0x49, 0xaa, 0xf3, 0x44, 0x08, 0xb3, 0x77, 0xa1, 0xec, 0x61,
-	0x3f, 0x90, 0x34, 0x27, 0x8b, 0x51, 0xa9, 0xfb, 0x5a, 0xc9, 0xe3, 0x09, 0x94, 0xb8, 0x53, 0x3f,
-	0x72, 0x0c, 0xc5, 0xa4, 0x8f, 0x11, 0xd4, 0x76, 0x8c, 0x45, 0x60, 0x69, 0x1c, 0x1f, 0x73, 0xd6,
-	0x42, 0xc2, 0x59, 0xd5, 0x9f, 0x43, 0x63, 0x68, 0xcc, 0xe6, 0x9c, 0xff, 0x52, 0xc7, 0x18, 0xe4,
-	0x71, 0xda, 0xc5, 0x7a, 0xa3, 0x6f, 0xdc, 0x45, 0x87, 0x96, 0x3f, 0x41, 0xed, 0x95, 0x6f, 0xba,
-	0x28, 0x89, 0xfc, 0xf4, 0x28, 0xb0, 0xdd, 0x63, 0xcd, 0x3b, 0x8b, 0x22, 0x54, 0xa2, 0xb4, 0xfa,
-	0x8f, 0x33, 0x50, 0x95, 0x9a, 0xc1, 0x1e, 0xa4, 0xec, 0xc3, 0x57, 0x56, 0xda, 0xc9, 0xbf, 0x25,
-	0x3b, 0xf1, 0x2d, 0x28, 0x04, 0xa1, 0xe1, 0x47, 0xa7, 0x29, 0x8a, 0x94, 0x63, 0xd7, 0x5b, 0xb8,
-	0xa6, 0xc6, 0xd1, 0x4c, 0x85, 0x9c, 0xe5, 0x9a, 0x62, 0xe5, 0xaf, 0x52, 0x21, 0x52, 0xdd, 0x81,
-	0x4a, 0x5c, 0x3c, 0x2e, 0x01, 0x6d, 0xf0, 0x7c, 0xa8, 0x6c, 0xb0, 0x0a, 0x14, 0xb4, 0x56, 0xff,
-	0x49, 0x47, 0xc9, 0xa8, 0xff, 0x2c, 0x03, 0x90, 0xe4, 0x62, 0xf7, 0x53, 0xad, 0xbd, 0xb9, 0x5c,
-	0xea, 0x7d, 0xfa, 0x2b, 0x35, 0xf6, 0x16, 0x54, 0x16, 0x2e, 0x01, 0x2d, 0x53, 0x88, 0x96, 0x04,
-	0xc0, 0x6e, 0x41, 0x2e, 0x8a, 0x65, 0x59, 0x8a, 0x1f, 0x78, 0x61, 0x38, 0xea, 0x67, 0x50, 0x89,
-	0x8b, 0x63, 0x75, 0xa8, 0x3c, 0x1e, 0xf4, 0x7a, 0x83, 0xe7, 0xdd, 0xfe, 0x13, 0x65, 0x03, 0x93,
-	0x87, 0x5a, 0xa7, 0xdd, 0xd9, 0xc3, 0x64, 0x06, 0xd7, 0x6c, 0xfb, 0x48, 0xd3, 0x3a, 0xfd, 0x91,
-	0xae, 0x0d, 0x9e, 0x2b, 0x59, 0xf5, 0x6f, 0xe6, 0x61, 0x6b, 0xe0, 0xee, 0x2d, 0xe6, 0x8e, 0x3d,
-	0x31, 0x42, 0xeb, 0xa9, 0x75, 0xd1, 0x0e, 0xcf, 0x51, 0x62, 0x1a, 0x61, 0xe8, 0xf3, 0xfd, 0x5a,
-	0xd1, 0x78, 0x82, 0xbb, 0xdb, 0x02, 0xcb, 0x0f, 0xc9, 0x9b, 0x48, 0x27, 0x81, 0x82, 0x85, 0x34,
-	0x38, 0xbc, 0xed, 0x39, 0x6d, 0x84, 0xb2, 0x1f, 0xc3, 0x55, 0xee, 0xa2, 0xe3, 0x94, 0xa8, 0x42,
-	0xea, 0x82, 0xbd, 0x2c, 0x2f, 0x5d, 0xc6, 0x09, 0x31, 0x2b, 0x92, 0x11, 0xdf, 0xba, 0x03, 0xd5,
-	0x24, 0x3b, 0x57, 0xf4, 0x2b, 0x1a, 0xc4, 0x84, 0xd4, 0x12, 0xcf, 0xd5, 0xcd, 0xa8, 0xd5, 0xba,
-	0x6d, 0x9e, 0x93, 0xf1, 0x53, 0xd0, 0x1a, 0x5e, 0xd2, 0x19, 0x94, 0xaa, 0x5f, 0xc0, 0x56, 0x8a,
-	0x92, 0x5a, 0xc1, 0xcd, 0x9f, 0x77, 0x23, 0x6f, 0xfc, 0x52, 0xef, 0x65, 0x08, 0x36, 0x87, 0xeb,
-	0x77, 0x9b, 0x5e, 0x1a, 0x8a, 0x12, 0xc0, 0x0e, 0x74, 0xfb, 0xd8, 0xf5, 0x7c, 0x4b, 0x70, 0xf0,
-	0xb2, 0x1d, 0x74, 0x29, 0x9d, 0x58, 0x20, 0xd2, 0xe1, 0x31, 0x17, 0x18, 0xd1, 0xd9, 0x29, 0x47,
-	0xdb, 0x5c, 0x24, 0xe6, 0xb5, 0x12, 0xa5, 0xbb, 0x26, 0x1a, 0xdf, 0x1c, 0x15, 0x19, 0x15, 0x40,
-	0x46, 0x45, 0x8d, 0x80, 0xcf, 0x38, 0xec, 0x66, 0x1f, 0xb6, 0xd7, 0x35, 0x72, 0x8d, 0xea, 0xb4,
-	0x23, 0xab, 0x4e, 0x4b, 0xee, 0xa8, 0x44, 0x8d, 0xfa, 0x17, 0x59, 0xa8, 0x74, 0xf9, 0x14, 0x86,
-	0xe7, 0xec, 0x35, 0xc8, 0xf9, 0xd6, 0xf4, 0xb2, 0x03, 0x5b, 0xc4, 0xb1, 0x7b, 0xb0, 0x65, 0x98,
-	0xa6, 0x6e, 0x4c, 0xa7, 0xd6, 0x24, 0xb4, 0x4c, 0x1d, 0xc5, 0xa2, 0x58, 0xb6, 0x9b, 0x86, 0x69,
-	0xb6, 0x04, 0x9c, 0xb6, 0x3f, 0x77, 0x3c, 0x44, 0x96, 0x00, 0xf7, 0x8f, 0xe7, 0x22, 0xc7, 0x83,
-	0x30, 0x04, 0x48, 0x89, 0x63, 0x3f, 0x88, 0x84, 0xaa, 0x69, 0x4d, 0x05, 0x3f, 0x6a, 0xa4, 0x35,
-	0x6f, 0x21, 0x64, 0xb9, 0xcb, 0xe9, 0xca, 0xb2, 0x9d, 0x6a, 0x9b, 0xdc, 0x87, 0x9d, 0xd7, 0xb6,
-	0xd2, 0x66, 0x6a, 0xd7, 0x0c, 0x2e, 0x77, 0x58, 0x14, 0x2f, 0x75, 0x58, 0xa4, 0x3d, 0x21, 0xb8,
-	0xc8, 0x4a, 0xb4, 0xdc, 0x13, 0x76, 0xdc, 0x35, 0xcf, 0xd5, 0xff, 0x96, 0x05, 0xd0, 0xac, 0xb9,
-	0x63, 0x4c, 0xac, 0xff, 0x7f, 0x46, 0xef, 0x0e, 0x54, 0xb9, 0x47, 0x56, 0x9f, 0x78, 0xae, 0x19,
-	0x85, 0x4d, 0x70, 0x50, 0xdb, 0x23, 0x06, 0xb6, 0x76, 0x78, 0x8b, 0xdf, 0x79, 0x78, 0x4b, 0xdf,
-	0x61, 0x78, 0xcb, 0xeb, 0x86, 0x37, 0x0f, 0xd5, 0x96, 0x6b, 0x38, 0x17, 0xdf, 0x58, 0x14, 0x18,
-	0x41, 0xae, 0xf4, 0xf9, 0x22, 0xe4, 0xa3, 0xc6, 0x0f, 0x2c, 0x2b, 0x04, 0xa1, 0xf1, 0xba, 0x03,
-	0x55, 0x6f, 0x11, 0xc6, 0x78, 0x7e, 0x84, 0x09, 0x1c, 0x44, 0x04, 0x71, 0x7e, 0x52, 0xeb, 0x72,
-	0x52, 0x7e, 0x52, 0xf1, 0x93, 0xfc, 0xb1, 0xda, 0x17, 0xe7, 0x27, 0x02, 0xdc, 0xa0, 0xf6, 0x8c,
-	0xc6, 0x2d, 0x58, 0xcc, 0x2c, 0x3e, 0x76, 0x39, 0x1e, 0x80, 0xd6, 0x16, 0x30, 0x2c, 0x65, 0x66,
-	0xcd, 0x3c, 0xff, 0x82, 0x97, 0x52, 0xe4, 0xa5, 0x70, 0x10, 0x95, 0xf2, 0x2e, 0xb0, 0x33, 0xc3,
-	0x0e, 0xf5, 0x74, 0x51, 0x5c, 0xd5, 0x56, 0x10, 0x33, 0x92, 0x8b, 0xbb, 0x06, 0x45, 0xd3, 0x0e,
-	0x4e, 0xbb, 0x03, 0xa1, 0x66, 0x8b, 0x14, 0xf2, 0xa0, 0xe0, 0x51, 0x77, 0xa0, 0x8f, 0x2f, 0xc4,
-	0x19, 0x63, 0x4e, 0x2b, 0x23, 0x60, 0xf7, 0x22, 0xa4, 0xd3, 0x11, 0x42, 0xf2, 0xde, 0x72, 0x76,
-	0xcd, 0x55, 0xe9, 0x06, 0xc2, 0xbb, 0x08, 0xe6, 0xec, 0xfa, 0x1e, 0x6c, 0x11, 0xa5, 0xe8, 0x38,
-	0x27, 0xad, 0x12, 0xe9, 0x26, 0x22, 0x06, 0x04, 0xe7, 0xb4, 0xb7, 0xa0, 0xe2, 0x5a, 0xe1, 0x99,
-	0xe7, 0x63, 0x6b, 0x6a, 0x7c, 0xf4, 0x62, 0x00, 0x0a, 0xf4, 0x60, 0x62, 0xb8, 0xd8, 0x78, 0x3a,
-	0x77, 0xc4, 0xf6, 0x88, 0x34, 0xea, 0xbc, 0x5c, 0x4c, 0x10, 0xb6, 0xc1, 0x87, 0x24, 0x81, 0xb0,
-	0x4f, 0xe1, 0x46, 0x6a, 0x34, 0x74, 0xc3, 0xf7, 0x8d, 0x0b, 0x7d, 0x66, 0x7c, 0xe5, 0xf9, 0xe4,
-	0x9d, 0xc8, 0x69, 0xd7, 0xe4, 0x41, 0x6e, 0x21, 0xfa, 0x00, 0xb1, 0x97, 0x66, 0xb5, 0x5d, 0xcf,
-	0x6f, 0x2a, 0x97, 0x65, 0x45, 0xac, 0xea, 0x4b, 0x8e, 0xe8, 0x43, 0x7f, 0xe1, 0x5a, 0xdc, 0x74,
-	0xa7, 0x4f, 0x53, 0x9c, 0xe3, 0xc5, 0x69, 0xb6, 0x07, 0x57, 0xb8, 0x1a, 0x6f, 0x99, 0xba, 0xe4,
-	0xa0, 0xcd, 0x5e, 0xee, 0xa0, 0x65, 0x11, 0x7d, 0x0c, 0x0e, 0xd4, 0x5f, 0x64, 0xe0, 0xe6, 0x80,
-	0xce, 0x14, 0x69, 0x33, 0x1c, 0x58, 0x41, 0x60, 0x1c, 0xa3, 0x0d, 0xf6, 0x78, 0xf1, 0xcd, 0x37,
-	0x68, 0xc1, 0x6f, 0x1e, 0x1a, 0xbe, 0xe5, 0x86, 0xf1, 0x56, 0x11, 0x1c, 0x7d, 0x19, 0xcc, 0x3e,
-	0x21, 0x27, 0xa8, 0xe5, 0x86, 0x47, 0xb1, 0x6c, 0x14, 0x6d, 0x49, 0xbb, 0xc5, 0x56, 0xa8, 0xd4,
-	0x7f, 0xf9, 0x0a, 0xe4, 0xfb, 0x9e, 0x69, 0xb1, 0xf7, 0xa0, 0x42, 0xb1, 0x65, 0xab, 0xbe, 0x77,
-	0x44, 0xd3, 0x1f, 0x52, 0x53, 0xca, 0xae, 0xf8, 0xba, 0x3c, 0x1a, 0xed, 0x35, 0x52, 0xb8, 0xe8,
-	0xf0, 0x0e, 0x99, 0x4f, 0x55, 0x58, 0x79, 0x64, 0xa6, 0x70, 0x0c, 0x8e, 0x2d, 0x39, 0xa4, 0x7c,
-	0xcb, 0x25, 0xb1, 0x5e, 0xd0, 0xe2, 0x34, 0xa9, 0xb9, 0xbe, 0x87, 0x8c, 0x52, 0xa7, 0x40, 0x8d,
-	0xc2, 0x1a, 0x35, 0x97, 0xe3, 0x29, 0x3c, 0xef, 0x3d, 0xa8, 0x7c, 0xe5, 0xd9, 0x2e, 0x6f, 0x78,
-	0x71, 0xa5, 0xe1, 0x9f, 0x7b, 0x36, 0x3f, 0x34, 0x28, 0x7f, 0x25, 0xbe, 0xd8, 0xeb, 0x50, 0xf2,
-	0x5c, 0x5e, 0x76, 0x69, 0xa5, 0xec, 0xa2, 0xe7, 0xf6, 0x78, 0x00, 0x48, 0x7d, 0xbc, 0xb0, 0x1d,
-	0x53, 0x47, 0x52, 0x6b, 0x1a, 0x0a, 0x1f, 0x79, 0x95, 0x80, 0x03, 0xb7, 0x67, 0x4d, 0x43, 0xd4,
-	0xde, 0xa7, 0xb6, 0x83, 0xfc, 0x98, 0x0a, 0xab, 0xac, 0x14, 0x06, 0x1c, 0x4d, 0x05, 0xbe, 0x09,
-	0xe5, 0x63, 0xdf, 0x5b, 0xcc, 0x51, 0x1d, 0x87, 0x55, 0xaf, 0x34, 0xe1, 0x76, 0x2f, 0xb0, 0xf7,
-	0xf4, 0x69, 0xbb, 0xc7, 0x7a, 0x60, 0x85, 0x64, 0xdb, 0x2e, 0xf5, 0x3e, 0xc2, 0x0f, 0x2d, 0x2a,
-	0xd5, 0x38, 0x3e, 0xd6, 0x45, 0x44, 0xcb, 0x4a, 0xa9, 0xc6, 0xf1, 0x31, 0x55, 0x7e, 0x1f, 0xea,
-	0x67, 0xb6, 0xab, 0x07, 0x73, 0x6b, 0xc2, 0x69, 0xeb, 0xab, 0xc5, 0x9e, 0xd9, 0x2e, 0xaa, 0xee,
-	0x44, 0x2f, 0xdb, 0x0e, 0x8d, 0x97, 0xda, 0x0e, 0x3b, 0x50, 0x70, 0xec, 0x99, 0x1d, 0x52, 0xc8,
-	0xc0, 0x92, 0x72, 0x41, 0x08, 0xa6, 0x42, 0x51, 0xb8, 0xa0, 0x94, 0x15, 0x12, 0x81, 0x49, 0xcb,
-	0xad, 0xad, 0x97, 0xc8, 0xad, 0xbb, 0x50, 0xf2, 0xc6, 0x5f, 0xe9, 0x28, 0x61, 0xd9, 0x7a, 0x09,
-	0x5b, 0xf4, 0xc6, 0x5f, 0x69, 0xd6, 0x94, 0x7d, 0x48, 0x7e, 0x7a, 0xcb, 0x0d, 0xf5, 0x28, 0xc3,
-	0x95, 0xf5, 0x19, 0x6a, 0x9c, 0x6c, 0xc0, 0xb3, 0xbd, 0x0f, 0x55, 0x9f, 0xec, 0x56, 0x9d, 0x8c,
-	0xdc, 0x6d, 0xd9, 0x2a, 0x48, 0x0c, 0x5a, 0x0d, 0xfc, 0xc4, 0xb8, 0x7d, 0x1d, 0xea, 0xfc, 0xec,
-	0x9f, 0x1f, 0xf6, 0x06, 0xe4, 0xea, 0xac, 0x68, 0x35, 0x02, 0xf2, 0x83, 0xe0, 0x80, 0xdd, 0x07,
-	0x88, 0x04, 0x6e, 0x78, 0x4e, 0x71, 0xc3, 0x71, 0x53, 0xf8, 0x59, 0x67, 0x3b, 0x3c, 0xd7, 0x2a,
-	0x66, 0xf4, 0xc9, 0x5e, 0x83, 0xda, 0xd8, 0x76, 0x4d, 0x5c, 0x0e, 0xa1, 0x71, 0x1c, 0x34, 0x9b,
-	0xb4, 0x5b, 0xaa, 0x02, 0x36, 0x32, 0x8e, 0x03, 0xf6, 0x01, 0xd4, 0x0c, 0x2e, 0x18, 0x79, 0x6c,
-	0xe1, 0x0d, 0xd9, 0x82, 0x93, 0x44, 0xa6, 0x56, 0x35, 0x24, 0xf9, 0xf9, 0x31, 0xb0, 0xc8, 0xbf,
-	0x4d, 0xda, 0x30, 0x5f, 0x17, 0x37, 0x57, 0xd6, 0xc5, 0xa6, 0x70, 0x70, 0xc7, 0xf1, 0xb0, 0x1f,
-	0x43, 0x3d, 0xad, 0x86, 0xdc, 0x5a, 0xe3, 0xd1, 0xa5, 0x29, 0xd3, 0x6a, 0x13, 0x59, 0x31, 0x79,
-	0x1d, 0xea, 0xae, 0x17, 0xea, 0x13, 0x63, 0x72, 0x62, 0x51, 0x46, 0xee, 0xb5, 0xac, 0xb9, 0x5e,
-	0xd8, 0x8e, 0x60, 0x38, 0x3e, 0x91, 0x71, 0x11, 0x9e, 0x93, 0xc3, 0x32, 0x1e, 0x9f, 0x58, 0x33,
-	0x45, 0x39, 0x1d, 0x29, 0xa9, 0x38, 0x4f, 0x5c, 0xe9, 0xa2, 0x0c, 0x77, 0x52, 0xf3, 0x14, 0x6b,
-	0x63, 0x1a, 0xf8, 0x89, 0x66, 0x76, 0x07, 0xaa, 0x81, 0xb7, 0xf0, 0x27, 0x96, 0x1e, 0x84, 0xd6,
-	0xbc, 0xb9, 0x43, 0x23, 0x0a, 0x1c, 0x34, 0x0c, 0xad, 0x39, 0xfb, 0x04, 0x1a, 0x73, 0xdf, 0xd2,
-	0xa5, 0x79, 0x7a, 0x4d, 0xee, 0xe2, 0xa1, 0x6f, 0x25, 0x53, 0x55, 0x9b, 0x4b, 0xa9, 0x28, 0xa7,
-	0xd4, 0x03, 0x75, 0x29, 0x67, 0xd2, 0x09, 0xcc, 0x99, 0x28, 0xdb, 0x3f, 0x81, 0x2d, 0x29, 0xe7,
-	0xe2, 0x94, 0x32, 0xbf, 0x9e, 0x72, 0xb0, 0x47, 0xe4, 0x47, 0xa7, 0x98, 0xbd, 0x31, 0x4f, 0xa5,
-	0x59, 0x6b, 0xc9, 0x16, 0x42, 0x03, 0xe0, 0x0d, 0xca, 0x7f, 0xfd, 0x12, 0x03, 0x27, 0x65, 0x24,
-	0x3d, 0xe5, 0xfe, 0xd5, 0x6e, 0xd0, 0x71, 0xcd, 0xe6, 0x9b, 0x3c, 0x68, 0x9d, 0x12, 0xec, 0x11,
-	0xd4, 0xc8, 0x89, 0x16, 0x52, 0x20, 0x5d, 0xd0, 0x7c, 0x4b, 0xf6, 0xf7, 0x90, 0x47, 0x9a, 0x10,
-	0x5a, 0xd5, 0x89, 0xbf, 0x03, 0xf6, 0x11, 0x6c, 0x71, 0xd7, 0x9b, 0xcc, 0x20, 0xdf, 0x5e, 0x5d,
-	0x5c, 0x44, 0xf4, 0x38, 0xe1, 0x92, 0x1a, 0xdc, 0xf0, 0x17, 0x2e, 0x09, 0x71, 0x91, 0x73, 0xee,
-	0x7b, 0x63, 0x8b, 0xe7, 0xbf, 0x4b, 0xf9, 0x45, 0x77, 0x34, 0x4e, 0xc6, 0xf3, 0x12, 0x3f, 0xba,
-	0xe6, 0xcb, 0xa0, 0x43, 0xcc, 0x77, 0x49, 0x99, 0x9c, 0xb3, 0x53, 0x99, 0xef, 0x7c, 0x97, 0x32,
-	0x77, 0x31, 0x1f, 0x95, 0xc9, 0x20, 0xbf, 0x58, 0xd8, 0x66, 0xf3, 0x1e, 0x0f, 0xb1, 0xc3, 0x6f,
-	0xf6, 0x26, 0x34, 0x7c, 0x6b, 0xb2, 0xf0, 0x03, 0xfb, 0x85, 0xa5, 0x07, 0xb6, 0x7b, 0xda, 0xfc,
-	0x01, 0x8d, 0x63, 0x3d, 0x86, 0x0e, 0x6d, 0xf7, 0x14, 0x57, 0xac, 0x75, 0x1e, 0x5a, 0xbe, 0xab,
-	0xa3, 0x4a, 0xd4, 0x7c, 0x57, 0x5e, 0xb1, 0x1d, 0x42, 0x0c, 0x27, 0x86, 0xab, 0x81, 0x15, 0x7f,
-	0xb3, 0x1f, 0xc3, 0x66, 0xa2, 0x20, 0xcf, 0x51, 0x05, 0x69, 0xfe, 0x70, 0xed, 0xd9, 0x0b, 0xa9,
-	0x27, 0x5a, 0x72, 0x30, 0xc9, 0x35, 0x99, 0xf4, 0xda, 0x0a, 0xf8, 0xda, 0xba, 0xff, 0xad, 0xd6,
-	0xd6, 0x90, 0xd6, 0xd6, 0x5b, 0x50, 0xb6, 0xdd, 0xd0, 0xf2, 0x5f, 0x18, 0x4e, 0xf3, 0xc1, 0x0a,
-	0x03, 0x8f, 0x71, 0xec, 0x0d, 0x28, 0x05, 0x8e, 0x8d, 0x8c, 0xa9, 0xf9, 0xde, 0x0a, 0x59, 0x84,
-	0x42, 0x89, 0x3d, 0xb5, 0x1d, 0x87, 0x4b, 0xec, 0xf7, 0x57, 0x24, 0xf6, 0x63, 0xdb, 0x71, 0xb8,
-	0xc4, 0x9e, 0x8a, 0x2f, 0x94, 0x72, 0x94, 0x03, 0xeb, 0x7f, 0xb8, 0x2a, 0xe5, 0x10, 0xf7, 0x8c,
-	0x6e, 0xa1, 0x54, 0x03, 0x72, 0x43, 0x71, 0x6f, 0xda, 0x23, 0xb9, 0x87, 0x69, 0xff, 0x94, 0x06,
-	0x41, 0x9c, 0x46, 0x4b, 0x40, 0x38, 0xe1, 0xd0, 0xf6, 0xf8, 0x80, 0x07, 0x47, 0x73, 0x48, 0xd7,
-	0x3c, 0x67, 0xef, 0x41, 0x3d, 0x8a, 0x25, 0xc1, 0xea, 0x82, 0xe6, 0x87, 0x2b, 0x2d, 0x48, 0x13,
-	0xb0, 0x3d, 0xa8, 0x4d, 0x51, 0x83, 0x9b, 0x71, 0x85, 0xae, 0xf9, 0x11, 0x35, 0x64, 0x27, 0x92,
-	0xa0, 0x97, 0x29, 0x7c, 0x5a, 0x2a, 0x17, 0x7b, 0x04, 0xf5, 0xc0, 0x72, 0x4d, 0x7d, 0x16, 0x08,
-	0xf9, 0xfe, 0x31, 0xd5, 0x2b, 0x98, 0x61, 0x7c, 0xa7, 0x4a, 0xab, 0x22, 0xd5, 0x41, 0xc0, 0x05,
-	0xfd, 0x23, 0xc0, 0xd5, 0xf6, 0x22, 0xc9, 0xf4, 0xc9, 0x25, 0x99, 0x90, 0x2a, 0xca, 0xf4, 0x2e,
-	0x14, 0x49, 0x3b, 0x1f, 0x36, 0x3f, 0x15, 0x43, 0x96, 0x5c, 0x3f, 0x1b, 0x45, 0x5f, 0x9a, 0xa0,
-	0x51, 0x7f, 0x59, 0x80, 0x72, 0xa4, 0x0e, 0xb2, 0x2a, 0x94, 0x8e, 0xfa, 0x4f, 0xfb, 0x83, 0xe7,
-	0x7d, 0x65, 0x83, 0x35, 0x00, 0x28, 0x22, 0x5a, 0x1f, 0xb6, 0x5b, 0x7d, 0x7e, 0x53, 0x80, 0xe2,
-	0xb0, 0x79, 0x3a, 0xcb, 0xb6, 0xa0, 0xfe, 0xf8, 0xa8, 0x4f, 0x11, 0x34, 0x1c, 0x94, 0x43, 0x50,
-	0xe7, 0x0b, 0xee, 0x5d, 0xe5, 0xa0, 0x3c, 0x82, 0x0e, 0x5a, 0xa3, 0x8e, 0xd6, 0x8d, 0x40, 0x05,
-	0x0a, 0xc6, 0x19, 0x1c, 0x69, 0x6d, 0x51, 0x52, 0x11, 0xab, 0x3d, 0xd4, 0x06, 0x9f, 0x77, 0xda,
-	0x23, 0x05, 0xd8, 0x55, 0xd8, 0x8a, 0xcb, 0x88, 0xca, 0x57, 0xaa, 0xac, 0x06, 0xe5, 0xa8, 0x1c,
-	0x65, 0x1b, 0x4b, 0xd5, 0x3a, 0xed, 0x23, 0x6d, 0xd8, 0x7d, 0xd6, 0xd1, 0xdb, 0xa3, 0x8e, 0x72,
-	0x95, 0x95, 0x21, 0x3f, 0xec, 0xf6, 0x9f, 0x2a, 0xd7, 0x58, 0x1d, 0x2a, 0xf8, 0xc5, 0x4b, 0xbf,
-	0xce, 0x18, 0x34, 0x12, 0x5a, 0x82, 0x35, 0xc9, 0xf1, 0xfb, 0xe4, 0x89, 0x72, 0x1b, 0x8b, 0xdd,
-	0xeb, 0x0e, 0x47, 0xdd, 0x7e, 0x7b, 0xa4, 0xdc, 0x61, 0x00, 0xc5, 0xc7, 0xdd, 0xde, 0xa8, 0xa3,
-	0x29, 0x3b, 0x58, 0xde, 0xe7, 0x83, 0x6e, 0x5f, 0x79, 0x8d, 0xe2, 0xcc, 0x5b, 0x07, 0x87, 0xbd,
-	0x8e, 0xa2, 0x52, 0x2d, 0x03, 0x6d, 0xa4, 0xbc, 0xce, 0x2a, 0x50, 0x38, 0xea, 0x63, 0xdb, 0xde,
-	0xc0, 0x0a, 0xe9, 0x53, 0x6f, 0xf5, 0x7a, 0xca, 0x9b, 0x92, 0x87, 0xf8, 0x2d, 0xfc, 0x7e, 0xde,
-	0xed, 0xef, 0x0d, 0x9e, 0x2b, 0x6f, 0x23, 0xd9, 0xae, 0x36, 0x68, 0xed, 0xb5, 0x5b, 0xc3, 0x91,
-	0x72, 0x17, 0x0b, 0x18, 0x1e, 0xf6, 0xba, 0x23, 0xe5, 0x1d, 0xa4, 0x7a, 0xd2, 0x1a, 0xed, 0x77,
-	0x34, 0xe5, 0x1e, 0x7e, 0xb7, 0x86, 0xc3, 0x8e, 0x36, 0x52, 0x1e, 0xe2, 0x77, 0xb7, 0x4f, 0xdf,
-	0x8f, 0xf0, 0x7b, 0xaf, 0xd3, 0xeb, 0x8c, 0x3a, 0xca, 0x07, 0x38, 0x60, 0x5a, 0xe7, 0xb0, 0xd7,
-	0x6a, 0x77, 0x94, 0x0f, 0x31, 0xd1, 0x1b, 0xb4, 0x9f, 0xea, 0x83, 0x43, 0xe5, 0x23, 0xac, 0x83,
-	0xfc, 0xdb, 0x43, 0x1c, 0xcc, 0x8f, 0x71, 0x9c, 0xe2, 0x24, 0xb5, 0xee, 0x13, 0xac, 0xf6, 0xa0,
-	0xdb, 0x3f, 0x1a, 0x2a, 0x9f, 0x22, 0x31, 0x7d, 0x12, 0xe6, 0x33, 0xb6, 0x0d, 0xca, 0xa0, 0xaf,
-	0xef, 0x1d, 0x1d, 0xf6, 0xba, 0xed, 0xd6, 0xa8, 0xa3, 0x3f, 0xed, 0x7c, 0xa9, 0xfc, 0x16, 0x4e,
-	0xfb, 0xa1, 0xd6, 0xd1, 0x45, 0x3b, 0x7e, 0x14, 0xa5, 0x45, 0x5b, 0x7e, 0x8c, 0x55, 0x24, 0x78,
-	0xfd, 0xe8, 0xa9, 0xf2, 0xdb, 0x4b, 0xa0, 0xe1, 0x53, 0xe5, 0x27, 0x38, 0xe7, 0xa3, 0xee, 0x41,
-	0x47, 0x17, 0x83, 0xf1, 0x53, 0x1c, 0xc8, 0xc7, 0xdd, 0x5e, 0x4f, 0x69, 0x91, 0x33, 0xb3, 0xa5,
-	0x8d, 0xba, 0x34, 0xd1, 0xbb, 0x4c, 0x81, 0xda, 0xe3, 0xa3, 0x9f, 0xff, 0xfc, 0x4b, 0x5d, 0xcc,
-	0x44, 0x5b, 0x5d, 0x40, 0x39, 0xd2, 0xfb, 0xb1, 0xf5, 0xdd, 0x7e, 0xbf, 0xa3, 0x29, 0x1b, 0x58,
-	0x42, 0xaf, 0xf3, 0x78, 0xa4, 0x64, 0xc8, 0x61, 0xdb, 0x7d, 0xb2, 0x3f, 0x52, 0xb2, 0xf8, 0x39,
-	0x38, 0xc2, 0x6c, 0x39, 0x9a, 0xaa, 0xce, 0x41, 0x57, 0xc9, 0xe3, 0x57, 0xab, 0x3f, 0xea, 0x2a,
-	0x05, 0x9a, 0xca, 0x6e, 0xff, 0x49, 0xaf, 0xa3, 0x14, 0x11, 0x7a, 0xd0, 0xd2, 0x9e, 0x2a, 0x25,
-	0xcc, 0xd4, 0x3a, 0x3c, 0xec, 0x7d, 0xa9, 0x94, 0x79, 0xf9, 0x7b, 0x9d, 0x2f, 0x94, 0x8a, 0x7a,
-	0x17, 0x4a, 0xad, 0xe3, 0xe3, 0x03, 0x34, 0xa7, 0xb0, 0xb1, 0x47, 0xbd, 0x1e, 0xbf, 0x3a, 0xb3,
-	0x3b, 0x18, 0x8d, 0x06, 0x07, 0x4a, 0x06, 0x17, 0xd1, 0x68, 0x70, 0xa8, 0x64, 0xd5, 0x2e, 0x94,
-	0x23, 0x36, 0x27, 0x5d, 0x63, 0x28, 0x43, 0xfe, 0x50, 0xeb, 0x3c, 0xe3, 0xa7, 0x0b, 0xfd, 0xce,
-	0x17, 0xd8, 0x3c, 0xfc, 0xc2, 0x82, 0x72, 0x58, 0x11, 0xbf, 0x6f, 0x40, 0xf7, 0x18, 0x7a, 0xdd,
-	0x7e, 0xa7, 0xa5, 0x29, 0x05, 0xf5, 0x3f, 0x64, 0x00, 0x12, 0xb1, 0x81, 0x82, 0x29, 0x36, 0xe1,
-	0x0a, 0xc2, 0xa9, 0x2c, 0xc7, 0x83, 0x57, 0xf8, 0xb9, 0x0c, 0xbb, 0x06, 0xc5, 0xa9, 0xe7, 0xcf,
-	0x8c, 0x30, 0xba, 0xf1, 0xc1, 0x53, 0xa8, 0xa4, 0x71, 0x5f, 0x26, 0xca, 0x47, 0xd7, 0xe2, 0x61,
-	0x4d, 0x79, 0xad, 0x26, 0x80, 0x3d, 0x84, 0xa1, 0x06, 0x65, 0xb9, 0x13, 0xc7, 0x0b, 0x2c, 0x13,
-	0x2d, 0x84, 0x02, 0x09, 0x41, 0x88, 0x40, 0xbb, 0x74, 0xae, 0x15, 0x5a, 0xfe, 0xcc, 0x76, 0x8d,
-	0xd0, 0x32, 0x45, 0x6c, 0x85, 0x04, 0x61, 0xaf, 0x40, 0xe5, 0xab, 0xc0, 0x13, 0x46, 0x1b, 0x8f,
-	0x28, 0x29, 0x23, 0x80, 0x2e, 0x48, 0xfd, 0x51, 0x0e, 0x20, 0xd1, 0x2b, 0x52, 0x4e, 0xd2, 0x4c,
-	0xda, 0x49, 0xfa, 0x10, 0xae, 0x89, 0x70, 0x66, 0x11, 0x23, 0x7b, 0xae, 0xdb, 0xae, 0x3e, 0x36,
-	0x22, 0x7f, 0x34, 0x13, 0x58, 0x7e, 0xb4, 0xda, 0x75, 0x77, 0x8d, 0x90, 0x3d, 0x84, 0x4d, 0x39,
-	0x4f, 0x78, 0x31, 0x4f, 0xfb, 0xd3, 0xf9, 0x71, 0x7b, 0x92, 0x71, 0x74, 0x31, 0x67, 0xef, 0xc1,
-	0x55, 0xdf, 0x9a, 0xfa, 0x56, 0x70, 0xa2, 0x87, 0x81, 0x5c, 0x0d, 0x3f, 0xc1, 0xdd, 0x12, 0xc8,
-	0x51, 0x10, 0xd7, 0xf2, 0x1e, 0x5c, 0x15, 0xba, 0xc6, 0x52, 0xc3, 0xf8, 0x65, 0xab, 0x2d, 0x8e,
-	0x94, 0xdb, 0xf5, 0x2a, 0x80, 0x50, 0xb3, 0xa2, 0x2b, 0xb6, 0x65, 0xad, 0xc2, 0x55, 0x2a, 0xd4,
-	0x8b, 0xdf, 0x05, 0x66, 0x07, 0xfa, 0x92, 0x6b, 0x4d, 0xf8, 0x9b, 0x15, 0x3b, 0x38, 0x4c, 0xb9,
-	0xd5, 0x2e, 0xf3, 0xda, 0x95, 0x2f, 0xf3, 0xda, 0x6d, 0x43, 0x81, 0x34, 0x31, 0x72, 0x1e, 0x95,
-	0x35, 0x9e, 0x60, 0x2a, 0xe4, 0x71, 0x31, 0x93, 0xb7, 0xa8, 0xf1, 0xb0, 0x71, 0x9f, 0x2e, 0x28,
-	0xe3, 0xcc, 0x20, 0x54, 0x23, 0x9c, 0xfa, 0x77, 0x33, 0xd0, 0x48, 0x6b, 0x0f, 0x3c, 0x4a, 0x29,
-	0x09, 0xbf, 0x2a, 0x24, 0x21, 0x57, 0xaf, 0x40, 0x65, 0x7e, 0x2a, 0x62, 0xad, 0xc4, 0x14, 0x95,
-	0xe7, 0xa7, 0x3c, 0xc6, 0x0a, 0xcd, 0xf2, 0xf9, 0x29, 0x5f, 0x11, 0xab, 0x13, 0x52, 0x9c, 0x9f,
-	0x46, 0xb6, 0xfb, 0x42, 0x10, 0xe5, 0x57, 0x89, 0x16, 0x44, 0xa4, 0xee, 0x40, 0x4d, 0xd6, 0xd1,
-	0x99, 0x02, 0x39, 0x94, 0xec, 0xbc, 0x31, 0xf8, 0xa9, 0xfe, 0xc3, 0x0c, 0x91, 0x7c, 0x27, 0x5f,
-	0x77, 0xca, 0x3e, 0xcd, 0xbe, 0xc4, 0x3e, 0xdd, 0xa1, 0x83, 0x5b, 0x9d, 0x22, 0x30, 0x26, 0x5e,
-	0x74, 0xe9, 0x12, 0x4e, 0x8c, 0xa0, 0xb5, 0x08, 0xbd, 0xb6, 0xe7, 0x88, 0x73, 0x03, 0x11, 0xd2,
-	0x9a, 0x8f, 0xfc, 0x4b, 0x22, 0x66, 0xf5, 0xf7, 0x33, 0xb0, 0xb5, 0xa2, 0x8c, 0x62, 0x3f, 0x92,
-	0x9b, 0xd3, 0xf8, 0x89, 0xd6, 0xe1, 0xcc, 0x08, 0x27, 0x27, 0xfa, 0xdc, 0xb7, 0xa6, 0xf6, 0x79,
-	0x74, 0xfd, 0x9b, 0x60, 0x87, 0x04, 0xa2, 0x43, 0x94, 0xf9, 0x9c, 0x54, 0x70, 0x34, 0xd1, 0xf9,
-	0x35, 0x47, 0x20, 0x50, 0x8f, 0x6c, 0xf3, 0xe8, 0x80, 0x35, 0x7f, 0xc9, 0x91, 0xef, 0x2d, 0x28,
-	0x76, 0x63, 0xa5, 0x37, 0xbe, 0x09, 0x99, 0x13, 0xb7, 0x1f, 0x3d, 0xa8, 0xb4, 0xe9, 0x26, 0xe5,
-	0x81, 0x31, 0x67, 0xf7, 0x20, 0x37, 0x33, 0xe6, 0xe2, 0x74, 0xb7, 0x19, 0xbb, 0x9e, 0x38, 0xf6,
-	0xfe, 0x81, 0x31, 0xe7, 0x67, 0x28, 0x48, 0x74, 0xf3, 0x23, 0x28, 0x47, 0x80, 0xef, 0x14, 0xea,
-	0xf1, 0xdf, 0x73, 0x50, 0xd9, 0x93, 0xcd, 0xe3, 0x89, 0xe1, 0xea, 0xa1, 0xbf, 0x70, 0xd1, 0x8a,
-	0x11, 0x8e, 0xba, 0x2a, 0x6a, 0x2a, 0x02, 0x14, 0x4d, 0x6d, 0xf6, 0xd7, 0x4c, 0xed, 0x2d, 0x40,
-	0x3b, 0x5e, 0xb7, 0x4d, 0xd2, 0x00, 0xf9, 0x10, 0x95, 0x7d, 0x54, 0xff, 0x50, 0x01, 0x5c, 0xeb,
-	0xa6, 0xcf, 0x7f, 0x7b, 0x37, 0x7d, 0x61, 0xad, 0x9b, 0xfe, 0xff, 0x15, 0xc7, 0x3a, 0x7b, 0x2b,
-	0x61, 0x88, 0xa7, 0xd6, 0x05, 0x91, 0x55, 0x78, 0x60, 0xca, 0x3c, 0x8e, 0x51, 0x43, 0xba, 0xcf,
-	0xa0, 0x11, 0x0d, 0xb3, 0xe8, 0x18, 0xa4, 0xc2, 0xe2, 0x04, 0x8e, 0x5b, 0xfe, 0xf5, 0x50, 0x4e,
-	0xa6, 0xf7, 0x4e, 0xf5, 0xd7, 0xef, 0x1d, 0xf5, 0x4f, 0xb3, 0x50, 0xf8, 0xd9, 0xc2, 0xf2, 0x2f,
-	0xd8, 0x47, 0x50, 0x09, 0xc2, 0x59, 0x28, 0x3b, 0x25, 0x6f, 0xf0, 0x6c, 0x84, 0x27, 0x9f, 0xa2,
-	0x35, 0xb3, 0xdc, 0x90, 0xdb, 0x0b, 0x48, 0x4b, 0x5c, 0x62, 0x1b, 0x0a, 0x68, 0xda, 0x73, 0x27,
-	0x68, 0x41, 0xe3, 0x09, 0xb6, 0x03, 0x05, 0xd7, 0x33, 0xad, 0x20, 0x7d, 0xfa, 0x88, 0xaa, 0xac,
-	0xc6, 0x11, 0x4c, 0x85, 0xa2, 0x88, 0xa0, 0xcf, 0xaf, 0x3a, 0x06, 0x39, 0x86, 0x62, 0x7f, 0x2c,
-	0x03, 0x0d, 0x99, 0xe8, 0xa2, 0x44, 0x9c, 0x46, 0xce, 0xe7, 0x78, 0x86, 0x39, 0x32, 0x8e, 0xa3,
-	0x8b, 0x44, 0x22, 0x89, 0x02, 0xd1, 0xb4, 0x42, 0x6b, 0x12, 0x0e, 0xbf, 0x76, 0xa2, 0x29, 0x93,
-	0x20, 0xaa, 0x09, 0xf5, 0x54, 0x67, 0xd2, 0x8a, 0x35, 0x2a, 0x21, 0x9d, 0x1e, 0x2a, 0x68, 0x19,
-	0x49, 0xc3, 0xcb, 0xca, 0x5a, 0x5d, 0x4e, 0x52, 0xf7, 0x48, 0x41, 0x38, 0x3a, 0xdc, 0x6b, 0x8d,
-	0x3a, 0x4a, 0x81, 0xd4, 0xb7, 0x8e, 0xf6, 0xa4, 0xa3, 0x14, 0xd5, 0x3f, 0xcc, 0xc2, 0xd6, 0xc8,
-	0x37, 0xdc, 0xc0, 0xe0, 0xb1, 0xad, 0x6e, 0xe8, 0x7b, 0x0e, 0xfb, 0x0c, 0xca, 0xe1, 0xc4, 0x91,
-	0x07, 0xf9, 0x4e, 0x34, 0xa5, 0x4b, 0xa4, 0xf7, 0x47, 0x13, 0x6e, 0x9a, 0x95, 0x42, 0xfe, 0xc1,
-	0x7e, 0x08, 0x85, 0xb1, 0x75, 0x6c, 0xbb, 0x62, 0x7b, 0x5d, 0x5d, 0xce, 0xb8, 0x8b, 0xc8, 0xfd,
-	0x0d, 0x8d, 0x53, 0xb1, 0xf7, 0xa0, 0x38, 0xf1, 0x66, 0x11, 0x1f, 0x4a, 0xc2, 0xf0, 0xa4, 0x8a,
-	0x10, 0xbb, 0xbf, 0xa1, 0x09, 0x3a, 0xf6, 0x11, 0x94, 0x7d, 0xcf, 0x71, 0xc6, 0xc6, 0xe4, 0x54,
-	0x70, 0xa8, 0xe6, 0x72, 0x1e, 0x4d, 0xe0, 0xf7, 0x37, 0xb4, 0x98, 0x56, 0xbd, 0x0f, 0x25, 0xd1,
-	0x58, 0x1c, 0x80, 0xdd, 0xce, 0x93, 0xae, 0x18, 0xc8, 0xf6, 0xe0, 0xe0, 0xa0, 0x3b, 0xe2, 0xf1,
-	0xfe, 0xda, 0xa0, 0xd7, 0xdb, 0x6d, 0xb5, 0x9f, 0x2a, 0xd9, 0xdd, 0x32, 0x14, 0x0d, 0x0a, 0x1d,
-	0x53, 0xff, 0x56, 0x06, 0x36, 0x97, 0x3a, 0xc0, 0x3e, 0x81, 0xfc, 0x0c, 0x25, 0x21, 0x1f, 0x9e,
-	0x37, 0xd6, 0xf6, 0x52, 0x4a, 0x73, 0xf9, 0x88, 0x39, 0xd4, 0x4f, 0xa1, 0x91, 0x86, 0x4b, 0xfa,
-	0x5e, 0x1d, 0x2a, 0x5a, 0xa7, 0xb5, 0xa7, 0x0f, 0xfa, 0xbd, 0x2f, 0xb9, 0xb9, 0x44, 0xc9, 0xe7,
-	0x5a, 0x77, 0xd4, 0x51, 0xb2, 0xea, 0xef, 0x80, 0xb2, 0x3c, 0x30, 0xec, 0x09, 0x6c, 0x4e, 0x3c,
-	0xb4, 0x5c, 0x39, 0x1b, 0x48, 0xa6, 0xec, 0xf6, 0x9a, 0x91, 0x14, 0x64, 0x34, 0x63, 0x8d, 0x49,
-	0x2a, 0xad, 0xfe, 0x35, 0x60, 0xab, 0x23, 0xf8, 0x9b, 0x2b, 0xfe, 0x7f, 0x67, 0x20, 0x7f, 0xe8,
-	0x18, 0x28, 0xd5, 0x0b, 0x74, 0x97, 0x53, 0x88, 0xd6, 0xaa, 0xb4, 0x7d, 0x71, 0x59, 0x10, 0x8e,
-	0xfd, 0x00, 0x72, 0xe1, 0x24, 0xba, 0xdb, 0x70, 0xfd, 0x92, 0xc5, 0xb7, 0xbf, 0xa1, 0x21, 0x15,
-	0xbb, 0x0b, 0x39, 0xd3, 0x8c, 0x82, 0x20, 0x84, 0x75, 0xbf, 0x67, 0x84, 0xc6, 0x9e, 0x35, 0xb5,
-	0x5d, 0x5b, 0xdc, 0x3d, 0x45, 0x12, 0xf6, 0x26, 0xe4, 0xcc, 0x89, 0x93, 0x8e, 0x68, 0x41, 0x4a,
-	0xa9, 0x40, 0x73, 0xe2, 0x30, 0x15, 0xea, 0xa1, 0x7f, 0xa1, 0xfb, 0x0b, 0x97, 0x8e, 0xe1, 0x02,
-	0xa1, 0xa3, 0x55, 0x51, 0x54, 0x2d, 0xe8, 0xcc, 0x2a, 0x10, 0x31, 0x92, 0x73, 0xdf, 0x9a, 0x1b,
-	0x7e, 0xac, 0x9d, 0xd9, 0xc1, 0x21, 0x07, 0xec, 0x16, 0x81, 0x9e, 0x7c, 0x51, 0xdf, 0xa5, 0x7b,
-	0x8d, 0xa8, 0xcd, 0xa8, 0xd1, 0xd7, 0x9a, 0x10, 0x74, 0x81, 0x51, 0xff, 0x2c, 0x07, 0x55, 0xa9,
-	0x3d, 0xec, 0x03, 0x28, 0x9b, 0xe9, 0x8d, 0x78, 0x63, 0xa5, 0xd1, 0xf7, 0xf7, 0xa2, 0x2d, 0x68,
-	0x8a, 0xe5, 0xfd, 0x29, 0xd4, 0x03, 0x2b, 0xd4, 0x5f, 0x18, 0xbe, 0x8d, 0x1c, 0x34, 0x10, 0xc3,
-	0x28, 0x5c, 0x8e, 0x43, 0x2b, 0x7c, 0x16, 0x61, 0xf6, 0x37, 0xb4, 0x5a, 0x20, 0xa5, 0xd9, 0x3b,
-	0x50, 0x8a, 0xba, 0x94, 0x4b, 0x5d, 0x48, 0xe7, 0xc0, 0xfd, 0x0d, 0x2d, 0xc2, 0x23, 0xa9, 0x75,
-	0x6e, 0x4d, 0x16, 0x61, 0xa4, 0x78, 0xd5, 0xa3, 0x0e, 0x11, 0x90, 0xde, 0xbe, 0xe0, 0x9f, 0xec,
-	0x21, 0xf2, 0x3a, 0xc3, 0x71, 0x3c, 0x92, 0xc8, 0x05, 0xd9, 0xbf, 0xb5, 0x17, 0xc3, 0xf9, 0x5b,
-	0x1b, 0x51, 0x8a, 0xbd, 0x05, 0x05, 0x2f, 0x3c, 0xb1, 0x7c, 0x71, 0xbb, 0x2c, 0xba, 0x21, 0x89,
-	0xa0, 0xbd, 0x76, 0x0f, 0x57, 0x0a, 0xa1, 0xd5, 0x5f, 0x66, 0xa0, 0x24, 0x46, 0x00, 0x8d, 0xc6,
-	0x61, 0x67, 0xa4, 0x3f, 0x6b, 0x69, 0xdd, 0xd6, 0x6e, 0xaf, 0x23, 0x02, 0x71, 0x9e, 0x68, 0xad,
-	0xbe, 0xe0, 0x93, 0x5a, 0xe7, 0xd9, 0xe0, 0x69, 0x87, 0x1b, 0x51, 0x7b, 0x9d, 0xfe, 0x97, 0x4a,
-	0x8e, 0x3b, 0x0e, 0x3a, 0x87, 0x2d, 0x0d, 0xb9, 0x64, 0x15, 0x4a, 0x9d, 0x2f, 0x3a, 0xed, 0x23,
-	0x62, 0x93, 0x0d, 0x80, 0xbd, 0x4e, 0xab, 0xd7, 0x1b, 0xa0, 0x25, 0xab, 0x14, 0x19, 0x83, 0x46,
-	0x5b, 0xeb, 0xa0, 0x55, 0xdb, 0x6a, 0xb7, 0x07, 0x47, 0xfd, 0x91, 0x52, 0xc2, 0x1a, 0x5b, 0x68,
-	0x62, 0xc6, 0x20, 0xba, 0x46, 0xbe, 0xa7, 0x0d, 0x0e, 0x63, 0x48, 0x65, 0xb7, 0x82, 0xea, 0x2f,
-	0xcd, 0x95, 0xfa, 0xbf, 0xea, 0xd0, 0x48, 0x2f, 0x4d, 0xf6, 0x31, 0x94, 0x4d, 0x33, 0x35, 0xc7,
-	0xb7, 0xd6, 0x2d, 0xe1, 0xfb, 0x7b, 0x66, 0x34, 0xcd, 0xfc, 0x83, 0xbd, 0x16, 0x6d, 0xa4, 0xec,
-	0xca, 0x46, 0x8a, 0xb6, 0xd1, 0x4f, 0x60, 0x53, 0xdc, 0x41, 0x44, 0x13, 0x6f, 0x6c, 0x04, 0x56,
-	0x7a, 0x97, 0xb4, 0x09, 0xb9, 0x27, 0x70, 0xfb, 0x1b, 0x5a, 0x63, 0x92, 0x82, 0xb0, 0x1f, 0x41,
-	0xc3, 0x20, 0xa3, 0x25, 0xce, 0x9f, 0x97, 0x45, 0x7c, 0x0b, 0x71, 0x52, 0xf6, 0xba, 0x21, 0x03,
-	0x70, 0x21, 0x9a, 0xbe, 0x37, 0x4f, 0x32, 0x17, 0xe4, 0x85, 0xb8, 0xe7, 0x7b, 0x73, 0x29, 0x6f,
-	0xcd, 0x94, 0xd2, 0xec, 0x23, 0xa8, 0x89, 0x96, 0x27, 0xe6, 0x4f, 0xbc, 0x65, 0x79, 0xb3, 0x49,
-	0x51, 0xd8, 0xdf, 0xd0, 0xaa, 0x93, 0x24, 0xc9, 0x1e, 0x41, 0x95, 0x37, 0x38, 0x31, 0x87, 0xe2,
-	0xb5, 0x46, 0xad, 0x8d, 0x72, 0x81, 0x11, 0xa7, 0xd8, 0x7b, 0x00, 0xd4, 0x4e, 0x9e, 0xa7, 0x9c,
-	0x3a, 0x82, 0xf1, 0xbd, 0x79, 0x94, 0xa5, 0x62, 0x46, 0x09, 0xa9, 0x79, 0x3c, 0x1a, 0xbc, 0xb2,
-	0xda, 0x3c, 0x0a, 0x5c, 0x4e, 0x9a, 0xc7, 0xa3, 0xbf, 0xe3, 0xe6, 0xf1, 0x6c, 0xb0, 0xd2, 0xbc,
-	0x28, 0x17, 0x6f, 0x1e, 0xcf, 0x14, 0x35, 0x8f, 0xe7, 0xa9, 0x2e, 0x37, 0x2f, 0xca, 0x42, 0xcd,
-	0xe3, 0x39, 0x7e, 0xb4, 0xa2, 0x99, 0xd5, 0x2e, 0xd5, 0xcc, 0x70, 0xda, 0xd2, 0xba, 0xd9, 0x8f,
-	0xa0, 0x11, 0x9c, 0x78, 0x67, 0x12, 0x03, 0xa9, 0xcb, 0xb9, 0x87, 0x27, 0xde, 0x99, 0xcc, 0x41,
-	0xea, 0x81, 0x0c, 0xc0, 0xd6, 0xf2, 0x2e, 0xd2, 0x7d, 0x8f, 0x86, 0xdc, 0x5a, 0xea, 0xe1, 0x33,
-	0xdb, 0x3a, 0xc3, 0xd6, 0x1a, 0x51, 0x02, 0x07, 0x25, 0x31, 0x74, 0x03, 0x71, 0x66, 0x98, 0x3a,
-	0x4e, 0x10, 0x35, 0x41, 0x6c, 0xfd, 0x06, 0xb8, 0xb6, 0x16, 0xae, 0x9c, 0x4d, 0x91, 0xd7, 0xd6,
-	0x91, 0x9b, 0xca, 0x58, 0xe3, 0xa4, 0x22, 0x6b, 0xb2, 0x2b, 0x02, 0xeb, 0xeb, 0x85, 0xe5, 0x4e,
-	0x2c, 0x71, 0xba, 0x98, 0xda, 0x15, 0x43, 0x81, 0x4b, 0x76, 0x45, 0x04, 0x89, 0xd7, 0x75, 0x9c,
-	0x9d, 0x2d, 0xaf, 0x6b, 0x29, 0x33, 0xad, 0xeb, 0x38, 0x6b, 0xbc, 0xa1, 0xe2, 0xbc, 0x57, 0x56,
-	0x36, 0x94, 0x94, 0x99, 0x6f, 0xa8, 0x08, 0xa0, 0xfe, 0xfd, 0x02, 0x94, 0x04, 0x1f, 0x60, 0x57,
-	0x60, 0x53, 0xb0, 0xa3, 0xbd, 0xd6, 0xa8, 0xb5, 0xdb, 0x1a, 0xa2, 0x02, 0xc1, 0xa0, 0xc1, 0xf9,
-	0x51, 0x0c, 0xcb, 0x20, 0x8f, 0x22, 0x86, 0x14, 0x83, 0xb2, 0xc8, 0xa3, 0x44, 0x5e, 0xfe, 0x2c,
-	0x46, 0x8e, 0x6d, 0x42, 0x95, 0x67, 0xe4, 0x00, 0x8a, 0x59, 0xa5, 0x5c, 0x3c, 0x5d, 0x90, 0xb2,
-	0x70, 0xe7, 0x56, 0x31, 0xc9, 0xc2, 0x01, 0xa5, 0x38, 0x0b, 0x4f, 0x97, 0xb1, 0x31, 0x23, 0xed,
-	0xa8, 0xdf, 0x4e, 0xea, 0xa9, 0x50, 0x9c, 0x21, 0x2f, 0xe6, 0x59, 0xb7, 0xf3, 0x5c, 0x01, 0xcc,
-	0xc4, 0x4b, 0xa1, 0x74, 0x15, 0x55, 0x20, 0x2a, 0x84, 0x92, 0x35, 0x76, 0x1d, 0xae, 0x0c, 0xf7,
-	0x07, 0xcf, 0x75, 0x9e, 0x29, 0xee, 0x42, 0x9d, 0x6d, 0x83, 0x22, 0x21, 0x78, 0xf1, 0x0d, 0xac,
-	0x92, 0xa0, 0x11, 0xe1, 0x50, 0xd9, 0x24, 0x5f, 0x31, 0xc2, 0x46, 0x5c, 0x26, 0x28, 0xd8, 0x15,
-	0x9e, 0x75, 0xd0, 0x3b, 0x3a, 0xe8, 0x0f, 0x95, 0x2d, 0x6c, 0x04, 0x41, 0x78, 0xcb, 0x59, 0x5c,
-	0x4c, 0x22, 0x49, 0xae, 0x90, 0x70, 0x41, 0xd8, 0xf3, 0x96, 0xd6, 0xef, 0xf6, 0x9f, 0x0c, 0x95,
-	0xed, 0xb8, 0xe4, 0x8e, 0xa6, 0x0d, 0xb4, 0xa1, 0x72, 0x35, 0x06, 0x0c, 0x47, 0xad, 0xd1, 0xd1,
-	0x50, 0xb9, 0x16, 0xb7, 0xf2, 0x50, 0x1b, 0xb4, 0x3b, 0xc3, 0x61, 0xaf, 0x3b, 0x1c, 0x29, 0xd7,
-	0xd9, 0x55, 0xd8, 0x4a, 0x5a, 0x14, 0x11, 0x37, 0xa5, 0x86, 0x6a, 0x4f, 0x3a, 0x23, 0xe5, 0x46,
-	0xdc, 0x8c, 0xf6, 0xa0, 0xd7, 0x6b, 0x91, 0x6f, 0xf3, 0x26, 0x12, 0x91, 0xab, 0x56, 0xf4, 0xe6,
-	0x15, 0x6c, 0xd7, 0x51, 0x5f, 0x06, 0xdd, 0x92, 0x96, 0xc6, 0xb0, 0xf3, 0xb3, 0xa3, 0x4e, 0xbf,
-	0xdd, 0x51, 0x5e, 0x4d, 0x96, 0x46, 0x0c, 0xbb, 0x1d, 0x2f, 0x8d, 0x18, 0x74, 0x27, 0xae, 0x33,
-	0x02, 0x0d, 0x95, 0x1d, 0x2c, 0x4f, 0xb4, 0xa3, 0xdf, 0xef, 0xb4, 0x47, 0xd8, 0xd7, 0xd7, 0xe2,
-	0x51, 0x3c, 0x3a, 0x7c, 0xa2, 0xb5, 0xf6, 0x3a, 0x8a, 0xba, 0x5b, 0xa3, 0x07, 0xb4, 0x84, 0xbc,
-	0x52, 0x3f, 0x07, 0x26, 0xbf, 0x44, 0x23, 0x6e, 0xc5, 0x33, 0xc8, 0x4f, 0x7d, 0x6f, 0x16, 0x5d,
-	0xc3, 0xc0, 0x6f, 0xb6, 0x03, 0xd5, 0xf9, 0x62, 0x4c, 0xa7, 0x8e, 0x49, 0xc8, 0xb6, 0x0c, 0x52,
-	0xff, 0x49, 0x06, 0x1a, 0x69, 0x59, 0x85, 0x3a, 0x9a, 0x3d, 0xd5, 0x5d, 0x2f, 0xe4, 0x37, 0xb7,
-	0x83, 0xc8, 0xd0, 0xb7, 0xa7, 0x7d, 0x2f, 0xa4, 0xab, 0xdb, 0x64, 0x99, 0xc5, 0xa2, 0x87, 0x97,
-	0x1a, 0xa7, 0x59, 0x17, 0xae, 0xa4, 0x1e, 0xea, 0x49, 0xdd, 0x9b, 0x6f, 0xc6, 0xcf, 0x8e, 0x2c,
-	0xb5, 0x5f, 0x63, 0xc1, 0x6a, 0x9f, 0x14, 0xc8, 0x05, 0x5f, 0x3b, 0xe2, 0x02, 0x1e, 0x7e, 0xaa,
-	0xfb, 0x50, 0x4f, 0x89, 0x46, 0xf2, 0xed, 0x4c, 0xd3, 0x2d, 0x2d, 0xdb, 0xd3, 0x97, 0x37, 0x53,
-	0xfd, 0xa3, 0x0c, 0xd4, 0x64, 0x41, 0xf9, 0xbd, 0x4b, 0xa2, 0xc0, 0x3e, 0xf1, 0xad, 0xdb, 0x66,
-	0x74, 0x63, 0x3b, 0x02, 0x75, 0xe9, 0xe1, 0x40, 0xee, 0x7c, 0x7a, 0x7c, 0x3a, 0x8c, 0xbb, 0x23,
-	0x83, 0xd0, 0x66, 0xa5, 0x90, 0xdd, 0xc7, 0x4f, 0x91, 0x40, 0x84, 0x06, 0x26, 0x10, 0xf5, 0x0e,
-	0x54, 0x1e, 0x9f, 0x46, 0x8f, 0x07, 0xc8, 0xef, 0x17, 0x54, 0x44, 0x28, 0xff, 0x1f, 0x67, 0xa0,
-	0x91, 0xdc, 0x49, 0xa3, 0xa8, 0x03, 0xfe, 0xc0, 0x13, 0x5f, 0x0e, 0x59, 0x73, 0x9c, 0xbc, 0x29,
-	0x98, 0x95, 0xdf, 0x14, 0x7c, 0x5d, 0x14, 0x96, 0x93, 0xc5, 0x49, 0x5c, 0x97, 0xb8, 0xdd, 0xf7,
-	0x08, 0x6a, 0xf8, 0x5f, 0xb3, 0xa6, 0x96, 0xef, 0x5b, 0xd1, 0x5b, 0x57, 0x2b, 0xc4, 0x29, 0x22,
-	0x32, 0x09, 0xac, 0xa9, 0xd0, 0x4c, 0xd6, 0x5e, 0x9b, 0x43, 0xbc, 0xfa, 0x77, 0xf2, 0x50, 0x95,
-	0xd4, 0x8e, 0x6f, 0xb5, 0xfc, 0x6e, 0x41, 0x25, 0xb9, 0x90, 0x25, 0x42, 0xb7, 0x63, 0x40, 0x6a,
-	0xae, 0x72, 0x4b, 0x73, 0xd5, 0x84, 0x92, 0x08, 0x4f, 0x10, 0x6e, 0xa5, 0x28, 0x99, 0xf6, 0x9b,
-	0x14, 0x5e, 0xe2, 0x73, 0x7c, 0x1f, 0x6a, 0xd2, 0x33, 0x08, 0xd1, 0xed, 0xce, 0x65, 0xfa, 0x6a,
-	0xf2, 0x24, 0x42, 0xc0, 0xae, 0x42, 0x71, 0x7a, 0xaa, 0x9b, 0xe3, 0xc8, 0x25, 0x51, 0x98, 0x9e,
-	0xee, 0x8d, 0xc9, 0x4f, 0x3b, 0x8d, 0x25, 0x6d, 0x99, 0x3b, 0x39, 0xa6, 0x91, 0x3c, 0xbd, 0x0b,
-	0xa5, 0xe9, 0x29, 0x8f, 0xc8, 0xae, 0xc8, 0x87, 0x8b, 0xc9, 0x90, 0x17, 0xa7, 0xa7, 0x14, 0x9e,
-	0xfd, 0x29, 0x28, 0x4b, 0x2e, 0xab, 0x40, 0x84, 0x3e, 0x2d, 0x37, 0x6a, 0x33, 0xed, 0xbd, 0x0a,
-	0xd8, 0x03, 0xd8, 0x16, 0x42, 0xdb, 0x08, 0x74, 0x1e, 0x3a, 0x47, 0x77, 0xfc, 0xf8, 0x43, 0x08,
-	0x5b, 0x1c, 0xd7, 0x0a, 0x86, 0x84, 0xc1, 0xc5, 0xaa, 0x42, 0x4d, 0x5a, 0xbb, 0xfc, 0x02, 0x65,
-	0x45, 0x4b, 0xc1, 0xd8, 0x27, 0x50, 0x9b, 0x9e, 0xf2, 0xb5, 0x30, 0xf2, 0x0e, 0x2c, 0x11, 0x04,
-	0xb5, 0xbd, 0xbc, 0x0a, 0x28, 0x56, 0x26, 0x45, 0xa9, 0xfe, 0xab, 0x0c, 0x34, 0x12, 0x7d, 0x12,
-	0x77, 0x28, 0xbb, 0x27, 0x3f, 0xdb, 0xd6, 0x5c, 0x56, 0x39, 0x91, 0xe4, 0xfe, 0xe8, 0x62, 0xce,
-	0x5f, 0x98, 0x59, 0x77, 0xad, 0x75, 0xdd, 0xa3, 0x15, 0xb9, 0x75, 0x8f, 0x56, 0xa8, 0x4f, 0x20,
-	0x37, 0xba, 0x98, 0x73, 0xdf, 0x05, 0x8a, 0x30, 0x6e, 0xe7, 0x70, 0xe1, 0x45, 0x67, 0x4a, 0x4f,
-	0x3b, 0x5f, 0xf2, 0x7b, 0x28, 0x87, 0x5a, 0xf7, 0xa0, 0xa5, 0x7d, 0x49, 0xe7, 0x70, 0x24, 0xe4,
-	0x1f, 0x0f, 0xb4, 0x4e, 0xf7, 0x49, 0x9f, 0x00, 0x79, 0xf2, 0x6c, 0x24, 0x4d, 0x6c, 0x99, 0xe6,
-	0xe3, 0x53, 0xf9, 0x76, 0x5f, 0x26, 0xf5, 0xf4, 0x57, 0x3a, 0x74, 0x3d, 0xbb, 0x1c, 0xba, 0xce,
-	0xe2, 0x2d, 0x1a, 0xef, 0x77, 0xf6, 0x36, 0xe4, 0xa7, 0xa7, 0xd6, 0x45, 0xda, 0x68, 0x48, 0xef,
-	0x2e, 0x22, 0x50, 0x7f, 0x95, 0x01, 0x96, 0x6a, 0x08, 0xd7, 0x63, 0xbf, 0x6f, 0x5b, 0x3e, 0x86,
-	0xa6, 0x78, 0xaf, 0x85, 0x53, 0x49, 0xee, 0x4c, 0x31, 0xa4, 0x57, 0xbd, 0xe4, 0xd4, 0x3d, 0xb9,
-	0x79, 0xcb, 0x1e, 0x00, 0x7f, 0x7c, 0x03, 0x67, 0x3c, 0xed, 0x26, 0x90, 0x36, 0xbf, 0x96, 0xd0,
-	0x24, 0xaf, 0x6d, 0xc8, 0xaf, 0x88, 0x70, 0xff, 0xee, 0x66, 0x32, 0x6b, 0xc4, 0x10, 0xd4, 0x3f,
-	0xc8, 0xc0, 0x95, 0xf4, 0x82, 0xf8, 0xcb, 0xf5, 0x32, 0xfd, 0x64, 0x4a, 0x6e, 0xf9, 0xc9, 0x94,
-	0x75, 0xeb, 0x29, 0xbf, 0x76, 0x3d, 0xfd, 0x5e, 0x06, 0xb6, 0xa5, 0xd1, 0x4f, 0x2c, 0x8f, 0xbf,
-	0xa2, 0x96, 0x49, 0x2f, 0xa7, 0xe4, 0x53, 0x2f, 0xa7, 0xa8, 0x7f, 0x98, 0x81, 0x6b, 0x4b, 0x2d,
-	0xd1, 0xac, 0xbf, 0xd2, 0xb6, 0xa4, 0x5f, 0x58, 0x21, 0x97, 0x2e, 0x8f, 0x93, 0xe0, 0x01, 0xde,
-	0x2c, 0xfd, 0x64, 0x4a, 0x0f, 0xe7, 0xf1, 0x5f, 0xa7, 0x1b, 0x69, 0x26, 0x11, 0xbc, 0xec, 0x43,
-	0xa8, 0x26, 0x2a, 0x50, 0x74, 0xab, 0x6d, 0x6d, 0xf8, 0xaf, 0x4c, 0xb7, 0x96, 0x2f, 0x66, 0xbf,
-	0x1d, 0x5f, 0xfc, 0x04, 0x6a, 0x71, 0xc1, 0x7b, 0xd6, 0x34, 0x6d, 0xdf, 0x2f, 0x5d, 0xc1, 0x4e,
-	0x51, 0xaa, 0x1f, 0xc0, 0x56, 0xd2, 0x8b, 0xb6, 0x78, 0x36, 0xe0, 0x0e, 0x54, 0x5d, 0xeb, 0x4c,
-	0x8f, 0x1e, 0x15, 0xe0, 0x23, 0x0d, 0xae, 0x75, 0x26, 0x08, 0xd4, 0xc7, 0x32, 0xdf, 0x8b, 0x5f,
-	0x43, 0x74, 0x4c, 0x79, 0x66, 0x4a, 0x9e, 0x63, 0x46, 0x28, 0x2c, 0x4d, 0x9a, 0x98, 0x92, 0x6b,
-	0x9d, 0x89, 0x17, 0x9f, 0x78, 0x39, 0x2d, 0xd3, 0x14, 0xc7, 0x7d, 0xeb, 0x6e, 0xe8, 0xde, 0x80,
-	0xf2, 0xdc, 0x4f, 0xcd, 0x6c, 0x69, 0xee, 0xf3, 0x6a, 0x6f, 0x8b, 0x73, 0xed, 0xd5, 0xa3, 0x41,
-	0x7e, 0xc6, 0x2d, 0xae, 0x32, 0xe6, 0x93, 0x77, 0x52, 0x3f, 0x14, 0xcc, 0x0e, 0x77, 0x9e, 0xa8,
-	0x33, 0x3e, 0x08, 0xcc, 0xdc, 0xad, 0xd3, 0x41, 0x20, 0x29, 0x73, 0xd6, 0xd7, 0xe2, 0x45, 0x06,
-	0xfc, 0x54, 0xff, 0x1c, 0x00, 0x92, 0x2e, 0xa7, 0xe4, 0x76, 0x66, 0x49, 0x6e, 0x7f, 0xa7, 0x13,
-	0xc1, 0x0f, 0xa0, 0x31, 0xf1, 0xe6, 0x17, 0x7a, 0x92, 0x23, 0xb7, 0x36, 0x47, 0x0d, 0xa9, 0x46,
-	0x49, 0x9c, 0xeb, 0xea, 0x79, 0x52, 0x7e, 0xed, 0x79, 0xd2, 0xfb, 0x50, 0xe2, 0x0e, 0xec, 0x40,
-	0x44, 0x4c, 0x5f, 0x5f, 0x96, 0x49, 0xf7, 0xc5, 0xd3, 0x38, 0x11, 0x1d, 0xeb, 0xa0, 0x75, 0x2a,
-	0xde, 0x05, 0x91, 0xe3, 0xa7, 0x6f, 0xaf, 0xe6, 0x8c, 0xc8, 0xf8, 0xe9, 0xb8, 0x21, 0x27, 0x25,
-	0x59, 0x1d, 0xce, 0x84, 0x57, 0x85, 0x64, 0x75, 0x49, 0x96, 0xd5, 0xa3, 0x19, 0xf7, 0xa5, 0xa0,
-	0xac, 0xfe, 0x21, 0x5c, 0x11, 0xb1, 0x68, 0x98, 0x01, 0x87, 0x93, 0xe8, 0xf9, 0xf5, 0x28, 0x71,
-	0xb7, 0x6c, 0x34, 0x23, 0x25, 0x18, 0xc9, 0xef, 0x82, 0x22, 0x3b, 0x87, 0x88, 0x96, 0x3f, 0x45,
-	0xd2, 0x90, 0x7c, 0x41, 0x48, 0xf9, 0x16, 0x6c, 0x8a, 0x82, 0xe3, 0x42, 0xf9, 0x1b, 0x4b, 0x75,
-	0x0e, 0x8e, 0x4a, 0xfc, 0x02, 0xb6, 0x27, 0x27, 0x86, 0x7b, 0x6c, 0xe9, 0xe1, 0xd8, 0xd1, 0xe9,
-	0xed, 0x39, 0x7d, 0x66, 0xcc, 0x45, 0xb0, 0xf5, 0xdb, 0x2b, 0xdd, 0x6f, 0x13, 0xf1, 0x68, 0xec,
-	0xd0, 0x81, 0x7d, 0x7c, 0x8e, 0xb9, 0x35, 0x59, 0x86, 0x2f, 0x9d, 0xf3, 0xd4, 0x96, 0xcf, 0x79,
-	0x56, 0xd4, 0x94, 0xfa, 0xaa, 0x9a, 0x72, 0xf3, 0x4f, 0xf3, 0x50, 0x14, 0xcf, 0x15, 0xdd, 0x83,
-	0xbc, 0xe9, 0x7b, 0xd1, 0x63, 0x91, 0xdb, 0xeb, 0xb4, 0x0c, 0x7a, 0x21, 0x1a, 0x15, 0x92, 0xfb,
-	0x50, 0x34, 0x4c, 0x53, 0x9f, 0x9e, 0xa6, 0xcf, 0x62, 0x96, 0x04, 0xfe, 0xfe, 0x86, 0x56, 0x30,
-	0x48, 0xf2, 0x7f, 0x0c, 0x15, 0xa4, 0xe7, 0x6e, 0xa6, 0x94, 0x21, 0xb4, 0x2a, 0x9a, 0xf7, 0x37,
-	0xb4, 0xb2, 0x11, 0x89, 0xe9, 0x1f, 0xa7, 0xbd, 0x5a, 0x5c, 0x6e, 0xde, 0x5c, 0xc9, 0x7a, 0x99,
-	0x7f, 0xeb, 0xb7, 0x81, 0xbb, 0x39, 0x62, 0xae, 0x53, 0x90, 0xdd, 0xfe, 0x2b, 0x3c, 0x6a, 0x7f,
-	0x43, 0xab, 0x19, 0x3c, 0x58, 0x82, 0xf3, 0xac, 0x0f, 0x23, 0x8f, 0x53, 0xfc, 0x96, 0xeb, 0x9a,
-	0x91, 0x41, 0x9e, 0x11, 0xbb, 0x9d, 0x88, 0x81, 0x60, 0x36, 0xd3, 0x8c, 0x82, 0x0f, 0x4a, 0x2b,
-	0xd9, 0x62, 0xce, 0x44, 0xd9, 0x62, 0x36, 0xf5, 0x09, 0x54, 0xc9, 0xf9, 0x23, 0xf2, 0x95, 0x57,
-	0x86, 0x36, 0x61, 0x2f, 0xe4, 0xd2, 0x4e, 0x98, 0x4d, 0x3b, 0xea, 0xa7, 0x6f, 0xc9, 0x5e, 0xc3,
-	0x5b, 0x6b, 0x07, 0x4a, 0x8b, 0x1d, 0x88, 0xbc, 0xb3, 0x1a, 0xcf, 0xc3, 0x76, 0xa1, 0x66, 0x48,
-	0x12, 0x47, 0xb8, 0x10, 0x6f, 0xad, 0x99, 0xa7, 0x98, 0x86, 0xca, 0x90, 0xd2, 0xc9, 0xd1, 0xd6,
-	0x4d, 0x0d, 0xae, 0xad, 0x5f, 0xca, 0xf2, 0x09, 0x7c, 0x9e, 0x9f, 0xc0, 0xab, 0xe9, 0x1b, 0x83,
-	0xe9, 0x8b, 0x24, 0xd2, 0x79, 0xfc, 0x4f, 0xd1, 0xf8, 0x95, 0xd9, 0x41, 0x15, 0x4a, 0xd1, 0xab,
-	0x59, 0x14, 0xd2, 0xd4, 0x1e, 0x1c, 0x7e, 0xa9, 0x64, 0x10, 0xdc, 0xed, 0x0f, 0x47, 0xad, 0xbe,
-	0x38, 0xb8, 0xec, 0xf6, 0xc5, 0xc1, 0xa5, 0xfa, 0xef, 0x72, 0x50, 0x89, 0x1d, 0xaf, 0xdf, 0xdf,
-	0xe2, 0x8d, 0x4d, 0xc9, 0x9c, 0x6c, 0x4a, 0x2e, 0x69, 0x6c, 0xfc, 0xc8, 0x9c, 0xdf, 0x24, 0xdd,
-	0x4c, 0xeb, 0x45, 0xc1, 0x6a, 0x64, 0x7b, 0xe1, 0x5b, 0x46, 0xb6, 0xcb, 0x21, 0x4a, 0xc5, 0x74,
-	0x88, 0xd2, 0xd2, 0xcb, 0x69, 0x25, 0x3a, 0xde, 0x97, 0x5f, 0x4e, 0xbb, 0xf4, 0x5c, 0xbf, 0x7c,
-	0xf9, 0xb9, 0x3e, 0x3d, 0x83, 0xff, 0xcc, 0xb6, 0xce, 0x44, 0xbc, 0x8e, 0x48, 0xa5, 0x05, 0x12,
-	0xbc, 0x44, 0x20, 0x2d, 0xb3, 0xa2, 0xea, 0x1a, 0x8b, 0xe9, 0x21, 0x6c, 0x4f, 0x4f, 0xe3, 0x57,
-	0x62, 0x12, 0xcb, 0xa9, 0x46, 0xdd, 0x58, 0x8b, 0x53, 0xbf, 0x86, 0x4a, 0xec, 0xf9, 0xfd, 0xfe,
-	0xb3, 0xf9, 0x5d, 0x6e, 0x31, 0xaa, 0xbf, 0x1b, 0xf9, 0x8b, 0x62, 0xc7, 0xeb, 0x5f, 0xd6, 0x5f,
-	0x94, 0xaa, 0x3e, 0xf7, 0x92, 0xea, 0xcf, 0xb9, 0xd3, 0x26, 0xae, 0xfc, 0x37, 0xbc, 0x84, 0xe5,
-	0xd5, 0x95, 0x4f, 0xad, 0x2e, 0x75, 0x21, 0x3c, 0x4f, 0x7f, 0xf9, 0xaa, 0xbf, 0x53, 0x87, 0xff,
-	0x3c, 0x13, 0xb9, 0x47, 0xe2, 0x57, 0x6e, 0x2e, 0x55, 0x92, 0xd6, 0x7b, 0x78, 0xbe, 0x4b, 0x75,
-	0xbf, 0xd6, 0xbe, 0xcb, 0xff, 0x3a, 0xfb, 0xee, 0x6d, 0x28, 0x70, 0xd6, 0x5b, 0xb8, 0xcc, 0xb6,
-	0xe3, 0xf8, 0x97, 0xbe, 0x0b, 0xa9, 0xaa, 0x42, 0x29, 0xe4, 0xfd, 0xdd, 0x8e, 0xca, 0x8d, 0xde,
-	0xb4, 0xc4, 0x04, 0x9a, 0xd7, 0x95, 0xc4, 0xcc, 0xfb, 0xee, 0x63, 0xf2, 0x1b, 0x33, 0xf0, 0xfe,
-	0x69, 0x16, 0xea, 0xa9, 0x43, 0x9f, 0xef, 0xd1, 0x98, 0xb5, 0x7c, 0x33, 0xb7, 0x9e, 0x6f, 0x5e,
-	0xca, 0xc2, 0xf2, 0x97, 0xb3, 0xb0, 0xff, 0x2b, 0xbc, 0x96, 0xc7, 0xdc, 0x89, 0x27, 0x28, 0xcb,
-	0x51, 0xcc, 0x1d, 0x8f, 0x26, 0x53, 0xff, 0x5e, 0x26, 0x7e, 0x90, 0x91, 0xd7, 0xb4, 0x4e, 0xf7,
-	0xce, 0xac, 0xd5, 0xbd, 0x6f, 0xc7, 0xcf, 0xab, 0x77, 0xf7, 0xb8, 0x29, 0x57, 0xd7, 0x24, 0x08,
-	0xfb, 0x14, 0x6e, 0x70, 0xfd, 0x81, 0xab, 0x4c, 0xba, 0x37, 0xd5, 0xe3, 0xc7, 0xd7, 0x45, 0xb8,
-	0xd9, 0x35, 0x4e, 0xc0, 0x1f, 0x0d, 0x9d, 0xb6, 0x22, 0xac, 0xda, 0x85, 0x7a, 0xea, 0x04, 0x4e,
-	0xfa, 0x21, 0x87, 0x8c, 0xfc, 0x43, 0x0e, 0x6c, 0x07, 0x0a, 0x67, 0x27, 0x96, 0x6f, 0xad, 0x79,
-	0x16, 0x84, 0x23, 0xd4, 0x1f, 0x41, 0x4d, 0x8e, 0x06, 0x60, 0xef, 0x42, 0xc1, 0x0e, 0xad, 0x59,
-	0x64, 0xb7, 0x5e, 0x5b, 0x0d, 0x18, 0x20, 0xd3, 0x95, 0x13, 0xa9, 0xbf, 0xcc, 0x80, 0xb2, 0x8c,
-	0x93, 0x7e, 0x6d, 0x22, 0x73, 0xc9, 0xaf, 0x4d, 0x64, 0x53, 0x8d, 0x5c, 0xf7, 0x83, 0x11, 0xf1,
-	0xd3, 0x04, 0xf9, 0x4b, 0x9e, 0x26, 0x60, 0x6f, 0x41, 0xd9, 0xb7, 0xe8, 0x29, 0x7f, 0x33, 0xfd,
-	0x92, 0x33, 0xbf, 0x7e, 0x12, 0xe1, 0xd4, 0xdf, 0xcf, 0x40, 0x49, 0x84, 0x2e, 0xac, 0x35, 0x2f,
-	0xdf, 0x81, 0x12, 0x7f, 0xd6, 0x3f, 0x32, 0xb7, 0x57, 0xa2, 0x01, 0x23, 0x3c, 0x9a, 0x9b, 0x88,
-	0x4a, 0x9b, 0x9b, 0x87, 0x8e, 0xe1, 0x6a, 0x04, 0xc7, 0xa5, 0xc6, 0x9d, 0x07, 0x68, 0x36, 0x05,
-	0xe2, 0x0e, 0x2b, 0x10, 0x08, 0x95, 0xa0, 0x40, 0xfd, 0x31, 0x94, 0x44, 0x68, 0xc4, 0xda, 0xa6,
-	0xbc, 0xec, 0xa1, 0xfb, 0x1d, 0x80, 0x24, 0x56, 0x62, 0x5d, 0x09, 0xea, 0x3d, 0x28, 0x47, 0xe1,
-	0x11, 0xb8, 0xfe, 0x92, 0xaa, 0x45, 0x9c, 0xab, 0xdc, 0x18, 0x47, 0x3c, 0x8f, 0xd5, 0xf3, 0x26,
-	0xa7, 0xe4, 0xc7, 0x7a, 0x00, 0x65, 0x27, 0x7a, 0x75, 0x2c, 0x73, 0xf9, 0xab, 0x63, 0x31, 0x11,
-	0xbb, 0x07, 0x31, 0x3b, 0x7e, 0x99, 0xa5, 0xab, 0xb6, 0xa2, 0xf0, 0x6d, 0x5a, 0x65, 0x8f, 0x84,
-	0xbf, 0x06, 0x41, 0x4b, 0x2e, 0x92, 0x54, 0x9b, 0x34, 0x89, 0x4c, 0x6d, 0x40, 0x4d, 0x3e, 0xd3,
-	0x55, 0x7f, 0x99, 0x07, 0xe5, 0xc0, 0x0a, 0x0d, 0x64, 0x5a, 0xc3, 0x89, 0xe1, 0x52, 0x27, 0x6e,
-	0x40, 0x39, 0x7e, 0xce, 0x38, 0x13, 0x3d, 0x87, 0xe8, 0x44, 0xef, 0xfc, 0x7a, 0x34, 0xa9, 0xb2,
-	0x2f, 0x01, 0x38, 0x88, 0x08, 0x38, 0x27, 0x48, 0xbd, 0x2b, 0x58, 0xb6, 0x83, 0x7d, 0xfe, 0xb2,
-	0xe0, 0x75, 0x7e, 0xb9, 0xd4, 0xf1, 0x26, 0xb4, 0x26, 0x6b, 0x74, 0x97, 0xb4, 0xe7, 0x4d, 0x30,
-	0x57, 0x64, 0x89, 0x06, 0x22, 0xea, 0xbd, 0x2c, 0x4c, 0x50, 0x72, 0x9a, 0x8b, 0x1b, 0x83, 0x61,
-	0x40, 0x9c, 0xa9, 0xa6, 0x95, 0x39, 0x60, 0x14, 0x44, 0x4f, 0x30, 0x4d, 0xc4, 0xbb, 0xc2, 0x39,
-	0x7a, 0x82, 0xa9, 0xed, 0x52, 0x74, 0x3b, 0x3d, 0x5d, 0x3d, 0x11, 0x2f, 0x87, 0x8b, 0x07, 0xae,
-	0x10, 0xf5, 0x3a, 0x7f, 0x79, 0xd9, 0xb7, 0x82, 0x80, 0x3f, 0x1f, 0xc0, 0x6f, 0xf6, 0xd7, 0x22,
-	0x60, 0xfc, 0x4e, 0x81, 0x78, 0xab, 0x1a, 0x49, 0x40, 0xbc, 0x53, 0xc0, 0x5f, 0xaa, 0x46, 0x82,
-	0x1b, 0x50, 0xfe, 0xc6, 0x73, 0x2d, 0x61, 0xdf, 0x62, 0xab, 0x4a, 0x98, 0x3e, 0x30, 0xe6, 0xea,
-	0xbf, 0xcd, 0xc0, 0xf6, 0xf2, 0xa8, 0xd2, 0x6c, 0xd7, 0xa0, 0xdc, 0x1e, 0xf4, 0xf4, 0x7e, 0xeb,
-	0xa0, 0xa3, 0x6c, 0xb0, 0x4d, 0xa8, 0x0e, 0x76, 0x3f, 0xef, 0xb4, 0x47, 0x1c, 0x90, 0xa1, 0x2b,
-	0x23, 0x43, 0x7d, 0xbf, 0xbb, 0xb7, 0xd7, 0xe9, 0x73, 0x65, 0x7e, 0xb0, 0xfb, 0xb9, 0xde, 0x1b,
-	0xb4, 0xf9, 0x33, 0xb9, 0xd1, 0x59, 0xf3, 0x50, 0xc9, 0xd3, 0xf9, 0x2e, 0x05, 0x25, 0x62, 0xb2,
-	0xc0, 0x63, 0xee, 0x9e, 0x0f, 0xf5, 0x76, 0x7f, 0xa4, 0x14, 0x31, 0xd5, 0x3f, 0xea, 0xf5, 0x28,
-	0x45, 0xc1, 0x35, 0xed, 0xc1, 0xc1, 0xa1, 0xd6, 0x19, 0x0e, 0xf5, 0x61, 0xf7, 0xe7, 0x1d, 0xa5,
-	0x4c, 0x35, 0x6b, 0xdd, 0x27, 0xdd, 0x3e, 0x07, 0x54, 0x58, 0x09, 0x72, 0x07, 0xdd, 0xbe, 0x02,
-	0xf4, 0xd1, 0xfa, 0x42, 0xa9, 0xe2, 0xc7, 0xf0, 0xe8, 0x40, 0xa9, 0xdd, 0x7b, 0x0d, 0x6a, 0xf2,
-	0xf3, 0xef, 0x14, 0x66, 0xe7, 0xb9, 0x16, 0x7f, 0xb3, 0xa9, 0xf7, 0xcd, 0x07, 0x4a, 0xe6, 0xde,
-	0xef, 0x4a, 0x6f, 0x78, 0x12, 0x8d, 0xf0, 0x9d, 0xd3, 0xcd, 0x25, 0x7e, 0xa1, 0x82, 0x3c, 0xe5,
-	0x74, 0xff, 0x62, 0xbf, 0x35, 0xdc, 0xe7, 0x5e, 0x75, 0x81, 0x21, 0x40, 0x2e, 0x79, 0xeb, 0x87,
-	0x6e, 0x2a, 0xd1, 0x67, 0x7c, 0xb4, 0x5c, 0xa0, 0x2b, 0x26, 0xdd, 0x21, 0x76, 0x4e, 0x81, 0x1a,
-	0x7e, 0xc5, 0xb8, 0xd2, 0x3d, 0x15, 0xaa, 0xd2, 0x0b, 0x6c, 0x54, 0x87, 0x11, 0x9c, 0x88, 0xe7,
-	0x83, 0xd0, 0x2a, 0x53, 0x32, 0xf7, 0x3e, 0x44, 0x76, 0x2f, 0xbf, 0x7f, 0x06, 0x50, 0xec, 0x7b,
-	0xfe, 0xcc, 0x70, 0x04, 0x9d, 0xb5, 0x08, 0x2c, 0x3e, 0x05, 0x9a, 0x25, 0x5e, 0x4a, 0x53, 0xb2,
-	0xf7, 0x1e, 0xc0, 0xd5, 0xb5, 0x8f, 0xbb, 0x51, 0xe4, 0xa8, 0x3d, 0x9b, 0x3b, 0x16, 0x0f, 0x7e,
-	0xdc, 0xbf, 0x18, 0xfb, 0xb6, 0xa9, 0x64, 0xee, 0xfd, 0x14, 0x9a, 0x97, 0xc5, 0xf2, 0x61, 0x35,
-	0xed, 0xfd, 0x16, 0xc5, 0x4b, 0xe2, 0x0c, 0x0d, 0x74, 0x9e, 0xca, 0xf0, 0x70, 0xd3, 0x5e, 0x87,
-	0x82, 0x0a, 0xee, 0xfd, 0x22, 0x23, 0x31, 0x95, 0x28, 0x1e, 0x2b, 0x06, 0x88, 0xa1, 0x97, 0x41,
-	0x9a, 0x65, 0x98, 0x4a, 0x86, 0x5d, 0x03, 0x96, 0x02, 0xf5, 0xbc, 0x89, 0xe1, 0x28, 0x59, 0x0a,
-	0x1f, 0x88, 0xe0, 0xcf, 0x7d, 0x3b, 0xb4, 0x94, 0x1c, 0x7b, 0x15, 0x6e, 0xc4, 0xb0, 0x9e, 0x77,
-	0x76, 0xe8, 0xdb, 0x68, 0x67, 0x5e, 0x70, 0x74, 0x7e, 0xf7, 0x27, 0x7f, 0xf2, 0xab, 0xdb, 0x99,
-	0xff, 0xf8, 0xab, 0xdb, 0x99, 0xff, 0xf1, 0xab, 0xdb, 0x1b, 0xbf, 0xfc, 0x9f, 0xb7, 0x33, 0x3f,
-	0x97, 0x7f, 0x72, 0x6d, 0x66, 0x84, 0xbe, 0x7d, 0xce, 0x77, 0x42, 0x94, 0x70, 0xad, 0x07, 0xf3,
-	0xd3, 0xe3, 0x07, 0xf3, 0xf1, 0x03, 0x64, 0x40, 0xe3, 0x22, 0xfd, 0xb8, 0xda, 0xa3, 0xff, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0x57, 0xd8, 0x0e, 0xbc, 0x6d, 0x00, 0x00,
+	// 10768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0xcd, 0x8f, 0x23, 0x47,
+	0x96, 0x18, 0xde, 0xfc, 0x26, 0x1f, 0x3f, 0x2a, 0x2b, 0xfb, 0x8b, 0xdd, 0x6a, 0x75, 0x97, 0x52,
+	0x1a, 0xa9, 0xd5, 0xa3, 0xe9, 0x96, 0xaa, 0xf5, 0xd1, 0xd2, 0xce, 0xac, 0x86, 0xc5, 0x62, 0x77,
+	0x51, 0xcd, 0x22, 0x6b, 0x82, 0xac, 0x6e, 0x49, 0x8b, 0x1f, 0x12, 0x49, 0x66, 0xb2, 0x2a, 0x55,
+	0xc9, 0x4c, 0x2a, 0x33, 0xd9, 0x55, 0x25, 0x60, 0x01, 0xfd, 0x6c, 0xc0, 0x0b, 0x1b, 0xf0, 0xc1,
+	0x30, 0xb0, 0x17, 0xdb, 0xf0, 0x78, 0xe1, 0xd3, 0xc2, 0x3e, 0xd9, 0xc0, 0x1a, 0x86, 0x2f, 0x86,
+	0x7d, 0x58, 0x1b, 0x86, 0x6d, 0xc0, 0x27, 0x7f, 0x60, 0x6d, 0x8c, 0x2f, 0xf6, 0x69, 0x0f, 0xeb,
+	0x3f, 0xc0, 0x78, 0x2f, 0x22, 0x33, 0x23, 0x49, 0xd6, 0xb4, 0xa4, 0x9d, 0x85, 0xed, 0x4b, 0x55,
+	0xc4, 0x7b, 0x2f, 0x22, 0xe3, 0xf3, 0x7d, 0xc5, 0x8b, 0x20, 0xc0, 0xdc, 0x31, 0xdc, 0xfb, 0x73,
+	0xdf, 0x0b, 0x3d, 0x35, 0x8f, 0xe9, 0x9b, 0x3f, 0x39, 0xb2, 0xc3, 0xe3, 0xc5, 0xf8, 0xfe, 0xc4,
+	0x9b, 0x3d, 0x38, 0xf2, 0x8e, 0xbc, 0x07, 0x84, 0x1c, 0x2f, 0xa6, 0x94, 0xa3, 0x0c, 0xa5, 0x78,
+	0xa1, 0x9b, 0xe0, 0x78, 0x93, 0x13, 0x91, 0xde, 0x08, 0xed, 0x99, 0x15, 0x84, 0xc6, 0x6c, 0xce,
+	0x01, 0xda, 0x1f, 0x65, 0x20, 0x3f, 0x3a, 0x9f, 0x5b, 0x6a, 0x03, 0xb2, 0xb6, 0xd9, 0xcc, 0x6c,
+	0x65, 0xee, 0x16, 0x58, 0xd6, 0x36, 0xd5, 0x2d, 0xa8, 0xba, 0x5e, 0xd8, 0x5f, 0x38, 0x8e, 0x31,
+	0x76, 0xac, 0x66, 0x76, 0x2b, 0x73, 0xb7, 0xcc, 0x64, 0x90, 0xfa, 0x0a, 0x54, 0x8c, 0x45, 0xe8,
+	0xe9, 0xb6, 0x3b, 0xf1, 0x9b, 0x39, 0xc2, 0x97, 0x11, 0xd0, 0x75, 0x27, 0xbe, 0x7a, 0x05, 0x0a,
+	0xa7, 0xb6, 0x19, 0x1e, 0x37, 0xf3, 0x54, 0x23, 0xcf, 0x20, 0x34, 0x98, 0x18, 0x8e, 0xd5, 0x2c,
+	0x70, 0x28, 0x65, 0x10, 0x1a, 0xd2, 0x47, 0x8a, 0x5b, 0x99, 0xbb, 0x15, 0xc6, 0x33, 0xea, 0x6d,
+	0x00, 0xcb, 0x5d, 0xcc, 0x5e, 0x18, 0xce, 0xc2, 0x0a, 0x9a, 0x25, 0x42, 0x49, 0x10, 0xed, 0x53,
+	0xa8, 0xcc, 0x82, 0xa3, 0x3d, 0xcb, 0x30, 0x2d, 0x5f, 0xbd, 0x0e, 0xa5, 0x59, 0x70, 0xa4, 0x87,
+	0xc6, 0x91, 0xe8, 0x42, 0x71, 0x16, 0x1c, 0x8d, 0x8c, 0x23, 0xf5, 0x06, 0x94, 0x09, 0x71, 0x3e,
+	0xe7, 0x7d, 0x28, 0x30, 0x24, 0xc4, 0x1e, 0x6b, 0x7f, 0x5a, 0x80, 0x52, 0xcf, 0x0e, 0x2d, 0xdf,
+	0x70, 0xd4, 0x6b, 0x50, 0xb4, 0x03, 0x77, 0xe1, 0x38, 0x54, 0xbc, 0xcc, 0x44, 0x4e, 0xbd, 0x06,
+	0x05, 0xfb, 0xd1, 0x0b, 0xc3, 0xe1, 0x65, 0xf7, 0x2e, 0x31, 0x9e, 0x55, 0x9b, 0x50, 0xb4, 0xdf,
+	0xfb, 0x10, 0x11, 0x39, 0x81, 0x10, 0x79, 0xc2, 0x3c, 0xdc, 0x46, 0x4c, 0x3e, 0xc6, 0x3c, 0xdc,
+	0x8e, 0x30, 0x1f, 0xbe, 0x8f, 0x18, 0xec, 0x7d, 0x8e, 0x30, 0x94, 0xc7, 0xaf, 0x2c, 0xe8, 0x2b,
+	0x38, 0x00, 0x75, 0xfc, 0xca, 0x22, 0xfa, 0xca, 0x82, 0x7f, 0xa5, 0x24, 0x10, 0x22, 0x4f, 0x18,
+	0xfe, 0x95, 0x72, 0x8c, 0x89, 0xbf, 0xb2, 0xe0, 0x5f, 0xa9, 0x6c, 0x65, 0xee, 0xe6, 0x09, 0xc3,
+	0xbf, 0x72, 0x05, 0xf2, 0x26, 0xc2, 0x61, 0x2b, 0x73, 0x37, 0xb3, 0x77, 0x89, 0xe5, 0x4d, 0x01,
+	0x0d, 0x10, 0x5a, 0xc5, 0x01, 0x46, 0x68, 0x20, 0xa0, 0x63, 0x84, 0xd6, 0x70, 0x34, 0x10, 0x3a,
+	0x16, 0xd0, 0x29, 0x42, 0xeb, 0x5b, 0x99, 0xbb, 0x59, 0x84, 0x62, 0x4e, 0xbd, 0x09, 0x25, 0xd3,
+	0x08, 0x2d, 0x44, 0x34, 0x44, 0x97, 0x23, 0x00, 0xe2, 0x70, 0xc5, 0x21, 0x6e, 0x43, 0x74, 0x3a,
+	0x02, 0xa8, 0x1a, 0x54, 0x91, 0x2c, 0xc2, 0x2b, 0x02, 0x2f, 0x03, 0xd5, 0x0f, 0xa0, 0x66, 0x5a,
+	0x13, 0x7b, 0x66, 0x38, 0xbc, 0x4f, 0x9b, 0x5b, 0x99, 0xbb, 0xd5, 0xed, 0x8d, 0xfb, 0xb4, 0x27,
+	0x62, 0xcc, 0xde, 0x25, 0x96, 0x22, 0x53, 0x1f, 0x41, 0x5d, 0xe4, 0xdf, 0xdb, 0xa6, 0x81, 0x55,
+	0xa9, 0x9c, 0x92, 0x2a, 0xf7, 0xde, 0xf6, 0xa3, 0xbd, 0x4b, 0x2c, 0x4d, 0xa8, 0xbe, 0x01, 0xb5,
+	0x78, 0x8b, 0x60, 0xc1, 0xcb, 0xa2, 0x55, 0x29, 0x28, 0x76, 0xeb, 0xab, 0xc0, 0x73, 0x91, 0xe0,
+	0x8a, 0x18, 0xb7, 0x08, 0xa0, 0x6e, 0x01, 0x98, 0xd6, 0xd4, 0x58, 0x38, 0x21, 0xa2, 0xaf, 0x8a,
+	0x01, 0x94, 0x60, 0xea, 0x6d, 0xa8, 0x2c, 0xe6, 0xd8, 0xcb, 0x67, 0x86, 0xd3, 0xbc, 0x26, 0x08,
+	0x12, 0x10, 0xd6, 0x8e, 0xeb, 0x1c, 0xb1, 0xd7, 0xc5, 0xec, 0x46, 0x00, 0xdc, 0x2b, 0x76, 0xb0,
+	0x63, 0xbb, 0xcd, 0x26, 0xad, 0x53, 0x9e, 0x51, 0x6f, 0x41, 0x2e, 0xf0, 0x27, 0xcd, 0x1b, 0xd4,
+	0x4b, 0xe0, 0xbd, 0xec, 0x9c, 0xcd, 0x7d, 0x86, 0xe0, 0x9d, 0x12, 0x14, 0x68, 0xcf, 0x68, 0xb7,
+	0xa0, 0x7c, 0x60, 0xf8, 0xc6, 0x8c, 0x59, 0x53, 0x55, 0x81, 0xdc, 0xdc, 0x0b, 0xc4, 0x6e, 0xc1,
+	0xa4, 0xd6, 0x83, 0xe2, 0x33, 0xc3, 0x47, 0x9c, 0x0a, 0x79, 0xd7, 0x98, 0x59, 0x84, 0xac, 0x30,
+	0x4a, 0xe3, 0x0e, 0x09, 0xce, 0x83, 0xd0, 0x9a, 0x09, 0x56, 0x20, 0x72, 0x08, 0x3f, 0x72, 0xbc,
+	0xb1, 0xd8, 0x09, 0x65, 0x26, 0x72, 0xda, 0x5f, 0xca, 0x40, 0xb1, 0xed, 0x39, 0x58, 0xdd, 0x75,
+	0x28, 0xf9, 0x96, 0xa3, 0x27, 0x9f, 0x2b, 0xfa, 0x96, 0x73, 0xe0, 0x05, 0x88, 0x98, 0x78, 0x1c,
+	0xc1, 0xf7, 0x66, 0x71, 0xe2, 0x11, 0x22, 0x6a, 0x40, 0x4e, 0x6a, 0xc0, 0x0d, 0x28, 0x87, 0x63,
+	0x47, 0x27, 0x78, 0x9e, 0xe0, 0xa5, 0x70, 0xec, 0xf4, 0x11, 0x75, 0x1d, 0x4a, 0xe6, 0x98, 0x63,
+	0x0a, 0x84, 0x29, 0x9a, 0x63, 0x44, 0x68, 0x1f, 0x43, 0x85, 0x19, 0xa7, 0xa2, 0x19, 0x57, 0xa1,
+	0x88, 0x15, 0x08, 0x2e, 0x97, 0x67, 0x85, 0x70, 0xec, 0x74, 0x4d, 0x04, 0x63, 0x23, 0x6c, 0x93,
+	0xda, 0x90, 0x67, 0x85, 0x89, 0xe7, 0x74, 0x4d, 0x6d, 0x04, 0xd0, 0xf6, 0x7c, 0xff, 0x07, 0x77,
+	0xe1, 0x0a, 0x14, 0x4c, 0x6b, 0x1e, 0x1e, 0x73, 0x06, 0xc1, 0x78, 0x46, 0xbb, 0x07, 0x65, 0x9c,
+	0x97, 0x9e, 0x1d, 0x84, 0xea, 0x6d, 0xc8, 0x3b, 0x76, 0x10, 0x36, 0x33, 0x5b, 0xb9, 0xa5, 0x59,
+	0x23, 0xb8, 0xb6, 0x05, 0xe5, 0x7d, 0xe3, 0xec, 0x19, 0xce, 0x9c, 0x7a, 0x45, 0x4c, 0xa1, 0x98,
+	0x12, 0x31, 0x9f, 0x35, 0x80, 0x91, 0xe1, 0x1f, 0x59, 0x21, 0xf1, 0xb3, 0x3f, 0xcb, 0x40, 0x75,
+	0xb8, 0x18, 0x7f, 0xbd, 0xb0, 0xfc, 0x73, 0x6c, 0xf3, 0x5d, 0xc8, 0x85, 0xe7, 0x73, 0x2a, 0xd1,
+	0xd8, 0xbe, 0xc6, 0xab, 0x97, 0xf0, 0xf7, 0xb1, 0x10, 0x43, 0x12, 0xec, 0x84, 0xeb, 0x99, 0x56,
+	0x34, 0x06, 0x05, 0x56, 0xc4, 0x6c, 0xd7, 0x44, 0xa1, 0xe0, 0xcd, 0xc5, 0x2c, 0x64, 0xbd, 0xb9,
+	0xba, 0x05, 0x85, 0xc9, 0xb1, 0xed, 0x98, 0x34, 0x01, 0xe9, 0x36, 0x73, 0x04, 0xce, 0x92, 0xef,
+	0x9d, 0xea, 0x81, 0xfd, 0x4d, 0xc4, 0xe4, 0x4b, 0xbe, 0x77, 0x3a, 0xb4, 0xbf, 0xb1, 0xb4, 0x91,
+	0x90, 0x34, 0x00, 0xc5, 0x61, 0xbb, 0xd5, 0x6b, 0x31, 0xe5, 0x12, 0xa6, 0x3b, 0x9f, 0x77, 0x87,
+	0xa3, 0xa1, 0x92, 0x51, 0x1b, 0x00, 0xfd, 0xc1, 0x48, 0x17, 0xf9, 0xac, 0x5a, 0x84, 0x6c, 0xb7,
+	0xaf, 0xe4, 0x90, 0x06, 0xe1, 0xdd, 0xbe, 0x92, 0x57, 0x4b, 0x90, 0x6b, 0xf5, 0xbf, 0x50, 0x0a,
+	0x94, 0xe8, 0xf5, 0x94, 0xa2, 0xf6, 0x87, 0x59, 0xa8, 0x0c, 0xc6, 0x5f, 0x59, 0x93, 0x10, 0xfb,
+	0x8c, 0xab, 0xd4, 0xf2, 0x5f, 0x58, 0x3e, 0x75, 0x3b, 0xc7, 0x44, 0x0e, 0x3b, 0x62, 0x8e, 0xa9,
+	0x73, 0x39, 0x96, 0x35, 0xc7, 0x44, 0x37, 0x39, 0xb6, 0x66, 0x46, 0x33, 0x27, 0xe8, 0x28, 0x87,
+	0xbb, 0xc2, 0x1b, 0x7f, 0x45, 0xdd, 0xcb, 0x31, 0x4c, 0xaa, 0x77, 0xa0, 0xca, 0xeb, 0x90, 0xd7,
+	0x17, 0x70, 0xd0, 0xf2, 0xe2, 0x2b, 0xca, 0x8b, 0x8f, 0x4a, 0x52, 0xad, 0x1c, 0x29, 0x24, 0x18,
+	0x07, 0xf5, 0xc5, 0x8a, 0xf6, 0xc6, 0x5f, 0x71, 0x6c, 0x99, 0xaf, 0x68, 0x6f, 0xfc, 0x15, 0xa1,
+	0x7e, 0x0c, 0x9b, 0xc1, 0x62, 0x1c, 0x4c, 0x7c, 0x7b, 0x1e, 0xda, 0x9e, 0xcb, 0x69, 0x2a, 0x44,
+	0xa3, 0xc8, 0x08, 0x22, 0xbe, 0x0b, 0xe5, 0xf9, 0x62, 0xac, 0xdb, 0xee, 0xd4, 0x23, 0xe6, 0x5e,
+	0xdd, 0xae, 0xf3, 0x89, 0x39, 0x58, 0x8c, 0xbb, 0xee, 0xd4, 0x63, 0xa5, 0x39, 0x4f, 0x68, 0x6f,
+	0x42, 0x49, 0xc0, 0x50, 0x7a, 0x87, 0x96, 0x6b, 0xb8, 0xa1, 0x1e, 0x8b, 0xfd, 0x32, 0x07, 0x74,
+	0x4d, 0xed, 0x6f, 0x67, 0x40, 0x19, 0x4a, 0x9f, 0xd9, 0xb7, 0x42, 0x63, 0x2d, 0x57, 0x78, 0x15,
+	0xc0, 0x98, 0x4c, 0xbc, 0x05, 0xaf, 0x86, 0x2f, 0x9e, 0x8a, 0x80, 0x74, 0x4d, 0x79, 0x6c, 0x72,
+	0xa9, 0xb1, 0x79, 0x0d, 0x6a, 0x51, 0x39, 0x69, 0x43, 0x57, 0x05, 0x2c, 0x1a, 0x9d, 0x60, 0x91,
+	0xda, 0xd5, 0xa5, 0x60, 0xc1, 0xb7, 0xf5, 0x5f, 0xcb, 0x42, 0xf9, 0xf1, 0xc2, 0x9d, 0x60, 0xd3,
+	0xd4, 0xd7, 0x21, 0x3f, 0x5d, 0xb8, 0x93, 0x66, 0x46, 0x16, 0x0d, 0xf1, 0x8a, 0x60, 0x84, 0xc4,
+	0xbd, 0x66, 0xf8, 0x47, 0xb8, 0x47, 0x57, 0xf6, 0x1a, 0xc2, 0xb5, 0x7f, 0x92, 0xe1, 0x35, 0x3e,
+	0x76, 0x8c, 0x23, 0xb5, 0x0c, 0xf9, 0xfe, 0xa0, 0xdf, 0x51, 0x2e, 0xa9, 0x35, 0x28, 0x77, 0xfb,
+	0xa3, 0x0e, 0xeb, 0xb7, 0x7a, 0x4a, 0x86, 0x16, 0xee, 0xa8, 0xb5, 0xd3, 0xeb, 0x28, 0x59, 0xc4,
+	0x3c, 0x1b, 0xf4, 0x5a, 0xa3, 0x6e, 0xaf, 0xa3, 0xe4, 0x39, 0x86, 0x75, 0xdb, 0x23, 0xa5, 0xac,
+	0x2a, 0x50, 0x3b, 0x60, 0x83, 0xdd, 0xc3, 0x76, 0x47, 0xef, 0x1f, 0xf6, 0x7a, 0x8a, 0xa2, 0x5e,
+	0x86, 0x8d, 0x18, 0x32, 0xe0, 0xc0, 0x2d, 0x2c, 0xf2, 0xac, 0xc5, 0x5a, 0xec, 0x89, 0xf2, 0x73,
+	0xb5, 0x0c, 0xb9, 0xd6, 0x93, 0x27, 0xca, 0xb7, 0xb8, 0x07, 0x2a, 0xcf, 0xbb, 0x7d, 0xfd, 0x59,
+	0xab, 0x77, 0xd8, 0x51, 0xbe, 0xcd, 0x46, 0xf9, 0x01, 0xdb, 0xed, 0x30, 0xe5, 0xdb, 0xbc, 0xba,
+	0x09, 0xb5, 0x2f, 0x07, 0xfd, 0xce, 0x7e, 0xeb, 0xe0, 0x80, 0x1a, 0xf2, 0x6d, 0x59, 0xfb, 0xe3,
+	0x3c, 0xe4, 0xb1, 0x27, 0xaa, 0x96, 0xec, 0xf7, 0xb8, 0x8b, 0xb8, 0xe1, 0x76, 0xf2, 0x7f, 0xfc,
+	0x27, 0x77, 0x2e, 0xf1, 0x9d, 0xfe, 0x1a, 0xe4, 0x1c, 0x3b, 0x6c, 0x66, 0xe5, 0x55, 0x22, 0x74,
+	0xa0, 0xbd, 0x4b, 0x0c, 0x71, 0xea, 0x6d, 0xc8, 0xf0, 0x2d, 0x5f, 0xdd, 0x6e, 0x88, 0x65, 0x24,
+	0x64, 0xc6, 0xde, 0x25, 0x96, 0x99, 0xab, 0xb7, 0x20, 0xf3, 0x42, 0xec, 0xff, 0x1a, 0xc7, 0x73,
+	0xa9, 0x81, 0xd8, 0x17, 0xea, 0x16, 0xe4, 0x26, 0x1e, 0xd7, 0x70, 0x62, 0x3c, 0xe7, 0xa1, 0x58,
+	0xff, 0xc4, 0x73, 0xd4, 0xd7, 0x21, 0xe7, 0x1b, 0xa7, 0xcd, 0xa2, 0x3c, 0x5d, 0x31, 0x93, 0x46,
+	0x22, 0xdf, 0x38, 0xc5, 0x46, 0x4c, 0x9b, 0x25, 0xb9, 0x11, 0xd1, 0x7c, 0xe3, 0x67, 0xa6, 0xea,
+	0x16, 0x64, 0x4e, 0x9b, 0x65, 0x59, 0xa8, 0x3f, 0xb7, 0x5d, 0xd3, 0x3b, 0x1d, 0xce, 0xad, 0x09,
+	0x52, 0x9c, 0xaa, 0x3f, 0x82, 0x5c, 0xb0, 0x18, 0xd3, 0x9e, 0xa9, 0x6e, 0x6f, 0xae, 0x70, 0x3f,
+	0xfc, 0x50, 0xb0, 0x18, 0xab, 0x6f, 0x42, 0x7e, 0xe2, 0xf9, 0x7e, 0x13, 0xe4, 0xba, 0x12, 0xc6,
+	0x8f, 0x4a, 0x0e, 0xe2, 0xf1, 0x83, 0x61, 0xb3, 0x2a, 0x13, 0x25, 0x9c, 0x17, 0x3f, 0x18, 0xaa,
+	0x6f, 0x08, 0x76, 0x5e, 0x93, 0x5b, 0x1d, 0x31, 0x7b, 0xac, 0x07, 0xb1, 0x38, 0x49, 0x33, 0xe3,
+	0xac, 0x59, 0x97, 0x89, 0x22, 0x2e, 0x8f, 0x6d, 0x9a, 0x19, 0x67, 0xea, 0x1b, 0x90, 0x7b, 0x61,
+	0x4d, 0x9a, 0x0d, 0xf9, 0x6b, 0x62, 0x92, 0x9e, 0x51, 0xf7, 0x10, 0x8d, 0x72, 0xcb, 0x58, 0x9c,
+	0xe1, 0xb6, 0xdb, 0xe0, 0x12, 0xc6, 0x58, 0x9c, 0x75, 0x4d, 0xe4, 0x60, 0xae, 0xf9, 0x82, 0xb4,
+	0xa9, 0x0c, 0xc3, 0x24, 0x6a, 0xf2, 0x81, 0xe5, 0x58, 0x93, 0xd0, 0x7e, 0x61, 0x87, 0xe7, 0xa4,
+	0x42, 0x65, 0x98, 0x0c, 0xda, 0x29, 0x42, 0xde, 0x3a, 0x9b, 0xfb, 0xda, 0x36, 0x40, 0xf2, 0x1d,
+	0xac, 0xc9, 0xb1, 0xdc, 0x48, 0x43, 0x70, 0x2c, 0x17, 0x39, 0x80, 0x69, 0x84, 0x06, 0x2d, 0x9f,
+	0x1a, 0xa3, 0xb4, 0x76, 0x03, 0x2a, 0xb1, 0xea, 0xa5, 0xd6, 0x20, 0x63, 0x08, 0xce, 0x9b, 0x31,
+	0xb4, 0xbb, 0x00, 0x02, 0xf5, 0xde, 0xf6, 0xa3, 0x34, 0x0e, 0x73, 0x11, 0x3f, 0xce, 0x8c, 0xb5,
+	0x9f, 0x42, 0x8d, 0x59, 0xc1, 0xc2, 0x09, 0xdb, 0x9e, 0xb3, 0x6b, 0x4d, 0xd5, 0x77, 0x00, 0xe2,
+	0x7c, 0x20, 0x04, 0x64, 0xb2, 0x98, 0x76, 0xad, 0x29, 0x93, 0xf0, 0xda, 0xef, 0xe5, 0xa1, 0x28,
+	0x0a, 0x26, 0xc2, 0x3c, 0x23, 0x09, 0xf3, 0x98, 0x75, 0x65, 0xd3, 0x0a, 0xcd, 0xb1, 0x6d, 0x9a,
+	0x96, 0x1b, 0x29, 0x2e, 0x3c, 0x87, 0xa3, 0x6f, 0x38, 0x47, 0xb4, 0xc2, 0x1b, 0xdb, 0x6a, 0xf4,
+	0xd1, 0xd9, 0xdc, 0xb7, 0x82, 0x80, 0x8b, 0x4c, 0xc3, 0x39, 0x8a, 0x36, 0x5b, 0xe1, 0xd7, 0x6d,
+	0xb6, 0x1b, 0x50, 0x76, 0xbd, 0x50, 0x27, 0xb3, 0xa2, 0x48, 0xdf, 0x28, 0x09, 0xfb, 0x49, 0x7d,
+	0x0b, 0x4a, 0x42, 0x21, 0x6c, 0x96, 0xe4, 0xbd, 0xb8, 0xcb, 0x81, 0x2c, 0xc2, 0xaa, 0x4d, 0xd4,
+	0x2f, 0x66, 0x33, 0xcb, 0x0d, 0x23, 0x11, 0x21, 0xb2, 0xea, 0x8f, 0xa1, 0xe2, 0xb9, 0x3a, 0xd7,
+	0x1a, 0x9b, 0x15, 0x79, 0x3d, 0x0d, 0xdc, 0x43, 0x82, 0xb2, 0xb2, 0x27, 0x52, 0xd8, 0x14, 0xc7,
+	0x3b, 0xd5, 0x27, 0x86, 0x6f, 0xd2, 0x52, 0x2f, 0xb3, 0x92, 0xe3, 0x9d, 0xb6, 0x0d, 0xdf, 0xe4,
+	0x22, 0xf3, 0x6b, 0x77, 0x31, 0xa3, 0xe5, 0x5d, 0x67, 0x22, 0xa7, 0xde, 0x82, 0xca, 0xc4, 0x59,
+	0x04, 0xa1, 0xe5, 0xef, 0x9c, 0x73, 0x3b, 0x80, 0x25, 0x00, 0x6c, 0xd7, 0xdc, 0xb7, 0x67, 0x86,
+	0x7f, 0x4e, 0x6b, 0xb9, 0xcc, 0xa2, 0x2c, 0xaa, 0x2a, 0xf3, 0x13, 0xdb, 0x3c, 0xe3, 0xc6, 0x00,
+	0xe3, 0x19, 0xa4, 0x3f, 0x26, 0x53, 0x2d, 0xa0, 0xe5, 0x5a, 0x66, 0x51, 0x96, 0xe6, 0x81, 0x92,
+	0xb4, 0x66, 0x2b, 0x4c, 0xe4, 0x52, 0xfa, 0xde, 0xe6, 0x85, 0xfa, 0x9e, 0x9a, 0xd2, 0xf7, 0xbe,
+	0x86, 0x92, 0x18, 0x41, 0xf5, 0x36, 0x5f, 0xd3, 0x69, 0x76, 0xc8, 0x39, 0x3e, 0xc2, 0xd5, 0xd7,
+	0xa1, 0xee, 0xf9, 0xf6, 0x91, 0xed, 0xea, 0x41, 0xe8, 0xdb, 0xee, 0x91, 0x58, 0x1b, 0x35, 0x0e,
+	0x1c, 0x12, 0x0c, 0xc5, 0x14, 0xce, 0x9e, 0x6e, 0x8c, 0x6d, 0x07, 0xf7, 0x4e, 0x4e, 0x58, 0xc1,
+	0x0b, 0xc7, 0x69, 0x71, 0x90, 0x36, 0x80, 0x72, 0x34, 0xde, 0xbf, 0x91, 0x6f, 0x6a, 0xbf, 0x05,
+	0xd5, 0xae, 0x6b, 0x5a, 0x67, 0x03, 0x92, 0xbc, 0xea, 0x3b, 0xa0, 0x4e, 0x7c, 0xcb, 0x08, 0x2d,
+	0xdd, 0x3a, 0x0b, 0x7d, 0x43, 0xe7, 0x96, 0x32, 0xb7, 0x52, 0x15, 0x8e, 0xe9, 0x20, 0x62, 0x84,
+	0x70, 0xed, 0x3f, 0x65, 0xa0, 0x7e, 0xc0, 0x27, 0xe2, 0xa9, 0x75, 0xbe, 0xcb, 0x75, 0xf9, 0x49,
+	0xb4, 0x89, 0xf2, 0x8c, 0xd2, 0xea, 0x6d, 0xa8, 0xce, 0x4f, 0xac, 0x73, 0x3d, 0xa5, 0xf7, 0x56,
+	0x10, 0xd4, 0xa6, 0xed, 0xf2, 0x36, 0x14, 0x3d, 0xfa, 0x7a, 0x33, 0x27, 0xb3, 0x4f, 0xa9, 0x59,
+	0x4c, 0x10, 0xa8, 0x1a, 0xd4, 0xe3, 0xaa, 0x64, 0x49, 0x2e, 0x2a, 0xa3, 0xe9, 0xba, 0x02, 0x05,
+	0x44, 0x05, 0xcd, 0xc2, 0x56, 0x0e, 0x95, 0x57, 0xca, 0xa8, 0xef, 0x42, 0x7d, 0xe2, 0xcd, 0xe6,
+	0x7a, 0x54, 0x5c, 0x48, 0x84, 0xf4, 0x36, 0xaf, 0x22, 0xc9, 0x01, 0xaf, 0x4b, 0xfb, 0xfd, 0x1c,
+	0x94, 0xa9, 0x0d, 0x62, 0xa7, 0xdb, 0xe6, 0x59, 0xb4, 0xd3, 0x2b, 0xac, 0x60, 0x9b, 0xc8, 0xfe,
+	0x5e, 0x05, 0xb0, 0x91, 0x44, 0x97, 0xf6, 0x7b, 0x85, 0x20, 0x51, 0x53, 0xe6, 0x86, 0x1f, 0x06,
+	0xcd, 0x1c, 0x6f, 0x0a, 0x65, 0x70, 0x09, 0x2e, 0x5c, 0xfb, 0xeb, 0x05, 0x6f, 0x7d, 0x99, 0x89,
+	0x9c, 0x7a, 0x17, 0x14, 0x5e, 0x19, 0x0d, 0xba, 0xac, 0x8a, 0x34, 0x08, 0x4e, 0x63, 0x1e, 0xe9,
+	0x7a, 0x9c, 0xc6, 0x3a, 0x43, 0x19, 0xc0, 0x77, 0x3b, 0x10, 0xa8, 0x83, 0x10, 0x79, 0x1f, 0x97,
+	0xd2, 0xfb, 0xb8, 0x09, 0xa5, 0x17, 0x76, 0x60, 0xe3, 0xac, 0x96, 0xf9, 0xce, 0x10, 0x59, 0x69,
+	0x1a, 0x2a, 0x2f, 0x9b, 0x86, 0xb8, 0xdb, 0x86, 0x73, 0xc4, 0x95, 0xc0, 0xa8, 0xdb, 0x2d, 0xe7,
+	0xc8, 0x53, 0xdf, 0x83, 0xab, 0x09, 0x5a, 0xf4, 0x86, 0x5c, 0x22, 0x64, 0xf5, 0x33, 0x35, 0xa6,
+	0xa4, 0x1e, 0x91, 0x96, 0x7e, 0x0f, 0x36, 0xa5, 0x22, 0x73, 0x54, 0x01, 0x02, 0x62, 0x03, 0x15,
+	0xb6, 0x11, 0x93, 0x93, 0x66, 0x10, 0x68, 0xff, 0x2a, 0x0b, 0xf5, 0xc7, 0x9e, 0x6f, 0xd9, 0x47,
+	0x6e, 0xb2, 0xea, 0x56, 0x74, 0xc5, 0x68, 0x25, 0x66, 0xa5, 0x95, 0x78, 0x07, 0xaa, 0x53, 0x5e,
+	0x50, 0x0f, 0xc7, 0xdc, 0x84, 0xcc, 0x33, 0x10, 0xa0, 0xd1, 0xd8, 0xc1, 0x1d, 0x18, 0x11, 0x50,
+	0xe1, 0x3c, 0x15, 0x8e, 0x0a, 0x21, 0xfb, 0x57, 0x3f, 0x21, 0x46, 0x68, 0x5a, 0x8e, 0x15, 0xf2,
+	0xe9, 0x69, 0x6c, 0xbf, 0x2a, 0x74, 0x06, 0xb9, 0x4d, 0xf7, 0x99, 0x35, 0x6d, 0x91, 0x0a, 0x81,
+	0x7c, 0x71, 0x97, 0xc8, 0xd5, 0x4f, 0x64, 0x26, 0x5a, 0xfc, 0x8e, 0x65, 0xf9, 0x6e, 0xd7, 0x46,
+	0x50, 0x89, 0xc1, 0xa8, 0x0f, 0xb2, 0x8e, 0xd0, 0x01, 0x2f, 0xa9, 0x55, 0x28, 0xb5, 0x5b, 0xc3,
+	0x76, 0x6b, 0xb7, 0xa3, 0x64, 0x10, 0x35, 0xec, 0x8c, 0xb8, 0xde, 0x97, 0x55, 0x37, 0xa0, 0x8a,
+	0xb9, 0xdd, 0xce, 0xe3, 0xd6, 0x61, 0x6f, 0xa4, 0xe4, 0xd4, 0x3a, 0x54, 0xfa, 0x03, 0xbd, 0xd5,
+	0x1e, 0x75, 0x07, 0x7d, 0x25, 0xaf, 0xfd, 0x1c, 0xca, 0xed, 0x63, 0x6b, 0x72, 0x72, 0xd1, 0x28,
+	0x92, 0x09, 0x66, 0x4d, 0x4e, 0x9a, 0xd9, 0x15, 0x26, 0xc3, 0x11, 0xda, 0x33, 0xa8, 0xb5, 0x23,
+	0x3e, 0x7d, 0x51, 0x2d, 0xdb, 0xd0, 0xa0, 0xcd, 0x37, 0x19, 0x47, 0xbb, 0x2f, 0xbb, 0x66, 0xf7,
+	0xd5, 0x90, 0xa6, 0x3d, 0x16, 0xdb, 0xef, 0x03, 0xa8, 0x1e, 0xf8, 0xde, 0xdc, 0xf2, 0x43, 0xaa,
+	0x56, 0x81, 0xdc, 0x89, 0x75, 0x2e, 0x6a, 0xc5, 0x64, 0x62, 0xa4, 0x66, 0x65, 0x23, 0x75, 0x1b,
+	0xca, 0x51, 0xb1, 0xef, 0x5c, 0xe6, 0x53, 0xa8, 0x8b, 0x32, 0xb6, 0x15, 0xe0, 0xc7, 0xee, 0x03,
+	0xcc, 0x63, 0x80, 0x50, 0x08, 0x22, 0xed, 0x54, 0x54, 0xce, 0x24, 0x0a, 0xed, 0xcf, 0x72, 0xd0,
+	0x38, 0x30, 0xfc, 0xd0, 0xc6, 0xc9, 0xe1, 0xc3, 0xf0, 0x16, 0xe4, 0x69, 0xc9, 0x73, 0x7b, 0xf8,
+	0x72, 0xac, 0xda, 0x72, 0x1a, 0x92, 0xec, 0x44, 0xa0, 0x7e, 0x02, 0x8d, 0x79, 0x04, 0xd6, 0x89,
+	0x9f, 0xf3, 0xb1, 0x59, 0x2e, 0x42, 0x63, 0x5e, 0x9f, 0xcb, 0x59, 0xf5, 0x67, 0x70, 0x25, 0x5d,
+	0xd6, 0x0a, 0x82, 0x84, 0x8f, 0xca, 0x93, 0x75, 0x39, 0x55, 0x90, 0x93, 0xa9, 0x6d, 0xd8, 0x4c,
+	0x8a, 0x4f, 0x3c, 0x67, 0x31, 0x73, 0x03, 0xa1, 0x6b, 0x5f, 0x5b, 0xfa, 0x7a, 0x9b, 0x63, 0x99,
+	0x32, 0x5f, 0x82, 0xa8, 0x1a, 0xd4, 0x62, 0x58, 0x7f, 0x31, 0xa3, 0x2d, 0x91, 0x67, 0x29, 0x98,
+	0xfa, 0x10, 0x20, 0xce, 0x07, 0xcd, 0xe2, 0x56, 0x6e, 0x4d, 0xff, 0xba, 0xa1, 0x35, 0x63, 0x12,
+	0x19, 0x6a, 0x04, 0xc8, 0x0c, 0x7c, 0x3b, 0x3c, 0x9e, 0x11, 0x17, 0xcb, 0xb1, 0x04, 0x40, 0xcc,
+	0x32, 0xd0, 0xd1, 0x64, 0x8b, 0x8b, 0x08, 0x86, 0xd6, 0xb0, 0x83, 0xe1, 0x62, 0x1c, 0xd7, 0x8b,
+	0x62, 0x30, 0xe9, 0xe5, 0x2c, 0x38, 0x12, 0x86, 0x6d, 0xd2, 0xc2, 0xfd, 0xe0, 0x48, 0xdd, 0x86,
+	0xab, 0x09, 0x51, 0xc2, 0x7f, 0x83, 0x26, 0x10, 0xe7, 0x4e, 0x86, 0x2f, 0x66, 0xc2, 0x81, 0xf6,
+	0x19, 0xd4, 0x53, 0xb3, 0xf3, 0x52, 0x81, 0x7c, 0x03, 0xca, 0xf8, 0x1f, 0xc5, 0xb1, 0x58, 0x80,
+	0x25, 0xcc, 0x0f, 0x43, 0x5f, 0xb3, 0x40, 0x59, 0x1e, 0x6b, 0xf5, 0x0d, 0x72, 0xf6, 0x60, 0x72,
+	0x8d, 0xd3, 0x26, 0x42, 0xa1, 0xed, 0xbe, 0x3a, 0x89, 0x59, 0x6a, 0xf5, 0xca, 0x64, 0x69, 0x7f,
+	0x2f, 0x0b, 0xf5, 0xd4, 0x88, 0xab, 0x3f, 0x92, 0x97, 0x9f, 0xb4, 0x71, 0x93, 0x31, 0x23, 0x89,
+	0xf3, 0x36, 0x28, 0x9e, 0x6f, 0xda, 0xae, 0x41, 0xce, 0x27, 0x3e, 0xdc, 0x59, 0x52, 0xe0, 0x36,
+	0x04, 0xfc, 0x40, 0x80, 0xd1, 0x00, 0x30, 0xad, 0xd8, 0x96, 0x17, 0x96, 0xb8, 0x0c, 0x92, 0xa5,
+	0x53, 0x3e, 0x2d, 0x9d, 0xde, 0x82, 0x8a, 0x63, 0x05, 0x81, 0x1e, 0x1e, 0x1b, 0x6e, 0xb3, 0xb0,
+	0xd2, 0xe9, 0x32, 0x22, 0x47, 0xc7, 0x86, 0x8b, 0x84, 0xb6, 0xab, 0x0b, 0x6f, 0x7d, 0x71, 0x95,
+	0xd0, 0x76, 0xc9, 0xc6, 0x41, 0xb9, 0x7f, 0x65, 0xdd, 0xc4, 0x0a, 0xb1, 0xa8, 0xae, 0xce, 0xab,
+	0xf6, 0x2a, 0x94, 0x9e, 0xd9, 0xd6, 0xa9, 0xe0, 0x65, 0x2f, 0x6c, 0xeb, 0x34, 0xe2, 0x65, 0x98,
+	0xd6, 0xfe, 0x63, 0x19, 0xca, 0x44, 0xbc, 0x7b, 0xb1, 0x93, 0xef, 0xfb, 0x18, 0x00, 0x5b, 0x90,
+	0x8f, 0x45, 0xcd, 0x32, 0x47, 0x24, 0x0c, 0x4a, 0x5b, 0x49, 0x86, 0x72, 0x8d, 0xa0, 0x12, 0xc6,
+	0xa2, 0x13, 0x35, 0x67, 0x52, 0xcc, 0x82, 0xaf, 0x1d, 0xe1, 0x13, 0x4a, 0x00, 0xea, 0x7d, 0xae,
+	0xd7, 0x92, 0xcf, 0xa2, 0x24, 0x33, 0x16, 0xea, 0x43, 0x64, 0xe6, 0x92, 0xb2, 0x8b, 0x19, 0xd2,
+	0x0f, 0x2c, 0x3f, 0x88, 0xb6, 0x53, 0x9d, 0x45, 0x59, 0xe4, 0x68, 0xa8, 0x3c, 0x35, 0xab, 0x72,
+	0x2d, 0x29, 0xed, 0x8f, 0x11, 0x81, 0x7a, 0x17, 0x4a, 0x24, 0xb2, 0x2d, 0x94, 0xe0, 0x12, 0xeb,
+	0x8c, 0x94, 0x29, 0x16, 0xa1, 0xd5, 0xb7, 0xa1, 0x30, 0x3d, 0xb1, 0xce, 0x83, 0x66, 0x5d, 0x66,
+	0x09, 0x29, 0x59, 0xc8, 0x38, 0x85, 0xfa, 0x06, 0x34, 0x7c, 0x6b, 0xaa, 0x93, 0xdb, 0x0f, 0x85,
+	0x77, 0xd0, 0x6c, 0x90, 0x6c, 0xae, 0xf9, 0xd6, 0xb4, 0x8d, 0xc0, 0xd1, 0xd8, 0x09, 0xd4, 0x37,
+	0xa1, 0x48, 0x52, 0x09, 0xd5, 0x7e, 0xe9, 0xcb, 0x91, 0x88, 0x63, 0x02, 0xab, 0x6e, 0x43, 0x25,
+	0x61, 0x1b, 0x57, 0xa9, 0x43, 0x57, 0x96, 0xf8, 0x11, 0xb1, 0x71, 0x96, 0x90, 0xa9, 0xef, 0x01,
+	0x08, 0x83, 0x44, 0x1f, 0x9f, 0x93, 0x23, 0xbd, 0x1a, 0x1b, 0x6c, 0x92, 0x00, 0x94, 0xcd, 0x96,
+	0xb7, 0xa0, 0x80, 0x52, 0x22, 0x68, 0x5e, 0xdf, 0xca, 0x25, 0x1a, 0x95, 0x24, 0xd6, 0x18, 0xc7,
+	0xa3, 0x4f, 0x0d, 0x17, 0x97, 0x8e, 0x53, 0xd8, 0x94, 0x2d, 0x34, 0xb1, 0x12, 0x51, 0x4b, 0xb3,
+	0x4e, 0x87, 0x5f, 0x3b, 0xea, 0x3d, 0xc8, 0x9b, 0xd6, 0x34, 0x68, 0xde, 0xd8, 0xca, 0x25, 0x6c,
+	0x3a, 0x5a, 0x8f, 0x68, 0xd0, 0x71, 0xd1, 0x82, 0x34, 0xea, 0x1e, 0x34, 0x70, 0xe9, 0x6d, 0x93,
+	0xe2, 0x8d, 0x43, 0xde, 0xbc, 0x49, 0xa5, 0x5e, 0x5b, 0x2a, 0xd5, 0x17, 0x44, 0x34, 0x41, 0x1d,
+	0x37, 0xf4, 0xcf, 0x59, 0xdd, 0x95, 0x61, 0xea, 0x4d, 0x28, 0xdb, 0x41, 0xcf, 0x9b, 0x9c, 0x58,
+	0x66, 0xf3, 0x15, 0x7e, 0xf6, 0x16, 0xe5, 0xd5, 0x8f, 0xa1, 0x4e, 0x8b, 0x11, 0xb3, 0xf8, 0xf1,
+	0xe6, 0x2d, 0x59, 0xe4, 0x8d, 0x64, 0x14, 0x4b, 0x53, 0xa2, 0xba, 0x65, 0x07, 0x7a, 0x68, 0xcd,
+	0xe6, 0x9e, 0x8f, 0xb6, 0xdd, 0xab, 0xdc, 0xe0, 0xb1, 0x83, 0x51, 0x04, 0x42, 0x3e, 0x1f, 0x1f,
+	0xfb, 0xe9, 0xde, 0x74, 0x1a, 0x58, 0x61, 0xf3, 0x36, 0xed, 0xb5, 0x46, 0x74, 0xfa, 0x37, 0x20,
+	0x28, 0x29, 0xa5, 0x81, 0x6e, 0x9e, 0xbb, 0xc6, 0xcc, 0x9e, 0x34, 0xef, 0x70, 0x13, 0xd2, 0x0e,
+	0x76, 0x39, 0x40, 0xb6, 0xe2, 0xb6, 0x64, 0x2b, 0xee, 0xe6, 0x13, 0xb2, 0xe2, 0xa8, 0x3d, 0x1f,
+	0x2c, 0xc9, 0xfd, 0xd4, 0x42, 0x97, 0x14, 0x04, 0x3c, 0x61, 0x49, 0x08, 0x77, 0x0a, 0x90, 0x33,
+	0xad, 0xe9, 0xcd, 0x9f, 0x83, 0xba, 0x3a, 0x92, 0x2f, 0x53, 0x42, 0x0a, 0x42, 0x09, 0xf9, 0x24,
+	0xfb, 0x28, 0xa3, 0x7d, 0x0c, 0xf5, 0xd4, 0xb6, 0x5c, 0xab, 0x4c, 0x71, 0xa3, 0xc2, 0x98, 0x09,
+	0xbf, 0x08, 0xcf, 0x68, 0xff, 0x36, 0x07, 0xb5, 0x3d, 0x23, 0x38, 0xde, 0x37, 0xe6, 0xc3, 0xd0,
+	0x08, 0x03, 0x1c, 0xdb, 0x63, 0x23, 0x38, 0x9e, 0x19, 0x73, 0xee, 0x1e, 0xcf, 0x70, 0x47, 0x8c,
+	0x80, 0xa1, 0x8b, 0x1c, 0x67, 0x15, 0xb3, 0x03, 0xf7, 0xe0, 0xa9, 0x38, 0x66, 0x89, 0xf3, 0xc8,
+	0x07, 0x82, 0xe3, 0xc5, 0x74, 0xea, 0x58, 0x82, 0x5f, 0x45, 0x59, 0xf5, 0x0d, 0xa8, 0x8b, 0x24,
+	0x99, 0x6f, 0x67, 0xe2, 0xcc, 0x35, 0x0d, 0x54, 0x1f, 0x42, 0x55, 0x00, 0x46, 0x11, 0xd7, 0x6a,
+	0xc4, 0x8e, 0xb1, 0x04, 0xc1, 0x64, 0x2a, 0xf5, 0x17, 0x70, 0x55, 0xca, 0x3e, 0xf6, 0xfc, 0xfd,
+	0x85, 0x13, 0xda, 0xed, 0xbe, 0xd0, 0x95, 0x5f, 0x59, 0x29, 0x9e, 0x90, 0xb0, 0xf5, 0x25, 0xd3,
+	0xad, 0xdd, 0xb7, 0x5d, 0xa1, 0x49, 0xa4, 0x81, 0x4b, 0x54, 0xc6, 0x59, 0xb3, 0xbc, 0x42, 0x65,
+	0x9c, 0xe1, 0x4a, 0x17, 0x80, 0x7d, 0x2b, 0x3c, 0xf6, 0xcc, 0x66, 0x45, 0x5e, 0xe9, 0x43, 0x19,
+	0xc5, 0xd2, 0x94, 0x38, 0x9c, 0x68, 0xc6, 0x4f, 0xdc, 0x90, 0xcc, 0xa5, 0x1c, 0x8b, 0xb2, 0x28,
+	0x17, 0x7c, 0xc3, 0x3d, 0xb2, 0x82, 0x66, 0x75, 0x2b, 0x77, 0x37, 0xc3, 0x44, 0x4e, 0xfb, 0xff,
+	0xb3, 0x50, 0xe0, 0x33, 0xf9, 0x0a, 0x54, 0xc6, 0x78, 0xa8, 0xae, 0xa3, 0xd7, 0x44, 0xf8, 0xce,
+	0x09, 0x80, 0xaa, 0x15, 0x99, 0x39, 0x01, 0xf7, 0xb1, 0x66, 0x18, 0xa5, 0xb1, 0x4a, 0x6f, 0x11,
+	0xe2, 0xb7, 0x72, 0x04, 0x15, 0x39, 0x6c, 0x84, 0xef, 0x9d, 0xd2, 0x6a, 0xc8, 0x13, 0x22, 0xca,
+	0xe2, 0x27, 0xb8, 0x88, 0xc1, 0x42, 0x05, 0xc2, 0x95, 0x09, 0xd0, 0x76, 0xc3, 0x65, 0x8f, 0x5e,
+	0x71, 0xc5, 0xa3, 0x87, 0x87, 0xe7, 0x53, 0xcf, 0x9f, 0x58, 0x03, 0xd7, 0x6a, 0xf7, 0x69, 0x84,
+	0xcb, 0x4c, 0x82, 0xa8, 0x1f, 0xc6, 0x6b, 0x91, 0x7a, 0xd4, 0x2c, 0xcb, 0xcc, 0x53, 0x5e, 0xb5,
+	0x2c, 0x45, 0xa7, 0x3d, 0x07, 0x60, 0xde, 0x69, 0x60, 0x85, 0xa4, 0x5e, 0x5d, 0xa7, 0xe6, 0xa7,
+	0x4e, 0xc5, 0xbc, 0x53, 0x3c, 0xfc, 0x12, 0x87, 0x8b, 0xd9, 0xf8, 0x70, 0x31, 0xd6, 0xc4, 0x72,
+	0xeb, 0x35, 0x31, 0xed, 0x01, 0x94, 0x50, 0xc4, 0x1a, 0xa1, 0x81, 0x8e, 0x54, 0xf2, 0x32, 0x72,
+	0x15, 0x4b, 0xf8, 0x3f, 0x93, 0xaf, 0x0a, 0xbf, 0xe3, 0x83, 0xa8, 0x25, 0x54, 0xe6, 0x35, 0xc9,
+	0xcb, 0x11, 0xb3, 0x6a, 0x51, 0x21, 0x17, 0xda, 0xda, 0x7f, 0xce, 0x40, 0x75, 0xe0, 0x9b, 0x28,
+	0x06, 0xd0, 0x4b, 0xfc, 0x52, 0xdd, 0x10, 0xa5, 0xb8, 0xe7, 0x38, 0x46, 0xac, 0x59, 0x55, 0x58,
+	0x02, 0x50, 0xdf, 0x83, 0xfc, 0xd4, 0x31, 0x8e, 0x9a, 0x39, 0xd9, 0x66, 0x94, 0xaa, 0x8f, 0xd2,
+	0x78, 0xa0, 0xc0, 0x88, 0x54, 0xfb, 0x1d, 0xa8, 0x4a, 0xc0, 0xd4, 0xd9, 0xc2, 0x25, 0x3a, 0xcf,
+	0x1a, 0xb6, 0x95, 0x0c, 0x1e, 0x3e, 0xec, 0x76, 0x86, 0x6d, 0x6e, 0x29, 0xa2, 0xcd, 0x38, 0xd4,
+	0x1f, 0x77, 0xd9, 0x70, 0xa4, 0xe4, 0xe9, 0x80, 0x8c, 0x00, 0xbd, 0xd6, 0x10, 0x4f, 0x1a, 0x00,
+	0x8a, 0x87, 0xfd, 0xee, 0x2f, 0x0e, 0x3b, 0x8a, 0xa2, 0xfd, 0x87, 0x0c, 0x40, 0xe2, 0x02, 0x57,
+	0x7f, 0x0c, 0xd5, 0x53, 0xca, 0xe9, 0xd2, 0xd9, 0x88, 0xdc, 0x47, 0xe0, 0x68, 0xd2, 0x30, 0x7e,
+	0x22, 0x19, 0x0c, 0x28, 0x49, 0x57, 0x0f, 0x49, 0xaa, 0xf3, 0x44, 0x08, 0xab, 0xef, 0x40, 0xd9,
+	0xc3, 0x7e, 0x20, 0x69, 0x4e, 0x16, 0xa3, 0x52, 0xf7, 0x59, 0xc9, 0xf3, 0xcd, 0x48, 0xe2, 0x4e,
+	0xfd, 0xc8, 0x31, 0x14, 0x93, 0x3e, 0x46, 0x50, 0xdb, 0x31, 0x16, 0x81, 0xc5, 0x38, 0x3e, 0xe6,
+	0xac, 0x85, 0x84, 0xb3, 0x6a, 0x5f, 0x42, 0x63, 0x68, 0xcc, 0xe6, 0x9c, 0xff, 0x52, 0xc7, 0x54,
+	0xc8, 0xe3, 0xb4, 0x8b, 0xf5, 0x46, 0x69, 0xdc, 0x45, 0x07, 0x96, 0x3f, 0x41, 0xed, 0x95, 0x6f,
+	0xba, 0x28, 0x8b, 0xfc, 0xf4, 0x30, 0xb0, 0xdd, 0x23, 0xe6, 0x9d, 0x46, 0x11, 0x2a, 0x51, 0x5e,
+	0xfb, 0x07, 0x19, 0xa8, 0x4a, 0xcd, 0x50, 0x1f, 0xa4, 0xec, 0xc3, 0x57, 0x56, 0xda, 0xc9, 0xd3,
+	0x92, 0x9d, 0xf8, 0x26, 0x14, 0x82, 0xd0, 0xf0, 0xa3, 0xd3, 0x14, 0x45, 0x2a, 0xb1, 0xe3, 0x2d,
+	0x5c, 0x93, 0x71, 0x34, 0xba, 0x8a, 0x2d, 0xd7, 0x6c, 0xe6, 0x2e, 0xa0, 0x42, 0xa4, 0xb6, 0x05,
+	0x95, 0xb8, 0x7a, 0x5c, 0x02, 0x6c, 0xf0, 0x7c, 0xa8, 0x5c, 0x52, 0x2b, 0x50, 0x60, 0xad, 0xfe,
+	0x93, 0x8e, 0x92, 0xd1, 0xfe, 0x71, 0x06, 0x20, 0x29, 0xa5, 0xde, 0x4f, 0xb5, 0xf6, 0xe6, 0x72,
+	0xad, 0xf7, 0xe9, 0xaf, 0xd4, 0xd8, 0x5b, 0x50, 0x59, 0xb8, 0x04, 0xb4, 0x4c, 0x21, 0x5a, 0x12,
+	0x00, 0xc6, 0x0f, 0x44, 0xb1, 0x2c, 0x4b, 0xf1, 0x03, 0x2f, 0x0c, 0x47, 0xfb, 0x04, 0x2a, 0x71,
+	0x75, 0xe8, 0xae, 0x78, 0x3c, 0xe8, 0xf5, 0x06, 0xcf, 0xbb, 0xfd, 0x27, 0xca, 0x25, 0xcc, 0x1e,
+	0xb0, 0x4e, 0xbb, 0xb3, 0x8b, 0xd9, 0x0c, 0xae, 0xd9, 0xf6, 0x21, 0x63, 0x9d, 0xfe, 0x48, 0x67,
+	0x83, 0xe7, 0x4a, 0x56, 0xfb, 0xcb, 0x79, 0xd8, 0x1c, 0xb8, 0xbb, 0x8b, 0xb9, 0x63, 0x4f, 0x8c,
+	0xd0, 0x7a, 0x6a, 0x9d, 0xb7, 0xc3, 0x33, 0x94, 0x98, 0x46, 0x18, 0xfa, 0x7c, 0xbf, 0x56, 0x18,
+	0xcf, 0x70, 0x77, 0x5b, 0x60, 0xf9, 0x21, 0x79, 0x13, 0xe9, 0x24, 0x50, 0xb0, 0x90, 0x06, 0x87,
+	0xb7, 0x3d, 0xa7, 0x8d, 0x50, 0xf5, 0x67, 0x70, 0x95, 0xbb, 0xe8, 0x38, 0x25, 0xaa, 0x90, 0xba,
+	0x60, 0x2f, 0xcb, 0x4b, 0x57, 0xe5, 0x84, 0x58, 0x14, 0xc9, 0x10, 0x86, 0x5e, 0xa7, 0xa4, 0x38,
+	0x57, 0xf4, 0x2b, 0x0c, 0x62, 0x42, 0x6a, 0x09, 0xba, 0x94, 0xa2, 0x56, 0xeb, 0xe8, 0xce, 0x46,
+	0xe3, 0xa7, 0xc0, 0x1a, 0x5e, 0xd2, 0x19, 0x94, 0xaa, 0x9f, 0xc3, 0x66, 0x8a, 0x92, 0x5a, 0xc1,
+	0xcd, 0x9f, 0x77, 0x22, 0x6f, 0xfc, 0x52, 0xef, 0x65, 0x08, 0x36, 0x87, 0xeb, 0x77, 0x1b, 0x5e,
+	0x1a, 0x8a, 0x12, 0xc0, 0x0e, 0x74, 0xfb, 0xc8, 0xf5, 0x7c, 0x4b, 0x70, 0xf0, 0xb2, 0x1d, 0x74,
+	0x29, 0x9f, 0x58, 0x20, 0xd2, 0xe1, 0x31, 0x17, 0x18, 0xd1, 0xd9, 0x29, 0x47, 0xdb, 0x5c, 0x24,
+	0xe6, 0x59, 0x89, 0xf2, 0x5d, 0x13, 0x8d, 0x6f, 0x8e, 0x8a, 0x8c, 0x0a, 0x20, 0xa3, 0xa2, 0x46,
+	0xc0, 0x67, 0x1c, 0x76, 0xb3, 0x0f, 0x57, 0xd6, 0x35, 0x72, 0x8d, 0xea, 0xb4, 0x25, 0xab, 0x4e,
+	0x4b, 0xee, 0xa8, 0x44, 0x8d, 0xfa, 0xa7, 0x59, 0xa8, 0x74, 0xf9, 0x14, 0x86, 0x67, 0x78, 0x08,
+	0xe9, 0x5b, 0xd3, 0x8b, 0x0e, 0x6c, 0x11, 0x87, 0xde, 0x47, 0xc3, 0x34, 0x75, 0x63, 0x3a, 0xb5,
+	0x26, 0xa1, 0x65, 0xea, 0x28, 0x16, 0xc5, 0xb2, 0xdd, 0x30, 0x4c, 0xb3, 0x25, 0xe0, 0xb4, 0xfd,
+	0xb9, 0xe3, 0x21, 0xb2, 0x04, 0xa8, 0x1f, 0x62, 0xb3, 0x37, 0xec, 0x40, 0x18, 0x02, 0xa4, 0xc4,
+	0xe1, 0x91, 0x09, 0xef, 0xbb, 0x69, 0x4d, 0x05, 0x3f, 0x6a, 0xa4, 0x35, 0x6f, 0x21, 0x64, 0xb9,
+	0xcb, 0xe9, 0xf2, 0xb2, 0x9d, 0x6a, 0x9b, 0xdc, 0x87, 0x9d, 0x67, 0x9b, 0x69, 0x33, 0xb5, 0x6b,
+	0x06, 0x17, 0x3b, 0x2c, 0x8a, 0x17, 0x3a, 0x2c, 0xd2, 0x9e, 0x10, 0x5c, 0x64, 0x25, 0x5a, 0xee,
+	0x09, 0x3b, 0xee, 0x9a, 0x67, 0xda, 0x7f, 0xc9, 0xe2, 0x69, 0xd8, 0xdc, 0x31, 0x26, 0xd6, 0xff,
+	0x3b, 0xa3, 0x77, 0x07, 0x7d, 0x0e, 0x8e, 0x15, 0xe2, 0x16, 0x73, 0xcd, 0x28, 0x6c, 0x82, 0x83,
+	0xda, 0x1e, 0x31, 0xb0, 0xb5, 0xc3, 0x5b, 0xfc, 0xde, 0xc3, 0x5b, 0xfa, 0x1e, 0xc3, 0x5b, 0x5e,
+	0x37, 0xbc, 0x79, 0xa8, 0xb6, 0x5c, 0xc3, 0x39, 0xff, 0xc6, 0xa2, 0xc0, 0x08, 0x72, 0xa5, 0xcf,
+	0x17, 0x21, 0x1f, 0x35, 0x7e, 0x60, 0x59, 0x21, 0x08, 0x8d, 0xd7, 0x1d, 0xa8, 0x7a, 0x8b, 0x30,
+	0xc6, 0xf3, 0x23, 0x4c, 0xe0, 0x20, 0x22, 0x88, 0xcb, 0x93, 0x5a, 0x97, 0x93, 0xca, 0x93, 0x8a,
+	0x9f, 0x94, 0x8f, 0xd5, 0xbe, 0xb8, 0x3c, 0x11, 0xe0, 0x06, 0xb5, 0x67, 0x34, 0x6e, 0xc1, 0x62,
+	0x66, 0xf1, 0xb1, 0xcb, 0xf1, 0x00, 0xb4, 0xb6, 0x80, 0x61, 0x2d, 0x33, 0x6b, 0xe6, 0xf9, 0xe7,
+	0xbc, 0x96, 0x22, 0xaf, 0x85, 0x83, 0xa8, 0x96, 0x77, 0x40, 0x3d, 0x35, 0xec, 0x50, 0x4f, 0x57,
+	0xc5, 0x55, 0x6d, 0x05, 0x31, 0x23, 0xb9, 0xba, 0x6b, 0x50, 0x34, 0xed, 0xe0, 0xa4, 0x3b, 0x10,
+	0x6a, 0xb6, 0xc8, 0x21, 0x0f, 0x0a, 0x1e, 0x76, 0x07, 0xfa, 0xf8, 0x5c, 0x9c, 0x31, 0xe6, 0x58,
+	0x19, 0x01, 0x3b, 0xe7, 0x21, 0x9d, 0x8e, 0x10, 0x92, 0xf7, 0x96, 0xb3, 0x6b, 0xae, 0x4a, 0x37,
+	0x10, 0xde, 0x45, 0x30, 0x67, 0xd7, 0xf7, 0x60, 0x93, 0x28, 0x45, 0xc7, 0x39, 0x69, 0x95, 0x48,
+	0x37, 0x10, 0x31, 0x58, 0x84, 0x31, 0xed, 0x2d, 0xa8, 0xb8, 0x56, 0x78, 0xea, 0xf9, 0xd8, 0x9a,
+	0x1a, 0x1f, 0xbd, 0x18, 0x80, 0x02, 0x3d, 0x98, 0x18, 0x2e, 0x36, 0xbe, 0x59, 0x17, 0xed, 0x11,
+	0x79, 0xd4, 0x79, 0xb9, 0x98, 0x20, 0x6c, 0x83, 0x0f, 0x49, 0x02, 0x51, 0x3f, 0x86, 0x1b, 0xa9,
+	0xd1, 0xd0, 0x0d, 0xdf, 0x37, 0xce, 0xf5, 0x99, 0xf1, 0x95, 0xe7, 0x93, 0x77, 0x22, 0xc7, 0xae,
+	0xc9, 0x83, 0xdc, 0x42, 0xf4, 0x3e, 0x62, 0x2f, 0x2c, 0x6a, 0xbb, 0x1e, 0x1e, 0x5b, 0x5e, 0x50,
+	0x14, 0xb1, 0x9a, 0x2f, 0x39, 0xa2, 0x0f, 0xfc, 0x85, 0x6b, 0x71, 0xd3, 0x9d, 0x92, 0xa6, 0x38,
+	0xc7, 0x8b, 0xf3, 0xea, 0x2e, 0x5c, 0xe6, 0x6a, 0xbc, 0x65, 0xea, 0x92, 0x83, 0x36, 0x7b, 0xb1,
+	0x83, 0x56, 0x8d, 0xe8, 0x63, 0x70, 0xa0, 0x7d, 0x9b, 0x81, 0x9b, 0x03, 0x3a, 0x53, 0xa4, 0xcd,
+	0xb0, 0x6f, 0x05, 0x81, 0x71, 0x84, 0x36, 0xd8, 0xe3, 0xc5, 0x37, 0xdf, 0xa0, 0x05, 0xbf, 0x71,
+	0x60, 0xf8, 0x96, 0x1b, 0xc6, 0x5b, 0x45, 0x70, 0xf4, 0x65, 0xb0, 0xfa, 0x88, 0x9c, 0xa0, 0x96,
+	0x1b, 0x1e, 0xc6, 0xb2, 0xb1, 0x99, 0x5d, 0xe3, 0x16, 0x5b, 0xa1, 0xd2, 0xfe, 0xf9, 0x2d, 0xc8,
+	0xf7, 0x3d, 0xd3, 0x52, 0xdf, 0x85, 0x0a, 0xc5, 0x96, 0xad, 0xfa, 0xde, 0x11, 0x4d, 0x7f, 0x48,
+	0x4d, 0x29, 0xbb, 0x22, 0x75, 0x71, 0x34, 0xda, 0x6b, 0xa4, 0x70, 0xd1, 0xe1, 0x1d, 0x32, 0x9f,
+	0xaa, 0xb0, 0xf2, 0x10, 0xc4, 0x38, 0x06, 0xc7, 0x96, 0x1c, 0x52, 0xbe, 0xe5, 0x92, 0x58, 0x2f,
+	0xb0, 0x38, 0x4f, 0x6a, 0xae, 0xef, 0x21, 0xa3, 0xd4, 0x29, 0x50, 0xa3, 0xb0, 0x46, 0xcd, 0xe5,
+	0x78, 0x0a, 0xcf, 0x7b, 0x17, 0x2a, 0x5f, 0x79, 0xb6, 0xcb, 0x1b, 0x5e, 0x5c, 0x69, 0xf8, 0x67,
+	0x9e, 0xcd, 0x0f, 0x0d, 0xca, 0x5f, 0x89, 0x94, 0xfa, 0x3a, 0x94, 0x3c, 0x97, 0xd7, 0x5d, 0x5a,
+	0xa9, 0xbb, 0xe8, 0xb9, 0x3d, 0x1e, 0x00, 0x52, 0x1f, 0x2f, 0xd0, 0x65, 0x86, 0xa4, 0xd6, 0x34,
+	0x14, 0x3e, 0xf2, 0x2a, 0x01, 0x07, 0x6e, 0xcf, 0x9a, 0xe2, 0xd1, 0x7e, 0x75, 0x6a, 0x3b, 0xc8,
+	0x8f, 0xa9, 0xb2, 0xca, 0x4a, 0x65, 0xc0, 0xd1, 0x54, 0xe1, 0x8f, 0xa0, 0x7c, 0xe4, 0x7b, 0x8b,
+	0x39, 0xaa, 0xe3, 0xb0, 0x42, 0x59, 0x22, 0xdc, 0xce, 0x39, 0xf6, 0x9e, 0x92, 0xb6, 0x7b, 0xa4,
+	0xa3, 0xcb, 0xa6, 0xba, 0xda, 0xfb, 0x08, 0x3f, 0xb4, 0xa8, 0x56, 0xe3, 0xe8, 0x48, 0x17, 0x11,
+	0x2d, 0x2b, 0xb5, 0x1a, 0x47, 0x47, 0xf4, 0xf1, 0xfb, 0x50, 0x3f, 0xc5, 0xe3, 0xec, 0xb9, 0x35,
+	0xe1, 0xb4, 0xf5, 0xd5, 0x6a, 0x4f, 0x6d, 0x17, 0x55, 0x77, 0xa2, 0x97, 0x6d, 0x87, 0xc6, 0x4b,
+	0x6d, 0x87, 0x2d, 0x28, 0x38, 0xf6, 0xcc, 0x0e, 0x29, 0x64, 0x60, 0x49, 0xb9, 0x20, 0x84, 0xaa,
+	0x41, 0x51, 0xb8, 0xa0, 0x94, 0x15, 0x12, 0x81, 0x49, 0xcb, 0xad, 0xcd, 0x97, 0xc8, 0xad, 0xbb,
+	0x80, 0x31, 0x78, 0x3a, 0x4a, 0x58, 0x75, 0xbd, 0x84, 0x2d, 0x7a, 0xe3, 0xaf, 0x30, 0xd4, 0xf0,
+	0x03, 0xf2, 0xd3, 0x5b, 0x6e, 0xa8, 0x47, 0x05, 0x2e, 0xaf, 0x2f, 0x50, 0xe3, 0x64, 0x03, 0x5e,
+	0xec, 0x3d, 0xa8, 0xfa, 0x64, 0xb7, 0xea, 0x64, 0xe4, 0x5e, 0x91, 0xad, 0x82, 0xc4, 0xa0, 0x65,
+	0xe0, 0xc7, 0x69, 0x94, 0x08, 0xfc, 0xec, 0x9f, 0x1f, 0xf6, 0x06, 0xe4, 0xea, 0xac, 0xb0, 0x1a,
+	0x01, 0xf9, 0x41, 0x70, 0x80, 0x27, 0x64, 0x91, 0xc0, 0x0d, 0xcf, 0x9a, 0xd7, 0xe5, 0xa6, 0xf0,
+	0xb3, 0xce, 0x76, 0x78, 0xc6, 0x2a, 0x66, 0x94, 0x44, 0x6f, 0xd4, 0xd8, 0x76, 0x4d, 0x5c, 0x0e,
+	0xa1, 0x71, 0x14, 0x34, 0x9b, 0xb4, 0x5b, 0xaa, 0x02, 0x36, 0x32, 0x8e, 0x02, 0xf5, 0x7d, 0xa8,
+	0x19, 0x5c, 0x30, 0xf2, 0xd8, 0xc2, 0x1b, 0xb2, 0x05, 0x27, 0x89, 0x4c, 0x56, 0x35, 0x92, 0x8c,
+	0xfa, 0x11, 0xa8, 0x91, 0x7f, 0x9b, 0xb4, 0x61, 0xbe, 0x2e, 0x6e, 0xae, 0xac, 0x8b, 0x0d, 0xe1,
+	0xe0, 0x8e, 0xe3, 0x61, 0x3f, 0x82, 0x7a, 0x5a, 0x0d, 0xb9, 0xb5, 0xc6, 0xa3, 0x4b, 0x53, 0xc6,
+	0x6a, 0x13, 0x29, 0x87, 0xe3, 0x83, 0x71, 0x36, 0x13, 0x63, 0x72, 0x6c, 0x51, 0x41, 0xee, 0xb5,
+	0xac, 0xb9, 0x5e, 0xd8, 0x8e, 0x60, 0x38, 0x3e, 0x91, 0x71, 0x11, 0x9e, 0x35, 0x6f, 0xcb, 0xe3,
+	0x13, 0x6b, 0xa6, 0x28, 0xa7, 0x45, 0x92, 0xe6, 0x89, 0x2b, 0x5d, 0x54, 0xe0, 0x4e, 0x6a, 0x9e,
+	0x62, 0x6d, 0x8c, 0x81, 0x1f, 0xa7, 0x29, 0xe0, 0xd3, 0x5b, 0xf8, 0x13, 0x4b, 0x0f, 0x42, 0x6b,
+	0xde, 0xdc, 0xa2, 0x11, 0x05, 0x0e, 0x1a, 0x86, 0xd6, 0x5c, 0x7d, 0x04, 0x8d, 0xb9, 0x6f, 0xe9,
+	0xd2, 0x3c, 0xbd, 0x26, 0x77, 0xf1, 0xc0, 0xb7, 0x92, 0xa9, 0xaa, 0xcd, 0xa5, 0x5c, 0x54, 0x52,
+	0xea, 0x81, 0xb6, 0x54, 0x32, 0xe9, 0x44, 0x6d, 0x2e, 0xe5, 0xd4, 0x4f, 0x61, 0x53, 0x2a, 0xb9,
+	0x38, 0xa1, 0xc2, 0xaf, 0xa7, 0x1c, 0xec, 0x11, 0xf9, 0xe1, 0x09, 0x16, 0x6f, 0xcc, 0x53, 0x79,
+	0xb5, 0xb5, 0x64, 0x0b, 0xa1, 0x01, 0xf0, 0x06, 0x95, 0xbf, 0x7e, 0x81, 0x81, 0x93, 0x32, 0x92,
+	0x9e, 0x72, 0xff, 0x6a, 0x37, 0xe8, 0xb8, 0x66, 0xf3, 0x47, 0x3c, 0x68, 0x9d, 0x32, 0xea, 0x43,
+	0xa8, 0x91, 0x13, 0x2d, 0xa4, 0x40, 0xba, 0xa0, 0xf9, 0xa6, 0xec, 0xef, 0x21, 0x8f, 0x34, 0x21,
+	0x58, 0xd5, 0x89, 0xd3, 0x81, 0xfa, 0x21, 0x6c, 0x72, 0xd7, 0x9b, 0xcc, 0x20, 0xdf, 0x5a, 0x5d,
+	0x5c, 0x44, 0xf4, 0x38, 0xe1, 0x92, 0x0c, 0x6e, 0xf8, 0x0b, 0x97, 0x84, 0xb8, 0x28, 0x39, 0xf7,
+	0xbd, 0xb1, 0xc5, 0xcb, 0xdf, 0xdd, 0xca, 0x25, 0xdd, 0x61, 0x9c, 0x8c, 0x97, 0x25, 0x7e, 0x74,
+	0xcd, 0x97, 0x41, 0x07, 0x58, 0xee, 0x82, 0x3a, 0x39, 0x67, 0xa7, 0x3a, 0xdf, 0xfe, 0x3e, 0x75,
+	0xee, 0x60, 0x39, 0xaa, 0x53, 0x85, 0xfc, 0x62, 0x61, 0x9b, 0xcd, 0x7b, 0x3c, 0xc4, 0x0e, 0xd3,
+	0x78, 0x22, 0xe8, 0x5b, 0x93, 0x85, 0x1f, 0xd8, 0x2f, 0x2c, 0x3d, 0xb0, 0xdd, 0x93, 0xe6, 0x8f,
+	0x69, 0x1c, 0xeb, 0x31, 0x74, 0x68, 0xbb, 0x27, 0xb8, 0x62, 0xad, 0xb3, 0xd0, 0xf2, 0x5d, 0x1d,
+	0x55, 0xa2, 0xe6, 0x3b, 0xf2, 0x8a, 0xed, 0x10, 0x62, 0x38, 0x31, 0x5c, 0x06, 0x56, 0x9c, 0x56,
+	0x7f, 0x06, 0x1b, 0x89, 0x82, 0x3c, 0x47, 0x15, 0xa4, 0xf9, 0x93, 0xb5, 0x67, 0x2f, 0xa4, 0x9e,
+	0xb0, 0xc6, 0x3c, 0x95, 0x5f, 0x5a, 0x5b, 0x01, 0x5f, 0x5b, 0xf7, 0xbf, 0xd3, 0xda, 0x1a, 0x62,
+	0x5e, 0x7d, 0x13, 0xca, 0xb6, 0x1b, 0x5a, 0x3e, 0x3a, 0x1f, 0x1e, 0xac, 0x30, 0xf0, 0x18, 0x87,
+	0x07, 0xaf, 0x81, 0x63, 0x23, 0x63, 0x6a, 0xbe, 0xbb, 0x42, 0x16, 0xa1, 0x50, 0x62, 0x4f, 0x6d,
+	0xc7, 0xe1, 0x12, 0xfb, 0xbd, 0x15, 0x89, 0xfd, 0xd8, 0x76, 0x1c, 0x2e, 0xb1, 0xa7, 0x22, 0x85,
+	0x52, 0x8e, 0x4a, 0xe0, 0xf7, 0xb7, 0x57, 0xa5, 0x1c, 0xe2, 0x9e, 0xd1, 0x2d, 0x94, 0x6a, 0x40,
+	0x6e, 0x28, 0xee, 0x4d, 0x7b, 0x28, 0xf7, 0x30, 0xed, 0x9f, 0x62, 0x10, 0xc4, 0x79, 0xb4, 0x04,
+	0x84, 0x13, 0x0e, 0x6d, 0x8f, 0xf7, 0x79, 0x70, 0x34, 0x87, 0xa0, 0xeb, 0xe0, 0x5d, 0xa8, 0x47,
+	0xb1, 0x24, 0xf8, 0xb9, 0xa0, 0xf9, 0xc1, 0x4a, 0x0b, 0xd2, 0x04, 0xea, 0x2e, 0xd4, 0xa6, 0xa8,
+	0xc1, 0xcd, 0xb8, 0x42, 0xd7, 0xfc, 0x90, 0x1a, 0xb2, 0x15, 0x49, 0xd0, 0x8b, 0x14, 0x3e, 0x96,
+	0x2a, 0xa5, 0x3e, 0x84, 0x7a, 0x60, 0xb9, 0x26, 0x9e, 0xbc, 0xf3, 0xa5, 0xfa, 0xd1, 0x56, 0x2e,
+	0x61, 0x86, 0xf1, 0x9d, 0x2a, 0x74, 0x28, 0xbb, 0xe6, 0x7e, 0xc0, 0x05, 0xfd, 0x43, 0xc0, 0xd5,
+	0xf6, 0x22, 0x29, 0xf4, 0xe8, 0x82, 0x42, 0x48, 0x15, 0x15, 0x7a, 0x07, 0xa3, 0xec, 0x0d, 0x77,
+	0x34, 0x6c, 0x7e, 0x2c, 0x86, 0x2c, 0xb9, 0x7e, 0x36, 0x8a, 0x52, 0x4c, 0xd0, 0xa8, 0xef, 0x42,
+	0x95, 0x47, 0x1c, 0x1d, 0xdb, 0x6e, 0x18, 0x34, 0x3f, 0x91, 0x3f, 0x40, 0xc7, 0x37, 0x7b, 0xb6,
+	0x1b, 0x32, 0xb0, 0xa3, 0x24, 0xd9, 0x77, 0x48, 0xab, 0x9f, 0x1a, 0xbe, 0x6b, 0xbb, 0x47, 0x41,
+	0xf3, 0xb7, 0xc8, 0x16, 0xac, 0x21, 0xf0, 0xb9, 0x80, 0xe1, 0x41, 0xb9, 0x83, 0x7c, 0x6b, 0x66,
+	0x84, 0x96, 0x6f, 0x1b, 0x0e, 0xda, 0x4b, 0x3f, 0xe5, 0xb6, 0x30, 0xc2, 0xf7, 0x13, 0xb0, 0xf6,
+	0xcb, 0x02, 0x94, 0x23, 0x85, 0x14, 0x63, 0x78, 0x0e, 0xfb, 0x4f, 0xfb, 0x83, 0xe7, 0x7d, 0xe5,
+	0x12, 0xba, 0x5e, 0x29, 0x26, 0x5b, 0x1f, 0xb6, 0x5b, 0x7d, 0x7e, 0x57, 0x81, 0x22, 0xc1, 0x79,
+	0x3e, 0xab, 0x6e, 0x42, 0xfd, 0xf1, 0x61, 0x9f, 0x62, 0x78, 0x38, 0x28, 0x87, 0xa0, 0xce, 0xe7,
+	0xdc, 0xbf, 0xcb, 0x41, 0x18, 0xbd, 0x5d, 0xdf, 0x6f, 0x8d, 0x3a, 0xac, 0x1b, 0x81, 0x0a, 0x14,
+	0x0e, 0x34, 0x38, 0x64, 0x6d, 0x51, 0x53, 0x11, 0x3f, 0x7b, 0xc0, 0x06, 0x9f, 0x75, 0xda, 0x23,
+	0x05, 0xd4, 0xab, 0xb0, 0x19, 0xd7, 0x11, 0xd5, 0xaf, 0x54, 0xd1, 0x75, 0x1c, 0xd5, 0xa3, 0x5c,
+	0xc1, 0x5a, 0x59, 0xa7, 0x7d, 0xc8, 0x86, 0xdd, 0x67, 0x1d, 0xbd, 0x3d, 0xea, 0x28, 0x57, 0xd1,
+	0x83, 0x38, 0xec, 0xf6, 0x9f, 0x2a, 0xd7, 0xd0, 0x3f, 0x87, 0x29, 0x5e, 0xfb, 0x75, 0x55, 0x85,
+	0x46, 0x42, 0x4b, 0xb0, 0x26, 0xb9, 0x9e, 0x9f, 0x3c, 0x51, 0x6e, 0x63, 0xb5, 0xbb, 0xdd, 0xe1,
+	0xa8, 0xdb, 0x6f, 0x8f, 0x94, 0x3b, 0xe8, 0x5d, 0x7e, 0xdc, 0xed, 0x8d, 0x3a, 0x4c, 0xd9, 0xc2,
+	0xfa, 0x3e, 0x1b, 0x74, 0xfb, 0xca, 0x6b, 0x08, 0x1d, 0xb6, 0xf6, 0x0f, 0x7a, 0x1d, 0x45, 0xa3,
+	0xaf, 0x0c, 0xd8, 0x48, 0x79, 0x1d, 0xfd, 0x94, 0x87, 0x7d, 0x6c, 0xdb, 0x1b, 0xf8, 0x41, 0x4a,
+	0xea, 0x78, 0x3d, 0xe3, 0x47, 0x92, 0x8f, 0xfa, 0x4d, 0x4c, 0x3f, 0xef, 0xf6, 0x77, 0x07, 0xcf,
+	0x95, 0xb7, 0x90, 0x6c, 0x87, 0x0d, 0x5a, 0xbb, 0x6d, 0x74, 0x65, 0xdf, 0xc5, 0x0a, 0x86, 0x07,
+	0xbd, 0xee, 0x48, 0x79, 0x1b, 0xa9, 0x9e, 0xb4, 0x46, 0x7b, 0x1d, 0xa6, 0xdc, 0xc3, 0x74, 0x6b,
+	0x38, 0xec, 0xb0, 0x91, 0xb2, 0x8d, 0xe9, 0x6e, 0x9f, 0xd2, 0x0f, 0x31, 0xbd, 0xdb, 0xe9, 0x75,
+	0x46, 0x1d, 0xe5, 0x7d, 0x1c, 0x30, 0xd6, 0x39, 0xe8, 0xb5, 0xda, 0x1d, 0xe5, 0x03, 0xcc, 0xf4,
+	0x06, 0xed, 0xa7, 0xfa, 0xe0, 0x40, 0xf9, 0x10, 0xbf, 0x41, 0x1e, 0xf6, 0x21, 0x0e, 0xe6, 0x47,
+	0x38, 0x4e, 0x71, 0x96, 0x5a, 0xf7, 0x08, 0x3f, 0xbb, 0xdf, 0xed, 0x1f, 0x0e, 0x95, 0x8f, 0x91,
+	0x98, 0x92, 0x84, 0xf9, 0x44, 0xbd, 0x02, 0xca, 0xa0, 0xaf, 0xef, 0x1e, 0x1e, 0xf4, 0xba, 0xed,
+	0xd6, 0xa8, 0xa3, 0x3f, 0xed, 0x7c, 0xa1, 0xfc, 0x16, 0x4e, 0xfb, 0x01, 0xeb, 0xe8, 0xa2, 0x1d,
+	0x3f, 0x8d, 0xf2, 0xa2, 0x2d, 0x3f, 0xc3, 0x4f, 0x24, 0x78, 0xfd, 0xf0, 0xa9, 0xf2, 0xdb, 0x4b,
+	0xa0, 0xe1, 0x53, 0xe5, 0x53, 0x9c, 0xf3, 0x51, 0x77, 0xbf, 0xa3, 0x8b, 0xc1, 0xc0, 0xf8, 0xff,
+	0xfc, 0xe3, 0x6e, 0xaf, 0xa7, 0xb4, 0xc8, 0x9d, 0xda, 0x62, 0xa3, 0x2e, 0x4d, 0xf4, 0x0e, 0xde,
+	0x25, 0x78, 0x7c, 0xf8, 0xe5, 0x97, 0x5f, 0xe8, 0x62, 0x26, 0xda, 0xda, 0x02, 0xca, 0x91, 0xe5,
+	0x81, 0xad, 0xef, 0xf6, 0xfb, 0x1d, 0xbc, 0x47, 0x53, 0x86, 0x7c, 0xaf, 0xf3, 0x78, 0xa4, 0x64,
+	0x10, 0xc8, 0xba, 0x4f, 0xf6, 0x46, 0x4a, 0x16, 0x93, 0x83, 0x43, 0x2c, 0x96, 0xa3, 0xa9, 0xea,
+	0xec, 0x77, 0x95, 0x3c, 0xa6, 0x5a, 0xfd, 0x51, 0x57, 0x29, 0xd0, 0x54, 0x76, 0xfb, 0x4f, 0x7a,
+	0x1d, 0xa5, 0x88, 0xd0, 0xfd, 0x16, 0x7b, 0xaa, 0x94, 0xb0, 0x50, 0xeb, 0xe0, 0xa0, 0xf7, 0x85,
+	0x52, 0xe6, 0xf5, 0xef, 0x76, 0x3e, 0x57, 0x2a, 0xda, 0x5d, 0x28, 0xb5, 0x8e, 0x8e, 0xf6, 0xd1,
+	0xa0, 0xc3, 0xc6, 0x62, 0x28, 0x1b, 0x5d, 0xde, 0xd9, 0x19, 0x8c, 0x46, 0x83, 0x7d, 0x25, 0x83,
+	0x8b, 0x68, 0x34, 0x38, 0x50, 0xb2, 0x5a, 0x17, 0xca, 0x11, 0xa3, 0x95, 0x2e, 0x52, 0x94, 0x21,
+	0x7f, 0xc0, 0x3a, 0xcf, 0xf8, 0xf9, 0x46, 0xbf, 0xf3, 0x39, 0x36, 0x0f, 0x53, 0x58, 0x51, 0x0e,
+	0x3f, 0xc4, 0x6f, 0x3c, 0xd0, 0x4d, 0x8a, 0x5e, 0xb7, 0xdf, 0x69, 0x31, 0xa5, 0x80, 0x17, 0x9a,
+	0x2a, 0xf1, 0xc6, 0x57, 0xdf, 0x49, 0x79, 0xbc, 0x9b, 0x4b, 0x7c, 0xe1, 0x3e, 0xfe, 0x91, 0xfc,
+	0xdd, 0x0f, 0xf0, 0xa6, 0xa9, 0x27, 0x2e, 0x7d, 0x36, 0xb6, 0x6f, 0xac, 0x23, 0x1f, 0x22, 0x01,
+	0xe3, 0x74, 0xa8, 0xbc, 0x25, 0x81, 0xa3, 0x51, 0x7c, 0x28, 0xc4, 0x91, 0xa3, 0x01, 0x5e, 0xdd,
+	0x8a, 0xbe, 0x81, 0xbd, 0x3d, 0x1c, 0x76, 0xf8, 0x10, 0x74, 0x9f, 0xf4, 0x07, 0xac, 0xc3, 0x47,
+	0xfe, 0xf1, 0x80, 0xb5, 0x3b, 0x4a, 0x16, 0x3d, 0xe6, 0xf1, 0x07, 0xa2, 0xab, 0x4a, 0x97, 0xe2,
+	0x5d, 0x44, 0x21, 0x81, 0x74, 0xa5, 0x43, 0xdf, 0xf9, 0x82, 0xdf, 0x25, 0x79, 0xc2, 0x06, 0x87,
+	0x07, 0x98, 0xcb, 0x69, 0xff, 0x2e, 0x03, 0x90, 0x88, 0x6b, 0x54, 0x08, 0xe2, 0x6e, 0x17, 0x44,
+	0xe7, 0xe4, 0x38, 0xfc, 0x0a, 0x3f, 0x0f, 0x43, 0x17, 0xce, 0xd4, 0xf3, 0x67, 0x46, 0x18, 0xdd,
+	0xb4, 0xe1, 0x39, 0xe4, 0x91, 0xdc, 0x87, 0x8c, 0x7a, 0x89, 0x6b, 0xf1, 0x70, 0xb2, 0x3c, 0xab,
+	0x09, 0x60, 0x0f, 0x61, 0xd8, 0x79, 0xcb, 0x9d, 0x38, 0x5e, 0x60, 0x99, 0x68, 0x99, 0x15, 0x48,
+	0xf9, 0x80, 0x08, 0xb4, 0x43, 0xe7, 0x89, 0xa1, 0xe5, 0xcf, 0x6c, 0xd7, 0x08, 0x2d, 0x53, 0xc4,
+	0xb4, 0x48, 0x10, 0x74, 0x14, 0xe1, 0xfd, 0x47, 0x2e, 0x7a, 0x79, 0x24, 0x4f, 0x19, 0x01, 0x74,
+	0x31, 0xed, 0x0f, 0x73, 0x00, 0x89, 0x3e, 0x97, 0x72, 0x4e, 0x67, 0xd2, 0xce, 0xe9, 0x6d, 0xb8,
+	0x26, 0xc2, 0xc8, 0x45, 0x6c, 0xf2, 0x99, 0x6e, 0xbb, 0xfa, 0xd8, 0x88, 0xce, 0x01, 0x54, 0x81,
+	0xe5, 0x47, 0xda, 0x5d, 0x77, 0xc7, 0x08, 0xd5, 0x6d, 0xd8, 0x90, 0xcb, 0x60, 0x54, 0x7e, 0x6e,
+	0x39, 0x2a, 0x9f, 0xd5, 0x93, 0x82, 0xa3, 0xf3, 0xb9, 0xfa, 0x2e, 0x5c, 0xf5, 0xad, 0xa9, 0x6f,
+	0x05, 0xc7, 0x7a, 0x18, 0xc8, 0x9f, 0xe1, 0x27, 0xe7, 0x9b, 0x02, 0x39, 0x0a, 0xe2, 0xaf, 0xbc,
+	0x0b, 0x57, 0x85, 0x8e, 0xb7, 0xd4, 0x30, 0x7e, 0xc9, 0x6d, 0x93, 0x23, 0xe5, 0x76, 0xbd, 0x0a,
+	0x20, 0xd4, 0xdb, 0xe8, 0x6a, 0x73, 0x99, 0x55, 0xb8, 0x2a, 0x8b, 0xf6, 0xc8, 0x3b, 0xa0, 0xda,
+	0x81, 0xbe, 0xe4, 0xd2, 0x14, 0x7e, 0x7e, 0xc5, 0x0e, 0x0e, 0x52, 0xee, 0xcc, 0x8b, 0xbc, 0xa5,
+	0xe5, 0x8b, 0xbc, 0xa5, 0x57, 0xa0, 0x40, 0x1a, 0x30, 0x39, 0xed, 0xca, 0x8c, 0x67, 0x54, 0x0d,
+	0xf2, 0xb8, 0x85, 0xc9, 0x4b, 0xd7, 0xd8, 0x6e, 0xdc, 0x47, 0x20, 0x69, 0xda, 0x08, 0x65, 0x84,
+	0xd3, 0xfe, 0x46, 0x06, 0x1a, 0x69, 0xad, 0x8d, 0x47, 0x87, 0x25, 0x61, 0x6f, 0x85, 0x24, 0xd4,
+	0xed, 0x15, 0xa8, 0xcc, 0x4f, 0x44, 0x8c, 0x9b, 0x98, 0xa2, 0xf2, 0xfc, 0x84, 0xc7, 0xb6, 0xa1,
+	0x3b, 0x64, 0x7e, 0xc2, 0x57, 0xc4, 0xea, 0x84, 0x14, 0xe7, 0x27, 0x91, 0xcf, 0x64, 0x21, 0x88,
+	0xf2, 0xab, 0x44, 0x0b, 0x22, 0xd2, 0xb6, 0xa0, 0x26, 0xdb, 0x46, 0x78, 0x0c, 0x81, 0x1a, 0x15,
+	0x6f, 0x0c, 0x26, 0xb5, 0xbf, 0x93, 0x81, 0x5a, 0xdc, 0xea, 0xef, 0xe8, 0x25, 0x4f, 0xf9, 0x05,
+	0xb2, 0x2f, 0xf1, 0x0b, 0x6c, 0xd1, 0x81, 0xb9, 0x4e, 0x91, 0x2f, 0x18, 0x2e, 0xcb, 0x5d, 0xe4,
+	0x70, 0x6c, 0x04, 0xad, 0x45, 0xe8, 0xb5, 0x3d, 0x47, 0x9c, 0xd7, 0x88, 0x50, 0xe2, 0x7c, 0xe4,
+	0xd7, 0x13, 0xb1, 0xc2, 0x7f, 0x35, 0x03, 0x9b, 0x2b, 0x46, 0x00, 0xf6, 0x23, 0xb9, 0xb1, 0x8e,
+	0x49, 0xb4, 0xca, 0x67, 0x46, 0x38, 0x39, 0xd6, 0xe7, 0xbe, 0x35, 0xb5, 0xcf, 0xa2, 0x6b, 0xf7,
+	0x04, 0x3b, 0x20, 0x10, 0x1d, 0x5e, 0xcd, 0xe7, 0x64, 0xfa, 0xa0, 0x6b, 0x84, 0x5f, 0x2f, 0x05,
+	0x02, 0xf5, 0x10, 0x12, 0x1f, 0x6c, 0xe7, 0x2f, 0x38, 0x6a, 0xbf, 0x05, 0xc5, 0x6e, 0x6c, 0x6c,
+	0xc4, 0x37, 0x50, 0x73, 0xe2, 0xd6, 0xa9, 0x07, 0x95, 0x36, 0xdd, 0x60, 0xdd, 0x37, 0xe6, 0xea,
+	0x3d, 0xbc, 0xad, 0x34, 0x17, 0xa7, 0xea, 0xcd, 0xd8, 0xe5, 0xc7, 0xb1, 0xf7, 0xf7, 0x8d, 0x39,
+	0x3f, 0xbb, 0x42, 0xa2, 0x9b, 0x1f, 0x42, 0x39, 0x02, 0x7c, 0xaf, 0x10, 0x9b, 0xff, 0x9a, 0x83,
+	0xca, 0xae, 0xec, 0x96, 0x98, 0x18, 0xae, 0x1e, 0xfa, 0x0b, 0x17, 0xad, 0x47, 0xe1, 0x20, 0xad,
+	0xa2, 0x86, 0x28, 0x40, 0xd1, 0xd4, 0x66, 0x7f, 0xcd, 0xd4, 0xde, 0x02, 0xf4, 0x9f, 0xe8, 0xb6,
+	0x49, 0x9a, 0x37, 0x1f, 0x22, 0xbc, 0x97, 0xda, 0x35, 0x51, 0xf1, 0x5e, 0x7b, 0x3c, 0x92, 0xff,
+	0xee, 0xc7, 0x23, 0x85, 0xb5, 0xc7, 0x23, 0xff, 0xb7, 0x1c, 0x68, 0xa8, 0x6f, 0x26, 0x0c, 0x11,
+	0x83, 0xbb, 0x91, 0xac, 0x42, 0x64, 0x11, 0x13, 0x7c, 0x6a, 0x9d, 0x23, 0xdd, 0x27, 0xd0, 0x88,
+	0x86, 0x59, 0x74, 0x0c, 0x52, 0xe1, 0x88, 0x02, 0x47, 0x9f, 0x67, 0xf5, 0x50, 0xce, 0xa6, 0xf7,
+	0x4e, 0xf5, 0xd7, 0xef, 0x1d, 0xed, 0x7f, 0x66, 0xa1, 0xf0, 0x0b, 0xbc, 0x77, 0xa7, 0x7e, 0x08,
+	0x95, 0x20, 0x9c, 0x85, 0xb2, 0x33, 0x58, 0x48, 0x66, 0xc2, 0x93, 0x2f, 0xd7, 0xc2, 0xb8, 0x53,
+	0x6e, 0xa7, 0x21, 0x2d, 0xa6, 0x70, 0xf5, 0xa0, 0x4b, 0x85, 0x3b, 0x9f, 0x0b, 0x8c, 0x67, 0xd0,
+	0x3d, 0x88, 0x9e, 0xe1, 0x20, 0x7d, 0xea, 0x8b, 0x0a, 0x3c, 0xe3, 0x08, 0x74, 0x0f, 0x8a, 0x9b,
+	0x0b, 0xf9, 0x55, 0x87, 0x2c, 0xc7, 0x50, 0xcc, 0x95, 0x65, 0x98, 0x64, 0x3f, 0xf0, 0x0b, 0x2a,
+	0x71, 0x1e, 0x39, 0x9f, 0xe3, 0x19, 0xe6, 0xc8, 0x38, 0x8a, 0x2e, 0x70, 0x89, 0x2c, 0x0a, 0x44,
+	0xd3, 0x0a, 0xad, 0x49, 0x38, 0xfc, 0xda, 0x89, 0xa6, 0x4c, 0x82, 0xe0, 0x0e, 0x30, 0xbd, 0xb9,
+	0x98, 0x1f, 0x4c, 0x6a, 0x26, 0xd4, 0x53, 0xdd, 0x4b, 0x1b, 0x18, 0xa8, 0x8c, 0x75, 0x7a, 0xa8,
+	0xa8, 0x66, 0x24, 0x4d, 0x37, 0x2b, 0x6b, 0xb7, 0x39, 0x49, 0xed, 0x25, 0x45, 0xe9, 0xf0, 0x60,
+	0xb7, 0x35, 0xea, 0x28, 0x05, 0x52, 0x63, 0x3b, 0xec, 0x49, 0x47, 0x29, 0x6a, 0x7f, 0x90, 0x85,
+	0xcd, 0x91, 0x6f, 0xb8, 0x81, 0xc1, 0xa3, 0x8c, 0xdd, 0xd0, 0xf7, 0x1c, 0xf5, 0x13, 0x28, 0x87,
+	0x13, 0x47, 0x1e, 0xf6, 0x3b, 0xd1, 0x24, 0x2f, 0x91, 0xde, 0x1f, 0x4d, 0xb8, 0x91, 0x5c, 0x0a,
+	0x79, 0x42, 0xfd, 0x09, 0x14, 0xc6, 0xd6, 0x91, 0xed, 0x8a, 0x0d, 0x77, 0x75, 0xb9, 0xe0, 0x0e,
+	0x22, 0xf1, 0xcd, 0x0a, 0xa2, 0x52, 0xdf, 0xc5, 0x1b, 0x78, 0xb3, 0x88, 0x33, 0x25, 0x01, 0x91,
+	0xd2, 0x87, 0x10, 0x8b, 0xef, 0x52, 0x70, 0x3a, 0xf5, 0x43, 0xbc, 0x32, 0xee, 0x38, 0x63, 0x63,
+	0x72, 0x22, 0x78, 0x56, 0x73, 0xb9, 0x0c, 0x13, 0xf8, 0xbd, 0x4b, 0x2c, 0xa6, 0xd5, 0xee, 0x43,
+	0x49, 0x34, 0x16, 0x07, 0x60, 0xa7, 0xf3, 0xa4, 0x2b, 0x06, 0xb2, 0x3d, 0xd8, 0xdf, 0xef, 0x8e,
+	0xb8, 0x9a, 0xc5, 0x06, 0xbd, 0xde, 0x4e, 0xab, 0xfd, 0x54, 0xc9, 0xee, 0x94, 0xa1, 0x68, 0x50,
+	0x10, 0x9f, 0xf6, 0x57, 0x32, 0xb0, 0xb1, 0xd4, 0x01, 0xf5, 0x11, 0xe4, 0x67, 0x9e, 0x19, 0x0d,
+	0xcf, 0x1b, 0x6b, 0x7b, 0x29, 0xe5, 0xb9, 0xc4, 0xc4, 0x12, 0xda, 0xc7, 0xd0, 0x48, 0xc3, 0x25,
+	0xbd, 0xb7, 0x0e, 0x15, 0xd6, 0x69, 0xed, 0xea, 0x83, 0x7e, 0xef, 0x0b, 0x6e, 0x36, 0x52, 0xf6,
+	0x39, 0xeb, 0x8e, 0x50, 0x4f, 0xfc, 0x1d, 0x50, 0x96, 0x07, 0x46, 0x7d, 0x02, 0x1b, 0x78, 0xed,
+	0xc2, 0xb1, 0x38, 0x63, 0x48, 0xa6, 0xec, 0xf6, 0x9a, 0x91, 0x14, 0x64, 0x34, 0x63, 0x8d, 0x49,
+	0x2a, 0xaf, 0xfd, 0x7f, 0xa0, 0xae, 0x8e, 0xe0, 0x6f, 0xae, 0xfa, 0xff, 0x95, 0x81, 0xfc, 0x81,
+	0x63, 0xa0, 0x9c, 0x2f, 0xd0, 0xad, 0xda, 0x66, 0x46, 0x3e, 0x96, 0xa1, 0x0d, 0x8d, 0xcb, 0x82,
+	0x70, 0xea, 0x8f, 0x21, 0x17, 0x4e, 0xa2, 0x5b, 0x26, 0xd7, 0x2f, 0x58, 0x7c, 0x78, 0xb5, 0x35,
+	0x9c, 0x38, 0xf8, 0x72, 0x81, 0x69, 0x46, 0xe1, 0x28, 0xc2, 0xcf, 0x82, 0x9e, 0xf0, 0x5d, 0x6b,
+	0x6a, 0xbb, 0xb6, 0xb8, 0x05, 0x8c, 0x24, 0x78, 0xcb, 0xd7, 0x9c, 0x38, 0xe9, 0xd8, 0x22, 0xa4,
+	0x94, 0x2a, 0x34, 0x27, 0xf8, 0xd4, 0x48, 0x3d, 0xf4, 0xcf, 0x75, 0x7f, 0xe1, 0xd2, 0x81, 0x68,
+	0x20, 0xb4, 0xb6, 0x2a, 0x0a, 0xaf, 0x05, 0x9d, 0x1e, 0x06, 0x22, 0x5a, 0x75, 0xee, 0x5b, 0x73,
+	0xc3, 0x8f, 0xf5, 0x35, 0x3c, 0x98, 0x23, 0x00, 0xde, 0x91, 0xc5, 0xda, 0xb5, 0x77, 0xe8, 0x86,
+	0x29, 0xea, 0x37, 0x5a, 0x94, 0x5a, 0x73, 0x19, 0x40, 0x60, 0xb4, 0x3f, 0xc9, 0x41, 0x55, 0x6a,
+	0x8f, 0xfa, 0x3e, 0x94, 0xcd, 0x89, 0xb3, 0x86, 0xff, 0x49, 0x44, 0xf7, 0x77, 0xa3, 0x2d, 0x68,
+	0xf2, 0x04, 0x85, 0x39, 0x5a, 0xa1, 0xfe, 0xc2, 0xf0, 0x6d, 0xe4, 0xa9, 0x41, 0x33, 0x2b, 0x3b,
+	0x7f, 0x87, 0x56, 0xf8, 0x2c, 0xc2, 0xe0, 0x4b, 0x25, 0x81, 0x94, 0x57, 0xdf, 0xc6, 0x7b, 0x9a,
+	0xbc, 0x4b, 0xb9, 0xd4, 0xd3, 0x00, 0x1c, 0x88, 0x4f, 0x8b, 0x08, 0x3c, 0x92, 0x5a, 0x67, 0xd6,
+	0x64, 0x11, 0x46, 0xaa, 0x58, 0x3d, 0xea, 0x10, 0x01, 0x91, 0x54, 0xe0, 0xd5, 0x6d, 0xe4, 0x7e,
+	0x86, 0xe3, 0x78, 0x24, 0xa3, 0x0b, 0xb2, 0xa7, 0x71, 0x37, 0x86, 0xf3, 0x57, 0x4f, 0xa2, 0x1c,
+	0x86, 0x4b, 0x79, 0xe1, 0xb1, 0xe5, 0x37, 0x8b, 0xb2, 0xb8, 0x18, 0x20, 0x68, 0xb7, 0xdd, 0xc3,
+	0x95, 0x42, 0x68, 0xed, 0x97, 0x19, 0x28, 0x89, 0x11, 0x40, 0xe3, 0x19, 0x2f, 0x4b, 0x3d, 0x6b,
+	0xb1, 0x2e, 0x7a, 0x5b, 0x44, 0x48, 0xd4, 0x13, 0xd6, 0xea, 0x0b, 0x3e, 0xc9, 0x3a, 0xcf, 0x06,
+	0x4f, 0x3b, 0xdc, 0x98, 0xdc, 0xed, 0xf4, 0xbf, 0x50, 0x72, 0xdc, 0x81, 0xd2, 0x39, 0x68, 0x31,
+	0xe4, 0x92, 0x55, 0x28, 0x75, 0x3e, 0xef, 0xb4, 0x0f, 0x89, 0x4d, 0x36, 0x00, 0x76, 0x3b, 0xad,
+	0x5e, 0x6f, 0x80, 0x16, 0xbd, 0x52, 0x44, 0x67, 0x48, 0x9b, 0x75, 0xd0, 0xba, 0x6f, 0xb5, 0xdb,
+	0x83, 0xc3, 0xfe, 0x48, 0x29, 0xe1, 0x17, 0x5b, 0x68, 0x6a, 0xc7, 0x20, 0xba, 0xd0, 0xbf, 0xcb,
+	0x06, 0x07, 0x31, 0xa4, 0xb2, 0x53, 0x41, 0x85, 0x98, 0xe6, 0x4a, 0xfb, 0x1f, 0x75, 0x68, 0xa4,
+	0x97, 0xa6, 0xfa, 0x11, 0x94, 0x4d, 0x33, 0x35, 0xc7, 0xb7, 0xd6, 0x2d, 0xe1, 0xfb, 0xbb, 0x66,
+	0x34, 0xcd, 0x3c, 0x81, 0xe7, 0x9b, 0x7c, 0x23, 0x65, 0x57, 0x36, 0x52, 0xb4, 0x8d, 0x3e, 0x85,
+	0x0d, 0x71, 0x1b, 0x14, 0x8d, 0xbe, 0xb1, 0x11, 0x58, 0xe9, 0x5d, 0xd2, 0x26, 0xe4, 0xae, 0xc0,
+	0xed, 0x5d, 0x62, 0x8d, 0x49, 0x0a, 0xa2, 0xfe, 0x14, 0x1a, 0x06, 0x99, 0x31, 0x71, 0xf9, 0xbc,
+	0x2c, 0xf4, 0x5b, 0x88, 0x93, 0x8a, 0xd7, 0x0d, 0x19, 0x80, 0x0b, 0xd1, 0xf4, 0xbd, 0x79, 0x52,
+	0xb8, 0x20, 0x2f, 0xc4, 0x5d, 0xdf, 0x9b, 0x4b, 0x65, 0x6b, 0xa6, 0x94, 0xc7, 0x88, 0x53, 0xd1,
+	0xf2, 0xc4, 0x20, 0x8a, 0xb7, 0x2c, 0x6f, 0x36, 0xa9, 0x0e, 0xf8, 0x02, 0xd0, 0x24, 0xc9, 0x62,
+	0xd8, 0x32, 0x6f, 0x70, 0x62, 0x20, 0xc5, 0x6b, 0x8d, 0x5a, 0x1b, 0x95, 0x02, 0x23, 0xce, 0xa9,
+	0xef, 0x02, 0x50, 0x3b, 0x79, 0x99, 0x72, 0xea, 0x30, 0xcc, 0xf7, 0xe6, 0x51, 0x91, 0x8a, 0x19,
+	0x65, 0xa4, 0xe6, 0xf1, 0xb8, 0xfc, 0xca, 0x6a, 0xf3, 0xb8, 0xf3, 0x20, 0x6e, 0x1e, 0x65, 0x93,
+	0xe6, 0xf1, 0x62, 0xb0, 0xd2, 0xbc, 0xa8, 0x14, 0x18, 0x71, 0x2e, 0x6e, 0x1e, 0x2f, 0x53, 0x5d,
+	0x6e, 0x5e, 0x54, 0xa4, 0x62, 0x46, 0x19, 0x9c, 0xb6, 0x25, 0x5d, 0xad, 0x76, 0xa1, 0xae, 0x86,
+	0xd3, 0x96, 0xd6, 0xd6, 0x7e, 0x0a, 0x8d, 0xe0, 0xd8, 0x3b, 0x95, 0x18, 0x48, 0x5d, 0x2e, 0x3d,
+	0x3c, 0xf6, 0x4e, 0x65, 0x0e, 0x52, 0x0f, 0x64, 0x00, 0xb6, 0x96, 0x77, 0x91, 0x6e, 0xde, 0x34,
+	0xe4, 0xd6, 0x52, 0x0f, 0xf1, 0x46, 0x04, 0xb6, 0xd6, 0x88, 0x32, 0x38, 0x28, 0x89, 0xe9, 0x1b,
+	0x34, 0x37, 0xe4, 0x41, 0xe9, 0x45, 0x16, 0x30, 0x7e, 0x09, 0x62, 0x7b, 0x38, 0xc0, 0xb5, 0xb5,
+	0x70, 0xe5, 0x62, 0x8a, 0xbc, 0xb6, 0x0e, 0xdd, 0x54, 0xc1, 0x1a, 0x27, 0x15, 0x45, 0x93, 0x5d,
+	0x11, 0x58, 0x5f, 0x2f, 0x2c, 0x77, 0x62, 0x35, 0x37, 0x57, 0x77, 0xc5, 0x50, 0xe0, 0x92, 0x5d,
+	0x11, 0x41, 0xe2, 0x75, 0x1d, 0x17, 0x57, 0x97, 0xd7, 0xb5, 0x54, 0xb8, 0x66, 0x4a, 0xf9, 0x64,
+	0x43, 0xc5, 0x65, 0x2f, 0xaf, 0x6c, 0x28, 0xa9, 0x70, 0xdd, 0x90, 0x01, 0xda, 0xdf, 0x2a, 0x40,
+	0x49, 0xf0, 0x01, 0x7c, 0x26, 0x44, 0xb0, 0xa3, 0xdd, 0xd6, 0xa8, 0xb5, 0xd3, 0x22, 0x07, 0x93,
+	0x0a, 0x0d, 0xce, 0x8f, 0x62, 0x58, 0x06, 0x79, 0x14, 0x31, 0xa4, 0x18, 0x94, 0x45, 0x1e, 0x25,
+	0xca, 0xf2, 0x07, 0x4a, 0x72, 0xe8, 0x64, 0xe4, 0x05, 0x39, 0x80, 0xa2, 0x87, 0xa9, 0x14, 0xcf,
+	0x17, 0xa4, 0x22, 0xdc, 0xc9, 0x57, 0x4c, 0x8a, 0x70, 0x40, 0x29, 0x2e, 0xc2, 0xf3, 0x65, 0x6c,
+	0xcc, 0x88, 0x1d, 0xf6, 0xdb, 0xc9, 0x77, 0x2a, 0x58, 0x48, 0x54, 0xf3, 0xac, 0xdb, 0x79, 0xae,
+	0x00, 0x16, 0xe2, 0xb5, 0x50, 0xbe, 0x8a, 0x2a, 0x10, 0x55, 0x42, 0xd9, 0x9a, 0x7a, 0x1d, 0x2e,
+	0x0f, 0xf7, 0x06, 0xcf, 0x75, 0x5e, 0x28, 0xee, 0x42, 0x1d, 0x3d, 0xae, 0x12, 0x82, 0x57, 0xdf,
+	0xc0, 0x4f, 0x12, 0x34, 0x22, 0x1c, 0x2a, 0x1b, 0xe4, 0x33, 0x47, 0xd8, 0x88, 0xcb, 0x04, 0x05,
+	0xbb, 0xc2, 0x8b, 0x0e, 0x7a, 0x87, 0xfb, 0xfd, 0xa1, 0xb2, 0x89, 0x8d, 0x20, 0x08, 0x6f, 0xb9,
+	0x1a, 0x57, 0x93, 0x48, 0x92, 0xcb, 0x24, 0x5c, 0x10, 0xf6, 0xbc, 0xc5, 0xfa, 0xdd, 0xfe, 0x93,
+	0xa1, 0x72, 0x25, 0xae, 0xb9, 0xc3, 0xd8, 0x80, 0x0d, 0x95, 0xab, 0x31, 0x60, 0x38, 0x6a, 0x8d,
+	0x0e, 0x87, 0xca, 0xb5, 0xb8, 0x95, 0x07, 0x6c, 0xd0, 0xee, 0x0c, 0x87, 0xbd, 0xee, 0x70, 0xa4,
+	0x5c, 0x47, 0x3f, 0x7d, 0xd2, 0xa2, 0x88, 0xb8, 0x29, 0x35, 0x94, 0x3d, 0xe9, 0x8c, 0x94, 0x1b,
+	0x71, 0x33, 0xda, 0x83, 0x1e, 0xbe, 0x1d, 0x33, 0xe8, 0x2b, 0x37, 0x91, 0x88, 0x5c, 0xd6, 0xa2,
+	0x37, 0xaf, 0x60, 0xbb, 0x0e, 0xfb, 0x32, 0xe8, 0x96, 0xb4, 0x34, 0x86, 0x9d, 0x5f, 0x1c, 0x76,
+	0xfa, 0xed, 0x8e, 0xf2, 0x6a, 0xb2, 0x34, 0x62, 0xd8, 0xed, 0x78, 0x69, 0xc4, 0xa0, 0x3b, 0xf1,
+	0x37, 0x23, 0xd0, 0x50, 0xd9, 0xc2, 0xfa, 0x44, 0x3b, 0xfa, 0xfd, 0x4e, 0x7b, 0x84, 0x7d, 0x7d,
+	0x2d, 0x1e, 0xc5, 0xc3, 0x83, 0x27, 0x0c, 0x6f, 0x2e, 0x6b, 0x3b, 0x35, 0x7a, 0xca, 0x4c, 0xc8,
+	0x2b, 0xed, 0x33, 0x50, 0xe5, 0x37, 0x81, 0xc4, 0xfb, 0x04, 0x2a, 0xe4, 0xa7, 0xbe, 0x37, 0x8b,
+	0x2e, 0xc4, 0x60, 0x1a, 0xef, 0x27, 0xcc, 0x17, 0x63, 0x3a, 0xff, 0x4d, 0x82, 0xe7, 0x65, 0x90,
+	0xf6, 0x0f, 0x33, 0xd0, 0x48, 0xcb, 0x2a, 0xd4, 0xd1, 0xec, 0xa9, 0x8e, 0x07, 0xf9, 0x74, 0x87,
+	0x3e, 0x88, 0x4c, 0x7f, 0x7b, 0xda, 0xf7, 0x42, 0xba, 0x44, 0x4f, 0xb6, 0x5a, 0x2c, 0x7a, 0x78,
+	0xad, 0x71, 0x5e, 0xed, 0xc2, 0xe5, 0xd4, 0x93, 0x49, 0xa9, 0x17, 0x0c, 0x9a, 0xf1, 0x03, 0x30,
+	0x4b, 0xed, 0x67, 0x6a, 0xb0, 0xda, 0x27, 0x05, 0x72, 0x78, 0xef, 0x8b, 0x5f, 0x85, 0xc4, 0xa4,
+	0xb6, 0x07, 0xf5, 0x94, 0x68, 0x24, 0x6f, 0xcf, 0x34, 0xdd, 0xd2, 0xb2, 0x3d, 0x7d, 0x79, 0x33,
+	0xb5, 0x3f, 0xcc, 0x40, 0x4d, 0x16, 0x94, 0x3f, 0xb8, 0x26, 0x0a, 0xb1, 0x14, 0x69, 0x74, 0xa5,
+	0x8a, 0xbb, 0xf3, 0x11, 0xa8, 0x4b, 0x4f, 0x38, 0x72, 0x77, 0xd4, 0xe3, 0x93, 0x61, 0xdc, 0x1d,
+	0x19, 0x84, 0x56, 0x2c, 0x05, 0x4f, 0x3f, 0x7e, 0x8a, 0x04, 0x22, 0x48, 0x33, 0x81, 0x68, 0x77,
+	0xa0, 0xf2, 0xf8, 0x24, 0x7a, 0xc6, 0x41, 0x7e, 0x49, 0xa2, 0x22, 0x2e, 0x55, 0xfc, 0x51, 0x06,
+	0x1a, 0xc9, 0xed, 0x40, 0x8a, 0xff, 0xe0, 0x4f, 0x6d, 0xf1, 0xe5, 0x80, 0x4f, 0x6d, 0xc5, 0xaf,
+	0x3b, 0x66, 0xe5, 0xd7, 0x1d, 0x5f, 0x17, 0x95, 0xe5, 0x64, 0x71, 0x12, 0x7f, 0x8b, 0xd7, 0x8e,
+	0x11, 0x02, 0xf8, 0x9f, 0x59, 0x53, 0xcb, 0xf7, 0xad, 0xe8, 0xd5, 0xb1, 0x15, 0xe2, 0x14, 0x11,
+	0x99, 0x04, 0xd6, 0xb4, 0x59, 0x90, 0xb9, 0x70, 0xfa, 0x02, 0x23, 0xe2, 0xb5, 0xbf, 0x9e, 0x87,
+	0xaa, 0xa4, 0x76, 0x7c, 0xa7, 0xe5, 0x77, 0x0b, 0xdf, 0xcc, 0x8a, 0xae, 0xc6, 0x89, 0x20, 0xfa,
+	0x18, 0x90, 0x9a, 0xab, 0xdc, 0xd2, 0x5c, 0xe1, 0x45, 0x1f, 0x1e, 0x28, 0x22, 0x1c, 0x4d, 0x51,
+	0x36, 0xed, 0x49, 0x29, 0xbc, 0xc4, 0x0b, 0xf9, 0x1e, 0xd4, 0xa4, 0x07, 0x29, 0xa2, 0x7b, 0xb6,
+	0xcb, 0xf4, 0xd5, 0xe4, 0x71, 0x8a, 0x00, 0x2f, 0xc4, 0x4e, 0x4f, 0x74, 0x73, 0x1c, 0x39, 0x29,
+	0x0a, 0xd3, 0x93, 0xdd, 0x31, 0x79, 0x6e, 0xa7, 0xb1, 0xa4, 0x2d, 0x13, 0xa6, 0x3c, 0x8d, 0xe4,
+	0xe9, 0x5d, 0x28, 0x4d, 0x4f, 0x78, 0x6c, 0x7c, 0x65, 0x2b, 0xb7, 0x6e, 0xc8, 0x8b, 0xd3, 0x13,
+	0x0a, 0x94, 0xff, 0x18, 0x94, 0x25, 0x27, 0x56, 0xd0, 0x84, 0xb5, 0x8d, 0xda, 0x48, 0xfb, 0xb3,
+	0x02, 0xf5, 0x01, 0x5c, 0x11, 0x42, 0xdb, 0x08, 0x74, 0x1e, 0xc4, 0x48, 0xb7, 0x2d, 0xf9, 0x93,
+	0x14, 0x9b, 0x1c, 0xd7, 0x0a, 0x86, 0x84, 0xc1, 0xc5, 0xaa, 0x41, 0x4d, 0x5a, 0xbb, 0xfc, 0x2a,
+	0x6b, 0x85, 0xa5, 0x60, 0xea, 0x23, 0xa8, 0x4d, 0x4f, 0xf8, 0x5a, 0x18, 0x79, 0xfb, 0x96, 0x08,
+	0x47, 0xbb, 0xb2, 0xbc, 0x0a, 0x28, 0x6a, 0x29, 0x45, 0xa9, 0xfd, 0x8b, 0x0c, 0x34, 0x12, 0x7d,
+	0x12, 0x77, 0x28, 0x7a, 0x3f, 0x93, 0x07, 0xf4, 0x9a, 0xcb, 0x2a, 0x27, 0x92, 0xa0, 0x9b, 0x9a,
+	0xbf, 0xf5, 0xb3, 0xee, 0x82, 0xf1, 0xba, 0xe7, 0x43, 0x72, 0xeb, 0x9e, 0x0f, 0xd1, 0x9e, 0x40,
+	0x0e, 0x8f, 0x25, 0xc8, 0x77, 0x81, 0x22, 0x8c, 0xdb, 0x39, 0x5c, 0x78, 0xd1, 0xd9, 0x1a, 0x1e,
+	0x3f, 0xd2, 0x8d, 0xa0, 0x03, 0xd6, 0xdd, 0x6f, 0xb1, 0x2f, 0xe8, 0x3c, 0x92, 0x84, 0xfc, 0xe3,
+	0x01, 0xeb, 0x74, 0x9f, 0xf4, 0x09, 0x90, 0x27, 0xcf, 0x46, 0xd2, 0xc4, 0x96, 0x69, 0x3e, 0x3e,
+	0x91, 0xef, 0x59, 0x66, 0x52, 0x8f, 0xb0, 0xa5, 0x2f, 0x11, 0x64, 0x97, 0x2f, 0x11, 0xa8, 0xf1,
+	0x16, 0x8d, 0xf7, 0x3b, 0x5e, 0x39, 0xc6, 0xdb, 0xbf, 0x69, 0xa3, 0x21, 0xbd, 0xbb, 0x88, 0x40,
+	0xfb, 0x55, 0x06, 0xd4, 0x54, 0x43, 0xb8, 0x1e, 0xfb, 0x43, 0xdb, 0xf2, 0x11, 0x34, 0xc5, 0xcb,
+	0x39, 0x9c, 0x4a, 0x72, 0x70, 0x8a, 0x21, 0xbd, 0xea, 0x25, 0xf1, 0x0f, 0xc9, 0x1d, 0x68, 0xf5,
+	0x01, 0xf0, 0x67, 0x50, 0x70, 0xc6, 0xd3, 0x6e, 0x02, 0x69, 0xf3, 0xb3, 0x84, 0x26, 0x79, 0xf7,
+	0x44, 0x7e, 0xcf, 0x85, 0x7b, 0x7c, 0x37, 0x92, 0x59, 0x23, 0x86, 0xa0, 0xfd, 0x7e, 0x06, 0x2e,
+	0xa7, 0x17, 0xc4, 0x9f, 0xaf, 0x97, 0xe9, 0xc7, 0x6b, 0x72, 0xcb, 0x8f, 0xd7, 0xac, 0x5b, 0x4f,
+	0xf9, 0xb5, 0xeb, 0xe9, 0xf7, 0x32, 0x70, 0x45, 0x1a, 0xfd, 0xc4, 0xf2, 0xf8, 0x0b, 0x6a, 0x99,
+	0xf4, 0x86, 0x4d, 0x3e, 0xf5, 0x86, 0x8d, 0xf6, 0x07, 0x19, 0xb8, 0xb6, 0xd4, 0x12, 0x66, 0xfd,
+	0x85, 0xb6, 0x25, 0xfd, 0xd6, 0x0d, 0x39, 0x79, 0x79, 0xc4, 0x0a, 0x0f, 0xb5, 0x57, 0xd3, 0x8f,
+	0xd7, 0xe0, 0x39, 0x88, 0xf6, 0x2f, 0xd3, 0x8d, 0x34, 0x93, 0x58, 0x6a, 0x0c, 0xfd, 0x49, 0x54,
+	0xa0, 0xe8, 0x7e, 0xe1, 0xda, 0x40, 0x6c, 0x99, 0x6e, 0x2d, 0x5f, 0xcc, 0x7e, 0x37, 0xbe, 0xf8,
+	0x08, 0x6a, 0x71, 0xc5, 0xbb, 0xd6, 0x34, 0x6d, 0xdf, 0x2f, 0x5d, 0x86, 0x4f, 0x51, 0x6a, 0xef,
+	0xc3, 0x66, 0xd2, 0x8b, 0xb6, 0x78, 0xc0, 0xe1, 0x0e, 0x54, 0x5d, 0xeb, 0x54, 0x8f, 0x9e, 0x77,
+	0xe0, 0x23, 0x0d, 0xae, 0x75, 0x2a, 0x08, 0xb4, 0xbf, 0x9b, 0x85, 0xab, 0x49, 0xb1, 0x7d, 0xcb,
+	0x3f, 0xb2, 0x0e, 0x3c, 0xc7, 0x9e, 0x9c, 0xd3, 0xdb, 0xc9, 0xb6, 0x9b, 0xdc, 0x83, 0xa8, 0xb3,
+	0xd2, 0xcc, 0x76, 0xa3, 0x5b, 0x10, 0x33, 0xe3, 0x0c, 0x23, 0x5f, 0xad, 0x49, 0x18, 0x88, 0x87,
+	0x27, 0x60, 0x66, 0x9c, 0xf1, 0x83, 0x98, 0x00, 0x8f, 0x37, 0x79, 0x5c, 0x9f, 0xa0, 0x49, 0x6e,
+	0x43, 0xe4, 0x99, 0xc2, 0x31, 0x9c, 0x74, 0x28, 0x6e, 0xbb, 0x62, 0x75, 0x8e, 0xf5, 0xc2, 0xe2,
+	0x7a, 0x4a, 0x9d, 0x95, 0x67, 0xc6, 0x59, 0x0f, 0xf3, 0xa8, 0xa4, 0xf8, 0x96, 0xe7, 0x1f, 0x19,
+	0x6e, 0xf4, 0xa8, 0x68, 0x99, 0x49, 0x10, 0x6c, 0x8b, 0x08, 0xce, 0x27, 0x67, 0x5c, 0x74, 0x38,
+	0x4d, 0xe1, 0xf8, 0x08, 0x89, 0x09, 0x78, 0xe8, 0x95, 0xb8, 0x04, 0x41, 0x04, 0xfc, 0xc6, 0x24,
+	0xae, 0xa8, 0xc0, 0x32, 0x1c, 0xdd, 0x98, 0x86, 0x96, 0x2f, 0xae, 0x40, 0x54, 0x10, 0xd2, 0x42,
+	0x80, 0xf6, 0x58, 0x96, 0x0c, 0xf1, 0xcb, 0x9d, 0x8e, 0x29, 0xaf, 0xdd, 0x92, 0xe7, 0x98, 0x11,
+	0x0a, 0xc7, 0x5b, 0x5a, 0xba, 0x25, 0xd7, 0x3a, 0x15, 0xaf, 0x93, 0xf1, 0x7a, 0x5a, 0xa6, 0x29,
+	0x5a, 0xb6, 0xee, 0x36, 0xf9, 0x0d, 0x28, 0x63, 0x50, 0x9d, 0x5c, 0xc1, 0xdc, 0xe7, 0x9f, 0xbd,
+	0x2d, 0x62, 0x01, 0x56, 0x8f, 0x53, 0x09, 0x1e, 0x5d, 0xbb, 0xcd, 0x27, 0x6f, 0xfa, 0x7e, 0x20,
+	0xc4, 0x01, 0xf2, 0x26, 0xf1, 0xcd, 0xf8, 0xf0, 0x14, 0x47, 0x19, 0x93, 0x08, 0x09, 0xac, 0xaf,
+	0xc5, 0x24, 0x62, 0x52, 0xfb, 0x67, 0x55, 0x80, 0xa4, 0xcb, 0x29, 0xcd, 0x26, 0xb3, 0xa4, 0xd9,
+	0x7c, 0xaf, 0x53, 0xd4, 0xf7, 0xf1, 0xd9, 0xa1, 0xf9, 0xb9, 0x9e, 0x94, 0xc8, 0xad, 0x2d, 0x51,
+	0x43, 0xaa, 0x51, 0x12, 0x93, 0xbd, 0x7a, 0x06, 0x97, 0x5f, 0x7b, 0x06, 0xf7, 0x1e, 0x94, 0xb8,
+	0x8b, 0x3f, 0x10, 0xd1, 0xfd, 0xd7, 0x97, 0xa5, 0xf6, 0x7d, 0xf1, 0x8c, 0x53, 0x44, 0xa7, 0x76,
+	0xa0, 0x11, 0xbf, 0x61, 0x23, 0xc7, 0xfa, 0xdf, 0x5e, 0x2d, 0x19, 0x91, 0xf1, 0x88, 0x02, 0x43,
+	0xce, 0x4a, 0xda, 0x4c, 0x38, 0x13, 0x7e, 0x27, 0xd2, 0x66, 0x4a, 0xb2, 0x36, 0x33, 0x9a, 0x71,
+	0x6f, 0x13, 0x6a, 0x33, 0x3f, 0x81, 0xcb, 0x22, 0x6e, 0x12, 0x0b, 0xe0, 0x70, 0x12, 0x3d, 0xbf,
+	0xca, 0x27, 0xee, 0x41, 0x8e, 0x66, 0x64, 0x26, 0x20, 0xf9, 0x5d, 0x50, 0x64, 0xf7, 0x19, 0xd1,
+	0xf2, 0x67, 0x73, 0x1a, 0x92, 0xb7, 0x0c, 0x29, 0xdf, 0x84, 0x0d, 0x51, 0x71, 0x5c, 0x29, 0x7f,
+	0x0f, 0xac, 0xce, 0xc1, 0x51, 0x8d, 0x9f, 0xc3, 0x95, 0xc9, 0x31, 0xde, 0x6c, 0xc7, 0xc7, 0x3b,
+	0x74, 0x7a, 0x27, 0x51, 0xc7, 0xc3, 0x5e, 0x7e, 0x31, 0xe0, 0xad, 0x95, 0xee, 0xb7, 0x89, 0x78,
+	0x34, 0x76, 0x28, 0xc8, 0x21, 0x3e, 0xfb, 0xdd, 0x9c, 0x2c, 0xc3, 0x97, 0xce, 0xc6, 0x6a, 0x2b,
+	0x67, 0x63, 0xcb, 0x8a, 0x5c, 0x7d, 0x55, 0x91, 0xbb, 0xf9, 0xf7, 0x0b, 0x50, 0xe4, 0x53, 0x45,
+	0x0f, 0x6c, 0xf8, 0x5e, 0xf4, 0xb0, 0xe9, 0x95, 0x75, 0x7a, 0x18, 0xbd, 0x66, 0x8e, 0x2a, 0xdb,
+	0x7d, 0x28, 0xe2, 0xd1, 0xee, 0xf4, 0x24, 0x7d, 0x5a, 0xb5, 0xa4, 0x12, 0xa1, 0xb3, 0xd9, 0xc0,
+	0x84, 0xfa, 0x11, 0x54, 0x90, 0x9e, 0x3b, 0xe2, 0x52, 0xa6, 0xe2, 0xaa, 0xf2, 0x82, 0x87, 0x4f,
+	0x86, 0x48, 0xab, 0x3f, 0x4b, 0xfb, 0xfd, 0xb8, 0x66, 0x71, 0x73, 0xa5, 0xe8, 0x45, 0x1e, 0xc0,
+	0xdf, 0x06, 0xee, 0x08, 0x8a, 0xf9, 0x72, 0x41, 0x3e, 0x18, 0x59, 0xe1, 0xe2, 0xe8, 0x75, 0x32,
+	0x78, 0x80, 0x09, 0xe5, 0xf1, 0x5d, 0x0c, 0x5e, 0x3e, 0x7e, 0x77, 0x78, 0xcd, 0xc8, 0x20, 0xcf,
+	0x88, 0x1d, 0x73, 0x98, 0xa1, 0x62, 0xa6, 0x19, 0x71, 0xca, 0xd2, 0x4a, 0xb1, 0x98, 0x33, 0x51,
+	0xb1, 0x28, 0xa3, 0x3e, 0x82, 0x2a, 0xb9, 0xc7, 0x44, 0xb9, 0xf2, 0xca, 0xd0, 0x26, 0xec, 0x85,
+	0x9c, 0xfe, 0x71, 0x4e, 0x6d, 0x47, 0xfd, 0xf4, 0x2d, 0xd9, 0xaf, 0x7a, 0x6b, 0xed, 0x40, 0xb1,
+	0xd8, 0xc5, 0xca, 0x3b, 0xcb, 0x78, 0x19, 0x75, 0x07, 0x6a, 0x86, 0x24, 0x93, 0x9b, 0x70, 0x41,
+	0x1d, 0x12, 0x0d, 0xd5, 0x21, 0xe5, 0xd5, 0xa7, 0xa0, 0xf2, 0x86, 0xcc, 0x50, 0xc0, 0xe9, 0x73,
+	0x92, 0x70, 0xc2, 0xf5, 0xfa, 0xca, 0x72, 0x4d, 0x92, 0x10, 0xdc, 0xbb, 0xc4, 0x14, 0x2a, 0x28,
+	0xc1, 0x92, 0x93, 0xc4, 0x9b, 0x0c, 0xae, 0xad, 0xdf, 0x17, 0x72, 0x08, 0x44, 0x9e, 0x87, 0x40,
+	0x68, 0xe9, 0xab, 0xb2, 0xe9, 0x1b, 0x54, 0x52, 0x40, 0xc4, 0xcf, 0xd1, 0xd7, 0x20, 0xf3, 0x96,
+	0x2a, 0x94, 0xa2, 0xe7, 0xe2, 0x28, 0x88, 0xac, 0x3d, 0x38, 0xc0, 0xc3, 0xc4, 0x2a, 0x94, 0xba,
+	0xfd, 0xe1, 0xa8, 0xd5, 0x17, 0xe7, 0xc4, 0xdd, 0xbe, 0x38, 0x27, 0xd6, 0xfe, 0x0d, 0x86, 0x54,
+	0xc4, 0xae, 0xed, 0x1f, 0xec, 0x60, 0x88, 0x2d, 0xf7, 0x9c, 0x6c, 0xb9, 0x2f, 0x29, 0xc8, 0x3c,
+	0x66, 0x81, 0x5f, 0xa1, 0xde, 0x48, 0xab, 0xa1, 0xc1, 0xea, 0x95, 0x8e, 0xc2, 0x77, 0xbc, 0xd2,
+	0x21, 0xc7, 0x88, 0x15, 0xd3, 0x31, 0x62, 0x4b, 0x4f, 0x06, 0x96, 0x28, 0xbe, 0x42, 0x7e, 0x32,
+	0xf0, 0xc2, 0xc0, 0x8a, 0xf2, 0xc5, 0x81, 0x15, 0xf4, 0xfb, 0x0f, 0xe8, 0xbb, 0x16, 0x01, 0x53,
+	0x22, 0x97, 0x96, 0x6e, 0xf0, 0x12, 0xe9, 0xb6, 0xcc, 0xd7, 0xaa, 0x6b, 0x0c, 0xd4, 0x6d, 0xb8,
+	0x32, 0x3d, 0x89, 0x9f, 0x47, 0x4a, 0x0c, 0xd5, 0x1a, 0x75, 0x63, 0x2d, 0x4e, 0xfb, 0x1a, 0x2a,
+	0xb1, 0xa3, 0xfd, 0x87, 0xcf, 0xe6, 0xf7, 0xb9, 0xbe, 0xab, 0xfd, 0x6e, 0xe4, 0x9e, 0x8b, 0xfd,
+	0xdc, 0x7f, 0x5e, 0xf7, 0x5c, 0xea, 0xf3, 0xb9, 0x97, 0x7c, 0xfe, 0x8c, 0xfb, 0xc8, 0xe2, 0x8f,
+	0xff, 0x86, 0x97, 0xb0, 0xbc, 0xba, 0xf2, 0xa9, 0xd5, 0xa5, 0x2d, 0x84, 0xa3, 0xef, 0xcf, 0xff,
+	0xe9, 0xef, 0xd5, 0xe1, 0x3f, 0xcd, 0x44, 0xde, 0xa8, 0xf8, 0x79, 0xa7, 0x0b, 0x35, 0xae, 0xf5,
+	0x0e, 0xb5, 0xef, 0xf3, 0xb9, 0x5f, 0x6b, 0x4e, 0xe7, 0x7f, 0x9d, 0x39, 0xfd, 0x16, 0x14, 0x38,
+	0x1f, 0x2f, 0x5c, 0x64, 0x4a, 0x73, 0xfc, 0x4b, 0x1f, 0x44, 0xd5, 0x34, 0xa1, 0x61, 0xf2, 0xfe,
+	0x5e, 0x89, 0xea, 0x8d, 0x1e, 0x73, 0xc5, 0x0c, 0x7a, 0x33, 0x2a, 0x89, 0x55, 0xfd, 0xfd, 0xc7,
+	0xe4, 0x37, 0x66, 0x4f, 0xff, 0xa3, 0x2c, 0xd4, 0x53, 0x67, 0x6c, 0x3f, 0xa0, 0x31, 0x6b, 0xf9,
+	0x66, 0x6e, 0x3d, 0xdf, 0xbc, 0x90, 0x85, 0xe5, 0x2f, 0x66, 0x61, 0xff, 0x47, 0x78, 0x2d, 0x0f,
+	0x7a, 0x14, 0x6f, 0xaf, 0x96, 0xa3, 0xa0, 0x47, 0x1e, 0xce, 0xa7, 0xfd, 0xcd, 0x4c, 0xfc, 0x12,
+	0x29, 0xff, 0xd2, 0x3a, 0x45, 0x3e, 0xb3, 0x56, 0x91, 0xbf, 0x1d, 0xff, 0xae, 0x40, 0x77, 0x97,
+	0x5b, 0xce, 0x75, 0x26, 0x41, 0xf0, 0x42, 0x36, 0x57, 0x46, 0xb8, 0xfe, 0xa5, 0x7b, 0x53, 0x3d,
+	0xc2, 0x9a, 0x22, 0xde, 0xef, 0x1a, 0x27, 0xe0, 0xaf, 0xe5, 0x4e, 0x5b, 0x11, 0x56, 0xeb, 0x42,
+	0x3d, 0x75, 0xe0, 0x29, 0xfd, 0x82, 0x49, 0x46, 0xfe, 0x05, 0x13, 0x0c, 0x2f, 0x3b, 0x3d, 0xb6,
+	0x7c, 0x6b, 0xcd, 0x7b, 0x38, 0x1c, 0x81, 0xcf, 0x96, 0xcb, 0xc1, 0x17, 0xea, 0x3b, 0x50, 0xb0,
+	0x43, 0x6b, 0x16, 0xb9, 0x09, 0xae, 0xad, 0xc6, 0x67, 0x90, 0xa7, 0x80, 0x13, 0x61, 0xa0, 0x83,
+	0xb2, 0x8c, 0x93, 0x7e, 0x66, 0x25, 0x73, 0xc1, 0xcf, 0xac, 0x64, 0x53, 0x8d, 0x5c, 0xf7, 0x4b,
+	0x29, 0xf1, 0x9b, 0x1c, 0xf9, 0x0b, 0xde, 0xe4, 0xc0, 0x7b, 0x57, 0xbe, 0x45, 0xbf, 0x61, 0x61,
+	0x36, 0x0b, 0x2b, 0x44, 0x31, 0x0e, 0xc3, 0x56, 0x4b, 0x22, 0x52, 0x64, 0xad, 0xad, 0xfa, 0x36,
+	0x94, 0xf8, 0xef, 0x59, 0x44, 0xde, 0x8d, 0x95, 0x70, 0xcc, 0x08, 0x8f, 0xb6, 0x2b, 0xa2, 0xd2,
+	0xb6, 0x2b, 0xc6, 0x0f, 0x31, 0x82, 0xe3, 0x52, 0xe3, 0xbe, 0x1a, 0xb4, 0xc1, 0x02, 0x71, 0x79,
+	0x1b, 0x08, 0x84, 0x4a, 0x50, 0xa0, 0xfd, 0x0c, 0x4a, 0x22, 0x12, 0x65, 0x6d, 0x53, 0x5e, 0xf6,
+	0x0b, 0x0f, 0x5b, 0x00, 0x49, 0x68, 0xca, 0xba, 0x1a, 0x30, 0xc0, 0x3f, 0x8a, 0x46, 0xc1, 0xf5,
+	0x97, 0x7c, 0x5a, 0x04, 0x1a, 0xcb, 0x8d, 0x71, 0xc4, 0xbb, 0x70, 0x78, 0x28, 0x4d, 0x6e, 0xc3,
+	0x07, 0xf8, 0xc0, 0xba, 0x78, 0x6e, 0x2f, 0x73, 0xf1, 0x73, 0x7b, 0x31, 0x91, 0x7a, 0x0f, 0x62,
+	0x76, 0xfc, 0x32, 0xb3, 0x59, 0x6b, 0x45, 0xf1, 0xf3, 0xb4, 0xca, 0x1e, 0x0a, 0xf7, 0x18, 0x82,
+	0x96, 0x3c, 0x52, 0xa9, 0x36, 0x31, 0x89, 0x4c, 0x6b, 0x40, 0x4d, 0x3e, 0x42, 0xd7, 0x7e, 0x99,
+	0x07, 0x05, 0x7f, 0xd5, 0x03, 0x99, 0x16, 0xde, 0x33, 0xa0, 0x4e, 0xdc, 0x80, 0x72, 0xfc, 0x8e,
+	0x77, 0x26, 0x7a, 0x07, 0xd4, 0x89, 0x1e, 0xb8, 0x16, 0x8e, 0x1c, 0xc9, 0x31, 0x01, 0x1c, 0x44,
+	0x04, 0x9c, 0x13, 0xa4, 0x1e, 0xd4, 0x2c, 0xdb, 0xc1, 0x1e, 0xe5, 0xd1, 0xd5, 0x87, 0x97, 0xa4,
+	0x1d, 0x6f, 0x42, 0x6b, 0xb2, 0x46, 0x97, 0xa8, 0x7b, 0xde, 0x04, 0x4b, 0x45, 0x66, 0x6d, 0x20,
+	0xae, 0x1d, 0x94, 0x39, 0x60, 0x44, 0x67, 0x14, 0xe2, 0xaa, 0x6c, 0x18, 0x10, 0x67, 0xaa, 0xb1,
+	0x32, 0x07, 0x8c, 0x82, 0xe8, 0xed, 0xb1, 0x89, 0x78, 0x50, 0x3b, 0x47, 0x6f, 0x8f, 0xe1, 0xe3,
+	0x68, 0xe8, 0x80, 0xc1, 0x37, 0xdb, 0x27, 0xe2, 0xc9, 0x7c, 0xf1, 0xb2, 0x1b, 0xa2, 0x5e, 0xe7,
+	0x4f, 0x8e, 0xfb, 0x56, 0x10, 0x70, 0x7f, 0x14, 0x7f, 0xd2, 0xa2, 0x16, 0x01, 0xe3, 0x07, 0x3a,
+	0xc4, 0x23, 0xed, 0x48, 0x02, 0xe2, 0x81, 0x0e, 0x02, 0x11, 0xc1, 0x0d, 0x28, 0x7f, 0xe3, 0xb9,
+	0x96, 0x30, 0x96, 0xb1, 0x55, 0x25, 0xcc, 0xef, 0x1b, 0x73, 0xed, 0x5f, 0x67, 0xe0, 0xca, 0xf2,
+	0xa8, 0xd2, 0x6c, 0xd7, 0xa0, 0xdc, 0x1e, 0xf4, 0xf4, 0x7e, 0x6b, 0x1f, 0x0f, 0xf5, 0x37, 0xa0,
+	0x3a, 0xd8, 0xc1, 0x2b, 0x5e, 0x1c, 0x90, 0xa1, 0x9b, 0x4a, 0x43, 0x7d, 0xaf, 0xbb, 0xbb, 0xdb,
+	0xe9, 0x73, 0x65, 0x7e, 0xb0, 0xf3, 0x99, 0xde, 0x1b, 0xb4, 0xf9, 0xfb, 0xd0, 0xd1, 0xd1, 0xfe,
+	0x50, 0xc9, 0x63, 0x96, 0xc7, 0x80, 0x62, 0xb6, 0xc0, 0x43, 0x1c, 0x9f, 0x0f, 0xf5, 0x76, 0x7f,
+	0xa4, 0x14, 0x31, 0x87, 0x57, 0x6a, 0xf4, 0x76, 0x14, 0xcb, 0xd4, 0x1e, 0xec, 0x1f, 0xb0, 0xce,
+	0x70, 0xa8, 0x0f, 0xbb, 0x5f, 0x76, 0x94, 0x32, 0x7d, 0x99, 0x75, 0x9f, 0x74, 0xfb, 0x1c, 0x50,
+	0xc1, 0xb3, 0x85, 0xfd, 0x6e, 0x5f, 0x01, 0x4a, 0xb4, 0x3e, 0x57, 0xaa, 0x98, 0x18, 0x1e, 0xee,
+	0x2b, 0xb5, 0x7b, 0xaf, 0x41, 0x4d, 0xfe, 0xdd, 0x03, 0x8a, 0x6a, 0xf4, 0x5c, 0x8b, 0x3f, 0x56,
+	0xd6, 0xfb, 0xe6, 0x7d, 0x25, 0x73, 0xef, 0x77, 0xa5, 0xc7, 0x6b, 0xa3, 0x8b, 0x31, 0x78, 0x10,
+	0x41, 0x17, 0xe6, 0xf8, 0x3d, 0x1e, 0x3a, 0x98, 0xa0, 0x6b, 0x3f, 0x7b, 0xad, 0xe1, 0x1e, 0x3f,
+	0xc4, 0x10, 0x18, 0x02, 0xe4, 0x92, 0x47, 0xae, 0xe8, 0x82, 0x1c, 0x25, 0xe3, 0x93, 0xfc, 0x02,
+	0x16, 0xa4, 0x43, 0xf6, 0x22, 0x9e, 0x4f, 0x63, 0x2a, 0xc6, 0x95, 0xee, 0x69, 0x50, 0x95, 0x9e,
+	0x1e, 0xa4, 0x6f, 0x18, 0xc1, 0xb1, 0x78, 0x37, 0x0b, 0xad, 0x32, 0x25, 0x73, 0xef, 0x03, 0xa8,
+	0x0b, 0x1a, 0xf1, 0xf0, 0x1f, 0xfe, 0x9c, 0x10, 0x5e, 0x8d, 0x71, 0x04, 0x9d, 0xb5, 0x08, 0x2c,
+	0x3e, 0x05, 0xcc, 0x12, 0x4f, 0x04, 0x2a, 0xd9, 0x7b, 0x0f, 0xe0, 0xea, 0xda, 0x57, 0x0d, 0xb1,
+	0xf8, 0xd0, 0xc6, 0x40, 0x48, 0x1e, 0x6b, 0xba, 0x77, 0x3e, 0xf6, 0x6d, 0x53, 0xc9, 0xdc, 0xfb,
+	0x39, 0x34, 0x2f, 0x0a, 0x9d, 0xc4, 0xcf, 0xb4, 0xf7, 0x5a, 0x14, 0x9e, 0x8a, 0x33, 0x34, 0xd0,
+	0x79, 0x2e, 0xc3, 0xa3, 0x7b, 0x7b, 0x1d, 0x8a, 0xe1, 0xb8, 0xf7, 0x6d, 0x46, 0x62, 0x2a, 0x51,
+	0xf8, 0x5b, 0x0c, 0x10, 0x43, 0x2f, 0x83, 0x98, 0x65, 0x98, 0x4a, 0x46, 0xbd, 0x06, 0x6a, 0x0a,
+	0xd4, 0xf3, 0x26, 0x86, 0xa3, 0x64, 0x29, 0x5a, 0x23, 0x82, 0x3f, 0xf7, 0xed, 0xd0, 0x52, 0x72,
+	0xea, 0xab, 0x70, 0x23, 0x86, 0xf5, 0xbc, 0xd3, 0x03, 0xdf, 0x46, 0x3b, 0xf3, 0x9c, 0xa3, 0xf3,
+	0x3b, 0x9f, 0xfe, 0xf1, 0xaf, 0x6e, 0x67, 0xfe, 0xfd, 0xaf, 0x6e, 0x67, 0xfe, 0xdb, 0xaf, 0x6e,
+	0x5f, 0xfa, 0xe5, 0x7f, 0xbf, 0x9d, 0xf9, 0x52, 0xfe, 0xad, 0xc1, 0x99, 0x11, 0xfa, 0xf6, 0x19,
+	0xdf, 0x09, 0x51, 0xc6, 0xb5, 0x1e, 0xcc, 0x4f, 0x8e, 0x1e, 0xcc, 0xc7, 0x0f, 0x90, 0x01, 0x8d,
+	0x8b, 0xf4, 0xab, 0x82, 0x0f, 0xff, 0xf7, 0x00, 0xc3, 0x4a, 0xfe, 0x37, 0xb5, 0x70, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -16413,6 +16709,45 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LateMaterialize {
+		i--
+		if m.LateMaterialize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.HintWarnings) > 0 {
+		for iNdEx := len(m.HintWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HintWarnings[iNdEx])
+			copy(dAtA[i:], m.HintWarnings[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.HintWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.IndexHints) > 0 {
+		for iNdEx := len(m.IndexHints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexHints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
 	if m.ScanTS != nil {
 		{
 			size, err := m.ScanTS.MarshalToSizedBuffer(dAtA[:i])
@@ -17158,6 +17493,52 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IndexHint) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexHint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexHint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IndexNames) > 0 {
+		for iNdEx := len(m.IndexNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexNames[iNdEx])
+			copy(dAtA[i:], m.IndexNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Scope != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExternScan) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -17842,6 +18223,11 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Dop != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Dop))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.DetectSqls) > 0 {
 		for iNdEx := len(m.DetectSqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DetectSqls[iNdEx])
@@ -19800,6 +20186,80 @@ func (m *AlterTableComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableMergePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableMergePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableMergePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SealAfter != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SealAfter))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeWindow != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TimeWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TimeColumn) > 0 {
+		i -= len(m.TimeColumn)
+		copy(dAtA[i:], m.TimeColumn)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TimeColumn)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reorganize {
+		i--
+		if m.Reorganize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxLevel != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxLevel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetObjectSize != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TargetObjectSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxObjects != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxObjects))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinRows != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MinRows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableName) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20339,6 +20799,27 @@ func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AlterMergePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterMergePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterMergePolicy != nil {
+		{
+			size, err := m.AlterMergePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20364,20 +20845,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA177 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j176 int
+		dAtA178 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j177 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA177[j176] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA178[j177] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j176++
+				j177++
 			}
-			dAtA177[j176] = uint8(num)
-			j176++
+			dAtA178[j177] = uint8(num)
+			j177++
 		}
-		i -= j176
-		copy(dAtA[i:], dAtA177[:j176])
-		i = encodeVarintPlan(dAtA, i, uint64(j176))
+		i -= j177
+		copy(dAtA[i:], dAtA178[:j177])
+		i = encodeVarintPlan(dAtA, i, uint64(j177))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -20422,20 +20903,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA180 := make([]byte, len(m.ForeignTbl)*10)
-		var j179 int
+		dAtA181 := make([]byte, len(m.ForeignTbl)*10)
+		var j180 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA180[j179] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA181[j180] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j179++
+				j180++
 			}
-			dAtA180[j179] = uint8(num)
-			j179++
+			dAtA181[j180] = uint8(num)
+			j180++
 		}
-		i -= j179
-		copy(dAtA[i:], dAtA180[:j179])
-		i = encodeVarintPlan(dAtA, i, uint64(j179))
+		i -= j180
+		copy(dAtA[i:], dAtA181[:j180])
+		i = encodeVarintPlan(dAtA, i, uint64(j180))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -20922,20 +21403,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA188 := make([]byte, len(m.ForeignTbl)*10)
-		var j187 int
+		dAtA189 := make([]byte, len(m.ForeignTbl)*10)
+		var j188 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA188[j187] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA189[j188] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j187++
+				j188++
 			}
-			dAtA188[j187] = uint8(num)
-			j187++
+			dAtA189[j188] = uint8(num)
+			j188++
 		}
-		i -= j187
-		copy(dAtA[i:], dAtA188[:j187])
-		i = encodeVarintPlan(dAtA, i, uint64(j187))
+		i -= j188
+		copy(dAtA[i:], dAtA189[:j188])
+		i = encodeVarintPlan(dAtA, i, uint64(j188))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21021,20 +21502,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA191 := make([]byte, len(m.AccountIDs)*10)
-		var j190 int
+		dAtA192 := make([]byte, len(m.AccountIDs)*10)
+		var j191 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA191[j190] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA192[j191] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j190++
+				j191++
 			}
-			dAtA191[j190] = uint8(num)
-			j190++
+			dAtA192[j191] = uint8(num)
+			j191++
 		}
-		i -= j190
-		copy(dAtA[i:], dAtA191[:j190])
-		i = encodeVarintPlan(dAtA, i, uint64(j190))
+		i -= j191
+		copy(dAtA[i:], dAtA192[:j191])
+		i = encodeVarintPlan(dAtA, i, uint64(j191))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -21246,21 +21727,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA195 := make([]byte, len(m.ParamTypes)*10)
-		var j194 int
+		dAtA196 := make([]byte, len(m.ParamTypes)*10)
+		var j195 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA195[j194] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA196[j195] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j194++
+				j195++
 			}
-			dAtA195[j194] = uint8(num)
-			j194++
+			dAtA196[j195] = uint8(num)
+			j195++
 		}
-		i -= j194
-		copy(dAtA[i:], dAtA195[:j194])
-		i = encodeVarintPlan(dAtA, i, uint64(j194))
+		i -= j195
+		copy(dAtA[i:], dAtA196[:j195])
+		i = encodeVarintPlan(dAtA, i, uint64(j195))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -21407,21 +21888,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA198 := make([]byte, len(m.ParamTypes)*10)
-		var j197 int
+		dAtA199 := make([]byte, len(m.ParamTypes)*10)
+		var j198 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA198[j197] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA199[j198] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j197++
+				j198++
 			}
-			dAtA198[j197] = uint8(num)
-			j197++
+			dAtA199[j198] = uint8(num)
+			j198++
 		}
-		i -= j197
-		copy(dAtA[i:], dAtA198[:j197])
-		i = encodeVarintPlan(dAtA, i, uint64(j197))
+		i -= j198
+		copy(dAtA[i:], dAtA199[:j198])
+		i = encodeVarintPlan(dAtA, i, uint64(j198))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -23964,6 +24445,45 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.ScanTS.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if len(m.IndexHints) > 0 {
+		for _, e := range m.IndexHints {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.HintWarnings) > 0 {
+		for _, s := range m.HintWarnings {
+			l = len(s)
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.LateMaterialize {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexHint) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovPlan(uint64(m.Scope))
+	}
+	if len(m.IndexNames) > 0 {
+		for _, s := range m.IndexNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24292,6 +24812,9 @@ func (m *Query) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.Dop != 0 {
+		n += 1 + sovPlan(uint64(m.Dop))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25219,6 +25742,43 @@ func (m *AlterTableComment) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableMergePolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinRows != 0 {
+		n += 1 + sovPlan(uint64(m.MinRows))
+	}
+	if m.MaxObjects != 0 {
+		n += 1 + sovPlan(uint64(m.MaxObjects))
+	}
+	if m.TargetObjectSize != 0 {
+		n += 1 + sovPlan(uint64(m.TargetObjectSize))
+	}
+	if m.MaxLevel != 0 {
+		n += 1 + sovPlan(uint64(m.MaxLevel))
+	}
+	if m.Reorganize {
+		n += 2
+	}
+	l = len(m.TimeColumn)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.TimeWindow != 0 {
+		n += 1 + sovPlan(uint64(m.TimeWindow))
+	}
+	if m.SealAfter != 0 {
+		n += 1 + sovPlan(uint64(m.SealAfter))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableName) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -25496,6 +26056,18 @@ func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AlterMergePolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterMergePolicy != nil {
+		l = m.AlterMergePolicy.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -39197,6 +39769,213 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexHints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexHints = append(m.IndexHints, &IndexHint{})
+			if err := m.IndexHints[len(m.IndexHints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HintWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HintWarnings = append(m.HintWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateMaterialize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LateMaterialize = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexHint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexHint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexHint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IndexHint_HintType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= IndexHint_HintScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexNames = append(m.IndexNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -41338,13 +42117,65 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadTag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LoadTag = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectSqls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DetectSqls = append(m.DetectSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dop", wireType)
 			}
-			var v int
+			m.Dop = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -41354,44 +42185,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Dop |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LoadTag = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DetectSqls", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DetectSqls = append(m.DetectSqls, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -45654,6 +46452,223 @@ func (m *AlterTableComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableMergePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableMergePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableMergePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRows", wireType)
+			}
+			m.MinRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjects", wireType)
+			}
+			m.MaxObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxObjects |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectSize", wireType)
+			}
+			m.TargetObjectSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetObjectSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevel", wireType)
+			}
+			m.MaxLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorganize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reorganize = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			m.TimeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAfter", wireType)
+			}
+			m.SealAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46942,6 +47957,41 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterMergePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableMergePolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterMergePolicy{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -79,6 +79,8 @@ const (
 	CmdMethod_MigrateConnFrom CmdMethod = 20
 	// MigrateConnTo migrate the session info to the new cn node.
 	CmdMethod_MigrateConnTo CmdMethod = 21
+	// GetScrubInfo gets the corrupted objects found by the scrubber of the tn
+	CmdMethod_GetScrubInfo CmdMethod = 22
 )
 
 var CmdMethod_name = map[int32]string{
@@ -104,6 +106,7 @@ var CmdMethod_name = map[int32]string{
 	19: "GetPipelineInfo",
 	20: "MigrateConnFrom",
 	21: "MigrateConnTo",
+	22: "GetScrubInfo",
 }
 
 var CmdMethod_value = map[string]int32{
@@ -129,6 +132,7 @@ var CmdMethod_value = map[string]int32{
 	"GetPipelineInfo":       19,
 	"MigrateConnFrom":       20,
 	"MigrateConnTo":         21,
+	"GetScrubInfo":          22,
 }
 
 func (x CmdMethod) String() string {
@@ -875,6 +879,8 @@ type Request struct {
 	GetPipelineInfoRequest *GetPipelineInfoRequest `protobuf:"bytes,22,opt,name=GetPipelineInfoRequest,proto3" json:"GetPipelineInfoRequest,omitempty"`
 	MigrateConnFromRequest *MigrateConnFromRequest `protobuf:"bytes,23,opt,name=MigrateConnFromRequest,proto3" json:"MigrateConnFromRequest,omitempty"`
 	MigrateConnToRequest   *MigrateConnToRequest   `protobuf:"bytes,24,opt,name=MigrateConnToRequest,proto3" json:"MigrateConnToRequest,omitempty"`
+	// GetScrubInfoRequest is the request for getting the corrupted objects from the tn
+	GetScrubInfoRequest *GetScrubInfoRequest `protobuf:"bytes,25,opt,name=GetScrubInfoRequest,proto3" json:"GetScrubInfoRequest,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -1078,6 +1084,13 @@ func (m *Request) GetMigrateConnToRequest() *MigrateConnToRequest {
 	return nil
 }
 
+func (m *Request) GetGetScrubInfoRequest() *GetScrubInfoRequest {
+	if m != nil {
+		return m.GetScrubInfoRequest
+	}
+	return nil
+}
+
 // ShowProcessListResponse is the response of command ShowProcessList.
 type ShowProcessListResponse struct {
 	Sessions []*status.Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
@@ -1161,6 +1174,8 @@ type Response struct {
 	GetPipelineInfoResponse *GetPipelineInfoResponse `protobuf:"bytes,22,opt,name=GetPipelineInfoResponse,proto3" json:"GetPipelineInfoResponse,omitempty"`
 	MigrateConnFromResponse *MigrateConnFromResponse `protobuf:"bytes,23,opt,name=MigrateConnFromResponse,proto3" json:"MigrateConnFromResponse,omitempty"`
 	MigrateConnToResponse   *MigrateConnToResponse   `protobuf:"bytes,24,opt,name=MigrateConnToResponse,proto3" json:"MigrateConnToResponse,omitempty"`
+	// GetScrubInfoResponse is the response to GetScrubInfo
+	GetScrubInfoResponse *GetScrubInfoResponse `protobuf:"bytes,25,opt,name=GetScrubInfoResponse,proto3" json:"GetScrubInfoResponse,omitempty"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -1364,6 +1379,13 @@ func (m *Response) GetMigrateConnToResponse() *MigrateConnToResponse {
 	return nil
 }
 
+func (m *Response) GetGetScrubInfoResponse() *GetScrubInfoResponse {
+	if m != nil {
+		return m.GetScrubInfoResponse
+	}
+	return nil
+}
+
 // AlterAccountRequest is the "alter account restricted" query request.
 type AlterAccountRequest struct {
 	// Tenant is the tenant which to alter.
@@ -2235,6 +2257,194 @@ func (m *GetCacheInfoResponse) GetCacheInfoList() []*CacheInfo {
 	return nil
 }
 
+type GetScrubInfoRequest struct {
+}
+
+func (m *GetScrubInfoRequest) Reset()         { *m = GetScrubInfoRequest{} }
+func (m *GetScrubInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScrubInfoRequest) ProtoMessage()    {}
+func (*GetScrubInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{35}
+}
+func (m *GetScrubInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetScrubInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetScrubInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetScrubInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScrubInfoRequest.Merge(m, src)
+}
+func (m *GetScrubInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetScrubInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScrubInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScrubInfoRequest proto.InternalMessageInfo
+
+type ScrubInfo struct {
+	// NodeId is the uuid of the tn.
+	NodeId string `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	// ObjectName is the name of the corrupted object.
+	ObjectName string `protobuf:"bytes,2,opt,name=ObjectName,proto3" json:"ObjectName,omitempty"`
+	// DbId is the id of the database the object belongs to.
+	DbId uint64 `protobuf:"varint,3,opt,name=DbId,proto3" json:"DbId,omitempty"`
+	// TableId is the id of the table the object belongs to.
+	TableId uint64 `protobuf:"varint,4,opt,name=TableId,proto3" json:"TableId,omitempty"`
+	// Error is the reason of the corruption.
+	Error string `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	// DetectedAt is the unix nanoseconds when the corruption was detected.
+	DetectedAt int64 `protobuf:"varint,6,opt,name=DetectedAt,proto3" json:"DetectedAt,omitempty"`
+	// Repaired is true if the object has been restored from the replica.
+	Repaired bool `protobuf:"varint,7,opt,name=Repaired,proto3" json:"Repaired,omitempty"`
+	// RepairError is the error of repairing.
+	RepairError string `protobuf:"bytes,8,opt,name=RepairError,proto3" json:"RepairError,omitempty"`
+}
+
+func (m *ScrubInfo) Reset()         { *m = ScrubInfo{} }
+func (m *ScrubInfo) String() string { return proto.CompactTextString(m) }
+func (*ScrubInfo) ProtoMessage()    {}
+func (*ScrubInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{36}
+}
+func (m *ScrubInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScrubInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScrubInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScrubInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubInfo.Merge(m, src)
+}
+func (m *ScrubInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScrubInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScrubInfo proto.InternalMessageInfo
+
+func (m *ScrubInfo) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *ScrubInfo) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *ScrubInfo) GetDbId() uint64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *ScrubInfo) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *ScrubInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ScrubInfo) GetDetectedAt() int64 {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return 0
+}
+
+func (m *ScrubInfo) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+func (m *ScrubInfo) GetRepairError() string {
+	if m != nil {
+		return m.RepairError
+	}
+	return ""
+}
+
+type GetScrubInfoResponse struct {
+	ScrubInfoList []*ScrubInfo `protobuf:"bytes,1,rep,name=ScrubInfoList,proto3" json:"ScrubInfoList,omitempty"`
+}
+
+func (m *GetScrubInfoResponse) Reset()         { *m = GetScrubInfoResponse{} }
+func (m *GetScrubInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScrubInfoResponse) ProtoMessage()    {}
+func (*GetScrubInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{37}
+}
+func (m *GetScrubInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetScrubInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetScrubInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetScrubInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScrubInfoResponse.Merge(m, src)
+}
+func (m *GetScrubInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetScrubInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScrubInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScrubInfoResponse proto.InternalMessageInfo
+
+func (m *GetScrubInfoResponse) GetScrubInfoList() []*ScrubInfo {
+	if m != nil {
+		return m.ScrubInfoList
+	}
+	return nil
+}
+
 type RemoveRemoteLockTableRequest struct {
 	GroupID uint32 `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
 	TableID uint64 `protobuf:"varint,2,opt,name=TableID,proto3" json:"TableID,omitempty"`
@@ -2245,7 +2455,7 @@ func (m *RemoveRemoteLockTableRequest) Reset()         { *m = RemoveRemoteLockTa
 func (m *RemoveRemoteLockTableRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveRemoteLockTableRequest) ProtoMessage()    {}
 func (*RemoveRemoteLockTableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{35}
+	return fileDescriptor_5c6ac9b241082464, []int{38}
 }
 func (m *RemoveRemoteLockTableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2303,7 +2513,7 @@ func (m *RemoveRemoteLockTableResponse) Reset()         { *m = RemoveRemoteLockT
 func (m *RemoveRemoteLockTableResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveRemoteLockTableResponse) ProtoMessage()    {}
 func (*RemoveRemoteLockTableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{36}
+	return fileDescriptor_5c6ac9b241082464, []int{39}
 }
 func (m *RemoveRemoteLockTableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,7 +2558,7 @@ func (m *GetLatestBindRequest) Reset()         { *m = GetLatestBindRequest{} }
 func (m *GetLatestBindRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLatestBindRequest) ProtoMessage()    {}
 func (*GetLatestBindRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{37}
+	return fileDescriptor_5c6ac9b241082464, []int{40}
 }
 func (m *GetLatestBindRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2399,7 +2609,7 @@ func (m *GetLatestBindResponse) Reset()         { *m = GetLatestBindResponse{} }
 func (m *GetLatestBindResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLatestBindResponse) ProtoMessage()    {}
 func (*GetLatestBindResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{38}
+	return fileDescriptor_5c6ac9b241082464, []int{41}
 }
 func (m *GetLatestBindResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2448,7 +2658,7 @@ func (m *UnsubscribeTableRequest) Reset()         { *m = UnsubscribeTableRequest
 func (m *UnsubscribeTableRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeTableRequest) ProtoMessage()    {}
 func (*UnsubscribeTableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{39}
+	return fileDescriptor_5c6ac9b241082464, []int{42}
 }
 func (m *UnsubscribeTableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2500,7 +2710,7 @@ func (m *UnsubscribeTableResponse) Reset()         { *m = UnsubscribeTableRespon
 func (m *UnsubscribeTableResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeTableResponse) ProtoMessage()    {}
 func (*UnsubscribeTableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{40}
+	return fileDescriptor_5c6ac9b241082464, []int{43}
 }
 func (m *UnsubscribeTableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2546,7 +2756,7 @@ func (m *CacheKey) Reset()         { *m = CacheKey{} }
 func (m *CacheKey) String() string { return proto.CompactTextString(m) }
 func (*CacheKey) ProtoMessage()    {}
 func (*CacheKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{41}
+	return fileDescriptor_5c6ac9b241082464, []int{44}
 }
 func (m *CacheKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +2814,7 @@ func (m *CacheKeys) Reset()         { *m = CacheKeys{} }
 func (m *CacheKeys) String() string { return proto.CompactTextString(m) }
 func (*CacheKeys) ProtoMessage()    {}
 func (*CacheKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{42}
+	return fileDescriptor_5c6ac9b241082464, []int{45}
 }
 func (m *CacheKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2649,7 +2859,7 @@ func (m *RequestCacheKey) Reset()         { *m = RequestCacheKey{} }
 func (m *RequestCacheKey) String() string { return proto.CompactTextString(m) }
 func (*RequestCacheKey) ProtoMessage()    {}
 func (*RequestCacheKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{43}
+	return fileDescriptor_5c6ac9b241082464, []int{46}
 }
 func (m *RequestCacheKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2702,7 +2912,7 @@ func (m *GetCacheDataRequest) Reset()         { *m = GetCacheDataRequest{} }
 func (m *GetCacheDataRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCacheDataRequest) ProtoMessage()    {}
 func (*GetCacheDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{44}
+	return fileDescriptor_5c6ac9b241082464, []int{47}
 }
 func (m *GetCacheDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2749,7 +2959,7 @@ func (m *ResponseCacheData) Reset()         { *m = ResponseCacheData{} }
 func (m *ResponseCacheData) String() string { return proto.CompactTextString(m) }
 func (*ResponseCacheData) ProtoMessage()    {}
 func (*ResponseCacheData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{45}
+	return fileDescriptor_5c6ac9b241082464, []int{48}
 }
 func (m *ResponseCacheData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2808,7 +3018,7 @@ func (m *GetCacheDataResponse) Reset()         { *m = GetCacheDataResponse{} }
 func (m *GetCacheDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCacheDataResponse) ProtoMessage()    {}
 func (*GetCacheDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{46}
+	return fileDescriptor_5c6ac9b241082464, []int{49}
 }
 func (m *GetCacheDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2852,7 +3062,7 @@ func (m *GetStatsInfoRequest) Reset()         { *m = GetStatsInfoRequest{} }
 func (m *GetStatsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsInfoRequest) ProtoMessage()    {}
 func (*GetStatsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{47}
+	return fileDescriptor_5c6ac9b241082464, []int{50}
 }
 func (m *GetStatsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2896,7 +3106,7 @@ func (m *GetStatsInfoResponse) Reset()         { *m = GetStatsInfoResponse{} }
 func (m *GetStatsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatsInfoResponse) ProtoMessage()    {}
 func (*GetStatsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{48}
+	return fileDescriptor_5c6ac9b241082464, []int{51}
 }
 func (m *GetStatsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2942,7 +3152,7 @@ func (m *PrepareStmt) Reset()         { *m = PrepareStmt{} }
 func (m *PrepareStmt) String() string { return proto.CompactTextString(m) }
 func (*PrepareStmt) ProtoMessage()    {}
 func (*PrepareStmt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{49}
+	return fileDescriptor_5c6ac9b241082464, []int{52}
 }
 func (m *PrepareStmt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3000,7 +3210,7 @@ func (m *MigrateConnFromRequest) Reset()         { *m = MigrateConnFromRequest{}
 func (m *MigrateConnFromRequest) String() string { return proto.CompactTextString(m) }
 func (*MigrateConnFromRequest) ProtoMessage()    {}
 func (*MigrateConnFromRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{50}
+	return fileDescriptor_5c6ac9b241082464, []int{53}
 }
 func (m *MigrateConnFromRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3045,7 +3255,7 @@ func (m *MigrateConnFromResponse) Reset()         { *m = MigrateConnFromResponse
 func (m *MigrateConnFromResponse) String() string { return proto.CompactTextString(m) }
 func (*MigrateConnFromResponse) ProtoMessage()    {}
 func (*MigrateConnFromResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{51}
+	return fileDescriptor_5c6ac9b241082464, []int{54}
 }
 func (m *MigrateConnFromResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3309,7 @@ func (m *MigrateConnToRequest) Reset()         { *m = MigrateConnToRequest{} }
 func (m *MigrateConnToRequest) String() string { return proto.CompactTextString(m) }
 func (*MigrateConnToRequest) ProtoMessage()    {}
 func (*MigrateConnToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{52}
+	return fileDescriptor_5c6ac9b241082464, []int{55}
 }
 func (m *MigrateConnToRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +3374,7 @@ func (m *MigrateConnToResponse) Reset()         { *m = MigrateConnToResponse{} }
 func (m *MigrateConnToResponse) String() string { return proto.CompactTextString(m) }
 func (*MigrateConnToResponse) ProtoMessage()    {}
 func (*MigrateConnToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{53}
+	return fileDescriptor_5c6ac9b241082464, []int{56}
 }
 func (m *MigrateConnToResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3237,6 +3447,9 @@ func init() {
 	proto.RegisterType((*GetCacheInfoRequest)(nil), "query.GetCacheInfoRequest")
 	proto.RegisterType((*CacheInfo)(nil), "query.CacheInfo")
 	proto.RegisterType((*GetCacheInfoResponse)(nil), "query.GetCacheInfoResponse")
+	proto.RegisterType((*GetScrubInfoRequest)(nil), "query.GetScrubInfoRequest")
+	proto.RegisterType((*ScrubInfo)(nil), "query.ScrubInfo")
+	proto.RegisterType((*GetScrubInfoResponse)(nil), "query.GetScrubInfoResponse")
 	proto.RegisterType((*RemoveRemoteLockTableRequest)(nil), "query.RemoveRemoteLockTableRequest")
 	proto.RegisterType((*RemoveRemoteLockTableResponse)(nil), "query.RemoveRemoteLockTableResponse")
 	proto.RegisterType((*GetLatestBindRequest)(nil), "query.GetLatestBindRequest")
@@ -3261,155 +3474,164 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 2368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x72, 0xdb, 0x46,
-	0xf2, 0x17, 0x45, 0x52, 0x22, 0x9b, 0x14, 0x05, 0x8d, 0x28, 0x09, 0x56, 0x6c, 0x59, 0x99, 0x7f,
-	0xaa, 0xe2, 0xc4, 0xff, 0xa5, 0xbc, 0xca, 0x5a, 0xfb, 0x75, 0xb1, 0x45, 0xc5, 0xb6, 0xe2, 0x2f,
-	0x79, 0x48, 0xc7, 0x8e, 0x0f, 0xa9, 0x82, 0xc8, 0x11, 0x85, 0x12, 0x09, 0x30, 0x00, 0xb8, 0x91,
-	0xf2, 0x14, 0x39, 0xee, 0x75, 0xf7, 0x01, 0xf6, 0x9e, 0x37, 0xf0, 0x31, 0xc7, 0x9c, 0xb2, 0x5b,
-	0xf6, 0x8b, 0x6c, 0xcd, 0xa0, 0x07, 0x9f, 0x03, 0x56, 0x36, 0x95, 0x0b, 0x6b, 0xa6, 0xa7, 0xfb,
-	0x87, 0x46, 0x63, 0xa6, 0x7f, 0xdd, 0x43, 0x68, 0x7c, 0x33, 0xe3, 0xde, 0x55, 0x67, 0xea, 0xb9,
-	0x81, 0x4b, 0xaa, 0x72, 0xb2, 0xdd, 0xf4, 0x03, 0x2b, 0x98, 0xf9, 0xa1, 0x70, 0x1b, 0xc6, 0xee,
-	0xe0, 0x02, 0xc7, 0xf5, 0xe0, 0xd2, 0xc1, 0xe1, 0x6a, 0x60, 0x4f, 0xb8, 0x1f, 0x58, 0x93, 0xa9,
-	0x12, 0x08, 0x2b, 0xdf, 0x76, 0xce, 0x5c, 0x14, 0xfc, 0x6e, 0x64, 0x07, 0xe7, 0xb3, 0xd3, 0xce,
-	0xc0, 0x9d, 0xec, 0x8d, 0xdc, 0x91, 0xbb, 0x27, 0xc5, 0xa7, 0xb3, 0x33, 0x39, 0x93, 0x13, 0x39,
-	0x42, 0xf5, 0x9b, 0x23, 0xd7, 0x1d, 0x8d, 0x79, 0xac, 0x95, 0x79, 0x00, 0xfd, 0x08, 0x9a, 0x2f,
-	0x84, 0x7f, 0x8c, 0x7f, 0x33, 0xe3, 0x7e, 0x40, 0xda, 0x50, 0x95, 0x73, 0xb3, 0xb4, 0x5b, 0xba,
-	0x55, 0x67, 0xe1, 0x84, 0x3e, 0x83, 0xcd, 0xde, 0xb9, 0xfb, 0xed, 0x89, 0xe7, 0x0e, 0xb8, 0xef,
-	0x3f, 0xb1, 0xfd, 0x40, 0xe9, 0x6f, 0xc2, 0x52, 0x9f, 0x3b, 0x96, 0x13, 0xa0, 0x01, 0xce, 0xc8,
-	0x75, 0xa8, 0xf7, 0xae, 0x7c, 0x5c, 0x5a, 0xdc, 0x2d, 0xdd, 0xaa, 0xb1, 0x58, 0x40, 0x5f, 0xc1,
-	0x5a, 0xef, 0xca, 0x19, 0x74, 0xdd, 0xc9, 0xc4, 0x8e, 0xa0, 0x0e, 0xa1, 0xf5, 0xc4, 0x0a, 0xb8,
-	0x1f, 0x84, 0xe2, 0x7e, 0x4f, 0x42, 0x36, 0xf6, 0xdb, 0x9d, 0xd8, 0xe9, 0xbe, 0x1a, 0x1d, 0x56,
-	0xde, 0xfe, 0x7c, 0x73, 0x81, 0x65, 0x2c, 0xe8, 0x1b, 0x20, 0x49, 0x60, 0x7f, 0xea, 0x3a, 0x3e,
-	0x27, 0x47, 0xb0, 0xda, 0x9d, 0x79, 0x1e, 0x77, 0xfe, 0x17, 0xe8, 0xac, 0x09, 0x25, 0x60, 0x3c,
-	0xe4, 0x41, 0xca, 0x67, 0xfa, 0x15, 0xac, 0x25, 0x64, 0xbf, 0xe9, 0xe3, 0xf6, 0x60, 0xa3, 0xeb,
-	0x7a, 0xfc, 0x68, 0x36, 0x99, 0x76, 0x5d, 0xe7, 0xcc, 0x1e, 0x25, 0x42, 0x7e, 0x7f, 0x10, 0xd8,
-	0xae, 0xa3, 0x42, 0x1e, 0xce, 0xa8, 0x09, 0x9b, 0x59, 0x83, 0xd0, 0x21, 0xfa, 0x01, 0x5c, 0x7b,
-	0xc8, 0x83, 0x13, 0xf1, 0xc1, 0x07, 0xee, 0xf8, 0x4b, 0xee, 0xf9, 0xb6, 0xeb, 0xa8, 0x57, 0x38,
-	0x80, 0x6d, 0xdd, 0x22, 0xbe, 0x8b, 0x09, 0xcb, 0x28, 0x92, 0x4f, 0x2b, 0x33, 0x35, 0xa5, 0x77,
-	0xe1, 0x5a, 0xaf, 0x08, 0x74, 0x8e, 0xd9, 0x01, 0x6c, 0xf7, 0x7e, 0xcd, 0xe3, 0xfe, 0x1f, 0x5a,
-	0x6c, 0xe6, 0xf4, 0x2d, 0xff, 0x42, 0x3d, 0x63, 0x1b, 0x6a, 0x62, 0xda, 0x75, 0x87, 0x5c, 0x2a,
-	0x57, 0x59, 0x34, 0xa7, 0x9f, 0xc0, 0x6a, 0xa4, 0x8d, 0xd0, 0x9b, 0xb0, 0xc4, 0xb8, 0x3f, 0x1b,
-	0x47, 0x3b, 0x35, 0x9c, 0x89, 0xb0, 0x89, 0xf7, 0xb7, 0xa7, 0x7c, 0x6c, 0x3b, 0xfc, 0xd8, 0x39,
-	0x73, 0x55, 0x64, 0xf6, 0x60, 0x2b, 0xb7, 0x82, 0x60, 0x6d, 0xa8, 0x76, 0xdd, 0x19, 0xee, 0xfa,
-	0x32, 0x0b, 0x27, 0xf4, 0x5f, 0x2b, 0xb0, 0xac, 0xbc, 0xbb, 0x0e, 0x75, 0x1c, 0x1e, 0x1f, 0x49,
-	0xad, 0x0a, 0x8b, 0x05, 0xa4, 0x03, 0xf5, 0xee, 0x64, 0xf8, 0x94, 0x07, 0xe7, 0xee, 0x50, 0x1e,
-	0x8f, 0xd6, 0xbe, 0xd1, 0x09, 0xb3, 0x46, 0x24, 0x67, 0xb1, 0x0a, 0xf9, 0x63, 0xfa, 0x98, 0x9a,
-	0x65, 0xb9, 0x9f, 0xd6, 0xd1, 0x24, 0xb9, 0xc4, 0xd2, 0xe7, 0xf9, 0x65, 0xd1, 0xc9, 0x35, 0x2b,
-	0x12, 0xe2, 0x06, 0x42, 0xe8, 0x95, 0x58, 0xd1, 0xb1, 0x7f, 0x02, 0xeb, 0xf7, 0xc7, 0x01, 0xf7,
-	0xee, 0x0f, 0x06, 0xe2, 0xcd, 0x15, 0x66, 0x55, 0x62, 0x6e, 0x23, 0xa6, 0x46, 0x83, 0xe9, 0xcc,
-	0xc8, 0x3d, 0x58, 0x7d, 0x6c, 0x8f, 0xc7, 0x5d, 0xd7, 0x51, 0x1b, 0xc8, 0x5c, 0x92, 0x48, 0x9b,
-	0x88, 0x94, 0x59, 0x65, 0x59, 0x75, 0xd2, 0x05, 0xa3, 0xef, 0x59, 0x03, 0xde, 0x9b, 0x5a, 0x11,
-	0xc4, 0xb2, 0x84, 0xd8, 0x42, 0x88, 0xec, 0x32, 0xcb, 0x19, 0x90, 0x63, 0x20, 0x0f, 0x79, 0xf0,
-	0xc4, 0x1d, 0x5c, 0x24, 0x76, 0x81, 0x59, 0x93, 0x30, 0xd7, 0x10, 0x26, 0xaf, 0xc0, 0x34, 0x46,
-	0xe4, 0x81, 0xcc, 0x0b, 0xfd, 0x4b, 0x27, 0x89, 0x54, 0x97, 0x48, 0x66, 0x8c, 0x94, 0x5e, 0x67,
-	0x79, 0x13, 0x11, 0x67, 0x91, 0x5f, 0xac, 0xc1, 0x79, 0x72, 0x67, 0x9a, 0x90, 0x8a, 0xb3, 0x46,
-	0x83, 0xe9, 0xcc, 0xc8, 0x9f, 0x00, 0x7a, 0x57, 0x03, 0x27, 0x4c, 0x31, 0x66, 0x23, 0xe5, 0x4e,
-	0x2e, 0x1f, 0xb3, 0x84, 0x2e, 0xb9, 0x0b, 0xf5, 0x28, 0xcf, 0x99, 0xcd, 0x54, 0x60, 0xb3, 0x39,
-	0x91, 0xc5, 0x9a, 0xe4, 0x44, 0x46, 0x34, 0x73, 0xd8, 0xcd, 0x15, 0x69, 0xbf, 0x1b, 0xdb, 0xeb,
-	0x93, 0x08, 0xd3, 0xd8, 0x0a, 0xc4, 0x7c, 0xfa, 0x30, 0x5b, 0x29, 0xc4, 0x5e, 0x31, 0x62, 0x7e,
-	0x89, 0x1c, 0x41, 0x2b, 0x9d, 0x36, 0xcd, 0x55, 0x89, 0x76, 0x5d, 0x9d, 0x47, 0x5d, 0x12, 0x66,
-	0x19, 0x1b, 0xb2, 0x07, 0xcb, 0x98, 0x70, 0x4c, 0x43, 0x9a, 0x6f, 0xa0, 0x79, 0x3a, 0x69, 0x31,
-	0xa5, 0x45, 0xbe, 0x82, 0x0d, 0xc6, 0x27, 0xee, 0xdf, 0xb8, 0xf8, 0x0d, 0xb8, 0xd8, 0x40, 0x7d,
-	0xeb, 0x74, 0xcc, 0xcd, 0x35, 0x69, 0xfe, 0x7f, 0xca, 0x5c, 0xa7, 0xa3, 0xc0, 0xf4, 0x08, 0xe4,
-	0x3e, 0xac, 0x88, 0x2d, 0x29, 0x99, 0xf1, 0xd0, 0x76, 0x86, 0x26, 0x91, 0x90, 0x1f, 0x24, 0xb6,
-	0x70, 0xb4, 0xa6, 0xa0, 0xd2, 0x16, 0xe4, 0x0b, 0x30, 0x5e, 0x3a, 0xfe, 0xec, 0xd4, 0x1f, 0x78,
-	0xf6, 0x29, 0x0f, 0x1d, 0x5b, 0x97, 0x28, 0x3b, 0x88, 0x92, 0x5d, 0x8e, 0x8e, 0x55, 0x76, 0x21,
-	0xb9, 0x87, 0x8f, 0xac, 0xc0, 0x52, 0x7b, 0xb8, 0xad, 0xdd, 0xc3, 0x09, 0x0d, 0xa6, 0x33, 0x43,
-	0xb4, 0x9e, 0x28, 0x8b, 0x92, 0x27, 0x62, 0x23, 0x8b, 0x96, 0xd5, 0x60, 0x3a, 0x33, 0x91, 0x1e,
-	0xf5, 0xc9, 0xdf, 0xdc, 0x4c, 0xa5, 0x47, 0xbd, 0x12, 0x2b, 0x30, 0x16, 0xb0, 0x4f, 0xed, 0x91,
-	0x67, 0x05, 0x5c, 0x24, 0xa9, 0x07, 0x9e, 0x3b, 0x51, 0xb0, 0x5b, 0x29, 0x58, 0xbd, 0x12, 0x2b,
-	0x30, 0x26, 0xcf, 0xa1, 0x9d, 0x58, 0xe9, 0x47, 0xbe, 0x9a, 0xa9, 0xef, 0xab, 0x53, 0x61, 0x5a,
-	0x43, 0xfa, 0x00, 0xb6, 0x72, 0x09, 0x1e, 0x19, 0xee, 0x36, 0xd4, 0x7a, 0xdc, 0x17, 0x27, 0xc4,
-	0x37, 0x4b, 0xbb, 0xe5, 0x5b, 0x8d, 0xfd, 0xd5, 0x0e, 0x96, 0xb0, 0x28, 0x67, 0x91, 0x02, 0xfd,
-	0x61, 0x05, 0x6a, 0x91, 0xe5, 0x6f, 0xcb, 0x7c, 0x6d, 0xa8, 0x7e, 0xee, 0x79, 0xae, 0x27, 0x29,
-	0xaf, 0xc9, 0xc2, 0x09, 0x79, 0x5d, 0xe8, 0x38, 0xf2, 0xda, 0x4e, 0x11, 0xaf, 0x85, 0x5a, 0xac,
-	0xf0, 0xbd, 0x9f, 0x43, 0x3b, 0x4d, 0x51, 0x08, 0x5b, 0x4d, 0xc5, 0x58, 0xa7, 0xc2, 0xb4, 0x86,
-	0x82, 0x9a, 0x62, 0xb6, 0x42, 0xb0, 0xa5, 0x54, 0x06, 0xcd, 0x2e, 0xb3, 0x9c, 0x81, 0xe0, 0x93,
-	0x04, 0x5d, 0x21, 0xca, 0x72, 0x2a, 0x81, 0xe7, 0xd6, 0x59, 0xde, 0x04, 0x4f, 0x4f, 0xcc, 0x56,
-	0x88, 0x54, 0xcb, 0x9e, 0x9e, 0xac, 0x06, 0xd3, 0x99, 0x21, 0x61, 0x46, 0x94, 0x85, 0x60, 0xf5,
-	0x2c, 0x61, 0x66, 0x14, 0x98, 0xc6, 0x48, 0x84, 0x3d, 0xcd, 0x58, 0x08, 0x06, 0xd9, 0xd4, 0x95,
-	0x53, 0x61, 0x5a, 0x43, 0xf2, 0x67, 0xc1, 0x75, 0x8a, 0xd2, 0x90, 0xeb, 0xae, 0x69, 0xb8, 0x0e,
-	0x41, 0x12, 0xca, 0xe4, 0x20, 0x4f, 0x76, 0x66, 0x9e, 0xec, 0xd0, 0x30, 0xc1, 0x76, 0x2f, 0xe6,
-	0xb0, 0xdd, 0x87, 0x73, 0xd8, 0x2e, 0x11, 0x96, 0x2c, 0x39, 0xbd, 0x98, 0x43, 0x77, 0x1f, 0xce,
-	0xa1, 0x3b, 0x05, 0xa9, 0xe1, 0xbb, 0xcf, 0x0b, 0xf8, 0xee, 0x46, 0x01, 0xdf, 0x21, 0x54, 0x96,
-	0xf0, 0xee, 0x64, 0x09, 0x6f, 0x33, 0x4b, 0x78, 0x68, 0x18, 0x31, 0xde, 0x9b, 0xf9, 0x8c, 0xf7,
-	0xd1, 0x7c, 0xc6, 0x43, 0xb4, 0x02, 0xca, 0x3b, 0xd4, 0x53, 0xde, 0x75, 0x3d, 0xe5, 0x21, 0x56,
-	0x86, 0xf3, 0x1e, 0x17, 0x72, 0xde, 0xcd, 0x42, 0xce, 0x53, 0x07, 0x36, 0x47, 0x7a, 0x89, 0xfd,
-	0x1c, 0xb2, 0x17, 0xee, 0xe7, 0xb6, 0x76, 0x3f, 0x27, 0x55, 0x98, 0xd6, 0x10, 0x01, 0x13, 0x04,
-	0x86, 0x80, 0x1b, 0x59, 0xc0, 0x9c, 0x0a, 0xd3, 0x1a, 0x8a, 0x14, 0x5a, 0xd0, 0xdd, 0x20, 0xf7,
-	0xed, 0x14, 0x71, 0x9f, 0x4a, 0xa1, 0x45, 0xcd, 0xd1, 0x6b, 0xd8, 0xca, 0x11, 0x18, 0x22, 0x6f,
-	0xa5, 0x90, 0x0b, 0xb4, 0x58, 0x91, 0x39, 0x61, 0xb0, 0x91, 0xe1, 0x31, 0xc4, 0x35, 0x53, 0x9f,
-	0x5b, 0xab, 0xc3, 0xf4, 0xa6, 0xf4, 0x58, 0xdb, 0xca, 0xc8, 0xee, 0x52, 0x5e, 0x56, 0x1c, 0x0f,
-	0xb1, 0xc9, 0x8b, 0xe6, 0xa2, 0x95, 0xec, 0x49, 0x2a, 0x94, 0xa4, 0x54, 0x67, 0x38, 0xa3, 0x7f,
-	0xd1, 0x73, 0x07, 0xa1, 0xd0, 0xb4, 0x84, 0xbc, 0x37, 0x1b, 0x08, 0xbe, 0x91, 0x78, 0x35, 0x96,
-	0x92, 0xd1, 0xe3, 0x5c, 0x0f, 0x24, 0x88, 0x14, 0x91, 0x90, 0x48, 0xcb, 0x2c, 0x16, 0x24, 0x5b,
-	0xe5, 0x45, 0x49, 0xb2, 0x89, 0x56, 0x39, 0x4f, 0x20, 0x26, 0x2c, 0xa7, 0x9f, 0xae, 0xa6, 0xf4,
-	0x75, 0xbe, 0x75, 0x22, 0x06, 0x94, 0xbb, 0x93, 0x21, 0x36, 0xca, 0x62, 0x28, 0x68, 0x58, 0x28,
-	0xf8, 0xf2, 0x59, 0x75, 0x16, 0x4e, 0x84, 0x87, 0xfd, 0x73, 0x8f, 0xfb, 0xe7, 0xee, 0x78, 0x28,
-	0x63, 0x51, 0x66, 0xb1, 0x80, 0x7e, 0xac, 0x21, 0x2d, 0x42, 0xa0, 0x22, 0xc6, 0x88, 0x2d, 0xc7,
-	0xb4, 0xad, 0x6b, 0xbc, 0xe8, 0x4f, 0x25, 0xa8, 0x29, 0x99, 0xf0, 0x5f, 0x1e, 0x2c, 0xfc, 0x1a,
-	0x15, 0xa6, 0xa6, 0x02, 0xf0, 0x31, 0xbf, 0x12, 0x8e, 0x95, 0x6f, 0x35, 0x99, 0x1c, 0x93, 0x4f,
-	0x43, 0xcb, 0xa7, 0xee, 0x90, 0x4b, 0xb7, 0x5a, 0xfb, 0xad, 0x8e, 0xbc, 0x71, 0x53, 0x52, 0x16,
-	0xad, 0x93, 0x5d, 0x68, 0xd8, 0x3e, 0xb3, 0x9c, 0x91, 0x4c, 0x27, 0xb2, 0x7c, 0xa8, 0xb1, 0xa4,
-	0x88, 0x7c, 0x0c, 0xcb, 0x8f, 0xdc, 0xf1, 0x90, 0x7b, 0xbe, 0x59, 0x95, 0x95, 0xd0, 0x4a, 0x08,
-	0xf6, 0xca, 0xb2, 0x05, 0x8f, 0x31, 0xb5, 0x2a, 0x14, 0x85, 0x4c, 0x28, 0x2e, 0x69, 0x15, 0x71,
-	0x95, 0x7e, 0xad, 0xa5, 0x61, 0xf1, 0x2a, 0x5d, 0xe7, 0x58, 0xc5, 0x5d, 0x8e, 0xc9, 0x67, 0xd0,
-	0x54, 0x7a, 0xa2, 0x4e, 0x91, 0xaf, 0x29, 0x6a, 0xb1, 0x70, 0xa7, 0x47, 0x10, 0x29, 0x25, 0xba,
-	0xae, 0x69, 0x3f, 0xe9, 0x39, 0x34, 0xfa, 0x97, 0xce, 0x2f, 0x8b, 0x28, 0x73, 0xbf, 0x8d, 0x22,
-	0x2a, 0xc6, 0xe4, 0x36, 0x2c, 0x3f, 0x9f, 0x06, 0xb2, 0x1a, 0x0c, 0xef, 0x1e, 0xd6, 0xe2, 0x80,
-	0xe2, 0x02, 0x53, 0x1a, 0xf4, 0x87, 0x12, 0x2c, 0xe3, 0xc3, 0xc9, 0x3d, 0xa8, 0x75, 0x3d, 0x6e,
-	0x05, 0xfc, 0x7e, 0x80, 0xb7, 0x60, 0xdb, 0x9d, 0xf0, 0x52, 0xb2, 0xa3, 0x2e, 0x25, 0x13, 0x77,
-	0x61, 0xb5, 0xb7, 0x3f, 0xdf, 0x5c, 0xf8, 0xfe, 0xdf, 0x37, 0x4b, 0x2c, 0xb2, 0x22, 0xbb, 0x50,
-	0x79, 0xca, 0x03, 0x4b, 0xee, 0xbc, 0xc6, 0x7e, 0xb3, 0x13, 0x5c, 0x3a, 0x9d, 0xfe, 0xa5, 0x23,
-	0x64, 0x4c, 0xae, 0x88, 0x57, 0x79, 0xe9, 0x73, 0xaf, 0x7f, 0xe9, 0x48, 0xe7, 0x6a, 0x4c, 0x4d,
-	0xc9, 0x1d, 0xa8, 0x8b, 0x98, 0x0b, 0x2f, 0x7d, 0xb3, 0x22, 0x43, 0x47, 0x54, 0xbd, 0x14, 0xc7,
-	0x82, 0xc5, 0x4a, 0xf4, 0x8d, 0xae, 0xa6, 0xd1, 0x7e, 0x99, 0x3b, 0x32, 0x9e, 0x99, 0x0f, 0xd3,
-	0x8a, 0xd1, 0x25, 0x40, 0x52, 0x85, 0x6e, 0x68, 0xbb, 0x79, 0xfa, 0x8f, 0x12, 0xd4, 0x23, 0xa1,
-	0x48, 0x3c, 0xcf, 0xdc, 0x21, 0xef, 0x5f, 0x4d, 0x39, 0x3e, 0x2e, 0x9a, 0x8b, 0xc4, 0x23, 0xc6,
-	0xc7, 0x43, 0x3c, 0x86, 0x38, 0x13, 0xe7, 0x50, 0x02, 0x48, 0xa3, 0x30, 0x27, 0xc5, 0x02, 0xe1,
-	0xfc, 0x4b, 0x9f, 0x0f, 0xe5, 0xd6, 0xae, 0x30, 0x39, 0x16, 0xb2, 0x07, 0x1e, 0x0f, 0xcb, 0xda,
-	0x0a, 0x93, 0x63, 0xf1, 0xe4, 0x47, 0x76, 0xc0, 0xac, 0xc0, 0x76, 0x65, 0x85, 0xba, 0xc8, 0xa2,
-	0x39, 0x7d, 0xa6, 0xaf, 0xcf, 0xc8, 0x01, 0xac, 0x44, 0x42, 0x19, 0x86, 0xb0, 0x57, 0x88, 0x4a,
-	0xfa, 0xc8, 0x20, 0xad, 0x46, 0xc7, 0x70, 0x7d, 0x5e, 0x6b, 0x2b, 0x3e, 0xe9, 0x43, 0xcf, 0x9d,
-	0x4d, 0x31, 0xf3, 0xad, 0x30, 0x35, 0x8d, 0xf7, 0xed, 0x91, 0xca, 0x7b, 0x38, 0x4d, 0x66, 0xc4,
-	0x72, 0x3a, 0x23, 0xde, 0x85, 0x1b, 0x73, 0xcb, 0x8a, 0xf4, 0x7d, 0x5e, 0x55, 0xdd, 0xe7, 0x7d,
-	0x21, 0x5f, 0x3a, 0xd7, 0x2c, 0xff, 0x1a, 0xe7, 0xe8, 0x6d, 0xd8, 0xd0, 0x56, 0x21, 0xe2, 0x4b,
-	0xc8, 0x8a, 0x05, 0xb7, 0x96, 0x18, 0xd3, 0x1e, 0x6c, 0x15, 0xf4, 0xd7, 0x64, 0x07, 0x40, 0xd4,
-	0x05, 0xa7, 0x96, 0xcf, 0xa3, 0xf6, 0x2a, 0x21, 0x99, 0xe3, 0xc1, 0x1f, 0xc0, 0x2c, 0x2a, 0x60,
-	0xe6, 0xd0, 0xc3, 0x03, 0xa8, 0xc9, 0x2f, 0xf7, 0x98, 0x5f, 0x09, 0x57, 0x4f, 0xac, 0xe0, 0x5c,
-	0xb9, 0x2a, 0xc6, 0x62, 0x4b, 0x3e, 0x3f, 0x3b, 0xf3, 0x79, 0x78, 0xcb, 0x5f, 0x66, 0x38, 0x23,
-	0x2d, 0x58, 0xec, 0x7d, 0x87, 0x9c, 0xb0, 0xd8, 0xfb, 0x8e, 0x1e, 0xe0, 0x16, 0x95, 0xf9, 0xf9,
-	0x13, 0xa8, 0x5c, 0x88, 0x9c, 0x5d, 0x4a, 0x25, 0x33, 0xb5, 0x8e, 0x37, 0xe2, 0x52, 0x85, 0xf6,
-	0x61, 0x15, 0x5f, 0x3d, 0x72, 0xa3, 0x0d, 0xd5, 0x63, 0x67, 0xc8, 0x2f, 0xd5, 0xc7, 0x92, 0x13,
-	0xd1, 0xb0, 0x2a, 0x0d, 0x4c, 0x15, 0x59, 0x5c, 0x16, 0x29, 0xd0, 0x57, 0xda, 0x3b, 0x09, 0x72,
-	0x2f, 0xf7, 0x30, 0x74, 0x31, 0x2a, 0x6e, 0xd3, 0xab, 0x2c, 0xab, 0x4e, 0x9f, 0xc3, 0x9a, 0x0a,
-	0x6a, 0x84, 0x5e, 0xe0, 0xb0, 0x01, 0xe5, 0x47, 0xb6, 0xfa, 0x73, 0x44, 0x0c, 0x45, 0x7c, 0x85,
-	0x3e, 0xb6, 0xba, 0x72, 0x4c, 0xbf, 0xd6, 0x17, 0x92, 0xa2, 0x23, 0xcc, 0x3d, 0x08, 0x9d, 0x35,
-	0x23, 0x67, 0x33, 0xeb, 0x2c, 0x6f, 0x42, 0x99, 0xf6, 0x3e, 0x85, 0xfc, 0x15, 0x9a, 0x91, 0x2c,
-	0x0c, 0x43, 0xd8, 0xb1, 0xc6, 0xff, 0x47, 0x25, 0x97, 0x59, 0x4a, 0x19, 0xcf, 0x4d, 0xbe, 0xe4,
-	0xdc, 0x87, 0x7a, 0x24, 0x8c, 0xfe, 0x12, 0xd1, 0x20, 0xb2, 0x58, 0x8d, 0xf6, 0xa0, 0x71, 0xe2,
-	0xf1, 0xa9, 0xe5, 0xf1, 0x5e, 0x30, 0x91, 0x21, 0x7a, 0x66, 0x4d, 0x54, 0x66, 0x94, 0x63, 0x11,
-	0xc8, 0xde, 0x8b, 0x27, 0x98, 0x12, 0xc5, 0x50, 0x1c, 0x92, 0x13, 0xcb, 0xb3, 0x26, 0x22, 0xfd,
-	0xf9, 0x18, 0xce, 0x84, 0x84, 0xde, 0x29, 0xba, 0x9f, 0x11, 0xdb, 0x59, 0x88, 0xa2, 0x93, 0x8d,
-	0x33, 0x6a, 0x15, 0xd6, 0xb4, 0x62, 0xa7, 0x1f, 0x1d, 0xa2, 0x43, 0x8b, 0x47, 0x87, 0xe4, 0x00,
-	0x9a, 0x09, 0x8f, 0x7d, 0x24, 0x06, 0x45, 0x3b, 0x89, 0x25, 0x96, 0xd2, 0xa3, 0x7f, 0x2f, 0xe9,
-	0xaf, 0x77, 0x8a, 0x7c, 0xc2, 0x07, 0x2f, 0x46, 0x0f, 0xde, 0x85, 0x46, 0x8f, 0x07, 0x5f, 0x5a,
-	0x5e, 0xf8, 0xdc, 0xf2, 0x6e, 0xf9, 0x56, 0x9d, 0x25, 0x45, 0x39, 0xd7, 0x2a, 0xbf, 0xd0, 0xb5,
-	0xdf, 0x17, 0xd4, 0xdd, 0xc5, 0x79, 0xe3, 0xd3, 0x7f, 0x96, 0x13, 0x17, 0x3d, 0xa4, 0x8e, 0x7f,
-	0x2b, 0x1a, 0x0b, 0x64, 0x1d, 0x56, 0x33, 0x77, 0x2f, 0x46, 0x89, 0x18, 0xd0, 0x4c, 0x56, 0xce,
-	0xc6, 0x22, 0x69, 0x42, 0x4d, 0x15, 0xb1, 0x46, 0x99, 0xac, 0x40, 0x3d, 0x2a, 0x25, 0x8d, 0x0a,
-	0x59, 0x85, 0x46, 0xa2, 0x7e, 0x32, 0xaa, 0xa4, 0x05, 0x10, 0xb3, 0xb6, 0xb1, 0x24, 0xf0, 0x92,
-	0x74, 0x65, 0x2c, 0x0b, 0x8d, 0xb8, 0xc5, 0x37, 0x6a, 0x02, 0x31, 0xea, 0xdc, 0x8d, 0x3a, 0xd9,
-	0xd4, 0xf5, 0xee, 0x06, 0x08, 0x79, 0xbe, 0x87, 0x36, 0x1a, 0x84, 0x64, 0xbb, 0x68, 0xa3, 0x49,
-	0x1a, 0x51, 0x4b, 0x6c, 0xac, 0x90, 0x6b, 0x05, 0xdd, 0xae, 0xd1, 0x22, 0x6b, 0x99, 0x66, 0xd5,
-	0x58, 0x25, 0xed, 0x7c, 0xef, 0x69, 0x18, 0xc9, 0xb7, 0x10, 0x67, 0xd5, 0x58, 0x43, 0x49, 0x74,
-	0x3a, 0x0c, 0x22, 0xc2, 0x99, 0xe9, 0xc3, 0x8c, 0x75, 0x21, 0xcc, 0xec, 0x56, 0xa3, 0x2d, 0x1e,
-	0x9b, 0xfa, 0x88, 0xc6, 0xc6, 0xe1, 0xa3, 0xb7, 0xef, 0x76, 0x4a, 0x3f, 0xbe, 0xdb, 0x29, 0xfd,
-	0xe7, 0xdd, 0x4e, 0xe9, 0xfb, 0xf7, 0x3b, 0x0b, 0x3f, 0xbe, 0xdf, 0x59, 0xf8, 0xe9, 0xfd, 0xce,
-	0xc2, 0x9b, 0x4e, 0xe2, 0x7f, 0xe6, 0x89, 0x15, 0x78, 0xf6, 0xa5, 0xeb, 0xd9, 0x23, 0xdb, 0x51,
-	0x13, 0x87, 0xef, 0x4d, 0x2f, 0x46, 0x7b, 0xd3, 0xd3, 0x3d, 0xb9, 0x77, 0x4e, 0x97, 0x64, 0x31,
-	0xf7, 0xd9, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xe1, 0x30, 0x44, 0xfb, 0x1e, 0x00, 0x00,
+	// 2501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x77, 0x1b, 0xb7,
+	0x11, 0x37, 0x45, 0x52, 0x22, 0x87, 0x94, 0xb4, 0x82, 0x28, 0x69, 0xa5, 0xc8, 0xb2, 0xb2, 0xcd,
+	0x7b, 0x71, 0xe2, 0x56, 0x72, 0x9d, 0x5a, 0xfd, 0x77, 0x89, 0x44, 0xc5, 0xb6, 0xe2, 0x3f, 0x92,
+	0x41, 0x3a, 0x4e, 0x7c, 0xc8, 0x7b, 0x2b, 0x12, 0x92, 0xb6, 0x26, 0x77, 0x99, 0xdd, 0x65, 0x23,
+	0xe5, 0x23, 0xf4, 0x94, 0x63, 0xaf, 0xfd, 0x0e, 0xbd, 0xf4, 0x1b, 0xe4, 0x98, 0x63, 0x4e, 0x6d,
+	0x9f, 0xfd, 0xfa, 0x2d, 0x7a, 0xe8, 0x1b, 0x2c, 0x80, 0xc5, 0xee, 0x62, 0xf5, 0xd2, 0x36, 0x17,
+	0x3d, 0xcc, 0x60, 0xe6, 0x07, 0x60, 0x16, 0x98, 0x1f, 0x30, 0x14, 0xb4, 0xbe, 0x9a, 0xb2, 0xf0,
+	0x6a, 0x67, 0x12, 0x06, 0x71, 0x40, 0xea, 0x5c, 0xd8, 0x68, 0x47, 0xb1, 0x1b, 0x4f, 0xa3, 0x44,
+	0xb9, 0x01, 0xa3, 0x60, 0xf0, 0x5a, 0xb4, 0x9b, 0xf1, 0xa5, 0x2f, 0x9a, 0x8b, 0xb1, 0x37, 0x66,
+	0x51, 0xec, 0x8e, 0x27, 0x52, 0x81, 0x5e, 0x91, 0xe7, 0x9f, 0x05, 0x42, 0xf1, 0x8b, 0x73, 0x2f,
+	0xbe, 0x98, 0x9e, 0xee, 0x0c, 0x82, 0xf1, 0xee, 0x79, 0x70, 0x1e, 0xec, 0x72, 0xf5, 0xe9, 0xf4,
+	0x8c, 0x4b, 0x5c, 0xe0, 0x2d, 0x61, 0x7e, 0xeb, 0x3c, 0x08, 0xce, 0x47, 0x2c, 0xb5, 0xca, 0x0d,
+	0xe0, 0xbc, 0x07, 0xed, 0xe7, 0x38, 0x3f, 0xca, 0xbe, 0x9a, 0xb2, 0x28, 0x26, 0x1d, 0xa8, 0x73,
+	0xd9, 0xae, 0x6c, 0x57, 0x6e, 0x37, 0x69, 0x22, 0x38, 0xcf, 0x60, 0xb5, 0x77, 0x11, 0x7c, 0x7d,
+	0x12, 0x06, 0x03, 0x16, 0x45, 0x4f, 0xbc, 0x28, 0x96, 0xf6, 0xab, 0x30, 0xdb, 0x67, 0xbe, 0xeb,
+	0xc7, 0xc2, 0x41, 0x48, 0x64, 0x13, 0x9a, 0xbd, 0xab, 0x48, 0x74, 0xcd, 0x6c, 0x57, 0x6e, 0x37,
+	0x68, 0xaa, 0x70, 0x5e, 0xc2, 0x52, 0xef, 0xca, 0x1f, 0x74, 0x83, 0xf1, 0xd8, 0x53, 0x50, 0x07,
+	0xb0, 0xf0, 0xc4, 0x8d, 0x59, 0x14, 0x27, 0xea, 0x7e, 0x8f, 0x43, 0xb6, 0xee, 0x75, 0x76, 0xd2,
+	0x49, 0xf7, 0x65, 0xeb, 0xa0, 0xf6, 0xdd, 0xdf, 0x6f, 0xdd, 0xa0, 0x39, 0x0f, 0xe7, 0x15, 0x10,
+	0x1d, 0x38, 0x9a, 0x04, 0x7e, 0xc4, 0xc8, 0x21, 0x2c, 0x76, 0xa7, 0x61, 0xc8, 0xfc, 0xff, 0x06,
+	0x3a, 0xef, 0xe2, 0x10, 0xb0, 0x1e, 0xb2, 0x38, 0x33, 0x67, 0xe7, 0x0b, 0x58, 0xd2, 0x74, 0x3f,
+	0xe9, 0x70, 0xbb, 0xb0, 0xd2, 0x0d, 0x42, 0x76, 0x38, 0x1d, 0x4f, 0xba, 0x81, 0x7f, 0xe6, 0x9d,
+	0x6b, 0x21, 0xdf, 0x1f, 0xc4, 0x5e, 0xe0, 0xcb, 0x90, 0x27, 0x92, 0x63, 0xc3, 0x6a, 0xde, 0x21,
+	0x99, 0x90, 0xf3, 0x0e, 0xac, 0x3f, 0x64, 0xf1, 0x09, 0x7e, 0xf0, 0x41, 0x30, 0xfa, 0x8c, 0x85,
+	0x91, 0x17, 0xf8, 0x72, 0x09, 0x7b, 0xb0, 0x61, 0xea, 0x14, 0x6b, 0xb1, 0x61, 0x4e, 0xa8, 0xf8,
+	0x68, 0x55, 0x2a, 0x45, 0xe7, 0x3e, 0xac, 0xf7, 0xca, 0x40, 0xaf, 0x71, 0xdb, 0x83, 0x8d, 0xde,
+	0xff, 0x32, 0xdc, 0xcf, 0x61, 0x81, 0x4e, 0xfd, 0xbe, 0x1b, 0xbd, 0x96, 0x63, 0x6c, 0x40, 0x03,
+	0xc5, 0x6e, 0x30, 0x64, 0xdc, 0xb8, 0x4e, 0x95, 0xec, 0x7c, 0x00, 0x8b, 0xca, 0x5a, 0x40, 0xaf,
+	0xc2, 0x2c, 0x65, 0xd1, 0x74, 0xa4, 0x76, 0x6a, 0x22, 0x61, 0xd8, 0x70, 0xfd, 0xde, 0x84, 0x8d,
+	0x3c, 0x9f, 0x1d, 0xf9, 0x67, 0x81, 0x8c, 0xcc, 0x2e, 0xac, 0x15, 0x7a, 0x04, 0x58, 0x07, 0xea,
+	0xdd, 0x60, 0x2a, 0x76, 0x7d, 0x95, 0x26, 0x82, 0xf3, 0xef, 0x79, 0x98, 0x93, 0xb3, 0xdb, 0x84,
+	0xa6, 0x68, 0x1e, 0x1d, 0x72, 0xab, 0x1a, 0x4d, 0x15, 0x64, 0x07, 0x9a, 0xdd, 0xf1, 0xf0, 0x29,
+	0x8b, 0x2f, 0x82, 0x21, 0x3f, 0x1e, 0x0b, 0xf7, 0xac, 0x9d, 0x24, 0x6b, 0x28, 0x3d, 0x4d, 0x4d,
+	0xc8, 0xaf, 0xb3, 0xc7, 0xd4, 0xae, 0xf2, 0xfd, 0xb4, 0x2c, 0x5c, 0xf4, 0x2e, 0x9a, 0x3d, 0xcf,
+	0x2f, 0xca, 0x4e, 0xae, 0x5d, 0xe3, 0x10, 0x37, 0x05, 0x84, 0xd9, 0x88, 0x96, 0x1d, 0xfb, 0x27,
+	0xb0, 0xbc, 0x3f, 0x8a, 0x59, 0xb8, 0x3f, 0x18, 0xe0, 0xca, 0x25, 0x66, 0x9d, 0x63, 0x6e, 0x08,
+	0x4c, 0x83, 0x05, 0x35, 0xb9, 0x91, 0x8f, 0x61, 0xf1, 0xb1, 0x37, 0x1a, 0x75, 0x03, 0x5f, 0x6e,
+	0x20, 0x7b, 0x96, 0x23, 0xad, 0x0a, 0xa4, 0x5c, 0x2f, 0xcd, 0x9b, 0x93, 0x2e, 0x58, 0xfd, 0xd0,
+	0x1d, 0xb0, 0xde, 0xc4, 0x55, 0x10, 0x73, 0x1c, 0x62, 0x4d, 0x40, 0xe4, 0xbb, 0x69, 0xc1, 0x81,
+	0x1c, 0x01, 0x79, 0xc8, 0xe2, 0x27, 0xc1, 0xe0, 0xb5, 0xb6, 0x0b, 0xec, 0x06, 0x87, 0x59, 0x17,
+	0x30, 0x45, 0x03, 0x6a, 0x70, 0x22, 0x0f, 0x78, 0x5e, 0xe8, 0x5f, 0xfa, 0x3a, 0x52, 0x93, 0x23,
+	0xd9, 0x29, 0x52, 0xb6, 0x9f, 0x16, 0x5d, 0x30, 0xce, 0x98, 0x5f, 0xdc, 0xc1, 0x85, 0xbe, 0x33,
+	0x6d, 0xc8, 0xc4, 0xd9, 0x60, 0x41, 0x4d, 0x6e, 0xe4, 0x37, 0x00, 0xbd, 0xab, 0x81, 0x9f, 0xa4,
+	0x18, 0xbb, 0x95, 0x99, 0x4e, 0x21, 0x1f, 0x53, 0xcd, 0x96, 0xdc, 0x87, 0xa6, 0xca, 0x73, 0x76,
+	0x3b, 0x13, 0xd8, 0x7c, 0x4e, 0xa4, 0xa9, 0x25, 0x39, 0xe1, 0x11, 0xcd, 0x1d, 0x76, 0x7b, 0x9e,
+	0xfb, 0x6f, 0xa7, 0xfe, 0xe6, 0x24, 0x42, 0x0d, 0xbe, 0x88, 0x58, 0x4c, 0x1f, 0xf6, 0x42, 0x06,
+	0xb1, 0x57, 0x8e, 0x58, 0xec, 0x22, 0x87, 0xb0, 0x90, 0x4d, 0x9b, 0xf6, 0x22, 0x47, 0xdb, 0x94,
+	0xe7, 0xd1, 0x94, 0x84, 0x69, 0xce, 0x87, 0xec, 0xc2, 0x9c, 0x48, 0x38, 0xb6, 0xc5, 0xdd, 0x57,
+	0x84, 0x7b, 0x36, 0x69, 0x51, 0x69, 0x45, 0xbe, 0x80, 0x15, 0xca, 0xc6, 0xc1, 0x1f, 0x19, 0xfe,
+	0x8d, 0x19, 0x6e, 0xa0, 0xbe, 0x7b, 0x3a, 0x62, 0xf6, 0x12, 0x77, 0xff, 0x99, 0x74, 0x37, 0xd9,
+	0x48, 0x30, 0x33, 0x02, 0xd9, 0x87, 0x79, 0xdc, 0x92, 0x9c, 0x19, 0x0f, 0x3c, 0x7f, 0x68, 0x13,
+	0x0e, 0xf9, 0x8e, 0xb6, 0x85, 0x55, 0x9f, 0x84, 0xca, 0x7a, 0x90, 0x4f, 0xc1, 0x7a, 0xe1, 0x47,
+	0xd3, 0xd3, 0x68, 0x10, 0x7a, 0xa7, 0x2c, 0x99, 0xd8, 0x32, 0x47, 0xd9, 0x12, 0x28, 0xf9, 0x6e,
+	0x75, 0xac, 0xf2, 0x1d, 0xfa, 0x1e, 0x3e, 0x74, 0x63, 0x57, 0xee, 0xe1, 0x8e, 0x71, 0x0f, 0x6b,
+	0x16, 0xd4, 0xe4, 0x26, 0xd0, 0x7a, 0xb1, 0x1b, 0x47, 0xfa, 0x89, 0x58, 0xc9, 0xa3, 0xe5, 0x2d,
+	0xa8, 0xc9, 0x0d, 0xd3, 0xa3, 0x39, 0xf9, 0xdb, 0xab, 0x99, 0xf4, 0x68, 0x36, 0xa2, 0x25, 0xce,
+	0x08, 0xfb, 0xd4, 0x3b, 0x0f, 0xdd, 0x98, 0x61, 0x92, 0x7a, 0x10, 0x06, 0x63, 0x09, 0xbb, 0x96,
+	0x81, 0x35, 0x1b, 0xd1, 0x12, 0x67, 0x72, 0x0c, 0x1d, 0xad, 0xa7, 0xaf, 0xe6, 0x6a, 0x67, 0xbe,
+	0xaf, 0xc9, 0x84, 0x1a, 0x1d, 0x65, 0x30, 0x07, 0xe1, 0xf4, 0x54, 0x5f, 0xfb, 0x7a, 0x21, 0x98,
+	0x39, 0x0b, 0x6a, 0x72, 0x73, 0x1e, 0xc0, 0x5a, 0x81, 0x2e, 0x04, 0x5f, 0xde, 0x81, 0x46, 0x8f,
+	0x45, 0x78, 0xde, 0x22, 0xbb, 0xb2, 0x5d, 0xbd, 0xdd, 0xba, 0xb7, 0xb8, 0x23, 0x2e, 0xc4, 0x42,
+	0x4f, 0x95, 0x81, 0xf3, 0xa7, 0x05, 0x68, 0x28, 0xcf, 0x9f, 0x96, 0x47, 0x3b, 0x50, 0xff, 0x24,
+	0x0c, 0x83, 0x90, 0x13, 0x68, 0x9b, 0x26, 0x02, 0xf9, 0xbc, 0x74, 0xe2, 0x76, 0x2d, 0xb3, 0xe9,
+	0x4b, 0xac, 0x68, 0xe9, 0xba, 0x8f, 0xa1, 0x93, 0x25, 0x3c, 0x01, 0x5b, 0xcf, 0x7c, 0x31, 0x93,
+	0x09, 0x35, 0x3a, 0x22, 0xd1, 0xa5, 0xdc, 0x27, 0xc0, 0x66, 0x33, 0xf9, 0x38, 0xdf, 0x4d, 0x0b,
+	0x0e, 0xc8, 0x4e, 0x1a, 0xf9, 0x09, 0x94, 0xb9, 0x0c, 0x1d, 0x14, 0xfa, 0x69, 0xd1, 0x45, 0x6c,
+	0x9f, 0x94, 0xfb, 0x04, 0x52, 0x23, 0xbf, 0x7d, 0xf2, 0x16, 0xd4, 0xe4, 0x26, 0xe8, 0x57, 0x11,
+	0xa0, 0x00, 0x6b, 0xe6, 0xe9, 0x37, 0x67, 0x40, 0x0d, 0x4e, 0x18, 0xf6, 0x2c, 0xff, 0x09, 0x30,
+	0xc8, 0x27, 0xc2, 0x82, 0x09, 0x35, 0x3a, 0x92, 0xdf, 0x02, 0xa4, 0x04, 0x69, 0xb7, 0x32, 0x73,
+	0x2a, 0x3e, 0x38, 0xa8, 0x66, 0x4c, 0xf6, 0x8a, 0xd4, 0x69, 0x17, 0xa9, 0x53, 0x38, 0xa6, 0xa6,
+	0xe4, 0xf9, 0x35, 0xdc, 0xf9, 0xee, 0x35, 0xdc, 0xa9, 0x85, 0x25, 0xd7, 0x87, 0x90, 0xa5, 0xe4,
+	0xf9, 0xee, 0x35, 0xe4, 0x29, 0x21, 0x8b, 0x7d, 0xe4, 0x93, 0x12, 0xf6, 0xbc, 0x59, 0xc2, 0x9e,
+	0x02, 0x2a, 0xe7, 0x44, 0xee, 0xe6, 0xe9, 0x73, 0x35, 0x4f, 0x9f, 0xc2, 0x51, 0x9a, 0x91, 0x57,
+	0xd7, 0xf3, 0xe7, 0x7b, 0xd7, 0xf3, 0xa7, 0x40, 0x2b, 0x21, 0xd0, 0x03, 0x33, 0x81, 0x6e, 0x9a,
+	0x09, 0x54, 0x60, 0x65, 0x5d, 0xc8, 0xe3, 0x52, 0x06, 0xbd, 0x55, 0xca, 0xa0, 0xf2, 0xc0, 0xe6,
+	0x7b, 0xf4, 0xfd, 0x9c, 0x70, 0xa1, 0xd8, 0xcf, 0x1d, 0xe3, 0x7e, 0xd6, 0x4d, 0xa8, 0xd1, 0x51,
+	0x00, 0x6a, 0x74, 0x28, 0x00, 0x57, 0xf2, 0x80, 0x05, 0x13, 0x6a, 0x74, 0xc4, 0x14, 0x5a, 0xf2,
+	0x56, 0xb2, 0x57, 0x33, 0x29, 0xb4, 0xc4, 0x8a, 0x96, 0xb9, 0x23, 0x72, 0x81, 0x0e, 0x05, 0xf2,
+	0x5a, 0x06, 0xb9, 0xc4, 0x8a, 0x96, 0xb9, 0x13, 0x0a, 0x2b, 0x39, 0x56, 0x14, 0xb8, 0x76, 0xe6,
+	0x73, 0x1b, 0x6d, 0xa8, 0xd9, 0x55, 0x06, 0x36, 0xa5, 0x46, 0x01, 0xb9, 0x5e, 0x08, 0x6c, 0xde,
+	0x84, 0x1a, 0x1d, 0x9d, 0x23, 0xe3, 0x4b, 0x8b, 0x3f, 0x7e, 0x79, 0x2d, 0xe5, 0x68, 0x28, 0xde,
+	0xa0, 0x4a, 0xc6, 0x97, 0x6e, 0x8f, 0x73, 0x2b, 0x67, 0xb9, 0x26, 0x15, 0x92, 0xf3, 0x3b, 0x33,
+	0x19, 0x11, 0x07, 0xda, 0x2e, 0xea, 0x7b, 0xd3, 0x01, 0x12, 0x18, 0xc7, 0x6b, 0xd0, 0x8c, 0xce,
+	0x39, 0x2a, 0x3c, 0xd1, 0x90, 0x99, 0x05, 0x92, 0x60, 0xe6, 0x2a, 0x4d, 0x15, 0xfa, 0x4b, 0x7e,
+	0x86, 0xb3, 0xb6, 0xf6, 0x92, 0x2f, 0x32, 0x92, 0x0d, 0x73, 0xd9, 0xd1, 0xa5, 0xe8, 0x7c, 0x5e,
+	0x7c, 0xd9, 0x11, 0x0b, 0xaa, 0xdd, 0xf1, 0x50, 0xbc, 0xe3, 0xb1, 0x89, 0xbc, 0x8e, 0x06, 0x11,
+	0x1f, 0xab, 0x49, 0x13, 0x01, 0x67, 0xd8, 0xbf, 0x08, 0x59, 0x74, 0x11, 0x8c, 0x86, 0x3c, 0x16,
+	0x55, 0x9a, 0x2a, 0x9c, 0xf7, 0x0d, 0x2c, 0x48, 0x08, 0xd4, 0xb0, 0x2d, 0xb0, 0x79, 0xdb, 0xe9,
+	0x98, 0xde, 0x85, 0xce, 0x0f, 0x15, 0x68, 0x48, 0x1d, 0xce, 0x9f, 0x9f, 0x54, 0xf1, 0x35, 0x6a,
+	0x54, 0x8a, 0x08, 0xf8, 0x98, 0x5d, 0xe1, 0xc4, 0xaa, 0xb7, 0xdb, 0x94, 0xb7, 0xc9, 0x87, 0x89,
+	0xe7, 0x53, 0xac, 0x5c, 0x54, 0xf9, 0xa5, 0x65, 0x61, 0x87, 0x17, 0x04, 0xa5, 0x96, 0xaa, 0x7e,
+	0xb2, 0x0d, 0x2d, 0x2f, 0xa2, 0xae, 0x7f, 0xce, 0xf3, 0x13, 0xbf, 0x8f, 0x34, 0xa8, 0xae, 0x22,
+	0xef, 0xc3, 0xdc, 0xa3, 0x60, 0x34, 0x64, 0x61, 0x64, 0xd7, 0xf9, 0xd5, 0x6a, 0x3e, 0x01, 0x7b,
+	0xe9, 0x7a, 0x48, 0x8c, 0x54, 0xf6, 0xa2, 0x21, 0xea, 0xd0, 0x70, 0xd6, 0x68, 0x28, 0x7a, 0x9d,
+	0x2f, 0x8d, 0xbc, 0x8e, 0x4b, 0xe9, 0xfa, 0x47, 0x32, 0xee, 0xbc, 0x4d, 0x3e, 0x82, 0xb6, 0xb4,
+	0xc3, 0x8b, 0x8f, 0x3d, 0x23, 0x2e, 0x77, 0xc9, 0x3e, 0x57, 0x10, 0x19, 0x23, 0x67, 0xd9, 0xf0,
+	0x3a, 0x76, 0x2e, 0xa0, 0xd5, 0xbf, 0xf4, 0x7f, 0x5c, 0x44, 0x69, 0xf0, 0xb5, 0x8a, 0x28, 0xb6,
+	0xc9, 0x1d, 0x98, 0x3b, 0x9e, 0xc4, 0xfc, 0x7a, 0x99, 0x94, 0x46, 0x96, 0xd2, 0x80, 0x8a, 0x0e,
+	0x2a, 0x2d, 0x9c, 0xbf, 0x55, 0x60, 0x4e, 0x0c, 0x4e, 0x3e, 0x86, 0x46, 0x37, 0x64, 0x6e, 0xcc,
+	0xf6, 0x63, 0x51, 0xa4, 0xdb, 0xd8, 0x49, 0x6a, 0xa6, 0x3b, 0xb2, 0x66, 0xaa, 0x95, 0xea, 0x1a,
+	0x58, 0xaa, 0xfb, 0xf6, 0x1f, 0xb7, 0x2a, 0x54, 0x79, 0x91, 0x6d, 0xa8, 0x3d, 0x65, 0xb1, 0xcb,
+	0x77, 0x5e, 0xeb, 0x5e, 0x7b, 0x07, 0xab, 0xb9, 0xfd, 0x4b, 0x1f, 0x75, 0x94, 0xf7, 0xe0, 0x52,
+	0x5e, 0x44, 0x2c, 0xec, 0x5f, 0xfa, 0x7c, 0x72, 0x0d, 0x2a, 0x45, 0x72, 0x17, 0x9a, 0x18, 0x73,
+	0x9c, 0x65, 0x64, 0xd7, 0x78, 0xe8, 0x88, 0xbc, 0x80, 0xa5, 0xb1, 0xa0, 0xa9, 0x11, 0x16, 0x38,
+	0x0d, 0xf7, 0x1d, 0xd3, 0x97, 0xb9, 0x0b, 0x2d, 0x61, 0xa6, 0x7d, 0x98, 0x85, 0x14, 0x9d, 0x03,
+	0xe8, 0x26, 0xce, 0x8a, 0xb1, 0xd8, 0xe0, 0xfc, 0xa5, 0x02, 0x4d, 0xa5, 0xc4, 0xc4, 0xf3, 0x2c,
+	0x18, 0xb2, 0xfe, 0xd5, 0x84, 0x89, 0xe1, 0x94, 0x8c, 0x89, 0x07, 0xdb, 0x47, 0x43, 0x71, 0x0c,
+	0x85, 0x44, 0x36, 0x05, 0x00, 0x77, 0x4a, 0x72, 0x52, 0xaa, 0xc0, 0xc9, 0xbf, 0x88, 0xd8, 0x90,
+	0x6f, 0xed, 0x1a, 0xe5, 0x6d, 0xd4, 0x3d, 0x08, 0x59, 0x72, 0x4f, 0xae, 0x51, 0xde, 0xc6, 0x91,
+	0x1f, 0x79, 0x31, 0x75, 0x63, 0x2f, 0xe0, 0x57, 0xde, 0x19, 0xaa, 0x64, 0xe7, 0x99, 0xf9, 0xc2,
+	0x47, 0xf6, 0x60, 0x5e, 0x29, 0x79, 0x18, 0x92, 0xc7, 0x87, 0x7a, 0x23, 0x28, 0x87, 0xac, 0x99,
+	0x08, 0x45, 0xe1, 0x85, 0xf3, 0xaf, 0x0a, 0x34, 0x95, 0x52, 0x5b, 0x6e, 0x25, 0xb3, 0xdc, 0x2d,
+	0x80, 0xe3, 0xd3, 0x3f, 0xb0, 0x41, 0xfc, 0xcc, 0x1d, 0x33, 0x11, 0x0a, 0x4d, 0x83, 0x8b, 0x3b,
+	0x3c, 0x3d, 0x4a, 0x32, 0x52, 0x8d, 0xf2, 0xb6, 0xbe, 0xdd, 0x6b, 0xd9, 0xed, 0xae, 0x9e, 0x2c,
+	0xf5, 0x24, 0xb5, 0x71, 0x01, 0xc7, 0x38, 0x64, 0x31, 0x1b, 0xc4, 0x6c, 0xb8, 0x9f, 0x54, 0xcb,
+	0xaa, 0x54, 0xd3, 0x60, 0xb0, 0x28, 0x9b, 0xb8, 0x5e, 0xc8, 0x86, 0xfc, 0x66, 0xdf, 0xa0, 0x4a,
+	0xc6, 0x94, 0x92, 0xb4, 0x13, 0xdc, 0x06, 0xc7, 0xd5, 0x55, 0x22, 0x9c, 0x05, 0x32, 0xc2, 0x70,
+	0x2a, 0xa5, 0x21, 0x9c, 0xa9, 0x43, 0xd6, 0xcc, 0x19, 0xc1, 0xe6, 0x75, 0x85, 0x0c, 0x5c, 0xfd,
+	0xc3, 0x30, 0x98, 0x4e, 0x04, 0x91, 0xcc, 0x53, 0x29, 0xa6, 0x71, 0x39, 0x94, 0x34, 0x22, 0x44,
+	0x9d, 0x60, 0xaa, 0x59, 0x82, 0xb9, 0x0f, 0x37, 0xaf, 0xbd, 0xf6, 0x65, 0xab, 0xb7, 0x75, 0x59,
+	0xbd, 0xfd, 0x14, 0x3a, 0x99, 0x2b, 0xdc, 0xff, 0x31, 0x39, 0xe7, 0x0e, 0xac, 0x18, 0x6f, 0x89,
+	0xf8, 0xed, 0x51, 0x96, 0x27, 0x15, 0xdb, 0x4e, 0x0f, 0xd6, 0x4a, 0xaa, 0x29, 0xfc, 0x33, 0xbb,
+	0xb1, 0x7b, 0xea, 0x46, 0x4c, 0x3d, 0x7f, 0x35, 0xcd, 0x35, 0x33, 0xf8, 0x15, 0xd8, 0x65, 0x17,
+	0xcc, 0x6b, 0xd8, 0xf6, 0x01, 0x34, 0xf8, 0x41, 0x78, 0xcc, 0xae, 0x70, 0xaa, 0x27, 0x6e, 0x7c,
+	0x21, 0xa7, 0x8a, 0x6d, 0xdc, 0xf2, 0xc7, 0x67, 0x67, 0x11, 0x4b, 0x7e, 0xd3, 0xa9, 0x52, 0x21,
+	0x91, 0x05, 0x98, 0xe9, 0x7d, 0x23, 0x28, 0x76, 0xa6, 0xf7, 0x8d, 0xb3, 0x27, 0x4e, 0x3c, 0xa7,
+	0xbb, 0x0f, 0xa0, 0xf6, 0x1a, 0x29, 0xb0, 0x92, 0xe1, 0x06, 0xd9, 0x2f, 0x7e, 0xff, 0xe0, 0x26,
+	0x4e, 0x1f, 0x16, 0xc5, 0xd2, 0xd5, 0x34, 0x3a, 0x50, 0x3f, 0xf2, 0x87, 0xec, 0x52, 0x7e, 0x2c,
+	0x2e, 0x60, 0x41, 0x41, 0x5a, 0x88, 0xcc, 0x9b, 0xc7, 0xa5, 0xca, 0xc0, 0x79, 0x69, 0xac, 0x40,
+	0x61, 0xd9, 0x39, 0x37, 0x98, 0x98, 0xa2, 0x7a, 0x7c, 0x64, 0x7b, 0x69, 0xde, 0xdc, 0x39, 0x86,
+	0x25, 0x19, 0x54, 0x85, 0x5e, 0x32, 0x61, 0x0b, 0xaa, 0x8f, 0x3c, 0xf9, 0x53, 0x18, 0x36, 0x79,
+	0x1a, 0x70, 0x63, 0x57, 0x94, 0x22, 0x78, 0xdb, 0xf9, 0xd2, 0x7c, 0xd1, 0xc7, 0x17, 0x7b, 0x61,
+	0x20, 0x31, 0x59, 0x5b, 0x4d, 0x36, 0xd7, 0x4f, 0x8b, 0x2e, 0x0e, 0x35, 0x56, 0xcf, 0xc8, 0xef,
+	0xa1, 0xad, 0x74, 0x49, 0x18, 0x92, 0x8a, 0x42, 0xfa, 0xeb, 0xa3, 0xde, 0x4d, 0x33, 0xc6, 0xe2,
+	0xdc, 0x14, 0x9f, 0x04, 0xf7, 0xa0, 0xa9, 0x94, 0xea, 0x07, 0x30, 0x03, 0x22, 0x4d, 0xcd, 0x9c,
+	0x1e, 0xb4, 0x4e, 0x42, 0x36, 0x71, 0x43, 0xd6, 0x8b, 0xc7, 0x3c, 0x44, 0x3c, 0x87, 0x8a, 0x2d,
+	0x88, 0x6d, 0x0c, 0x64, 0xef, 0xf9, 0x13, 0x91, 0x56, 0xb1, 0x89, 0x87, 0xe4, 0xc4, 0x0d, 0xdd,
+	0x31, 0xb2, 0x49, 0x24, 0xc2, 0xa9, 0x69, 0x9c, 0xbb, 0x65, 0xd5, 0x38, 0xdc, 0xce, 0xa8, 0x52,
+	0x27, 0x5b, 0x48, 0x8e, 0x5b, 0xfa, 0xe6, 0xc0, 0x9d, 0x7e, 0x78, 0x20, 0x26, 0x34, 0x73, 0x78,
+	0x40, 0xf6, 0xa0, 0xad, 0xcd, 0x38, 0xb2, 0x67, 0x32, 0x2c, 0xae, 0x75, 0xd1, 0x8c, 0x9d, 0xf3,
+	0xe7, 0x8a, 0xb9, 0x98, 0x57, 0x36, 0x27, 0x31, 0xf0, 0x8c, 0x1a, 0x78, 0x1b, 0x5a, 0x3d, 0x16,
+	0x7f, 0xe6, 0x86, 0xc9, 0xb8, 0xd5, 0xed, 0x2a, 0x66, 0x71, 0x4d, 0x55, 0x98, 0x5a, 0xed, 0x47,
+	0x4e, 0xed, 0x97, 0x25, 0xef, 0xa2, 0xf2, 0xbc, 0xf1, 0xe1, 0x5f, 0xab, 0x5a, 0x21, 0x8e, 0x34,
+	0xc5, 0x8f, 0xc8, 0xd6, 0x0d, 0xb2, 0x0c, 0x8b, 0xb9, 0xda, 0x98, 0x55, 0x21, 0x16, 0xb4, 0xf5,
+	0x87, 0x88, 0x35, 0x43, 0xda, 0xd0, 0x90, 0x6f, 0x02, 0xab, 0x4a, 0xe6, 0xa1, 0xa9, 0x6e, 0xe6,
+	0x56, 0x8d, 0x2c, 0x42, 0x4b, 0xbb, 0x8e, 0x5a, 0x75, 0xb2, 0x00, 0x90, 0x5e, 0x82, 0xac, 0x59,
+	0xc4, 0xd3, 0xd9, 0xdf, 0x9a, 0x43, 0x8b, 0xb4, 0x04, 0x63, 0x35, 0x10, 0x51, 0x55, 0x56, 0xac,
+	0x26, 0x59, 0x35, 0xd5, 0x56, 0x2c, 0x40, 0x7d, 0xb1, 0xc6, 0x61, 0xb5, 0x08, 0xc9, 0x57, 0x39,
+	0xac, 0x36, 0x69, 0xa9, 0x92, 0x85, 0x35, 0x4f, 0xd6, 0x4b, 0xaa, 0x11, 0xd6, 0x02, 0x59, 0xca,
+	0x15, 0x13, 0xac, 0x45, 0xd2, 0x29, 0xd6, 0x06, 0x2c, 0x4b, 0x5f, 0x05, 0x9e, 0x55, 0x6b, 0x49,
+	0x68, 0xd4, 0xe9, 0xb0, 0x08, 0x86, 0x33, 0xf7, 0x4e, 0xb6, 0x96, 0x51, 0x99, 0xdb, 0xad, 0x56,
+	0x07, 0x87, 0xcd, 0x7c, 0x44, 0x6b, 0x45, 0xc2, 0x49, 0x66, 0xb6, 0x56, 0x0f, 0x1e, 0x7d, 0xf7,
+	0x66, 0xab, 0xf2, 0xfd, 0x9b, 0xad, 0xca, 0x3f, 0xdf, 0x6c, 0x55, 0xbe, 0x7d, 0xbb, 0x75, 0xe3,
+	0xfb, 0xb7, 0x5b, 0x37, 0x7e, 0x78, 0xbb, 0x75, 0xe3, 0xd5, 0x8e, 0xf6, 0x7f, 0x06, 0x63, 0x37,
+	0x0e, 0xbd, 0xcb, 0x20, 0xf4, 0xce, 0x3d, 0x5f, 0x0a, 0x3e, 0xdb, 0x9d, 0xbc, 0x3e, 0xdf, 0x9d,
+	0x9c, 0xee, 0xf2, 0xdd, 0x74, 0x3a, 0xcb, 0x6f, 0xcb, 0x1f, 0xfd, 0x67, 0x00, 0x7a, 0xa9, 0x8f,
+	0xf6, 0xfb, 0x20, 0x00, 0x00,
 }
 
 func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
@@ -3893,6 +4115,20 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GetScrubInfoRequest != nil {
+		{
+			size, err := m.GetScrubInfoRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.MigrateConnToRequest != nil {
 		{
 			size, err := m.MigrateConnToRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -4245,6 +4481,20 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GetScrubInfoResponse != nil {
+		{
+			size, err := m.GetScrubInfoResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.MigrateConnToResponse != nil {
 		{
 			size, err := m.MigrateConnToResponse.MarshalToSizedBuffer(dAtA[:i])
@@ -5016,12 +5266,12 @@ func (m *TxnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
-	if err49 != nil {
-		return 0, err49
+	n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
+	if err51 != nil {
+		return 0, err51
 	}
-	i -= n49
-	i = encodeVarintQuery(dAtA, i, uint64(n49))
+	i -= n51
+	i = encodeVarintQuery(dAtA, i, uint64(n51))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -5191,7 +5441,7 @@ func (m *GetCacheInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRemoteLockTableRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetScrubInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5201,35 +5451,20 @@ func (m *RemoveRemoteLockTableRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveRemoteLockTableRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetScrubInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveRemoteLockTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetScrubInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TableID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TableID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRemoteLockTableResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScrubInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5239,25 +5474,73 @@ func (m *RemoveRemoteLockTableResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveRemoteLockTableResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScrubInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveRemoteLockTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScrubInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+	if len(m.RepairError) > 0 {
+		i -= len(m.RepairError)
+		copy(dAtA[i:], m.RepairError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RepairError)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x42
+	}
+	if m.Repaired {
+		i--
+		if m.Repaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DetectedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DetectedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TableId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TableId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DbId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DbId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestBindRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetScrubInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5267,25 +5550,128 @@ func (m *GetLatestBindRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLatestBindRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetScrubInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLatestBindRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetScrubInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TableID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TableID))
-		i--
-		dAtA[i] = 0x10
+	if len(m.ScrubInfoList) > 0 {
+		for iNdEx := len(m.ScrubInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScrubInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.GroupID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
-		i--
-		dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRemoteLockTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRemoteLockTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRemoteLockTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TableID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TableID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRemoteLockTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRemoteLockTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRemoteLockTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBindRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBindRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBindRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TableID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TableID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -6194,6 +6580,10 @@ func (m *Request) Size() (n int) {
 		l = m.MigrateConnToRequest.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.GetScrubInfoRequest != nil {
+		l = m.GetScrubInfoRequest.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -6312,6 +6702,10 @@ func (m *Response) Size() (n int) {
 		l = m.MigrateConnToResponse.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.GetScrubInfoResponse != nil {
+		l = m.GetScrubInfoResponse.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -6595,6 +6989,67 @@ func (m *GetCacheInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetScrubInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ScrubInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DbId != 0 {
+		n += 1 + sovQuery(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovQuery(uint64(m.TableId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DetectedAt != 0 {
+		n += 1 + sovQuery(uint64(m.DetectedAt))
+	}
+	if m.Repaired {
+		n += 2
+	}
+	l = len(m.RepairError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetScrubInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScrubInfoList) > 0 {
+		for _, e := range m.ScrubInfoList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RemoveRemoteLockTableRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8904,6 +9359,42 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetScrubInfoRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetScrubInfoRequest == nil {
+				m.GetScrubInfoRequest = &GetScrubInfoRequest{}
+			}
+			if err := m.GetScrubInfoRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9866,6 +10357,42 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetScrubInfoResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetScrubInfoResponse == nil {
+				m.GetScrubInfoResponse = &GetScrubInfoResponse{}
+			}
+			if err := m.GetScrubInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11610,6 +12137,395 @@ func (m *GetCacheInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetScrubInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetScrubInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetScrubInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScrubInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrubInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrubInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			}
+			m.DbId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			m.DetectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repaired = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepairError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetScrubInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetScrubInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetScrubInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrubInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrubInfoList = append(m.ScrubInfoList, &ScrubInfo{})
+			if err := m.ScrubInfoList[len(m.ScrubInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RemoveRemoteLockTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -273,6 +273,115 @@ func (m *ShuffleRange) GetResult() []float64 {
 	return nil
 }
 
+// Histogram is an equi-depth histogram over the float64 encoding of a column,
+// the same encoding used by MinValMap and MaxValMap. Bucket i covers
+// [Bounds[i], Bounds[i+1]] and holds Counts[i] non-null rows.
+type Histogram struct {
+	Bounds []float64 `protobuf:"fixed64,1,rep,packed,name=Bounds,proto3" json:"Bounds,omitempty"`
+	Counts []float64 `protobuf:"fixed64,2,rep,packed,name=Counts,proto3" json:"Counts,omitempty"`
+}
+
+func (m *Histogram) Reset()         { *m = Histogram{} }
+func (m *Histogram) String() string { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()    {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3f8e561c9795adb, []int{2}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Histogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
+func (m *Histogram) GetBounds() []float64 {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *Histogram) GetCounts() []float64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+// MCVList holds the most common values of a column and the fraction of the
+// table rows each of them accounts for.
+type MCVList struct {
+	Vals  []float64 `protobuf:"fixed64,1,rep,packed,name=Vals,proto3" json:"Vals,omitempty"`
+	Freqs []float64 `protobuf:"fixed64,2,rep,packed,name=Freqs,proto3" json:"Freqs,omitempty"`
+}
+
+func (m *MCVList) Reset()         { *m = MCVList{} }
+func (m *MCVList) String() string { return proto.CompactTextString(m) }
+func (*MCVList) ProtoMessage()    {}
+func (*MCVList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3f8e561c9795adb, []int{3}
+}
+func (m *MCVList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MCVList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MCVList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MCVList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MCVList.Merge(m, src)
+}
+func (m *MCVList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MCVList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MCVList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MCVList proto.InternalMessageInfo
+
+func (m *MCVList) GetVals() []float64 {
+	if m != nil {
+		return m.Vals
+	}
+	return nil
+}
+
+func (m *MCVList) GetFreqs() []float64 {
+	if m != nil {
+		return m.Freqs
+	}
+	return nil
+}
+
 type StatsInfo struct {
 	NdvMap               map[string]float64       `protobuf:"bytes,1,rep,name=NdvMap,proto3" json:"NdvMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	MinValMap            map[string]float64       `protobuf:"bytes,2,rep,name=MinValMap,proto3" json:"MinValMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
@@ -286,13 +395,15 @@ type StatsInfo struct {
 	ApproxObjectNumber   int64                    `protobuf:"varint,10,opt,name=ApproxObjectNumber,proto3" json:"ApproxObjectNumber,omitempty"`
 	TableCnt             float64                  `protobuf:"fixed64,11,opt,name=TableCnt,proto3" json:"TableCnt,omitempty"`
 	TableName            string                   `protobuf:"bytes,12,opt,name=TableName,proto3" json:"TableName,omitempty"`
+	HistogramMap         map[string]*Histogram    `protobuf:"bytes,13,rep,name=HistogramMap,proto3" json:"HistogramMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MCVMap               map[string]*MCVList      `protobuf:"bytes,14,rep,name=MCVMap,proto3" json:"MCVMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StatsInfo) Reset()         { *m = StatsInfo{} }
 func (m *StatsInfo) String() string { return proto.CompactTextString(m) }
 func (*StatsInfo) ProtoMessage()    {}
 func (*StatsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{2}
+	return fileDescriptor_a3f8e561c9795adb, []int{4}
 }
 func (m *StatsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,6 +516,20 @@ func (m *StatsInfo) GetTableName() string {
 	return ""
 }
 
+func (m *StatsInfo) GetHistogramMap() map[string]*Histogram {
+	if m != nil {
+		return m.HistogramMap
+	}
+	return nil
+}
+
+func (m *StatsInfo) GetMCVMap() map[string]*MCVList {
+	if m != nil {
+		return m.MCVMap
+	}
+	return nil
+}
+
 type StatsInfoKey struct {
 	DatabaseID uint64 `protobuf:"varint,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
 	TableID    uint64 `protobuf:"varint,2,opt,name=TableID,proto3" json:"TableID,omitempty"`
@@ -414,7 +539,7 @@ func (m *StatsInfoKey) Reset()         { *m = StatsInfoKey{} }
 func (m *StatsInfoKey) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKey) ProtoMessage()    {}
 func (*StatsInfoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{3}
+	return fileDescriptor_a3f8e561c9795adb, []int{5}
 }
 func (m *StatsInfoKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +590,7 @@ func (m *StatsInfoKeys) Reset()         { *m = StatsInfoKeys{} }
 func (m *StatsInfoKeys) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKeys) ProtoMessage()    {}
 func (*StatsInfoKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{4}
+	return fileDescriptor_a3f8e561c9795adb, []int{6}
 }
 func (m *StatsInfoKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,8 +629,12 @@ func (m *StatsInfoKeys) GetKeys() []StatsInfoKey {
 func init() {
 	proto.RegisterType((*ShuffleHeap)(nil), "statsinfo.ShuffleHeap")
 	proto.RegisterType((*ShuffleRange)(nil), "statsinfo.ShuffleRange")
+	proto.RegisterType((*Histogram)(nil), "statsinfo.Histogram")
+	proto.RegisterType((*MCVList)(nil), "statsinfo.MCVList")
 	proto.RegisterType((*StatsInfo)(nil), "statsinfo.StatsInfo")
 	proto.RegisterMapType((map[string]uint64)(nil), "statsinfo.StatsInfo.DataTypeMapEntry")
+	proto.RegisterMapType((map[string]*Histogram)(nil), "statsinfo.StatsInfo.HistogramMapEntry")
+	proto.RegisterMapType((map[string]*MCVList)(nil), "statsinfo.StatsInfo.MCVMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MaxValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MinValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.NdvMapEntry")
@@ -519,60 +648,68 @@ func init() {
 func init() { proto.RegisterFile("statsinfo.proto", fileDescriptor_a3f8e561c9795adb) }
 
 var fileDescriptor_a3f8e561c9795adb = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xad, 0x63, 0x37, 0x8d, 0x3f, 0x67, 0xdb, 0xd5, 0xa8, 0xea, 0x8e, 0x2a, 0x14, 0x4c, 0x00,
-	0xc9, 0xac, 0xd8, 0x44, 0x84, 0xcb, 0xb2, 0xfc, 0x90, 0xda, 0x2d, 0xd0, 0x6a, 0x9b, 0xae, 0x34,
-	0x29, 0x7b, 0x80, 0xd3, 0x38, 0x4c, 0x5c, 0x53, 0xc7, 0xb6, 0xfc, 0xa3, 0x38, 0xfd, 0x2b, 0xf8,
-	0xb3, 0xf6, 0xb8, 0xc7, 0x3d, 0x21, 0xd4, 0x1e, 0xf8, 0x23, 0xb8, 0xa0, 0xf9, 0xc6, 0x4e, 0xdc,
-	0x62, 0x15, 0xe5, 0x94, 0x79, 0xdf, 0xbc, 0xf7, 0x66, 0xe6, 0xcb, 0x9b, 0x31, 0xec, 0xa4, 0x19,
-	0xcf, 0x52, 0x3f, 0x9c, 0x45, 0x83, 0x38, 0x89, 0xb2, 0x88, 0x98, 0xcb, 0xc2, 0xfe, 0x33, 0xcf,
-	0xcf, 0x2e, 0x72, 0x77, 0x30, 0x8d, 0xe6, 0x43, 0x2f, 0xf2, 0xa2, 0x21, 0x32, 0xdc, 0x7c, 0x86,
-	0x08, 0x01, 0x8e, 0x94, 0xb2, 0xff, 0xb7, 0x06, 0xd6, 0xe4, 0x22, 0x9f, 0xcd, 0x02, 0x71, 0x2c,
-	0x78, 0x4c, 0x9e, 0x82, 0x71, 0x2a, 0x66, 0x19, 0xd5, 0x6c, 0xcd, 0xb1, 0x46, 0x7b, 0x83, 0xd5,
-	0x4a, 0x35, 0x16, 0x43, 0x0e, 0xf9, 0x1c, 0x36, 0x99, 0xef, 0x5d, 0x64, 0xb4, 0xf5, 0x20, 0x59,
-	0x91, 0xc8, 0x63, 0xd0, 0x5f, 0x89, 0x05, 0xd5, 0x6d, 0xcd, 0xd1, 0x98, 0x1c, 0x92, 0x5d, 0xd8,
-	0x7c, 0xc3, 0x83, 0x5c, 0x50, 0x03, 0x6b, 0x0a, 0x90, 0x3d, 0x68, 0x1f, 0x0b, 0xb4, 0xdd, 0xb4,
-	0x35, 0x47, 0x67, 0x25, 0x22, 0xdb, 0xd0, 0x9a, 0x5c, 0xd3, 0x36, 0xd6, 0x5a, 0x93, 0x6b, 0xa9,
-	0x3e, 0xcb, 0x83, 0x20, 0xa5, 0x5b, 0x58, 0x52, 0x80, 0x50, 0xd8, 0x62, 0xe2, 0x4a, 0x24, 0xa9,
-	0xa0, 0x1d, 0x5b, 0x73, 0x3a, 0xac, 0x82, 0xfd, 0xf7, 0x2d, 0xe8, 0x96, 0xdb, 0x62, 0x3c, 0xf4,
-	0x04, 0xf9, 0x00, 0xcc, 0x93, 0x74, 0x92, 0x25, 0xe7, 0x8b, 0x58, 0xe0, 0x79, 0x3b, 0x6c, 0x55,
-	0x28, 0x97, 0x6b, 0x2d, 0x97, 0x7b, 0x0a, 0xc6, 0x79, 0x22, 0x04, 0xee, 0xff, 0x81, 0xc6, 0x48,
-	0x8e, 0x3c, 0xea, 0xd8, 0x0f, 0xcb, 0x63, 0xc9, 0x21, 0x56, 0x78, 0x81, 0x27, 0x92, 0x15, 0x5e,
-	0x10, 0x02, 0xc6, 0xd8, 0x0f, 0x53, 0xda, 0xb6, 0x75, 0xa7, 0xcb, 0x70, 0x8c, 0x35, 0x5e, 0xc8,
-	0x13, 0xa9, 0x1a, 0x2f, 0xb0, 0xc6, 0xa2, 0xdf, 0x53, 0xda, 0xb1, 0x75, 0x47, 0x67, 0x38, 0x5e,
-	0x1d, 0xdd, 0xc4, 0x62, 0x79, 0xf4, 0x3d, 0x68, 0x8f, 0x79, 0x71, 0x2a, 0x42, 0x0a, 0xaa, 0x71,
-	0x0a, 0x49, 0xf6, 0x0f, 0x01, 0xf7, 0x52, 0x6a, 0xd9, 0xba, 0xd3, 0x61, 0x0a, 0xc8, 0x46, 0xbd,
-	0xbe, 0x12, 0x49, 0xc0, 0x63, 0xda, 0xc5, 0x5d, 0x55, 0x50, 0xce, 0xfc, 0x14, 0xfa, 0xb3, 0x28,
-	0x99, 0xd3, 0x47, 0x6a, 0xa6, 0x84, 0x72, 0x05, 0x26, 0xd2, 0x3c, 0xc8, 0xe8, 0xb6, 0xad, 0x3b,
-	0x1a, 0x2b, 0x51, 0xff, 0x9f, 0x0e, 0x98, 0x13, 0xd9, 0x8f, 0x93, 0x70, 0x16, 0x91, 0xe7, 0xd0,
-	0x3e, 0xfb, 0xf5, 0x6a, 0xcc, 0x63, 0xaa, 0xd9, 0xba, 0x63, 0x8d, 0xec, 0x7a, 0xaf, 0x2a, 0xd6,
-	0x40, 0x51, 0xbe, 0x0f, 0xb3, 0x64, 0xc1, 0x4a, 0x3e, 0x39, 0x00, 0x73, 0xec, 0x87, 0x6f, 0x78,
-	0x20, 0xc5, 0x2d, 0x14, 0x7f, 0xdc, 0x28, 0x5e, 0xb2, 0x94, 0x7e, 0xa5, 0x42, 0x0b, 0x5e, 0x94,
-	0x16, 0xfa, 0x43, 0x16, 0x15, 0xab, 0xb2, 0xa8, 0x30, 0xf9, 0x11, 0xac, 0x23, 0x9e, 0x71, 0x99,
-	0x02, 0x69, 0x62, 0xa0, 0xc9, 0xa7, 0x8d, 0x26, 0x35, 0x9e, 0xb2, 0xa9, 0x2b, 0xc9, 0x11, 0x80,
-	0xfc, 0x67, 0x5e, 0x86, 0x99, 0xf4, 0xd9, 0x44, 0x9f, 0x4f, 0x9a, 0x9b, 0xb1, 0xa4, 0x29, 0x9b,
-	0x9a, 0x8e, 0x7c, 0x0d, 0x5b, 0x13, 0xff, 0x1a, 0xb7, 0xd2, 0x46, 0x8b, 0x8f, 0x1a, 0x2d, 0x4a,
-	0x8e, 0xd2, 0x57, 0x0a, 0x32, 0x81, 0x9d, 0x7a, 0xe6, 0xa5, 0xc9, 0x16, 0x9a, 0x7c, 0xd6, 0x6c,
-	0x72, 0x97, 0xab, 0xcc, 0xee, 0x3b, 0x10, 0x1b, 0xac, 0xc3, 0x20, 0x9a, 0x5e, 0x9e, 0xe5, 0x73,
-	0x57, 0x24, 0x78, 0xcf, 0x74, 0x56, 0x2f, 0x91, 0x11, 0xec, 0x1e, 0x4c, 0xa7, 0x79, 0xc2, 0x33,
-	0xf1, 0xda, 0xfd, 0x4d, 0x4c, 0xb3, 0x92, 0x6a, 0x22, 0xb5, 0x71, 0x8e, 0x0c, 0x80, 0x1c, 0xc4,
-	0x71, 0x12, 0x15, 0x77, 0x14, 0x2a, 0xca, 0x0d, 0x33, 0x64, 0x1f, 0x3a, 0xe7, 0xdc, 0x0d, 0xc4,
-	0xcb, 0x30, 0xa3, 0x16, 0xe6, 0x74, 0x89, 0xe5, 0xd5, 0xc6, 0xf1, 0x19, 0x9f, 0x0b, 0x8c, 0xb7,
-	0xc9, 0x56, 0x85, 0xfd, 0xaf, 0xc0, 0xaa, 0xa5, 0x4f, 0xde, 0xcd, 0x4b, 0xb1, 0xc0, 0x17, 0xc0,
-	0x64, 0x72, 0x28, 0x6f, 0xcc, 0x15, 0x3e, 0x4c, 0x2d, 0xf5, 0x30, 0x21, 0x78, 0xd1, 0x7a, 0xae,
-	0xed, 0x7f, 0x03, 0xdb, 0x77, 0xb3, 0xb7, 0xb6, 0xfa, 0x4e, 0xec, 0xd6, 0x52, 0x7f, 0x07, 0x8f,
-	0xef, 0xe7, 0xed, 0xff, 0xf4, 0x46, 0x5d, 0xff, 0x2d, 0xec, 0xdc, 0xcb, 0xd9, 0x5a, 0xf2, 0x17,
-	0xd0, 0xad, 0x67, 0x6c, 0x2d, 0xed, 0x2f, 0xb0, 0xdb, 0x14, 0xad, 0x06, 0x8f, 0x67, 0x75, 0x0f,
-	0x6b, 0xf4, 0xe4, 0xbf, 0xef, 0x2c, 0x3a, 0xd4, 0xcc, 0xfb, 0xc7, 0xd0, 0x5d, 0x26, 0x58, 0x7e,
-	0x56, 0x7a, 0x00, 0xb2, 0x4f, 0x2e, 0x4f, 0xc5, 0xc9, 0x11, 0x7a, 0x1b, 0xac, 0x56, 0x91, 0xef,
-	0x1b, 0x66, 0xe1, 0xe4, 0xa8, 0xdc, 0x68, 0x05, 0xfb, 0x87, 0xf0, 0xa8, 0xee, 0x94, 0x92, 0x2f,
-	0xc0, 0x90, 0xbf, 0xe5, 0x43, 0xf6, 0xa4, 0xe9, 0xce, 0xbc, 0x12, 0x8b, 0x43, 0xe3, 0xed, 0x9f,
-	0x1f, 0x6e, 0x30, 0xa4, 0x1e, 0x9e, 0xbe, 0xbd, 0xe9, 0x69, 0xef, 0x6e, 0x7a, 0xda, 0x5f, 0x37,
-	0x3d, 0xed, 0x8f, 0xdb, 0xde, 0xc6, 0xbb, 0xdb, 0xde, 0xc6, 0xfb, 0xdb, 0xde, 0xc6, 0xcf, 0xa3,
-	0xda, 0x97, 0x79, 0xce, 0xb3, 0xc4, 0x2f, 0xa2, 0xc4, 0xf7, 0xfc, 0xb0, 0x02, 0xa1, 0x18, 0xc6,
-	0x97, 0xde, 0x30, 0x76, 0x87, 0xcb, 0x65, 0xdc, 0x36, 0x7e, 0xa5, 0xbf, 0xfc, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x63, 0xd9, 0x3a, 0xfd, 0xf2, 0x07, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x4d, 0xbb, 0xfd, 0xbc, 0xed, 0x24, 0x43, 0x29, 0xca, 0x94, 0x22, 0x64, 0x1a, 0xf3, 0x90,
+	0x89, 0x18, 0x5b, 0x78, 0x36, 0xc3, 0x0c, 0x20, 0xc5, 0x0e, 0x90, 0x30, 0x71, 0x46, 0x2a, 0x67,
+	0xbc, 0x80, 0x55, 0xd9, 0x53, 0x76, 0x9a, 0xb4, 0xbb, 0x4d, 0x3f, 0x42, 0x3b, 0x5f, 0xc1, 0x5f,
+	0xf0, 0x2b, 0xb3, 0x9c, 0xe5, 0xac, 0x10, 0x4a, 0x16, 0xfc, 0x06, 0xaa, 0x5b, 0x6d, 0xbb, 0x9c,
+	0xb4, 0x82, 0xc2, 0xca, 0x75, 0x4e, 0x9f, 0x73, 0xea, 0xd1, 0xf7, 0x56, 0x1b, 0xb6, 0xc3, 0x88,
+	0x47, 0xa1, 0xe3, 0x8d, 0xfd, 0xe6, 0x2c, 0xf0, 0x23, 0x9f, 0x54, 0x96, 0xc4, 0xde, 0x93, 0x89,
+	0x13, 0x9d, 0xc7, 0xc3, 0xe6, 0xc8, 0x9f, 0xb6, 0x26, 0xfe, 0xc4, 0x6f, 0xa1, 0x62, 0x18, 0x8f,
+	0x11, 0x21, 0xc0, 0x91, 0x72, 0xd6, 0xff, 0x31, 0xc0, 0xea, 0x9f, 0xc7, 0xe3, 0xb1, 0x2b, 0x8e,
+	0x04, 0x9f, 0x91, 0x7d, 0xc8, 0x9f, 0x88, 0x71, 0x44, 0x0d, 0xdb, 0x68, 0x58, 0xed, 0xdd, 0xe6,
+	0x6a, 0x26, 0x4d, 0xc5, 0x50, 0x43, 0xbe, 0x84, 0x02, 0x73, 0x26, 0xe7, 0x11, 0xcd, 0xdd, 0x2b,
+	0x56, 0x22, 0xf2, 0x08, 0xcc, 0x97, 0x62, 0x4e, 0x4d, 0xdb, 0x68, 0x18, 0x4c, 0x0e, 0xc9, 0x0e,
+	0x14, 0x06, 0xdc, 0x8d, 0x05, 0xcd, 0x23, 0xa7, 0x00, 0xd9, 0x85, 0xe2, 0x91, 0xc0, 0xd8, 0x82,
+	0x6d, 0x34, 0x4c, 0x96, 0x22, 0xb2, 0x05, 0xb9, 0xfe, 0x15, 0x2d, 0x22, 0x97, 0xeb, 0x5f, 0x49,
+	0xf7, 0x69, 0xec, 0xba, 0x21, 0x2d, 0x21, 0xa5, 0x00, 0xa1, 0x50, 0x62, 0xe2, 0x52, 0x04, 0xa1,
+	0xa0, 0x65, 0xdb, 0x68, 0x94, 0xd9, 0x02, 0xd6, 0xdf, 0xe7, 0xa0, 0x9a, 0x2e, 0x8b, 0x71, 0x6f,
+	0x22, 0xc8, 0x87, 0x50, 0x39, 0x0e, 0xfb, 0x51, 0x70, 0x36, 0x9f, 0x09, 0xdc, 0x6f, 0x99, 0xad,
+	0x88, 0x74, 0xba, 0xdc, 0x72, 0xba, 0x7d, 0xc8, 0x9f, 0x05, 0x42, 0x50, 0xf3, 0xde, 0xbd, 0xa2,
+	0x46, 0x6e, 0xb5, 0xe7, 0x78, 0xe9, 0xb6, 0xe4, 0x10, 0x19, 0x9e, 0xd0, 0x42, 0xca, 0xf0, 0x84,
+	0x10, 0xc8, 0xf7, 0x1c, 0x2f, 0xa4, 0x45, 0xdb, 0x6c, 0x54, 0x19, 0x8e, 0x91, 0xe3, 0x89, 0xdc,
+	0x91, 0xe2, 0x78, 0x82, 0x1c, 0xf3, 0x7f, 0x0f, 0x69, 0xd9, 0x36, 0x1b, 0x26, 0xc3, 0xf1, 0x6a,
+	0xeb, 0x15, 0x24, 0xd3, 0xad, 0xef, 0x42, 0xb1, 0xc7, 0x93, 0x13, 0xe1, 0x51, 0x50, 0x07, 0xa7,
+	0x90, 0x54, 0xff, 0xe0, 0xf2, 0x49, 0x48, 0x2d, 0xdb, 0x6c, 0x94, 0x99, 0x02, 0xf2, 0xa0, 0x5e,
+	0x5d, 0x8a, 0xc0, 0xe5, 0x33, 0x5a, 0xc5, 0x55, 0x2d, 0xa0, 0x7c, 0xf2, 0xda, 0x73, 0xc6, 0x7e,
+	0x30, 0xa5, 0x9b, 0xea, 0x49, 0x0a, 0xe5, 0x0c, 0x4c, 0x84, 0xb1, 0x1b, 0xd1, 0x2d, 0xdb, 0x6c,
+	0x18, 0x2c, 0x45, 0xf5, 0x17, 0x50, 0x39, 0x72, 0xc2, 0xc8, 0x9f, 0x04, 0x1c, 0x45, 0x1d, 0x3f,
+	0xf6, 0xde, 0x84, 0xd4, 0x50, 0x22, 0x85, 0x24, 0xdf, 0xf5, 0x63, 0x2f, 0x0a, 0x69, 0x4e, 0xf1,
+	0x0a, 0xd5, 0x9f, 0x42, 0xa9, 0xd7, 0x1d, 0x9c, 0x38, 0x61, 0x24, 0xf7, 0x3a, 0xe0, 0xee, 0xc2,
+	0x88, 0x63, 0x5c, 0x7d, 0x20, 0x7e, 0x5b, 0xb8, 0x14, 0xa8, 0xff, 0x69, 0x41, 0xa5, 0x2f, 0xdf,
+	0xc0, 0xb1, 0x37, 0xf6, 0xc9, 0x33, 0x28, 0x9e, 0xbe, 0xb9, 0xec, 0xf1, 0x19, 0x3a, 0xad, 0xb6,
+	0xad, 0xbf, 0x9d, 0x85, 0xaa, 0xa9, 0x24, 0xdf, 0x7b, 0x51, 0x30, 0x67, 0xa9, 0x9e, 0x1c, 0x40,
+	0xa5, 0xe7, 0x78, 0x03, 0xee, 0x4a, 0x73, 0x0e, 0xcd, 0x9f, 0x64, 0x9a, 0x97, 0x2a, 0xe5, 0x5f,
+	0xb9, 0x30, 0x82, 0x27, 0x69, 0x84, 0x79, 0x5f, 0x04, 0x4f, 0xd6, 0x23, 0x16, 0x98, 0xfc, 0x08,
+	0xd6, 0x21, 0x8f, 0xb8, 0xac, 0x3b, 0x19, 0x92, 0xc7, 0x90, 0xcf, 0x32, 0x43, 0x34, 0x9d, 0x8a,
+	0xd1, 0x9d, 0xe4, 0x10, 0x40, 0xd6, 0x42, 0xd7, 0x8b, 0x64, 0x4e, 0x01, 0x73, 0x3e, 0xcd, 0x3e,
+	0x8c, 0xa5, 0x4c, 0xc5, 0x68, 0x3e, 0xf2, 0x02, 0x4a, 0x7d, 0xe7, 0x0a, 0x97, 0x52, 0xc4, 0x88,
+	0x8f, 0x33, 0x23, 0x52, 0x8d, 0xf2, 0x2f, 0x1c, 0xa4, 0x0f, 0xdb, 0x7a, 0x97, 0xc9, 0x90, 0x12,
+	0x86, 0x7c, 0x91, 0x1d, 0xb2, 0xae, 0x55, 0x61, 0xb7, 0x13, 0x88, 0x0d, 0x56, 0xc7, 0xf5, 0x47,
+	0x17, 0xa7, 0xf1, 0x74, 0x28, 0x02, 0xec, 0x6c, 0x93, 0xe9, 0x14, 0x69, 0xc3, 0xce, 0xc1, 0x68,
+	0x14, 0x07, 0x3c, 0x12, 0xaf, 0x86, 0xbf, 0x8a, 0x51, 0x94, 0x4a, 0x2b, 0x28, 0xcd, 0x7c, 0x46,
+	0x9a, 0x40, 0x0e, 0x66, 0xb3, 0xc0, 0x4f, 0xd6, 0x1c, 0xaa, 0x79, 0x32, 0x9e, 0x90, 0x3d, 0x28,
+	0x9f, 0xf1, 0xa1, 0x2b, 0xba, 0x5e, 0x44, 0x2d, 0xec, 0x8c, 0x25, 0x96, 0x97, 0x09, 0x8e, 0x4f,
+	0xf9, 0x54, 0x60, 0x43, 0x55, 0xd8, 0x8a, 0x20, 0x3f, 0x41, 0x75, 0xd9, 0x20, 0xf2, 0x44, 0x36,
+	0xf1, 0x44, 0x3e, 0xcf, 0x3c, 0x11, 0x5d, 0xa8, 0x8e, 0x63, 0xcd, 0x2b, 0x8b, 0xbd, 0xd7, 0x1d,
+	0xc8, 0x94, 0xad, 0x7b, 0x8a, 0x5d, 0x49, 0xd2, 0x62, 0x57, 0x60, 0xef, 0x6b, 0xb0, 0xb4, 0x1e,
+	0x90, 0x77, 0xd2, 0x85, 0x98, 0xe3, 0xcd, 0x57, 0x61, 0x72, 0x28, 0x7b, 0xed, 0x12, 0x2f, 0xe4,
+	0x9c, 0xba, 0x90, 0x11, 0x3c, 0xcf, 0x3d, 0x33, 0xf6, 0xbe, 0x81, 0xad, 0xf5, 0x0e, 0x78, 0xb0,
+	0x9b, 0x27, 0xff, 0xd7, 0xfd, 0x1d, 0x3c, 0xba, 0x5d, 0xf5, 0xff, 0xe5, 0xcf, 0xeb, 0xfe, 0x6f,
+	0x61, 0xfb, 0x56, 0xb5, 0x3f, 0xc8, 0xfe, 0x1c, 0xaa, 0x7a, 0xa5, 0x3f, 0xc8, 0xfb, 0x0b, 0xec,
+	0x64, 0x15, 0x78, 0x46, 0xc6, 0x13, 0x3d, 0xc3, 0x6a, 0x3f, 0xbe, 0xfb, 0x7d, 0xc1, 0x04, 0x3d,
+	0xfc, 0x35, 0x7c, 0x70, 0xa7, 0x56, 0x32, 0x92, 0xf7, 0xd7, 0x93, 0x77, 0xb4, 0xe4, 0xa5, 0x5d,
+	0x8f, 0xed, 0x81, 0xa5, 0x15, 0x4f, 0x46, 0x60, 0x63, 0x3d, 0x90, 0x68, 0x81, 0xe9, 0x45, 0xae,
+	0xc5, 0xd5, 0x8f, 0xa0, 0xba, 0xac, 0x4a, 0xf9, 0xd1, 0xaf, 0x01, 0xc8, 0xb7, 0x39, 0xe4, 0xa1,
+	0x38, 0x3e, 0xc4, 0xd8, 0x3c, 0xd3, 0x18, 0xf9, 0xf5, 0xc1, 0xbe, 0x39, 0x3e, 0x4c, 0x8f, 0x73,
+	0x01, 0xeb, 0x1d, 0xd8, 0xd4, 0x93, 0x42, 0xf2, 0x15, 0xe4, 0xe5, 0x6f, 0x7a, 0xe9, 0x3f, 0xce,
+	0xea, 0x83, 0x97, 0x62, 0xde, 0xc9, 0xbf, 0xfd, 0xeb, 0xa3, 0x0d, 0x86, 0xd2, 0xce, 0xc9, 0xdb,
+	0xeb, 0x9a, 0xf1, 0xee, 0xba, 0x66, 0xfc, 0x7d, 0x5d, 0x33, 0xfe, 0xb8, 0xa9, 0x6d, 0xbc, 0xbb,
+	0xa9, 0x6d, 0xbc, 0xbf, 0xa9, 0x6d, 0xfc, 0xdc, 0xd6, 0xfe, 0x37, 0x4d, 0x79, 0x14, 0x38, 0x89,
+	0x1f, 0x38, 0x13, 0xc7, 0x5b, 0x00, 0x4f, 0xb4, 0x66, 0x17, 0x93, 0xd6, 0x6c, 0xd8, 0x5a, 0x4e,
+	0x33, 0x2c, 0xe2, 0x7f, 0xa8, 0xa7, 0xff, 0x0e, 0x00, 0xa7, 0xb4, 0x97, 0x91, 0x90, 0x09, 0x00,
+	0x00,
 }
 
 func (m *ShuffleHeap) Marshal() (dAtA []byte, err error) {
@@ -817,6 +954,92 @@ func (m *ShuffleRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			f9 := math.Float64bits(float64(m.Counts[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f9))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Counts)*8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bounds) > 0 {
+		for iNdEx := len(m.Bounds) - 1; iNdEx >= 0; iNdEx-- {
+			f10 := math.Float64bits(float64(m.Bounds[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f10))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Bounds)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MCVList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MCVList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MCVList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Freqs) > 0 {
+		for iNdEx := len(m.Freqs) - 1; iNdEx >= 0; iNdEx-- {
+			f11 := math.Float64bits(float64(m.Freqs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f11))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Freqs)*8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vals) > 0 {
+		for iNdEx := len(m.Vals) - 1; iNdEx >= 0; iNdEx-- {
+			f12 := math.Float64bits(float64(m.Vals[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f12))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Vals)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StatsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -837,6 +1060,58 @@ func (m *StatsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MCVMap) > 0 {
+		for k := range m.MCVMap {
+			v := m.MCVMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintStatsinfo(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStatsinfo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStatsinfo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.HistogramMap) > 0 {
+		for k := range m.HistogramMap {
+			v := m.HistogramMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintStatsinfo(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStatsinfo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStatsinfo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TableName) > 0 {
 		i -= len(m.TableName)
 		copy(dAtA[i:], m.TableName)
@@ -1181,6 +1456,36 @@ func (m *ShuffleRange) Size() (n int) {
 	return n
 }
 
+func (m *Histogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Bounds)*8)) + len(m.Bounds)*8
+	}
+	if len(m.Counts) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Counts)*8)) + len(m.Counts)*8
+	}
+	return n
+}
+
+func (m *MCVList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vals) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Vals)*8)) + len(m.Vals)*8
+	}
+	if len(m.Freqs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Freqs)*8)) + len(m.Freqs)*8
+	}
+	return n
+}
+
 func (m *StatsInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1264,6 +1569,32 @@ func (m *StatsInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStatsinfo(uint64(l))
 	}
+	if len(m.HistogramMap) > 0 {
+		for k, v := range m.HistogramMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovStatsinfo(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovStatsinfo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovStatsinfo(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MCVMap) > 0 {
+		for k, v := range m.MCVMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovStatsinfo(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovStatsinfo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovStatsinfo(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2052,7 +2383,7 @@ func (m *ShuffleRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsInfo) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2075,41 +2406,357 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NdvMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStatsinfo
-				}
-				if iNdEx >= l {
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStatsinfo
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStatsinfo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Bounds = append(m.Bounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Bounds) == 0 {
+					m.Bounds = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Bounds = append(m.Bounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Counts = append(m.Counts, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Counts = append(m.Counts, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatsinfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MCVList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatsinfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MCVList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MCVList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Vals = append(m.Vals, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Vals) == 0 {
+					m.Vals = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Vals = append(m.Vals, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vals", wireType)
+			}
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Freqs = append(m.Freqs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Freqs) == 0 {
+					m.Freqs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Freqs = append(m.Freqs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freqs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatsinfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatsinfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NdvMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
 			if m.NdvMap == nil {
 				m.NdvMap = make(map[string]float64)
 			}
@@ -2967,6 +3614,264 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistogramMap == nil {
+				m.HistogramMap = make(map[string]*Histogram)
+			}
+			var mapkey string
+			var mapvalue *Histogram
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Histogram{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStatsinfo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HistogramMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MCVMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MCVMap == nil {
+				m.MCVMap = make(map[string]*MCVList)
+			}
+			var mapkey string
+			var mapvalue *MCVList
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MCVList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStatsinfo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MCVMap[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatsinfo(dAtA[iNdEx:])
@@ -50,6 +50,7 @@ const (
 	SessionField_CLIENT_HOST     SessionField = 16
 	SessionField_ROLE            SessionField = 17
 	SessionField_PROXY_HOST      SessionField = 18
+	SessionField_QUERY_MEMORY    SessionField = 19
 )
 
 var SessionField_name = map[int32]string{
@@ -72,6 +73,7 @@ var SessionField_name = map[int32]string{
 	16: "CLIENT_HOST",
 	17: "ROLE",
 	18: "PROXY_HOST",
+	19: "QUERY_MEMORY",
 }
 
 var SessionField_value = map[string]int32{
@@ -94,6 +96,7 @@ var SessionField_value = map[string]int32{
 	"CLIENT_HOST":     16,
 	"ROLE":            17,
 	"PROXY_HOST":      18,
+	"QUERY_MEMORY":    19,
 }
 
 func (x SessionField) String() string {
@@ -145,7 +148,9 @@ type Session struct {
 	// FromProxy denotes whether the session is dispatched from proxy
 	FromProxy bool `protobuf:"varint,19,opt,name=FromProxy,proto3" json:"FromProxy,omitempty"`
 	// ProxyHost is the host address of proxy connection.
-	ProxyHost            string   `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	ProxyHost string `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	// QueryMemory is the bytes the running query holds in the session pool.
+	QueryMemory          uint64   `protobuf:"varint,21,opt,name=QueryMemory,proto3" json:"QueryMemory,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -324,6 +329,13 @@ func (m *Session) GetProxyHost() string {
 	return ""
 }
 
+func (m *Session) GetQueryMemory() uint64 {
+	if m != nil {
+		return m.QueryMemory
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("status.SessionField", SessionField_name, SessionField_value)
 	proto.RegisterType((*Session)(nil), "status.Session")
@@ -332,47 +344,48 @@ func init() {
 func init() { proto.RegisterFile("status.proto", fileDescriptor_dfe4fce6682daf5b) }
 
 var fileDescriptor_dfe4fce6682daf5b = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcb, 0x4e, 0xdb, 0x4c,
-	0x14, 0xc7, 0x71, 0x08, 0xb9, 0x9c, 0xdc, 0xcc, 0x01, 0x7d, 0x1a, 0xa1, 0x4f, 0x21, 0xaa, 0xba,
-	0x88, 0x2a, 0x35, 0x91, 0xda, 0x75, 0x17, 0x89, 0x6d, 0x84, 0xa5, 0x60, 0x13, 0x8f, 0x23, 0x41,
-	0x37, 0x28, 0x09, 0x83, 0x6b, 0x35, 0xf6, 0x44, 0xf6, 0x44, 0x82, 0xb7, 0xe8, 0x13, 0xf4, 0x79,
-	0x58, 0xf6, 0x09, 0xda, 0x8a, 0xb7, 0xe8, 0xae, 0x9a, 0x99, 0xdc, 0x58, 0x76, 0x77, 0xfe, 0xbf,
-	0x73, 0xf1, 0xff, 0x9c, 0x81, 0x40, 0x3d, 0x17, 0x53, 0xb1, 0xca, 0x7b, 0xcb, 0x8c, 0x0b, 0x8e,
-	0x25, 0xad, 0xce, 0xde, 0x47, 0xb1, 0xf8, 0xb2, 0x9a, 0xf5, 0xe6, 0x3c, 0xe9, 0x47, 0x3c, 0xe2,
-	0x7d, 0x95, 0x9e, 0xad, 0x1e, 0x94, 0x52, 0x42, 0x45, 0xba, 0xed, 0xec, 0x3c, 0xe2, 0x3c, 0x5a,
-	0xb0, 0x5d, 0x95, 0x88, 0x13, 0x96, 0x8b, 0x69, 0xb2, 0xd4, 0x05, 0x6f, 0xfe, 0x14, 0xa1, 0x4c,
-	0x59, 0x9e, 0xc7, 0x3c, 0xc5, 0xff, 0xa0, 0xe4, 0xf1, 0x7b, 0xe6, 0xda, 0xc4, 0xe8, 0x18, 0xdd,
-	0x6a, 0xb0, 0x56, 0x92, 0x5b, 0x3c, 0x4d, 0x5d, 0x9b, 0x14, 0x3a, 0x46, 0xb7, 0x11, 0xac, 0x15,
-	0xfe, 0x0f, 0xd5, 0x75, 0xab, 0x6b, 0x93, 0x43, 0xd5, 0xb2, 0x03, 0x48, 0xa0, 0x3c, 0x98, 0xcf,
-	0xf9, 0x2a, 0x15, 0xa4, 0xa8, 0x72, 0x1b, 0x89, 0x08, 0xc5, 0x49, 0xce, 0x32, 0x72, 0xa4, 0xb0,
-	0x8a, 0x25, 0xbb, 0xe4, 0xb9, 0x20, 0x25, 0xcd, 0x64, 0x8c, 0x4d, 0x28, 0xd8, 0x43, 0x52, 0x56,
-	0xa4, 0x60, 0x0f, 0xf1, 0x12, 0xea, 0xeb, 0xf1, 0x54, 0x4c, 0x33, 0x41, 0x2a, 0x1d, 0xa3, 0x5b,
-	0xfb, 0x70, 0xd6, 0xd3, 0x3b, 0xf6, 0x36, 0x3b, 0xf6, 0xc2, 0xcd, 0x8e, 0xc3, 0xca, 0xf3, 0xcf,
-	0xf3, 0x83, 0x6f, 0xbf, 0xce, 0x8d, 0xe0, 0x55, 0xa7, 0xf4, 0x66, 0xf1, 0x24, 0x99, 0xa6, 0xf7,
-	0xa4, 0xaa, 0xbd, 0xad, 0xa5, 0xf4, 0xe1, 0xa6, 0x0f, 0x9c, 0x80, 0xf6, 0x21, 0x63, 0x3c, 0x85,
-	0xa3, 0xf0, 0x51, 0xee, 0x58, 0x53, 0x50, 0x0b, 0xec, 0x40, 0x8d, 0x8a, 0xa9, 0x60, 0x09, 0x4b,
-	0x85, 0x6b, 0x93, 0xba, 0xca, 0xed, 0x23, 0x7c, 0x0b, 0x8d, 0xad, 0x0c, 0x9f, 0x96, 0x8c, 0x34,
-	0x54, 0xcd, 0x6b, 0x28, 0xaf, 0x38, 0x5e, 0xb1, 0xec, 0x49, 0x55, 0x34, 0xf5, 0x15, 0xb7, 0x40,
-	0xcd, 0x18, 0x8f, 0x28, 0x5f, 0x65, 0x73, 0xa6, 0x2a, 0x5a, 0xeb, 0x19, 0xfb, 0x10, 0x6d, 0x00,
-	0xd5, 0xa2, 0xef, 0x62, 0xfe, 0xc3, 0x5d, 0xf6, 0xfa, 0xb0, 0x0d, 0x60, 0x2d, 0x62, 0x96, 0x0a,
-	0xf5, 0x12, 0xc7, 0xea, 0x43, 0x7b, 0x44, 0xde, 0x26, 0xe0, 0x0b, 0x46, 0x50, 0xdf, 0x46, 0xc6,
-	0xd2, 0xfd, 0x45, 0xc6, 0x93, 0xeb, 0x8c, 0x3f, 0x3e, 0x91, 0x93, 0x8e, 0xd1, 0xad, 0x04, 0x3b,
-	0x20, 0xb3, 0x2a, 0x50, 0x03, 0x4f, 0xf5, 0x6e, 0x5b, 0xf0, 0xee, 0x7b, 0x61, 0xfb, 0xa0, 0x17,
-	0x31, 0x5b, 0xdc, 0x63, 0x0d, 0xca, 0x9e, 0x6f, 0x3b, 0x77, 0xae, 0x6d, 0x1e, 0x48, 0x61, 0xf9,
-	0x9e, 0x27, 0x85, 0x81, 0x4d, 0x00, 0xea, 0x50, 0xea, 0xfa, 0x4a, 0x17, 0x64, 0x72, 0x60, 0x59,
-	0xfe, 0xc4, 0x0b, 0xcd, 0x43, 0xac, 0x40, 0x71, 0x42, 0x9d, 0xc0, 0x2c, 0xca, 0xe8, 0xd2, 0xa7,
-	0xa1, 0x79, 0x84, 0x25, 0xf9, 0xb7, 0x63, 0x96, 0xf0, 0x18, 0x1a, 0x9b, 0x46, 0x1a, 0x0e, 0x82,
-	0xd0, 0x2c, 0xeb, 0xc1, 0x57, 0x57, 0x03, 0xcf, 0x36, 0x2b, 0xb2, 0xc3, 0xf5, 0x2e, 0x7c, 0xb3,
-	0x8a, 0x00, 0xa5, 0xf0, 0x46, 0x8d, 0x07, 0x34, 0xa1, 0x4e, 0xc3, 0x41, 0xe8, 0x5c, 0x39, 0x5e,
-	0x28, 0x49, 0x0d, 0x11, 0x9a, 0x3b, 0x12, 0xde, 0x5e, 0x3b, 0x66, 0x5d, 0x9a, 0x1a, 0x4f, 0x9c,
-	0xe0, 0x56, 0xeb, 0x06, 0x9e, 0x40, 0x8b, 0x8e, 0x47, 0x77, 0xd4, 0x9f, 0x04, 0x96, 0xa3, 0x61,
-	0x13, 0x5b, 0x50, 0xd3, 0x45, 0xfa, 0xf3, 0x2d, 0x09, 0xac, 0x91, 0x2b, 0xc7, 0x28, 0xab, 0xa6,
-	0xb4, 0x10, 0xf8, 0x23, 0xc7, 0x3c, 0x96, 0x03, 0xaf, 0x03, 0xff, 0xe6, 0x56, 0x67, 0x70, 0xf8,
-	0xe9, 0xf9, 0xa5, 0x6d, 0xfc, 0x78, 0x69, 0x1b, 0xbf, 0x5f, 0xda, 0xc6, 0xe7, 0xfe, 0xde, 0xbf,
-	0x7e, 0x32, 0x15, 0x59, 0xfc, 0xc8, 0xb3, 0x38, 0x8a, 0xd3, 0x8d, 0x48, 0x59, 0x7f, 0xf9, 0x35,
-	0xea, 0x2f, 0x67, 0x7d, 0xfd, 0x5b, 0x31, 0x2b, 0xa9, 0x97, 0xff, 0xf8, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x41, 0x1b, 0x87, 0x8c, 0x4a, 0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcb, 0x6e, 0xda, 0x40,
+	0x14, 0x86, 0x63, 0x02, 0x06, 0x0e, 0xb7, 0xc9, 0x24, 0xad, 0x46, 0x51, 0x45, 0x50, 0xd5, 0x05,
+	0xaa, 0x54, 0x90, 0xda, 0x75, 0x17, 0x60, 0x3b, 0x8a, 0x25, 0xb0, 0x83, 0x6d, 0xa4, 0xd0, 0x4d,
+	0x04, 0x64, 0xe2, 0x5a, 0xc5, 0x1e, 0x64, 0x0f, 0x52, 0x78, 0x8b, 0xee, 0xfb, 0x12, 0x7d, 0x8c,
+	0x2c, 0xfb, 0x04, 0x6d, 0xc5, 0x93, 0x54, 0x33, 0xc3, 0x2d, 0xcb, 0xee, 0xce, 0xff, 0x9d, 0x8b,
+	0xff, 0x73, 0x6c, 0x19, 0xaa, 0x19, 0x9f, 0xf2, 0x55, 0xd6, 0x59, 0xa6, 0x8c, 0x33, 0xac, 0x2b,
+	0x75, 0xf9, 0x21, 0x8c, 0xf8, 0xd7, 0xd5, 0xac, 0x33, 0x67, 0x71, 0x37, 0x64, 0x21, 0xeb, 0xca,
+	0xf4, 0x6c, 0xf5, 0x28, 0x95, 0x14, 0x32, 0x52, 0x6d, 0x97, 0x57, 0x21, 0x63, 0xe1, 0x82, 0x1e,
+	0xaa, 0x78, 0x14, 0xd3, 0x8c, 0x4f, 0xe3, 0xa5, 0x2a, 0x78, 0xfb, 0xa3, 0x00, 0x45, 0x9f, 0x66,
+	0x59, 0xc4, 0x12, 0xfc, 0x1a, 0x74, 0x87, 0x3d, 0x50, 0xdb, 0x24, 0x5a, 0x4b, 0x6b, 0x97, 0xbd,
+	0xad, 0x12, 0xdc, 0x60, 0x49, 0x62, 0x9b, 0x24, 0xd7, 0xd2, 0xda, 0x35, 0x6f, 0xab, 0xf0, 0x1b,
+	0x28, 0x6f, 0x5b, 0x6d, 0x93, 0x9c, 0xca, 0x96, 0x03, 0xc0, 0x04, 0x8a, 0xbd, 0xf9, 0x9c, 0xad,
+	0x12, 0x4e, 0xf2, 0x32, 0xb7, 0x93, 0x18, 0x43, 0x7e, 0x9c, 0xd1, 0x94, 0x14, 0x24, 0x96, 0xb1,
+	0x60, 0x37, 0x2c, 0xe3, 0x44, 0x57, 0x4c, 0xc4, 0xb8, 0x0e, 0x39, 0xb3, 0x4f, 0x8a, 0x92, 0xe4,
+	0xcc, 0x3e, 0xbe, 0x81, 0xea, 0x76, 0xbc, 0xcf, 0xa7, 0x29, 0x27, 0xa5, 0x96, 0xd6, 0xae, 0x7c,
+	0xbc, 0xec, 0xa8, 0x1d, 0x3b, 0xbb, 0x1d, 0x3b, 0xc1, 0x6e, 0xc7, 0x7e, 0xe9, 0xf9, 0xf7, 0xd5,
+	0xc9, 0xf7, 0x3f, 0x57, 0x9a, 0xf7, 0xa2, 0x53, 0x78, 0x33, 0x58, 0x1c, 0x4f, 0x93, 0x07, 0x52,
+	0x56, 0xde, 0xb6, 0x52, 0xf8, 0xb0, 0x93, 0x47, 0x46, 0x40, 0xf9, 0x10, 0x31, 0xbe, 0x80, 0x42,
+	0xf0, 0x24, 0x76, 0xac, 0x48, 0xa8, 0x04, 0x6e, 0x41, 0xc5, 0xe7, 0x53, 0x4e, 0x63, 0x9a, 0x70,
+	0xdb, 0x24, 0x55, 0x99, 0x3b, 0x46, 0xf8, 0x1d, 0xd4, 0xf6, 0x32, 0x58, 0x2f, 0x29, 0xa9, 0xc9,
+	0x9a, 0x97, 0x50, 0x5c, 0x71, 0xb4, 0xa2, 0xe9, 0x5a, 0x56, 0xd4, 0xd5, 0x15, 0xf7, 0x40, 0xce,
+	0x18, 0x0d, 0x7c, 0xb6, 0x4a, 0xe7, 0x54, 0x56, 0x34, 0xb6, 0x33, 0x8e, 0x21, 0x36, 0x01, 0x64,
+	0x8b, 0xba, 0x0b, 0xfa, 0x8f, 0xbb, 0x1c, 0xf5, 0xe1, 0x26, 0x80, 0xb1, 0x88, 0x68, 0xc2, 0xe5,
+	0x9b, 0x38, 0x93, 0x0f, 0x3a, 0x22, 0xe2, 0x36, 0x1e, 0x5b, 0x50, 0x82, 0xd5, 0x6d, 0x44, 0x2c,
+	0xdc, 0x5f, 0xa7, 0x2c, 0xbe, 0x4d, 0xd9, 0xd3, 0x9a, 0x9c, 0xb7, 0xb4, 0x76, 0xc9, 0x3b, 0x00,
+	0x91, 0x95, 0x81, 0x1c, 0x78, 0xa1, 0x76, 0xdb, 0x03, 0x71, 0x41, 0xf9, 0xf4, 0x21, 0x8d, 0x59,
+	0xba, 0x26, 0xaf, 0x5a, 0x5a, 0x3b, 0xef, 0x1d, 0xa3, 0xf7, 0x3f, 0x73, 0xfb, 0x57, 0x7e, 0x1d,
+	0xd1, 0xc5, 0x03, 0xae, 0x40, 0xd1, 0x71, 0x4d, 0xeb, 0xde, 0x36, 0xd1, 0x89, 0x10, 0x86, 0xeb,
+	0x38, 0x42, 0x68, 0xb8, 0x0e, 0xe0, 0x5b, 0xbe, 0x6f, 0xbb, 0x52, 0xe7, 0x44, 0xb2, 0x67, 0x18,
+	0xee, 0xd8, 0x09, 0xd0, 0x29, 0x2e, 0x41, 0x7e, 0xec, 0x5b, 0x1e, 0xca, 0x8b, 0xe8, 0xc6, 0xf5,
+	0x03, 0x54, 0xc0, 0xba, 0xf8, 0xba, 0x90, 0x8e, 0xcf, 0xa0, 0xb6, 0x6b, 0xf4, 0x83, 0x9e, 0x17,
+	0xa0, 0xa2, 0x1a, 0x3c, 0x1c, 0xf6, 0x1c, 0x13, 0x95, 0x44, 0x87, 0xed, 0x5c, 0xbb, 0xa8, 0x8c,
+	0x01, 0xf4, 0xe0, 0x4e, 0x8e, 0x07, 0x8c, 0xa0, 0xea, 0x07, 0xbd, 0xc0, 0x1a, 0x5a, 0x4e, 0x20,
+	0x48, 0x05, 0x63, 0xa8, 0x1f, 0x48, 0x30, 0xb9, 0xb5, 0x50, 0x55, 0x98, 0x1a, 0x8d, 0x2d, 0x6f,
+	0xa2, 0x74, 0x0d, 0x9f, 0x43, 0xc3, 0x1f, 0x0d, 0xee, 0x7d, 0x77, 0xec, 0x19, 0x96, 0x82, 0x75,
+	0xdc, 0x80, 0x8a, 0x2a, 0x52, 0x8f, 0x6f, 0x08, 0x60, 0x0c, 0x6c, 0x31, 0x46, 0x5a, 0x45, 0xc2,
+	0x82, 0xe7, 0x0e, 0x2c, 0x74, 0x26, 0x06, 0xde, 0x7a, 0xee, 0xdd, 0x44, 0x65, 0xb0, 0xb0, 0xa1,
+	0x7a, 0x87, 0xd6, 0xd0, 0xf5, 0x26, 0xe8, 0xbc, 0xff, 0xf9, 0x79, 0xd3, 0xd4, 0x7e, 0x6d, 0x9a,
+	0xda, 0xdf, 0x4d, 0x53, 0xfb, 0xd2, 0x3d, 0xfa, 0x5d, 0xc4, 0x53, 0x9e, 0x46, 0x4f, 0x2c, 0x8d,
+	0xc2, 0x28, 0xd9, 0x89, 0x84, 0x76, 0x97, 0xdf, 0xc2, 0xee, 0x72, 0xd6, 0x55, 0xff, 0x97, 0x99,
+	0x2e, 0xbf, 0x96, 0x4f, 0xff, 0x06, 0x00, 0x97, 0x84, 0xe3, 0x7c, 0x7e, 0x04, 0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -399,6 +412,13 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueryMemory != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.QueryMemory))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.ProxyHost) > 0 {
 		i -= len(m.ProxyHost)
 		copy(dAtA[i:], m.ProxyHost)
@@ -646,6 +666,9 @@ func (m *Session) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStatus(uint64(l))
 	}
+	if m.QueryMemory != 0 {
+		n += 2 + sovStatus(uint64(m.QueryMemory))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1304,6 +1327,25 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMemory", wireType)
+			}
+			m.QueryMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMemory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(dAtA[iNdEx:])
@@ -61,6 +61,9 @@ func (t *Details) Type() TaskType {
 func detailsType(d isDetails_Details) (TaskType, error) {
 	switch d := d.(type) {
 	case *Details_Connector:
+		if d.Connector != nil && d.Connector.Options["type"] == "mysql" {
+			return TaskType_TypeMysqlBinlogConnector, nil
+		}
 		return TaskType_TypeKafkaSinkConnector, nil
 	default:
 		return TaskType_TypeUnknown, moerr.NewInternalErrorNoCtx("Unknown details type: %T", d)
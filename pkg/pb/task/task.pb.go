@@ -104,6 +104,9 @@ const (
 	TaskCode_ConnectorKafkaSink TaskCode = 4
 	// MergeObject is for the merge object task.
 	TaskCode_MergeObject TaskCode = 5
+	// ConnectorMysqlBinlog is for the connector task which replicates from
+	// the binlog of an upstream MySQL.
+	TaskCode_ConnectorMysqlBinlog TaskCode = 6
 )
 
 var TaskCode_name = map[int32]string{
@@ -113,15 +116,17 @@ var TaskCode_name = map[int32]string{
 	3: "MetricStorageUsage",
 	4: "ConnectorKafkaSink",
 	5: "MergeObject",
+	6: "ConnectorMysqlBinlog",
 }
 
 var TaskCode_value = map[string]int32{
-	"TestOnly":           0,
-	"SystemInit":         1,
-	"MetricLogMerge":     2,
-	"MetricStorageUsage": 3,
-	"ConnectorKafkaSink": 4,
-	"MergeObject":        5,
+	"TestOnly":             0,
+	"SystemInit":           1,
+	"MetricLogMerge":       2,
+	"MetricStorageUsage":   3,
+	"ConnectorKafkaSink":   4,
+	"MergeObject":          5,
+	"ConnectorMysqlBinlog": 6,
 }
 
 func (x TaskCode) String() string {
@@ -163,18 +168,21 @@ func (ResultCode) EnumDescriptor() ([]byte, []int) {
 type TaskType int32
 
 const (
-	TaskType_TypeUnknown            TaskType = 0
-	TaskType_TypeKafkaSinkConnector TaskType = 1
+	TaskType_TypeUnknown              TaskType = 0
+	TaskType_TypeKafkaSinkConnector   TaskType = 1
+	TaskType_TypeMysqlBinlogConnector TaskType = 2
 )
 
 var TaskType_name = map[int32]string{
 	0: "Unknown",
 	1: "KafkaSinkConnector",
+	2: "MysqlBinlogConnector",
 }
 
 var TaskType_value = map[string]int32{
-	"Unknown":            0,
-	"KafkaSinkConnector": 1,
+	"Unknown":              0,
+	"KafkaSinkConnector":   1,
+	"MysqlBinlogConnector": 2,
 }
 
 func (x TaskType) String() string {
@@ -1039,83 +1047,85 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1212 bytes of a gzipped FileDescriptorProto
+	// 1243 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x8e, 0x1b, 0x45,
-	0x17, 0x9e, 0xf6, 0xdd, 0xc7, 0x97, 0xf4, 0x5f, 0xf9, 0x35, 0x6a, 0x59, 0x61, 0x62, 0x99, 0x20,
-	0x46, 0x23, 0xe1, 0x01, 0x13, 0x10, 0x89, 0x04, 0x64, 0xc6, 0x1e, 0x94, 0x21, 0x99, 0x24, 0xaa,
-	0xf1, 0x6c, 0x10, 0x9b, 0x72, 0xfb, 0xa4, 0xd3, 0xd8, 0xae, 0x36, 0xd5, 0xd5, 0x61, 0xbc, 0xe0,
-	0x05, 0xb2, 0x62, 0x07, 0x9b, 0x3c, 0x01, 0x0f, 0xc1, 0x36, 0xcb, 0x2c, 0x59, 0x71, 0x89, 0x78,
-	0x04, 0xb6, 0x48, 0xa8, 0xaa, 0xfa, 0x66, 0x0f, 0x20, 0x8d, 0x94, 0x9d, 0xcf, 0x77, 0x2e, 0x7d,
-	0xce, 0x77, 0x2e, 0x65, 0x00, 0xc9, 0xc2, 0x59, 0x7f, 0x29, 0x02, 0x19, 0x90, 0x92, 0xfa, 0xdd,
-	0x79, 0xc7, 0xf3, 0xe5, 0x93, 0x68, 0xd2, 0x77, 0x83, 0xc5, 0xbe, 0x17, 0x78, 0xc1, 0xbe, 0x56,
-	0x4e, 0xa2, 0xc7, 0x5a, 0xd2, 0x82, 0xfe, 0x65, 0x9c, 0x3a, 0xd7, 0xbd, 0x20, 0xf0, 0xe6, 0x98,
-	0x59, 0x49, 0x7f, 0x81, 0xa1, 0x64, 0x8b, 0x65, 0x6c, 0xd0, 0x5e, 0xa0, 0x64, 0x53, 0x26, 0x99,
-	0x91, 0x7b, 0xdf, 0x5b, 0xd0, 0x1c, 0xb3, 0x70, 0x76, 0x12, 0xc3, 0xa4, 0x0d, 0x85, 0xe3, 0x91,
-	0x63, 0x75, 0xad, 0xdd, 0x3a, 0x2d, 0x1c, 0x8f, 0xc8, 0x1e, 0xd4, 0x8e, 0xce, 0xd1, 0x8d, 0x64,
-	0x20, 0x9c, 0x42, 0xd7, 0xda, 0x6d, 0x0f, 0xda, 0x7d, 0x9d, 0xa5, 0xf2, 0x1a, 0x06, 0x53, 0xa4,
-	0xa9, 0x9e, 0x38, 0x50, 0x1d, 0x06, 0x5c, 0xe2, 0xb9, 0x74, 0x8a, 0x5d, 0x6b, 0xb7, 0x49, 0x13,
-	0x91, 0xbc, 0x07, 0xd5, 0x87, 0x4b, 0xe9, 0x07, 0x3c, 0x74, 0x4a, 0x5d, 0x6b, 0xb7, 0x31, 0xf8,
-	0x5f, 0x16, 0x24, 0x56, 0x1c, 0x96, 0x5e, 0xfc, 0x72, 0x7d, 0x8b, 0x26, 0x76, 0xbd, 0x9f, 0x0a,
-	0xd0, 0xc8, 0xa9, 0xc9, 0x0d, 0x68, 0x9d, 0xb0, 0x73, 0x8a, 0x52, 0xac, 0xc6, 0xaa, 0x28, 0x9d,
-	0x63, 0x8b, 0xae, 0x83, 0xca, 0x4a, 0x4b, 0xc7, 0x5c, 0xa2, 0x78, 0xca, 0xe6, 0x3a, 0xe7, 0x22,
-	0x5d, 0x07, 0x95, 0xd5, 0x08, 0xe7, 0x6c, 0x35, 0x8a, 0x04, 0x53, 0xd1, 0x75, 0xba, 0x45, 0xba,
-	0x0e, 0x92, 0x2e, 0x34, 0x86, 0x01, 0x77, 0x23, 0x21, 0x90, 0xbb, 0x2b, 0x9d, 0x78, 0x8b, 0xe6,
-	0x21, 0xf2, 0x01, 0x54, 0xee, 0xb3, 0x09, 0xce, 0x43, 0xa7, 0xdc, 0x2d, 0xee, 0x36, 0x06, 0x6f,
-	0x5c, 0xa8, 0xaa, 0x6f, 0xf4, 0x47, 0x5c, 0x8a, 0x15, 0x8d, 0x8d, 0x15, 0xa7, 0x14, 0xc3, 0x20,
-	0x12, 0x2e, 0x3a, 0x15, 0x4d, 0x47, 0xcc, 0x69, 0x82, 0xd2, 0x54, 0xdf, 0xb9, 0x05, 0x8d, 0x5c,
-	0x08, 0x62, 0x43, 0x71, 0x86, 0xab, 0xb8, 0x3f, 0xea, 0x27, 0xf9, 0x3f, 0x94, 0x9f, 0xb2, 0x79,
-	0x84, 0xba, 0xd2, 0x3a, 0x35, 0xc2, 0xed, 0xc2, 0x47, 0x56, 0xef, 0x66, 0xf6, 0x19, 0xe5, 0x37,
-	0x7c, 0x74, 0xa6, 0xfd, 0x4a, 0x54, 0xfd, 0x24, 0xdb, 0x50, 0x39, 0xc1, 0x45, 0x20, 0x56, 0xda,
-	0xb1, 0x44, 0x63, 0xa9, 0x77, 0x0f, 0x5a, 0xa6, 0xa1, 0x48, 0x31, 0x8c, 0xe6, 0x92, 0xdc, 0x80,
-	0x92, 0xea, 0xb3, 0xf6, 0x6d, 0x0f, 0xec, 0x34, 0xd3, 0x68, 0x2e, 0x75, 0xff, 0xb5, 0x56, 0xa5,
-	0x71, 0x24, 0x44, 0x3c, 0x24, 0x75, 0x6a, 0x84, 0xde, 0x9f, 0x05, 0xa8, 0x1f, 0x84, 0x2b, 0xee,
-	0x2a, 0x4a, 0x72, 0xb3, 0x55, 0xd2, 0xb3, 0x75, 0x13, 0x6a, 0xc9, 0xdc, 0x69, 0xb7, 0xc6, 0x80,
-	0x64, 0x04, 0x26, 0x9a, 0x78, 0x2e, 0x52, 0x4b, 0xd2, 0x83, 0xe6, 0x23, 0x26, 0x90, 0x4b, 0x65,
-	0x75, 0x3c, 0xd2, 0xbd, 0xab, 0xd3, 0x35, 0x8c, 0xec, 0x42, 0xe5, 0x54, 0x32, 0x19, 0x99, 0x71,
-	0x4b, 0xb3, 0x56, 0x5a, 0x83, 0xd3, 0x58, 0x4f, 0x76, 0x00, 0x14, 0x4a, 0x23, 0xce, 0x51, 0x38,
-	0x65, 0x1d, 0x2b, 0x87, 0xe8, 0xba, 0x96, 0x81, 0xfb, 0x44, 0x37, 0xaa, 0x45, 0x8d, 0xa0, 0x06,
-	0xe8, 0x3e, 0x0b, 0xe5, 0x5d, 0x64, 0x42, 0x4e, 0x90, 0x49, 0xa7, 0x6a, 0x06, 0x68, 0x0d, 0x24,
-	0x1d, 0xa8, 0x0d, 0x05, 0x32, 0x89, 0x07, 0xd2, 0xa9, 0x69, 0x83, 0x54, 0x36, 0xc3, 0xb5, 0x58,
-	0xce, 0x51, 0xe2, 0xf4, 0x40, 0x3a, 0x75, 0xad, 0xce, 0x43, 0xe4, 0xd6, 0x46, 0x23, 0x1c, 0xd0,
-	0x14, 0x5d, 0x35, 0xa5, 0xac, 0xa9, 0xe8, 0xba, 0x65, 0xef, 0x0f, 0x4b, 0x7d, 0x39, 0xe0, 0xaf,
-	0x91, 0xf5, 0x8e, 0x89, 0x78, 0x74, 0xbe, 0x14, 0x31, 0xe3, 0xa9, 0xac, 0x74, 0x0f, 0xf0, 0x5c,
-	0xaa, 0x0d, 0xd4, 0x7c, 0x17, 0x69, 0x2a, 0xab, 0x6e, 0x8d, 0x85, 0xef, 0x79, 0x28, 0xcc, 0xd6,
-	0x96, 0x75, 0x1e, 0x6b, 0xd8, 0x1a, 0x4f, 0x95, 0x0d, 0x9e, 0x3a, 0x50, 0x3b, 0x5b, 0x4e, 0x8d,
-	0xce, 0x90, 0x9c, 0xca, 0xbd, 0x1f, 0x2d, 0xb0, 0x87, 0x01, 0xe7, 0xe8, 0xca, 0x40, 0x8c, 0x50,
-	0x32, 0x7f, 0x1e, 0x92, 0x6b, 0x50, 0x1f, 0xb3, 0xc9, 0x1c, 0x1f, 0xb0, 0x05, 0xc6, 0x7b, 0x92,
-	0x01, 0xe4, 0xe3, 0xec, 0x10, 0x15, 0xf4, 0xca, 0xbe, 0x69, 0x6a, 0xdf, 0x0c, 0xd3, 0x8f, 0xad,
-	0xcc, 0xe2, 0x26, 0x3e, 0x9d, 0xdb, 0xd0, 0xcc, 0x2b, 0x2e, 0xb5, 0x8e, 0x3f, 0x5b, 0x50, 0x4d,
-	0x92, 0xec, 0x42, 0x63, 0x84, 0xa1, 0x2b, 0x7c, 0x1d, 0x2c, 0xf6, 0xcf, 0x43, 0xaa, 0x8c, 0x03,
-	0xd7, 0x0d, 0x22, 0x2e, 0x8f, 0x47, 0x3a, 0x56, 0x8b, 0x66, 0x80, 0xba, 0xb4, 0xb1, 0x10, 0x37,
-	0x23, 0x11, 0x35, 0x5f, 0x21, 0x0a, 0xce, 0xe2, 0x5e, 0xd4, 0x69, 0x2a, 0x67, 0x3b, 0x5a, 0xce,
-	0xed, 0x28, 0xf9, 0x10, 0xea, 0x69, 0xf5, 0xf1, 0x8c, 0x6d, 0xff, 0x33, 0x29, 0x77, 0xb7, 0x68,
-	0x66, 0x7a, 0x58, 0x4f, 0xcb, 0xe9, 0xfd, 0x55, 0x02, 0x18, 0x31, 0x5c, 0xbc, 0xd6, 0x89, 0x5b,
-	0x63, 0xa0, 0xf8, 0x1f, 0x0c, 0x94, 0xd6, 0x19, 0xd8, 0x83, 0x9a, 0x8a, 0x3b, 0x5e, 0x2d, 0x51,
-	0x17, 0xba, 0xf6, 0x62, 0x29, 0x94, 0xa6, 0xfa, 0x8d, 0xed, 0xaf, 0x5c, 0xd8, 0xfe, 0x77, 0x8d,
-	0x3e, 0xbe, 0x25, 0xd5, 0x7f, 0xb9, 0x25, 0x39, 0x1b, 0xf2, 0xf9, 0xe6, 0x65, 0xa8, 0xe9, 0x82,
-	0x3b, 0x7d, 0xf3, 0x32, 0xf7, 0x93, 0x97, 0xb9, 0x3f, 0x4e, 0x5e, 0xe6, 0xc3, 0x9a, 0x2a, 0xfc,
-	0xbb, 0x5f, 0xaf, 0x5b, 0x9b, 0xf7, 0xe3, 0xed, 0x94, 0x61, 0x7d, 0x1f, 0x1a, 0x83, 0x96, 0xf9,
-	0x74, 0x0c, 0xd2, 0x74, 0x9c, 0xee, 0xe4, 0x16, 0x08, 0x2e, 0xf1, 0xbd, 0x6c, 0xcd, 0xee, 0xe4,
-	0xd6, 0xac, 0x71, 0x99, 0x08, 0x89, 0x17, 0xb9, 0x0d, 0xe5, 0x23, 0xae, 0x4e, 0x59, 0xf3, 0x12,
-	0xee, 0xc6, 0x85, 0x7c, 0x02, 0x55, 0x55, 0x39, 0x8d, 0xb8, 0xd3, 0xba, 0x84, 0x77, 0xe2, 0xb4,
-	0xf7, 0x83, 0x95, 0xef, 0x13, 0x69, 0x40, 0xd5, 0x14, 0x36, 0xb5, 0xb7, 0x94, 0xa0, 0x9a, 0xe9,
-	0x73, 0xcf, 0xb6, 0x48, 0x4b, 0xcd, 0x7a, 0x7c, 0x62, 0xed, 0x02, 0x01, 0xa8, 0x3c, 0x62, 0x51,
-	0x88, 0x53, 0xbb, 0x48, 0xea, 0xf1, 0x72, 0xd8, 0x25, 0xd2, 0x84, 0xda, 0x90, 0x71, 0x17, 0xe7,
-	0x38, 0xb5, 0xcb, 0xe4, 0x2a, 0x5c, 0x51, 0x67, 0x75, 0x81, 0x14, 0xbf, 0x8e, 0x30, 0x54, 0x9e,
-	0x15, 0x42, 0xa0, 0xad, 0x3d, 0x33, 0xac, 0xaa, 0x0c, 0x8d, 0x5b, 0x06, 0xd6, 0xf6, 0xbe, 0x35,
-	0xd3, 0xa8, 0xdf, 0xc8, 0x26, 0xd4, 0xc6, 0x18, 0xca, 0x87, 0x7c, 0xbe, 0xb2, 0xb7, 0x48, 0x1b,
-	0xe0, 0x74, 0x15, 0x4a, 0x5c, 0x1c, 0x73, 0x5f, 0xda, 0x96, 0x0a, 0x79, 0x82, 0x52, 0xf8, 0xee,
-	0xfd, 0xc0, 0x3b, 0x41, 0xe1, 0xa1, 0x5d, 0x20, 0xdb, 0x40, 0x0c, 0x76, 0x2a, 0x03, 0xc1, 0x3c,
-	0x3c, 0x0b, 0x99, 0x87, 0x76, 0x51, 0xe1, 0xe9, 0x22, 0xde, 0x63, 0x8f, 0x67, 0xec, 0xd4, 0xe7,
-	0x33, 0xbb, 0x44, 0xae, 0x40, 0x43, 0xbb, 0x3e, 0x9c, 0x7c, 0x85, 0xae, 0xb4, 0xcb, 0x7b, 0x6f,
-	0x01, 0x64, 0x4f, 0xb5, 0xe2, 0xe2, 0x34, 0x72, 0x5d, 0x0c, 0x43, 0x7b, 0x4b, 0x15, 0xff, 0x19,
-	0xf3, 0x55, 0x8d, 0xd6, 0xde, 0x97, 0xd9, 0xce, 0x90, 0x6b, 0x50, 0x3d, 0xe3, 0x33, 0x1e, 0x7c,
-	0xc3, 0xed, 0xad, 0xce, 0x95, 0x67, 0xcf, 0xbb, 0x0d, 0x05, 0xc7, 0x10, 0x19, 0x00, 0x49, 0x3f,
-	0x98, 0xa6, 0x60, 0x5b, 0x9d, 0xce, 0xb3, 0xe7, 0xdd, 0x6d, 0x65, 0x78, 0x51, 0x7b, 0xf8, 0xe9,
-	0xcb, 0xdf, 0x77, 0xac, 0x17, 0xaf, 0x76, 0xac, 0x97, 0xaf, 0x76, 0xac, 0xdf, 0x5e, 0xed, 0x58,
-	0x5f, 0xe4, 0xff, 0xd6, 0x2e, 0x98, 0x14, 0xfe, 0x79, 0x20, 0x7c, 0xcf, 0xe7, 0x89, 0xc0, 0x71,
-	0x7f, 0x39, 0xf3, 0xf6, 0x97, 0x93, 0x7d, 0x35, 0xf0, 0x93, 0x8a, 0x1e, 0x83, 0xf7, 0xff, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0x1c, 0xcd, 0xa5, 0xbc, 0x20, 0x0b, 0x00, 0x00,
+	0x17, 0x9e, 0xf6, 0xdd, 0xc7, 0x97, 0xf4, 0x5f, 0x89, 0x46, 0x2d, 0x2b, 0xff, 0xc4, 0x32, 0x41,
+	0x8c, 0x46, 0xc2, 0x03, 0x26, 0x44, 0x24, 0x12, 0x90, 0x19, 0x7b, 0x50, 0x86, 0x64, 0x92, 0xa8,
+	0xc6, 0xb3, 0x61, 0x57, 0x6e, 0x9f, 0x74, 0x1a, 0xb7, 0xab, 0x9d, 0xea, 0xea, 0x60, 0xbf, 0x42,
+	0x56, 0x2c, 0x90, 0xc2, 0x26, 0x4f, 0xc0, 0x43, 0xb0, 0xcd, 0x32, 0x4b, 0x56, 0x5c, 0x22, 0x1e,
+	0x81, 0x2d, 0x12, 0xaa, 0xea, 0xab, 0x3d, 0x01, 0x69, 0xa4, 0xec, 0x7c, 0xbe, 0x73, 0xe9, 0x73,
+	0xbe, 0x73, 0x29, 0x03, 0x48, 0x16, 0xcc, 0xfa, 0x0b, 0xe1, 0x4b, 0x9f, 0x94, 0xd4, 0xef, 0xce,
+	0x87, 0x8e, 0x2b, 0x9f, 0x84, 0x93, 0xbe, 0xed, 0xcf, 0xf7, 0x1d, 0xdf, 0xf1, 0xf7, 0xb5, 0x72,
+	0x12, 0x3e, 0xd6, 0x92, 0x16, 0xf4, 0xaf, 0xc8, 0xa9, 0x73, 0xcd, 0xf1, 0x7d, 0xc7, 0xc3, 0xcc,
+	0x4a, 0xba, 0x73, 0x0c, 0x24, 0x9b, 0x2f, 0x62, 0x83, 0xf6, 0x1c, 0x25, 0x9b, 0x32, 0xc9, 0x22,
+	0xb9, 0xf7, 0xc2, 0x80, 0xe6, 0x98, 0x05, 0xb3, 0x93, 0x18, 0x26, 0x6d, 0x28, 0x1c, 0x8f, 0x2c,
+	0xa3, 0x6b, 0xec, 0xd6, 0x69, 0xe1, 0x78, 0x44, 0xf6, 0xa0, 0x76, 0xb4, 0x44, 0x3b, 0x94, 0xbe,
+	0xb0, 0x0a, 0x5d, 0x63, 0xb7, 0x3d, 0x68, 0xf7, 0x75, 0x96, 0xca, 0x6b, 0xe8, 0x4f, 0x91, 0xa6,
+	0x7a, 0x62, 0x41, 0x75, 0xe8, 0x73, 0x89, 0x4b, 0x69, 0x15, 0xbb, 0xc6, 0x6e, 0x93, 0x26, 0x22,
+	0xf9, 0x18, 0xaa, 0x0f, 0x17, 0xd2, 0xf5, 0x79, 0x60, 0x95, 0xba, 0xc6, 0x6e, 0x63, 0xf0, 0xbf,
+	0x2c, 0x48, 0xac, 0x38, 0x2c, 0xbd, 0xfa, 0xf5, 0xda, 0x16, 0x4d, 0xec, 0x7a, 0x3f, 0x17, 0xa0,
+	0x91, 0x53, 0x93, 0xeb, 0xd0, 0x3a, 0x61, 0x4b, 0x8a, 0x52, 0xac, 0xc6, 0xaa, 0x28, 0x9d, 0x63,
+	0x8b, 0xae, 0x83, 0xca, 0x4a, 0x4b, 0xc7, 0x5c, 0xa2, 0x78, 0xc6, 0x3c, 0x9d, 0x73, 0x91, 0xae,
+	0x83, 0xca, 0x6a, 0x84, 0x1e, 0x5b, 0x8d, 0x42, 0xc1, 0x54, 0x74, 0x9d, 0x6e, 0x91, 0xae, 0x83,
+	0xa4, 0x0b, 0x8d, 0xa1, 0xcf, 0xed, 0x50, 0x08, 0xe4, 0xf6, 0x4a, 0x27, 0xde, 0xa2, 0x79, 0x88,
+	0x7c, 0x0a, 0x95, 0xfb, 0x6c, 0x82, 0x5e, 0x60, 0x95, 0xbb, 0xc5, 0xdd, 0xc6, 0xe0, 0xff, 0xe7,
+	0xaa, 0xea, 0x47, 0xfa, 0x23, 0x2e, 0xc5, 0x8a, 0xc6, 0xc6, 0x8a, 0x53, 0x8a, 0x81, 0x1f, 0x0a,
+	0x1b, 0xad, 0x8a, 0xa6, 0x23, 0xe6, 0x34, 0x41, 0x69, 0xaa, 0xef, 0xdc, 0x82, 0x46, 0x2e, 0x04,
+	0x31, 0xa1, 0x38, 0xc3, 0x55, 0xdc, 0x1f, 0xf5, 0x93, 0x5c, 0x81, 0xf2, 0x33, 0xe6, 0x85, 0xa8,
+	0x2b, 0xad, 0xd3, 0x48, 0xb8, 0x5d, 0xf8, 0xcc, 0xe8, 0xdd, 0xc8, 0x3e, 0xa3, 0xfc, 0x86, 0x8f,
+	0xce, 0xb4, 0x5f, 0x89, 0xaa, 0x9f, 0x64, 0x1b, 0x2a, 0x27, 0x38, 0xf7, 0xc5, 0x4a, 0x3b, 0x96,
+	0x68, 0x2c, 0xf5, 0xee, 0x41, 0x2b, 0x6a, 0x28, 0x52, 0x0c, 0x42, 0x4f, 0x92, 0xeb, 0x50, 0x52,
+	0x7d, 0xd6, 0xbe, 0xed, 0x81, 0x99, 0x66, 0x1a, 0x7a, 0x52, 0xe1, 0x54, 0x6b, 0x55, 0x1a, 0x47,
+	0x42, 0xc4, 0x43, 0x52, 0xa7, 0x91, 0xd0, 0xfb, 0xab, 0x00, 0xf5, 0x83, 0x60, 0xc5, 0x6d, 0x45,
+	0x49, 0x6e, 0xb6, 0x4a, 0x7a, 0xb6, 0x6e, 0x40, 0x2d, 0x99, 0x3b, 0xed, 0xd6, 0x18, 0x90, 0x8c,
+	0xc0, 0x44, 0x13, 0xcf, 0x45, 0x6a, 0x49, 0x7a, 0xd0, 0x7c, 0xc4, 0x04, 0x72, 0xa9, 0xac, 0x8e,
+	0x47, 0xba, 0x77, 0x75, 0xba, 0x86, 0x91, 0x5d, 0xa8, 0x9c, 0x4a, 0x26, 0xc3, 0x68, 0xdc, 0xd2,
+	0xac, 0x95, 0x36, 0xc2, 0x69, 0xac, 0x27, 0x3b, 0x00, 0x0a, 0xa5, 0x21, 0xe7, 0x28, 0xac, 0xb2,
+	0x8e, 0x95, 0x43, 0x74, 0x5d, 0x0b, 0xdf, 0x7e, 0xa2, 0x1b, 0xd5, 0xa2, 0x91, 0xa0, 0x06, 0xe8,
+	0x3e, 0x0b, 0xe4, 0x5d, 0x64, 0x42, 0x4e, 0x90, 0x49, 0xab, 0x1a, 0x0d, 0xd0, 0x1a, 0x48, 0x3a,
+	0x50, 0x1b, 0x0a, 0x64, 0x12, 0x0f, 0xa4, 0x55, 0xd3, 0x06, 0xa9, 0x1c, 0x0d, 0xd7, 0x7c, 0xe1,
+	0xa1, 0xc4, 0xe9, 0x81, 0xb4, 0xea, 0x5a, 0x9d, 0x87, 0xc8, 0xad, 0x8d, 0x46, 0x58, 0xa0, 0x29,
+	0xba, 0x1c, 0x95, 0xb2, 0xa6, 0xa2, 0xeb, 0x96, 0xbd, 0x3f, 0x0d, 0xf5, 0x65, 0x9f, 0xbf, 0x43,
+	0xd6, 0x3b, 0x51, 0xc4, 0xa3, 0xe5, 0x42, 0xc4, 0x8c, 0xa7, 0xb2, 0xd2, 0x3d, 0xc0, 0xa5, 0x54,
+	0x1b, 0xa8, 0xf9, 0x2e, 0xd2, 0x54, 0x56, 0xdd, 0x1a, 0x0b, 0xd7, 0x71, 0x50, 0x44, 0x5b, 0x5b,
+	0xd6, 0x79, 0xac, 0x61, 0x6b, 0x3c, 0x55, 0x36, 0x78, 0xea, 0x40, 0xed, 0x6c, 0x31, 0x8d, 0x74,
+	0x11, 0xc9, 0xa9, 0xdc, 0xfb, 0xc9, 0x00, 0x73, 0xe8, 0x73, 0x8e, 0xb6, 0xf4, 0xc5, 0x08, 0x25,
+	0x73, 0xbd, 0x80, 0x5c, 0x85, 0xfa, 0x98, 0x4d, 0x3c, 0x7c, 0xc0, 0xe6, 0x18, 0xef, 0x49, 0x06,
+	0x90, 0xcf, 0xb3, 0x43, 0x54, 0xd0, 0x2b, 0xfb, 0x5e, 0x54, 0xfb, 0x66, 0x98, 0x7e, 0x6c, 0x15,
+	0x2d, 0x6e, 0xe2, 0xd3, 0xb9, 0x0d, 0xcd, 0xbc, 0xe2, 0x42, 0xeb, 0xf8, 0x8b, 0x01, 0xd5, 0x24,
+	0xc9, 0x2e, 0x34, 0x46, 0x18, 0xd8, 0xc2, 0xd5, 0xc1, 0x62, 0xff, 0x3c, 0xa4, 0xca, 0x38, 0xb0,
+	0x6d, 0x3f, 0xe4, 0xf2, 0x78, 0xa4, 0x63, 0xb5, 0x68, 0x06, 0xa8, 0x4b, 0x1b, 0x0b, 0x71, 0x33,
+	0x12, 0x51, 0xf3, 0x15, 0xa0, 0xe0, 0x2c, 0xee, 0x45, 0x9d, 0xa6, 0x72, 0xb6, 0xa3, 0xe5, 0xdc,
+	0x8e, 0x92, 0x9b, 0x50, 0x4f, 0xab, 0x8f, 0x67, 0x6c, 0xfb, 0xed, 0xa4, 0xdc, 0xdd, 0xa2, 0x99,
+	0xe9, 0x61, 0x3d, 0x2d, 0xa7, 0xf7, 0x77, 0x09, 0x60, 0xc4, 0x70, 0xfe, 0x4e, 0x27, 0x6e, 0x8d,
+	0x81, 0xe2, 0x7f, 0x30, 0x50, 0x5a, 0x67, 0x60, 0x0f, 0x6a, 0x2a, 0xee, 0x78, 0xb5, 0x40, 0xab,
+	0xbc, 0xf9, 0x62, 0x29, 0x94, 0xa6, 0xfa, 0x8d, 0xed, 0xaf, 0x9c, 0xdb, 0xfe, 0x8f, 0x22, 0x7d,
+	0x7c, 0x4b, 0xaa, 0xff, 0x72, 0x4b, 0x72, 0x36, 0xe4, 0xeb, 0xcd, 0xcb, 0x50, 0xd3, 0x05, 0x77,
+	0xfa, 0xd1, 0xcb, 0xdc, 0x4f, 0x5e, 0xe6, 0xfe, 0x38, 0x79, 0x99, 0x0f, 0x6b, 0xaa, 0xf0, 0xef,
+	0x7f, 0xbb, 0x66, 0x6c, 0xde, 0x8f, 0x0f, 0x52, 0x86, 0xf5, 0x7d, 0x68, 0x0c, 0x5a, 0xd1, 0xa7,
+	0x63, 0x90, 0x26, 0x5a, 0x72, 0x27, 0xb7, 0x40, 0x70, 0x81, 0xef, 0x65, 0x6b, 0x76, 0x27, 0xb7,
+	0x66, 0x8d, 0x8b, 0x44, 0x48, 0xbc, 0xc8, 0x6d, 0x28, 0x1f, 0x71, 0x75, 0xca, 0x9a, 0x17, 0x70,
+	0x8f, 0x5c, 0xc8, 0x17, 0x50, 0x55, 0x95, 0xd3, 0x90, 0x5b, 0xad, 0x0b, 0x78, 0x27, 0x4e, 0x7b,
+	0x3f, 0x1a, 0xf9, 0x3e, 0x91, 0x06, 0x54, 0xa3, 0xc2, 0xa6, 0xe6, 0x96, 0x12, 0x54, 0x33, 0x5d,
+	0xee, 0x98, 0x06, 0x69, 0x41, 0x3d, 0x3d, 0xb1, 0x66, 0x81, 0x00, 0x54, 0x1e, 0xb1, 0x30, 0xc0,
+	0xa9, 0x59, 0x24, 0xf5, 0x78, 0x39, 0xcc, 0x12, 0x69, 0x42, 0x6d, 0xc8, 0xb8, 0x8d, 0x1e, 0x4e,
+	0xcd, 0x32, 0xb9, 0x0c, 0x97, 0xd4, 0x59, 0x9d, 0x23, 0xc5, 0xa7, 0x21, 0x06, 0xca, 0xb3, 0x42,
+	0x08, 0xb4, 0xb5, 0x67, 0x86, 0x55, 0x95, 0x61, 0xe4, 0x96, 0x81, 0xb5, 0xbd, 0x17, 0x46, 0x34,
+	0x8e, 0xfa, 0x91, 0x6c, 0x42, 0x6d, 0x8c, 0x81, 0x7c, 0xc8, 0xbd, 0x95, 0xb9, 0x45, 0xda, 0x00,
+	0xa7, 0xab, 0x40, 0xe2, 0xfc, 0x98, 0xbb, 0xd2, 0x34, 0x54, 0xcc, 0x13, 0x94, 0xc2, 0xb5, 0xef,
+	0xfb, 0xce, 0x09, 0x0a, 0x07, 0xcd, 0x02, 0xd9, 0x06, 0x12, 0x61, 0xa7, 0xd2, 0x17, 0xcc, 0xc1,
+	0xb3, 0x80, 0x39, 0x68, 0x16, 0x15, 0x9e, 0x6e, 0xe2, 0x3d, 0xf6, 0x78, 0xc6, 0x4e, 0x5d, 0x3e,
+	0x33, 0x4b, 0xe4, 0x12, 0x34, 0xb4, 0xeb, 0xc3, 0xc9, 0xb7, 0x68, 0x4b, 0xb3, 0x4c, 0x2c, 0xb8,
+	0x92, 0x1a, 0x9e, 0xac, 0x82, 0xa7, 0xde, 0xa1, 0xcb, 0x3d, 0xdf, 0x31, 0x2b, 0x7b, 0xef, 0x03,
+	0x64, 0xaf, 0xb8, 0xa2, 0xe9, 0x34, 0xb4, 0x6d, 0x0c, 0x02, 0x73, 0x4b, 0xf1, 0xf2, 0x15, 0x73,
+	0x55, 0xf9, 0xc6, 0xde, 0x0f, 0x46, 0xb6, 0x4f, 0xe4, 0x2a, 0x54, 0xcf, 0xf8, 0x8c, 0xfb, 0xdf,
+	0x71, 0x73, 0xab, 0x73, 0xe9, 0xf9, 0xcb, 0x6e, 0x43, 0xc1, 0x31, 0x44, 0x06, 0x40, 0xd2, 0x5c,
+	0xd2, 0x8f, 0x9a, 0x46, 0xa7, 0xf3, 0xfc, 0x65, 0x77, 0x5b, 0x19, 0x9e, 0xd7, 0x92, 0x9b, 0x70,
+	0x25, 0x97, 0x56, 0xe6, 0x55, 0xe8, 0x5c, 0x7d, 0xfe, 0xb2, 0x6b, 0x29, 0xaf, 0xb7, 0xe9, 0x0f,
+	0xbf, 0x7c, 0xfd, 0xc7, 0x8e, 0xf1, 0xea, 0xcd, 0x8e, 0xf1, 0xfa, 0xcd, 0x8e, 0xf1, 0xfb, 0x9b,
+	0x1d, 0xe3, 0x9b, 0xfc, 0x5f, 0xe5, 0x39, 0x93, 0xc2, 0x5d, 0xfa, 0xc2, 0x75, 0x5c, 0x9e, 0x08,
+	0x1c, 0xf7, 0x17, 0x33, 0x67, 0x7f, 0x31, 0xd9, 0x57, 0x4b, 0x34, 0xa9, 0xe8, 0xd1, 0xfa, 0xe4,
+	0x9f, 0x01, 0x00, 0xec, 0x40, 0x0f, 0xd0, 0x74, 0x0b, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -59,6 +59,22 @@ func (c *CounterLogExporter) Export() []zap.Field {
 		fields = append(fields, zap.Any("FileService Cache Remote Hit Rate", float64(cacheRemoteHit)/float64(cacheRemoteRead)))
 	}
 
+	for _, policy := range []struct {
+		name string
+		hit  *stats.Counter
+		read *stats.Counter
+	}{
+		{"LRU", &c.counter.FileService.Cache.LRU.Hit, &c.counter.FileService.Cache.LRU.Read},
+		{"FIFO", &c.counter.FileService.Cache.FIFO.Hit, &c.counter.FileService.Cache.FIFO.Read},
+		{"S3FIFO", &c.counter.FileService.Cache.S3FIFO.Hit, &c.counter.FileService.Cache.S3FIFO.Read},
+	} {
+		hit := policy.hit.LoadW()
+		read := policy.read.LoadW()
+		if hit != 0 && read != 0 {
+			fields = append(fields, zap.Any("FileService Cache "+policy.name+" Hit Rate", float64(hit)/float64(read)))
+		}
+	}
+
 	// all fields in CounterSet
 	_ = c.counter.IterFields(func(path []string, counter *stats.Counter) error {
 		counterValue := counter.SwapW(0)
@@ -66,10 +66,28 @@ type FileServiceCounterSet struct {
 			Read stats.Counter
 			Hit  stats.Counter
 		}
+		// by eviction policy
 		LRU struct {
+			Read              stats.Counter
+			Hit               stats.Counter
 			Evict             stats.Counter
 			EvictWithZeroRead stats.Counter
 		}
+		FIFO struct {
+			Read  stats.Counter
+			Hit   stats.Counter
+			Evict stats.Counter
+		}
+		S3FIFO struct {
+			Read  stats.Counter
+			Hit   stats.Counter
+			Evict stats.Counter
+		}
+		// entries read by large scans
+		ScanAdmission struct {
+			Demote stats.Counter
+			Bypass stats.Counter
+		}
 	}
 
 	FileWithChecksum struct {
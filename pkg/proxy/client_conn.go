@@ -291,6 +291,10 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return c.handleKillQuery(ev, resp)
 	case *setVarEvent:
 		return c.handleSetVar(ev)
+	case *changeUserEvent:
+		return c.handleChangeUser(ev, resp)
+	case *resetConnectionEvent:
+		return c.handleResetConnection(ev)
 	default:
 	}
 	return nil
@@ -365,6 +369,60 @@ func (c *clientConn) handleSetVar(e *setVarEvent) error {
 	return nil
 }
 
+// handleChangeUser handles the change user event. The proxy logs in as
+// the new user to a CN server of the new tenant, and only after the login
+// succeeds, the server connection of the tunnel, the cached handshake packet
+// and the tenant are replaced. The response of the login is sent to client.
+func (c *clientConn) handleChangeUser(e *changeUserEvent, resp chan<- []byte) error {
+	if c.handshakePack == nil {
+		err := moerr.NewInternalErrorNoCtx("no handshake information for change user")
+		c.sendErr(err, resp)
+		return err
+	}
+	pack, username, err := rebuildHandshakeResp(c.handshakePack, e.payload)
+	if err != nil {
+		c.sendErr(err, resp)
+		return err
+	}
+	var ci clientInfo
+	if err := ci.parse(username); err != nil {
+		c.sendErr(err, resp)
+		return err
+	}
+	info := c.clientInfo
+	info.username = ci.username
+	info.labelInfo = newLabelInfo(ci.Tenant, ci.Labels)
+
+	// Log in to a CN server of the new tenant with the new user. The
+	// current session is kept if the CN server rejects the new user.
+	sc, r, err := c.routeAndConnect(info, pack)
+	if err != nil {
+		c.sendErr(err, resp)
+		return err
+	}
+	if !isOKPacket(r) {
+		_ = sc.Close()
+		sendResp(changeUserResp(r), resp)
+		return nil
+	}
+	if err := c.tun.switchServerConn(c.ctx, sc); err != nil {
+		_ = sc.Close()
+		c.sendErr(err, resp)
+		return err
+	}
+	c.handshakePack = pack
+	c.clientInfo = info
+	c.migration.setVarStmts = nil
+	sendResp(changeUserResp(r), resp)
+	return nil
+}
+
+// handleResetConnection handles the reset connection event.
+func (c *clientConn) handleResetConnection(_ *resetConnectionEvent) error {
+	c.migration.setVarStmts = nil
+	return nil
+}
+
 // Close implements the ClientConn interface.
 func (c *clientConn) Close() error {
 	return c.queryClient.Close()
@@ -377,9 +435,43 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 		return c.testHelper.connectToBackend()
 	}
 
+	sc, r, err := c.routeAndConnect(c.clientInfo, c.handshakePack)
+	if err != nil {
+		return nil, err
+	}
+
+	if prevAdd == "" {
+		// r is the packet received from CN server, send r to client.
+		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
+			v2.ProxyConnectCommonFailCounter.Inc()
+			return nil, err
+		}
+	} else {
+		// The connection has been transferred to a new server, but migration fails,
+		// but we don't return error, which will cause unknown issue.
+		if err := c.migrateConn(prevAdd, sc); err != nil {
+			c.log.Error("failed to migrate connection information", zap.Error(err))
+			return sc, nil
+		}
+	}
+	if !isOKPacket(r) {
+		v2.ProxyConnectCommonFailCounter.Inc()
+		return nil, withCode(moerr.NewInternalErrorNoCtx("access error"),
+			codeAuthFailed)
+	}
+	v2.ProxyConnectSuccessCounter.Inc()
+	return sc, nil
+}
+
+// routeAndConnect selects a CN server for the client ci and logs in to it
+// with the handshake packet pack. It returns the server connection and the
+// packet received from the CN server.
+func (c *clientConn) routeAndConnect(
+	ci clientInfo, pack *frontend.Packet,
+) (ServerConn, []byte, error) {
 	if c.router == nil {
 		v2.ProxyConnectCommonFailCounter.Inc()
-		return nil, moerr.NewInternalErrorNoCtx("no router available")
+		return nil, nil, moerr.NewInternalErrorNoCtx("no router available")
 	}
 
 	badCNServers := make(map[string]struct{})
@@ -390,18 +482,14 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 		return false
 	}
 
-	var err error
-	var cn *CNServer
-	var sc ServerConn
-	var r []byte
 	for {
 		// Select the best CN server from backend.
 		//
 		// NB: The selected CNServer must have label hash in it.
-		cn, err = c.router.Route(c.ctx, c.clientInfo, filterFn)
+		cn, err := c.router.Route(c.ctx, ci, filterFn)
 		if err != nil {
 			v2.ProxyConnectRouteFailCounter.Inc()
-			return nil, err
+			return nil, nil, err
 		}
 		// We have to set proxy connection ID after cn is returned.
 		cn.proxyConnID = c.connID
@@ -415,7 +503,7 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 
 		// After select a CN server, we try to connect to it. If connect
 		// fails, and it is a retryable error, we reselect another CN server.
-		sc, r, err = c.router.Connect(cn, c.handshakePack, c.tun)
+		sc, r, err := c.router.Connect(cn, pack, c.tun)
 		if err != nil {
 			if isRetryableErr(err) {
 				v2.ProxyConnectRetryCounter.Inc()
@@ -430,36 +518,12 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 					zap.Error(err),
 				)
 				continue
-			} else {
-				v2.ProxyConnectCommonFailCounter.Inc()
-				return nil, err
 			}
-		} else {
-			break
-		}
-	}
-
-	if prevAdd == "" {
-		// r is the packet received from CN server, send r to client.
-		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
 			v2.ProxyConnectCommonFailCounter.Inc()
-			return nil, err
-		}
-	} else {
-		// The connection has been transferred to a new server, but migration fails,
-		// but we don't return error, which will cause unknown issue.
-		if err := c.migrateConn(prevAdd, sc); err != nil {
-			c.log.Error("failed to migrate connection information", zap.Error(err))
-			return sc, nil
+			return nil, nil, err
 		}
+		return sc, r, nil
 	}
-	if !isOKPacket(r) {
-		v2.ProxyConnectCommonFailCounter.Inc()
-		return nil, withCode(moerr.NewInternalErrorNoCtx("access error"),
-			codeAuthFailed)
-	}
-	v2.ProxyConnectSuccessCounter.Inc()
-	return sc, nil
 }
 
 // readPacket reads MySQL packets from clients. It is mainly used in
@@ -328,6 +328,106 @@ func makeClientHandshakeResp() []byte {
 	return data
 }
 
+func makeChangeUserPayload(username, dbname string) []byte {
+	var payload []byte
+	payload = append(payload, username...)
+	payload = append(payload, 0)  // the end of username
+	payload = append(payload, 20) // length of auth response
+	payload = append(payload, make([]byte, 20)...)
+	payload = append(payload, dbname...)
+	payload = append(payload, 0)     // end of db name
+	payload = append(payload, 33, 0) // client charset
+	payload = append(payload, "mysql_native_password"...)
+	payload = append(payload, 0)
+	return payload
+}
+
+func TestRebuildHandshakeResp(t *testing.T) {
+	handshake := bytesToPacket(makeClientHandshakeResp())
+	pack, username, err := rebuildHandshakeResp(handshake, makeChangeUserPayload("tenant2:user2", "db2"))
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", username)
+	require.Equal(t, int(pack.Length), len(pack.Payload))
+
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	mp := cc.(*clientConn).mysqlProto
+	_, err = mp.HandleHandshake(context.Background(), pack.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", mp.GetUserName())
+	require.Equal(t, "db2", mp.GetDatabaseName())
+
+	// without database
+	pack, _, err = rebuildHandshakeResp(handshake, makeChangeUserPayload("tenant2:user2", ""))
+	require.NoError(t, err)
+	_, err = mp.HandleHandshake(context.Background(), pack.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "", mp.GetDatabaseName())
+
+	_, _, err = rebuildHandshakeResp(handshake, []byte{'a', 'b'})
+	require.Error(t, err)
+	_, _, err = rebuildHandshakeResp(&frontend.Packet{Payload: []byte{1, 2}}, makeChangeUserPayload("a", ""))
+	require.Error(t, err)
+}
+
+func TestClientConn_HandleChangeUser(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	c.handshakePack = bytesToPacket(makeClientHandshakeResp())
+	c.clientInfo.username = "user1"
+	c.clientInfo.labelInfo = newLabelInfo("tenant1", nil)
+	c.migration.setVarStmts = []string{"set @a=1"}
+	resp := make(chan []byte, 10)
+
+	checkUnchanged := func() {
+		require.Equal(t, Tenant("tenant1"), c.GetTenant())
+		require.Equal(t, "user1", c.clientInfo.username)
+		require.Equal(t, []string{"set @a=1"}, c.migration.setVarStmts)
+	}
+	changeUser := makeChangeUserEvent(makeChangeUserPayload("tenant2:user2", "db2"))
+
+	// No router available.
+	require.Error(t, c.HandleEvent(context.Background(), changeUser, resp))
+	require.True(t, isErrPacket(<-resp))
+	checkUnchanged()
+
+	// The CN server of the new tenant rejects the user.
+	var routed clientInfo
+	errPacket := []byte{1, 0, 0, 2, 0xFF}
+	c.router = &mockRouter{
+		mockRouteFn: func(ctx context.Context, ci clientInfo) (*CNServer, error) {
+			routed = ci
+			return &CNServer{}, nil
+		},
+		mockConnectFn: func(_ *CNServer, pack *frontend.Packet) (ServerConn, []byte, error) {
+			return newMockServerConn(nil), errPacket, nil
+		},
+	}
+	require.NoError(t, c.HandleEvent(context.Background(), changeUser, resp))
+	r := <-resp
+	require.True(t, isErrPacket(r))
+	require.Equal(t, byte(1), r[3])
+	require.Equal(t, Tenant("tenant2"), routed.Tenant)
+	require.Equal(t, "user2", routed.username)
+	checkUnchanged()
+
+	// The CN server accepts the user, but the tunnel is not running.
+	c.router.(*mockRouter).mockConnectFn = func(_ *CNServer, pack *frontend.Packet) (ServerConn, []byte, error) {
+		return newMockServerConn(nil), makeOKPacket(8), nil
+	}
+	c.tun = newTunnel(context.Background(), c.log, nil)
+	defer func() { _ = c.tun.Close() }()
+	require.Error(t, c.HandleEvent(context.Background(), changeUser, resp))
+	require.True(t, isErrPacket(<-resp))
+	checkUnchanged()
+
+	require.NoError(t, c.HandleEvent(context.Background(), makeResetConnectionEvent(), nil))
+	require.Empty(t, c.migration.setVarStmts)
+}
+
 func TestClientConn_ConnectToBackend(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
@@ -32,6 +32,10 @@ func (t eventType) String() string {
 		return "KillQuery"
 	case TypeSetVar:
 		return "SetVar"
+	case TypeChangeUser:
+		return "ChangeUser"
+	case TypeResetConnection:
+		return "ResetConnection"
 	}
 	return "Unknown"
 }
@@ -43,6 +47,10 @@ const (
 	TypeKillQuery eventType = 1
 	// TypeSetVar indicates the set variable statement.
 	TypeSetVar eventType = 2
+	// TypeChangeUser indicates the COM_CHANGE_USER command.
+	TypeChangeUser eventType = 3
+	// TypeResetConnection indicates the COM_RESET_CONNECTION command.
+	TypeResetConnection eventType = 4
 )
 
 // IEvent is the event interface.
@@ -99,6 +107,16 @@ func makeEvent(msg []byte, b *msgBuf) (IEvent, bool) {
 			return nil, false
 		}
 	}
+	// The proxy logs in as the new user itself, so the change user
+	// command is consumed and not sent to dst.
+	if isCmdChangeUser(msg) {
+		return makeChangeUserEvent(msg[preRecvLen:]), true
+	}
+	// The command below is sent to dst, and the proxy only updates
+	// the information which is used in connection migration.
+	if isCmdResetConnection(msg) {
+		return makeResetConnectionEvent(), false
+	}
 	return nil, false
 }
 
@@ -150,3 +168,46 @@ func makeSetVarEvent(stmt string) IEvent {
 func (e *setVarEvent) eventType() eventType {
 	return TypeSetVar
 }
+
+// changeUserEvent is the event that COM_CHANGE_USER command is captured.
+// The proxy logs in as the new user to a CN server of the new tenant and
+// replaces the server connection, then the login information and the
+// variables kept in clientConn are updated.
+type changeUserEvent struct {
+	baseEvent
+	// payload is the payload of COM_CHANGE_USER without the command byte.
+	payload []byte
+}
+
+// makeChangeUserEvent creates an event with TypeChangeUser type.
+func makeChangeUserEvent(payload []byte) IEvent {
+	e := &changeUserEvent{
+		payload: append([]byte(nil), payload...),
+	}
+	e.typ = TypeChangeUser
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *changeUserEvent) eventType() eventType {
+	return TypeChangeUser
+}
+
+// resetConnectionEvent is the event that COM_RESET_CONNECTION command is
+// captured. The session variables are reset in CN server, so the variables
+// kept in clientConn should be cleared.
+type resetConnectionEvent struct {
+	baseEvent
+}
+
+// makeResetConnectionEvent creates an event with TypeResetConnection type.
+func makeResetConnectionEvent() IEvent {
+	e := &resetConnectionEvent{}
+	e.typ = TypeResetConnection
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *resetConnectionEvent) eventType() eventType {
+	return TypeResetConnection
+}
@@ -102,6 +102,24 @@ func TestMakeEvent(t *testing.T) {
 			require.False(t, r)
 		}
 	})
+
+	t.Run("change user", func(t *testing.T) {
+		data := []byte{0, 0, 0, 0, byte(cmdChangeUser), 'u', 0}
+		e, r = makeEvent(data, nil)
+		require.NotNil(t, e)
+		require.True(t, r)
+		ev, ok := e.(*changeUserEvent)
+		require.True(t, ok)
+		require.Equal(t, []byte{'u', 0}, ev.payload)
+	})
+
+	t.Run("reset connection", func(t *testing.T) {
+		data := []byte{0, 0, 0, 0, byte(cmdResetConnection)}
+		e, r = makeEvent(data, nil)
+		require.NotNil(t, e)
+		require.False(t, r)
+		require.Equal(t, TypeResetConnection, e.eventType())
+	})
 }
 
 func TestKillQueryEvent(t *testing.T) {
@@ -431,4 +449,10 @@ func TestEventType_String(t *testing.T) {
 
 	e3 := setVarEvent{}
 	require.Equal(t, "SetVar", e3.eventType().String())
+
+	e4 := changeUserEvent{}
+	require.Equal(t, "ChangeUser", e4.eventType().String())
+
+	e5 := resetConnectionEvent{}
+	require.Equal(t, "ResetConnection", e5.eventType().String())
 }
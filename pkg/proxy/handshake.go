@@ -130,3 +130,108 @@ func (s *serverConn) writeHandshakeResp(handshakeResp *frontend.Packet) (*fronte
 	}
 	return data, nil
 }
+
+// rebuildHandshakeResp builds a new handshake response packet for the login with
+// the user in the COM_CHANGE_USER payload. The capabilities, the max packet size
+// and the reserved part are kept from the original handshake response. The auth
+// response in COM_CHANGE_USER is scrambled with the same salt, which is also sent
+// to the CN servers, so it is still valid when the connection is transferred.
+// It returns the new packet and the username in the payload.
+func rebuildHandshakeResp(handshake *frontend.Packet, payload []byte) (*frontend.Packet, string, error) {
+	// capabilities(4) + max-packet size(4) + character set(1) + reserved(23)
+	const fixedLen = 32
+	if handshake == nil || len(handshake.Payload) < fixedLen {
+		return nil, "", moerr.NewInternalErrorNoCtx("protocol error: handshake packet is too short")
+	}
+	capabilities := binary.LittleEndian.Uint32(handshake.Payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		return nil, "", moerr.NewInternalErrorNoCtx("protocol error: handshake response 320 is not supported")
+	}
+
+	readNUL := func(pos int) (string, int, error) {
+		if pos > len(payload) {
+			return "", pos, moerr.NewInternalErrorNoCtx("protocol error: change user packet is too short")
+		}
+		zeroPos := bytes.IndexByte(payload[pos:], 0)
+		if zeroPos == -1 {
+			return "", pos, moerr.NewInternalErrorNoCtx("protocol error: cannot get null string")
+		}
+		return string(payload[pos : pos+zeroPos]), pos + zeroPos + 1, nil
+	}
+
+	pos := 0
+	username, pos, err := readNUL(pos)
+	if err != nil {
+		return nil, "", err
+	}
+	var auth []byte
+	if capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		if pos >= len(payload) || pos+1+int(payload[pos]) > len(payload) {
+			return nil, "", moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+		}
+		l := int(payload[pos])
+		auth = payload[pos+1 : pos+1+l]
+		pos += 1 + l
+	} else {
+		var s string
+		if s, pos, err = readNUL(pos); err != nil {
+			return nil, "", err
+		}
+		auth = []byte(s)
+	}
+	database, pos, err := readNUL(pos)
+	if err != nil {
+		return nil, "", err
+	}
+	charset := handshake.Payload[8]
+	var attrs []byte
+	if pos+2 <= len(payload) {
+		// The collation ID is 2 bytes in COM_CHANGE_USER, but only 1 byte in
+		// handshake response.
+		charset = payload[pos]
+		pos += 2
+		if capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 && pos < len(payload) {
+			if _, pos, err = readNUL(pos); err != nil {
+				return nil, "", err
+			}
+		}
+		if capabilities&frontend.CLIENT_CONNECT_ATTRS != 0 && pos < len(payload) {
+			attrs = payload[pos:]
+		}
+	}
+
+	// Always use one byte length for the auth response, and the database
+	// is carried only if it is not empty.
+	capabilities &^= frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA | frontend.CLIENT_CONNECT_WITH_DB
+	capabilities |= frontend.CLIENT_SECURE_CONNECTION
+	if database != "" {
+		capabilities |= frontend.CLIENT_CONNECT_WITH_DB
+	}
+	if len(attrs) == 0 {
+		capabilities &^= frontend.CLIENT_CONNECT_ATTRS
+	}
+
+	data := make([]byte, 0, fixedLen+len(payload)+len(frontend.AuthNativePassword)+2)
+	data = binary.LittleEndian.AppendUint32(data, capabilities)
+	data = append(data, handshake.Payload[4:8]...)
+	data = append(data, charset)
+	data = append(data, handshake.Payload[9:fixedLen]...)
+	data = append(data, username...)
+	data = append(data, 0)
+	data = append(data, byte(len(auth)))
+	data = append(data, auth...)
+	if database != "" {
+		data = append(data, database...)
+		data = append(data, 0)
+	}
+	if capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 {
+		data = append(data, frontend.AuthNativePassword...)
+		data = append(data, 0)
+	}
+	data = append(data, attrs...)
+	return &frontend.Packet{
+		Length:     int32(len(data)),
+		SequenceID: handshake.SequenceID,
+		Payload:    data,
+	}, username, nil
+}
@@ -50,6 +50,9 @@ const (
 	// For stmt prepare and execute cmd from JDBC.
 	cmdStmtPrepare MySQLCmd = 0x16
 	cmdStmtClose   MySQLCmd = 0x19
+	// For connection pools to recycle the connection.
+	cmdChangeUser      MySQLCmd = 0x11
+	cmdResetConnection MySQLCmd = 0x1f
 )
 
 // MySQLConn contains a buffer to save data which may be only part
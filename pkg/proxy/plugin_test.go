@@ -45,7 +45,8 @@ func (p *mockPlugin) RecommendCN(ctx context.Context, clientInfo clientInfo) (*p
 }
 
 type mockRouter struct {
-	mockRouteFn func(ctx context.Context, ci clientInfo) (*CNServer, error)
+	mockRouteFn   func(ctx context.Context, ci clientInfo) (*CNServer, error)
+	mockConnectFn func(c *CNServer, handshakeResp *frontend.Packet) (ServerConn, []byte, error)
 
 	refreshCount int
 }
@@ -62,6 +63,9 @@ func (r *mockRouter) SelectByConnID(connID uint32) (*CNServer, error) {
 }
 
 func (r *mockRouter) Connect(c *CNServer, handshakeResp *frontend.Packet, t *tunnel) (ServerConn, []byte, error) {
+	if r.mockConnectFn != nil {
+		return r.mockConnectFn(c, handshakeResp)
+	}
 	return nil, nil, nil
 }
 
@@ -388,6 +388,48 @@ func (t *tunnel) transferSync(ctx context.Context) error {
 	return nil
 }
 
+// switchServerConn replaces the server connection of the tunnel with sc,
+// which has logged in to the CN server already. It is used when the client
+// changes the user, and the current session is abandoned.
+func (t *tunnel) switchServerConn(ctx context.Context, sc ServerConn) error {
+	t.mu.Lock()
+	if !t.mu.started || t.mu.inTransfer {
+		t.mu.Unlock()
+		return moerr.NewInternalErrorNoCtx("cannot switch server connection now")
+	}
+	t.mu.inTransfer = true
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.mu.inTransfer = false
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTransferTimeout)
+	defer cancel()
+
+	csp, scp := t.getPipes()
+	if err := csp.pause(ctx); err != nil {
+		return err
+	}
+	if err := scp.pause(ctx); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	newConn := newMySQLConn(connServerName, sc.RawConn(), 0, t.reqC, t.respC,
+		t.mu.scp.src.msgBuf, sc.ConnID())
+	t.mu.Unlock()
+	t.replaceServerConn(newConn, false)
+	t.logger.Info("switch to a new CN server for change user",
+		zap.String("addr", newConn.RemoteAddr().String()))
+	if err := t.kickoff(); err != nil {
+		t.logger.Error("failed to kickoff tunnel", zap.Error(err))
+		_ = t.Close()
+		return err
+	}
+	return nil
+}
+
 // getNewServerConn selects a new CN server and connects to it then
 // returns the new connection.
 func (t *tunnel) getNewServerConn(ctx context.Context) (*MySQLConn, error) {
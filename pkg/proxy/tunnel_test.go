@@ -673,3 +673,41 @@ func TestReplaceServerConn(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "select 1", string(buf[5:n]))
 }
+
+func TestSwitchServerConn(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.TODO()
+	clientProxy, client := net.Pipe()
+	serverProxy, _ := net.Pipe()
+
+	rt := runtime.DefaultRuntime()
+	tu := newTunnel(ctx, rt.Logger(), nil)
+	defer func() {
+		require.NoError(t, tu.Close())
+	}()
+
+	newServerProxy, newServer := net.Pipe()
+	newSC := newMockServerConn(newServerProxy)
+	// The tunnel has not started.
+	require.Error(t, tu.switchServerConn(ctx, newSC))
+
+	cc := newMockClientConn(clientProxy, "t1", clientInfo{}, nil, tu)
+	require.NotNil(t, cc)
+	sc := newMockServerConn(serverProxy)
+	require.NoError(t, tu.run(cc, sc))
+
+	require.NoError(t, tu.switchServerConn(ctx, newSC))
+	_, mysqlSC := tu.getConns()
+	require.Equal(t, newServerProxy, mysqlSC.src)
+
+	go func() {
+		_, err := client.Write(makeSimplePacket("select 1"))
+		require.NoError(t, err)
+	}()
+
+	buf := make([]byte, 30)
+	n, err := newServer.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "select 1", string(buf[5:n]))
+}
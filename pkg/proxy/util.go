@@ -63,6 +63,20 @@ func isCmdStmtClose(p []byte) bool {
 	return false
 }
 
+func isCmdChangeUser(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdChangeUser) {
+		return true
+	}
+	return false
+}
+
+func isCmdResetConnection(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdResetConnection) {
+		return true
+	}
+	return false
+}
+
 // isOKPacket returns true if []byte is a MySQL OK packet.
 func isOKPacket(p []byte) bool {
 	if len(p) > 4 && p[4] == 0 {
@@ -71,6 +85,17 @@ func isOKPacket(p []byte) bool {
 	return false
 }
 
+// changeUserResp makes the response of COM_CHANGE_USER from the packet
+// received in the login to CN server. The sequence ID of the response is 1.
+func changeUserResp(p []byte) []byte {
+	r := make([]byte, len(p))
+	copy(r, p)
+	if len(r) > 3 {
+		r[3] = 1
+	}
+	return r
+}
+
 // isOKPacket returns true if []byte is a MySQL EOF packet.
 func isEOFPacket(p []byte) bool {
 	if len(p) > 4 && p[4] == 0xFE {
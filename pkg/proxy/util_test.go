@@ -158,6 +158,34 @@ func TestIsCmdStmtClose(t *testing.T) {
 	require.True(t, ret)
 }
 
+func TestIsCmdChangeUser(t *testing.T) {
+	var data []byte
+	ret := isCmdChangeUser(data)
+	require.False(t, ret)
+
+	data = []byte{0, 0, 0, 0, 20, 0}
+	ret = isCmdChangeUser(data)
+	require.False(t, ret)
+
+	data = []byte{0, 0, 0, 0, byte(cmdChangeUser), 0}
+	ret = isCmdChangeUser(data)
+	require.True(t, ret)
+}
+
+func TestIsCmdResetConnection(t *testing.T) {
+	var data []byte
+	ret := isCmdResetConnection(data)
+	require.False(t, ret)
+
+	data = []byte{0, 0, 0, 0, 20, 0}
+	ret = isCmdResetConnection(data)
+	require.False(t, ret)
+
+	data = []byte{0, 0, 0, 0, byte(cmdResetConnection), 0}
+	ret = isCmdResetConnection(data)
+	require.True(t, ret)
+}
+
 func TestIsOKPacket(t *testing.T) {
 	var data []byte
 	ret := isOKPacket(data)
@@ -347,10 +347,12 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 				}
 			}
 
-			switch ctr.typ {
-			case H8:
+			switch {
+			case len(ctr.vecs) == 1 && ctr.vecs[0].GetDict() != nil:
+				err = ctr.processHDict(bat, proc)
+			case ctr.typ == H8:
 				err = ctr.processH8(bat, proc)
-			case HStr:
+			case ctr.typ == HStr:
 				err = ctr.processHStr(bat, proc)
 			default:
 			}
@@ -454,6 +456,102 @@ func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// processHDict do group by aggregation on a dictionary encoded group by column,
+// only the distinct values are inserted into the hashmap, and the group of
+// each row is looked up by its code.
+func (ctr *container) processHDict(bat *batch.Batch, proc *process.Process) error {
+	vec := ctr.vecs[0]
+	d := vec.GetDict()
+	hasNull := vec.GetNulls().Any()
+
+	// keys are the distinct values, followed by a null if any row is null
+	keys := proc.GetVector(*vec.GetType())
+	defer proc.PutVector(keys)
+	if err := keys.UnionBatch(d.Values(), 0, d.Len(), nil, proc.Mp()); err != nil {
+		return err
+	}
+	if hasNull {
+		if err := vector.AppendBytes(keys, nil, true, proc.Mp()); err != nil {
+			return err
+		}
+	}
+
+	var itr hashmap.Iterator
+	var mp hashmap.HashMap
+	if ctr.typ == H8 {
+		itr, mp = ctr.intHashMap.NewIterator(), ctr.intHashMap
+	} else {
+		itr, mp = ctr.strHashMap.NewIterator(), ctr.strHashMap
+	}
+	keyVecs := []*vector.Vector{keys}
+	groups := make([]uint64, keys.Length())
+	for i := 0; i < keys.Length(); i += hashmap.UnitLimit {
+		n := keys.Length() - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := mp.GroupCount()
+		vals, _, err := itr.Insert(i, n, keyVecs)
+		if err != nil {
+			return err
+		}
+		cnt := 0
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for k, v := range vals[:n] {
+			if v > rows {
+				ctr.inserted[k] = 1
+				rows++
+				cnt++
+			}
+		}
+		if cnt > 0 {
+			ctr.bat.AddRowCount(cnt)
+			if err := ctr.bat.Vecs[0].UnionBatch(keys, int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+				return err
+			}
+			for _, ag := range ctr.bat.Aggs {
+				if err := ag.Grows(cnt, proc.Mp()); err != nil {
+					return err
+				}
+			}
+		}
+		copy(groups[i:], vals[:n])
+	}
+
+	codes := d.Codes()
+	nsp := vec.GetNulls()
+	nullGroup := uint64(0)
+	if hasNull {
+		nullGroup = groups[len(groups)-1]
+	}
+	vals := make([]uint64, hashmap.UnitLimit)
+	count := bat.RowCount()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		if i%(hashmap.UnitLimit*32) == 0 {
+			runtime.Gosched()
+		}
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		for k := 0; k < n; k++ {
+			if hasNull && nsp.Contains(uint64(i+k)) {
+				vals[k] = nullGroup
+			} else {
+				vals[k] = groups[codes[i+k]]
+			}
+		}
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for j, ag := range ctr.bat.Aggs {
+			ctr.tmpVecs[0] = ctr.aggVecs[j].vec
+			if err := ag.BatchFill(int64(i), ctr.inserted[:n], vals[:n], ctr.tmpVecs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 /*
 func (ctr *container) processHIndex(bat *batch.Batch, proc *process.Process) error {
 	mSels := make([][]int64, index.MaxLowCardinality+1)
@@ -27,6 +27,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -118,6 +119,55 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupDict(t *testing.T) {
+	groupSums := func(withDict bool) map[string]int64 {
+		ts := []types.Type{types.T_varchar.ToType(), types.T_int64.ToType()}
+		tc := newTestCase([]bool{true, false}, ts, []*plan.Expr{newExpression(0)},
+			[]agg.Aggregate{{Op: function.AggSumOverloadID, E: newExpression(1)}})
+		tc.arg.NeedEval = true
+		require.NoError(t, tc.arg.Prepare(tc.proc))
+
+		var bats []*batch.Batch
+		for b := 0; b < 2; b++ {
+			bat := batch.NewWithSize(2)
+			bat.Vecs[0] = vector.NewVec(ts[0])
+			bat.Vecs[1] = vector.NewVec(ts[1])
+			for i := 0; i < 20; i++ {
+				key := []byte{byte('a' + (i+b)%3)}
+				require.NoError(t, vector.AppendBytes(bat.Vecs[0], key, i%7 == 0, tc.proc.Mp()))
+				require.NoError(t, vector.AppendFixed(bat.Vecs[1], int64(i), false, tc.proc.Mp()))
+			}
+			bat.SetRowCount(20)
+			if withDict {
+				d, ok := vector.BuildDict(bat.Vecs[0])
+				require.True(t, ok)
+				bat.Vecs[0].SetDict(d)
+			}
+			bats = append(bats, bat)
+		}
+		resetChildren(tc.arg, append(bats, batch.EmptyBatch))
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+
+		sums := make(map[string]int64)
+		keys, vals := result.Batch.Vecs[0], result.Batch.Vecs[1]
+		for i := 0; i < keys.Length(); i++ {
+			key := "null"
+			if !keys.IsNull(uint64(i)) {
+				key = string(keys.GetBytesAt(i))
+			}
+			sums[key] = vector.GetFixedAt[int64](vals, i)
+		}
+		tc.arg.Free(tc.proc, false, nil)
+		tc.arg.GetChildren(0).Free(tc.proc, false, nil)
+		tc.proc.FreeVectors()
+		return sums
+	}
+	sums := groupSums(true)
+	require.Equal(t, 4, len(sums))
+	require.Equal(t, groupSums(false), sums)
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
@@ -195,6 +195,13 @@ func (ctr *container) probe(ap *Argument, proc *process.Process, anal process.An
 	mSels := ctr.mp.Sels()
 	count := ap.bat.RowCount()
 	itr := ctr.mp.NewIterator()
+	var dict *vector.Dict
+	if len(ctr.vecs) == 1 {
+		dict = ctr.vecs[0].GetDict()
+	}
+	if dict != nil && dict != ctr.dict {
+		ctr.findDict(itr, dict)
+	}
 	rowCount := 0
 	for i := ap.lastrow; i < count; i += hashmap.UnitLimit {
 		if rowCount >= colexec.DefaultBatchSize {
@@ -210,7 +217,13 @@ func (ctr *container) probe(ap *Argument, proc *process.Process, anal process.An
 		}
 		copy(ctr.inBuckets, hashmap.OneUInt8s)
 
-		vals, zvals := itr.Find(i, n, ctr.vecs, ctr.inBuckets)
+		var vals []uint64
+		var zvals []int64
+		if dict != nil {
+			vals, zvals = ctr.dictRows(dict, i, n)
+		} else {
+			vals, zvals = itr.Find(i, n, ctr.vecs, ctr.inBuckets)
+		}
 		for k := 0; k < n; k++ {
 			if ctr.inBuckets[k] == 0 || zvals[k] == 0 || vals[k] == 0 {
 				continue
@@ -314,6 +327,49 @@ func (ctr *container) evalApCondForOneSel(bat, rbat *batch.Batch, ap *Argument,
 	return nil
 }
 
+// findDict looks up the distinct values of the dictionary of the join key
+// in the hashmap once, the rows are then looked up by their codes.
+func (ctr *container) findDict(itr hashmap.Iterator, d *vector.Dict) {
+	values := []*vector.Vector{d.Values()}
+	k := d.Len()
+	ctr.dict = d
+	ctr.dictVals = make([]uint64, k)
+	ctr.dictInBuckets = make([]uint8, k)
+	for i := 0; i < k; i += hashmap.UnitLimit {
+		n := k - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		copy(ctr.inBuckets, hashmap.OneUInt8s)
+		vals, _ := itr.Find(i, n, values, ctr.inBuckets)
+		copy(ctr.dictVals[i:], vals[:n])
+		copy(ctr.dictInBuckets[i:], ctr.inBuckets[:n])
+	}
+	if ctr.dictRowVals == nil {
+		ctr.dictRowVals = make([]uint64, hashmap.UnitLimit)
+		ctr.dictRowZvals = make([]int64, hashmap.UnitLimit)
+	}
+}
+
+// dictRows returns the lookup results of the rows [start, start+n) of the
+// dictionary encoded join key like Iterator.Find does, null rows are not found.
+func (ctr *container) dictRows(d *vector.Dict, start, n int) ([]uint64, []int64) {
+	codes := d.Codes()[start : start+n]
+	nsp := ctr.vecs[0].GetNulls()
+	hasNull := nsp.Any()
+	for k, code := range codes {
+		if hasNull && nsp.Contains(uint64(start+k)) {
+			ctr.dictRowZvals[k] = 0
+			ctr.dictRowVals[k] = 0
+			continue
+		}
+		ctr.dictRowZvals[k] = 1
+		ctr.dictRowVals[k] = ctr.dictVals[code]
+		ctr.inBuckets[k] = ctr.dictInBuckets[code]
+	}
+	return ctr.dictRowVals[:n], ctr.dictRowZvals[:n]
+}
+
 func (ctr *container) evalJoinCondition(bat *batch.Batch, proc *process.Process) error {
 	for i := range ctr.evecs {
 		vec, err := ctr.evecs[i].executor.Eval(proc, []*batch.Batch{bat})
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
@@ -114,6 +115,65 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestDictJoin(t *testing.T) {
+	probe := func(withDict bool) []string {
+		ts := []types.Type{types.T_varchar.ToType()}
+		tc := newTestCase([]bool{false}, ts, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+			[][]*plan.Expr{
+				{
+					newExpr(0, types.T_varchar.ToType()),
+				},
+				{
+					newExpr(0, types.T_varchar.ToType()),
+				},
+			})
+		tc.arg.Cond = nil
+
+		values0 := []string{"a", "b", "a", "c", "b", "c", "a", "a"}
+		v0 := testutil.NewVector(len(values0), ts[0], tc.proc.Mp(), false, values0)
+		bats := hashBuildWithBatch(t, tc, testutil.NewBatchWithVectors([]*vector.Vector{v0}, nil))
+		if jm, ok := bats[0].AuxData.(*hashmap.JoinMap); ok {
+			jm.SetDupCount(int64(1))
+		}
+		require.NoError(t, tc.arg.Prepare(tc.proc))
+
+		values1 := []string{"c", "d", "c", "c", "b", "a", "b", "d", "a", "b"}
+		v1 := vector.NewVec(ts[0])
+		for i, v := range values1 {
+			require.NoError(t, vector.AppendBytes(v1, []byte(v), i == 3, tc.proc.Mp()))
+		}
+		if withDict {
+			d, ok := vector.BuildDict(v1)
+			require.True(t, ok)
+			v1.SetDict(d)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- testutil.NewBatchWithVectors([]*vector.Vector{v1}, nil)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- bats[0]
+		tc.proc.Reg.MergeReceivers[1].Ch <- bats[1]
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+		var rows []string
+		for {
+			result, err := tc.arg.Call(tc.proc)
+			require.NoError(t, err)
+			if result.Status == vm.ExecStop || result.Batch == nil {
+				break
+			}
+			for i := 0; i < result.Batch.RowCount(); i++ {
+				rows = append(rows, string(result.Batch.Vecs[0].GetBytesAt(i))+string(result.Batch.Vecs[1].GetBytesAt(i)))
+			}
+		}
+		tc.arg.Free(tc.proc, false, nil)
+		tc.proc.FreeVectors()
+		return rows
+	}
+	rows := probe(true)
+	// the a, b and non-null c probe rows match 4, 2 and 2 build rows
+	require.Equal(t, 2*4+3*2+2*2, len(rows))
+	require.Equal(t, probe(false), rows)
+}
+
 /*
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
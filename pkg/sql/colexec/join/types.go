@@ -61,6 +61,13 @@ type container struct {
 	evecs []evalVector
 	vecs  []*vector.Vector
 
+	// the hashmap lookup of the distinct values of a dictionary encoded join key
+	dict          *vector.Dict
+	dictVals      []uint64
+	dictInBuckets []uint8
+	dictRowVals   []uint64
+	dictRowZvals  []int64
+
 	mp *hashmap.JoinMap
 
 	maxAllocSize int64
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -43,7 +44,57 @@ func (arg *Argument) Prepare(proc *process.Process) (err error) {
 
 	filterList := colexec.SplitAndExprs([]*plan.Expr{ap.E})
 	ap.ctr.executors, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, filterList)
-	return err
+	if err != nil {
+		return err
+	}
+	ap.ctr.dictCols = make([]int32, len(filterList))
+	for i, expr := range filterList {
+		ap.ctr.dictCols[i] = dictFilterColumn(expr)
+	}
+	return nil
+}
+
+// dictFilterColumn returns the only column referenced by a filter which gives
+// the same result for the same value, so it can be evaluated once for each
+// distinct value of a dictionary encoded column, -1 otherwise.
+func dictFilterColumn(expr *plan.Expr) int32 {
+	col := int32(-1)
+	var walk func(*plan.Expr) bool
+	walk = func(e *plan.Expr) bool {
+		switch ex := e.Expr.(type) {
+		case *plan.Expr_Col:
+			if col != -1 && col != ex.Col.ColPos {
+				return false
+			}
+			col = ex.Col.ColPos
+			return true
+		case *plan.Expr_Lit, *plan.Expr_T, *plan.Expr_Vec:
+			return true
+		case *plan.Expr_List:
+			for _, arg := range ex.List.List {
+				if !walk(arg) {
+					return false
+				}
+			}
+			return true
+		case *plan.Expr_F:
+			f, ok := function.GetFunctionByIdWithoutError(ex.F.Func.GetObj())
+			if !ok || f.CannotFold() || f.IsRealTimeRelated() {
+				return false
+			}
+			for _, arg := range ex.F.Args {
+				if !walk(arg) {
+					return false
+				}
+			}
+			return true
+		}
+		return false
+	}
+	if !walk(expr) {
+		return -1
+	}
+	return col
 }
 
 func (arg *Argument) Call(proc *process.Process) (vm.CallResult, error) {
@@ -77,6 +128,24 @@ func (arg *Argument) Call(proc *process.Process) (vm.CallResult, error) {
 			break
 		}
 
+		if col := arg.ctr.dictCols[i]; col >= 0 {
+			if d := arg.buf.Vecs[col].GetDict(); d != nil && d.Len() < arg.buf.RowCount()/2 {
+				if sels == nil {
+					sels = proc.Mp().GetSels()
+				}
+				if sels, err = arg.ctr.evalOnDict(proc, i, arg.buf.Vecs[col], d, sels[:0]); err != nil {
+					result.Batch = nil
+					return result, err
+				}
+				arg.buf, err = tryDupBatch(proc, arg.buf)
+				if err != nil {
+					return result, err
+				}
+				arg.buf.Shrink(sels, false)
+				continue
+			}
+		}
+
 		vec, err := arg.ctr.executors[i].Eval(proc, []*batch.Batch{arg.buf})
 		if err != nil {
 			result.Batch = nil
@@ -150,6 +219,54 @@ func (arg *Argument) Call(proc *process.Process) (vm.CallResult, error) {
 	return result, nil
 }
 
+// evalOnDict evaluates the i-th filter on the distinct values of the dictionary
+// encoded vector vec only, and selects the rows by their codes.
+func (ctr *container) evalOnDict(proc *process.Process, i int, vec *vector.Vector, d *vector.Dict, sels []int64) ([]int64, error) {
+	hasNull := vec.GetNulls().Any()
+
+	// keys are the distinct values, followed by a null if any row is null
+	keys := proc.GetVector(*vec.GetType())
+	defer proc.PutVector(keys)
+	if err := keys.UnionBatch(d.Values(), 0, d.Len(), nil, proc.Mp()); err != nil {
+		return nil, err
+	}
+	if hasNull {
+		if err := vector.AppendBytes(keys, nil, true, proc.Mp()); err != nil {
+			return nil, err
+		}
+	}
+	col := ctr.dictCols[i]
+	bat := batch.NewWithSize(int(col) + 1)
+	bat.Vecs[col] = keys
+	bat.SetRowCount(keys.Length())
+
+	res, err := ctr.executors[i].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetType().IsBoolean() {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "filter condition is not boolean")
+	}
+	bs := vector.GenerateFunctionFixedTypeParameter[bool](res)
+	selected := make([]bool, keys.Length())
+	for j := range selected {
+		v, null := bs.GetValue(uint64(j))
+		selected[j] = v && !null
+	}
+
+	nsp := vec.GetNulls()
+	for j, code := range d.Codes() {
+		if hasNull && nsp.Contains(uint64(j)) {
+			if selected[len(selected)-1] {
+				sels = append(sels, int64(j))
+			}
+		} else if selected[code] {
+			sels = append(sels, int64(j))
+		}
+	}
+	return sels, nil
+}
+
 func tryDupBatch(proc *process.Process, bat *batch.Batch) (*batch.Batch, error) {
 	cnt := bat.GetCnt()
 	if cnt == 1 {
@@ -67,6 +67,9 @@ func (arg *Argument) Release() {
 
 type container struct {
 	executors []colexec.ExpressionExecutor
+	// dictCols[i] is the column the i-th filter can be evaluated on the
+	// dictionary of, -1 if none
+	dictCols []int32
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool, err error) {
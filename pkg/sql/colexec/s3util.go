@@ -44,7 +44,9 @@ import (
 type S3Writer struct {
 	sortIndex      int // When writing table data, if table has sort key, need to sort data and then write to S3
 	pk             int
-	partitionIndex int16 // This value is aligned with the partition number
+	bfColumns      []uint16 // columns with bloom filter indexes
+	lcColumns      []uint16 // low cardinality columns
+	partitionIndex int16    // This value is aligned with the partition number
 	isClusterBy    bool
 
 	schemaVersion uint32
@@ -133,6 +135,8 @@ func AllocS3Writer(proc *process.Process, tableDef *plan.TableDef) (*S3Writer, e
 		schemaVersion:  tableDef.Version,
 		sortIndex:      -1,
 		pk:             -1,
+		bfColumns:      catalog.BloomIndexColumnPositions(tableDef),
+		lcColumns:      catalog.LowCardinalityColumnPositions(tableDef),
 		partitionIndex: 0,
 	}
 
@@ -185,6 +189,8 @@ func AllocPartitionS3Writer(proc *process.Process, tableDef *plan.TableDef) ([]*
 			schemaVersion:  tableDef.Version,
 			sortIndex:      -1,
 			pk:             -1,
+			bfColumns:      catalog.BloomIndexColumnPositions(tableDef),
+			lcColumns:      catalog.LowCardinalityColumnPositions(tableDef),
 			partitionIndex: int16(i), // This value is aligned with the partition number
 		}
 
@@ -614,6 +620,12 @@ func (w *S3Writer) WriteBlock(bat *batch.Batch, dataType ...objectio.DataMetaTyp
 	if w.sortIndex > -1 {
 		w.writer.SetSortKey(uint16(w.sortIndex))
 	}
+	if len(w.bfColumns) > 0 {
+		w.writer.SetBloomFilterColumns(w.bfColumns)
+	}
+	if len(w.lcColumns) > 0 {
+		w.writer.SetLowCardinalityColumns(w.lcColumns)
+	}
 	if w.attrs == nil {
 		w.attrs = bat.Attrs
 	}
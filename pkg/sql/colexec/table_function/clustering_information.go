@@ -0,0 +1,148 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func clusteringInformationPrepare(proc *process.Process, arg *Argument) (err error) {
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	for i := range arg.Attrs {
+		arg.Attrs[i] = strings.ToUpper(arg.Attrs[i])
+	}
+	return err
+}
+
+func clusteringInformationCall(_ int, proc *process.Process, arg *Argument, result *vm.CallResult) (bool, error) {
+	bat := result.Batch
+	if bat == nil {
+		return true, nil
+	}
+
+	source, err := arg.ctr.executorsForArgs[0].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return false, err
+	}
+	if source.Length() != 1 || source.IsNull(0) {
+		return false, moerr.NewInvalidInput(proc.Ctx, "clustering_information: the table name is required")
+	}
+	dbname, tablename := proc.SessionInfo.Database, source.GetStringAt(0)
+	if i := strings.IndexByte(tablename, '.'); i >= 0 {
+		dbname, tablename = tablename[:i], tablename[i+1:]
+	}
+	if dbname == "" {
+		return false, moerr.NewNoDB(proc.Ctx)
+	}
+
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, dbname, proc.TxnOperator)
+	if err != nil {
+		return false, err
+	}
+	rel, err := db.Relation(proc.Ctx, tablename, nil)
+	if err != nil {
+		return false, err
+	}
+	cols, err := clusteringColumns(proc.Ctx, rel.GetTableDef(proc.Ctx))
+	if err != nil {
+		return false, err
+	}
+
+	rbat := batch.NewWithSize(len(arg.Attrs))
+	rbat.Attrs = arg.Attrs
+	for i, attr := range arg.Attrs {
+		idx, ok := plan2.ClusteringInformationColName2Index[strings.ToLower(attr)]
+		if !ok {
+			return false, moerr.NewInternalError(proc.Ctx, "bad input select columns name %v", attr)
+		}
+		rbat.Vecs[i] = proc.GetVector(plan2.ClusteringInformationColTypes[idx])
+	}
+	for _, col := range cols {
+		infos, err := rel.GetColumMetadataScanInfo(proc.Ctx, col)
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return false, err
+		}
+		zms := make([]index.ZM, 0, len(infos))
+		for _, info := range infos {
+			zms = append(zms, index.ZM(info.ZoneMap))
+		}
+		if err = fillClusteringInformation(proc, arg.Attrs, rbat, col, index.CalculateClusteringInfo(zms)); err != nil {
+			rbat.Clean(proc.Mp())
+			return false, err
+		}
+	}
+	rbat.SetRowCount(len(cols))
+	result.Batch = rbat
+	return false, nil
+}
+
+// clusteringColumns returns the columns of the cluster by key of the table,
+// or of the primary key if the table is not clustered by any column.
+func clusteringColumns(ctx context.Context, tableDef *plan.TableDef) ([]string, error) {
+	if tableDef.ClusterBy != nil {
+		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			return util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name), nil
+		}
+		return []string{tableDef.ClusterBy.Name}, nil
+	}
+	if tableDef.Pkey != nil && tableDef.Pkey.PkeyColName != catalog.FakePrimaryKeyColName {
+		return tableDef.Pkey.Names, nil
+	}
+	return nil, moerr.NewInvalidInput(ctx, "table %s has neither cluster by nor primary key", tableDef.Name)
+}
+
+func fillClusteringInformation(proc *process.Process, attrs []string, bat *batch.Batch, col string, info index.ClusteringInfo) error {
+	var err error
+	mp := proc.GetMPool()
+	for i, attr := range attrs {
+		switch plan2.ClusteringInformationColType(plan2.ClusteringInformationColName2Index[strings.ToLower(attr)]) {
+		case plan2.ClusteringInformationColTypeColumnName:
+			err = vector.AppendBytes(bat.Vecs[i], []byte(col), false, mp)
+		case plan2.ClusteringInformationColTypeTotalObjects:
+			err = vector.AppendFixed(bat.Vecs[i], int64(info.TotalObjects), false, mp)
+		case plan2.ClusteringInformationColTypeEmptyObjects:
+			err = vector.AppendFixed(bat.Vecs[i], int64(info.EmptyObjects), false, mp)
+		case plan2.ClusteringInformationColTypeOverlappingObjects:
+			err = vector.AppendFixed(bat.Vecs[i], int64(info.OverlappingObjects), false, mp)
+		case plan2.ClusteringInformationColTypeAverageOverlaps:
+			err = vector.AppendFixed(bat.Vecs[i], info.AverageOverlaps, false, mp)
+		case plan2.ClusteringInformationColTypeAverageDepth:
+			err = vector.AppendFixed(bat.Vecs[i], info.AverageDepth, false, mp)
+		case plan2.ClusteringInformationColTypeMaxDepth:
+			err = vector.AppendFixed(bat.Vecs[i], int64(info.MaxDepth), false, mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusteringColumns(t *testing.T) {
+	ctx := context.Background()
+	cols, err := clusteringColumns(ctx, &plan.TableDef{
+		ClusterBy: &plan.ClusterByDef{Name: util.BuildCompositeClusterByColumnName([]string{"a", "b"})},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, cols)
+
+	cols, err = clusteringColumns(ctx, &plan.TableDef{ClusterBy: &plan.ClusterByDef{Name: "a"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, cols)
+
+	cols, err = clusteringColumns(ctx, &plan.TableDef{
+		Pkey: &plan.PrimaryKeyDef{PkeyColName: "id", Names: []string{"id"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, cols)
+
+	_, err = clusteringColumns(ctx, &plan.TableDef{
+		Pkey: &plan.PrimaryKeyDef{PkeyColName: catalog.FakePrimaryKeyColName},
+	})
+	require.Error(t, err)
+}
+
+func TestFillClusteringInformation(t *testing.T) {
+	proc := testutil.NewProcess()
+	attrs := []string{"COLUMN_NAME", "AVERAGE_DEPTH", "MAX_DEPTH"}
+	bat := batch.NewWithSize(len(attrs))
+	for i, attr := range attrs {
+		idx := plan2.ClusteringInformationColName2Index[strings.ToLower(attr)]
+		bat.Vecs[i] = proc.GetVector(plan2.ClusteringInformationColTypes[idx])
+	}
+	defer bat.Clean(proc.Mp())
+
+	info := index.ClusteringInfo{TotalObjects: 3, AverageDepth: 1.5, MaxDepth: 2}
+	require.NoError(t, fillClusteringInformation(proc, attrs, bat, "a", info))
+	require.Equal(t, "a", bat.Vecs[0].GetStringAt(0))
+	require.Equal(t, 1.5, vector.GetFixedAt[float64](bat.Vecs[1], 0))
+	require.Equal(t, int64(2), vector.GetFixedAt[int64](bat.Vecs[2], 0))
+}
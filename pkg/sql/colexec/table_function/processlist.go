@@ -151,6 +151,10 @@ func processlist(_ int, proc *process.Process, arg *Argument, result *vm.CallRes
 					if err := vector.AppendBytes(bat.Vecs[i], []byte(session.GetProxyHost()), false, mp); err != nil {
 						return false, err
 					}
+				case status.SessionField_QUERY_MEMORY:
+					if err := vector.AppendFixed(bat.Vecs[i], session.GetQueryMemory(), false, mp); err != nil {
+						return false, err
+					}
 				}
 			}
 		}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	pblock "github.com/matrixorigin/matrixone/pkg/pb/lock"
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
@@ -732,6 +733,137 @@ func getCacheStats(proc *process.Process) ([]*query.GetCacheInfoResponse, error)
 	return rsps, err
 }
 
+func moScrubPrepare(proc *process.Process, arg *Argument) error {
+	arg.ctr.state = dataProducing
+	if len(arg.Args) > 0 {
+		return moerr.NewInvalidInput(proc.Ctx, "moScrub: no argument is required")
+	}
+	for i := range arg.Attrs {
+		arg.Attrs[i] = strings.ToUpper(arg.Attrs[i])
+	}
+	return nil
+}
+
+func moScrubCall(_ int, proc *process.Process, arg *Argument, result *vm.CallResult) (bool, error) {
+	switch arg.ctr.state {
+	case dataProducing:
+
+		rsps, err := getScrubInfo(proc)
+		if err != nil {
+			return false, err
+		}
+
+		//alloc batch
+		bat := batch.NewWithSize(len(arg.Attrs))
+		for i, col := range arg.Attrs {
+			col = strings.ToLower(col)
+			idx, ok := plan2.MoScrubColName2Index[col]
+			if !ok {
+				return false, moerr.NewInternalError(proc.Ctx, "bad input select columns name %v", col)
+			}
+
+			tp := plan2.MoScrubColTypes[idx]
+			bat.Vecs[i] = proc.GetVector(tp)
+		}
+		bat.Attrs = arg.Attrs
+		for _, rsp := range rsps {
+			if rsp == nil || len(rsp.ScrubInfoList) == 0 {
+				continue
+			}
+
+			for _, info := range rsp.ScrubInfoList {
+				if info == nil {
+					continue
+				}
+
+				if err = fillScrubRecord(proc, arg.Attrs, bat, info); err != nil {
+					return false, err
+				}
+			}
+		}
+
+		bat.SetRowCount(bat.Vecs[0].Length())
+		result.Batch = bat
+		arg.ctr.state = dataFinished
+		return false, nil
+
+	case dataFinished:
+		result.Batch = nil
+		return true, nil
+	default:
+		return false, moerr.NewInternalError(proc.Ctx, "unknown state %v", arg.ctr.state)
+	}
+}
+
+func fillScrubRecord(proc *process.Process, attrs []string, bat *batch.Batch, info *query.ScrubInfo) error {
+	var err error
+	for colIdx, attr := range attrs {
+		switch plan2.MoScrubColType(plan2.MoScrubColName2Index[strings.ToLower(attr)]) {
+		case plan2.MoScrubColTypeNodeId:
+			if err = vector.AppendBytes(bat.Vecs[colIdx], []byte(info.GetNodeId()), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeObjectName:
+			if err = vector.AppendBytes(bat.Vecs[colIdx], []byte(info.GetObjectName()), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeDbId:
+			if err = vector.AppendFixed(bat.Vecs[colIdx], info.GetDbId(), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeTableId:
+			if err = vector.AppendFixed(bat.Vecs[colIdx], info.GetTableId(), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeError:
+			if err = vector.AppendBytes(bat.Vecs[colIdx], []byte(info.GetError()), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeDetectedAt:
+			if err = vector.AppendFixed(bat.Vecs[colIdx], types.UnixNanoToTimestamp(info.GetDetectedAt()), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeRepaired:
+			if err = vector.AppendFixed(bat.Vecs[colIdx], info.GetRepaired(), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		case plan2.MoScrubColTypeRepairError:
+			if err = vector.AppendBytes(bat.Vecs[colIdx], []byte(info.GetRepairError()), false, proc.GetMPool()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// getScrubInfo get the corrupted objects found by the scrubbers of all tn
+func getScrubInfo(proc *process.Process) ([]*query.GetScrubInfoResponse, error) {
+	var err error
+	var nodes []string
+
+	listTnService(func(s *metadata.TNService) {
+		nodes = append(nodes, s.QueryAddress)
+	})
+
+	genRequest := func() *query.Request {
+		req := proc.QueryClient.NewRequest(query.CmdMethod_GetScrubInfo)
+		req.GetScrubInfoRequest = &query.GetScrubInfoRequest{}
+		return req
+	}
+
+	rsps := make([]*query.GetScrubInfoResponse, 0)
+
+	handleValidResponse := func(nodeAddr string, rsp *query.Response) {
+		if rsp != nil && rsp.GetScrubInfoResponse != nil {
+			rsps = append(rsps, rsp.GetScrubInfoResponse)
+		}
+	}
+
+	err = requestMultipleCn(proc.Ctx, nodes, proc.QueryClient, genRequest, handleValidResponse, nil)
+	return rsps, err
+}
+
 var selectSuperTenant = func(selector clusterservice.Selector,
 	username string,
 	filter func(string) bool,
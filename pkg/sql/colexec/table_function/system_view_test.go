@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
@@ -61,6 +62,8 @@ func (m *mockQueryService) NewRequest(method query.CmdMethod) *query.Request {
 		req.GetTxnInfoRequest = &query.GetTxnInfoRequest{}
 	case query.CmdMethod_GetLockInfo:
 		req.GetLockInfoRequest = &query.GetLockInfoRequest{}
+	case query.CmdMethod_GetScrubInfo:
+		req.GetScrubInfoRequest = &query.GetScrubInfoRequest{}
 	default:
 		panic(fmt.Sprintf("usp method:%s", method.String()))
 	}
@@ -114,6 +117,16 @@ func Test_gettingInfo(t *testing.T) {
 		Waiters:     nil,
 	}
 
+	wantScrubInfo := &query.ScrubInfo{
+		NodeId:     "tn_node_id",
+		ObjectName: "mock_object",
+		DbId:       1,
+		TableId:    1000,
+		Error:      "data corrupted",
+		DetectedAt: 1e9,
+		Repaired:   true,
+	}
+
 	selectStubs := gostub.Stub(&selectSuperTenant,
 		func(selector clusterservice.Selector,
 			username string,
@@ -163,6 +176,12 @@ func Test_gettingInfo(t *testing.T) {
 						wantLockInfo,
 					},
 				}
+			case query.CmdMethod_GetScrubInfo:
+				resp.GetScrubInfoResponse = &query.GetScrubInfoResponse{
+					ScrubInfoList: []*query.ScrubInfo{
+						wantScrubInfo,
+					},
+				}
 			default:
 				panic(fmt.Sprintf("usp method %v", req.CmdMethod.String()))
 			}
@@ -458,6 +477,33 @@ func Test_gettingInfo(t *testing.T) {
 			assert.Equal(t, vector.MustStrCol(bat.GetVector(2))[0], "Exclusive")
 		})
 	}
+
+	///// test moScrubCall
+
+	arg := &Argument{
+		ctr: &container{
+			state: dataProducing,
+		},
+		Attrs: []string{
+			"object_name",
+			"table_id",
+			"detected_at",
+			"repaired",
+		},
+	}
+	result := vm.NewCallResult()
+	got, err := moScrubCall(0, testProc, arg, &result)
+	assert.NoError(t, err)
+	assert.False(t, got)
+	bat := result.Batch
+	assert.Equal(t, 1, bat.RowCount())
+	assert.Equal(t, "mock_object", vector.MustStrCol(bat.GetVector(0))[0])
+	assert.Equal(t, uint64(1000), vector.MustFixedCol[uint64](bat.GetVector(1))[0])
+	assert.Equal(t, types.UnixNanoToTimestamp(1e9), vector.MustFixedCol[types.Timestamp](bat.GetVector(2))[0])
+	assert.True(t, vector.MustFixedCol[bool](bat.GetVector(3))[0])
+	got, err = moScrubCall(0, testProc, arg, &result)
+	assert.NoError(t, err)
+	assert.True(t, got)
 }
 
 var _ logservice.CNHAKeeperClient = &mockHKClient{}
@@ -70,6 +70,10 @@ func (arg *Argument) Call(proc *process.Process) (vm.CallResult, error) {
 		f, e = moTransactionsCall(idx, proc, tblArg, &result)
 	case "mo_cache":
 		f, e = moCacheCall(idx, proc, tblArg, &result)
+	case "mo_scrub":
+		f, e = moScrubCall(idx, proc, tblArg, &result)
+	case "clustering_information":
+		f, e = clusteringInformationCall(idx, proc, tblArg, &result)
 	default:
 		result.Status = vm.ExecStop
 		return result, moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
@@ -149,6 +153,10 @@ func (arg *Argument) Prepare(proc *process.Process) error {
 		return moTransactionsPrepare(proc, tblArg)
 	case "mo_cache":
 		return moCachePrepare(proc, tblArg)
+	case "mo_scrub":
+		return moScrubPrepare(proc, tblArg)
+	case "clustering_information":
+		return clusteringInformationPrepare(proc, tblArg)
 	default:
 		return moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
 	}
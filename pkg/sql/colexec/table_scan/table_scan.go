@@ -106,7 +106,7 @@ func (arg *Argument) Call(proc *process.Process) (vm.CallResult, error) {
 			}
 		}
 		// read data from storage engine
-		bat, err := arg.Reader.Read(proc.Ctx, arg.Attrs, nil, proc.Mp(), proc)
+		bat, err := arg.Reader.Read(proc.Ctx, arg.Attrs, arg.ReadFilter, proc.Mp(), proc)
 		if err != nil {
 			result.Status = vm.ExecStop
 			e = err
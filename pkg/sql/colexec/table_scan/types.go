@@ -30,8 +30,11 @@ type Argument struct {
 	TopValueMsgTag int32
 	OrderBy        []*plan.OrderBySpec
 	Reader         engine.Reader
-	Attrs          []string
-	TableID        uint64
+	// ReadFilter is the filter the reader applies to the rows of a block
+	// before reading the columns the filter does not use, nil for none.
+	ReadFilter *plan.Expr
+	Attrs      []string
+	TableID    uint64
 
 	buf    *batch.Batch
 	tmpBuf *batch.Batch
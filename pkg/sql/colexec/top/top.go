@@ -297,6 +297,10 @@ func (arg *Argument) getTopValue() ([]byte, bool) {
 	}
 	x := int(ctr.sels[0])
 	vec := ctr.cmps[ctr.poses[0]].Vector()
+	// a null top value has no zonemap to filter the blocks with
+	if vec.IsNull(uint64(x)) {
+		return nil, false
+	}
 	if vec.GetType().IsVarlen() {
 		return vec.GetBytesAt(x), true
 	}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -36,6 +37,10 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 	}
 	tblName := qry.GetTableDef().GetName()
 
+	if qry.GetTableDef().GetIsTemporary() {
+		return s.alterTempTableCopy(c, dbName, tblName)
+	}
+
 	dbSource, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
 	if err != nil {
 		return err
@@ -249,6 +254,85 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 	return nil
 }
 
+// alterTempTableCopy alters a session temporary table. The rows are copied
+// into a replica with the new definition, then the original table is dropped
+// and the replica takes its name, so the rows are copied only once. Temporary
+// tables are invisible to other sessions, so no locks are taken, and the sqls
+// run on the session engine.
+func (s *Scope) alterTempTableCopy(c *Compile, dbName, tblName string) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+
+	// 1. create the replica table and copy the rows of the original table
+	if err := c.runSqlOnSession(qry.CreateTmpTableSql); err != nil {
+		getLogger().Info("Create copy table for alter temporary table",
+			zap.String("databaseName", dbName),
+			zap.String("origin tableName", tblName),
+			zap.String("CreateTmpTableSql", qry.CreateTmpTableSql),
+			zap.Error(err))
+		return err
+	}
+	if err := c.runSqlOnSession(qry.InsertTmpDataSql); err != nil {
+		getLogger().Info("insert data to copy table for alter temporary table",
+			zap.String("databaseName", dbName),
+			zap.String("origin tableName", tblName),
+			zap.String("InsertTmpDataSql", qry.InsertTmpDataSql),
+			zap.Error(err))
+		return err
+	}
+
+	// 2. drop the original table
+	if err := dropTempTable(c, dbName, tblName); err != nil {
+		return err
+	}
+
+	// 3. rename the replica table into the original table
+	copyTblName := qry.CopyTableDef.Name
+	_, newRel, err := getTempRelation(c.ctx, c.e, c.proc.TxnOperator, nil, dbName, copyTblName)
+	if err != nil {
+		return err
+	}
+	req := api.NewRenameTableReq(newRel.GetDBID(c.ctx), newRel.GetTableID(c.ctx),
+		engine.GetTempTableName(dbName, copyTblName), engine.GetTempTableName(dbName, tblName))
+	tmp, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = newRel.TableRenameInTxn(c.ctx, [][]byte{tmp}); err != nil {
+		getLogger().Info("Rename copy tableName to origin tableName for alter temporary table",
+			zap.String("databaseName", dbName),
+			zap.String("origin tableName", tblName),
+			zap.String("copy tableName", copyTblName),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// dropTempTable drops a temporary table with its index tables and its auto
+// increment columns.
+func dropTempTable(c *Compile, dbName, tblName string) error {
+	dbSource, rel, err := getTempRelation(c.ctx, c.e, c.proc.TxnOperator, nil, dbName, tblName)
+	if err != nil {
+		return err
+	}
+	tableDef := rel.CopyTableDef(c.ctx)
+	if err = dbSource.Delete(c.ctx, engine.GetTempTableName(dbName, tblName)); err != nil {
+		return err
+	}
+	for _, indexDef := range tableDef.Indexes {
+		if !indexDef.TableExist {
+			continue
+		}
+		if err = dbSource.Delete(c.ctx, engine.GetTempTableName(dbName, indexDef.IndexTableName)); err != nil {
+			return err
+		}
+	}
+	return incrservice.GetAutoIncrementService(c.ctx).Delete(
+		c.ctx,
+		rel.GetTableID(c.ctx),
+		c.proc.TxnOperator)
+}
+
 func (s *Scope) AlterTable(c *Compile) (err error) {
 	qry := s.Plan.GetDdl().GetAlterTable()
 	if qry.AlgorithmType == plan.AlterTable_COPY {
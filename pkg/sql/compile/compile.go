@@ -66,6 +66,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsertsecondaryindex"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsertunique"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/sample"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
@@ -2138,23 +2140,30 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) (*Sco
 		if n.ObjRef.PubInfo != nil {
 			ctx = defines.AttachAccountId(ctx, uint32(n.ObjRef.PubInfo.TenantId))
 		}
-		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
-		if err != nil {
-			panic(err)
-		}
-		rel, err = db.Relation(ctx, n.TableDef.Name, c.proc)
-		if err != nil {
-			if txnOp.IsSnapOp() {
-				return nil, err
+		if n.TableDef.GetIsTemporary() && !txnOp.IsSnapOp() {
+			if db, rel, err = getTempRelation(c.ctx, c.e, txnOp, c.proc, n.ObjRef.SchemaName, n.TableDef.Name); err != nil {
+				rel = nil
 			}
-			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
-			if e != nil {
-				panic(e)
+		}
+		if rel == nil {
+			db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
+			if err != nil {
+				panic(err)
 			}
-			rel, e = db.Relation(c.ctx, engine.GetTempTableName(n.ObjRef.SchemaName, n.TableDef.Name), c.proc)
-			if e != nil {
-				panic(e)
+			rel, err = db.Relation(ctx, n.TableDef.Name, c.proc)
+			if err != nil {
+				if txnOp.IsSnapOp() {
+					return nil, err
+				}
+				var e error // avoid contamination of error messages
+				db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
+				if e != nil {
+					panic(e)
+				}
+				rel, e = db.Relation(c.ctx, engine.GetTempTableName(n.ObjRef.SchemaName, n.TableDef.Name), c.proc)
+				if e != nil {
+					panic(e)
+				}
 			}
 		}
 		tblDef = rel.GetTableDef(ctx)
@@ -2191,6 +2200,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) (*Sco
 		SchemaName:             n.ObjRef.SchemaName,
 		AccountId:              n.ObjRef.GetPubInfo(),
 		FilterExpr:             plan2.DeepCopyExpr(filterExpr),
+		LateMaterialize:        n.LateMaterialize && filterExpr != nil,
 		node:                   n,
 		RuntimeFilterSpecs:     n.RuntimeFilterProbeList,
 		OrderBy:                n.OrderBy,
@@ -3507,6 +3517,10 @@ func (c *Compile) generateCPUNumber(cpunum, blocks int) int {
 	if cpunum <= 0 || blocks <= 0 {
 		return 1
 	}
+	// a DOP(n) optimizer hint caps the parallelism of the query
+	if dop := int(c.pn.GetQuery().GetDop()); dop > 0 && cpunum > dop {
+		cpunum = dop
+	}
 
 	if cpunum <= blocks {
 		return cpunum
@@ -3695,11 +3709,27 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, []any, []types.T, e
 		ctx = defines.AttachAccountId(ctx, catalog.System_Account)
 	}
 
-	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
-	if err != nil {
-		return nil, nil, nil, err
+	if n.TableDef.GetIsTemporary() && !txnOp.IsSnapOp() {
+		if db, rel, err = getTempRelation(ctx, c.e, txnOp, c.proc, n.ObjRef.SchemaName, n.TableDef.Name); err == nil {
+			// temporary table shadows the permanent one, just scan at local cn.
+			c.cnList = engine.Nodes{
+				engine.Node{
+					Addr: c.addr,
+					Rel:  rel,
+					Mcpu: 1,
+				},
+			}
+		} else {
+			rel = nil
+		}
+	}
+	if rel == nil {
+		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rel, err = db.Relation(ctx, n.TableDef.Name, c.proc)
 	}
-	rel, err = db.Relation(ctx, n.TableDef.Name, c.proc)
 	if err != nil {
 		if txnOp.IsSnapOp() {
 			return nil, nil, nil, err
@@ -3830,6 +3860,9 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, []any, []types.T, e
 							zm := blkMeta.ColumnMeta(uint16(columnMap[int(col.Col.ColPos)])).ZoneMap()
 							if zm.GetType().FixedLength() < 0 {
 								partialResults = nil
+							} else if !zm.IsInited() {
+								// all the values of the block are null
+								continue
 							} else {
 								if partialResults[i] == nil {
 									partialResults[i] = zm.GetMin()
@@ -3957,6 +3990,9 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, []any, []types.T, e
 							zm := blkMeta.ColumnMeta(uint16(columnMap[int(col.Col.ColPos)])).ZoneMap()
 							if zm.GetType().FixedLength() < 0 {
 								partialResults = nil
+							} else if !zm.IsInited() {
+								// all the values of the block are null
+								continue
 							} else {
 								if partialResults[i] == nil {
 									partialResults[i] = zm.GetMax()
@@ -4460,6 +4496,42 @@ func (c *Compile) runSqlWithResult(sql string) (executor.Result, error) {
 	return exec.Exec(c.proc.Ctx, sql, opts)
 }
 
+// runSqlOnSession runs sql in the current txn on the engine of the compile,
+// so the sql can see the session temporary tables, which are invisible to the
+// internal sql executor used by runSql.
+func (c *Compile) runSqlOnSession(sql string) error {
+	if sql == "" {
+		return nil
+	}
+	stmts, err := parsers.Parse(c.ctx, dialect.MYSQL, sql, 1, 0)
+	defer func() {
+		for _, stmt := range stmts {
+			stmt.Free()
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	proc := process.NewFromProc(c.proc, c.proc.Ctx, 0)
+	defer proc.Cancel()
+	compileContext := newCompilerContext(c.proc.Ctx, c.db, c.e, proc)
+	compileContext.SetRootSql(sql)
+	pn, err := plan2.BuildPlan(compileContext, stmts[0], false)
+	if err != nil {
+		return err
+	}
+
+	runC := NewCompile(c.addr, c.db, sql, c.tenant, c.uid, c.proc.Ctx, c.e, proc, stmts[0], c.isInternal, c.cnLabel, time.Now())
+	defer runC.Release()
+	runC.disableRetry = true
+	if err = runC.Compile(c.proc.Ctx, pn, func(*batch.Batch) error { return nil }); err != nil {
+		return err
+	}
+	_, err = runC.Run(0)
+	return err
+}
+
 func evalRowsetData(proc *process.Process,
 	exprs []*plan.RowsetExpr, vec *vector.Vector, exprExecs []colexec.ExpressionExecutor,
 ) error {
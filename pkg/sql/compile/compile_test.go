@@ -221,6 +221,18 @@ func TestCompileShouldReturnCtxError(t *testing.T) {
 	}
 }
 
+func TestGenerateCPUNumber(t *testing.T) {
+	c := &Compile{}
+	require.Equal(t, 1, c.generateCPUNumber(0, 10))
+	require.Equal(t, 8, c.generateCPUNumber(8, 10))
+	require.Equal(t, 5, c.generateCPUNumber(8, 5))
+
+	// the DOP optimizer hint caps the parallelism
+	c.pn = &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{Dop: 2}}}
+	require.Equal(t, 2, c.generateCPUNumber(8, 10))
+	require.Equal(t, 1, c.generateCPUNumber(8, 1))
+}
+
 func GetFilePath() string {
 	dir, _ := os.Getwd()
 	return dir
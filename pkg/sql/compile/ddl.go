@@ -347,6 +347,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	var addCol []*plan.AlterAddColumn
 	var dropCol []*plan.AlterDropColumn
 	var changePartitionDef *plan.PartitionByDef
+	var mergePolicy *plan.AlterTableMergePolicy
 
 	cols := tableDef.Cols
 	// drop foreign key
@@ -589,6 +590,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		case *plan.AlterTable_Action_AlterComment:
 			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateComment)
 			comment = act.AlterComment.NewComment
+		case *plan.AlterTable_Action_AlterMergePolicy:
+			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdatePolicy)
+			mergePolicy = act.AlterMergePolicy
 		case *plan.AlterTable_Action_AlterName:
 			alterKinds = addAlterKind(alterKinds, api.AlterKind_RenameTable)
 			oldName = act.AlterName.OldName
@@ -704,6 +708,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			req = api.NewUpdateConstraintReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), string(ct))
 		case api.AlterKind_UpdateComment:
 			req = api.NewUpdateCommentReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), comment)
+		case api.AlterKind_UpdatePolicy:
+			req = api.NewUpdatePolicyReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), mergePolicy)
 		case api.AlterKind_RenameTable:
 			req = api.NewRenameTableReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), oldName, newName)
 		case api.AlterKind_AddColumn:
@@ -860,22 +866,6 @@ func (s *Scope) CreateTable(c *Compile) error {
 		}
 	}
 
-	// check in EntireEngine.TempEngine, notice that TempEngine may not init
-	tmpDBSource, err := c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
-	if err == nil {
-		if _, err := tmpDBSource.Relation(c.ctx, engine.GetTempTableName(dbName, tblName), nil); err == nil {
-			if qry.GetIfNotExists() {
-				return nil
-			}
-			getLogger().Info("createTable",
-				zap.String("databaseName", c.db),
-				zap.String("tableName", qry.GetTableDef().GetName()),
-				zap.Error(err),
-			)
-			return moerr.NewTableAlreadyExists(c.ctx, fmt.Sprintf("temporary '%s'", tblName))
-		}
-	}
-
 	if err := lockMoTable(c, dbName, tblName, lock.LockMode_Exclusive); err != nil {
 		getLogger().Info("createTable",
 			zap.String("databaseName", c.db),
@@ -1325,13 +1315,18 @@ func (s *Scope) CreateTempTable(c *Compile) error {
 		return err
 	}
 
-	// Temporary table names and persistent table names are not allowed to be duplicated
-	// So before create temporary table, need to check if it exists a table has same name
+	// A temporary table may have the same name as a persistent table, which
+	// it shadows until it is dropped, so only the temporary tables are checked.
 	dbName := c.db
 	if qry.GetDatabase() != "" {
 		dbName = qry.GetDatabase()
 	}
 
+	// the database must exist
+	if _, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator); err != nil {
+		return err
+	}
+
 	// check in EntireEngine.TempEngine
 	tmpDBSource, err := c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
 	if err != nil {
@@ -1345,18 +1340,6 @@ func (s *Scope) CreateTempTable(c *Compile) error {
 		return moerr.NewTableAlreadyExists(c.ctx, fmt.Sprintf("temporary '%s'", tblName))
 	}
 
-	// check in EntireEngine.Engine
-	dbSource, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
-	if err != nil {
-		return err
-	}
-	if _, err := dbSource.Relation(c.ctx, tblName, nil); err == nil {
-		if qry.GetIfNotExists() {
-			return nil
-		}
-		return moerr.NewTableAlreadyExists(c.ctx, tblName)
-	}
-
 	// create temporary table
 	if err := tmpDBSource.Create(c.ctx, engine.GetTempTableName(dbName, tblName), append(exeCols, exeDefs...)); err != nil {
 		return err
@@ -1370,7 +1353,7 @@ func (s *Scope) CreateTempTable(c *Compile) error {
 		if err != nil {
 			return err
 		}
-		if _, err := tmpDBSource.Relation(c.ctx, def.Name, nil); err == nil {
+		if _, err := tmpDBSource.Relation(c.ctx, engine.GetTempTableName(dbName, def.Name), nil); err == nil {
 			return moerr.NewTableAlreadyExists(c.ctx, def.Name)
 		}
 
@@ -1814,22 +1797,17 @@ func (s *Scope) TruncateTable(c *Compile) error {
 	keepAutoIncrement := false
 	affectedRows := uint64(0)
 
-	dbSource, err = c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
-	if err != nil {
-		return err
-	}
-
-	if rel, err = dbSource.Relation(c.ctx, tblName, nil); err != nil {
-		var e error // avoid contamination of error messages
-		dbSource, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
-		if e != nil {
+	// temporary table shadows the permanent table with the same name
+	if dbSource, rel, err = getTempRelation(c.ctx, c.e, c.proc.TxnOperator, nil, dbName, tblName); err == nil {
+		isTemp = true
+	} else {
+		dbSource, err = c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
+		if err != nil {
 			return err
 		}
-		rel, e = dbSource.Relation(c.ctx, engine.GetTempTableName(dbName, tblName), nil)
-		if e != nil {
+		if rel, err = dbSource.Relation(c.ctx, tblName, nil); err != nil {
 			return err
 		}
-		isTemp = true
 	}
 
 	if !isTemp && c.proc.TxnOperator.Txn().IsPessimistic() {
@@ -2000,31 +1978,23 @@ func (s *Scope) DropTable(c *Compile) error {
 
 	tblId := qry.GetTableId()
 
-	dbSource, err = c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
-	if err != nil {
-		if qry.GetIfExists() {
-			return nil
-		}
-		return err
-	}
-
-	if rel, err = dbSource.Relation(c.ctx, tblName, nil); err != nil {
-		var e error // avoid contamination of error messages
-		dbSource, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
-		if dbSource == nil && qry.GetIfExists() {
-			return nil
-		} else if e != nil {
+	// temporary table shadows the permanent table with the same name
+	if dbSource, rel, err = getTempRelation(c.ctx, c.e, c.proc.TxnOperator, nil, dbName, tblName); err == nil {
+		isTemp = true
+	} else {
+		dbSource, err = c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
+		if err != nil {
+			if qry.GetIfExists() {
+				return nil
+			}
 			return err
 		}
-		rel, e = dbSource.Relation(c.ctx, engine.GetTempTableName(dbName, tblName), nil)
-		if e != nil {
+		if rel, err = dbSource.Relation(c.ctx, tblName, nil); err != nil {
 			if qry.GetIfExists() {
 				return nil
-			} else {
-				return err
 			}
+			return err
 		}
-		isTemp = true
 	}
 
 	if !isTemp && !isView && !isSource && c.proc.TxnOperator.Txn().IsPessimistic() {
@@ -2117,7 +2087,7 @@ func (s *Scope) DropTable(c *Compile) error {
 			return err
 		}
 		for _, name := range qry.IndexTableNames {
-			if err := dbSource.Delete(c.ctx, name); err != nil {
+			if err := dbSource.Delete(c.ctx, engine.GetTempTableName(dbName, name)); err != nil {
 				return err
 			}
 		}
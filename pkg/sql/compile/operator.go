@@ -628,7 +628,12 @@ func constructPreInsert(n *plan.Node, eg engine.Engine, proc *process.Process) (
 		attrs = append(attrs, col.Name)
 	}
 
-	if preCtx.Ref.SchemaName != "" {
+	if preCtx.TableDef.GetIsTemporary() {
+		if _, _, err := getTempRelation(proc.Ctx, eg, proc.TxnOperator, proc, preCtx.Ref.SchemaName, preCtx.Ref.ObjName); err == nil {
+			schemaName = defines.TEMPORARY_DBNAME
+		}
+	}
+	if preCtx.Ref.SchemaName != "" && schemaName != defines.TEMPORARY_DBNAME {
 		dbSource, err := eg.Database(proc.Ctx, preCtx.Ref.SchemaName, proc.TxnOperator)
 		if err != nil {
 			return nil, err
@@ -1933,13 +1938,23 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 	var isTemp bool
 	oldDbName := ref.SchemaName
 	if ref.SchemaName != "" {
-		dbSource, err = eg.Database(ctx, ref.SchemaName, proc.TxnOperator)
-		if err != nil {
-			return nil, nil, err
+		if tableDef.GetIsTemporary() && ref.SchemaName != defines.TEMPORARY_DBNAME {
+			// temporary table shadows the permanent table with the same name
+			if dbSource, relation, err = getTempRelation(ctx, eg, proc.TxnOperator, proc, ref.SchemaName, ref.ObjName); err == nil {
+				ref.SchemaName = defines.TEMPORARY_DBNAME
+				ref.ObjName = engine.GetTempTableName(oldDbName, ref.ObjName)
+				isTemp = true
+			}
+		}
+		if !isTemp {
+			dbSource, err = eg.Database(ctx, ref.SchemaName, proc.TxnOperator)
+			if err != nil {
+				return nil, nil, err
+			}
+			relation, err = dbSource.Relation(ctx, ref.ObjName, proc)
 		}
-		relation, err = dbSource.Relation(ctx, ref.ObjName, proc)
 		if err == nil {
-			isTemp = defines.TEMPORARY_DBNAME == ref.SchemaName
+			isTemp = isTemp || defines.TEMPORARY_DBNAME == ref.SchemaName
 		} else {
 			dbSource, err = eg.Database(ctx, defines.TEMPORARY_DBNAME, proc.TxnOperator)
 			if err != nil {
@@ -127,7 +127,11 @@ func (s *Scope) Run(c *Compile) (err error) {
 			if s.DataSource.node != nil && len(s.DataSource.node.RecvMsgList) > 0 {
 				tag = s.DataSource.node.RecvMsgList[0].MsgTag
 			}
-			_, err = p.Run(s.DataSource.R, tag, s.Proc)
+			var readFilter *plan.Expr
+			if s.DataSource.LateMaterialize {
+				readFilter = s.DataSource.FilterExpr
+			}
+			_, err = p.Run(s.DataSource.R, tag, readFilter, s.Proc)
 		}
 	}
 
@@ -452,11 +456,18 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		if util.TableIsClusterTable(s.DataSource.TableDef.GetTableType()) {
 			ctx = defines.AttachAccountId(ctx, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, s.DataSource.SchemaName, s.Proc.TxnOperator)
-		if err != nil {
-			return err
+		if s.DataSource.TableDef.GetIsTemporary() {
+			if db, rel, err = getTempRelation(c.ctx, c.e, s.Proc.TxnOperator, c.proc, s.DataSource.SchemaName, s.DataSource.RelationName); err != nil {
+				rel = nil
+			}
+		}
+		if rel == nil {
+			db, err = c.e.Database(ctx, s.DataSource.SchemaName, s.Proc.TxnOperator)
+			if err != nil {
+				return err
+			}
+			rel, err = db.Relation(ctx, s.DataSource.RelationName, c.proc)
 		}
-		rel, err = db.Relation(ctx, s.DataSource.RelationName, c.proc)
 		if err != nil {
 			var e error // avoid contamination of error messages
 			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, s.Proc.TxnOperator)
@@ -569,11 +580,13 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		ss[i] = newScope(Normal)
 		ss[i].NodeInfo = s.NodeInfo
 		ss[i].DataSource = &Source{
-			R:            rds[i],
-			SchemaName:   s.DataSource.SchemaName,
-			RelationName: s.DataSource.RelationName,
-			Attributes:   s.DataSource.Attributes,
-			AccountId:    s.DataSource.AccountId,
+			R:               rds[i],
+			SchemaName:      s.DataSource.SchemaName,
+			RelationName:    s.DataSource.RelationName,
+			Attributes:      s.DataSource.Attributes,
+			AccountId:       s.DataSource.AccountId,
+			FilterExpr:      s.DataSource.FilterExpr,
+			LateMaterialize: s.DataSource.LateMaterialize,
 		}
 		ss[i].Proc = process.NewWithAnalyze(s.Proc, c.ctx, 0, c.anal.Nodes())
 	}
@@ -198,6 +198,13 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 
 	case pipeline.Method_PipelineMessage:
 		c := receiver.newCompile()
+		if deadline := c.proc.Lim.ExecutionDeadline; deadline != 0 {
+			// the statement times out on this CN as well as on the one it is sent from.
+			var cancel context.CancelFunc
+			c.proc.Ctx, cancel = context.WithDeadlineCause(c.proc.Ctx, time.Unix(0, deadline), moerr.NewQueryTimeoutNoCtx())
+			defer cancel()
+			c.ctx = defines.AttachAccountId(c.proc.Ctx, receiver.procBuildHelper.accountId)
+		}
 		// decode and rewrite the scope.
 		s, err := decodeScope(receiver.scopeData, c.proc, true, c.e)
 		defer func() {
@@ -402,6 +409,17 @@ func encodeProcessInfo(proc *process.Process, sql string) ([]byte, error) {
 		procInfo.Id = proc.Id
 		procInfo.Sql = sql
 		procInfo.Lim = convertToPipelineLimitation(proc.Lim)
+		// The remote pipeline shares the quota of the query, so it may take
+		// only the bytes the query does not hold yet.
+		if mp := proc.Mp(); mp != nil {
+			if held, quota := mp.QueryMemoryHeld(); quota > 0 {
+				if held >= quota {
+					return nil, moerr.NewQueryMemoryExceeded(proc.Ctx, quota)
+				}
+				procInfo.Lim.QueryMemory = quota
+				procInfo.Lim.QueryMemoryHeld = held
+			}
+		}
 		procInfo.UnixTime = proc.UnixTime
 		accountId, err := defines.GetAccountId(proc.Ctx)
 		if err != nil {
@@ -519,6 +537,7 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			TableDef:               s.DataSource.TableDef,
 			Timestamp:              &s.DataSource.Timestamp,
 			RuntimeFilterProbeList: s.DataSource.RuntimeFilterSpecs,
+			LateMaterialize:        s.DataSource.LateMaterialize,
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -634,6 +653,7 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			TableDef:           dsc.TableDef,
 			Timestamp:          *dsc.Timestamp,
 			RuntimeFilterSpecs: dsc.RuntimeFilterProbeList,
+			LateMaterialize:    dsc.LateMaterialize,
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -1673,22 +1693,28 @@ func convertToResultPos(relList, colList []int32) []colexec.ResultPos {
 // convert process.Limitation to pipeline.ProcessLimitation
 func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimitation {
 	return &pipeline.ProcessLimitation{
-		Size:          lim.Size,
-		BatchRows:     lim.BatchRows,
-		BatchSize:     lim.BatchSize,
-		PartitionRows: lim.PartitionRows,
-		ReaderSize:    lim.ReaderSize,
+		Size:              lim.Size,
+		BatchRows:         lim.BatchRows,
+		BatchSize:         lim.BatchSize,
+		PartitionRows:     lim.PartitionRows,
+		ReaderSize:        lim.ReaderSize,
+		QueryMemory:       lim.QueryMemory,
+		ExecutionDeadline: lim.ExecutionDeadline,
+		QueryMemoryHeld:   lim.QueryMemoryHeld,
 	}
 }
 
 // convert pipeline.ProcessLimitation to process.Limitation
 func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitation {
 	return process.Limitation{
-		Size:          lim.Size,
-		BatchRows:     lim.BatchRows,
-		BatchSize:     lim.BatchSize,
-		PartitionRows: lim.PartitionRows,
-		ReaderSize:    lim.ReaderSize,
+		Size:              lim.Size,
+		BatchRows:         lim.BatchRows,
+		BatchSize:         lim.BatchSize,
+		PartitionRows:     lim.PartitionRows,
+		ReaderSize:        lim.ReaderSize,
+		QueryMemory:       lim.QueryMemory,
+		ExecutionDeadline: lim.ExecutionDeadline,
+		QueryMemoryHeld:   lim.QueryMemoryHeld,
 	}
 }
 
@@ -98,6 +98,9 @@ type messageSenderOnClient struct {
 	streamSender morpc.Stream
 	receiveCh    chan morpc.Message
 
+	// queryMemory is the bytes the remote pipeline reported to hold last.
+	queryMemory int64
+
 	c *Compile
 }
 
@@ -195,6 +198,9 @@ func (sender *messageSenderOnClient) receiveBatch() (bat *batch.Batch, over bool
 		if info, get := m.TryToGetMoErr(); get {
 			return nil, false, info
 		}
+		if err = sender.reportQueryMemory(int64(m.QueryMemory)); err != nil {
+			return nil, false, err
+		}
 		if m.IsEndMessage() {
 			anaData := m.GetAnalyse()
 			if len(anaData) > 0 {
@@ -254,10 +260,23 @@ func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
 	}
 }
 
+// reportQueryMemory counts the bytes the remote pipeline holds against
+// the quota of the query, which fails the query if it holds more than the
+// quota in all.
+func (sender *messageSenderOnClient) reportQueryMemory(nb int64) error {
+	delta := nb - sender.queryMemory
+	if delta == 0 {
+		return nil
+	}
+	sender.queryMemory = nb
+	return sender.c.proc.Mp().AddRemoteQueryMemory(delta)
+}
+
 func (sender *messageSenderOnClient) close() {
 	if sender.ctxCancel != nil {
 		sender.ctxCancel()
 	}
+	_ = sender.reportQueryMemory(0)
 	// XXX not a good way to deal it if close failed.
 	_ = sender.streamSender.Close(true)
 }
@@ -282,6 +301,10 @@ type messageReceiverOnServer struct {
 	// XXX what's that. So confused.
 	sequence uint64
 
+	// mp is the pool of the pipeline, whose bytes in use are reported to
+	// the client with the batches.
+	mp *mpool.MPool
+
 	// result.
 	finalAnalysisInfo []*process.AnalyzeInfo
 }
@@ -378,6 +401,10 @@ func (receiver *messageReceiverOnServer) newCompile() *Compile {
 	proc.UnixTime = pHelper.unixTime
 	proc.Id = pHelper.id
 	proc.Lim = pHelper.lim
+	// The bytes the query holds elsewhere count against the quota too.
+	mp.SetQueryQuota(proc.Lim.QueryMemory)
+	_ = mp.AddRemoteQueryMemory(proc.Lim.QueryMemoryHeld)
+	receiver.mp = mp
 	proc.SessionInfo = pHelper.sessionInfo
 	proc.SessionInfo.StorageEngine = cnInfo.storeEngine
 	proc.AnalInfos = make([]*process.AnalyzeInfo, len(pHelper.analysisNodeList))
@@ -441,6 +468,7 @@ func (receiver *messageReceiverOnServer) sendBatch(
 		}
 		m.SetMessageType(pipeline.Method_BatchMessage)
 		m.SetData(data)
+		m.SetQueryMemory(receiver.queryMemory())
 		// XXX too bad.
 		m.SetCheckSum(checksum)
 		m.SetSequence(receiver.sequence)
@@ -464,6 +492,7 @@ func (receiver *messageReceiverOnServer) sendBatch(
 		}
 		m.SetMessageType(pipeline.Method_BatchMessage)
 		m.SetData(data[start:end])
+		m.SetQueryMemory(receiver.queryMemory())
 		m.SetSequence(receiver.sequence)
 		receiver.sequence++
 
@@ -474,6 +503,20 @@ func (receiver *messageReceiverOnServer) sendBatch(
 	return nil
 }
 
+// queryMemory returns the bytes the pipeline holds, not counting the bytes
+// the query held elsewhere when the pipeline was sent.
+func (receiver *messageReceiverOnServer) queryMemory() uint64 {
+	if receiver.mp == nil {
+		return 0
+	}
+	nb := receiver.mp.CurrNB() + receiver.mp.RemoteQueryMemory() -
+		receiver.procBuildHelper.lim.QueryMemoryHeld
+	if nb < 0 {
+		return 0
+	}
+	return uint64(nb)
+}
+
 func (receiver *messageReceiverOnServer) sendEndMessage() error {
 	message, err := receiver.acquireMessage()
 	if err != nil {
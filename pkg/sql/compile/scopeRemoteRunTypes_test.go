@@ -19,6 +19,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 
 	"github.com/golang/mock/gomock"
@@ -58,6 +60,29 @@ func Test_MessageSenderOnClient(t *testing.T) {
 	_ = sender.send(make([]byte, maxMessageSizeToMoRpc+1), nil, 0)
 }
 
+func Test_MessageSenderOnClientQueryMemory(t *testing.T) {
+	mp := mpool.MustNewZero()
+	mp.SetQueryQuota(1 << 20)
+	defer mp.SetQueryQuota(0)
+	c := reuse.Alloc[Compile](nil)
+	c.proc = process.New(context.TODO(), mp, nil, nil, nil, nil, nil, nil, nil, nil)
+	sender := &messageSenderOnClient{c: c}
+
+	require.NoError(t, sender.reportQueryMemory(512<<10))
+	require.Equal(t, int64(512<<10), mp.RemoteQueryMemory())
+	require.NoError(t, sender.reportQueryMemory(256<<10))
+	require.Equal(t, int64(256<<10), mp.RemoteQueryMemory())
+
+	// the remote pipeline and the local one share the quota.
+	_, err := mp.Alloc(896 << 10)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryExceeded))
+	err = sender.reportQueryMemory(2 << 20)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryExceeded))
+
+	require.NoError(t, sender.reportQueryMemory(0))
+	require.Zero(t, mp.RemoteQueryMemory())
+}
+
 func Test_MessageReceiverOnServer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ctx := context.Background()
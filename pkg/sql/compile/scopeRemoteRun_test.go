@@ -638,6 +638,11 @@ func Test_convertToProcessLimitation(t *testing.T) {
 	}
 	limitation := convertToProcessLimitation(lim)
 	require.Equal(t, limitation.Size, int64(100))
+
+	lim.QueryMemory = 1 << 20
+	lim.ExecutionDeadline = time.Now().UnixNano()
+	lim.QueryMemoryHeld = 1 << 10
+	require.Equal(t, lim, convertToPipelineLimitation(convertToProcessLimitation(lim)))
 }
 
 func Test_convertToProcessSessionInfo(t *testing.T) {
@@ -215,6 +215,13 @@ func (c *compilerContext) Resolve(dbName string, tableName string) (*plan.Object
 	if err != nil {
 		return nil, nil
 	}
+	if table, err := c.getTempRelation(dbName, tableName); err == nil {
+		obj, tableDef := c.getTableDef(table, dbName, tableName)
+		if tableDef != nil {
+			tableDef.IsTemporary = true
+		}
+		return obj, tableDef
+	}
 	table, err := c.getRelation(dbName, tableName)
 	if err != nil {
 		return nil, nil
@@ -258,6 +265,10 @@ func (c *compilerContext) getRelation(
 		return nil, err
 	}
 
+	if table, err := c.getTempRelation(dbName, tableName); err == nil {
+		return table, nil
+	}
+
 	db, err := c.engine.Database(c.ctx, dbName, c.proc.TxnOperator)
 	if err != nil {
 		return nil, err
@@ -270,6 +281,18 @@ func (c *compilerContext) getRelation(
 	return table, nil
 }
 
+// getTempRelation returns the session temporary table, which is only visible
+// when the sql runs on the engine of a session, see Compile.runSqlOnSession.
+func (c *compilerContext) getTempRelation(
+	dbName string,
+	tableName string) (engine.Relation, error) {
+	if e, ok := c.engine.(*engine.EntireEngine); !ok || e.TempEngine == nil {
+		return nil, moerr.NewNoSuchTable(c.ctx, dbName, tableName)
+	}
+	_, table, err := getTempRelation(c.ctx, c.engine, c.proc.TxnOperator, nil, dbName, tableName)
+	return table, err
+}
+
 func (c *compilerContext) getTableDef(
 	table engine.Relation,
 	dbName, tableName string) (*plan.ObjectRef, *plan.TableDef) {
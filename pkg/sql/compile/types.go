@@ -76,10 +76,13 @@ type Source struct {
 	R                      engine.Reader
 	Bat                    *batch.Batch
 	FilterExpr             *plan.Expr // todo: change this to []*plan.Expr
-	node                   *plan.Node
-	TableDef               *plan.TableDef
-	Timestamp              timestamp.Timestamp
-	AccountId              *plan.PubInfo
+	// LateMaterialize makes the reader apply FilterExpr before reading the
+	// columns it does not use.
+	LateMaterialize bool
+	node            *plan.Node
+	TableDef        *plan.TableDef
+	Timestamp       timestamp.Timestamp
+	AccountId       *plan.PubInfo
 
 	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 	OrderBy            []*plan.OrderBySpec // for ordered scan
@@ -24,7 +24,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -85,6 +87,28 @@ var (
 	//deleteMoTablePartitionsWithTableIdAndIndexNameFormat = `delete from mo_catalog.mo_table_partitions where table_id = %v and name = '%s';`
 )
 
+// getTempRelation opens the session temporary table dbName.tblName. A temporary
+// table shadows the permanent table with the same name, so the callers holding
+// a temporary table def look here before the permanent database.
+func getTempRelation(
+	ctx context.Context,
+	eg engine.Engine,
+	txnOp client.TxnOperator,
+	proc *process.Process,
+	dbName string,
+	tblName string,
+) (engine.Database, engine.Relation, error) {
+	db, err := eg.Database(ctx, defines.TEMPORARY_DBNAME, txnOp)
+	if err != nil {
+		return nil, nil, err
+	}
+	rel, err := db.Relation(ctx, engine.GetTempTableName(dbName, tblName), proc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, rel, nil
+}
+
 // genCreateIndexTableSql: Generate ddl statements for creating index table
 func genCreateIndexTableSql(indexTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) string {
 	var sql string
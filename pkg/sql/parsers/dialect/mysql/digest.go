@@ -0,0 +1,75 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// NormalizeSQL returns the text of sql as the statements differing only in
+// literals, comments, optimizer hints, whitespace and letter case share it:
+// the tokens lower-cased and separated by a space, each literal replaced by
+// "?".
+func NormalizeSQL(sql string) (string, error) {
+	scanner := NewScanner(dialect.MYSQL, sql)
+	defer PutScanner(scanner)
+
+	var buf strings.Builder
+	for {
+		typ, str := scanner.Scan()
+		switch typ {
+		case 0, eofChar:
+			return buf.String(), nil
+		case LEX_ERROR:
+			return "", moerr.NewParseErrorNoCtx("invalid token near '%s'", str)
+		case ';':
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(' ')
+		}
+		switch typ {
+		case STRING, INTEGRAL, FLOAT, HEXNUM, BIT_LITERAL, VALUE_ARG:
+			buf.WriteByte('?')
+		case QUOTE_ID:
+			buf.WriteString(strings.ToLower(str))
+		default:
+			buf.WriteString(strings.ToLower(strings.TrimSpace(sql[scanner.PrePos:scanner.Pos])))
+		}
+	}
+}
+
+// Digest returns the SHA-256 in hex of the normalized text of sql, which
+// identifies the statements NormalizeSQL does not tell apart.
+func Digest(sql string) (string, error) {
+	text, err := NormalizeSQL(sql)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ParseOptimizerHints parses the text of a /*+ ... */ comment, without the
+// comment markers.
+func ParseOptimizerHints(text string) tree.OptimizerHints {
+	return parseOptimizerHints(text)
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{"SELECT a, `B` FROM t1 WHERE a = 1 AND b <> 'x';", "select a , b from t1 where a = ? and b <> ?"},
+		{"select /*+ DOP(4) */ a from t1 -- comment\n where a >= 1.5", "select a from t1 where a >= ?"},
+		{"select a from t1 where a in (0x1f, b'01', ?)", "select a from t1 where a in ( ? , ? , ? )"},
+	}
+	for _, tcase := range cases {
+		got, err := NormalizeSQL(tcase.input)
+		if err != nil {
+			t.Errorf("NormalizeSQL(%q) err: %v", tcase.input, err)
+			continue
+		}
+		if got != tcase.output {
+			t.Errorf("NormalizeSQL(%q): expected %q, got %q", tcase.input, tcase.output, got)
+		}
+	}
+}
+
+func TestDigest(t *testing.T) {
+	d1, err := Digest("select a from t1 where a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d2, err := Digest("SELECT /*+ HASH_JOIN(t1) */ a\nFROM t1 WHERE a = 42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d3, err := Digest("select a from t1 where b = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d1 != d2 {
+		t.Errorf("expected the same digest, got %s and %s", d1, d2)
+	}
+	if d1 == d3 {
+		t.Errorf("expected different digests, got %s", d1)
+	}
+	if len(d1) != 64 {
+		t.Errorf("expected a sha256 digest, got %s", d1)
+	}
+}
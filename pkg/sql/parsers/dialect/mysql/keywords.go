@@ -41,6 +41,7 @@ func init() {
 		"avg_row_length":             AVG_ROW_LENGTH,
 		"avg":                        AVG,
 		"bsi":                        BSI,
+		"bloom":                      BLOOM,
 		"before":                     UNUSED,
 		"begin":                      BEGIN,
 		"between":                    BETWEEN,
@@ -464,6 +465,7 @@ func init() {
 		"triggers":                   TRIGGERS,
 		"true":                       TRUE,
 		"truncate":                   TRUNCATE,
+		"ttl":                        TTL,
 		"uncommitted":                UNCOMMITTED,
 		"undo":                       UNUSED,
 		"unknown":                    UNKNOWN,
@@ -578,6 +580,9 @@ func init() {
 		"reference":                  REFERENCE,
 		"modump":                     MODUMP,
 		"low_cardinality":            LOW_CARDINALITY,
+		"merge_policy":               MERGE_POLICY,
+		"plan":                       PLAN,
+		"baseline":                   BASELINE,
 		"preceding":                  PRECEDING,
 		"following":                  FOLLOWING,
 		"fill":                       FILL,
@@ -619,6 +624,7 @@ func init() {
 		"sample":                     SAMPLE,
 		"percent":                    PERCENT,
 		"master":                     MASTER,
+		"logs":                       LOGS,
 		"parallelism":                PARALLELISM,
 		"bitmap_bit_position":        BITMAP_BIT_POSITION,
 		"bitmap_bucket_number":       BITMAP_BUCKET_NUMBER,
@@ -89,6 +89,7 @@ func (l *Lexer) GetParamIndex() int {
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	l.scanner.HintAllowed = typ == SELECT
 
 	switch typ {
 	case INTEGRAL:
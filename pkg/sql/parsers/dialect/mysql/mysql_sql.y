@@ -192,6 +192,8 @@ import (
     assignment *tree.Assignment
     properties []tree.Property
     property tree.Property
+    mergePolicyOptions []tree.MergePolicyOption
+    mergePolicyOption tree.MergePolicyOption
     exportParm *tree.ExportParam
 
     epxlainOptions []tree.OptionElem
@@ -222,6 +224,7 @@ import (
     indexHint *tree.IndexHint
     indexHintList []*tree.IndexHint
     indexVisibility tree.VisibleType
+    optimizerHints tree.OptimizerHints
 
     killOption tree.KillOption
     statementOption tree.StatementOption
@@ -285,6 +288,7 @@ import (
 %token <item> INTEGRAL HEX FLOAT
 %token <str>  HEXNUM BIT_LITERAL
 %token <str> NULL TRUE FALSE
+%token <str> OPTIMIZER_HINTS
 %nonassoc LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
 %right <str> UNIQUE KEY
@@ -334,7 +338,7 @@ import (
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
-%token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
+%token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES TTL
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM ENGINE_ATTRIBUTE SECONDARY_ENGINE_ATTRIBUTE INSERT_METHOD
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS CLUSTER
@@ -352,7 +356,7 @@ import (
 %token <str> PROPERTIES
 
 // Secondary Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER BLOOM
 %token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN LISTS OP_TYPE REINDEX
 
 
@@ -366,10 +370,13 @@ import (
 
 // Revoke
 %token <str> REVOKE FUNCTION PRIVILEGES TABLESPACE EXECUTE SUPER GRANT OPTION REFERENCES REPLICATION
-%token <str> SLAVE CLIENT USAGE RELOAD FILE TEMPORARY ROUTINE EVENT SHUTDOWN
+%token <str> SLAVE CLIENT USAGE RELOAD FILE TEMPORARY ROUTINE EVENT SHUTDOWN LOGS
 
 // Type Modifiers
-%token <str> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL ENGINES LOW_CARDINALITY AUTOEXTEND_SIZE
+%token <str> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL ENGINES LOW_CARDINALITY AUTOEXTEND_SIZE MERGE_POLICY
+
+// Plan baseline
+%token <str> PLAN BASELINE
 
 // Account
 %token <str> ADMIN_NAME RANDOM SUSPEND ATTRIBUTE HISTORY REUSE CURRENT OPTIONAL FAILED_LOGIN_ATTEMPTS PASSWORD_LOCK_TIME UNBOUNDED SECONDARY RESTRICTED
@@ -487,7 +494,7 @@ import (
 %type <statement> show_tables_stmt show_sequences_stmt show_process_stmt show_errors_stmt show_warnings_stmt show_target
 %type <statement> show_procedure_status_stmt show_function_status_stmt show_node_list_stmt show_locks_stmt
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt show_table_size_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_master_status_stmt show_binary_logs_stmt
 %type <statement> show_servers_stmt show_connectors_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
@@ -530,6 +537,7 @@ import (
 %type <statement> create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
 %type <statement> create_stage_stmt drop_stage_stmt alter_stage_stmt
 %type <statement> create_snapshot_stmt drop_snapshot_stmt
+%type <statement> create_plan_baseline_stmt drop_plan_baseline_stmt
 %type <str> urlparams
 %type <str> comment_opt view_list_opt view_opt security_opt view_tail check_type
 %type <subscriptionOption> subcription_opt
@@ -739,6 +747,9 @@ import (
 // type <str> mo_keywords
 %type <properties> properties_list
 %type <property> property_elem
+%type <mergePolicyOptions> merge_policy_option_list
+%type <mergePolicyOption> merge_policy_option
+%type <str> merge_policy_value
 %type <assignments> set_value_list
 %type <assignment> set_value
 %type <str> row_opt substr_option
@@ -776,6 +787,7 @@ import (
 %type <indexHintScope> index_hint_scope
 %type <indexHint> index_hint
 %type <indexHintList> index_hint_list index_hint_list_opt
+%type <optimizerHints> optimizer_hints_opt
 
 %token <str> KILL
 %type <killOption> kill_opt
@@ -944,6 +956,12 @@ create_snapshot_stmt:
         }
     }
 
+create_plan_baseline_stmt:
+    CREATE PLAN BASELINE FOR select_stmt
+    {
+        $$ = &tree.CreatePlanBaseline{Select: $5}
+    }
+
 snapshot_object_opt:
     CLUSTER
     {
@@ -2661,6 +2679,14 @@ explain_stmt:
     {
         $$ = tree.NewExplainStmt($2, "text")
     }
+|   explain_sym FORMAT '=' STRING explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", strings.ToLower($4))
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainStmt($3, "text")
@@ -2677,6 +2703,16 @@ explain_stmt:
         explainStmt.Options = options
         $$ = explainStmt
     }
+|   explain_sym ANALYZE FORMAT '=' STRING explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", strings.ToLower($5))
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym ANALYZE VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainAnalyze($4, "text")
@@ -2738,6 +2774,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   ID
 
 
 prepare_sym:
@@ -3124,6 +3161,48 @@ alter_option:
     {
         $$ = tree.NewTableOptionCharset($1)
     }
+|   SET MERGE_POLICY '(' merge_policy_option_list ')'
+    {
+        $$ = tree.NewAlterOptionMergePolicy($4)
+    }
+
+merge_policy_option_list:
+    merge_policy_option
+    {
+        $$ = []tree.MergePolicyOption{$1}
+    }
+|   merge_policy_option_list ',' merge_policy_option
+    {
+        $$ = append($1, $3)
+    }
+
+merge_policy_option:
+    ident equal_opt merge_policy_value
+    {
+        $$ = tree.MergePolicyOption{
+            Key: $1.Compare(),
+            Val: $3,
+        }
+    }
+
+merge_policy_value:
+    STRING
+|   INTEGRAL
+    {
+        $$ = fmt.Sprintf("%v", $1)
+    }
+|   ident
+    {
+        $$ = $1.Compare()
+    }
+|   TRUE
+    {
+        $$ = "true"
+    }
+|   FALSE
+    {
+        $$ = "false"
+    }
 
 rename_type:
     {
@@ -3556,6 +3635,8 @@ show_stmt:
 |   show_stages_stmt
 |   show_connectors_stmt
 |   show_snapshots_stmt
+|   show_master_status_stmt
+|   show_binary_logs_stmt
 
 show_collation_stmt:
     SHOW COLLATION like_opt where_expression_opt
@@ -3969,6 +4050,22 @@ show_servers_stmt:
         $$ = &tree.ShowBackendServers{}
     }
 
+show_master_status_stmt:
+    SHOW MASTER STATUS
+    {
+        $$ = &tree.ShowMasterStatus{}
+    }
+
+show_binary_logs_stmt:
+    SHOW BINARY LOGS
+    {
+        $$ = &tree.ShowBinaryLogs{}
+    }
+|   SHOW MASTER LOGS
+    {
+        $$ = &tree.ShowBinaryLogs{}
+    }
+
 table_name_unresolved:
     ident
     {
@@ -4028,6 +4125,7 @@ drop_ddl_stmt:
 |   drop_stage_stmt
 |   drop_connector_stmt
 |   drop_snapshot_stmt
+|   drop_plan_baseline_stmt
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -5115,30 +5213,41 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT optimizer_hints_opt distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Distinct: $3,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Hints: $2,
         }
     }
-|    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|    SELECT optimizer_hints_opt select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Option: $3,
+            Hints: $2,
         }
     }
 
+optimizer_hints_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINTS
+    {
+        $$ = parseOptimizerHints($1)
+    }
+
 select_option_opt:
     SQL_SMALL_RESULT
     {
@@ -5152,6 +5261,14 @@ select_option_opt:
 	{
     	$$ = strings.ToLower($1)
     }
+|   SQL_CACHE
+    {
+        $$ = strings.ToLower($1)
+    }
+|   SQL_NO_CACHE
+    {
+        $$ = strings.ToLower($1)
+    }
 
 distinct_opt:
     {
@@ -5609,6 +5726,15 @@ as_name_opt:
     {
         $$ = tree.NewCStr($2, yylex.(*Lexer).lower)
     }
+// the select options are reserved in the select list, but still names
+|   AS SQL_CACHE
+    {
+        $$ = tree.NewCStr($2, yylex.(*Lexer).lower)
+    }
+|   AS SQL_NO_CACHE
+    {
+        $$ = tree.NewCStr($2, yylex.(*Lexer).lower)
+    }
 
 stmt_name:
     ident
@@ -5634,6 +5760,7 @@ create_stmt:
 |   create_publication_stmt
 |   create_stage_stmt
 |   create_snapshot_stmt
+|   create_plan_baseline_stmt
 
 create_ddl_stmt:
     create_table_stmt
@@ -6322,6 +6449,16 @@ drop_snapshot_stmt:
         $$ = tree.NewDropSnapShot(ifExists, name)
     }
 
+drop_plan_baseline_stmt:
+    DROP PLAN BASELINE exists_opt FOR select_stmt
+    {
+        $$ = &tree.DropPlanBaseline{IfExists: $4, Select: $6}
+    }
+|   DROP PLAN BASELINE exists_opt STRING
+    {
+        $$ = &tree.DropPlanBaseline{IfExists: $4, Digest: $5}
+    }
+
 account_role_name:
     ident
     {
@@ -6784,6 +6921,10 @@ using_opt:
     {
 	$$ = tree.INDEX_TYPE_MASTER
     }
+|   USING BLOOM
+    {
+        $$ = tree.INDEX_TYPE_BLOOM
+    }
 |   USING HASH
     {
         $$ = tree.INDEX_TYPE_HASH
@@ -7922,6 +8063,10 @@ table_option:
     {
         $$ = tree.NewTableOptionUnion($4)
     }
+|   TTL equal_opt ident '+' INTERVAL INTEGRAL time_unit
+    {
+        $$ = tree.NewTableOptionTTL(tree.Identifier($3.Compare()), $6.(int64), $7)
+    }
 |   PROPERTIES '(' properties_list ')'
     {
         var Preperties = $3
@@ -8151,6 +8296,8 @@ index_def:
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
 	    case "master":
 	    	keyTyp = tree.INDEX_TYPE_MASTER
+            case "bloom":
+                keyTyp = tree.INDEX_TYPE_BLOOM
             case "hash":
             	keyTyp = tree.INDEX_TYPE_HASH
 	    case "rtree":
@@ -8190,6 +8337,8 @@ index_def:
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
 	     case "master":
         	keyTyp = tree.INDEX_TYPE_MASTER
+	     case "bloom":
+		keyTyp = tree.INDEX_TYPE_BLOOM
 	     case "hash":
 		keyTyp = tree.INDEX_TYPE_HASH
 	     case "rtree":
@@ -8362,6 +8511,7 @@ index_type:
 |   IVFFLAT
 |   MASTER
 |   BSI
+|   BLOOM
 
 insert_method_options:
     NO
@@ -11742,6 +11892,9 @@ non_reserved_keyword:
 |   TRIGGERS
 |   HISTORY
 |   LOW_CARDINALITY
+|   MERGE_POLICY
+|   PLAN
+|   BASELINE
 |   S3OPTION
 |   STAGEOPTION
 |   EXTENSION
@@ -11763,6 +11916,7 @@ non_reserved_keyword:
 |   STAGE
 |   SNAPSHOTS
 |   STAGES
+|   LOGS
 |   BACKUP
 |   FILESYSTEM
 |   PARALLELISM
@@ -11778,6 +11932,8 @@ non_reserved_keyword:
 |   BTREE
 |	IVFFLAT
 |	MASTER
+|	BLOOM
+|	TTL
 |	COALESCE
 |	CONNECT
 |	CIPHER
@@ -11832,8 +11988,6 @@ non_reserved_keyword:
 |	RTREE
 |	SECOND
 |	SHUTDOWN
-|	SQL_CACHE
-|	SQL_NO_CACHE
 |	SLAVE
 |	SLIDING
 |	SUPER
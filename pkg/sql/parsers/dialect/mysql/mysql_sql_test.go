@@ -16,6 +16,7 @@ package mysql
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -223,6 +224,25 @@ var (
 		output: "select * from kv where k = 1 for update",
 	}, {
 		input: "select a from t1 use index(b)",
+	}, {
+		input:  "select /*+ JOIN_ORDER(t2, t1) DOP(4) */ distinct a from t1, t2 where t1.a = t2.b",
+		output: "select distinct a from t1 cross join t2 where t1.a = t2.b",
+	}, {
+		input:  "create plan baseline for select /*+ JOIN_ORDER(t2, t1) */ a from t1, t2 where t1.a = t2.b",
+		output: "create plan baseline for select a from t1 cross join t2 where t1.a = t2.b",
+	}, {
+		input: "drop plan baseline if exists for select a from t1 where a = 1",
+	}, {
+		input: "drop plan baseline '0b5c'",
+	}, {
+		input: "select plan, baseline from t1",
+	}, {
+		input:  "SELECT SQL_NO_CACHE a FROM t1",
+		output: "select sql_no_cache a from t1",
+	}, {
+		input: "select sql_cache a from t1 where a = 1",
+	}, {
+		input: "select 1 as sql_cache, 1 as sql_no_cache",
 	}, {
 		input:  "SELECT   id,cid,status,ip,streams   FROM camera     WHERE (cid_type = ?)",
 		output: "select id, cid, status, ip, streams from camera where (cid_type = ?)",
@@ -330,6 +350,15 @@ var (
 		}, {
 			input:  "explain (analyze true,verbose false,format json) select * from emp",
 			output: "explain (analyze true,verbose false,format json) select * from emp",
+		}, {
+			input:  "explain (format dot) select * from emp",
+			output: "explain (format dot) select * from emp",
+		}, {
+			input:  "explain format = 'JSON' select * from emp",
+			output: "explain (format json) select * from emp",
+		}, {
+			input:  "explain analyze format = 'dot' select * from emp",
+			output: "explain (analyze,format dot) select * from emp",
 		}, {
 			input:  "with t11 as (select * from t1) update t11 join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
 			output: "with t11 as (select * from t1) update t11 inner join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
@@ -1383,6 +1412,13 @@ var (
 			input: "show warnings",
 		}, {
 			input: "show errors",
+		}, {
+			input: "show master status",
+		}, {
+			input: "show binary logs",
+		}, {
+			input:  "show master logs",
+			output: "show binary logs",
 		}, {
 			input: "show full processlist",
 		}, {
@@ -1466,6 +1502,24 @@ var (
 		}, {
 			input:  "create index idx using master on A (a,b,c)",
 			output: "create index idx using master on a (a, b, c)",
+		}, {
+			input:  "create index idx using bloom on A (a)",
+			output: "create index idx using bloom on a (a)",
+		}, {
+			input:  "create table t (a int, b varchar(64), key idx_b using bloom (b))",
+			output: "create table t (a int, b varchar(64), index idx_b using bloom (b))",
+		}, {
+			input:  "create table t (a int, ts datetime) TTL = ts + INTERVAL 30 DAY",
+			output: "create table t (a int, ts datetime) ttl = ts + interval 30 day",
+		}, {
+			input:  "alter table t ttl ts + interval 12 hour",
+			output: "alter table t ttl = ts + interval 12 hour",
+		}, {
+			input:  "alter table t set merge_policy (target_object_size = '128M', max_level 3)",
+			output: "alter table t set merge_policy (target_object_size = '128M', max_level = '3')",
+		}, {
+			input:  "alter table t SET MERGE_POLICY (time_column = ts, time_window = '1 day', seal_after = '7 days', reorganize = true)",
+			output: "alter table t set merge_policy (time_column = 'ts', time_window = '1 day', seal_after = '7 days', reorganize = 'true')",
 		}, {
 			input: "create unique index idx1 using btree on a (a, b(10), (a + b), (a - b)) visible",
 		}, {
@@ -2858,6 +2912,34 @@ var (
 	}
 )
 
+func TestOptimizerHints(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		input string
+		hints string
+	}{
+		{"select /*+ join_order(t2, `t1`) HASH_JOIN(t1) no_merge() dop(4) set_var(sql_mode = '') */ a from t1, t2",
+			"JOIN_ORDER(t2,t1) HASH_JOIN(t1) NO_MERGE() DOP(4) SET_VAR(sql_mode='')"},
+		{"select /*+ BROADCAST(t1) SHUFFLE(t2 */ a from t1 /*+ DOP(4) */", "BROADCAST(t1)"},
+		{"select a /*+ DOP(4) */ from t1", ""},
+		{"select /* DOP(4) */ a from t1", ""},
+	}
+	for _, tcase := range cases {
+		ast, err := ParseOne(ctx, tcase.input, 1, 0)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		var hints []string
+		for _, hint := range ast.(*tree.Select).Select.(*tree.SelectClause).Hints {
+			hints = append(hints, hint.Name+"("+strings.Join(hint.Args, ",")+")")
+		}
+		if got := strings.Join(hints, " "); got != tcase.hints {
+			t.Errorf("hints of %q: expected %q, got %q", tcase.input, tcase.hints, got)
+		}
+	}
+}
+
 // Test whether strings in SQL can be restored in string format
 func TestSQLStringFmt(t *testing.T) {
 	ctx := context.TODO()
@@ -0,0 +1,75 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// parseOptimizerHints parses the text of a /*+ ... */ comment, a list of
+// hints each written NAME or NAME(arg, ...). As in MySQL, a malformed hint
+// does not fail the statement: the hints from there on are ignored.
+func parseOptimizerHints(text string) tree.OptimizerHints {
+	var hints tree.OptimizerHints
+	for {
+		text = strings.TrimLeft(text, " \t\r\n")
+		if text == "" {
+			return hints
+		}
+
+		i := 0
+		for i < len(text) && isHintNameChar(text[i]) {
+			i++
+		}
+		if i == 0 {
+			return hints
+		}
+		hint := &tree.OptimizerHint{Name: strings.ToUpper(text[:i])}
+		text = strings.TrimLeft(text[i:], " \t\r\n")
+
+		if strings.HasPrefix(text, "(") {
+			end := strings.IndexByte(text, ')')
+			if end < 0 {
+				return hints
+			}
+			for _, arg := range strings.Split(text[1:end], ",") {
+				if arg = normalizeHintArg(arg); arg != "" {
+					hint.Args = append(hint.Args, arg)
+				}
+			}
+			text = text[end+1:]
+		}
+		hints = append(hints, hint)
+	}
+}
+
+func isHintNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// normalizeHintArg trims an argument and drops the quotes of the identifiers,
+// so that `t1` and t1 name the same table and SET_VAR(a = 1) reads a=1.
+func normalizeHintArg(arg string) string {
+	if name, value, ok := strings.Cut(arg, "="); ok {
+		return normalizeHintArg(name) + "=" + strings.TrimSpace(value)
+	}
+	arg = strings.TrimSpace(arg)
+	if len(arg) >= 2 && arg[0] == '`' && arg[len(arg)-1] == '`' {
+		arg = arg[1 : len(arg)-1]
+	}
+	return arg
+}
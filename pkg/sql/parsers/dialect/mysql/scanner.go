@@ -43,6 +43,9 @@ type Scanner struct {
 	MysqlSpecialComment *Scanner
 
 	CommentFlag bool
+	// HintAllowed is set when the last token was SELECT, which is where a
+	// /*+ ... */ comment holds optimizer hints instead of being skipped.
+	HintAllowed bool
 	Pos         int
 	Line        int
 	Col         int
@@ -59,6 +62,7 @@ func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
 	scanner.LastError = nil
 	scanner.posVarIndex = 0
 	scanner.MysqlSpecialComment = nil
+	scanner.HintAllowed = false
 	scanner.Pos = 0
 	scanner.Line = 0
 	scanner.Col = 0
@@ -179,6 +183,10 @@ func (s *Scanner) Scan() (int, string) {
 			return s.Scan()
 		case '*':
 			s.inc()
+			if s.cur() == '+' && s.HintAllowed {
+				s.inc()
+				return s.scanOptimizerHints()
+			}
 			switch s.cur() {
 			case '!':
 				s.CommentFlag = true
@@ -562,6 +570,22 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 	return COMMENT, s.buf[start:s.Pos]
 }
 
+// scanOptimizerHints scans the text of a '/*+' delimited comment;
+// assumes the opening prefix has already been scanned
+func (s *Scanner) scanOptimizerHints() (int, string) {
+	start := s.Pos
+	for {
+		if s.cur() == '*' && s.peek(1) == '/' {
+			s.incN(2)
+			return OPTIMIZER_HINTS, s.buf[start : s.Pos-2]
+		}
+		if s.cur() == eofChar {
+			return LEX_ERROR, s.buf[start-3 : s.Pos]
+		}
+		s.inc()
+	}
+}
+
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
 /*func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	start := s.Pos - 3
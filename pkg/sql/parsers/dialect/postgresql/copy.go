@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// copyStdinFile is the file name of the data of COPY FROM STDIN, which the
+// client sends in the CopyData messages.
+const copyStdinFile = "stdin"
+
+// copyOption is an option of COPY. The options in the legacy form of
+// [BINARY] [DELIMITER 'c'] [NULL 's'] [CSV [HEADER]] are parsed into the
+// same options as the ones in the form of WITH (name value, ...).
+type copyOption struct {
+	name  string
+	value string
+}
+
+// newCopyFromStdin makes the statement of COPY FROM STDIN, which is a local
+// load reading the data from the client. The text format is loaded as the
+// fields terminated by the delimiter, and the csv format is loaded as the
+// fields enclosed by the quote.
+func newCopyFromStdin(table *tree.TableName, columns []tree.LoadColumn, options []*copyOption) (*tree.Load, error) {
+	csv := false
+	header := false
+	delimiter := ""
+	quote := byte('"')
+	var escape *tree.EscapedBy
+	singleByte := func(opt *copyOption) (byte, error) {
+		if len(opt.value) != 1 {
+			return 0, moerr.NewInvalidInputNoCtx("COPY %s must be a single one-byte character", opt.name)
+		}
+		return opt.value[0], nil
+	}
+	for _, opt := range options {
+		switch strings.ToLower(opt.name) {
+		case "format":
+			switch strings.ToLower(opt.value) {
+			case "csv":
+				csv = true
+			case "text":
+				csv = false
+			case "binary":
+				return nil, moerr.NewNotSupportedNoCtx("COPY with the format binary")
+			default:
+				return nil, moerr.NewInvalidInputNoCtx("COPY format \"%s\" not recognized", opt.value)
+			}
+		case "delimiter":
+			if _, err := singleByte(opt); err != nil {
+				return nil, err
+			}
+			delimiter = opt.value
+		case "header":
+			switch strings.ToLower(opt.value) {
+			case "", "true", "on", "1":
+				header = true
+			case "false", "off", "0":
+				header = false
+			default:
+				return nil, moerr.NewNotSupportedNoCtx("COPY with the header %s", opt.value)
+			}
+		case "quote":
+			b, err := singleByte(opt)
+			if err != nil {
+				return nil, err
+			}
+			quote = b
+		case "escape":
+			b, err := singleByte(opt)
+			if err != nil {
+				return nil, err
+			}
+			escape = &tree.EscapedBy{Value: b}
+		case "null", "encoding":
+			// the null string and the encoding of the data are the default
+			// ones of the load.
+		default:
+			return nil, moerr.NewInvalidInputNoCtx("COPY option \"%s\" not recognized", opt.name)
+		}
+	}
+	if delimiter == "" {
+		delimiter = "\t"
+		if csv {
+			delimiter = ","
+		}
+	}
+
+	fields := &tree.Fields{
+		Terminated: &tree.Terminated{Value: delimiter},
+		EnclosedBy: &tree.EnclosedBy{Value: 0},
+		EscapedBy:  escape,
+	}
+	if csv {
+		fields.EnclosedBy.Value = quote
+	}
+	tail := &tree.TailParameter{
+		Fields: fields,
+		Lines: &tree.Lines{
+			TerminatedBy: &tree.Terminated{Value: "\n"},
+		},
+		ColumnList: columns,
+	}
+	if header {
+		tail.IgnoredLines = 1
+	}
+	return &tree.Load{
+		Local:             true,
+		DuplicateHandling: &tree.DuplicateKeyError{},
+		Table:             table,
+		Param: &tree.ExternParam{
+			ExParamConst: tree.ExParamConst{
+				Filepath:     copyStdinFile,
+				CompressType: tree.AUTO,
+				Format:       tree.CSV,
+				Tail:         tail,
+			},
+		},
+	}, nil
+}
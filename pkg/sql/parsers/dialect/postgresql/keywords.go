@@ -18,6 +18,24 @@ var keywords map[string]int
 
 func init() {
 	keywords = map[string]int{
-		"use": USE,
+		"use":       USE,
+		"copy":      COPY,
+		"from":      FROM,
+		"stdin":     STDIN,
+		"with":      WITH,
+		"as":        AS,
+		"binary":    BINARY,
+		"csv":       CSV,
+		"header":    HEADER,
+		"delimiter": DELIMITER,
+		"null":      NULL,
+		"quote":     QUOTE,
+		"escape":    ESCAPE,
+		"encoding":  ENCODING,
+		"format":    FORMAT,
+		"text":      TEXT,
+		"true":      TRUE,
+		"false":     FALSE,
+		"on":        ON,
 	}
 }
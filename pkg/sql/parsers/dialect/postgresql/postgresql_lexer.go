@@ -78,6 +78,11 @@ func (l *Lexer) Error(err string) {
 	l.scanner.LastError = PositionedErr{Err: err, Pos: l.scanner.Pos + 1, Near: l.scanner.LastToken}
 }
 
+// SetError sets the error of the statement that is parsed but invalid.
+func (l *Lexer) SetError(err error) {
+	l.scanner.LastError = err
+}
+
 func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
 }
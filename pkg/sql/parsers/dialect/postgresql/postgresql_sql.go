@@ -11,6 +11,8 @@ import (
 //line postgresql_sql.y:16
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -115,6 +117,17 @@ const COLLATE = 57443
 const BINARY = 57444
 const UNDERSCORE_BINARY = 57445
 const INTERVAL = 57446
+const COPY = 57447
+const STDIN = 57448
+const WITH = 57449
+const CSV = 57450
+const HEADER = 57451
+const DELIMITER = 57452
+const QUOTE = 57453
+const ESCAPE = 57454
+const ENCODING = 57455
+const FORMAT = 57456
+const TEXT = 57457
 
 var yyToknames = [...]string{
 	"$end",
@@ -238,6 +251,17 @@ var yyToknames = [...]string{
 	"UNDERSCORE_BINARY",
 	"INTERVAL",
 	"'.'",
+	"COPY",
+	"STDIN",
+	"WITH",
+	"CSV",
+	"HEADER",
+	"DELIMITER",
+	"QUOTE",
+	"ESCAPE",
+	"ENCODING",
+	"FORMAT",
+	"TEXT",
 	"';'",
 }
 
@@ -247,7 +271,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line postgresql_sql.y:110
+//line postgresql_sql.y:288
 
 //line yacctab:1
 var yyExca = [...]int{
@@ -258,22 +282,43 @@ var yyExca = [...]int{
 
 const yyPrivate = 57344
 
-const yyLast = 11
+const yyLast = 150
 
 var yyAct = [...]int{
-	6, 7, 5, 3, 1, 4, 2, 0, 0, 0,
-	8,
+	70, 8, 31, 26, 48, 77, 76, 66, 43, 75,
+	65, 74, 9, 62, 79, 72, 25, 50, 62, 61,
+	6, 68, 69, 33, 32, 11, 57, 12, 27, 1,
+	52, 3, 37, 64, 63, 49, 34, 28, 35, 24,
+	23, 10, 5, 53, 4, 2, 0, 0, 47, 0,
+	0, 29, 30, 0, 39, 43, 0, 0, 0, 46,
+	43, 40, 41, 42, 44, 45, 71, 78, 13, 54,
+	0, 14, 0, 15, 16, 17, 18, 19, 20, 21,
+	22, 14, 38, 15, 16, 17, 18, 19, 20, 21,
+	22, 14, 7, 15, 16, 17, 18, 19, 20, 21,
+	22, 39, 73, 56, 51, 0, 39, 36, 40, 41,
+	42, 44, 45, 40, 41, 42, 44, 45, 67, 0,
+	55, 0, 51, 0, 0, 0, 0, 0, 0, 0,
+	0, 51, 0, 0, 0, 0, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 58, 59, 60,
 }
 
 var yyPact = [...]int{
-	-48, -1000, -122, -1000, -1000, -58, -48, -1000, -1000,
+	-30, -1000, -132, -1000, -1000, -1000, -47, -32, -30, -1000,
+	-39, -118, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 16, -32, -32, -121, -33, -1000,
+	-1000, -17, -1000, -32, -1000, -42, -12, -64, -1000, -1000,
+	-1000, -1000, 0, 0, 0, 0, -1000, -38, -1000, -52,
+	-1000, -1000, -1000, -42, -64, -1000, -51, -1000, -53, -56,
+	-57, -1000, -42, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -43, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 3, 6, 5, 4,
+	0, 31, 45, 44, 42, 41, 39, 37, 36, 48,
+	32, 4, 82, 25, 68, 35, 34, 33, 29, 103,
 }
 
-//line postgresql_sql.y:110
+//line postgresql_sql.y:288
 type yySymType struct {
 	union interface{}
 	id    int
@@ -282,6 +327,21 @@ type yySymType struct {
 	yys   int
 }
 
+func (st *yySymType) copyOptionUnion() *copyOption {
+	v, _ := st.union.(*copyOption)
+	return v
+}
+
+func (st *yySymType) copyOptionsUnion() []*copyOption {
+	v, _ := st.union.([]*copyOption)
+	return v
+}
+
+func (st *yySymType) loadColumnsUnion() []tree.LoadColumn {
+	v, _ := st.union.([]tree.LoadColumn)
+	return v
+}
+
 func (st *yySymType) statementUnion() tree.Statement {
 	v, _ := st.union.(tree.Statement)
 	return v
@@ -292,20 +352,49 @@ func (st *yySymType) statementsUnion() []tree.Statement {
 	return v
 }
 
+func (st *yySymType) tableNameUnion() *tree.TableName {
+	v, _ := st.union.(*tree.TableName)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 4, 2, 2, 1, 3, 3,
+	0, 18, 2, 2, 1, 1, 1, 3, 3, 4,
+	6, 6, 7, 7, 8, 8, 8, 8, 8, 9,
+	9, 11, 15, 15, 15, 16, 16, 17, 17, 17,
+	17, 17, 17, 17, 17, 10, 10, 12, 12, 12,
+	12, 12, 12, 12, 19, 19, 5, 5, 13, 13,
+	14, 14, 14, 14, 14, 14, 14, 14, 14,
 }
 
 var yyR2 = [...]int{
-	0, 1, 1, 3, 1, 2, 1,
+	0, 1, 1, 3, 1, 1, 0, 2, 1, 6,
+	0, 3, 1, 3, 0, 3, 4, 1, 2, 1,
+	3, 2, 1, 1, 1, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 1, 1, 1,
+	3, 3, 3, 3, 0, 1, 1, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -4, -2, -1, -3, 50, 122, 59, -1,
+	-1000, -18, -2, -1, -3, -4, 50, 122, 133, 59,
+	-5, -13, 59, -14, 123, 125, 126, 127, 128, 129,
+	130, 131, 132, -1, -6, 55, 121, 12, -7, -13,
+	-13, 123, 57, 56, -8, 55, 124, -10, -12, 118,
+	125, 126, 127, 72, 128, 129, -13, -9, -11, -15,
+	59, -14, 72, 55, -10, -12, -19, 26, -19, -19,
+	-19, 57, 56, -16, -17, 62, 59, -14, 73, 74,
+	52, 118, 67, -9, 62, 62, 62, 62, -11, 57,
 }
 
 var yyDef = [...]int{
-	0, -2, 1, 2, 4, 6, 0, 5, 3,
+	6, -2, 1, 2, 4, 5, 8, 0, 6, 7,
+	10, 46, 48, 49, 50, 51, 52, 53, 54, 55,
+	56, 57, 58, 3, 0, 0, 0, 0, 0, 12,
+	47, 14, 11, 0, 9, 0, 0, 17, 35, 37,
+	38, 39, 44, 44, 44, 44, 13, 0, 19, 25,
+	22, 23, 24, 0, 18, 36, 0, 45, 0, 0,
+	0, 15, 0, 21, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 0, 40, 41, 42, 43, 20, 16,
 }
 
 var yyTok1 = [...]int{
@@ -314,7 +403,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 84, 3, 3, 3, 112, 104, 3,
 	55, 57, 109, 107, 56, 108, 121, 110, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 122,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 133,
 	92, 91, 93, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -335,7 +424,8 @@ var yyTok2 = [...]int{
 	75, 76, 77, 78, 79, 80, 81, 82, 83, 85,
 	86, 87, 88, 89, 90, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 105, 106, 111, 113, 116, 117,
-	118, 119, 120,
+	118, 119, 120, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132,
 }
 
 var yyTok3 = [...]int{
@@ -689,7 +779,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line postgresql_sql.y:86
+//line postgresql_sql.y:100
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -697,28 +787,249 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line postgresql_sql.y:92
+//line postgresql_sql.y:106
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 5:
+	case 6:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:116
+		{
+			yyLOCAL = tree.Statement(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 7:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line postgresql_sql.y:103
+//line postgresql_sql.y:122
 		{
 			yyLOCAL = &tree.Use{Name: tree.NewCStr(yyDollar[2].str, 1)}
 		}
 		yyVAL.union = yyLOCAL
-	case 6:
+	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line postgresql_sql.y:107
+//line postgresql_sql.y:126
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
+	case 9:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:131
+		{
+			load, err := newCopyFromStdin(yyDollar[2].tableNameUnion(), yyDollar[3].loadColumnsUnion(), yyDollar[6].copyOptionsUnion())
+			if err != nil {
+				yylex.(*Lexer).SetError(err)
+				goto ret1
+			}
+			yyLOCAL = load
+		}
+		yyVAL.union = yyLOCAL
+	case 10:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:141
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 11:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:145
+		{
+			yyLOCAL = yyDollar[2].loadColumnsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 12:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:151
+		{
+			yyLOCAL = []tree.LoadColumn{tree.SetUnresolvedName(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 13:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:155
+		{
+			yyLOCAL = append(yyDollar[1].loadColumnsUnion(), tree.SetUnresolvedName(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 14:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:160
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 15:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:164
+		{
+			yyLOCAL = yyDollar[2].copyOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 16:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:168
+		{
+			yyLOCAL = yyDollar[3].copyOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 18:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:173
+		{
+			yyLOCAL = yyDollar[2].copyOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 19:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:179
+		{
+			yyLOCAL = []*copyOption{yyDollar[1].copyOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 20:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:183
+		{
+			yyLOCAL = append(yyDollar[1].copyOptionsUnion(), yyDollar[3].copyOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 21:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:189
+		{
+			yyLOCAL = &copyOption{name: yyDollar[1].str, value: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 25:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:199
+		{
+			yyVAL.str = ""
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:213
+		{
+			yyVAL.str = fmt.Sprintf("%d", yyDollar[1].item)
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:219
+		{
+			yyLOCAL = []*copyOption{yyDollar[1].copyOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 36:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*copyOption
+//line postgresql_sql.y:223
+		{
+			yyLOCAL = append(yyDollar[1].copyOptionsUnion(), yyDollar[2].copyOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 37:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:229
+		{
+			yyLOCAL = &copyOption{name: "format", value: "binary"}
+		}
+		yyVAL.union = yyLOCAL
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:233
+		{
+			yyLOCAL = &copyOption{name: "format", value: "csv"}
+		}
+		yyVAL.union = yyLOCAL
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:237
+		{
+			yyLOCAL = &copyOption{name: "header"}
+		}
+		yyVAL.union = yyLOCAL
+	case 40:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:241
+		{
+			yyLOCAL = &copyOption{name: "delimiter", value: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:245
+		{
+			yyLOCAL = &copyOption{name: "null", value: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 42:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:249
+		{
+			yyLOCAL = &copyOption{name: "quote", value: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 43:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *copyOption
+//line postgresql_sql.y:253
+		{
+			yyLOCAL = &copyOption{name: "escape", value: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 44:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:258
+		{
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:260
+		{
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:264
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:269
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix, nil)
+		}
+		yyVAL.union = yyLOCAL
 	}
 	goto yystack /* stack new state and value */
 }
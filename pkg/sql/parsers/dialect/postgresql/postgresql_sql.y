@@ -16,6 +16,8 @@
 package postgresql
     
 import (
+    "fmt"
+
     "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 %}
@@ -29,6 +31,10 @@ import (
 %union {
     statement tree.Statement
     statements []tree.Statement
+    tableName *tree.TableName
+    loadColumns []tree.LoadColumn
+    copyOption *copyOption
+    copyOptions []*copyOption
 }
 
 %token LEX_ERROR
@@ -70,9 +76,17 @@ import (
 %right <str> INTERVAL
 %nonassoc <str> '.'
 
+// COPY
+%token <str> COPY STDIN WITH CSV HEADER DELIMITER QUOTE ESCAPE ENCODING FORMAT TEXT
+
 %type <statement> stmt
 %type <statements> stmt_list
-%type <statement> use_stmt
+%type <statement> use_stmt copy_stmt
+%type <tableName> table_name
+%type <loadColumns> copy_columns_opt copy_column_list
+%type <copyOptions> copy_options_opt copy_generic_option_list copy_legacy_option_list
+%type <copyOption> copy_generic_option copy_legacy_option
+%type <str> ident non_reserved_keyword copy_option_name copy_option_value_opt copy_option_value
 
 %start start_command
 
@@ -96,7 +110,12 @@ stmt_list:
     }
 
 stmt:
-   use_stmt 
+    use_stmt
+|   copy_stmt
+|   /* EMPTY */
+    {
+        $$ = tree.Statement(nil)
+    }
 
 use_stmt:
     USE ID
@@ -107,4 +126,163 @@ use_stmt:
     {
         $$ = &tree.Use{}
     }
+copy_stmt:
+    COPY table_name copy_columns_opt FROM STDIN copy_options_opt
+    {
+        load, err := newCopyFromStdin($2, $3, $6)
+        if err != nil {
+            yylex.(*Lexer).SetError(err)
+            goto ret1
+        }
+        $$ = load
+    }
+
+copy_columns_opt:
+    {
+        $$ = nil
+    }
+|   '(' copy_column_list ')'
+    {
+        $$ = $2
+    }
+
+copy_column_list:
+    ident
+    {
+        $$ = []tree.LoadColumn{tree.SetUnresolvedName($1)}
+    }
+|   copy_column_list ',' ident
+    {
+        $$ = append($1, tree.SetUnresolvedName($3))
+    }
+
+copy_options_opt:
+    {
+        $$ = nil
+    }
+|   '(' copy_generic_option_list ')'
+    {
+        $$ = $2
+    }
+|   WITH '(' copy_generic_option_list ')'
+    {
+        $$ = $3
+    }
+|   copy_legacy_option_list
+|   WITH copy_legacy_option_list
+    {
+        $$ = $2
+    }
+
+copy_generic_option_list:
+    copy_generic_option
+    {
+        $$ = []*copyOption{$1}
+    }
+|   copy_generic_option_list ',' copy_generic_option
+    {
+        $$ = append($1, $3)
+    }
+
+copy_generic_option:
+    copy_option_name copy_option_value_opt
+    {
+        $$ = &copyOption{name: $1, value: $2}
+    }
+
+copy_option_name:
+    ID
+|   non_reserved_keyword
+|   NULL
+
+copy_option_value_opt:
+    {
+        $$ = ""
+    }
+|   copy_option_value
+
+copy_option_value:
+    STRING
+|   ID
+|   non_reserved_keyword
+|   TRUE
+|   FALSE
+|   ON
+|   BINARY
+|   INTEGRAL
+    {
+        $$ = fmt.Sprintf("%d", $1)
+    }
+
+copy_legacy_option_list:
+    copy_legacy_option
+    {
+        $$ = []*copyOption{$1}
+    }
+|   copy_legacy_option_list copy_legacy_option
+    {
+        $$ = append($1, $2)
+    }
+
+copy_legacy_option:
+    BINARY
+    {
+        $$ = &copyOption{name: "format", value: "binary"}
+    }
+|   CSV
+    {
+        $$ = &copyOption{name: "format", value: "csv"}
+    }
+|   HEADER
+    {
+        $$ = &copyOption{name: "header"}
+    }
+|   DELIMITER as_opt STRING
+    {
+        $$ = &copyOption{name: "delimiter", value: $3}
+    }
+|   NULL as_opt STRING
+    {
+        $$ = &copyOption{name: "null", value: $3}
+    }
+|   QUOTE as_opt STRING
+    {
+        $$ = &copyOption{name: "quote", value: $3}
+    }
+|   ESCAPE as_opt STRING
+    {
+        $$ = &copyOption{name: "escape", value: $3}
+    }
+
+as_opt:
+    {}
+|   AS
+    {}
+
+table_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewTableName(tree.Identifier($1), prefix, nil)
+    }
+|   ident '.' ident
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
+        $$ = tree.NewTableName(tree.Identifier($3), prefix, nil)
+    }
+
+ident:
+    ID
+|   non_reserved_keyword
+
+non_reserved_keyword:
+    STDIN
+|   CSV
+|   HEADER
+|   DELIMITER
+|   QUOTE
+|   ESCAPE
+|   ENCODING
+|   FORMAT
+|   TEXT
 %%
@@ -135,6 +135,12 @@ func init() {
 		reuse.DefaultOptions[AlterTableOrderByColumnClause](), //.
 	) // WithEnableChecker()
 
+	reuse.CreatePool[AlterOptionMergePolicy](
+		func() *AlterOptionMergePolicy { return &AlterOptionMergePolicy{} },
+		func(a *AlterOptionMergePolicy) { a.reset() },
+		reuse.DefaultOptions[AlterOptionMergePolicy](), //.
+	) // WithEnableChecker()
+
 	reuse.CreatePool[AlterAccountAuthOption](
 		func() *AlterAccountAuthOption { return &AlterAccountAuthOption{} },
 		func(a *AlterAccountAuthOption) { a.reset() },
@@ -536,6 +542,8 @@ func (node *AlterTable) reset() {
 				opt.Free()
 			case *AlterTableOrderByColumnClause:
 				opt.Free()
+			case *AlterOptionMergePolicy:
+				opt.Free()
 			case *AlterAddCol:
 				opt.Free()
 			case *TableOptionProperties:
@@ -604,6 +612,8 @@ func (node *AlterTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", option))
@@ -1263,6 +1273,45 @@ func (node *AlterTableOrderByColumnClause) reset() {
 	*node = AlterTableOrderByColumnClause{}
 }
 
+// MergePolicyOption is an option of SET MERGE_POLICY, the value is kept as
+// text and checked by the plan builder
+type MergePolicyOption struct {
+	Key string
+	Val string
+}
+
+type AlterOptionMergePolicy struct {
+	alterOptionImpl
+	Options []MergePolicyOption
+}
+
+func NewAlterOptionMergePolicy(options []MergePolicyOption) *AlterOptionMergePolicy {
+	a := reuse.Alloc[AlterOptionMergePolicy](nil)
+	a.Options = options
+	return a
+}
+
+func (node *AlterOptionMergePolicy) Free() { reuse.Free[AlterOptionMergePolicy](node, nil) }
+
+func (node *AlterOptionMergePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("set merge_policy (")
+	for i, opt := range node.Options {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(opt.Key)
+		ctx.WriteString(" = ")
+		ctx.WriteString("'" + opt.Val + "'")
+	}
+	ctx.WriteString(")")
+}
+
+func (node AlterOptionMergePolicy) TypeName() string { return "tree.AlterOptionMergePolicy" }
+
+func (node *AlterOptionMergePolicy) reset() {
+	*node = AlterOptionMergePolicy{}
+}
+
 type AlterColumnOrder struct {
 	Column    *UnresolvedName
 	Direction Direction
@@ -275,6 +275,12 @@ func init() {
 		reuse.DefaultOptions[TableOptionAutoIncrement](), //.
 	) //WithEnableChecker()
 
+	reuse.CreatePool[TableOptionTTL](
+		func() *TableOptionTTL { return &TableOptionTTL{} },
+		func(t *TableOptionTTL) { t.reset() },
+		reuse.DefaultOptions[TableOptionTTL](), //.
+	) //WithEnableChecker()
+
 	reuse.CreatePool[TableOptionComment](
 		func() *TableOptionComment { return &TableOptionComment{} },
 		func(t *TableOptionComment) { t.reset() },
@@ -1187,6 +1193,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -1276,6 +1284,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -2005,6 +2015,8 @@ func (it IndexType) ToString() string {
 		return "ivfflat"
 	case INDEX_TYPE_MASTER:
 		return "master"
+	case INDEX_TYPE_BLOOM:
+		return "bloom"
 	case INDEX_TYPE_INVALID:
 		return ""
 	default:
@@ -2021,6 +2033,7 @@ const (
 	INDEX_TYPE_ZONEMAP
 	INDEX_TYPE_IVFFLAT
 	INDEX_TYPE_MASTER
+	INDEX_TYPE_BLOOM
 )
 
 type VisibleType int
@@ -2784,6 +2797,41 @@ func NewTableOptionComment(c string) *TableOptionComment {
 	return t
 }
 
+// TableOptionTTL expires rows once the time in Column plus the interval has passed.
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column   Identifier
+	Interval int64
+	Unit     string
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	node.Column.Format(ctx)
+	ctx.WriteString(" + interval ")
+	ctx.WriteString(strconv.FormatInt(node.Interval, 10))
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+}
+
+func (node TableOptionTTL) TypeName() string { return "tree.TableOptionTTL" }
+
+func (node *TableOptionTTL) reset() {
+	*node = TableOptionTTL{}
+}
+
+func (node *TableOptionTTL) Free() {
+	reuse.Free[TableOptionTTL](node, nil)
+}
+
+func NewTableOptionTTL(column Identifier, interval int64, unit string) *TableOptionTTL {
+	t := reuse.Alloc[TableOptionTTL](nil)
+	t.Column = column
+	t.Interval = interval
+	t.Unit = unit
+	return t
+}
+
 type TableOptionAvgRowLength struct {
 	tableOptionImpl
 	Length uint64
@@ -3802,6 +3850,8 @@ func (node *Partition) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -3935,6 +3985,8 @@ func (node *SubPartition) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
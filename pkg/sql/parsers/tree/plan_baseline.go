@@ -0,0 +1,62 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// CreatePlanBaseline pins the plan of a query: the queries with its digest
+// are planned with the optimizer hints of Select, or with the join order of
+// its current plan if it has none. It replaces the baseline of the digest.
+type CreatePlanBaseline struct {
+	statementImpl
+
+	Select *Select
+}
+
+func (node *CreatePlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("create plan baseline for ")
+	node.Select.Format(ctx)
+}
+
+func (node *CreatePlanBaseline) GetStatementType() string { return "Create Plan Baseline" }
+
+func (node *CreatePlanBaseline) GetQueryType() string { return QueryTypeOth }
+
+// DropPlanBaseline drops the baseline of the digest of Select, or of Digest
+// if Select is nil.
+type DropPlanBaseline struct {
+	statementImpl
+
+	IfExists bool
+	Select   *Select
+	Digest   string
+}
+
+func (node *DropPlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop plan baseline ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	if node.Select != nil {
+		ctx.WriteString("for ")
+		node.Select.Format(ctx)
+		return
+	}
+	ctx.WriteString("'")
+	ctx.WriteString(node.Digest)
+	ctx.WriteString("'")
+}
+
+func (node *DropPlanBaseline) GetStatementType() string { return "Drop Plan Baseline" }
+
+func (node *DropPlanBaseline) GetQueryType() string { return QueryTypeOth }
@@ -303,6 +303,8 @@ type SelectClause struct {
 	GroupBy  GroupBy
 	Having   *Where
 	Option   string
+	// Hints are left out of the formatted statement, like any comment.
+	Hints OptimizerHints
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
@@ -619,6 +621,43 @@ func NewFrom(t TableExprs) *From {
 	return &From{Tables: t}
 }
 
+// OptimizerHints are the hints of the /*+ ... */ comment following SELECT.
+type OptimizerHints []*OptimizerHint
+
+// Find returns the last hint named name, nil if there is none.
+func (node OptimizerHints) Find(name string) *OptimizerHint {
+	for i := len(node) - 1; i >= 0; i-- {
+		if node[i].Name == name {
+			return node[i]
+		}
+	}
+	return nil
+}
+
+// Format writes the hints as the text of a /*+ ... */ comment.
+func (node OptimizerHints) Format(ctx *FmtCtx) {
+	for i, hint := range node {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		hint.Format(ctx)
+	}
+}
+
+// OptimizerHint is an optimizer hint such as JOIN_ORDER(t1, t2) or
+// SET_VAR(name=value). Name is upper case.
+type OptimizerHint struct {
+	Name string
+	Args []string
+}
+
+func (node *OptimizerHint) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	ctx.WriteByte('(')
+	ctx.WriteString(strings.Join(node.Args, ", "))
+	ctx.WriteByte(')')
+}
+
 type IndexHintType int
 
 const (
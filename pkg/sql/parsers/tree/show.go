@@ -824,6 +824,30 @@ func (node *ShowBackendServers) Format(ctx *FmtCtx) {
 func (node *ShowBackendServers) GetStatementType() string { return "Show Backend Servers" }
 func (node *ShowBackendServers) GetQueryType() string     { return QueryTypeOth }
 
+// ShowMasterStatus indicates SHOW MASTER STATUS statement.
+type ShowMasterStatus struct {
+	showImpl
+}
+
+func (node *ShowMasterStatus) Format(ctx *FmtCtx) {
+	ctx.WriteString("show master status")
+}
+
+func (node *ShowMasterStatus) GetStatementType() string { return "Show Master Status" }
+func (node *ShowMasterStatus) GetQueryType() string     { return QueryTypeOth }
+
+// ShowBinaryLogs indicates SHOW BINARY LOGS statement.
+type ShowBinaryLogs struct {
+	showImpl
+}
+
+func (node *ShowBinaryLogs) Format(ctx *FmtCtx) {
+	ctx.WriteString("show binary logs")
+}
+
+func (node *ShowBinaryLogs) GetStatementType() string { return "Show Binary Logs" }
+func (node *ShowBinaryLogs) GetQueryType() string     { return QueryTypeOth }
+
 type ShowConnectors struct {
 	showImpl
 }
@@ -195,6 +195,8 @@ func (node *CreateSource) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
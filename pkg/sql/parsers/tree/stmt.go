@@ -139,6 +139,8 @@ var (
 	//    ShowCollation
 	//    ShowSubscriptions
 	//    ShowBackendServers
+	//    ShowMasterStatus
+	//    ShowBinaryLogs
 	compositeResRowType = MakeStmtKind(OUTPUT_RESULT_ROW, RESP_PREBUILD_RESULT_ROW, EXEC_IN_FRONTEND)
 )
 
@@ -269,6 +271,14 @@ func (node *ShowBackendServers) StmtKind() StmtKind {
 	return compositeResRowType
 }
 
+func (node *ShowMasterStatus) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
+func (node *ShowBinaryLogs) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
 func (node *SetTransaction) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -599,6 +609,14 @@ func (node *ShowSnapShots) StmtKind() StmtKind {
 	return defaultResRowTyp
 }
 
+func (node *CreatePlanBaseline) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropPlanBaseline) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *UpgradeStatement) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
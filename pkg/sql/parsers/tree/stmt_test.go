@@ -126,6 +126,8 @@ func TestQueryType(t *testing.T) {
 					&ShowPublications{}:              QueryTypeOth,
 					&ShowSubscriptions{}:             QueryTypeOth,
 					&ShowBackendServers{}:            QueryTypeOth,
+					&ShowMasterStatus{}:              QueryTypeOth,
+					&ShowBinaryLogs{}:                QueryTypeOth,
 				},
 			},
 		},
@@ -69,7 +69,8 @@ func (builder *QueryBuilder) applyIndicesForFilters(nodeID int32, node *plan.Nod
 	{
 		masterIndexes := make([]*plan.IndexDef, 0)
 		for _, indexDef := range node.TableDef.Indexes {
-			if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexDef.IndexAlgo) {
+			if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexDef.IndexAlgo) &&
+				indexHintsAllow(node.IndexHints, plan.IndexHint_JOIN, indexDef.IndexName) {
 				masterIndexes = append(masterIndexes, indexDef)
 			}
 		}
@@ -117,6 +118,7 @@ func (builder *QueryBuilder) applyIndicesForFilters(nodeID int32, node *plan.Nod
 				}
 			}
 			if isAllFilterColumnsIncluded {
+				builder.markIndexHintUsed(node, plan.IndexHint_JOIN, indexDef.IndexName)
 				return builder.applyIndicesForFiltersUsingMasterIndex(nodeID, node, indexDef)
 			}
 		}
@@ -148,7 +150,8 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 		// 1.a if there are no table scans with multi-table indexes, skip
 		multiTableIndexes := make(map[string]*MultiTableIndex)
 		for _, indexDef := range scanNode.TableDef.Indexes {
-			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
+			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) &&
+				indexHintsAllow(scanNode.IndexHints, plan.IndexHint_ORDER_BY, indexDef.IndexName) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 						IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -235,6 +238,7 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 			goto END0
 		}
 
+		builder.markIndexHintUsed(scanNode, plan.IndexHint_ORDER_BY, multiTableIndexWithSortDistFn.IndexDefs[catalog.SystemSI_IVFFLAT_TblType_Metadata].IndexName)
 		newSortNode := builder.applyIndicesForSortUsingVectorIndex(nodeID, projNode, sortNode, scanNode,
 			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy)
 
@@ -268,6 +272,9 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 	sort.Slice(indexes, func(i, j int) bool {
 		return (indexes[i].Unique && !indexes[j].Unique) || (indexes[i].Unique == indexes[j].Unique && len(indexes[i].Parts) > len(indexes[j].Parts))
 	})
+	indexes = allowedIndexes(node.IndexHints, plan.IndexHint_JOIN, indexes)
+	forceIndex := indexHintsForced(node.IndexHints, plan.IndexHint_JOIN)
+	usePK := indexHintsAllow(node.IndexHints, plan.IndexHint_JOIN, primaryIndexName)
 
 	// Apply unique/secondary indices if only indexed column is referenced
 
@@ -307,7 +314,8 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 			colPos = col.ColPos
 		}
 
-		if colPos == pkPos {
+		if colPos == pkPos && usePK {
+			builder.markIndexHintUsed(node, plan.IndexHint_JOIN, primaryIndexName)
 			return nodeID
 		}
 
@@ -371,6 +379,7 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 			}
 
 			idxColMap[[2]int32{node.BindingTags[0], colPos}] = idxColExpr
+			builder.markIndexHintUsed(node, plan.IndexHint_JOIN, idxDef.IndexName)
 
 			for i, expr := range node.FilterList {
 				fn := expr.GetF()
@@ -415,7 +424,7 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 	}
 
 END0:
-	if node.Stats.Selectivity > InFilterSelectivityLimit || node.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(node.Stats.TableCnt)) {
+	if !forceIndex && (node.Stats.Selectivity > InFilterSelectivityLimit || node.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(node.Stats.TableCnt))) {
 		return nodeID
 	}
 
@@ -454,7 +463,8 @@ END0:
 		}
 	}
 
-	if filterOnPK {
+	if filterOnPK && usePK {
+		builder.markIndexHintUsed(node, plan.IndexHint_JOIN, primaryIndexName)
 		return nodeID
 	}
 
@@ -491,6 +501,8 @@ END0:
 			}
 		}
 
+		// a forced index is taken as soon as a prefix of its key is filtered
+		usePartialIndex = usePartialIndex || (forceIndex && len(filterIdx) > 0)
 		if len(filterIdx) < numParts && (idxDef.Unique || !usePartialIndex) {
 			continue
 		}
@@ -548,6 +560,7 @@ END0:
 		}, builder.ctxByNode[nodeID])
 
 		node.Limit, node.Offset = nil, nil
+		builder.markIndexHintUsed(node, plan.IndexHint_JOIN, idxDef.IndexName)
 
 		pkIdx := node.TableDef.Name2ColIndex[node.TableDef.Pkey.PkeyColName]
 		pkExpr := &plan.Expr{
@@ -613,7 +626,8 @@ END0:
 			continue
 		}
 
-		if col.ColPos == pkPos {
+		if col.ColPos == pkPos && usePK {
+			builder.markIndexHintUsed(node, plan.IndexHint_JOIN, primaryIndexName)
 			return nodeID
 		}
 
@@ -630,7 +644,7 @@ END0:
 		}
 
 		idxTag := builder.genNewTag()
-		idxDef := node.TableDef.Indexes[idxPos]
+		idxDef := indexes[idxPos]
 		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName)
 
 		builder.nameByColRef[[2]int32{idxTag, 0}] = idxTableDef.Name + "." + idxTableDef.Cols[0].Name
@@ -671,6 +685,7 @@ END0:
 		}, builder.ctxByNode[nodeID])
 
 		node.Limit, node.Offset = nil, nil
+		builder.markIndexHintUsed(node, plan.IndexHint_JOIN, idxDef.IndexName)
 
 		pkIdx := node.TableDef.Name2ColIndex[node.TableDef.Pkey.PkeyColName]
 		pkExpr := &plan.Expr{
@@ -712,6 +727,10 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 	if node.JoinType == plan.Node_INDEX {
 		return nodeID
 	}
+	// HASH_JOIN(t) keeps the joins of t hash joins
+	if builder.subtreeHasHintTag(nodeID, builder.hints.hashJoin) {
+		return nodeID
+	}
 
 	leftChild := builder.qry.Nodes[node.Children[0]]
 	if leftChild.NodeType != plan.Node_TABLE_SCAN {
@@ -721,7 +740,8 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 
 	rightChild := builder.qry.Nodes[node.Children[1]]
 
-	if rightChild.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(leftChild.Stats.TableCnt)) || rightChild.Stats.Outcnt > leftChild.Stats.Cost*0.1 {
+	forceIndex := indexHintsForced(leftChild.IndexHints, plan.IndexHint_JOIN)
+	if !forceIndex && (rightChild.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(leftChild.Stats.TableCnt)) || rightChild.Stats.Outcnt > leftChild.Stats.Cost*0.1) {
 		return nodeID
 	}
 
@@ -759,11 +779,12 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 		}
 	}
 
-	if joinOnPK {
+	if joinOnPK && indexHintsAllow(leftChild.IndexHints, plan.IndexHint_JOIN, primaryIndexName) {
+		builder.markIndexHintUsed(leftChild, plan.IndexHint_JOIN, primaryIndexName)
 		return nodeID
 	}
 
-	indexes := leftChild.TableDef.Indexes
+	indexes := allowedIndexes(leftChild.IndexHints, plan.IndexHint_JOIN, leftChild.TableDef.Indexes)
 	condIdx := make([]int, 0, len(col2Cond))
 	for _, idxDef := range indexes {
 		if !idxDef.TableExist {
@@ -883,6 +904,7 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 		}, builder.ctxByNode[nodeID])
 
 		leftChild.Limit, leftChild.Offset = nil, nil
+		builder.markIndexHintUsed(leftChild, plan.IndexHint_JOIN, idxDef.IndexName)
 
 		node.Children[0] = idxJoinNodeID
 
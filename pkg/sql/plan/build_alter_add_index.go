@@ -0,0 +1,106 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// AddIndex adds a secondary or unique index to the copy table. It is only used
+// by the temporary tables, whose indexes are rebuilt when the copy table is
+// created from its DDL.
+func AddIndex(ctx CompilerContext, alterPlan *plan.AlterTable, spec tree.TableDef, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+
+	constrNames := map[string]bool{}
+	for _, idx := range tableDef.Indexes {
+		constrNames[strings.ToLower(idx.IndexName)] = true
+	}
+
+	var keyParts []*tree.KeyPart
+	indexDef := &plan.IndexDef{
+		IndexAlgo: catalog.MoIndexDefaultAlgo.ToString(),
+	}
+	switch def := spec.(type) {
+	case *tree.UniqueIndex:
+		if err := checkDuplicateConstraint(constrNames, def.GetIndexName(), false, ctx.GetContext()); err != nil {
+			return err
+		}
+		if len(def.GetIndexName()) == 0 {
+			setEmptyUniqueIndexName(constrNames, def)
+		}
+		keyParts = def.KeyParts
+		indexDef.IndexName = def.GetIndexName()
+		indexDef.Unique = true
+		if def.IndexOption != nil {
+			indexDef.Comment = def.IndexOption.Comment
+		}
+	case *tree.Index:
+		if def.KeyType != tree.INDEX_TYPE_INVALID && def.KeyType != tree.INDEX_TYPE_BTREE {
+			return moerr.NewNYI(ctx.GetContext(), "add %s index for temporary table", def.KeyType.ToString())
+		}
+		if err := checkDuplicateConstraint(constrNames, def.Name, false, ctx.GetContext()); err != nil {
+			return err
+		}
+		if len(def.Name) == 0 {
+			setEmptyIndexName(constrNames, def)
+		}
+		keyParts = def.KeyParts
+		indexDef.IndexName = def.Name
+		if def.IndexOption != nil {
+			indexDef.Comment = def.IndexOption.Comment
+		}
+	default:
+		return moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", spec)
+	}
+
+	if err := checkIndexKeypartSupportability(ctx.GetContext(), keyParts); err != nil {
+		return err
+	}
+	for _, key := range keyParts {
+		colName := key.ColName.Parts[0]
+		if FindColumn(tableDef.Cols, colName) == nil {
+			return moerr.NewErrKeyColumnDoesNotExist(ctx.GetContext(), colName)
+		}
+		indexDef.Parts = append(indexDef.Parts, colName)
+	}
+	tableDef.Indexes = append(tableDef.Indexes, indexDef)
+	return nil
+}
+
+// DropIndex removes an index from the copy table. Like AddIndex, it is only
+// used by the temporary tables.
+func DropIndex(ctx CompilerContext, alterPlan *plan.AlterTable, indexName string, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+	indexes := tableDef.Indexes[:0]
+	found := false
+	for _, indexDef := range tableDef.Indexes {
+		if strings.EqualFold(indexDef.IndexName, indexName) {
+			found = true
+			continue
+		}
+		indexes = append(indexes, indexDef)
+	}
+	if !found {
+		return moerr.NewInternalError(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", indexName)
+	}
+	tableDef.Indexes = indexes
+	return nil
+}
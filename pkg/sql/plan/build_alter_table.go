@@ -87,10 +87,11 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 				err = AddPrimaryKey(ctx, alterTablePlan, optionAdd, alterTableCtx)
 			case *tree.ForeignKey:
 				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
-			case *tree.UniqueIndex:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
-			case *tree.Index:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+			case *tree.UniqueIndex, *tree.Index:
+				if !tableDef.IsTemporary {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+				}
+				err = AddIndex(ctx, alterTablePlan, optionAdd, alterTableCtx)
 			case *tree.ColumnTableDef:
 				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			default:
@@ -101,10 +102,11 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 			case tree.AlterTableDropColumn:
 				//return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
 				err = DropColumn(ctx, alterTablePlan, string(option.Name), alterTableCtx)
-			case tree.AlterTableDropIndex:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
-			case tree.AlterTableDropKey:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
+			case tree.AlterTableDropIndex, tree.AlterTableDropKey:
+				if !tableDef.IsTemporary {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
+				}
+				err = DropIndex(ctx, alterTablePlan, string(option.Name), alterTableCtx)
 			case tree.AlterTableDropPrimaryKey:
 				err = DropPrimaryKey(ctx, alterTablePlan, alterTableCtx)
 			case tree.AlterTableDropForeignKey:
@@ -195,6 +197,9 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 	} else if tblName == catalog.MO_DATABASE || tblName == catalog.MO_TABLES || tblName == catalog.MO_COLUMNS {
 		createStr = fmt.Sprintf("CREATE TABLE `%s`.`%s` (", formatStr(schemaName), formatStr(tblName))
 	}
+	if tableDef.IsTemporary {
+		createStr = fmt.Sprintf("CREATE TEMPORARY TABLE `%s`.`%s` (", formatStr(schemaName), formatStr(tblName))
+	}
 
 	rowCount := 0
 	var pkDefs []string
@@ -274,7 +279,7 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
 			updateOpt = " ON UPDATE " + col.OnUpdate.OriginString
 		}
-		createStr += fmt.Sprintf("`%s` %s %s%s%s", formatStr(colName), typeStr, nullOrNot, updateOpt, hasAttrComment)
+		createStr += fmt.Sprintf("`%s` %s %s%s%s%s", formatStr(colName), typeStr, nullOrNot, getLowCardinalityAttr(tableDef, colName), updateOpt, hasAttrComment)
 		rowCount++
 		if col.Primary {
 			pkDefs = append(pkDefs, colName)
@@ -436,6 +441,7 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 	}
 
 	createStr += comment
+	createStr += getTableTTLOption(tableDef)
 	createStr += partition
 
 	if tableDef.TableType == catalog.SystemExternalRel {
@@ -640,10 +646,6 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewNoSuchTable(ctx.GetContext(), schemaName, tableName)
 	}
 
-	if tableDef.IsTemporary {
-		return nil, moerr.NewNYI(ctx.GetContext(), "alter table for temporary table")
-	}
-
 	if tableDef.ViewSql != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "you should use alter view statemnt for View")
 	}
@@ -663,6 +665,15 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't add/drop column for partition table now")
 	}
 
+	// temporary tables are private to the session, they are always altered by
+	// copying, which needs neither locks nor catalog updates
+	if tableDef.IsTemporary {
+		if stmt.PartitionOption != nil {
+			return nil, moerr.NewPartitionNoTemporary(ctx.GetContext())
+		}
+		return buildAlterTableCopy(stmt, ctx)
+	}
+
 	if stmt.PartitionOption != nil {
 		if stmt.Options != nil {
 			return nil, moerr.NewParseError(ctx.GetContext(), "Unsupported multi schema change")
@@ -734,6 +745,8 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 			algorithm = plan.AlterTable_COPY
 		case *tree.TableOptionAutoIncrement:
 			algorithm = plan.AlterTable_INPLACE
+		case *tree.AlterOptionMergePolicy:
+			algorithm = plan.AlterTable_INPLACE
 		default:
 			algorithm = plan.AlterTable_INPLACE
 		}
@@ -14,7 +14,12 @@
 
 package plan
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
 
 func TestAlterTable1(t *testing.T) {
 	//sql := "ALTER TABLE t1 ADD (d TIMESTAMP, e INT not null);"
@@ -40,3 +45,84 @@ func TestAlterTableAddColumns(t *testing.T) {
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestAlterTemporaryTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.tables["t1"].IsTemporary = true
+	defer func() {
+		mock.ctxt.tables["t1"].IsTemporary = false
+	}()
+
+	sqls := []string{
+		`ALTER TABLE t1 ADD d TIMESTAMP;`,
+		`ALTER TABLE t1 ADD INDEX idx_b(b);`,
+		`ALTER TABLE t1 ADD UNIQUE INDEX (b);`,
+		`CREATE INDEX idx_b ON t1(b);`,
+	}
+	for _, sql := range sqls {
+		logicPlan, err := buildSingleStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%s: %+v", sql, err)
+		}
+		alterTable := logicPlan.GetDdl().GetAlterTable()
+		if alterTable == nil {
+			t.Fatalf("%s: expect alter table plan, got %v", sql, logicPlan)
+		}
+		if alterTable.AlgorithmType != plan.AlterTable_COPY {
+			t.Fatalf("%s: expect copy algorithm", sql)
+		}
+		if !strings.HasPrefix(alterTable.CreateTableSql, "CREATE TEMPORARY TABLE") {
+			t.Fatalf("%s: unexpected create sql %s", sql, alterTable.CreateTableSql)
+		}
+	}
+
+	logicPlan, err := buildSingleStmt(mock, t, `ALTER TABLE t1 ADD INDEX idx_b(b);`)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !strings.Contains(logicPlan.GetDdl().GetAlterTable().CreateTableSql, "KEY `idx_b` (`b`)") {
+		t.Fatalf("index is missing: %s", logicPlan.GetDdl().GetAlterTable().CreateTableSql)
+	}
+
+	runTestShouldError(mock, t, []string{
+		`ALTER TABLE t1 DROP INDEX idx_not_exists;`,
+		`DROP INDEX idx_not_exists ON t1;`,
+		`ALTER TABLE t1 ADD INDEX idx_c(c);`,
+	})
+}
+
+func TestAlterTableMergePolicy(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "ALTER TABLE orders SET MERGE_POLICY (target_object_size = '128M', max_level = 3, reorganize = true, time_column = o_orderdate, time_window = '1 day', seal_after = '7 days')"
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	if alterTable.AlgorithmType != plan.AlterTable_INPLACE {
+		t.Fatalf("expect inplace algorithm")
+	}
+	policy := alterTable.Actions[0].GetAlterMergePolicy()
+	expected := &plan.AlterTableMergePolicy{
+		TargetObjectSize: 128 << 20,
+		MaxLevel:         3,
+		Reorganize:       true,
+		TimeColumn:       "o_orderdate",
+		TimeWindow:       86400,
+		SealAfter:        7 * 86400,
+	}
+	if policy == nil || expected.String() != policy.String() {
+		t.Fatalf("unexpected merge policy %v", policy)
+	}
+
+	runTestShouldError(mock, t, []string{
+		"ALTER TABLE orders SET MERGE_POLICY (unknown = 1)",
+		"ALTER TABLE orders SET MERGE_POLICY (target_object_size = 'big')",
+		"ALTER TABLE orders SET MERGE_POLICY (max_level = 100)",
+		"ALTER TABLE orders SET MERGE_POLICY (time_column = o_orderdate)",
+		"ALTER TABLE orders SET MERGE_POLICY (time_column = o_clerk, time_window = '1 day')",
+		"ALTER TABLE orders SET MERGE_POLICY (time_column = o_nothing, time_window = '1 day')",
+		"ALTER TABLE orders SET MERGE_POLICY (seal_after = '7 days')",
+		"ALTER TABLE orders SET MERGE_POLICY (time_column = o_orderdate, time_window = '1 month')",
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -787,6 +788,23 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionTTL:
+			ttl, err := buildTableTTL(ctx, opt, createTable.TableDef)
+			if err != nil {
+				return nil, err
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.PropTTL,
+								Value: ttl.String(),
+							},
+						},
+					},
+				},
+			})
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -1001,6 +1019,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
 	fkDatasOfFKSelfRefer := make([]*FkData, 0)
+	var lowCardinalityCols []string
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -1070,6 +1089,11 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name: def.Name.Parts[0],
 					})
 					indexs = append(indexs, def.Name.Parts[0])
+				case *tree.AttributeLowCardinality:
+					if !catalog.IsLowCardinalityType(types.T(colType.GetId())) {
+						return moerr.NewNotSupported(ctx.GetContext(), "LOW_CARDINALITY column '%s' must be of string type", def.Name.Parts[0])
+					}
+					lowCardinalityCols = append(lowCardinalityCols, def.Name.Parts[0])
 				}
 			}
 			if len(pks) > 0 {
@@ -1203,6 +1227,21 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 	}
 
+	if len(lowCardinalityCols) > 0 {
+		createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: []*plan.Property{
+						{
+							Key:   catalog.PropLowCardinality,
+							Value: catalog.EncodeLowCardinalityColumns(lowCardinalityCols),
+						},
+					},
+				},
+			},
+		})
+	}
+
 	if stmt.IsAsSelect {
 		// add as select cols
 		for _, col := range asSelectCols {
@@ -1632,6 +1671,8 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			indexDef, tableDef, err = buildIvfFlatSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		case tree.INDEX_TYPE_MASTER:
 			indexDef, tableDef, err = buildMasterSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
+		case tree.INDEX_TYPE_BLOOM:
+			indexDef, err = buildBloomSecondaryIndexDef(ctx, indexInfo, colMap)
 		default:
 			return moerr.NewInvalidInputNoCtx("unsupported index type: %s", indexInfo.KeyType.ToString())
 		}
@@ -1745,6 +1786,147 @@ func buildMasterSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, co
 	return []*plan.IndexDef{indexDef}, []*TableDef{tableDef}, nil
 }
 
+// buildTableTTL checks the TTL option against the table columns. The TTL
+// column must be a DATE, DATETIME or TIMESTAMP column.
+func buildTableTTL(ctx CompilerContext, opt *tree.TableOptionTTL, tableDef *TableDef) (catalog.TableTTL, error) {
+	if opt.Interval <= 0 {
+		return catalog.TableTTL{}, moerr.NewInvalidInput(ctx.GetContext(), "TTL interval must be positive")
+	}
+	unit, err := catalog.NormalizeTTLUnit(ctx.GetContext(), opt.Unit)
+	if err != nil {
+		return catalog.TableTTL{}, err
+	}
+	name := string(opt.Column)
+	for _, col := range tableDef.Cols {
+		if col.Name != name {
+			continue
+		}
+		if !catalog.IsTTLColumnType(types.T(col.Typ.Id)) {
+			return catalog.TableTTL{}, moerr.NewNotSupported(ctx.GetContext(), "TTL column '%s' must be of DATE, DATETIME or TIMESTAMP type", name)
+		}
+		return catalog.TableTTL{Column: name, Interval: opt.Interval, Unit: unit}, nil
+	}
+	return catalog.TableTTL{}, moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+}
+
+// maxMergePolicyLevel caps the max_level option of the merge policy
+const maxMergePolicyLevel = 16
+
+// buildMergePolicy checks the options of SET MERGE_POLICY against the table.
+// The time column must be a DATE, DATETIME or TIMESTAMP column, and only a
+// table with a sort key can be reorganized.
+func buildMergePolicy(ctx CompilerContext, opt *tree.AlterOptionMergePolicy, tableDef *TableDef) (*plan.AlterTableMergePolicy, error) {
+	policy := &plan.AlterTableMergePolicy{}
+	parseUint := func(key, val string, max uint64) (uint64, error) {
+		v, err := strconv.ParseUint(val, 10, 64)
+		if err != nil || v > max {
+			return 0, moerr.NewInvalidInput(ctx.GetContext(), "invalid merge policy %s '%s'", key, val)
+		}
+		return v, nil
+	}
+	for _, o := range opt.Options {
+		var err error
+		switch key := strings.ToLower(o.Key); key {
+		case catalog.MergePolicyMinRows:
+			var v uint64
+			v, err = parseUint(key, o.Val, math.MaxUint32)
+			policy.MinRows = uint32(v)
+		case catalog.MergePolicyMaxObjects:
+			var v uint64
+			v, err = parseUint(key, o.Val, math.MaxUint32)
+			policy.MaxObjects = uint32(v)
+		case catalog.MergePolicyTargetObjectSize:
+			policy.TargetObjectSize, err = catalog.ParseMergePolicySize(ctx.GetContext(), o.Val)
+		case catalog.MergePolicyMaxLevel:
+			var v uint64
+			v, err = parseUint(key, o.Val, maxMergePolicyLevel)
+			policy.MaxLevel = uint32(v)
+		case catalog.MergePolicyReorganize:
+			policy.Reorganize, err = strconv.ParseBool(o.Val)
+			if err != nil {
+				err = moerr.NewInvalidInput(ctx.GetContext(), "invalid merge policy %s '%s'", key, o.Val)
+			}
+		case catalog.MergePolicyTimeColumn:
+			policy.TimeColumn = strings.ToLower(o.Val)
+		case catalog.MergePolicyTimeWindow:
+			policy.TimeWindow, err = catalog.ParseMergePolicyInterval(ctx.GetContext(), o.Val)
+		case catalog.MergePolicySealAfter:
+			policy.SealAfter, err = catalog.ParseMergePolicyInterval(ctx.GetContext(), o.Val)
+		default:
+			err = moerr.NewInvalidInput(ctx.GetContext(), "unknown merge policy option '%s'", o.Key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if (policy.TimeColumn == "") != (policy.TimeWindow == 0) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "merge policy time_column and time_window must be set together")
+	}
+	if policy.SealAfter != 0 && policy.TimeWindow == 0 {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "merge policy seal_after needs time_window")
+	}
+	if policy.TimeColumn != "" {
+		found := false
+		for _, col := range tableDef.Cols {
+			if col.Name != policy.TimeColumn {
+				continue
+			}
+			if !catalog.IsTTLColumnType(types.T(col.Typ.Id)) {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "merge policy time column '%s' must be of DATE, DATETIME or TIMESTAMP type", col.Name)
+			}
+			found = true
+			break
+		}
+		if !found {
+			return nil, moerr.NewBadFieldError(ctx.GetContext(), policy.TimeColumn, tableDef.Name)
+		}
+	}
+	if policy.Reorganize {
+		hasPk := tableDef.Pkey != nil && tableDef.Pkey.PkeyColName != catalog.FakePrimaryKeyColName
+		hasClusterBy := tableDef.ClusterBy != nil && tableDef.ClusterBy.Name != ""
+		if !hasPk && !hasClusterBy {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "reorganize table '%s' without primary key or cluster by", tableDef.Name)
+		}
+	}
+	return policy, nil
+}
+
+// buildBloomSecondaryIndexDef builds a bloom filter index. It has no hidden
+// table: the per-block bloom filters of its columns are written into the data
+// objects and used to prune blocks for equality and IN filters.
+func buildBloomSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef) ([]*plan.IndexDef, error) {
+	indexDef := &plan.IndexDef{}
+	indexDef.Unique = false
+
+	indexParts := make([]string, 0)
+	for _, keyPart := range indexInfo.KeyParts {
+		name := keyPart.ColName.Parts[0]
+		colDef, ok := colMap[name]
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+		}
+		typ := types.T(colDef.Typ.Id)
+		if typ == types.T_json || typ.IsArrayRelate() {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("bloom filter index on %s column '%s'", typ.String(), name))
+		}
+		indexParts = append(indexParts, name)
+	}
+
+	if indexInfo.Name == "" {
+		indexDef.IndexName = indexInfo.KeyParts[0].ColName.Parts[0]
+	} else {
+		indexDef.IndexName = indexInfo.Name
+	}
+	indexDef.Parts = indexParts
+	indexDef.TableExist = false
+	indexDef.IndexAlgo = indexInfo.KeyType.ToString()
+	if indexInfo.IndexOption != nil {
+		indexDef.Comment = indexInfo.IndexOption.Comment
+	}
+	return []*plan.IndexDef{indexDef}, nil
+}
+
 func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
 
 	// 1. indexDef init
@@ -2572,6 +2754,17 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	default:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
+	if tableDef.IsTemporary {
+		// the indexes of a temporary table are built by recreating it
+		var def tree.TableDef = sIdx
+		if uIdx != nil {
+			def = uIdx
+		}
+		return buildAlterTableCopy(&tree.AlterTable{
+			Table:   stmt.Table,
+			Options: tree.AlterTableOptions{&tree.AlterOptionAdd{Def: def}},
+		}, ctx)
+	}
 	colMap := make(map[string]*ColDef)
 	for _, col := range tableDef.Cols {
 		colMap[col.Name] = col
@@ -2635,6 +2828,14 @@ func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot drop index in subscription database")
 	}
 
+	if tableDef.IsTemporary {
+		// the indexes of a temporary table are dropped by recreating it
+		return buildAlterTableCopy(&tree.AlterTable{
+			Table:   stmt.TableName,
+			Options: tree.AlterTableOptions{&tree.AlterOptionDrop{Typ: tree.AlterTableDropIndex, Name: stmt.Name}},
+		}, ctx)
+	}
+
 	// check index
 	dropIndex.IndexName = string(stmt.Name)
 	found := false
@@ -2742,6 +2943,25 @@ func getTableComment(tableDef *plan.TableDef) string {
 	return comment
 }
 
+// getTableTTLOption returns the TTL table option of tableDef in create table
+// syntax, or an empty string if the table has no TTL.
+func getTableTTLOption(tableDef *plan.TableDef) string {
+	ttl, ok := catalog.TableTTLFromDefs(tableDef.Defs)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(" TTL = `%s` + INTERVAL %d %s", formatStr(ttl.Column), ttl.Interval, strings.ToUpper(ttl.Unit))
+}
+
+// getLowCardinalityAttr returns the LOW_CARDINALITY column attribute of the
+// column named colName, or an empty string if the column is not declared so.
+func getLowCardinalityAttr(tableDef *plan.TableDef, colName string) string {
+	if slices.Contains(catalog.LowCardinalityColumnsFromDefs(tableDef.Defs), colName) {
+		return " LOW_CARDINALITY"
+	}
+	return ""
+}
+
 func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
 	tableName := string(stmt.Table.ObjectName)
 	databaseName := string(stmt.Table.SchemaName)
@@ -3185,6 +3405,16 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				},
 			}
+		case *tree.AlterOptionMergePolicy:
+			policy, err := buildMergePolicy(ctx, opt, tableDef)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterMergePolicy{
+					AlterMergePolicy: policy,
+				},
+			}
 		case *tree.TableOptionAutoIncrement:
 			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Can't set AutoIncr column value.")
 		case *tree.AlterOptionAlterCheck, *tree.TableOptionCharset:
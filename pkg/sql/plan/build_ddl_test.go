@@ -447,3 +447,63 @@ func TestCreateTableAsSelect(t *testing.T) {
 	sqls := []string{"CREATE TABLE t1 (a int, b char(5)); CREATE TABLE t2 (c float) as select b, a from t1"}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestCreateTableWithBloomIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t1 (a int primary key, b varchar(64), c bigint, key idx_b using bloom (b, c))"
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	assert.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	// bloom filter indexes have no hidden table
+	assert.Empty(t, createTable.IndexTables)
+	assert.Equal(t, 1, len(createTable.TableDef.Indexes))
+	indexDef := createTable.TableDef.Indexes[0]
+	assert.Equal(t, "idx_b", indexDef.IndexName)
+	assert.False(t, indexDef.TableExist)
+	assert.True(t, catalog.IsBloomIndexAlgo(indexDef.IndexAlgo))
+	assert.Equal(t, []string{"b", "c"}, catalog.BloomIndexColumns(createTable.TableDef.Indexes))
+	assert.Equal(t, []uint16{1, 2}, catalog.BloomIndexColumnPositions(createTable.TableDef))
+
+	sqls := []string{
+		"create table t2 (a int primary key, b json, key idx_b using bloom (b))",
+		"create table t2 (a int primary key, b int, key idx_b using bloom (c))",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestCreateTableWithTTL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t1 (a int primary key, ts datetime) ttl = ts + interval 30 sql_tsi_day"
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	assert.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().TableDef
+	ttl, ok := catalog.TableTTLFromDefs(tableDef.Defs)
+	assert.True(t, ok)
+	assert.Equal(t, catalog.TableTTL{Column: "ts", Interval: 30, Unit: "day"}, ttl)
+	assert.Equal(t, " TTL = `ts` + INTERVAL 30 DAY", getTableTTLOption(tableDef))
+
+	sqls := []string{
+		"create table t2 (a int primary key, ts datetime) ttl = b + interval 1 day",
+		"create table t2 (a int primary key, ts varchar(20)) ttl = ts + interval 1 day",
+		"create table t2 (a int primary key, ts date) ttl = ts + interval 0 day",
+		"create table t2 (a int primary key, ts date) ttl = ts + interval 1 day_hour",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestCreateTableWithLowCardinality(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t1 (a int primary key, b varchar(20) low_cardinality, c int, d char(2) low_cardinality)"
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	assert.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().TableDef
+	assert.Equal(t, []string{"b", "d"}, catalog.LowCardinalityColumnsFromDefs(tableDef.Defs))
+	assert.Equal(t, []uint16{1, 3}, catalog.LowCardinalityColumnPositions(tableDef))
+	assert.Equal(t, " LOW_CARDINALITY", getLowCardinalityAttr(tableDef, "b"))
+	assert.Equal(t, "", getLowCardinalityAttr(tableDef, "c"))
+
+	sqls := []string{
+		"create table t2 (a int primary key, b int low_cardinality)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
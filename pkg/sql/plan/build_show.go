@@ -199,7 +199,7 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
 			updateOpt = " ON UPDATE " + col.OnUpdate.OriginString
 		}
-		createStr += fmt.Sprintf("`%s` %s %s%s%s", formatStr(colName), typeStr, nullOrNot, updateOpt, hasAttrComment)
+		createStr += fmt.Sprintf("`%s` %s %s%s%s%s", formatStr(colName), typeStr, nullOrNot, getLowCardinalityAttr(tableDef, colName), updateOpt, hasAttrComment)
 		rowCount++
 		if col.Primary {
 			pkDefs = append(pkDefs, colName)
@@ -337,6 +337,7 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 	}
 
 	createStr += comment
+	createStr += getTableTTLOption(tableDef)
 	createStr += partition
 
 	/**
@@ -723,7 +723,7 @@ func rewriteForCreateTableLike(stmt *tree.CreateTable, ctx CompilerContext) (new
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
 			updateOpt = " ON UPDATE " + col.OnUpdate.OriginString
 		}
-		createStr += fmt.Sprintf("`%s` %s %s%s%s", formatStr(colName), typeStr, nullOrNot, updateOpt, hasAttrComment)
+		createStr += fmt.Sprintf("`%s` %s %s%s%s%s", formatStr(colName), typeStr, nullOrNot, getLowCardinalityAttr(tableDef, colName), updateOpt, hasAttrComment)
 		rowCount++
 		if col.Primary {
 			pkDefs = append(pkDefs, colName)
@@ -861,6 +861,7 @@ func rewriteForCreateTableLike(stmt *tree.CreateTable, ctx CompilerContext) (new
 	}
 
 	createStr += comment
+	createStr += getTableTTLOption(tableDef)
 	createStr += partition
 
 	if tableDef.ClusterBy != nil {
@@ -0,0 +1,101 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var ClusteringInformationColNames = []string{
+	"column_name",
+	"total_objects",
+	"empty_objects",
+	"overlapping_objects",
+	"average_overlaps",
+	"average_depth",
+	"max_depth",
+}
+
+var ClusteringInformationColTypes = []types.Type{
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_int64, 0, 0),
+	types.New(types.T_int64, 0, 0),
+	types.New(types.T_int64, 0, 0),
+	types.New(types.T_float64, 0, 0),
+	types.New(types.T_float64, 0, 0),
+	types.New(types.T_int64, 0, 0),
+}
+
+var ClusteringInformationColName2Index = map[string]int32{
+	"column_name":         0,
+	"total_objects":       1,
+	"empty_objects":       2,
+	"overlapping_objects": 3,
+	"average_overlaps":    4,
+	"average_depth":       5,
+	"max_depth":           6,
+}
+
+type ClusteringInformationColType int32
+
+const (
+	ClusteringInformationColTypeColumnName = iota
+	ClusteringInformationColTypeTotalObjects
+	ClusteringInformationColTypeEmptyObjects
+	ClusteringInformationColTypeOverlappingObjects
+	ClusteringInformationColTypeAverageOverlaps
+	ClusteringInformationColTypeAverageDepth
+	ClusteringInformationColTypeMaxDepth
+)
+
+// buildClusteringInformation builds clustering_information('[db.]table'),
+// which shows how well the objects of the table are clustered by every column
+// of its cluster by key, or of its primary key.
+func (builder *QueryBuilder) buildClusteringInformation(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) != 1 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "clustering_information: the table name is required")
+	}
+
+	colDefs := make([]*plan.ColDef, 0, len(ClusteringInformationColNames))
+	for i, name := range ClusteringInformationColNames {
+		colDefs = append(colDefs, &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:    int32(ClusteringInformationColTypes[i].Oid),
+				Width: ClusteringInformationColTypes[i].Width,
+				Scale: ClusteringInformationColTypes[i].Scale,
+			},
+		})
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "clustering_information",
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), nil
+}
@@ -286,6 +286,15 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		newNode.TblFuncExprList[idx] = DeepCopyExpr(expr)
 	}
 
+	for _, hint := range node.IndexHints {
+		newNode.IndexHints = append(newNode.IndexHints, &plan.IndexHint{
+			Type:       hint.Type,
+			Scope:      hint.Scope,
+			IndexNames: append([]string(nil), hint.IndexNames...),
+		})
+	}
+	newNode.HintWarnings = append(newNode.HintWarnings, node.HintWarnings...)
+
 	return newNode
 }
 
@@ -573,6 +582,7 @@ func DeepCopyQuery(qry *plan.Query) *plan.Query {
 		Nodes:    make([]*plan.Node, len(qry.Nodes)),
 		Params:   make([]*plan.Expr, len(qry.Params)),
 		Headings: qry.Headings,
+		Dop:      qry.Dop,
 	}
 	for idx, param := range qry.Params {
 		newQry.Params[idx] = DeepCopyExpr(param)
@@ -0,0 +1,309 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// PlanJSON is the document of EXPLAIN FORMAT=JSON. Its field names are part
+// of the output and must not change.
+type PlanJSON struct {
+	Plans []StepJSON `json:"plans"`
+}
+
+// StepJSON is one step of a query plan, a tree of operators.
+type StepJSON struct {
+	Step int       `json:"step"`
+	Root *NodeJSON `json:"root"`
+}
+
+// NodeJSON is an operator of the plan. Details holds the lines the text
+// format prints under the operator, such as "Filter Cond", split into name
+// and value.
+type NodeJSON struct {
+	Id          int32        `json:"id"`
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	Estimate    EstimateJSON `json:"estimate"`
+	Analyze     *AnalyzeJSON `json:"analyze,omitempty"`
+	Details     []DetailJSON `json:"details,omitempty"`
+	SourceSteps []int32      `json:"source_steps,omitempty"`
+	Children    []*NodeJSON  `json:"children,omitempty"`
+}
+
+// EstimateJSON are the statistics the optimizer estimated for an operator.
+type EstimateJSON struct {
+	Cost        float64 `json:"cost"`
+	Rows        float64 `json:"rows"`
+	Selectivity float64 `json:"selectivity"`
+	BlockNum    int32   `json:"block_num"`
+	TableRows   float64 `json:"table_rows"`
+	RowSize     float64 `json:"row_size"`
+	HashmapSize float64 `json:"hashmap_size"`
+}
+
+// AnalyzeJSON are the runtime metrics EXPLAIN ANALYZE measured for an
+// operator. Times are in nanoseconds and sizes in bytes.
+type AnalyzeJSON struct {
+	TimeConsumed    int64 `json:"time_consumed"`
+	WaitTime        int64 `json:"wait_time"`
+	InputRows       int64 `json:"input_rows"`
+	OutputRows      int64 `json:"output_rows"`
+	InputSize       int64 `json:"input_size"`
+	OutputSize      int64 `json:"output_size"`
+	MemorySize      int64 `json:"memory_size"`
+	DiskIO          int64 `json:"disk_io"`
+	S3IOByte        int64 `json:"s3_io_byte"`
+	S3IOInputCount  int64 `json:"s3_io_input_count"`
+	S3IOOutputCount int64 `json:"s3_io_output_count"`
+	NetworkIO       int64 `json:"network_io"`
+	ScanTime        int64 `json:"scan_time"`
+	InsertTime      int64 `json:"insert_time"`
+}
+
+// DetailJSON is a line of the text format such as "Filter Cond: a > 1".
+type DetailJSON struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// BuildPlanJSON converts query to the document of EXPLAIN FORMAT=JSON.
+func BuildPlanJSON(ctx context.Context, query *plan.Query, options *ExplainOptions) (*PlanJSON, error) {
+	doc := &PlanJSON{Plans: make([]StepJSON, 0, len(query.Steps))}
+	for i, rootID := range query.Steps {
+		root, err := buildNodeJSON(ctx, rootID, query.Nodes, options)
+		if err != nil {
+			return nil, err
+		}
+		doc.Plans = append(doc.Plans, StepJSON{Step: i, Root: root})
+	}
+	return doc, nil
+}
+
+func buildNodeJSON(ctx context.Context, nodeID int32, nodes []*plan.Node, options *ExplainOptions) (*NodeJSON, error) {
+	index, err := serachNodeIndex(ctx, nodeID, nodes)
+	if err != nil {
+		return nil, err
+	}
+	node := nodes[index]
+
+	ret := &NodeJSON{
+		Id:          node.NodeId,
+		Type:        node.NodeType.String(),
+		Estimate:    estimateOf(node.Stats),
+		SourceSteps: node.SourceStep,
+	}
+	if options.Analyze && node.AnalyzeInfo != nil {
+		ret.Analyze = analyzeOf(node.AnalyzeInfo)
+	}
+	if ret.Title, err = nodeTitle(ctx, node, options); err != nil {
+		return nil, err
+	}
+	lines, err := nodeDetails(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		name, value, _ := strings.Cut(line, ": ")
+		ret.Details = append(ret.Details, DetailJSON{Name: name, Value: value})
+	}
+
+	for _, childID := range node.Children {
+		child, err := buildNodeJSON(ctx, childID, nodes, options)
+		if err != nil {
+			return nil, err
+		}
+		ret.Children = append(ret.Children, child)
+	}
+	return ret, nil
+}
+
+func estimateOf(stats *plan.Stats) EstimateJSON {
+	if stats == nil {
+		return EstimateJSON{}
+	}
+	return EstimateJSON{
+		Cost:        stats.Cost,
+		Rows:        stats.Outcnt,
+		Selectivity: stats.Selectivity,
+		BlockNum:    stats.BlockNum,
+		TableRows:   stats.TableCnt,
+		RowSize:     stats.Rowsize,
+		HashmapSize: stats.GetHashmapStats().GetHashmapSize(),
+	}
+}
+
+func analyzeOf(info *plan.AnalyzeInfo) *AnalyzeJSON {
+	return &AnalyzeJSON{
+		TimeConsumed:    info.TimeConsumed,
+		WaitTime:        info.WaitTimeConsumed,
+		InputRows:       info.InputRows,
+		OutputRows:      info.OutputRows,
+		InputSize:       info.InputSize,
+		OutputSize:      info.OutputSize,
+		MemorySize:      info.MemorySize,
+		DiskIO:          info.DiskIO,
+		S3IOByte:        info.S3IOByte,
+		S3IOInputCount:  info.S3IOInputCount,
+		S3IOOutputCount: info.S3IOOutputCount,
+		NetworkIO:       info.NetworkIO,
+		ScanTime:        info.ScanTime,
+		InsertTime:      info.InsertTime,
+	}
+}
+
+// textOptions returns options for the describers of the text format, which
+// the other formats reuse for the operator names and expressions.
+func textOptions(options *ExplainOptions) *ExplainOptions {
+	ret := *options
+	ret.Format = EXPLAIN_FORMAT_TEXT
+	return &ret
+}
+
+// nodeTitle returns the first line the text format prints for node, such as
+// "Table Scan on db.t", without the costs.
+func nodeTitle(ctx context.Context, node *plan.Node, options *ExplainOptions) (string, error) {
+	opts := textOptions(options)
+	opts.Verbose = false
+	return NewNodeDescriptionImpl(node).GetNodeBasicInfo(ctx, opts)
+}
+
+// nodeDetails returns the lines the text format prints under node, but for
+// the ANALYZE one.
+func nodeDetails(ctx context.Context, node *plan.Node, options *ExplainOptions) ([]string, error) {
+	opts := textOptions(options)
+	desc := NewNodeDescriptionImpl(node)
+
+	var lines []string
+	if opts.Verbose {
+		if node.GetProjectList() != nil {
+			line, err := desc.GetProjectListInfo(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, line)
+		}
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil {
+			line, err := desc.GetTableDef(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, line)
+		}
+	}
+	extraInfo, err := desc.GetExtraInfo(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(lines, extraInfo...), nil
+}
+
+// explainPlanJSON writes the plan as a single JSON document.
+func (e *ExplainQueryImpl) explainPlanJSON(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	doc, err := BuildPlanJSON(ctx, e.QueryPlan, options)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	buffer.pushDocument(string(data))
+	return nil
+}
+
+// explainPlanDot writes the plan as a Graphviz digraph, an edge going from
+// each operator to the one consuming its output, labeled with the rows it
+// passes on: the actual ones with ANALYZE, the estimated ones otherwise.
+func (e *ExplainQueryImpl) explainPlanDot(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	doc, err := BuildPlanJSON(ctx, e.QueryPlan, options)
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	buf.WriteString("digraph plan {\n")
+	buf.WriteString("  node [shape=box, fontname=\"Helvetica\"];\n")
+	buf.WriteString("  rankdir=BT;\n")
+	for _, step := range doc.Plans {
+		if len(doc.Plans) > 1 {
+			fmt.Fprintf(buf, "  subgraph cluster_%d {\n    label=\"Plan %d\";\n", step.Step, step.Step)
+		}
+		writeDotNode(buf, step.Root, options)
+		if len(doc.Plans) > 1 {
+			buf.WriteString("  }\n")
+		}
+	}
+	// the steps feeding a sink or recursive scan
+	for _, step := range doc.Plans {
+		writeDotSourceEdges(buf, step.Root, doc.Plans)
+	}
+	buf.WriteString("}")
+
+	buffer.pushDocument(buf.String())
+	return nil
+}
+
+func writeDotNode(buf *bytes.Buffer, node *NodeJSON, options *ExplainOptions) {
+	label := []string{node.Title}
+	if options.Verbose || options.Analyze {
+		label = append(label, fmt.Sprintf("cost=%s rows=%s",
+			strconv.FormatFloat(node.Estimate.Cost, 'f', 2, 64),
+			strconv.FormatFloat(node.Estimate.Rows, 'f', 2, 64)))
+	}
+	if node.Analyze != nil {
+		label = append(label, fmt.Sprintf("time=%dns rows=%d memory=%dbytes",
+			node.Analyze.TimeConsumed, node.Analyze.OutputRows, node.Analyze.MemorySize))
+	}
+	for _, detail := range node.Details {
+		label = append(label, detail.Name+": "+detail.Value)
+	}
+	fmt.Fprintf(buf, "  n%d [label=\"%s\\l\"];\n", node.Id, dotEscape(strings.Join(label, "\n")))
+
+	for _, child := range node.Children {
+		writeDotNode(buf, child, options)
+		rows := strconv.FormatFloat(child.Estimate.Rows, 'f', 0, 64)
+		if child.Analyze != nil {
+			rows = strconv.FormatInt(child.Analyze.OutputRows, 10)
+		}
+		fmt.Fprintf(buf, "  n%d -> n%d [label=\"%s\"];\n", child.Id, node.Id, rows)
+	}
+}
+
+func writeDotSourceEdges(buf *bytes.Buffer, node *NodeJSON, steps []StepJSON) {
+	for _, source := range node.SourceSteps {
+		if source >= 0 && int(source) < len(steps) {
+			fmt.Fprintf(buf, "  n%d -> n%d [style=dashed];\n", steps[source].Root.Id, node.Id)
+		}
+	}
+	for _, child := range node.Children {
+		writeDotSourceEdges(buf, child, steps)
+	}
+}
+
+// dotEscape quotes s for a DOT string, its lines left aligned.
+func dotEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "\n", `\l`)
+}
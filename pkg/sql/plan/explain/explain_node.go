@@ -255,6 +255,19 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, partPruneInfo)
 	}
 
+	// Get index hint info
+	if len(ndesc.Node.IndexHints) > 0 {
+		hintInfo, err := ndesc.GetIndexHintInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, hintInfo)
+	}
+
+	for _, warning := range ndesc.Node.HintWarnings {
+		lines = append(lines, "Hint Warning: "+warning)
+	}
+
 	// Get Sort list info
 	if len(ndesc.Node.OrderBy) > 0 {
 		orderByInfo, err := ndesc.GetOrderByInfo(ctx, options)
@@ -337,6 +350,10 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	if ndesc.Node.LateMaterialize && options.Verbose {
+		lines = append(lines, "Late Materialization: true")
+	}
+
 	// Get Block Filter list info
 	if len(ndesc.Node.BlockFilterList) > 0 {
 		filterInfo, err := ndesc.GetBlockFilterConditionInfo(ctx, options)
@@ -522,6 +539,24 @@ func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, optio
 	return buf.String(), nil
 }
 
+func (ndesc *NodeDescribeImpl) GetIndexHintInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 100))
+	buf.WriteString("Index Hint: ")
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		for i, hint := range ndesc.Node.IndexHints {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(plan2.FormatIndexHint(hint))
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return "", moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return "", moerr.NewNYI(ctx, "explain format dot")
+	}
+	return buf.String(), nil
+}
+
 func (ndesc *NodeDescribeImpl) GetBlockFilterConditionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Block Filter Cond: ")
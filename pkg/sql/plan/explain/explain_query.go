@@ -39,6 +39,13 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return e.explainPlanJSON(ctx, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return e.explainPlanDot(ctx, buffer, options)
+	}
+
 	nodes := e.QueryPlan.Nodes
 
 	isForest := false
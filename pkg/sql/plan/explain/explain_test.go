@@ -16,14 +16,17 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSingleSql(t *testing.T) {
@@ -297,6 +300,92 @@ func TestCompositeUniqueIndexTableDeleteSQL(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestIndexHintQuery(t *testing.T) {
+	sqls := []string{
+		"explain verbose select * from test_idx use index (idx1) where n_nationkey = 1",
+		"explain select * from test_idx ignore index for join (idx1) where n_nationkey = 1",
+		"explain select n_name from test_idx force index for group by (idx1) group by n_name",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+
+	node := &pb.Node{
+		NodeType: pb.Node_TABLE_SCAN,
+		TableDef: &pb.TableDef{Name: "t"},
+		IndexHints: []*pb.IndexHint{
+			{Type: pb.IndexHint_FORCE, Scope: pb.IndexHint_ALL, IndexNames: []string{"a", "b"}},
+			{Type: pb.IndexHint_IGNORE, Scope: pb.IndexHint_ORDER_BY, IndexNames: []string{"c"}},
+		},
+		HintWarnings: []string{"FORCE INDEX (a, b) could not be honored"},
+	}
+	lines, err := NewNodeDescriptionImpl(node).GetExtraInfo(context.TODO(), NewExplainDefaultOptions())
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Index Hint: FORCE INDEX (a, b), IGNORE INDEX FOR ORDER BY (c)",
+		"Hint Warning: FORCE INDEX (a, b) could not be honored",
+	}, lines)
+}
+
+func TestExplainFormats(t *testing.T) {
+	sqls := []string{
+		"explain (format json) select n_name, count(*) from nation, region where n_regionkey = r_regionkey group by n_name",
+		"explain format = 'json' with t as (select * from nation) select * from t union all select * from t",
+		"explain (verbose true, format dot) select * from nation where n_name like 'A%' order by n_name",
+		"explain format = 'dot' with t as (select * from nation) select * from t union all select * from t",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+
+	ctx := context.TODO()
+	query := &pb.Query{
+		Steps: []int32{1},
+		Nodes: []*pb.Node{
+			{
+				NodeId:      0,
+				NodeType:    pb.Node_TABLE_SCAN,
+				TableDef:    &pb.TableDef{Name: "t"},
+				ObjRef:      &pb.ObjectRef{SchemaName: "db", ObjName: "t"},
+				Stats:       &pb.Stats{Cost: 100, Outcnt: 10, BlockNum: 1},
+				AnalyzeInfo: &pb.AnalyzeInfo{OutputRows: 8, TimeConsumed: 1000},
+			},
+			{
+				NodeId:   1,
+				NodeType: pb.Node_PROJECT,
+				Children: []int32{0},
+				Stats:    &pb.Stats{Cost: 10, Outcnt: 10},
+			},
+		},
+	}
+	options := &ExplainOptions{Analyze: true, Format: EXPLAIN_FORMAT_JSON}
+
+	doc, err := BuildPlanJSON(ctx, query, options)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doc.Plans))
+	root := doc.Plans[0].Root
+	require.Equal(t, "PROJECT", root.Type)
+	require.Nil(t, root.Analyze)
+	scan := root.Children[0]
+	require.Equal(t, "Table Scan on db.t", scan.Title)
+	require.Equal(t, EstimateJSON{Cost: 100, Rows: 10, BlockNum: 1}, scan.Estimate)
+	require.Equal(t, int64(8), scan.Analyze.OutputRows)
+
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	require.Equal(t, 1, len(buffer.Lines))
+	var parsed PlanJSON
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), &parsed))
+	require.Equal(t, *doc, parsed)
+
+	options.Format = EXPLAIN_FORMAT_DOT
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	require.Equal(t, 1, len(buffer.Lines))
+	dot := buffer.Lines[0]
+	require.True(t, strings.HasPrefix(dot, "digraph plan {"))
+	require.Contains(t, dot, `n0 [label="Table Scan on db.t\lcost=100.00 rows=10.00\ltime=1000ns rows=8 memory=0bytes\l"];`)
+	require.Contains(t, dot, `n0 -> n1 [label="8"];`)
+}
+
 func TestMultiTableDeleteSQL(t *testing.T) {
 	sqls := []string{
 		"explain verbose delete emp,dept from emp ,dept where emp.deptno = dept.deptno and emp.deptno = 10",
@@ -354,6 +443,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
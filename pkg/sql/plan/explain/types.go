@@ -120,6 +120,17 @@ func (buf *ExplainDataBuffer) PushPlanTitle(title string) {
 	buf.End++
 }
 
+// pushDocument adds a document of the JSON or DOT format, which is output as
+// a single row.
+func (buf *ExplainDataBuffer) pushDocument(doc string) {
+	if buf.Start == -1 {
+		buf.Start++
+	}
+	buf.CurrentLine++
+	buf.Lines = append(buf.Lines, doc)
+	buf.End++
+}
+
 func (buf *ExplainDataBuffer) ToString() string {
 	strBuffer := bytes.NewBufferString("")
 	rs := buf.Lines
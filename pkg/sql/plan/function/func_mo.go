@@ -557,6 +557,10 @@ var (
 		"mo_pubs":                     0,
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
+		"mo_binlog_positions":         0,
+		"mo_column_stats":             0,
+		"mo_plan_baselines":           0,
+		"mo_scrub_history":            0,
 	}
 )
 
@@ -0,0 +1,294 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+)
+
+const (
+	// DefaultHistogramBuckets is the number of buckets of the histograms kept
+	// in the stats info.
+	DefaultHistogramBuckets = 64
+	// DefaultMCVSize is the number of most common values ANALYZE TABLE keeps
+	// for a column.
+	DefaultMCVSize = 32
+	// DefaultHistogramSampleRows is the number of rows ANALYZE TABLE samples
+	// to build the histogram of a column.
+	DefaultHistogramSampleRows = 10000
+)
+
+// IsHistogramType returns if histograms are kept for columns of type typ.
+func IsHistogramType(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_date, types.T_time, types.T_timestamp, types.T_datetime:
+		return true
+	}
+	return false
+}
+
+// HistogramValue converts a value of a histogram type to the float64 encoding
+// used by the stats info.
+func HistogramValue(typ types.T, buf []byte) float64 {
+	switch typ {
+	case types.T_int8:
+		return float64(types.DecodeInt8(buf))
+	case types.T_int16:
+		return float64(types.DecodeInt16(buf))
+	case types.T_int32:
+		return float64(types.DecodeInt32(buf))
+	case types.T_int64:
+		return float64(types.DecodeInt64(buf))
+	case types.T_uint8:
+		return float64(types.DecodeUint8(buf))
+	case types.T_uint16:
+		return float64(types.DecodeUint16(buf))
+	case types.T_uint32:
+		return float64(types.DecodeUint32(buf))
+	case types.T_uint64:
+		return float64(types.DecodeUint64(buf))
+	case types.T_date:
+		return float64(types.DecodeDate(buf))
+	case types.T_time:
+		return float64(types.DecodeTime(buf))
+	case types.T_timestamp:
+		return float64(types.DecodeTimestamp(buf))
+	case types.T_datetime:
+		return float64(types.DecodeDatetime(buf))
+	}
+	return 0
+}
+
+// HistogramBuilder collects value ranges, each holding rows spread uniformly
+// over it, and turns them into an equi-depth histogram. A range whose min
+// equals its max is a single value repeated, so object zonemaps and sampled
+// values are handled alike.
+type HistogramBuilder struct {
+	mins []float64
+	maxs []float64
+	rows []float64
+}
+
+func NewHistogramBuilder() *HistogramBuilder {
+	return &HistogramBuilder{}
+}
+
+// Update adds a range of rows values lying in [min, max].
+func (hb *HistogramBuilder) Update(min, max float64, rows int64) {
+	if rows <= 0 || math.IsNaN(min) || math.IsNaN(max) {
+		return
+	}
+	if max < min {
+		min, max = max, min
+	}
+	hb.mins = append(hb.mins, min)
+	hb.maxs = append(hb.maxs, max)
+	hb.rows = append(hb.rows, float64(rows))
+}
+
+// Build returns the equi-depth histogram of the ranges added so far, nil if
+// there is none.
+func (hb *HistogramBuilder) Build(buckets int) *pb.Histogram {
+	var total float64
+	for _, r := range hb.rows {
+		total += r
+	}
+	if total == 0 || buckets <= 0 {
+		return nil
+	}
+
+	// the cumulative distribution is piecewise linear between the ends of
+	// the ranges, with a step wherever a single value is repeated.
+	xs := make([]float64, 0, 2*len(hb.mins))
+	xs = append(xs, hb.mins...)
+	xs = append(xs, hb.maxs...)
+	slices.Sort(xs)
+	xs = slices.Compact(xs)
+	point := make([]float64, len(xs))
+	slope := make([]float64, len(xs))
+	for i := range hb.mins {
+		lo, _ := slices.BinarySearch(xs, hb.mins[i])
+		if hb.maxs[i] == hb.mins[i] {
+			point[lo] += hb.rows[i]
+			continue
+		}
+		hi, _ := slices.BinarySearch(xs, hb.maxs[i])
+		density := hb.rows[i] / (hb.maxs[i] - hb.mins[i])
+		slope[lo] += density
+		slope[hi] -= density
+	}
+
+	depth := total / float64(buckets)
+	eps := total * 1e-9
+	h := &pb.Histogram{
+		Bounds: make([]float64, 0, buckets+1),
+		Counts: make([]float64, buckets),
+	}
+	h.Bounds = append(h.Bounds, xs[0])
+	next, cum, density := depth, float64(0), float64(0)
+	for k := range xs {
+		cum += point[k]
+		for next <= cum+eps && len(h.Bounds) < buckets {
+			h.Bounds = append(h.Bounds, xs[k])
+			next += depth
+		}
+		if k == len(xs)-1 {
+			break
+		}
+		density += slope[k]
+		seg := density * (xs[k+1] - xs[k])
+		for next <= cum+seg+eps && len(h.Bounds) < buckets {
+			bound := xs[k] + (next-cum)/density
+			h.Bounds = append(h.Bounds, math.Max(xs[k], math.Min(bound, xs[k+1])))
+			next += depth
+		}
+		cum += seg
+	}
+	for len(h.Bounds) <= buckets {
+		h.Bounds = append(h.Bounds, xs[len(xs)-1])
+	}
+	for i := range h.Counts {
+		h.Counts[i] = depth
+	}
+	return h
+}
+
+// histogramCDF returns the fraction of the rows of h whose value is not
+// greater than v. For discrete types a bucket [lo, hi] holds hi-lo+1 values.
+func histogramCDF(h *pb.Histogram, v float64, discrete bool) float64 {
+	var total, below float64
+	for i, c := range h.Counts {
+		total += c
+		lo, hi := h.Bounds[i], h.Bounds[i+1]
+		switch {
+		case v >= hi:
+			below += c
+		case v < lo:
+		case discrete:
+			below += c * (math.Floor(v) - lo + 1) / (hi - lo + 1)
+		default:
+			below += c * (v - lo) / (hi - lo)
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return below / total
+}
+
+// calcSelectivityByHistogram estimates the fraction of the non-null rows of
+// a column matching a range filter with the histogram of the column.
+func calcSelectivityByHistogram(funcName string, h *pb.Histogram, typ types.T, vals []*plan.Literal) (ret float64, ok bool) {
+	if h == nil || len(h.Counts) == 0 || len(h.Bounds) != len(h.Counts)+1 {
+		return 0, false
+	}
+	discrete := typ.IsInteger() || typ.IsDateRelate()
+	below := func(v float64) float64 {
+		if discrete {
+			return histogramCDF(h, v-1, discrete)
+		}
+		return histogramCDF(h, math.Nextafter(v, math.Inf(-1)), discrete)
+	}
+	value := func(i int) (float64, bool) {
+		if i >= len(vals) || vals[i] == nil {
+			return 0, false
+		}
+		return getFloat64Value(typ, vals[i])
+	}
+
+	switch funcName {
+	case ">":
+		if v, ok := value(0); ok {
+			return 1 - histogramCDF(h, v, discrete), true
+		}
+	case ">=":
+		if v, ok := value(0); ok {
+			return 1 - below(v), true
+		}
+	case "<":
+		if v, ok := value(0); ok {
+			return below(v), true
+		}
+	case "<=":
+		if v, ok := value(0); ok {
+			return histogramCDF(h, v, discrete), true
+		}
+	case "between":
+		if lb, ok := value(0); ok {
+			if ub, ok := value(1); ok {
+				return math.Max(histogramCDF(h, ub, discrete)-below(lb), 0), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// calcSelectivityByMCV estimates the fraction of the rows of a column equal
+// to v with its most common values. A value which is not a common one gets an
+// even share of what the common values leave to the other distinct values.
+func calcSelectivityByMCV(m *pb.MCVList, v, ndv, nullRatio float64) float64 {
+	var common float64
+	for i, val := range m.Vals {
+		if val == v {
+			return m.Freqs[i]
+		}
+		common += m.Freqs[i]
+	}
+	rest := 1 - common - nullRatio
+	if rest <= 0 {
+		return 0
+	}
+	ndv -= float64(len(m.Vals))
+	if ndv < 1 {
+		ndv = 1
+	}
+	return rest / ndv
+}
+
+// WithAnalyzedStats returns a copy of s whose histograms and most common
+// values are replaced by the ones collected by ANALYZE TABLE. s itself may be
+// shared by the engine and is left untouched.
+func WithAnalyzedStats(s *pb.StatsInfo, hists map[string]*pb.Histogram, mcvs map[string]*pb.MCVList) *pb.StatsInfo {
+	if s == nil || (len(hists) == 0 && len(mcvs) == 0) {
+		return s
+	}
+	ret := *s
+	ret.HistogramMap = make(map[string]*pb.Histogram, len(s.HistogramMap)+len(hists))
+	for k, v := range s.HistogramMap {
+		ret.HistogramMap[k] = v
+	}
+	for k, v := range hists {
+		if _, ok := s.DataTypeMap[k]; ok && v != nil {
+			ret.HistogramMap[k] = v
+		}
+	}
+	ret.MCVMap = make(map[string]*pb.MCVList, len(s.MCVMap)+len(mcvs))
+	for k, v := range s.MCVMap {
+		ret.MCVMap[k] = v
+	}
+	for k, v := range mcvs {
+		if _, ok := s.DataTypeMap[k]; ok && v != nil {
+			ret.MCVMap[k] = v
+		}
+	}
+	return &ret
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func i64Lit(v int64) *plan.Literal {
+	return &plan.Literal{Value: &plan.Literal_I64Val{I64Val: v}}
+}
+
+func TestHistogramBuilder(t *testing.T) {
+	hb := NewHistogramBuilder()
+	require.Nil(t, hb.Build(4))
+
+	// two objects of 100 rows over [0, 100] and [100, 200]
+	hb.Update(0, 100, 100)
+	hb.Update(100, 200, 100)
+	h := hb.Build(4)
+	require.Equal(t, []float64{0, 50, 100, 150, 200}, h.Bounds)
+	require.Equal(t, []float64{50, 50, 50, 50}, h.Counts)
+
+	// a skewed column: most rows hold a single value
+	hb = NewHistogramBuilder()
+	hb.Update(10, 10, 300)
+	hb.Update(0, 100, 100)
+	h = hb.Build(4)
+	require.Equal(t, 5, len(h.Bounds))
+	require.Equal(t, float64(0), h.Bounds[0])
+	require.Equal(t, float64(10), h.Bounds[1])
+	require.Equal(t, float64(10), h.Bounds[2])
+	require.Equal(t, float64(10), h.Bounds[3])
+	require.Equal(t, float64(100), h.Bounds[4])
+
+	// sampled values
+	hb = NewHistogramBuilder()
+	for i := 0; i < 1000; i++ {
+		hb.Update(float64(i), float64(i), 1)
+	}
+	h = hb.Build(10)
+	require.Equal(t, 11, len(h.Bounds))
+	for i := 1; i < len(h.Bounds); i++ {
+		require.LessOrEqual(t, h.Bounds[i-1], h.Bounds[i])
+	}
+	require.Equal(t, float64(0), h.Bounds[0])
+	require.Equal(t, float64(999), h.Bounds[10])
+}
+
+func TestCalcSelectivityByHistogram(t *testing.T) {
+	_, ok := calcSelectivityByHistogram(">", nil, types.T_int64, []*plan.Literal{i64Lit(1)})
+	require.False(t, ok)
+
+	// 900 rows equal to 1, 100 rows spread over [2, 1001]
+	hb := NewHistogramBuilder()
+	hb.Update(1, 1, 900)
+	hb.Update(2, 1001, 100)
+	h := hb.Build(DefaultHistogramBuckets)
+
+	sel, ok := calcSelectivityByHistogram("<=", h, types.T_int64, []*plan.Literal{i64Lit(1)})
+	require.True(t, ok)
+	require.InDelta(t, 0.9, sel, 0.02)
+
+	sel, ok = calcSelectivityByHistogram(">", h, types.T_int64, []*plan.Literal{i64Lit(501)})
+	require.True(t, ok)
+	require.InDelta(t, 0.05, sel, 0.02)
+
+	sel, ok = calcSelectivityByHistogram("<", h, types.T_int64, []*plan.Literal{i64Lit(1)})
+	require.True(t, ok)
+	require.InDelta(t, 0, sel, 0.001)
+
+	sel, ok = calcSelectivityByHistogram(">=", h, types.T_int64, []*plan.Literal{i64Lit(0)})
+	require.True(t, ok)
+	require.InDelta(t, 1, sel, 0.001)
+
+	sel, ok = calcSelectivityByHistogram("between", h, types.T_int64, []*plan.Literal{i64Lit(2), i64Lit(1001)})
+	require.True(t, ok)
+	require.InDelta(t, 0.1, sel, 0.02)
+
+	_, ok = calcSelectivityByHistogram("between", h, types.T_int64, []*plan.Literal{i64Lit(2), nil})
+	require.False(t, ok)
+
+	// the min/max estimation sees a uniform column
+	require.InDelta(t, 0.5, calcSelectivityByMinMax(">", 1, 1001, types.T_int64, []*plan.Literal{i64Lit(501)}), 0.01)
+}
+
+func TestCalcSelectivityByMCV(t *testing.T) {
+	m := &pb.MCVList{
+		Vals:  []float64{1, 2},
+		Freqs: []float64{0.5, 0.3},
+	}
+	require.Equal(t, 0.5, calcSelectivityByMCV(m, 1, 12, 0))
+	require.Equal(t, 0.3, calcSelectivityByMCV(m, 2, 12, 0))
+	// the other 10 distinct values share the remaining 20% of the rows
+	require.InDelta(t, 0.02, calcSelectivityByMCV(m, 3, 12, 0), 1e-9)
+	require.InDelta(t, 0.01, calcSelectivityByMCV(m, 3, 12, 0.1), 1e-9)
+	require.Equal(t, float64(0), calcSelectivityByMCV(m, 3, 12, 0.2))
+}
+
+func TestWithAnalyzedStats(t *testing.T) {
+	s := NewStatsInfo()
+	s.DataTypeMap["a"] = uint64(types.T_int64)
+	s.DataTypeMap["b"] = uint64(types.T_int64)
+	zmHist := &pb.Histogram{Bounds: []float64{0, 10}, Counts: []float64{10}}
+	s.HistogramMap["a"] = zmHist
+	s.HistogramMap["b"] = zmHist
+
+	require.Equal(t, s, WithAnalyzedStats(s, nil, nil))
+
+	hist := &pb.Histogram{Bounds: []float64{0, 5}, Counts: []float64{10}}
+	mcv := &pb.MCVList{Vals: []float64{1}, Freqs: []float64{0.5}}
+	ret := WithAnalyzedStats(s,
+		map[string]*pb.Histogram{"a": hist, "dropped": hist},
+		map[string]*pb.MCVList{"a": mcv})
+	require.Equal(t, hist, ret.HistogramMap["a"])
+	require.Equal(t, zmHist, ret.HistogramMap["b"])
+	require.Equal(t, mcv, ret.MCVMap["a"])
+	require.NotContains(t, ret.HistogramMap, "dropped")
+	// the stats shared by the engine are left untouched
+	require.Equal(t, zmHist, s.HistogramMap["a"])
+	require.Empty(t, s.MCVMap)
+}
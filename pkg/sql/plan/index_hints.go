@@ -0,0 +1,197 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const primaryIndexName = "primary"
+
+// buildIndexHints converts the USE/IGNORE/FORCE INDEX hints of a table scan.
+// As in MySQL, naming an index the table does not have is an error.
+func (builder *QueryBuilder) buildIndexHints(tableDef *plan.TableDef, hints []*tree.IndexHint) ([]*plan.IndexHint, error) {
+	ret := make([]*plan.IndexHint, 0, len(hints))
+	for _, hint := range hints {
+		h := &plan.IndexHint{
+			IndexNames: make([]string, 0, len(hint.IndexNames)),
+		}
+		switch hint.HintType {
+		case tree.HintUse:
+			h.Type = plan.IndexHint_USE
+		case tree.HintIgnore:
+			h.Type = plan.IndexHint_IGNORE
+		case tree.HintForce:
+			h.Type = plan.IndexHint_FORCE
+		}
+		switch hint.HintScope {
+		case tree.HintForJoin:
+			h.Scope = plan.IndexHint_JOIN
+		case tree.HintForOrderBy:
+			h.Scope = plan.IndexHint_ORDER_BY
+		case tree.HintForGroupBy:
+			h.Scope = plan.IndexHint_GROUP_BY
+		default:
+			h.Scope = plan.IndexHint_ALL
+		}
+		for _, name := range hint.IndexNames {
+			if !tableHasIndex(tableDef, name) {
+				return nil, moerr.NewErrKeyDoesNotExist(builder.GetContext(), name, tableDef.Name)
+			}
+			h.IndexNames = append(h.IndexNames, strings.ToLower(name))
+		}
+		ret = append(ret, h)
+	}
+	return ret, nil
+}
+
+func tableHasIndex(tableDef *plan.TableDef, name string) bool {
+	if strings.EqualFold(name, primaryIndexName) {
+		return tableDef.Pkey != nil && tableDef.Pkey.PkeyColName != catalog.FakePrimaryKeyColName
+	}
+	for _, indexDef := range tableDef.Indexes {
+		if strings.EqualFold(indexDef.IndexName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// indexHintsAllow returns if the index named name may be used for scope. The
+// USE and FORCE hints of a scope restrict the candidates to the indexes they
+// name, an empty list meaning none at all, and the IGNORE hints remove theirs.
+// A hint without a FOR clause applies to every scope.
+func indexHintsAllow(hints []*plan.IndexHint, scope plan.IndexHint_HintScope, name string) bool {
+	restricted, listed := false, false
+	for _, hint := range hints {
+		if hint.Scope != plan.IndexHint_ALL && hint.Scope != scope {
+			continue
+		}
+		named := hintNamesIndex(hint, name)
+		if hint.Type == plan.IndexHint_IGNORE {
+			if named {
+				return false
+			}
+			continue
+		}
+		restricted = true
+		listed = listed || named
+	}
+	return !restricted || listed
+}
+
+// allowedIndexes returns the indexes the hints let be used for scope.
+func allowedIndexes(hints []*plan.IndexHint, scope plan.IndexHint_HintScope, indexes []*plan.IndexDef) []*plan.IndexDef {
+	if len(hints) == 0 {
+		return indexes
+	}
+	ret := make([]*plan.IndexDef, 0, len(indexes))
+	for _, indexDef := range indexes {
+		if indexHintsAllow(hints, scope, indexDef.IndexName) {
+			ret = append(ret, indexDef)
+		}
+	}
+	return ret
+}
+
+// indexHintsForced returns if a FORCE INDEX hint applies to scope, in which
+// case a usable index is taken whatever its estimated cost.
+func indexHintsForced(hints []*plan.IndexHint, scope plan.IndexHint_HintScope) bool {
+	for _, hint := range hints {
+		if hint.Type == plan.IndexHint_FORCE && (hint.Scope == plan.IndexHint_ALL || hint.Scope == scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func hintNamesIndex(hint *plan.IndexHint, name string) bool {
+	for _, n := range hint.IndexNames {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// markIndexHintUsed records that the index named name of the table scanned
+// by node was picked for scope.
+func (builder *QueryBuilder) markIndexHintUsed(node *plan.Node, scope plan.IndexHint_HintScope, name string) {
+	if len(node.IndexHints) == 0 {
+		return
+	}
+	if builder.indexHintUsed == nil {
+		builder.indexHintUsed = make(map[[2]int32]string)
+	}
+	builder.indexHintUsed[[2]int32{node.BindingTags[0], int32(scope)}] = name
+}
+
+// checkIndexHints attaches a warning to the table scans whose index hints
+// could not be honored, so that EXPLAIN reports them.
+func (builder *QueryBuilder) checkIndexHints(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.checkIndexHints(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || len(node.IndexHints) == 0 {
+		return
+	}
+
+	usedFor := func(hint *plan.IndexHint, scope plan.IndexHint_HintScope) bool {
+		name, ok := builder.indexHintUsed[[2]int32{node.BindingTags[0], int32(scope)}]
+		return ok && hintNamesIndex(hint, name)
+	}
+	node.HintWarnings = node.HintWarnings[:0]
+	for _, hint := range node.IndexHints {
+		switch {
+		case hint.Type == plan.IndexHint_IGNORE:
+		case hint.Scope == plan.IndexHint_GROUP_BY:
+			node.HintWarnings = append(node.HintWarnings,
+				fmt.Sprintf("%s is not supported, indexes are not used for GROUP BY", FormatIndexHint(hint)))
+		case hint.Type == plan.IndexHint_FORCE:
+			var honored bool
+			if hint.Scope == plan.IndexHint_ALL {
+				honored = usedFor(hint, plan.IndexHint_JOIN) || usedFor(hint, plan.IndexHint_ORDER_BY)
+			} else {
+				honored = usedFor(hint, hint.Scope)
+			}
+			if !honored {
+				node.HintWarnings = append(node.HintWarnings,
+					fmt.Sprintf("%s could not be honored, no usable index for the query", FormatIndexHint(hint)))
+			}
+		}
+	}
+}
+
+// FormatIndexHint returns hint the way it is written in SQL.
+func FormatIndexHint(hint *plan.IndexHint) string {
+	var sb strings.Builder
+	sb.WriteString(hint.Type.String())
+	sb.WriteString(" INDEX")
+	if hint.Scope != plan.IndexHint_ALL {
+		sb.WriteString(" FOR ")
+		sb.WriteString(strings.ReplaceAll(hint.Scope.String(), "_", " "))
+	}
+	sb.WriteString(" (")
+	sb.WriteString(strings.Join(hint.IndexNames, ", "))
+	sb.WriteString(")")
+	return sb.String()
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexHintsAllow(t *testing.T) {
+	require.True(t, indexHintsAllow(nil, plan.IndexHint_JOIN, "a"))
+
+	hints := []*plan.IndexHint{
+		{Type: plan.IndexHint_USE, Scope: plan.IndexHint_ALL, IndexNames: []string{"a", "b"}},
+		{Type: plan.IndexHint_IGNORE, Scope: plan.IndexHint_ORDER_BY, IndexNames: []string{"b"}},
+	}
+	require.True(t, indexHintsAllow(hints, plan.IndexHint_JOIN, "A"))
+	require.True(t, indexHintsAllow(hints, plan.IndexHint_JOIN, "b"))
+	require.False(t, indexHintsAllow(hints, plan.IndexHint_JOIN, "c"))
+	require.False(t, indexHintsAllow(hints, plan.IndexHint_ORDER_BY, "b"))
+	require.False(t, indexHintsForced(hints, plan.IndexHint_JOIN))
+
+	// USE INDEX () means no index at all
+	hints = []*plan.IndexHint{{Type: plan.IndexHint_USE, Scope: plan.IndexHint_JOIN}}
+	require.False(t, indexHintsAllow(hints, plan.IndexHint_JOIN, "a"))
+	require.True(t, indexHintsAllow(hints, plan.IndexHint_ORDER_BY, "a"))
+
+	hints = []*plan.IndexHint{{Type: plan.IndexHint_FORCE, Scope: plan.IndexHint_JOIN, IndexNames: []string{"a"}}}
+	require.True(t, indexHintsForced(hints, plan.IndexHint_JOIN))
+	require.False(t, indexHintsForced(hints, plan.IndexHint_ORDER_BY))
+	require.Equal(t, "FORCE INDEX FOR JOIN (a)", FormatIndexHint(hints[0]))
+
+	indexes := []*plan.IndexDef{{IndexName: "a"}, {IndexName: "b"}}
+	require.Equal(t, indexes[:1], allowedIndexes(hints, plan.IndexHint_JOIN, indexes))
+	require.Equal(t, indexes, allowedIndexes(hints, plan.IndexHint_ORDER_BY, indexes))
+}
+
+func TestBuildIndexHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	_, err := runOneStmt(mock, t, "select * from test_idx force index (idx9) where n_nationkey = 1")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrKeyDoesNotExist))
+
+	findScan := func(p *Plan) *plan.Node {
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == "test_idx" {
+				return node
+			}
+		}
+		return nil
+	}
+
+	p, err := runOneStmt(mock, t, "select * from test_idx use index (IDX1) ignore index for order by (idx1) where n_nationkey = 1")
+	require.NoError(t, err)
+	scan := findScan(p)
+	require.Equal(t, 2, len(scan.IndexHints))
+	require.Equal(t, []string{"idx1"}, scan.IndexHints[0].IndexNames)
+	require.Equal(t, plan.IndexHint_IGNORE, scan.IndexHints[1].Type)
+	require.Equal(t, plan.IndexHint_ORDER_BY, scan.IndexHints[1].Scope)
+	require.Empty(t, scan.HintWarnings)
+
+	// no index serves a range filter, nor a GROUP BY
+	p, err = runOneStmt(mock, t, "select n_name from test_idx force index (idx1) force index for group by (idx1) where n_nationkey > 1 group by n_name")
+	require.NoError(t, err)
+	scan = findScan(p)
+	require.Equal(t, 2, len(scan.HintWarnings))
+	require.Contains(t, scan.HintWarnings[0], "FORCE INDEX (idx1) could not be honored")
+	require.Contains(t, scan.HintWarnings[1], "FORCE INDEX FOR GROUP BY (idx1) is not supported")
+}
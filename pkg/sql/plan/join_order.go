@@ -217,6 +217,19 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	leaves, conds := builder.gatherJoinLeavesAndConds(node, nil, nil)
 	newConds := deduceNewOnList(conds)
 	conds = append(conds, newConds...)
+
+	if hinted := builder.hintedJoinOrder(leaves, conds); hinted != nil {
+		nodeID = hinted[0].NodeId
+		for _, leaf := range hinted[1:] {
+			nodeID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{nodeID, leaf.NodeId},
+				JoinType: plan.Node_INNER,
+			}, nil)
+		}
+		return builder.pushdownJoinConds(nodeID, conds)
+	}
+
 	vertices := builder.getJoinGraph(leaves, conds)
 
 	subTrees := make([]*plan.Node, 0, len(leaves))
@@ -321,6 +334,12 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 		}
 	}
 
+	return builder.pushdownJoinConds(nodeID, conds)
+}
+
+// pushdownJoinConds pushes the conditions of a reordered join down its tree,
+// keeping those that cannot be pushed in a FILTER on top of it.
+func (builder *QueryBuilder) pushdownJoinConds(nodeID int32, conds []*plan.Expr) int32 {
 	nodeID, conds = builder.pushdownFilters(nodeID, conds, true)
 	if len(conds) > 0 {
 		nodeID = builder.appendNode(&plan.Node{
@@ -0,0 +1,127 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+const (
+	// lateMaterializeCopyCost is the cost of copying a byte of a column to
+	// the result of a block, relative to the cost of loading it.
+	lateMaterializeCopyCost = 1.0
+	// lateMaterializeEvalCost is the cost of evaluating the filter on a
+	// byte of its columns once more in the reader, relative to the cost of
+	// loading it.
+	lateMaterializeEvalCost = 1.0
+)
+
+// determineLateMaterialize marks the table scans under nodeID whose
+// filter is selective enough and whose other columns are wide enough for
+// the reader to read the filter columns first, and the other columns only
+// for the rows passing the filter. It runs once the columns of the scans
+// are pruned, so that the columns of a scan are the ones it reads.
+func (builder *QueryBuilder) determineLateMaterialize(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.determineLateMaterialize(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || len(node.FilterList) == 0 ||
+		node.TableDef == nil || node.Stats == nil || node.TableDef.IsTemporary ||
+		(node.TableDef.TableType != catalog.SystemOrdinaryRel && node.TableDef.TableType != catalog.SystemPartitionRel) {
+		return
+	}
+
+	filterCols := make(map[int32]bool)
+	for _, expr := range node.FilterList {
+		if !lateMaterializable(expr, filterCols) {
+			return
+		}
+	}
+	s := builder.getStatsInfoByTableID(node.TableDef.TblId)
+	if s == nil || s.TableCnt <= 0 {
+		return
+	}
+	var filterSize, restSize float64
+	for i, col := range node.TableDef.Cols {
+		if filterCols[int32(i)] {
+			if col.Name == catalog.Row_ID {
+				// the rowid is built rather than read
+				return
+			}
+			filterSize += float64(s.SizeMap[col.Name])
+		} else if col.Name != catalog.Row_ID {
+			restSize += float64(s.SizeMap[col.Name])
+		}
+	}
+	if restSize == 0 {
+		return
+	}
+	rowsPerBlock := float64(DefaultBlockMaxRows)
+	if s.BlockNumber > 0 {
+		rowsPerBlock = math.Max(s.TableCnt/float64(s.BlockNumber), 1)
+	}
+	node.LateMaterialize = lateMaterializeCheaper(node.Stats.Selectivity, rowsPerBlock, filterSize, restSize)
+}
+
+// lateMaterializeCheaper compares the cost of a scan reading all its
+// columns and then filtering the rows, with the one of reading the filter
+// columns, evaluating the filter, and then reading the other columns of
+// the blocks where some rows pass, copying only those rows. The rows
+// passing the filter are assumed to be spread over the blocks.
+func lateMaterializeCheaper(selectivity, rowsPerBlock, filterSize, restSize float64) bool {
+	selectivity = math.Min(math.Max(selectivity, 0), 1)
+	blockHit := 1 - math.Pow(1-selectivity, rowsPerBlock)
+	eager := (filterSize + restSize) * (1 + lateMaterializeCopyCost)
+	late := filterSize*(1+lateMaterializeCopyCost+lateMaterializeEvalCost) +
+		restSize*(blockHit+selectivity*lateMaterializeCopyCost)
+	return late < eager
+}
+
+// lateMaterializable tells whether the reader can evaluate expr before the
+// filter of the scan does: its result must not change from an evaluation
+// to the other. It records the columns expr reads.
+func lateMaterializable(expr *plan.Expr, cols map[int32]bool) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Lit, *plan.Expr_T, *plan.Expr_P, *plan.Expr_V:
+		return true
+	case *plan.Expr_Col:
+		cols[e.Col.ColPos] = true
+		return true
+	case *plan.Expr_F:
+		f, exists := function.GetFunctionByIdWithoutError(e.F.Func.GetObj())
+		if !exists || f.CannotFold() {
+			return false
+		}
+		for _, arg := range e.F.Args {
+			if !lateMaterializable(arg, cols) {
+				return false
+			}
+		}
+		return true
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			if !lateMaterializable(item, cols) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
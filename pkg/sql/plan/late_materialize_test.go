@@ -0,0 +1,56 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLateMaterializeCheaper(t *testing.T) {
+	// a selective filter on a narrow column of a wide table
+	require.True(t, lateMaterializeCheaper(0.00001, 8192, 8, 1000))
+	// a filter passing all the rows
+	require.False(t, lateMaterializeCheaper(1, 8192, 8, 1000))
+	// the filter columns are most of the table
+	require.False(t, lateMaterializeCheaper(0.00001, 8192, 1000, 8))
+}
+
+func TestLateMaterializable(t *testing.T) {
+	ctx := context.Background()
+	col := &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{ColPos: 2},
+		},
+	}
+
+	eq, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{col, makePlan2Int64ConstExprWithType(1)})
+	require.NoError(t, err)
+	cols := make(map[int32]bool)
+	require.True(t, lateMaterializable(eq, cols))
+	require.Equal(t, map[int32]bool{2: true}, cols)
+
+	// rand() may return something else when evaluated again
+	rand, err := BindFuncExprImplByPlanExpr(ctx, "rand", nil)
+	require.NoError(t, err)
+	lt, err := BindFuncExprImplByPlanExpr(ctx, "<", []*plan.Expr{rand, makePlan2Float64ConstExprWithType(0.5)})
+	require.NoError(t, err)
+	require.False(t, lateMaterializable(lt, make(map[int32]bool)))
+}
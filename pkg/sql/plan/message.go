@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -72,7 +73,11 @@ func (builder *QueryBuilder) handleMessgaeFromTopToScan(nodeID int32) {
 	if scanNode.Stats.BlockNum < 64 {
 		return
 	}
-	if GetSortOrder(scanNode.TableDef, orderByCol.Col.ColPos) != 0 {
+	// the zonemaps of the other columns filter the blocks as well, as long as
+	// they hold the exact bounds of the blocks, which the truncated ones of
+	// varlen columns do not
+	if GetSortOrder(scanNode.TableDef, orderByCol.Col.ColPos) != 0 &&
+		types.T(scanNode.TableDef.Cols[orderByCol.Col.ColPos].Typ.Id).FixedLength() < 0 {
 		return
 	}
 
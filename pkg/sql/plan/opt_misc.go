@@ -122,6 +122,9 @@ func (builder *QueryBuilder) canRemoveProject(parentType plan.Node_NodeType, nod
 	if node.NodeType != plan.Node_PROJECT || node.Limit != nil || node.Offset != nil {
 		return false
 	}
+	if builder.hints.noMerge[node.BindingTags[0]] {
+		return false
+	}
 
 	if parentType == plan.Node_DISTINCT || parentType == plan.Node_UNKNOWN {
 		return false
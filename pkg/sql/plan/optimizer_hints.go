@@ -0,0 +1,280 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// optimizerHints are the /*+ ... */ hints of a query, their table names
+// resolved to binding tags.
+type optimizerHints struct {
+	joinOrder        [][]int32
+	joinOrderApplied bool
+	hashJoin         map[int32]bool
+	broadcast        map[int32]bool
+	shuffle          map[int32]bool
+	noMerge          map[int32]bool
+}
+
+// bindOptimizerHints records the hints of a query block once its FROM clause
+// is bound. As in MySQL, unknown hints and names are ignored.
+func (builder *QueryBuilder) bindOptimizerHints(hints tree.OptimizerHints, ctx *BindContext) {
+	h := &builder.hints
+	for _, hint := range hints {
+		switch hint.Name {
+		case "JOIN_ORDER":
+			var tags []int32
+			for _, name := range hint.Args {
+				if binding := hintBinding(ctx, name); binding != nil {
+					tags = append(tags, binding.tag)
+				}
+			}
+			if len(tags) > 1 {
+				h.joinOrder = append(h.joinOrder, tags)
+			}
+		case "HASH_JOIN":
+			h.hashJoin = addHintTags(h.hashJoin, ctx, hint.Args)
+		case "BROADCAST":
+			h.broadcast = addHintTags(h.broadcast, ctx, hint.Args)
+		case "SHUFFLE":
+			h.shuffle = addHintTags(h.shuffle, ctx, hint.Args)
+		case "NO_MERGE":
+			if len(hint.Args) > 0 {
+				h.noMerge = addHintTags(h.noMerge, ctx, hint.Args)
+				break
+			}
+			// without arguments, no derived table of the block is merged
+			for _, binding := range ctx.bindings {
+				if builder.qry.Nodes[binding.nodeId].NodeType == plan.Node_PROJECT {
+					if h.noMerge == nil {
+						h.noMerge = make(map[int32]bool)
+					}
+					h.noMerge[binding.tag] = true
+				}
+			}
+		case "DOP":
+			if len(hint.Args) == 1 {
+				if dop, err := strconv.ParseInt(hint.Args[0], 10, 32); err == nil && dop > 0 {
+					builder.qry.Dop = int32(dop)
+				}
+			}
+		}
+	}
+}
+
+func hintBinding(ctx *BindContext, name string) *Binding {
+	if binding, ok := ctx.bindingByTable[name]; ok {
+		return binding
+	}
+	return ctx.bindingByTable[strings.ToLower(name)]
+}
+
+func addHintTags(tags map[int32]bool, ctx *BindContext, names []string) map[int32]bool {
+	for _, name := range names {
+		if binding := hintBinding(ctx, name); binding != nil {
+			if tags == nil {
+				tags = make(map[int32]bool)
+			}
+			tags[binding.tag] = true
+		}
+	}
+	return tags
+}
+
+// subtreeHasHintTag returns if one of the tables under nodeID is in tags.
+func (builder *QueryBuilder) subtreeHasHintTag(nodeID int32, tags map[int32]bool) bool {
+	if len(tags) == 0 {
+		return false
+	}
+	for _, tag := range builder.enumerateTags(nodeID) {
+		if tags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+// hintedBuildSide returns the child of a join that HASH_JOIN or BROADCAST
+// hints put on the build side, -1 if they do not apply.
+func (builder *QueryBuilder) hintedBuildSide(node *plan.Node) int {
+	for _, tags := range []map[int32]bool{builder.hints.hashJoin, builder.hints.broadcast} {
+		left := builder.subtreeHasHintTag(node.Children[0], tags)
+		right := builder.subtreeHasHintTag(node.Children[1], tags)
+		if left != right {
+			if left {
+				return 0
+			}
+			return 1
+		}
+	}
+	return -1
+}
+
+// hintedJoinOrder orders the leaves of a join as a JOIN_ORDER hint asks: the
+// leaves it names come first and in its order, followed by the others, those
+// connected to the ones already joined first. It returns nil if no JOIN_ORDER
+// hint names two leaves of the join.
+func (builder *QueryBuilder) hintedJoinOrder(leaves []*plan.Node, conds []*plan.Expr) []*plan.Node {
+	if len(builder.hints.joinOrder) == 0 {
+		return nil
+	}
+
+	leafByTag := make(map[int32]int32)
+	for i, leaf := range leaves {
+		for _, tag := range builder.enumerateTags(leaf.NodeId) {
+			leafByTag[tag] = int32(i)
+		}
+	}
+
+	for _, hint := range builder.hints.joinOrder {
+		joined := make(map[int32]bool)
+		order := make([]*plan.Node, 0, len(leaves))
+		for _, tag := range hint {
+			if i, ok := leafByTag[tag]; ok && !joined[i] {
+				joined[i] = true
+				order = append(order, leaves[i])
+			}
+		}
+		if len(order) < 2 {
+			continue
+		}
+
+		rest := make([]int32, 0, len(leaves)-len(order))
+		for i := range leaves {
+			if !joined[int32(i)] {
+				rest = append(rest, int32(i))
+			}
+		}
+		sort.Slice(rest, func(i, j int) bool { return compareStats(leaves[rest[i]].Stats, leaves[rest[j]].Stats) })
+
+		for len(rest) > 0 {
+			next := 0
+		find:
+			for i, leaf := range rest {
+				for _, cond := range conds {
+					hyperEdge := make(map[int32]bool)
+					getHyperEdgeFromExpr(cond, leafByTag, hyperEdge)
+					if !hyperEdge[leaf] {
+						continue
+					}
+					for other := range hyperEdge {
+						if joined[other] {
+							next = i
+							break find
+						}
+					}
+				}
+			}
+			joined[rest[next]] = true
+			order = append(order, leaves[rest[next]])
+			rest = append(rest[:next], rest[next+1:]...)
+		}
+
+		builder.hints.joinOrderApplied = true
+		return order
+	}
+	return nil
+}
+
+// CaptureJoinOrder plans stmt and returns a JOIN_ORDER hint naming the tables
+// of its first join in the order of the plan, so that the plan can be pinned.
+// It returns nil if the plan joins less than two tables.
+func CaptureJoinOrder(ctx CompilerContext, stmt *tree.Select) (*tree.OptimizerHint, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false)
+	bindCtx := NewBindContext(builder, nil)
+	rootID, err := builder.buildSelect(stmt, bindCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	builder.qry.Steps = append(builder.qry.Steps, rootID)
+	// the binding tags of the nodes are gone once the query is created
+	tags := make(map[int32]int32)
+	for _, node := range builder.qry.Nodes {
+		if len(node.BindingTags) > 0 {
+			tags[node.NodeId] = node.BindingTags[0]
+		}
+	}
+	if _, err = builder.createQuery(); err != nil {
+		return nil, err
+	}
+
+	// the optimizer replaces nodes, the root among them
+	joinID := builder.findJoin(builder.qry.Steps[0])
+	if joinID < 0 {
+		return nil, nil
+	}
+	hint := &tree.OptimizerHint{Name: "JOIN_ORDER"}
+	seen := make(map[string]bool)
+	for _, leafID := range builder.joinLeaves(joinID, nil) {
+		if name := builder.leafTableName(leafID, tags); name != "" && !seen[name] {
+			seen[name] = true
+			hint.Args = append(hint.Args, name)
+		}
+	}
+	if len(hint.Args) < 2 {
+		return nil, nil
+	}
+	return hint, nil
+}
+
+// findJoin returns the topmost join under nodeID, -1 if there is none.
+func (builder *QueryBuilder) findJoin(nodeID int32) int32 {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType == plan.Node_JOIN {
+		return nodeID
+	}
+	for _, childID := range node.Children {
+		if joinID := builder.findJoin(childID); joinID >= 0 {
+			return joinID
+		}
+	}
+	return -1
+}
+
+// joinLeaves appends the inputs of the join tree under nodeID, left to right.
+func (builder *QueryBuilder) joinLeaves(nodeID int32, leaves []int32) []int32 {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType != plan.Node_JOIN {
+		return append(leaves, nodeID)
+	}
+	for _, childID := range node.Children {
+		leaves = builder.joinLeaves(childID, leaves)
+	}
+	return leaves
+}
+
+// leafTableName returns the name, or alias, the query gives to the table or
+// derived table of a join input.
+func (builder *QueryBuilder) leafTableName(nodeID int32, tags map[int32]int32) string {
+	for {
+		if tag, ok := tags[nodeID]; ok {
+			if name, ok := builder.nameByColRef[[2]int32{tag, 0}]; ok {
+				table, _, _ := strings.Cut(name, ".")
+				return table
+			}
+		}
+		node := builder.qry.Nodes[nodeID]
+		if len(node.Children) != 1 {
+			return ""
+		}
+		nodeID = node.Children[0]
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	build := func(sql string) *plan.Query {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		return p.GetQuery()
+	}
+	tableOf := func(qry *plan.Query, nodeID int32) string {
+		if node := qry.Nodes[nodeID]; node.NodeType == plan.Node_TABLE_SCAN {
+			return node.TableDef.Name
+		}
+		return ""
+	}
+	// the join of the final plan reading the table named name
+	joinOf := func(qry *plan.Query, name string) *plan.Node {
+		var find func(nodeID int32) *plan.Node
+		find = func(nodeID int32) *plan.Node {
+			node := qry.Nodes[nodeID]
+			for _, childID := range node.Children {
+				if node.NodeType == plan.Node_JOIN && tableOf(qry, childID) == name {
+					return node
+				}
+				if join := find(childID); join != nil {
+					return join
+				}
+			}
+			return nil
+		}
+		return find(qry.Steps[0])
+	}
+
+	qry := build("select /*+ JOIN_ORDER(r, n) */ s_name from supplier s, nation n, region r where s.s_nationkey = n.n_nationkey and n.n_regionkey = r.r_regionkey")
+	join := joinOf(qry, "region")
+	require.Equal(t, []string{"region", "nation"}, []string{tableOf(qry, join.Children[0]), tableOf(qry, join.Children[1])})
+	require.Equal(t, "supplier", tableOf(qry, joinOf(qry, "supplier").Children[1]))
+
+	qry = build("select /*+ HASH_JOIN(s) SHUFFLE(n) DOP(4) */ s_name from supplier s, nation n where s.s_nationkey = n.n_nationkey")
+	join = joinOf(qry, "supplier")
+	require.Equal(t, "supplier", tableOf(qry, join.Children[1]))
+	require.True(t, join.Stats.HashmapStats.Shuffle)
+	require.Equal(t, int32(4), qry.Dop)
+
+	qry = build("select /*+ BROADCAST(s) */ s_name from supplier s, nation n where s.s_nationkey = n.n_nationkey")
+	join = joinOf(qry, "supplier")
+	require.Equal(t, "supplier", tableOf(qry, join.Children[1]))
+	require.False(t, join.Stats.HashmapStats.Shuffle)
+
+	// the derived table stays a block of its own, under its PROJECT
+	countProjects := func(qry *plan.Query) int {
+		cnt := 0
+		var walk func(nodeID int32)
+		walk = func(nodeID int32) {
+			if qry.Nodes[nodeID].NodeType == plan.Node_PROJECT {
+				cnt++
+			}
+			for _, childID := range qry.Nodes[nodeID].Children {
+				walk(childID)
+			}
+		}
+		walk(qry.Steps[0])
+		return cnt
+	}
+	sql := " d.n_name from (select n_nationkey, n_name from nation) d, region where d.n_nationkey = r_regionkey"
+	require.Equal(t, 1, countProjects(build("select"+sql)))
+	require.Equal(t, 2, countProjects(build("select /*+ NO_MERGE(d) */"+sql)))
+	require.Equal(t, 2, countProjects(build("select /*+ NO_MERGE() */"+sql)))
+
+	// unknown hints and names are ignored
+	qry = build("select /*+ JOIN_ORDER(x, y) HASH_JOIN(x) DOP(zero) NO_SUCH_HINT(1) */ s_name from supplier s, nation n where s.s_nationkey = n.n_nationkey")
+	require.Equal(t, int32(0), qry.Dop)
+}
+
+func TestCaptureJoinOrder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext()
+
+	capture := func(sql string) *tree.OptimizerHint {
+		stmt, err := mysql.ParseOne(ctx.GetContext(), sql, 1, 0)
+		require.NoError(t, err)
+		hint, err := CaptureJoinOrder(ctx, stmt.(*tree.Select))
+		require.NoError(t, err)
+		return hint
+	}
+
+	sql := " s_name from supplier s, nation n, region r where s.s_nationkey = n.n_nationkey and n.n_regionkey = r.r_regionkey"
+	hint := capture("select" + sql)
+	require.Equal(t, "JOIN_ORDER", hint.Name)
+	require.ElementsMatch(t, []string{"s", "n", "r"}, hint.Args)
+
+	// the captured order plans the query the same way
+	pinned := capture("select /*+ JOIN_ORDER(" + strings.Join(hint.Args, ", ") + ") */" + sql)
+	require.Equal(t, hint.Args, pinned.Args)
+
+	require.Nil(t, capture("select s_name from supplier where s_suppkey = 1"))
+}
@@ -38,6 +38,8 @@ func (builder *QueryBuilder) buildProcesslist(tbl *tree.TableFunction, ctx *Bind
 		switch status.SessionField(i) {
 		case status.SessionField_CONN_ID:
 			typ = types.New(types.T_uint32, 0, 0)
+		case status.SessionField_QUERY_MEMORY:
+			typ = types.New(types.T_uint64, 0, 0)
 		default:
 			typ = types.New(types.T_varchar, types.MaxVarcharLen, 0)
 		}
@@ -1458,7 +1458,9 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		colGroup := make([]int, 0)
 		builder.removeRedundantJoinCond(rootID, colMap, colGroup)
 		ReCalcNodeStats(rootID, builder, true, false, true)
-		rootID = builder.applyAssociativeLaw(rootID)
+		if !builder.hints.joinOrderApplied {
+			rootID = builder.applyAssociativeLaw(rootID)
+		}
 		builder.determineBuildAndProbeSide(rootID, true)
 		rootID = builder.aggPullup(rootID, rootID)
 		ReCalcNodeStats(rootID, builder, true, false, true)
@@ -1477,6 +1479,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 
 		builder.optimizeLikeExpr(rootID)
 		rootID = builder.applyIndices(rootID, colRefCnt, make(map[[2]int32]*plan.Expr))
+		builder.checkIndexHints(rootID)
 		ReCalcNodeStats(rootID, builder, true, false, true)
 
 		determineHashOnPK(rootID, builder)
@@ -1521,6 +1524,10 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		}
 	}
 
+	for _, rootID := range builder.qry.Steps {
+		builder.determineLateMaterialize(rootID)
+	}
+
 	//for i := 1; i < len(builder.qry.Steps); i++ {
 	//	builder.remapSinkScanColRefs(builder.qry.Steps[i], int32(i), sinkColRef)
 	//}
@@ -2188,6 +2195,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		if err != nil {
 			return 0, err
 		}
+		builder.bindOptimizerHints(clause.Hints, ctx)
 
 		ctx.binder = NewWhereBinder(builder, ctx)
 		if !ctx.isTryBindingCTE {
@@ -3688,6 +3696,31 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					builder.qry.Nodes[nodeID].FilterList = accountFilterExprs
 				}
 			}
+
+			// rows of a table with TTL are invisible once expired, even before
+			// the merge drops them
+			if ttl, ok := catalog.TableTTLFromDefs(midNode.TableDef.Defs); ok {
+				if colIdx, ok := midNode.TableDef.Name2ColIndex[ttl.Column]; ok {
+					col := midNode.TableDef.Cols[colIdx]
+					nullable := !col.NotNull && (col.Default == nil || col.Default.NullAbility)
+					utc := types.T(col.Typ.Id) != types.T_timestamp
+					ttlFilter := util.BuildTTLFilter(ttl.Column, ttl.Interval, ttl.Unit, nullable, utc)
+					ctx.binder = NewWhereBinder(builder, ctx)
+					ttlFilterExprs, err := splitAndBindCondition(ttlFilter, NoAlias, ctx)
+					if err != nil {
+						return 0, err
+					}
+					builder.qry.Nodes[nodeID].FilterList = append(builder.qry.Nodes[nodeID].FilterList, ttlFilterExprs...)
+				}
+			}
+
+			if len(tbl.IndexHints) > 0 {
+				indexHints, err := builder.buildIndexHints(midNode.TableDef, tbl.IndexHints)
+				if err != nil {
+					return 0, err
+				}
+				builder.qry.Nodes[nodeID].IndexHints = indexHints
+			}
 		}
 		return
 	case *tree.StatementSource:
@@ -3987,6 +4020,10 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildMoTransactions(tbl, ctx, exprs, childId)
 	case "mo_cache":
 		nodeId, err = builder.buildMoCache(tbl, ctx, exprs, childId)
+	case "mo_scrub":
+		nodeId, err = builder.buildMoScrub(tbl, ctx, exprs, childId)
+	case "clustering_information":
+		nodeId, err = builder.buildClusteringInformation(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupported(builder.GetContext(), "table function '%s' not supported", id)
 	}
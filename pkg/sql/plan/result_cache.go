@@ -0,0 +1,100 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// ResultCacheTables returns the tables the result of qry is computed from,
+// their database names by id. It returns false if the result also depends on
+// something else, such as the time, a random number, a variable or the rows
+// of an external or temporary table or of a snapshot, and so cannot be
+// cached.
+func ResultCacheTables(qry *plan.Query) (map[uint64]string, bool) {
+	if qry.StmtType != plan.Query_SELECT {
+		return nil, false
+	}
+	tables := make(map[uint64]string)
+	for _, node := range qry.Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			def := node.TableDef
+			if def == nil || node.ObjRef == nil || def.IsTemporary ||
+				(node.ScanTS != nil && !node.ScanTS.IsEmpty()) ||
+				(def.TableType != catalog.SystemOrdinaryRel && def.TableType != catalog.SystemPartitionRel) {
+				return nil, false
+			}
+			tables[def.TblId] = node.ObjRef.SchemaName
+		case plan.Node_VALUE_SCAN, plan.Node_PROJECT, plan.Node_MATERIAL,
+			plan.Node_SINK, plan.Node_SINK_SCAN, plan.Node_RECURSIVE_CTE, plan.Node_RECURSIVE_SCAN,
+			plan.Node_AGG, plan.Node_DISTINCT, plan.Node_FILTER, plan.Node_JOIN, plan.Node_SORT,
+			plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_UNIQUE, plan.Node_WINDOW,
+			plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL,
+			plan.Node_TIME_WINDOW, plan.Node_FILL, plan.Node_PARTITION:
+		default:
+			return nil, false
+		}
+
+		exprs := make([]*plan.Expr, 0, len(node.ProjectList)+len(node.FilterList))
+		exprs = append(exprs, node.ProjectList...)
+		exprs = append(exprs, node.FilterList...)
+		exprs = append(exprs, node.BlockFilterList...)
+		exprs = append(exprs, node.OnList...)
+		exprs = append(exprs, node.AggList...)
+		exprs = append(exprs, node.GroupBy...)
+		exprs = append(exprs, node.WinSpecList...)
+		exprs = append(exprs, node.FillVal...)
+		exprs = append(exprs, node.Limit, node.Offset)
+		for _, spec := range node.OrderBy {
+			exprs = append(exprs, spec.Expr)
+		}
+		for _, expr := range exprs {
+			if !resultCacheable(expr) {
+				return nil, false
+			}
+		}
+	}
+	return tables, true
+}
+
+func resultCacheable(expr *plan.Expr) bool {
+	if expr == nil {
+		return true
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_V:
+		return false
+	case *plan.Expr_F:
+		f, exists := function.GetFunctionByIdWithoutError(e.F.Func.GetObj())
+		if !exists || f.CannotFold() || f.IsRealTimeRelated() {
+			return false
+		}
+		for _, arg := range e.F.Args {
+			if !resultCacheable(arg) {
+				return false
+			}
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			if !resultCacheable(item) {
+				return false
+			}
+		}
+	}
+	return true
+}
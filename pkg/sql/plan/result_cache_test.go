@@ -0,0 +1,53 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultCacheTables(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	tablesOf := func(sql string) (map[uint64]string, bool) {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		return ResultCacheTables(p.GetQuery())
+	}
+
+	tables, ok := tablesOf("select n_name, count(*) from nation n, region r where n.n_regionkey = r.r_regionkey and r.r_name = 'ASIA' group by n_name order by 2 limit 3")
+	require.True(t, ok)
+	require.Len(t, tables, 2)
+	for _, db := range tables {
+		require.Equal(t, "tpch", db)
+	}
+
+	tables, ok = tablesOf("select abs(-1), concat('a', 'b')")
+	require.True(t, ok)
+	require.Empty(t, tables)
+
+	for _, sql := range []string{
+		"select n_name, rand() from nation",
+		"select n_name from nation where n_nationkey > unix_timestamp(now())",
+		"select uuid()",
+		"select n_name from nation where n_nationkey = @a",
+		"insert into nation select * from nation",
+	} {
+		_, ok = tablesOf(sql)
+		require.False(t, ok, sql)
+	}
+}
@@ -306,7 +306,14 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 		return
 	}
 
-	if n.Stats.HashmapStats.HashmapSize < HashMapSizeForShuffle {
+	// BROADCAST(t) sends the build side to every worker instead of shuffling,
+	// SHUFFLE(t) shuffles whatever the size of the hash map
+	if builder.subtreeHasHintTag(n.Children[1], builder.hints.broadcast) {
+		return
+	}
+	forceShuffle := builder.subtreeHasHintTag(n.NodeId, builder.hints.shuffle)
+
+	if n.Stats.HashmapStats.HashmapSize < HashMapSizeForShuffle && !forceShuffle {
 		return
 	}
 	idx := 0
@@ -331,7 +338,7 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 
 	//find the highest ndv
 	highestNDV := n.OnList[idx].Ndv
-	if highestNDV < ShuffleThreshHoldOfNDV {
+	if highestNDV < ShuffleThreshHoldOfNDV && !forceShuffle {
 		return
 	}
 
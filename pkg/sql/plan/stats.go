@@ -91,6 +91,8 @@ func NewStatsInfo() *pb.StatsInfo {
 		NullCntMap:         make(map[string]uint64),
 		SizeMap:            make(map[string]uint64),
 		ShuffleRangeMap:    make(map[string]*pb.ShuffleRange),
+		HistogramMap:       make(map[string]*pb.Histogram),
+		MCVMap:             make(map[string]*pb.MCVList),
 		BlockNumber:        0,
 		ApproxObjectNumber: 0,
 		TableCnt:           0,
@@ -103,6 +105,7 @@ type InfoFromZoneMap struct {
 	ColumnNDVs           []float64
 	NullCnts             []int64
 	ShuffleRanges        []*pb.ShuffleRange
+	Histograms           []*HistogramBuilder
 	ColumnSize           []int64
 	BlockNumber          int64
 	AccurateObjectNumber int64
@@ -118,6 +121,7 @@ func NewInfoFromZoneMap(lenCols int) *InfoFromZoneMap {
 		NullCnts:      make([]int64, lenCols),
 		ColumnSize:    make([]int64, lenCols),
 		ShuffleRanges: make([]*pb.ShuffleRange, lenCols),
+		Histograms:    make([]*HistogramBuilder, lenCols),
 	}
 	return info
 }
@@ -202,6 +206,13 @@ func UpdateStatsInfo(info *InfoFromZoneMap, tableDef *plan.TableDef, s *pb.Stats
 			}
 			info.ShuffleRanges[i] = nil
 		}
+
+		if info.Histograms[i] != nil {
+			if h := info.Histograms[i].Build(DefaultHistogramBuckets); h != nil {
+				s.HistogramMap[colName] = h
+			}
+			info.Histograms[i] = nil
+		}
 	}
 }
 
@@ -341,6 +352,9 @@ func estimateEqualitySelectivity(expr *plan.Expr, builder *QueryBuilder) float64
 	if col == nil {
 		return 0.01
 	}
+	if sel, ok := estimateEqualitySelectivityByDistribution(expr, builder); ok {
+		return sel
+	}
 	ndv := getExprNdv(expr, builder)
 	if ndv > 0 {
 		return 1 / ndv
@@ -348,6 +362,35 @@ func estimateEqualitySelectivity(expr *plan.Expr, builder *QueryBuilder) float64
 	return 0.01
 }
 
+// estimateEqualitySelectivityByDistribution estimates col=literal with the
+// most common values and the histogram of the column, if there are some.
+func estimateEqualitySelectivityByDistribution(expr *plan.Expr, builder *QueryBuilder) (float64, bool) {
+	col, _, literals, colFnName := extractColRefAndLiteralsInFilter(expr)
+	if col == nil || colFnName != "" || len(literals) != 1 || literals[0] == nil {
+		return 0, false
+	}
+	s := builder.getStatsInfoByCol(col)
+	if s == nil || s.TableCnt <= 0 {
+		return 0, false
+	}
+	typ := types.T(s.DataTypeMap[col.Name])
+	val, ok := getFloat64Value(typ, literals[0])
+	if !ok {
+		return 0, false
+	}
+	if m := s.MCVMap[col.Name]; m != nil && len(m.Vals) > 0 {
+		nullRatio := math.Min(float64(s.NullCntMap[col.Name])/s.TableCnt, 1)
+		return calcSelectivityByMCV(m, val, s.NdvMap[col.Name], nullRatio), true
+	}
+	if h := s.HistogramMap[col.Name]; h != nil && len(h.Bounds) > 0 {
+		// a value out of the histogram matches hardly any row
+		if val < h.Bounds[0] || val > h.Bounds[len(h.Bounds)-1] {
+			return 1 / s.TableCnt, true
+		}
+	}
+	return 0, false
+}
+
 func calcSelectivityByMinMax(funcName string, min, max float64, typ types.T, vals []*plan.Literal) (ret float64) {
 	switch funcName {
 	case ">", ">=":
@@ -452,6 +495,12 @@ func estimateNonEqualitySelectivity(expr *plan.Expr, funcName string, builder *Q
 
 		switch colFnName {
 		case "":
+			if sel, ok := calcSelectivityByHistogram(funcName, s.HistogramMap[col.Name], typ, literals); ok {
+				if s.TableCnt > 0 {
+					sel *= 1 - math.Min(float64(s.NullCntMap[col.Name])/s.TableCnt, 1)
+				}
+				return sel
+			}
 			return calcSelectivityByMinMax(funcName, s.MinValMap[col.Name], s.MaxValMap[col.Name], typ, literals)
 		case "year":
 			switch typ {
@@ -1126,7 +1175,11 @@ func (builder *QueryBuilder) determineBuildAndProbeSide(nodeID int32, recursive
 
 	switch node.JoinType {
 	case plan.Node_INNER, plan.Node_OUTER:
-		if leftChild.Stats.Outcnt < rightChild.Stats.Outcnt {
+		if side := builder.hintedBuildSide(node); side >= 0 {
+			if side == 0 {
+				node.Children[0], node.Children[1] = node.Children[1], node.Children[0]
+			}
+		} else if leftChild.Stats.Outcnt < rightChild.Stats.Outcnt {
 			node.Children[0], node.Children[1] = node.Children[1], node.Children[0]
 
 		}
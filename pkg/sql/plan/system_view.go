@@ -345,3 +345,82 @@ func (builder *QueryBuilder) buildMoCache(tbl *tree.TableFunction, ctx *BindCont
 	}
 	return builder.appendNode(node, ctx), err
 }
+
+var MoScrubColNames = []string{
+	"node_id",
+	"object_name",
+	"db_id",
+	"table_id",
+	"error",
+	"detected_at",
+	"repaired",
+	"repair_error",
+}
+
+var MoScrubColTypes = []types.Type{
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_uint64, 0, 0),
+	types.New(types.T_uint64, 0, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_timestamp, 0, 6),
+	types.New(types.T_bool, 0, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+}
+
+var MoScrubColName2Index = map[string]int32{
+	"node_id":      0,
+	"object_name":  1,
+	"db_id":        2,
+	"table_id":     3,
+	"error":        4,
+	"detected_at":  5,
+	"repaired":     6,
+	"repair_error": 7,
+}
+
+type MoScrubColType int32
+
+const (
+	MoScrubColTypeNodeId = iota
+	MoScrubColTypeObjectName
+	MoScrubColTypeDbId
+	MoScrubColTypeTableId
+	MoScrubColTypeError
+	MoScrubColTypeDetectedAt
+	MoScrubColTypeRepaired
+	MoScrubColTypeRepairError
+)
+
+func (builder *QueryBuilder) buildMoScrub(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	var err error
+
+	colDefs := make([]*plan.ColDef, 0, len(MoScrubColNames))
+
+	for i, name := range MoScrubColNames {
+		colDefs = append(colDefs, &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:    int32(MoScrubColTypes[i].Oid),
+				Width: MoScrubColTypes[i].Width,
+				Scale: MoScrubColTypes[i].Scale,
+			},
+		})
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "mo_scrub",
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), err
+}
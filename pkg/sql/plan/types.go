@@ -177,6 +177,12 @@ type QueryBuilder struct {
 
 	deleteNode map[uint64]int32 //delete node in this query. key is tableId, value is the nodeId of sinkScan node in the delete plan
 	skipStats  bool
+
+	// indexHintUsed is the index picked for each [scan tag, hint scope] of a
+	// table scan with index hints
+	indexHintUsed map[[2]int32]string
+
+	hints optimizerHints
 }
 
 type CTERef struct {
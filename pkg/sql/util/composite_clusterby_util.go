@@ -47,14 +47,7 @@ func BuildCompositeClusterByColumnName(s []string) string {
 }
 
 func SplitCompositeClusterByColumnName(s string) []string {
-	var names []string
-	for next := len(catalog.PrefixCBColName); next < len(s); {
-		strLen, _ := strconv.Atoi(s[next : next+3])
-		names = append(names, s[next+3:next+3+strLen])
-		next += strLen + 3
-	}
-
-	return names
+	return catalog.SplitCompositeClusterByColumnName(s)
 }
 
 func GetClusterByFirstColumn(cbName string) string {
@@ -213,9 +213,13 @@ func BuildMoColumnsFilter(curAccountId uint64) tree.Expr {
 	mo_stored_procedure := tree.NewNumValWithType(constant.MakeString("mo_stored_procedure"), "mo_stored_procedure", false, tree.P_char)
 	mo_stages := tree.NewNumValWithType(constant.MakeString("mo_stages"), "mo_stages", false, tree.P_char)
 	mo_snapshots := tree.NewNumValWithType(constant.MakeString("mo_snapshots"), "mo_snapshots", false, tree.P_char)
+	mo_binlog_positions := tree.NewNumValWithType(constant.MakeString("mo_binlog_positions"), "mo_binlog_positions", false, tree.P_char)
+	mo_column_stats := tree.NewNumValWithType(constant.MakeString("mo_column_stats"), "mo_column_stats", false, tree.P_char)
+	mo_plan_baselines := tree.NewNumValWithType(constant.MakeString("mo_plan_baselines"), "mo_plan_baselines", false, tree.P_char)
 
 	notInValues := tree.NewTuple(tree.Exprs{mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst,
-		mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_table_partitions, mo_pubs, mo_stored_procedure, mo_stages, mo_snapshots})
+		mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_table_partitions, mo_pubs, mo_stored_procedure, mo_stages, mo_snapshots,
+		mo_binlog_positions, mo_column_stats, mo_plan_baselines})
 
 	notInexpr := tree.NewComparisonExpr(tree.NOT_IN, att_relnameColName, notInValues)
 
@@ -231,6 +235,39 @@ func BuildMoColumnsFilter(curAccountId uint64) tree.Expr {
 	return tree.NewOrExpr(left, right)
 }
 
+// Build the filter condition AST expression hiding expired rows of a table with TTL, as follows:
+// colName >= date_sub(now(), interval n unit), or colName is null or ... if the column is nullable.
+// The DATE and DATETIME columns, which have no timezone, are compared with utc_timestamp() instead
+// of now(), the way the merge compares them before dropping the expired rows.
+func BuildTTLFilter(colName string, interval int64, unit string, nullable bool, utc bool) tree.Expr {
+	ttlColName := &tree.UnresolvedName{
+		NumParts: 1,
+		Parts:    tree.NameParts{colName},
+	}
+	intervalConst := tree.NewNumValWithType(constant.MakeInt64(interval), strconv.FormatInt(interval, 10), false, tree.P_int64)
+	unitConst := tree.NewNumValWithType(constant.MakeString(unit), unit, false, tree.P_char)
+	intervalExpr := &tree.FuncExpr{
+		Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("interval")),
+		Exprs: tree.Exprs{intervalConst, unitConst},
+	}
+	nowFunc := "now"
+	if utc {
+		nowFunc = "utc_timestamp"
+	}
+	nowExpr := &tree.FuncExpr{
+		Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName(nowFunc)),
+	}
+	cutoffExpr := &tree.FuncExpr{
+		Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("date_sub")),
+		Exprs: tree.Exprs{nowExpr, intervalExpr},
+	}
+	aliveExpr := tree.NewComparisonExpr(tree.GREAT_THAN_EQUAL, ttlColName, cutoffExpr)
+	if !nullable {
+		return aliveExpr
+	}
+	return tree.NewOrExpr(tree.NewIsNullExpr(ttlColName), aliveExpr)
+}
+
 // build equal ast expr: colName = 'xxx'
 func makeStringEqualAst(lColName, rValue string) tree.Expr {
 	relkindColName := &tree.UnresolvedName{
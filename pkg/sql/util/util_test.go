@@ -16,6 +16,7 @@ package util
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -74,3 +75,14 @@ func Test_SplitNameOfPartitionTable(t *testing.T) {
 		}
 	}
 }
+
+func Test_BuildTTLFilter(t *testing.T) {
+	filter := BuildTTLFilter("ts", 30, "day", false, false)
+	require.Equal(t, "ts >= date_sub(now(), interval(30, day))", tree.String(filter, 0))
+
+	filter = BuildTTLFilter("ts", 2, "hour", true, false)
+	require.Equal(t, "ts is null or ts >= date_sub(now(), interval(2, hour))", tree.String(filter, 0))
+
+	filter = BuildTTLFilter("dt", 2, "hour", false, true)
+	require.Equal(t, "dt >= date_sub(utc_timestamp(), interval(2, hour))", tree.String(filter, 0))
+}
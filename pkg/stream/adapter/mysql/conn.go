@@ -0,0 +1,560 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	clientLongPassword     uint32 = 0x00000001
+	clientLongFlag         uint32 = 0x00000004
+	clientProtocol41       uint32 = 0x00000200
+	clientTransactions     uint32 = 0x00002000
+	clientSecureConnection uint32 = 0x00008000
+	clientMultiResults     uint32 = 0x00020000
+	clientPluginAuth       uint32 = 0x00080000
+
+	clientCapabilities = clientLongPassword | clientLongFlag | clientProtocol41 |
+		clientTransactions | clientSecureConnection | clientMultiResults | clientPluginAuth
+
+	comQuit          byte = 0x01
+	comQuery         byte = 0x03
+	comBinlogDump    byte = 0x12
+	comRegisterSlave byte = 0x15
+
+	packetOK  byte = 0x00
+	packetEOF byte = 0xfe
+	packetErr byte = 0xff
+
+	// utf8mb4_general_ci
+	defaultCollation byte = 45
+
+	maxPacketSize = 1<<24 - 1
+
+	authNativePassword = "mysql_native_password"
+	authCachingSha2    = "caching_sha2_password"
+
+	cachingSha2FastAuthOK  byte = 3
+	cachingSha2FullAuth    byte = 4
+	cachingSha2RequestKey  byte = 2
+	cachingSha2MoreAuthTag byte = 1
+)
+
+// Config is the configuration used to connect to an upstream MySQL server.
+type Config struct {
+	// Addr is the host:port of the MySQL server.
+	Addr     string
+	User     string
+	Password string
+	// ServerID is the server id registered to the upstream as a replica, it
+	// must be unique among all the replicas of the upstream.
+	ServerID uint32
+	// DialTimeout is the timeout of establishing the connection.
+	DialTimeout time.Duration
+	// ReadTimeout is the timeout of waiting for a packet. Zero means no timeout.
+	ReadTimeout time.Duration
+}
+
+// Conn is a client connection to a MySQL server. It only implements the
+// parts of the client/server protocol that a replica needs: authentication,
+// text queries and the binlog dump.
+type Conn struct {
+	cfg           Config
+	nc            net.Conn
+	br            *bufio.Reader
+	seq           uint8
+	serverVersion string
+	connectionID  uint32
+}
+
+// Dial connects to the MySQL server and authenticates the user.
+func Dial(ctx context.Context, cfg Config) (*Conn, error) {
+	d := net.Dialer{Timeout: cfg.DialTimeout}
+	nc, err := d.DialContext(ctx, "tcp", cfg.Addr)
+	if err != nil {
+		return nil, err
+	}
+	c := &Conn{
+		cfg: cfg,
+		nc:  nc,
+		br:  bufio.NewReaderSize(nc, 64*1024),
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = nc.SetDeadline(deadline)
+	}
+	if err = c.handshake(ctx); err != nil {
+		_ = nc.Close()
+		return nil, err
+	}
+	_ = nc.SetDeadline(time.Time{})
+	return c, nil
+}
+
+// ServerVersion returns the version string sent by the server in the handshake.
+func (c *Conn) ServerVersion() string {
+	return c.serverVersion
+}
+
+// Close sends COM_QUIT and closes the connection.
+func (c *Conn) Close() error {
+	c.seq = 0
+	_ = c.writePacket([]byte{comQuit})
+	return c.nc.Close()
+}
+
+func (c *Conn) readPacket() ([]byte, error) {
+	var payload []byte
+	for {
+		if c.cfg.ReadTimeout > 0 {
+			_ = c.nc.SetReadDeadline(time.Now().Add(c.cfg.ReadTimeout))
+		}
+		var header [4]byte
+		if _, err := io.ReadFull(c.br, header[:]); err != nil {
+			return nil, err
+		}
+		length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+		if header[3] != c.seq {
+			return nil, moerr.NewInternalErrorNoCtx("invalid packet sequence %d, expected %d", header[3], c.seq)
+		}
+		c.seq++
+		start := len(payload)
+		payload = append(payload, make([]byte, length)...)
+		if _, err := io.ReadFull(c.br, payload[start:]); err != nil {
+			return nil, err
+		}
+		if length < maxPacketSize {
+			return payload, nil
+		}
+	}
+}
+
+func (c *Conn) writePacket(payload []byte) error {
+	for {
+		length := len(payload)
+		if length > maxPacketSize {
+			length = maxPacketSize
+		}
+		data := make([]byte, 4, 4+length)
+		data[0] = byte(length)
+		data[1] = byte(length >> 8)
+		data[2] = byte(length >> 16)
+		data[3] = c.seq
+		c.seq++
+		data = append(data, payload[:length]...)
+		if _, err := c.nc.Write(data); err != nil {
+			return err
+		}
+		payload = payload[length:]
+		// a payload of exactly maxPacketSize is followed by an empty packet
+		if length < maxPacketSize {
+			return nil
+		}
+	}
+}
+
+// writeCommand starts a new command phase.
+func (c *Conn) writeCommand(cmd byte, arg []byte) error {
+	c.seq = 0
+	return c.writePacket(append([]byte{cmd}, arg...))
+}
+
+func (c *Conn) readOK(ctx context.Context) error {
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	switch data[0] {
+	case packetOK:
+		return nil
+	case packetErr:
+		return parseErrPacket(ctx, data)
+	default:
+		return moerr.NewInternalError(ctx, "unexpected packet 0x%02x, expected OK", data[0])
+	}
+}
+
+func parseErrPacket(ctx context.Context, data []byte) error {
+	if len(data) < 3 {
+		return moerr.NewInternalError(ctx, "invalid error packet from upstream")
+	}
+	code := binary.LittleEndian.Uint16(data[1:])
+	msg := data[3:]
+	state := ""
+	if len(msg) >= 6 && msg[0] == '#' {
+		state = string(msg[1:6])
+		msg = msg[6:]
+	}
+	return moerr.NewInternalError(ctx, "upstream error %d (%s): %s", code, state, string(msg))
+}
+
+func (c *Conn) handshake(ctx context.Context) error {
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	if data[0] == packetErr {
+		return parseErrPacket(ctx, data)
+	}
+	r := reader{data: data}
+	if v := r.byte(); v != 10 {
+		return moerr.NewInternalError(ctx, "unsupported protocol version %d", v)
+	}
+	c.serverVersion = r.nulString()
+	c.connectionID = r.uint32()
+	scramble := append([]byte(nil), r.bytes(8)...)
+	r.skip(1)
+	capability := uint32(r.uint16())
+	plugin := authNativePassword
+	if !r.done() {
+		r.skip(1 + 2) // charset, status
+		capability |= uint32(r.uint16()) << 16
+		authLen := int(r.byte())
+		r.skip(10)
+		if capability&clientSecureConnection != 0 {
+			n := authLen - 8
+			if n < 13 {
+				n = 13
+			}
+			// the last byte is the NUL terminator
+			scramble = append(scramble, r.bytes(n-1)...)
+			r.skip(1)
+		}
+		if capability&clientPluginAuth != 0 && !r.done() {
+			plugin = r.nulString()
+		}
+	}
+	if r.err != nil {
+		return moerr.NewInternalError(ctx, "invalid handshake packet from upstream")
+	}
+	if capability&clientProtocol41 == 0 {
+		return moerr.NewInternalError(ctx, "upstream server does not support protocol 4.1")
+	}
+
+	auth, err := scrambleAuth(ctx, plugin, scramble, c.cfg.Password)
+	if err != nil {
+		return err
+	}
+	resp := make([]byte, 0, 64+len(c.cfg.User)+len(auth))
+	resp = binary.LittleEndian.AppendUint32(resp, clientCapabilities)
+	resp = binary.LittleEndian.AppendUint32(resp, maxPacketSize)
+	resp = append(resp, defaultCollation)
+	resp = append(resp, make([]byte, 23)...)
+	resp = append(resp, c.cfg.User...)
+	resp = append(resp, 0)
+	resp = append(resp, byte(len(auth)))
+	resp = append(resp, auth...)
+	resp = append(resp, plugin...)
+	resp = append(resp, 0)
+	if err = c.writePacket(resp); err != nil {
+		return err
+	}
+	return c.finishAuth(ctx, plugin, scramble)
+}
+
+func (c *Conn) finishAuth(ctx context.Context, plugin string, scramble []byte) error {
+	for {
+		data, err := c.readPacket()
+		if err != nil {
+			return err
+		}
+		switch data[0] {
+		case packetOK:
+			return nil
+		case packetErr:
+			return parseErrPacket(ctx, data)
+		case packetEOF:
+			// AuthSwitchRequest
+			r := reader{data: data[1:]}
+			plugin = r.nulString()
+			scramble = bytes.TrimSuffix(r.rest(), []byte{0})
+			auth, err := scrambleAuth(ctx, plugin, scramble, c.cfg.Password)
+			if err != nil {
+				return err
+			}
+			if err = c.writePacket(auth); err != nil {
+				return err
+			}
+		case cachingSha2MoreAuthTag:
+			if plugin != authCachingSha2 || len(data) < 2 {
+				return moerr.NewInternalError(ctx, "unexpected auth more data packet")
+			}
+			switch data[1] {
+			case cachingSha2FastAuthOK:
+				// an OK packet follows
+			case cachingSha2FullAuth:
+				if err = c.writePacket([]byte{cachingSha2RequestKey}); err != nil {
+					return err
+				}
+				key, err := c.readPacket()
+				if err != nil {
+					return err
+				}
+				if key[0] == packetErr {
+					return parseErrPacket(ctx, key)
+				}
+				enc, err := encryptPassword(ctx, key[1:], scramble, c.cfg.Password)
+				if err != nil {
+					return err
+				}
+				if err = c.writePacket(enc); err != nil {
+					return err
+				}
+			default:
+				return moerr.NewInternalError(ctx, "unexpected caching_sha2_password state %d", data[1])
+			}
+		default:
+			return moerr.NewInternalError(ctx, "unexpected auth packet 0x%02x", data[0])
+		}
+	}
+}
+
+func scrambleAuth(ctx context.Context, plugin string, scramble []byte, password string) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, nil
+	}
+	if len(scramble) < 20 {
+		return nil, moerr.NewInternalError(ctx, "invalid auth scramble length %d", len(scramble))
+	}
+	switch plugin {
+	case authNativePassword:
+		// SHA1(password) XOR SHA1(scramble + SHA1(SHA1(password)))
+		h1 := sha1.Sum([]byte(password))
+		h2 := sha1.Sum(h1[:])
+		h := sha1.New()
+		h.Write(scramble[:20])
+		h.Write(h2[:])
+		h3 := h.Sum(nil)
+		for i := range h3 {
+			h3[i] ^= h1[i]
+		}
+		return h3, nil
+	case authCachingSha2:
+		// SHA256(password) XOR SHA256(SHA256(SHA256(password)) + scramble)
+		h1 := sha256.Sum256([]byte(password))
+		h2 := sha256.Sum256(h1[:])
+		h := sha256.New()
+		h.Write(h2[:])
+		h.Write(scramble[:20])
+		h3 := h.Sum(nil)
+		for i := range h3 {
+			h3[i] ^= h1[i]
+		}
+		return h3, nil
+	default:
+		return nil, moerr.NewInternalError(ctx, "unsupported auth plugin %s", plugin)
+	}
+}
+
+func encryptPassword(ctx context.Context, keyPEM []byte, scramble []byte, password string) ([]byte, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, moerr.NewInternalError(ctx, "invalid public key from upstream")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "invalid public key type %T", pub)
+	}
+	plain := append([]byte(password), 0)
+	for i := range plain {
+		plain[i] ^= scramble[i%len(scramble)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, rsaPub, plain, nil)
+}
+
+// Result is the text result set of a query. A nil value is NULL.
+type Result struct {
+	Columns []string
+	Rows    [][][]byte
+}
+
+// Query runs the sql and returns its result set. Statements without result
+// set return an empty Result.
+func (c *Conn) Query(ctx context.Context, sql string) (*Result, error) {
+	if err := c.writeCommand(comQuery, []byte(sql)); err != nil {
+		return nil, err
+	}
+	data, err := c.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	switch data[0] {
+	case packetOK:
+		return &Result{}, nil
+	case packetErr:
+		return nil, parseErrPacket(ctx, data)
+	}
+	r := reader{data: data}
+	count := int(r.lenEncInt())
+	res := &Result{Columns: make([]string, 0, count)}
+	for i := 0; i < count; i++ {
+		if data, err = c.readPacket(); err != nil {
+			return nil, err
+		}
+		r = reader{data: data}
+		// catalog, schema, table, org_table, name
+		for j := 0; j < 4; j++ {
+			r.lenEncString()
+		}
+		res.Columns = append(res.Columns, string(r.lenEncString()))
+	}
+	// EOF after the column definitions
+	if data, err = c.readPacket(); err != nil {
+		return nil, err
+	}
+	for {
+		if data, err = c.readPacket(); err != nil {
+			return nil, err
+		}
+		if data[0] == packetErr {
+			return nil, parseErrPacket(ctx, data)
+		}
+		if data[0] == packetEOF && len(data) < 9 {
+			return res, nil
+		}
+		r = reader{data: data}
+		row := make([][]byte, count)
+		for i := range row {
+			if r.peek() == 0xfb {
+				r.skip(1)
+				continue
+			}
+			row[i] = append([]byte{}, r.lenEncString()...)
+		}
+		if r.err != nil {
+			return nil, moerr.NewInternalError(ctx, "invalid row packet from upstream")
+		}
+		res.Rows = append(res.Rows, row)
+	}
+}
+
+// Exec runs the sql and discards its result.
+func (c *Conn) Exec(ctx context.Context, sql string) error {
+	_, err := c.Query(ctx, sql)
+	return err
+}
+
+// Position is a position in the binlog of the upstream.
+type Position struct {
+	File string
+	Pos  uint32
+}
+
+// MasterStatus returns the current binlog position of the upstream.
+func (c *Conn) MasterStatus(ctx context.Context) (Position, error) {
+	res, err := c.Query(ctx, "SHOW MASTER STATUS")
+	if err != nil {
+		return Position{}, err
+	}
+	if len(res.Rows) == 0 || len(res.Rows[0]) < 2 {
+		return Position{}, moerr.NewInternalError(ctx, "binlog is not enabled on upstream %s", c.cfg.Addr)
+	}
+	pos, err := strconv.ParseUint(string(res.Rows[0][1]), 10, 32)
+	if err != nil {
+		return Position{}, err
+	}
+	return Position{File: string(res.Rows[0][0]), Pos: uint32(pos)}, nil
+}
+
+// StartDump registers the connection as a replica and requests the binlog
+// stream from the position. The events are then read by ReadEvent. It returns
+// whether the events have the CRC32 checksum, see NewParser.
+func (c *Conn) StartDump(ctx context.Context, pos Position, heartbeat time.Duration) (bool, error) {
+	res, err := c.Query(ctx, "SELECT @@global.binlog_checksum")
+	if err != nil {
+		return false, err
+	}
+	checksum := false
+	if len(res.Rows) > 0 && len(res.Rows[0]) > 0 {
+		alg := string(res.Rows[0][0])
+		checksum = strings.EqualFold(alg, "CRC32")
+		// tell the upstream that we can handle the checksum, otherwise it
+		// refuses to send the binlog if binlog_checksum is not NONE.
+		if err = c.Exec(ctx, "SET @master_binlog_checksum = '"+alg+"'"); err != nil {
+			return false, err
+		}
+	}
+	if heartbeat > 0 {
+		if err = c.Exec(ctx, "SET @master_heartbeat_period = "+strconv.FormatInt(heartbeat.Nanoseconds(), 10)); err != nil {
+			return false, err
+		}
+	}
+
+	data := binary.LittleEndian.AppendUint32(nil, c.cfg.ServerID)
+	data = append(data, 0, 0, 0) // hostname, user, password
+	data = binary.LittleEndian.AppendUint16(data, 0)
+	data = binary.LittleEndian.AppendUint32(data, 0) // replication rank
+	data = binary.LittleEndian.AppendUint32(data, 0) // master id
+	if err = c.writeCommand(comRegisterSlave, data); err != nil {
+		return false, err
+	}
+	if err = c.readOK(ctx); err != nil {
+		return false, err
+	}
+
+	data = binary.LittleEndian.AppendUint32(nil, pos.Pos)
+	data = binary.LittleEndian.AppendUint16(data, 0)
+	data = binary.LittleEndian.AppendUint32(data, c.cfg.ServerID)
+	data = append(data, pos.File...)
+	return checksum, c.writeCommand(comBinlogDump, data)
+}
+
+// ReadEvent returns the next raw binlog event after StartDump. io.EOF is
+// returned if the upstream ends the stream. The read is interrupted if the
+// ctx is done, and the connection can not be used after that.
+func (c *Conn) ReadEvent(ctx context.Context) ([]byte, error) {
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.nc.SetReadDeadline(time.Now())
+	})
+	defer stop()
+	data, err := c.readPacket()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	switch data[0] {
+	case packetOK:
+		return data[1:], nil
+	case packetErr:
+		return nil, parseErrPacket(ctx, data)
+	case packetEOF:
+		if len(data) < 9 {
+			return nil, io.EOF
+		}
+	}
+	return nil, moerr.NewInternalError(ctx, "unexpected binlog packet 0x%02x", data[0])
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	momysql "github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql"
+	"github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql/mysqltest"
+)
+
+var testTable = &mysqltest.Table{
+	ID:     100,
+	Schema: "db1",
+	Name:   "t1",
+	Columns: []mysqltest.Column{
+		{Name: "id", Type: momysql.MysqlTypeLongLong},
+		{Name: "name", Type: momysql.MysqlTypeVarchar, Meta: 1024},
+		{Name: "score", Type: momysql.MysqlTypeTiny, Unsigned: true},
+	},
+	FullMetadata: true,
+}
+
+func readEvents(t *testing.T, ctx context.Context, c *momysql.Conn, p *momysql.Parser, n int) []*momysql.Event {
+	var events []*momysql.Event
+	for len(events) < n {
+		data, err := c.ReadEvent(ctx)
+		require.NoError(t, err)
+		ev, err := p.Parse(ctx, data)
+		require.NoError(t, err)
+		events = append(events, ev)
+	}
+	return events
+}
+
+func TestDial(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	producer, err := mysqltest.NewProducer("repl", "secret", true)
+	require.NoError(t, err)
+	defer producer.Close()
+
+	_, err = momysql.Dial(ctx, momysql.Config{Addr: producer.Addr(), User: "repl", Password: "bad"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Access denied")
+
+	c, err := momysql.Dial(ctx, momysql.Config{Addr: producer.Addr(), User: "repl", Password: "secret"})
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, "8.0.36-mysqltest", c.ServerVersion())
+
+	pos, err := c.MasterStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, producer.Position(), pos)
+
+	_, err = c.Query(ctx, "select 1")
+	require.Error(t, err)
+	// the connection is still usable after an error
+	require.NoError(t, c.Exec(ctx, "SET NAMES utf8mb4"))
+}
+
+func TestDump(t *testing.T) {
+	for _, checksum := range []bool{true, false} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		producer, err := mysqltest.NewProducer("repl", "", checksum)
+		require.NoError(t, err)
+
+		start := producer.Position()
+		producer.Append(
+			mysqltest.QueryEvent("db1", "BEGIN"),
+			mysqltest.TableMapEvent(testTable),
+			mysqltest.RowsEvent(testTable, momysql.RowsInsert,
+				[]any{int64(1), "a", uint8(200)},
+				[]any{int64(-2), nil, nil}),
+			mysqltest.XidEvent(10),
+		)
+		producer.Rotate()
+		producer.Append(
+			mysqltest.QueryEvent("db1", "BEGIN"),
+			mysqltest.TableMapEvent(testTable),
+			mysqltest.RowsEvent(testTable, momysql.RowsUpdate,
+				[]any{int64(1), "a", uint8(200)},
+				[]any{int64(1), "b", uint8(201)}),
+			mysqltest.RowsEvent(testTable, momysql.RowsDelete,
+				[]any{int64(-2), nil, nil}),
+			mysqltest.XidEvent(11),
+		)
+
+		c, err := momysql.Dial(ctx, momysql.Config{Addr: producer.Addr(), User: "repl", ServerID: 1001})
+		require.NoError(t, err)
+		hasChecksum, err := c.StartDump(ctx, start, time.Second)
+		require.NoError(t, err)
+		require.Equal(t, checksum, hasChecksum)
+		parser := momysql.NewParser(hasChecksum)
+
+		// the fake rotate event, the format description event and the first
+		// transaction
+		events := readEvents(t, ctx, c, parser, 7)
+		require.Equal(t, &momysql.RotateEvent{Pos: uint64(start.Pos), File: start.File}, events[0].Body)
+		require.Equal(t, "8.0.36-mysqltest", events[1].Body.(*momysql.FormatDescriptionEvent).ServerVersion)
+		require.Equal(t, "BEGIN", events[2].Body.(*momysql.QueryEvent).Query)
+		tm := events[3].Body.(*momysql.TableMapEvent)
+		require.Equal(t, "db1", tm.Schema)
+		require.Equal(t, "t1", tm.Table)
+		require.Equal(t, []string{"id", "name", "score"}, tm.ColumnNames)
+		require.Equal(t, []bool{false, false, true}, tm.Unsigned)
+		rows := events[4].Body.(*momysql.RowsEvent)
+		require.Equal(t, momysql.RowsInsert, rows.Action)
+		require.Equal(t, [][]any{
+			{int64(1), []byte("a"), uint64(200)},
+			{int64(-2), nil, nil},
+		}, rows.Rows)
+		require.Equal(t, &momysql.XidEvent{Xid: 10}, events[5].Body)
+		require.Equal(t, events[5].Header.LogPos, events[6].Header.LogPos-events[6].Header.EventSize)
+
+		// the rotate at the end of the file and the next file
+		rotate := events[6].Body.(*momysql.RotateEvent)
+		require.Equal(t, "mysql-bin.000002", rotate.File)
+		events = readEvents(t, ctx, c, parser, 6)
+		require.Equal(t, momysql.EventFormatDescription, events[0].Header.Type)
+		rows = events[3].Body.(*momysql.RowsEvent)
+		require.Equal(t, momysql.RowsUpdate, rows.Action)
+		require.Equal(t, []any{int64(1), []byte("b"), uint64(201)}, rows.Rows[1])
+		rows = events[4].Body.(*momysql.RowsEvent)
+		require.Equal(t, momysql.RowsDelete, rows.Action)
+		require.Equal(t, producer.Position().Pos, events[5].Header.LogPos)
+
+		require.Equal(t, []uint32{1001}, producer.Replicas())
+		require.NoError(t, c.Close())
+		producer.Close()
+		cancel()
+	}
+}
+
+func TestDumpInvalidPosition(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	producer, err := mysqltest.NewProducer("repl", "", false)
+	require.NoError(t, err)
+	defer producer.Close()
+
+	c, err := momysql.Dial(ctx, momysql.Config{Addr: producer.Addr(), User: "repl", ServerID: 1001})
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.StartDump(ctx, momysql.Position{File: "mysql-bin.000001", Pos: 5}, 0)
+	require.NoError(t, err)
+	_, err = c.ReadEvent(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1236")
+}
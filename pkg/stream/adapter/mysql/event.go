@@ -0,0 +1,506 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// EventType is the type code in the binlog event header.
+type EventType byte
+
+const (
+	EventUnknown           EventType = 0
+	EventQuery             EventType = 2
+	EventStop              EventType = 3
+	EventRotate            EventType = 4
+	EventFormatDescription EventType = 15
+	EventXid               EventType = 16
+	EventTableMap          EventType = 19
+	EventWriteRowsV1       EventType = 23
+	EventUpdateRowsV1      EventType = 24
+	EventDeleteRowsV1      EventType = 25
+	EventHeartbeat         EventType = 27
+	EventWriteRows         EventType = 30
+	EventUpdateRows        EventType = 31
+	EventDeleteRows        EventType = 32
+	EventGTID              EventType = 33
+	EventAnonymousGTID     EventType = 34
+	EventPreviousGTIDs     EventType = 35
+)
+
+const (
+	// EventHeaderLen is the length of the v4 event header.
+	EventHeaderLen = 19
+	// ChecksumLen is the length of the CRC32 checksum at the end of events.
+	ChecksumLen = 4
+
+	checksumAlgOff   byte = 0
+	checksumAlgCRC32 byte = 1
+
+	// the table id is 6 bytes since 5.1.4, and 4 bytes before.
+	tableIDLen = 6
+)
+
+// RowsAction is the kind of change carried by a rows event.
+type RowsAction int
+
+const (
+	RowsInsert RowsAction = iota
+	RowsUpdate
+	RowsDelete
+)
+
+// EventHeader is the common header of binlog events.
+type EventHeader struct {
+	Timestamp uint32
+	Type      EventType
+	ServerID  uint32
+	EventSize uint32
+	// LogPos is the position of the next event in the binlog file.
+	LogPos uint32
+	Flags  uint16
+}
+
+// Event is a parsed binlog event. Body is one of *RotateEvent,
+// *FormatDescriptionEvent, *QueryEvent, *XidEvent, *TableMapEvent,
+// *RowsEvent and *GTIDEvent, or nil for the events ignored by the parser.
+type Event struct {
+	Header EventHeader
+	Body   any
+}
+
+// RotateEvent tells the replica to switch to the next binlog file.
+type RotateEvent struct {
+	Pos  uint64
+	File string
+}
+
+// FormatDescriptionEvent describes the format of the following events.
+type FormatDescriptionEvent struct {
+	BinlogVersion uint16
+	ServerVersion string
+	ChecksumAlg   byte
+}
+
+// QueryEvent is a statement in the binlog. With row based replication it
+// carries BEGIN, COMMIT of non-transactional engines and DDL.
+type QueryEvent struct {
+	Schema string
+	Query  string
+}
+
+// XidEvent is the commit of a transaction.
+type XidEvent struct {
+	Xid uint64
+}
+
+// GTIDEvent is the GTID of the next transaction.
+type GTIDEvent struct {
+	SID []byte
+	GNO int64
+}
+
+// TableMapEvent maps a table id used by the following rows events to a table.
+type TableMapEvent struct {
+	TableID     uint64
+	Schema      string
+	Table       string
+	ColumnTypes []byte
+	ColumnMeta  []uint16
+	// Unsigned and ColumnNames are only known if the upstream runs with
+	// binlog_row_metadata=FULL, otherwise they are nil.
+	Unsigned    []bool
+	ColumnNames []string
+}
+
+// RowsEvent holds the row images changed by one statement of a table.
+type RowsEvent struct {
+	Table  *TableMapEvent
+	Action RowsAction
+	Flags  uint16
+	// Present marks the columns in the row images, with binlog_row_image
+	// other than FULL some columns may be missing. For updates, Present is
+	// for the before images and PresentAfter is for the after images.
+	Present      []bool
+	PresentAfter []bool
+	// Rows holds a full width image for each row, the missing columns are
+	// nil. For updates, each before image is followed by its after image.
+	Rows [][]any
+}
+
+// Parser parses the raw binlog events returned by Conn.ReadEvent. It keeps
+// the table maps and the format description, so a Parser must only be used
+// for one stream.
+type Parser struct {
+	checksum       bool
+	postHeaderLens []byte
+	tables         map[uint64]*TableMapEvent
+}
+
+// NewParser creates a parser. checksum tells whether the events before the
+// format description event have the checksum, it is the case if the upstream
+// binlog_checksum is CRC32.
+func NewParser(checksum bool) *Parser {
+	return &Parser{
+		checksum: checksum,
+		tables:   make(map[uint64]*TableMapEvent),
+	}
+}
+
+// Parse parses a raw event.
+func (p *Parser) Parse(ctx context.Context, data []byte) (*Event, error) {
+	if len(data) < EventHeaderLen {
+		return nil, moerr.NewInternalError(ctx, "binlog event is too short: %d bytes", len(data))
+	}
+	r := reader{data: data}
+	ev := &Event{
+		Header: EventHeader{
+			Timestamp: r.uint32(),
+			Type:      EventType(r.byte()),
+			ServerID:  r.uint32(),
+			EventSize: r.uint32(),
+			LogPos:    r.uint32(),
+			Flags:     r.uint16(),
+		},
+	}
+	if int(ev.Header.EventSize) != len(data) {
+		return nil, moerr.NewInternalError(ctx, "invalid binlog event size %d, got %d bytes", ev.Header.EventSize, len(data))
+	}
+
+	if ev.Header.Type == EventFormatDescription {
+		fde, err := p.parseFormatDescription(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		ev.Body = fde
+		return ev, nil
+	}
+
+	if p.checksum {
+		if len(data) < EventHeaderLen+ChecksumLen {
+			return nil, moerr.NewInternalError(ctx, "binlog event is too short: %d bytes", len(data))
+		}
+		if err := verifyChecksum(ctx, data); err != nil {
+			return nil, err
+		}
+		data = data[:len(data)-ChecksumLen]
+	}
+	body := data[EventHeaderLen:]
+
+	var err error
+	switch ev.Header.Type {
+	case EventRotate:
+		ev.Body, err = parseRotate(ctx, body)
+	case EventQuery:
+		ev.Body, err = p.parseQuery(ctx, body)
+	case EventXid:
+		r := reader{data: body}
+		ev.Body = &XidEvent{Xid: r.uint64()}
+		err = r.err
+	case EventGTID:
+		r := reader{data: body}
+		r.skip(1)
+		ev.Body = &GTIDEvent{SID: r.bytes(16), GNO: int64(r.uint64())}
+		err = r.err
+	case EventTableMap:
+		var tm *TableMapEvent
+		if tm, err = p.parseTableMap(ctx, body); err == nil {
+			p.tables[tm.TableID] = tm
+			ev.Body = tm
+		}
+	case EventWriteRowsV1, EventUpdateRowsV1, EventDeleteRowsV1,
+		EventWriteRows, EventUpdateRows, EventDeleteRows:
+		ev.Body, err = p.parseRows(ctx, ev.Header.Type, body)
+	}
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "invalid binlog event %d at %d: %v", ev.Header.Type, ev.Header.LogPos, err)
+	}
+	return ev, nil
+}
+
+func verifyChecksum(ctx context.Context, data []byte) error {
+	n := len(data) - ChecksumLen
+	r := reader{data: data[n:]}
+	if expected := r.uint32(); crc32.ChecksumIEEE(data[:n]) != expected {
+		return moerr.NewInternalError(ctx, "binlog event checksum mismatch")
+	}
+	return nil
+}
+
+func (p *Parser) postHeaderLen(typ EventType, def int) int {
+	if int(typ)-1 < len(p.postHeaderLens) {
+		return int(p.postHeaderLens[typ-1])
+	}
+	return def
+}
+
+func (p *Parser) parseFormatDescription(ctx context.Context, data []byte) (*FormatDescriptionEvent, error) {
+	r := reader{data: data[EventHeaderLen:]}
+	fde := &FormatDescriptionEvent{
+		BinlogVersion: r.uint16(),
+		ServerVersion: strings.TrimRight(string(r.bytes(50)), "\x00"),
+	}
+	r.skip(4)
+	if headerLen := r.byte(); r.err == nil && headerLen != EventHeaderLen {
+		return nil, moerr.NewInternalError(ctx, "unsupported binlog event header length %d", headerLen)
+	}
+	lens := r.rest()
+	if r.err != nil {
+		return nil, moerr.NewInternalError(ctx, "invalid format description event")
+	}
+	fde.ChecksumAlg = checksumAlgOff
+	if versionAtLeast(fde.ServerVersion, 5, 6, 1) {
+		if len(lens) < 1+ChecksumLen {
+			return nil, moerr.NewInternalError(ctx, "invalid format description event")
+		}
+		fde.ChecksumAlg = lens[len(lens)-1-ChecksumLen]
+		lens = lens[:len(lens)-1-ChecksumLen]
+		if fde.ChecksumAlg == checksumAlgCRC32 {
+			if err := verifyChecksum(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.checksum = fde.ChecksumAlg == checksumAlgCRC32
+	p.postHeaderLens = append(p.postHeaderLens[:0], lens...)
+	return fde, nil
+}
+
+// versionAtLeast compares the leading x.y.z of the server version.
+func versionAtLeast(version string, want ...int) bool {
+	parts := strings.SplitN(version, ".", len(want))
+	for i, w := range want {
+		if i >= len(parts) {
+			return false
+		}
+		s := parts[i]
+		j := 0
+		for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			j++
+		}
+		v, _ := strconv.Atoi(s[:j])
+		if v != w {
+			return v > w
+		}
+	}
+	return true
+}
+
+func parseRotate(ctx context.Context, body []byte) (*RotateEvent, error) {
+	r := reader{data: body}
+	ev := &RotateEvent{Pos: r.uint64()}
+	ev.File = string(r.rest())
+	return ev, r.err
+}
+
+func (p *Parser) parseQuery(ctx context.Context, body []byte) (*QueryEvent, error) {
+	r := reader{data: body}
+	r.skip(4 + 4) // thread id, exec time
+	schemaLen := int(r.byte())
+	r.skip(2) // error code
+	statusLen := 0
+	if p.postHeaderLen(EventQuery, 13) >= 13 {
+		statusLen = int(r.uint16())
+	}
+	r.skip(statusLen)
+	ev := &QueryEvent{Schema: string(r.bytes(schemaLen))}
+	r.skip(1)
+	ev.Query = string(r.rest())
+	return ev, r.err
+}
+
+func (p *Parser) readTableID(r *reader, typ EventType) uint64 {
+	if p.postHeaderLen(typ, tableIDLen+2) == 6 {
+		return uint64(r.uint32())
+	}
+	return r.uint48()
+}
+
+func (p *Parser) parseTableMap(ctx context.Context, body []byte) (*TableMapEvent, error) {
+	r := reader{data: body}
+	tm := &TableMapEvent{TableID: p.readTableID(&r, EventTableMap)}
+	r.skip(2)
+	tm.Schema = string(r.bytes(int(r.byte())))
+	r.skip(1)
+	tm.Table = string(r.bytes(int(r.byte())))
+	r.skip(1)
+	count := int(r.lenEncInt())
+	if r.err != nil || count > r.left() {
+		return nil, moerr.NewInternalError(ctx, "invalid column count")
+	}
+	tm.ColumnTypes = append([]byte{}, r.bytes(count)...)
+	meta := reader{data: r.lenEncString()}
+	tm.ColumnMeta = make([]uint16, count)
+	for i, typ := range tm.ColumnTypes {
+		switch typ {
+		case MysqlTypeFloat, MysqlTypeDouble, MysqlTypeBlob, MysqlTypeGeometry,
+			MysqlTypeJSON, MysqlTypeTimestamp2, MysqlTypeDatetime2, MysqlTypeTime2:
+			tm.ColumnMeta[i] = uint16(meta.byte())
+		case MysqlTypeVarchar, MysqlTypeVarString, MysqlTypeBit:
+			tm.ColumnMeta[i] = meta.uint16()
+		case MysqlTypeNewDecimal, MysqlTypeString, MysqlTypeEnum, MysqlTypeSet:
+			// big endian: precision and scale, or real type and length
+			b := meta.bytes(2)
+			if b != nil {
+				tm.ColumnMeta[i] = uint16(b[0])<<8 | uint16(b[1])
+			}
+		}
+	}
+	if meta.err != nil {
+		return nil, moerr.NewInternalError(ctx, "invalid column metadata")
+	}
+	r.skip((count + 7) / 8) // null bitmap
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	// optional metadata
+	for !r.done() {
+		typ := r.byte()
+		field := reader{data: r.lenEncString()}
+		if r.err != nil {
+			return nil, r.err
+		}
+		switch typ {
+		case 1: // SIGNEDNESS
+			bits := field.rest()
+			tm.Unsigned = make([]bool, count)
+			n := 0
+			for i, ct := range tm.ColumnTypes {
+				if !isNumericType(ct) {
+					continue
+				}
+				if n/8 < len(bits) {
+					tm.Unsigned[i] = bits[n/8]&(0x80>>(n%8)) != 0
+				}
+				n++
+			}
+		case 4: // COLUMN_NAME
+			names := make([]string, 0, count)
+			for !field.done() {
+				names = append(names, string(field.lenEncString()))
+			}
+			if field.err == nil && len(names) == count {
+				tm.ColumnNames = names
+			}
+		}
+	}
+	return tm, nil
+}
+
+func isNumericType(typ byte) bool {
+	switch typ {
+	case MysqlTypeTiny, MysqlTypeShort, MysqlTypeInt24, MysqlTypeLong, MysqlTypeLongLong,
+		MysqlTypeFloat, MysqlTypeDouble, MysqlTypeDecimal, MysqlTypeNewDecimal:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) parseRows(ctx context.Context, typ EventType, body []byte) (*RowsEvent, error) {
+	r := reader{data: body}
+	tableID := p.readTableID(&r, typ)
+	ev := &RowsEvent{Flags: r.uint16()}
+	if typ >= EventWriteRows {
+		// the extra data length includes itself
+		r.skip(int(r.uint16()) - 2)
+	}
+	switch typ {
+	case EventWriteRowsV1, EventWriteRows:
+		ev.Action = RowsInsert
+	case EventUpdateRowsV1, EventUpdateRows:
+		ev.Action = RowsUpdate
+	default:
+		ev.Action = RowsDelete
+	}
+	tm, ok := p.tables[tableID]
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "no table map for table id %d", tableID)
+	}
+	ev.Table = tm
+	count := int(r.lenEncInt())
+	if count != len(tm.ColumnTypes) {
+		return nil, moerr.NewInternalError(ctx, "rows event of %s.%s has %d columns, table map has %d",
+			tm.Schema, tm.Table, count, len(tm.ColumnTypes))
+	}
+	ev.Present = readBitmap(&r, count)
+	if ev.Action == RowsUpdate {
+		ev.PresentAfter = readBitmap(&r, count)
+	}
+	for !r.done() && r.err == nil {
+		row, err := readRowImage(&r, tm, ev.Present)
+		if err != nil {
+			return nil, err
+		}
+		ev.Rows = append(ev.Rows, row)
+		if ev.Action == RowsUpdate {
+			if row, err = readRowImage(&r, tm, ev.PresentAfter); err != nil {
+				return nil, err
+			}
+			ev.Rows = append(ev.Rows, row)
+		}
+	}
+	return ev, r.err
+}
+
+func readBitmap(r *reader, n int) []bool {
+	b := r.bytes((n + 7) / 8)
+	if b == nil {
+		return nil
+	}
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = b[i/8]&(1<<(i%8)) != 0
+	}
+	return bits
+}
+
+func readRowImage(r *reader, tm *TableMapEvent, present []bool) ([]any, error) {
+	n := 0
+	for _, ok := range present {
+		if ok {
+			n++
+		}
+	}
+	nulls := readBitmap(r, n)
+	if r.err != nil {
+		return nil, r.err
+	}
+	row := make([]any, len(present))
+	j := 0
+	for i, ok := range present {
+		if !ok {
+			continue
+		}
+		if nulls[j] {
+			j++
+			continue
+		}
+		j++
+		unsigned := tm.Unsigned != nil && tm.Unsigned[i]
+		v, err := readValue(r, tm.ColumnTypes[i], tm.ColumnMeta[i], unsigned)
+		if err != nil {
+			return nil, err
+		}
+		row[i] = v
+	}
+	return row, r.err
+}
@@ -0,0 +1,317 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// The value types of the MySQL binary JSON.
+const (
+	jsonSmallObject byte = 0x00
+	jsonLargeObject byte = 0x01
+	jsonSmallArray  byte = 0x02
+	jsonLargeArray  byte = 0x03
+	jsonLiteral     byte = 0x04
+	jsonInt16       byte = 0x05
+	jsonUint16      byte = 0x06
+	jsonInt32       byte = 0x07
+	jsonUint32      byte = 0x08
+	jsonInt64       byte = 0x09
+	jsonUint64      byte = 0x0a
+	jsonDouble      byte = 0x0b
+	jsonString      byte = 0x0c
+	jsonOpaque      byte = 0x0f
+
+	jsonLiteralNull  byte = 0x00
+	jsonLiteralTrue  byte = 0x01
+	jsonLiteralFalse byte = 0x02
+)
+
+// DecodeJSON converts the MySQL binary JSON in the binlog to the JSON text.
+func DecodeJSON(data []byte) (string, error) {
+	// an empty value is the JSON null, it's logged for the JSON columns of
+	// rows inserted before the column is added.
+	if len(data) == 0 {
+		return "null", nil
+	}
+	var sb strings.Builder
+	if err := decodeJSONValue(&sb, data[0], data[1:]); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func invalidJSON() error {
+	return moerr.NewInternalErrorNoCtx("invalid binary json in binlog")
+}
+
+func decodeJSONValue(sb *strings.Builder, typ byte, data []byte) error {
+	switch typ {
+	case jsonSmallObject, jsonLargeObject:
+		return decodeJSONContainer(sb, data, typ == jsonLargeObject, true)
+	case jsonSmallArray, jsonLargeArray:
+		return decodeJSONContainer(sb, data, typ == jsonLargeArray, false)
+	case jsonLiteral:
+		if len(data) < 1 {
+			return invalidJSON()
+		}
+		switch data[0] {
+		case jsonLiteralNull:
+			sb.WriteString("null")
+		case jsonLiteralTrue:
+			sb.WriteString("true")
+		case jsonLiteralFalse:
+			sb.WriteString("false")
+		default:
+			return invalidJSON()
+		}
+	case jsonInt16, jsonUint16, jsonInt32, jsonUint32, jsonInt64, jsonUint64, jsonDouble:
+		n := 8
+		switch typ {
+		case jsonInt16, jsonUint16:
+			n = 2
+		case jsonInt32, jsonUint32:
+			n = 4
+		}
+		if len(data) < n {
+			return invalidJSON()
+		}
+		switch typ {
+		case jsonInt16:
+			sb.WriteString(strconv.FormatInt(int64(int16(binary.LittleEndian.Uint16(data))), 10))
+		case jsonUint16:
+			sb.WriteString(strconv.FormatUint(uint64(binary.LittleEndian.Uint16(data)), 10))
+		case jsonInt32:
+			sb.WriteString(strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(data))), 10))
+		case jsonUint32:
+			sb.WriteString(strconv.FormatUint(uint64(binary.LittleEndian.Uint32(data)), 10))
+		case jsonInt64:
+			sb.WriteString(strconv.FormatInt(int64(binary.LittleEndian.Uint64(data)), 10))
+		case jsonUint64:
+			sb.WriteString(strconv.FormatUint(binary.LittleEndian.Uint64(data), 10))
+		case jsonDouble:
+			sb.WriteString(strconv.FormatFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)), 'g', -1, 64))
+		}
+	case jsonString:
+		s, err := readJSONString(data)
+		if err != nil {
+			return err
+		}
+		writeJSONString(sb, s)
+	case jsonOpaque:
+		return decodeJSONOpaque(sb, data)
+	default:
+		return invalidJSON()
+	}
+	return nil
+}
+
+// readJSONVarLen reads the variable length integer of 7 bits per byte.
+func readJSONVarLen(data []byte) (int, int, error) {
+	v := 0
+	for i := 0; i < 5 && i < len(data); i++ {
+		v |= int(data[i]&0x7f) << (7 * i)
+		if data[i]&0x80 == 0 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, invalidJSON()
+}
+
+func readJSONString(data []byte) (string, error) {
+	n, size, err := readJSONVarLen(data)
+	if err != nil {
+		return "", err
+	}
+	if size+n > len(data) {
+		return "", invalidJSON()
+	}
+	return string(data[size : size+n]), nil
+}
+
+func writeJSONString(sb *strings.Builder, s string) {
+	b, _ := json.Marshal(s)
+	sb.Write(b)
+}
+
+func decodeJSONContainer(sb *strings.Builder, data []byte, large bool, object bool) error {
+	offsetSize := 2
+	if large {
+		offsetSize = 4
+	}
+	readOffset := func(pos int) (int, error) {
+		if pos+offsetSize > len(data) {
+			return 0, invalidJSON()
+		}
+		if large {
+			return int(binary.LittleEndian.Uint32(data[pos:])), nil
+		}
+		return int(binary.LittleEndian.Uint16(data[pos:])), nil
+	}
+	count, err := readOffset(0)
+	if err != nil {
+		return err
+	}
+	size, err := readOffset(offsetSize)
+	if err != nil {
+		return err
+	}
+	if size > len(data) {
+		return invalidJSON()
+	}
+	data = data[:size]
+
+	keyEntrySize := offsetSize + 2
+	valueEntrySize := 1 + offsetSize
+	pos := 2 * offsetSize
+	keysPos := pos
+	valuesPos := pos
+	if object {
+		valuesPos += count * keyEntrySize
+	}
+	if valuesPos+count*valueEntrySize > len(data) {
+		return invalidJSON()
+	}
+
+	if object {
+		sb.WriteByte('{')
+	} else {
+		sb.WriteByte('[')
+	}
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		if object {
+			entry := keysPos + i*keyEntrySize
+			offset, err := readOffset(entry)
+			if err != nil {
+				return err
+			}
+			length := int(binary.LittleEndian.Uint16(data[entry+offsetSize:]))
+			if offset+length > len(data) {
+				return invalidJSON()
+			}
+			writeJSONString(sb, string(data[offset:offset+length]))
+			sb.WriteString(": ")
+		}
+		entry := valuesPos + i*valueEntrySize
+		typ := data[entry]
+		if isJSONInlined(typ, large) {
+			if err = decodeJSONValue(sb, typ, data[entry+1:entry+1+offsetSize]); err != nil {
+				return err
+			}
+			continue
+		}
+		offset, err := readOffset(entry + 1)
+		if err != nil {
+			return err
+		}
+		if offset >= len(data) {
+			return invalidJSON()
+		}
+		if err = decodeJSONValue(sb, typ, data[offset:]); err != nil {
+			return err
+		}
+	}
+	if object {
+		sb.WriteByte('}')
+	} else {
+		sb.WriteByte(']')
+	}
+	return nil
+}
+
+func isJSONInlined(typ byte, large bool) bool {
+	switch typ {
+	case jsonLiteral, jsonInt16, jsonUint16:
+		return true
+	case jsonInt32, jsonUint32:
+		return large
+	}
+	return false
+}
+
+func decodeJSONOpaque(sb *strings.Builder, data []byte) error {
+	if len(data) < 1 {
+		return invalidJSON()
+	}
+	fieldType := data[0]
+	n, size, err := readJSONVarLen(data[1:])
+	if err != nil {
+		return err
+	}
+	if 1+size+n > len(data) {
+		return invalidJSON()
+	}
+	value := data[1+size : 1+size+n]
+	switch fieldType {
+	case MysqlTypeNewDecimal:
+		if len(value) < 2 {
+			return invalidJSON()
+		}
+		r := reader{data: value[2:]}
+		v, err := readDecimal(&r, int(value[0]), int(value[1]))
+		if err != nil {
+			return err
+		}
+		sb.WriteString(v.(string))
+		return nil
+	case MysqlTypeDate, MysqlTypeDatetime, MysqlTypeTimestamp, MysqlTypeTime:
+		if len(value) < 8 {
+			return invalidJSON()
+		}
+		sb.WriteByte('"')
+		sb.WriteString(formatPackedTemporal(fieldType, int64(binary.LittleEndian.Uint64(value))))
+		sb.WriteByte('"')
+		return nil
+	}
+	writeJSONString(sb, fmt.Sprintf("base64:type%d:%s", fieldType, base64.StdEncoding.EncodeToString(value)))
+	return nil
+}
+
+// formatPackedTemporal formats the packed temporal values in the binary JSON.
+func formatPackedTemporal(typ byte, packed int64) string {
+	sign := ""
+	if packed < 0 {
+		sign = "-"
+		packed = -packed
+	}
+	intPart, frac := packed>>24, packed%(1<<24)
+	fracStr := ""
+	if frac != 0 {
+		fracStr = fmt.Sprintf(".%06d", frac)
+	}
+	if typ == MysqlTypeTime {
+		return fmt.Sprintf("%s%02d:%02d:%02d%s", sign,
+			(intPart>>12)%(1<<10), (intPart>>6)%64, intPart%64, fracStr)
+	}
+	ymd, hms := intPart>>17, intPart%(1<<17)
+	ym := ymd >> 5
+	date := fmt.Sprintf("%04d-%02d-%02d", ym/13, ym%13, ymd%32)
+	if typ == MysqlTypeDate {
+		return date
+	}
+	return fmt.Sprintf("%s %02d:%02d:%02d%s", date, hms>>12, (hms>>6)%64, hms%64, fracStr)
+}
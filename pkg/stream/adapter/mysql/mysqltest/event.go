@@ -0,0 +1,260 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysqltest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	momysql "github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql"
+)
+
+// Column is a column of a Table.
+type Column struct {
+	Name     string
+	Type     byte
+	Meta     uint16
+	Unsigned bool
+}
+
+// Table is the upstream table of the table map and rows events.
+type Table struct {
+	ID      uint64
+	Schema  string
+	Name    string
+	Columns []Column
+	// FullMetadata logs the signedness and the column names in the table
+	// map event, like binlog_row_metadata=FULL.
+	FullMetadata bool
+}
+
+// QueryEvent makes a QUERY_EVENT, such as BEGIN.
+func QueryEvent(schema, query string) []byte {
+	body := binary.LittleEndian.AppendUint32(nil, 1) // thread id
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = append(body, byte(len(schema)))
+	body = binary.LittleEndian.AppendUint16(body, 0) // error code
+	body = binary.LittleEndian.AppendUint16(body, 0) // status vars
+	body = append(body, schema...)
+	body = append(body, 0)
+	body = append(body, query...)
+	return makeEvent(momysql.EventQuery, 0, body)
+}
+
+// XidEvent makes the XID_EVENT which commits the transaction.
+func XidEvent(xid uint64) []byte {
+	return makeEvent(momysql.EventXid, 0, binary.LittleEndian.AppendUint64(nil, xid))
+}
+
+func appendTableID(data []byte, id uint64) []byte {
+	data = binary.LittleEndian.AppendUint32(data, uint32(id))
+	return binary.LittleEndian.AppendUint16(data, uint16(id>>32))
+}
+
+// TableMapEvent makes the TABLE_MAP_EVENT of the table.
+func TableMapEvent(t *Table) []byte {
+	body := appendTableID(nil, t.ID)
+	body = binary.LittleEndian.AppendUint16(body, 0)
+	body = append(body, byte(len(t.Schema)))
+	body = append(body, t.Schema...)
+	body = append(body, 0)
+	body = append(body, byte(len(t.Name)))
+	body = append(body, t.Name...)
+	body = append(body, 0)
+	body = append(body, byte(len(t.Columns)))
+	var meta []byte
+	for _, col := range t.Columns {
+		body = append(body, col.Type)
+		switch col.Type {
+		case momysql.MysqlTypeFloat, momysql.MysqlTypeDouble, momysql.MysqlTypeBlob,
+			momysql.MysqlTypeJSON, momysql.MysqlTypeTimestamp2, momysql.MysqlTypeDatetime2, momysql.MysqlTypeTime2:
+			meta = append(meta, byte(col.Meta))
+		case momysql.MysqlTypeVarchar, momysql.MysqlTypeVarString, momysql.MysqlTypeBit:
+			meta = binary.LittleEndian.AppendUint16(meta, col.Meta)
+		case momysql.MysqlTypeNewDecimal, momysql.MysqlTypeString:
+			meta = append(meta, byte(col.Meta>>8), byte(col.Meta))
+		}
+	}
+	body = append(body, byte(len(meta)))
+	body = append(body, meta...)
+	// all the columns are nullable
+	nullable := make([]byte, (len(t.Columns)+7)/8)
+	for i := range nullable {
+		nullable[i] = 0xff
+	}
+	body = append(body, nullable...)
+
+	if t.FullMetadata {
+		var signedness []byte
+		n := 0
+		var names []byte
+		for _, col := range t.Columns {
+			names = append(names, byte(len(col.Name)))
+			names = append(names, col.Name...)
+			switch col.Type {
+			case momysql.MysqlTypeTiny, momysql.MysqlTypeShort, momysql.MysqlTypeInt24, momysql.MysqlTypeLong,
+				momysql.MysqlTypeLongLong, momysql.MysqlTypeFloat, momysql.MysqlTypeDouble, momysql.MysqlTypeNewDecimal:
+				if n%8 == 0 {
+					signedness = append(signedness, 0)
+				}
+				if col.Unsigned {
+					signedness[n/8] |= 0x80 >> (n % 8)
+				}
+				n++
+			}
+		}
+		body = append(body, 1, byte(len(signedness)))
+		body = append(body, signedness...)
+		body = append(body, 4, byte(len(names)))
+		body = append(body, names...)
+	}
+	return makeEvent(momysql.EventTableMap, 0, body)
+}
+
+// RowsEvent makes the v2 rows event of the table with the full row images.
+// For RowsUpdate, the rows are the before and after images in pairs. The
+// values are encoded according to the column types: integers for the
+// integer types, float32 and float64 for FLOAT and DOUBLE, string or []byte
+// for the string types, "YYYY-MM-DD" for DATE and "YYYY-MM-DD hh:mm:ss" for
+// DATETIME2 with meta 0. It panics on the other types.
+func RowsEvent(t *Table, action momysql.RowsAction, rows ...[]any) []byte {
+	typ := momysql.EventWriteRows
+	switch action {
+	case momysql.RowsUpdate:
+		typ = momysql.EventUpdateRows
+	case momysql.RowsDelete:
+		typ = momysql.EventDeleteRows
+	}
+	body := appendTableID(nil, t.ID)
+	// the flags are STMT_END
+	body = binary.LittleEndian.AppendUint16(body, 1)
+	body = binary.LittleEndian.AppendUint16(body, 2)
+	body = append(body, byte(len(t.Columns)))
+	present := make([]byte, (len(t.Columns)+7)/8)
+	for i := range t.Columns {
+		present[i/8] |= 1 << (i % 8)
+	}
+	body = append(body, present...)
+	if action == momysql.RowsUpdate {
+		body = append(body, present...)
+	}
+	for _, row := range rows {
+		nulls := make([]byte, (len(t.Columns)+7)/8)
+		for i, v := range row {
+			if v == nil {
+				nulls[i/8] |= 1 << (i % 8)
+			}
+		}
+		body = append(body, nulls...)
+		for i, v := range row {
+			if v != nil {
+				body = appendValue(body, t.Columns[i], v)
+			}
+		}
+	}
+	return makeEvent(typ, 0, body)
+}
+
+func toUint64(v any) uint64 {
+	switch v := v.(type) {
+	case int:
+		return uint64(v)
+	case int8:
+		return uint64(v)
+	case int16:
+		return uint64(v)
+	case int32:
+		return uint64(v)
+	case int64:
+		return uint64(v)
+	case uint:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	}
+	panic(fmt.Sprintf("%T is not an integer", v))
+}
+
+func toBytes(v any) []byte {
+	switch v := v.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	}
+	panic(fmt.Sprintf("%T is not a string", v))
+}
+
+func appendValue(data []byte, col Column, v any) []byte {
+	switch col.Type {
+	case momysql.MysqlTypeTiny:
+		return append(data, byte(toUint64(v)))
+	case momysql.MysqlTypeShort:
+		return binary.LittleEndian.AppendUint16(data, uint16(toUint64(v)))
+	case momysql.MysqlTypeInt24:
+		u := toUint64(v)
+		return append(data, byte(u), byte(u>>8), byte(u>>16))
+	case momysql.MysqlTypeLong:
+		return binary.LittleEndian.AppendUint32(data, uint32(toUint64(v)))
+	case momysql.MysqlTypeLongLong:
+		return binary.LittleEndian.AppendUint64(data, toUint64(v))
+	case momysql.MysqlTypeFloat:
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(v.(float32)))
+	case momysql.MysqlTypeDouble:
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(v.(float64)))
+	case momysql.MysqlTypeVarchar, momysql.MysqlTypeVarString:
+		b := toBytes(v)
+		if col.Meta < 256 {
+			data = append(data, byte(len(b)))
+		} else {
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(b)))
+		}
+		return append(data, b...)
+	case momysql.MysqlTypeString:
+		b := toBytes(v)
+		return append(append(data, byte(len(b))), b...)
+	case momysql.MysqlTypeBlob:
+		b := toBytes(v)
+		for i := 0; i < int(col.Meta); i++ {
+			data = append(data, byte(len(b)>>(8*i)))
+		}
+		return append(data, b...)
+	case momysql.MysqlTypeDate:
+		d, err := time.Parse("2006-01-02", v.(string))
+		if err != nil {
+			panic(err)
+		}
+		u := uint32(d.Year())<<9 | uint32(d.Month())<<5 | uint32(d.Day())
+		return append(data, byte(u), byte(u>>8), byte(u>>16))
+	case momysql.MysqlTypeDatetime2:
+		d, err := time.Parse("2006-01-02 15:04:05", v.(string))
+		if err != nil {
+			panic(err)
+		}
+		ym := uint64(d.Year())*13 + uint64(d.Month())
+		u := (ym<<5|uint64(d.Day()))<<17 | uint64(d.Hour())<<12 | uint64(d.Minute())<<6 | uint64(d.Second())
+		u += 0x8000000000
+		return append(data, byte(u>>32), byte(u>>24), byte(u>>16), byte(u>>8), byte(u))
+	}
+	panic(fmt.Sprintf("unsupported column type %d", col.Type))
+}
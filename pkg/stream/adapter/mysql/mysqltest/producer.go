@@ -0,0 +1,544 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mysqltest provides a fake MySQL binlog producer for the tests of
+// the MySQL replication connector. It speaks just enough of the MySQL
+// protocol for a replica: the mysql_native_password handshake, the queries
+// sent before the dump, COM_REGISTER_SLAVE and COM_BINLOG_DUMP.
+package mysqltest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	momysql "github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql"
+)
+
+const (
+	serverVersion = "8.0.36-mysqltest"
+
+	comQuit          = 0x01
+	comQuery         = 0x03
+	comBinlogDump    = 0x12
+	comRegisterSlave = 0x15
+
+	// LOG_EVENT_ARTIFICIAL_F marks the events not in the binlog file.
+	artificialFlag = 0x20
+
+	// the first event is at 4, after the binlog magic number.
+	binlogStartPos = 4
+)
+
+// postHeaderLens is the post header length of the event types from 1 to 41
+// in the format description event, the same as MySQL 8.0.
+var postHeaderLens = []byte{
+	56, 13, 0, 8, 0, 18, 0, 4, 4, 4, 4, 18, 0, 0, 98, 0, 4, 26, 8, 0,
+	0, 0, 8, 8, 8, 2, 0, 0, 0, 10, 10, 10, 42, 42, 0, 18, 52, 0, 10, 40,
+	0,
+}
+
+type binlogFile struct {
+	name    string
+	events  [][]byte
+	offsets []uint32
+	end     uint32
+}
+
+// Producer is a fake MySQL server which serves the binlog appended by the
+// tests.
+type Producer struct {
+	user     string
+	password string
+	checksum bool
+	ln       net.Listener
+
+	mu struct {
+		sync.Mutex
+		files    []*binlogFile
+		conns    map[net.Conn]struct{}
+		serverID []uint32
+		closed   bool
+		// changed is closed and replaced when the binlog is appended.
+		changed chan struct{}
+	}
+	wg sync.WaitGroup
+}
+
+// NewProducer starts a fake producer listening on a random local port. With
+// checksum, the events are logged with CRC32 like binlog_checksum=CRC32.
+func NewProducer(user, password string, checksum bool) (*Producer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	p := &Producer{
+		user:     user,
+		password: password,
+		checksum: checksum,
+		ln:       ln,
+	}
+	p.mu.conns = make(map[net.Conn]struct{})
+	p.mu.changed = make(chan struct{})
+	p.newFileLocked("mysql-bin.000001")
+	p.wg.Add(1)
+	go p.serve()
+	return p, nil
+}
+
+// Addr returns the address of the producer.
+func (p *Producer) Addr() string {
+	return p.ln.Addr().String()
+}
+
+// Close stops the producer and closes all the connections.
+func (p *Producer) Close() {
+	p.mu.Lock()
+	p.mu.closed = true
+	for c := range p.mu.conns {
+		_ = c.Close()
+	}
+	close(p.mu.changed)
+	p.mu.Unlock()
+	_ = p.ln.Close()
+	p.wg.Wait()
+}
+
+// Disconnect closes all the client connections, the clients could connect
+// again.
+func (p *Producer) Disconnect() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for c := range p.mu.conns {
+		_ = c.Close()
+	}
+}
+
+// Replicas returns the server ids registered by COM_REGISTER_SLAVE.
+func (p *Producer) Replicas() []uint32 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]uint32(nil), p.mu.serverID...)
+}
+
+// Position returns the end of the binlog.
+func (p *Producer) Position() momysql.Position {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f := p.mu.files[len(p.mu.files)-1]
+	return momysql.Position{File: f.name, Pos: f.end}
+}
+
+// Append appends the events made by the Event functions to the binlog. The
+// log positions and the checksums of the events are filled by Append.
+func (p *Producer) Append(events ...[]byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f := p.mu.files[len(p.mu.files)-1]
+	for _, ev := range events {
+		p.appendLocked(f, ev)
+	}
+	p.notifyLocked()
+}
+
+// Rotate appends a rotate event and switches to the next binlog file.
+func (p *Producer) Rotate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f := p.mu.files[len(p.mu.files)-1]
+	seq, _ := strconv.Atoi(f.name[strings.LastIndexByte(f.name, '.')+1:])
+	next := fmt.Sprintf("mysql-bin.%06d", seq+1)
+	body := binary.LittleEndian.AppendUint64(nil, binlogStartPos)
+	body = append(body, next...)
+	p.appendLocked(f, makeEvent(momysql.EventRotate, 0, body))
+	p.newFileLocked(next)
+	p.notifyLocked()
+}
+
+func (p *Producer) newFileLocked(name string) {
+	f := &binlogFile{name: name, end: binlogStartPos}
+	p.mu.files = append(p.mu.files, f)
+	p.appendLocked(f, p.formatDescription())
+}
+
+func (p *Producer) notifyLocked() {
+	if !p.mu.closed {
+		close(p.mu.changed)
+		p.mu.changed = make(chan struct{})
+	}
+}
+
+func (p *Producer) appendLocked(f *binlogFile, ev []byte) {
+	ev = append([]byte(nil), ev...)
+	if p.checksum && momysql.EventType(ev[4]) != momysql.EventFormatDescription {
+		ev = append(ev, 0, 0, 0, 0)
+	}
+	binary.LittleEndian.PutUint32(ev[9:], uint32(len(ev)))
+	binary.LittleEndian.PutUint32(ev[13:], f.end+uint32(len(ev)))
+	p.sum(ev)
+	f.offsets = append(f.offsets, f.end)
+	f.events = append(f.events, ev)
+	f.end += uint32(len(ev))
+}
+
+// sum fills the checksum of the event if the checksum is on.
+func (p *Producer) sum(ev []byte) {
+	if p.checksum || momysql.EventType(ev[4]) == momysql.EventFormatDescription {
+		n := len(ev) - momysql.ChecksumLen
+		binary.LittleEndian.PutUint32(ev[n:], crc32.ChecksumIEEE(ev[:n]))
+	}
+}
+
+func (p *Producer) formatDescription() []byte {
+	body := binary.LittleEndian.AppendUint16(nil, 4)
+	var version [50]byte
+	copy(version[:], serverVersion)
+	body = append(body, version[:]...)
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = append(body, momysql.EventHeaderLen)
+	body = append(body, postHeaderLens...)
+	if p.checksum {
+		body = append(body, 1)
+	} else {
+		body = append(body, 0)
+	}
+	body = append(body, 0, 0, 0, 0)
+	return makeEvent(momysql.EventFormatDescription, 0, body)
+}
+
+func (p *Producer) serve() {
+	defer p.wg.Done()
+	for {
+		c, err := p.ln.Accept()
+		if err != nil {
+			return
+		}
+		p.mu.Lock()
+		if p.mu.closed {
+			p.mu.Unlock()
+			_ = c.Close()
+			return
+		}
+		p.mu.conns[c] = struct{}{}
+		p.mu.Unlock()
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			defer func() {
+				p.mu.Lock()
+				delete(p.mu.conns, c)
+				p.mu.Unlock()
+				_ = c.Close()
+			}()
+			_ = p.handle(&serverConn{nc: c, br: bufio.NewReader(c)})
+		}()
+	}
+}
+
+type serverConn struct {
+	nc  net.Conn
+	br  *bufio.Reader
+	seq uint8
+}
+
+func (c *serverConn) read() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		return nil, err
+	}
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	c.seq = header[3] + 1
+	data := make([]byte, length)
+	_, err := io.ReadFull(c.br, data)
+	return data, err
+}
+
+func (c *serverConn) write(data []byte) error {
+	packet := []byte{byte(len(data)), byte(len(data) >> 8), byte(len(data) >> 16), c.seq}
+	c.seq++
+	_, err := c.nc.Write(append(packet, data...))
+	return err
+}
+
+func (c *serverConn) writeOK() error {
+	return c.write([]byte{0, 0, 0, 2, 0, 0, 0})
+}
+
+func (c *serverConn) writeEOF() error {
+	return c.write([]byte{0xfe, 0, 0, 2, 0})
+}
+
+func (c *serverConn) writeErr(code uint16, msg string) error {
+	data := []byte{0xff}
+	data = binary.LittleEndian.AppendUint16(data, code)
+	data = append(data, "#HY000"...)
+	data = append(data, msg...)
+	return c.write(data)
+}
+
+func appendLenEncString(data []byte, s string) []byte {
+	return append(append(data, byte(len(s))), s...)
+}
+
+func (c *serverConn) writeResult(columns []string, rows [][]string) error {
+	if err := c.write([]byte{byte(len(columns))}); err != nil {
+		return err
+	}
+	for _, col := range columns {
+		data := appendLenEncString(nil, "def")
+		data = appendLenEncString(data, "")
+		data = appendLenEncString(data, "")
+		data = appendLenEncString(data, "")
+		data = appendLenEncString(data, col)
+		data = appendLenEncString(data, col)
+		data = append(data, 0x0c, 45, 0, 0, 0, 0, 0, 0, 0xfd, 0, 0, 0, 0, 0)
+		if err := c.write(data); err != nil {
+			return err
+		}
+	}
+	if err := c.writeEOF(); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		var data []byte
+		for _, v := range row {
+			data = appendLenEncString(data, v)
+		}
+		if err := c.write(data); err != nil {
+			return err
+		}
+	}
+	return c.writeEOF()
+}
+
+func nativePassword(scramble []byte, password string) []byte {
+	if password == "" {
+		return nil
+	}
+	h1 := sha1.Sum([]byte(password))
+	h2 := sha1.Sum(h1[:])
+	h := sha1.New()
+	h.Write(scramble)
+	h.Write(h2[:])
+	h3 := h.Sum(nil)
+	for i := range h3 {
+		h3[i] ^= h1[i]
+	}
+	return h3
+}
+
+func (p *Producer) handle(c *serverConn) error {
+	scramble := []byte("0123456789abcdefghij")
+	greeting := []byte{10}
+	greeting = append(greeting, serverVersion...)
+	greeting = append(greeting, 0)
+	greeting = binary.LittleEndian.AppendUint32(greeting, 1)
+	greeting = append(greeting, scramble[:8]...)
+	greeting = append(greeting, 0)
+	// protocol 4.1, secure connection and plugin auth
+	capability := uint32(0x00000200 | 0x00008000 | 0x00080000)
+	greeting = binary.LittleEndian.AppendUint16(greeting, uint16(capability))
+	greeting = append(greeting, 45)
+	greeting = binary.LittleEndian.AppendUint16(greeting, 2)
+	greeting = binary.LittleEndian.AppendUint16(greeting, uint16(capability>>16))
+	greeting = append(greeting, 21)
+	greeting = append(greeting, make([]byte, 10)...)
+	greeting = append(greeting, scramble[8:]...)
+	greeting = append(greeting, 0)
+	greeting = append(greeting, "mysql_native_password"...)
+	greeting = append(greeting, 0)
+	if err := c.write(greeting); err != nil {
+		return err
+	}
+
+	data, err := c.read()
+	if err != nil {
+		return err
+	}
+	// capability, max packet size, charset and the filler
+	if len(data) < 32 {
+		return c.writeErr(1043, "Bad handshake")
+	}
+	rest := data[32:]
+	i := bytes.IndexByte(rest, 0)
+	if i < 0 || i+1 >= len(rest) {
+		return c.writeErr(1043, "Bad handshake")
+	}
+	user := string(rest[:i])
+	rest = rest[i+1:]
+	n := int(rest[0])
+	if 1+n > len(rest) {
+		return c.writeErr(1043, "Bad handshake")
+	}
+	auth := rest[1 : 1+n]
+	if user != p.user || !bytes.Equal(auth, nativePassword(scramble, p.password)) {
+		return c.writeErr(1045, fmt.Sprintf("Access denied for user '%s'", user))
+	}
+	if err = c.writeOK(); err != nil {
+		return err
+	}
+
+	for {
+		data, err = c.read()
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return c.writeErr(1047, "Unknown command")
+		}
+		switch data[0] {
+		case comQuit:
+			return nil
+		case comQuery:
+			if err = p.query(c, string(data[1:])); err != nil {
+				return err
+			}
+		case comRegisterSlave:
+			if len(data) >= 5 {
+				p.mu.Lock()
+				p.mu.serverID = append(p.mu.serverID, binary.LittleEndian.Uint32(data[1:]))
+				p.mu.Unlock()
+			}
+			if err = c.writeOK(); err != nil {
+				return err
+			}
+		case comBinlogDump:
+			if len(data) < 11 {
+				return c.writeErr(1047, "Bad binlog dump")
+			}
+			pos := binary.LittleEndian.Uint32(data[1:])
+			return p.dump(c, string(data[11:]), pos)
+		default:
+			if err = c.writeErr(1047, "Unknown command"); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *Producer) query(c *serverConn, sql string) error {
+	upper := strings.ToUpper(strings.TrimSpace(sql))
+	switch {
+	case strings.HasPrefix(upper, "SET "):
+		return c.writeOK()
+	case upper == "SELECT @@GLOBAL.BINLOG_CHECKSUM":
+		checksum := "NONE"
+		if p.checksum {
+			checksum = "CRC32"
+		}
+		return c.writeResult([]string{"@@global.binlog_checksum"}, [][]string{{checksum}})
+	case upper == "SHOW MASTER STATUS":
+		pos := p.Position()
+		return c.writeResult(
+			[]string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"},
+			[][]string{{pos.File, strconv.FormatUint(uint64(pos.Pos), 10), "", "", ""}})
+	}
+	return c.writeErr(1064, "You have an error in your SQL syntax near '"+sql+"'")
+}
+
+func (p *Producer) dump(c *serverConn, file string, pos uint32) error {
+	p.mu.Lock()
+	idx := -1
+	for i, f := range p.mu.files {
+		if f.name == file {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		p.mu.Unlock()
+		return c.writeErr(1236, "Could not find first log file name in binary log index file")
+	}
+	f := p.mu.files[idx]
+	next := -1
+	for i, off := range f.offsets {
+		if off == pos {
+			next = i
+		}
+	}
+	if pos == f.end {
+		next = len(f.events)
+	}
+	if next < 0 {
+		p.mu.Unlock()
+		return c.writeErr(1236, "Client requested master to start replication from invalid position")
+	}
+	p.mu.Unlock()
+
+	// the fake rotate event and the format description event
+	body := binary.LittleEndian.AppendUint64(nil, uint64(pos))
+	body = append(body, file...)
+	rotate := makeEvent(momysql.EventRotate, artificialFlag, body)
+	if p.checksum {
+		rotate = append(rotate, 0, 0, 0, 0)
+	}
+	binary.LittleEndian.PutUint32(rotate[9:], uint32(len(rotate)))
+	p.sum(rotate)
+	if err := c.write(append([]byte{0}, rotate...)); err != nil {
+		return err
+	}
+	if next > 0 {
+		fde := append([]byte(nil), f.events[0]...)
+		// a zero log position tells the replica not to update its position
+		binary.LittleEndian.PutUint32(fde[13:], 0)
+		p.sum(fde)
+		if err := c.write(append([]byte{0}, fde...)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		p.mu.Lock()
+		if p.mu.closed {
+			p.mu.Unlock()
+			return nil
+		}
+		f = p.mu.files[idx]
+		events := f.events[next:]
+		rotated := idx+1 < len(p.mu.files)
+		changed := p.mu.changed
+		p.mu.Unlock()
+
+		for _, ev := range events {
+			if err := c.write(append([]byte{0}, ev...)); err != nil {
+				return err
+			}
+			next++
+		}
+		if rotated {
+			// the rotate event at the end of the file has been sent
+			idx++
+			next = 0
+			continue
+		}
+		<-changed
+	}
+}
+
+func makeEvent(typ momysql.EventType, flags uint16, body []byte) []byte {
+	ev := make([]byte, momysql.EventHeaderLen, momysql.EventHeaderLen+len(body)+momysql.ChecksumLen)
+	binary.LittleEndian.PutUint32(ev[0:], 1700000000)
+	ev[4] = byte(typ)
+	binary.LittleEndian.PutUint32(ev[5:], 1)
+	binary.LittleEndian.PutUint32(ev[9:], uint32(momysql.EventHeaderLen+len(body)))
+	binary.LittleEndian.PutUint16(ev[17:], flags)
+	return append(ev, body...)
+}
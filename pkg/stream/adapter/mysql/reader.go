@@ -0,0 +1,163 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// reader decodes the little endian fields of packets and events. The first
+// out of range read sets err, and all the reads after it return zero values,
+// so that the callers only need to check err once.
+type reader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *reader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *reader) left() int {
+	return len(r.data) - r.pos
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.pos+n > len(r.data) {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *reader) skip(n int) {
+	r.bytes(n)
+}
+
+func (r *reader) rest() []byte {
+	if r.err != nil {
+		return nil
+	}
+	b := r.data[r.pos:]
+	r.pos = len(r.data)
+	return b
+}
+
+func (r *reader) peek() byte {
+	if r.err != nil || r.pos >= len(r.data) {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	return r.data[r.pos]
+}
+
+func (r *reader) byte() byte {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *reader) uint16() uint16 {
+	b := r.bytes(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *reader) uint24() uint32 {
+	b := r.bytes(3)
+	if b == nil {
+		return 0
+	}
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
+
+func (r *reader) uint32() uint32 {
+	b := r.bytes(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *reader) uint48() uint64 {
+	b := r.bytes(6)
+	if b == nil {
+		return 0
+	}
+	return uint64(binary.LittleEndian.Uint32(b)) | uint64(binary.LittleEndian.Uint16(b[4:]))<<32
+}
+
+func (r *reader) uint64() uint64 {
+	b := r.bytes(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+// uintN reads a little endian unsigned integer of n bytes.
+func (r *reader) uintN(n int) uint64 {
+	b := r.bytes(n)
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v
+}
+
+func (r *reader) lenEncInt() uint64 {
+	switch b := r.byte(); b {
+	case 0xfc:
+		return uint64(r.uint16())
+	case 0xfd:
+		return uint64(r.uint24())
+	case 0xfe:
+		return r.uint64()
+	default:
+		return uint64(b)
+	}
+}
+
+func (r *reader) lenEncString() []byte {
+	n := r.lenEncInt()
+	if n > uint64(r.left()) {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	return r.bytes(int(n))
+}
+
+func (r *reader) nulString() string {
+	if r.err != nil {
+		return ""
+	}
+	i := bytes.IndexByte(r.data[r.pos:], 0)
+	if i < 0 {
+		r.err = io.ErrUnexpectedEOF
+		return ""
+	}
+	s := string(r.data[r.pos : r.pos+i])
+	r.pos += i + 1
+	return s
+}
@@ -0,0 +1,346 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// The column types in the table map event.
+const (
+	MysqlTypeDecimal    byte = 0
+	MysqlTypeTiny       byte = 1
+	MysqlTypeShort      byte = 2
+	MysqlTypeLong       byte = 3
+	MysqlTypeFloat      byte = 4
+	MysqlTypeDouble     byte = 5
+	MysqlTypeNull       byte = 6
+	MysqlTypeTimestamp  byte = 7
+	MysqlTypeLongLong   byte = 8
+	MysqlTypeInt24      byte = 9
+	MysqlTypeDate       byte = 10
+	MysqlTypeTime       byte = 11
+	MysqlTypeDatetime   byte = 12
+	MysqlTypeYear       byte = 13
+	MysqlTypeVarchar    byte = 15
+	MysqlTypeBit        byte = 16
+	MysqlTypeTimestamp2 byte = 17
+	MysqlTypeDatetime2  byte = 18
+	MysqlTypeTime2      byte = 19
+	MysqlTypeJSON       byte = 245
+	MysqlTypeNewDecimal byte = 246
+	MysqlTypeEnum       byte = 247
+	MysqlTypeSet        byte = 248
+	MysqlTypeBlob       byte = 252
+	MysqlTypeVarString  byte = 253
+	MysqlTypeString     byte = 254
+	MysqlTypeGeometry   byte = 255
+)
+
+// readValue decodes a column value of the row image. The values are:
+//   - int64 or uint64 for the integers, YEAR, BIT, ENUM (the index) and SET
+//     (the bitmask). The integers are int64 unless the column is known to be
+//     unsigned, see UnsignedValue.
+//   - float32 and float64 for FLOAT and DOUBLE.
+//   - string for DECIMAL, JSON and the temporal types. TIMESTAMP is in UTC.
+//   - []byte for the strings and blobs.
+func readValue(r *reader, typ byte, meta uint16, unsigned bool) (any, error) {
+	switch typ {
+	case MysqlTypeTiny:
+		v := r.byte()
+		if unsigned {
+			return uint64(v), r.err
+		}
+		return int64(int8(v)), r.err
+	case MysqlTypeShort:
+		v := r.uint16()
+		if unsigned {
+			return uint64(v), r.err
+		}
+		return int64(int16(v)), r.err
+	case MysqlTypeInt24:
+		v := r.uint24()
+		if unsigned {
+			return uint64(v), r.err
+		}
+		return int64(int32(v<<8) >> 8), r.err
+	case MysqlTypeLong:
+		v := r.uint32()
+		if unsigned {
+			return uint64(v), r.err
+		}
+		return int64(int32(v)), r.err
+	case MysqlTypeLongLong:
+		v := r.uint64()
+		if unsigned {
+			return v, r.err
+		}
+		return int64(v), r.err
+	case MysqlTypeYear:
+		v := int64(r.byte())
+		if v != 0 {
+			v += 1900
+		}
+		return v, r.err
+	case MysqlTypeFloat:
+		return math.Float32frombits(r.uint32()), r.err
+	case MysqlTypeDouble:
+		return math.Float64frombits(r.uint64()), r.err
+	case MysqlTypeNewDecimal:
+		return readDecimal(r, int(meta>>8), int(meta&0xff))
+	case MysqlTypeBit:
+		n := int(meta>>8) + (int(meta&0xff)+7)/8
+		b := r.bytes(n)
+		var v uint64
+		for _, c := range b {
+			v = v<<8 | uint64(c)
+		}
+		return v, r.err
+	case MysqlTypeVarchar, MysqlTypeVarString:
+		if meta < 256 {
+			return r.bytes(int(r.byte())), r.err
+		}
+		return r.bytes(int(r.uint16())), r.err
+	case MysqlTypeString, MysqlTypeEnum, MysqlTypeSet:
+		realType, length := byte(meta>>8), int(meta&0xff)
+		if realType&0x30 != 0x30 {
+			// the length of CHAR(N) with N*maxlen > 255 is split into the
+			// real type byte.
+			length |= int((realType&0x30)^0x30) << 4
+			realType |= 0x30
+		}
+		switch realType {
+		case MysqlTypeEnum:
+			return int64(r.uintN(length)), r.err
+		case MysqlTypeSet:
+			return r.uintN(length), r.err
+		}
+		if length < 256 {
+			return r.bytes(int(r.byte())), r.err
+		}
+		return r.bytes(int(r.uint16())), r.err
+	case MysqlTypeBlob, MysqlTypeGeometry:
+		return r.bytes(int(r.uintN(int(meta)))), r.err
+	case MysqlTypeJSON:
+		b := r.bytes(int(r.uintN(int(meta))))
+		if r.err != nil {
+			return nil, r.err
+		}
+		return DecodeJSON(b)
+	case MysqlTypeDate:
+		v := r.uint24()
+		return fmt.Sprintf("%04d-%02d-%02d", v>>9, (v>>5)&15, v&31), r.err
+	case MysqlTypeTime:
+		v := r.uint24()
+		return fmt.Sprintf("%02d:%02d:%02d", v/10000, v%10000/100, v%100), r.err
+	case MysqlTypeDatetime:
+		v := r.uint64()
+		d, t := v/1000000, v%1000000
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d",
+			d/10000, d%10000/100, d%100, t/10000, t%10000/100, t%100), r.err
+	case MysqlTypeTimestamp:
+		sec := int64(r.uint32())
+		return time.Unix(sec, 0).UTC().Format("2006-01-02 15:04:05"), r.err
+	case MysqlTypeTimestamp2:
+		b := r.bytes(4)
+		if b == nil {
+			return nil, r.err
+		}
+		sec := int64(bigEndian(b))
+		frac := readFrac(r, int(meta))
+		return time.Unix(sec, 0).UTC().Format("2006-01-02 15:04:05") + formatFrac(frac, int(meta)), r.err
+	case MysqlTypeDatetime2:
+		return readDatetime2(r, int(meta))
+	case MysqlTypeTime2:
+		return readTime2(r, int(meta))
+	case MysqlTypeNull:
+		return nil, nil
+	}
+	return nil, moerr.NewInternalErrorNoCtx("unsupported binlog column type %d", typ)
+}
+
+// UnsignedValue reinterprets the signed integer decoded from a column of the
+// type as unsigned. It is needed if the upstream does not log the signedness.
+func UnsignedValue(v int64, typ byte) uint64 {
+	switch typ {
+	case MysqlTypeTiny:
+		return uint64(uint8(v))
+	case MysqlTypeShort:
+		return uint64(uint16(v))
+	case MysqlTypeInt24:
+		return uint64(v) & 0xffffff
+	case MysqlTypeLong:
+		return uint64(uint32(v))
+	}
+	return uint64(v)
+}
+
+func bigEndian(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// readFrac reads the big endian fractional seconds of TIME2, DATETIME2 and
+// TIMESTAMP2, and returns it in microseconds.
+func readFrac(r *reader, fsp int) int64 {
+	n := (fsp + 1) / 2
+	if n == 0 {
+		return 0
+	}
+	v := int64(bigEndian(r.bytes(n)))
+	switch n {
+	case 1:
+		return v * 10000
+	case 2:
+		return v * 100
+	}
+	return v
+}
+
+func formatFrac(usec int64, fsp int) string {
+	if fsp == 0 {
+		return ""
+	}
+	s := fmt.Sprintf(".%06d", usec)
+	return s[:1+fsp]
+}
+
+func readDatetime2(r *reader, fsp int) (any, error) {
+	b := r.bytes(5)
+	if b == nil {
+		return nil, r.err
+	}
+	v := int64(bigEndian(b)) - 0x8000000000
+	frac := readFrac(r, fsp)
+	if v < 0 {
+		return nil, moerr.NewInternalErrorNoCtx("negative datetime")
+	}
+	ymd, hms := v>>17, v&(1<<17-1)
+	ym := ymd >> 5
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d%s",
+		ym/13, ym%13, ymd&31, hms>>12, (hms>>6)&63, hms&63, formatFrac(frac, fsp)), r.err
+}
+
+func readTime2(r *reader, fsp int) (any, error) {
+	b := r.bytes(3)
+	if b == nil {
+		return nil, r.err
+	}
+	v := int64(bigEndian(b)) - 0x800000
+	n := (fsp + 1) / 2
+	var frac int64
+	if n > 0 {
+		fb := r.bytes(n)
+		if fb == nil {
+			return nil, r.err
+		}
+		raw := int64(bigEndian(fb))
+		// the fractional part is stored in two's complement with the integer
+		// part for negative values.
+		if v < 0 && raw != 0 {
+			v++
+			raw -= int64(1) << (8 * n)
+		}
+		frac = raw
+		switch n {
+		case 1:
+			frac *= 10000
+		case 2:
+			frac *= 100
+		}
+	}
+	sign := ""
+	if v < 0 || frac < 0 {
+		sign = "-"
+		v, frac = -v, -frac
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d%s", sign, v>>12, (v>>6)&63, v&63, formatFrac(frac, fsp)), r.err
+}
+
+var decimalDigitBytes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// readDecimal decodes the binary DECIMAL of MySQL: the integer part and the
+// fraction part are stored as groups of 9 digits in 4 bytes big endian, the
+// leftover digits take the fewest bytes. The sign bit is inverted, and all
+// the bits are inverted for negative values.
+func readDecimal(r *reader, precision, scale int) (any, error) {
+	intg := precision - scale
+	intg0, intgx := intg/9, intg%9
+	frac0, fracx := scale/9, scale%9
+	size := intg0*4 + decimalDigitBytes[intgx] + frac0*4 + decimalDigitBytes[fracx]
+	b := r.bytes(size)
+	if b == nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	data := append([]byte{}, b...)
+	negative := data[0]&0x80 == 0
+	data[0] ^= 0x80
+	if negative {
+		for i := range data {
+			data[i] ^= 0xff
+		}
+	}
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	pos := 0
+	group := func(n int) uint64 {
+		v := bigEndian(data[pos : pos+n])
+		pos += n
+		return v
+	}
+	leading := true
+	if intgx > 0 {
+		if v := group(decimalDigitBytes[intgx]); v != 0 {
+			fmt.Fprintf(&sb, "%d", v)
+			leading = false
+		}
+	}
+	for i := 0; i < intg0; i++ {
+		v := group(4)
+		if leading {
+			if v != 0 {
+				fmt.Fprintf(&sb, "%d", v)
+				leading = false
+			}
+		} else {
+			fmt.Fprintf(&sb, "%09d", v)
+		}
+	}
+	if leading {
+		sb.WriteByte('0')
+	}
+	if scale > 0 {
+		sb.WriteByte('.')
+		for i := 0; i < frac0; i++ {
+			fmt.Fprintf(&sb, "%09d", group(4))
+		}
+		if fracx > 0 {
+			fmt.Fprintf(&sb, "%0*d", fracx, group(decimalDigitBytes[fracx]))
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package momysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadValue(t *testing.T) {
+	kases := []struct {
+		typ      byte
+		meta     uint16
+		unsigned bool
+		data     []byte
+		want     any
+	}{
+		{MysqlTypeTiny, 0, false, []byte{0xff}, int64(-1)},
+		{MysqlTypeTiny, 0, true, []byte{0xff}, uint64(255)},
+		{MysqlTypeShort, 0, false, []byte{0x00, 0x80}, int64(-32768)},
+		{MysqlTypeInt24, 0, false, []byte{0xff, 0xff, 0xff}, int64(-1)},
+		{MysqlTypeInt24, 0, true, []byte{0xff, 0xff, 0xff}, uint64(0xffffff)},
+		{MysqlTypeLong, 0, false, []byte{0x01, 0x00, 0x00, 0x80}, int64(-2147483647)},
+		{MysqlTypeLongLong, 0, true, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint64(1<<64 - 1)},
+		{MysqlTypeYear, 0, false, []byte{124}, int64(2024)},
+		{MysqlTypeDouble, 0, false, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x3f}, 1.5},
+		{MysqlTypeFloat, 4, false, []byte{0, 0, 0xc0, 0x3f}, float32(1.5)},
+		{MysqlTypeBit, 0x0102, false, []byte{0x01, 0x02}, uint64(0x102)},
+		{MysqlTypeVarchar, 10, false, []byte{3, 'a', 'b', 'c'}, []byte("abc")},
+		{MysqlTypeVarchar, 1000, false, []byte{3, 0, 'a', 'b', 'c'}, []byte("abc")},
+		// CHAR(10)
+		{MysqlTypeString, uint16(MysqlTypeString)<<8 | 40, false, []byte{2, 'h', 'i'}, []byte("hi")},
+		// CHAR(255) in utf8mb4 takes 1020 bytes, the length is split into the type
+		{MysqlTypeString, 0xcefc, false, []byte{1, 0, 'x'}, []byte("x")},
+		{MysqlTypeString, uint16(MysqlTypeEnum)<<8 | 1, false, []byte{2}, int64(2)},
+		{MysqlTypeString, uint16(MysqlTypeSet)<<8 | 2, false, []byte{0x05, 0x01}, uint64(0x105)},
+		{MysqlTypeBlob, 2, false, []byte{2, 0, 0x00, 0xff}, []byte{0x00, 0xff}},
+		{MysqlTypeDate, 0, false, []byte{0xdd, 0xd0, 0x0f}, "2024-06-29"},
+		{MysqlTypeDatetime2, 0, false, []byte{0x99, 0xb3, 0xba, 0xa2, 0x0f}, "2024-06-29 10:08:15"},
+		{MysqlTypeDatetime2, 3, false, []byte{0x99, 0xb3, 0xba, 0xa2, 0x0f, 0x04, 0xce}, "2024-06-29 10:08:15.123"},
+		{MysqlTypeTimestamp2, 0, false, []byte{0x65, 0x5e, 0x0e, 0x80}, "2023-11-22 14:21:52"},
+		{MysqlTypeTime2, 0, false, []byte{0x80, 0xa2, 0x0f}, "10:08:15"},
+		{MysqlTypeTime2, 0, false, []byte{0x7f, 0xff, 0xff}, "-00:00:01"},
+		// -00:00:01.5
+		{MysqlTypeTime2, 2, false, []byte{0x7f, 0xff, 0xfe, 0xce}, "-00:00:01.50"},
+		{MysqlTypeTime2, 6, false, []byte{0x7f, 0xff, 0xfe, 0xf8, 0x5e, 0xe0}, "-00:00:01.500000"},
+		{MysqlTypeJSON, 4, false, []byte{3, 0, 0, 0, 0x04, 0x01, 0x00}, "true"},
+	}
+	for _, kase := range kases {
+		r := reader{data: kase.data}
+		v, err := readValue(&r, kase.typ, kase.meta, kase.unsigned)
+		require.NoError(t, err, "type %d", kase.typ)
+		require.Equal(t, kase.want, v, "type %d", kase.typ)
+		require.True(t, r.done(), "type %d", kase.typ)
+	}
+
+	r := reader{data: []byte{1}}
+	_, err := readValue(&r, MysqlTypeShort, 0, false)
+	require.Error(t, err)
+
+	require.Equal(t, uint64(255), UnsignedValue(-1, MysqlTypeTiny))
+	require.Equal(t, uint64(0xffffff), UnsignedValue(-1, MysqlTypeInt24))
+	require.Equal(t, uint64(1<<64-1), UnsignedValue(-1, MysqlTypeLongLong))
+}
+
+func TestReadDecimal(t *testing.T) {
+	// the examples in the MySQL source
+	kases := []struct {
+		precision, scale int
+		data             []byte
+		want             string
+	}{
+		{14, 4, []byte{0x81, 0x0d, 0xfb, 0x38, 0xd2, 0x04, 0xd2}, "1234567890.1234"},
+		{14, 4, []byte{0x7e, 0xf2, 0x04, 0xc7, 0x2d, 0xfb, 0x2d}, "-1234567890.1234"},
+		{10, 2, []byte{0x80, 0x00, 0x00, 0x00, 0x00}, "0.00"},
+		{5, 0, []byte{0x80, 0x30, 0x39}, "12345"},
+		{20, 10, []byte{0x80, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00}, "1.0000000000"},
+	}
+	for _, kase := range kases {
+		r := reader{data: kase.data}
+		v, err := readDecimal(&r, kase.precision, kase.scale)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, v)
+		require.True(t, r.done())
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	kases := []struct {
+		data []byte
+		want string
+	}{
+		{nil, "null"},
+		{[]byte{0x0c, 0x02, 'h', 'i'}, `"hi"`},
+		{[]byte{0x05, 0xff, 0xff}, "-1"},
+		{[]byte{0x0b, 0, 0, 0, 0, 0, 0, 0xf8, 0x3f}, "1.5"},
+		// {"a": 1, "b": [true, "x"]}
+		{[]byte{
+			0x00,
+			0x02, 0x00, 0x23, 0x00, // count 2, size 35
+			0x12, 0x00, 0x01, 0x00, // key "a" at 18
+			0x13, 0x00, 0x01, 0x00, // key "b" at 19
+			0x05, 0x01, 0x00, // int16 1 inlined
+			0x02, 0x14, 0x00, // array at 20
+			'a', 'b',
+			0x02, 0x00, 0x0f, 0x00, // count 2, size 15
+			0x04, 0x01, 0x00, // true
+			0x0c, 0x0a, 0x00, // string at 10
+			0x01, 'x', // "x"
+			0x00, 0x00, 0x00, // padding
+		}, `{"a": 1, "b": [true, "x"]}`},
+		// opaque decimal 1.50
+		{[]byte{0x0f, MysqlTypeNewDecimal, 0x04, 0x03, 0x02, 0x81, 0x32}, "1.50"},
+	}
+	for _, kase := range kases {
+		s, err := DecodeJSON(kase.data)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, s)
+	}
+
+	_, err := DecodeJSON([]byte{0x00, 0x05, 0x00})
+	require.Error(t, err)
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	require.True(t, versionAtLeast("8.0.36-log", 5, 6, 1))
+	require.True(t, versionAtLeast("5.6.1", 5, 6, 1))
+	require.False(t, versionAtLeast("5.5.62", 5, 6, 1))
+	require.True(t, versionAtLeast("10.6.12-MariaDB", 5, 6, 1))
+}
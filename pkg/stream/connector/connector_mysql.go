@@ -0,0 +1,699 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	momysql "github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMysqlServerIDBase is added to the task id as the server id of
+	// the replica if the server_id option is not set.
+	defaultMysqlServerIDBase = 1 << 30
+	// mysqlFirstBinlogPos is the position of the first event in a binlog
+	// file, right after the magic number.
+	mysqlFirstBinlogPos = 4
+
+	mysqlHeartbeatPeriod      = time.Second * 10
+	mysqlReadTimeout          = mysqlHeartbeatPeriod * 3
+	mysqlDialTimeout          = time.Second * 10
+	mysqlPositionSaveInterval = time.Second * 5
+	mysqlMinRetryBackoff      = time.Second
+	mysqlMaxRetryBackoff      = time.Second * 30
+)
+
+func MysqlBinlogConnectorExecutor(
+	logger *zap.Logger,
+	ts taskservice.TaskService,
+	ieFactory func() ie.InternalExecutor,
+	attachToTask func(context.Context, uint64, taskservice.ActiveRoutine) error,
+) func(context.Context, task.Task) error {
+	return func(ctx context.Context, t task.Task) error {
+		ctx1, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+		tasks, err := ts.QueryDaemonTask(ctx1,
+			taskservice.WithTaskIDCond(taskservice.EQ, t.GetID()),
+		)
+		if err != nil {
+			return err
+		}
+		if len(tasks) != 1 {
+			return moerr.NewInternalError(ctx, "invalid tasks count %d", len(tasks))
+		}
+		details, ok := tasks[0].Details.Details.(*task.Details_Connector)
+		if !ok {
+			return moerr.NewInternalError(ctx, "invalid details type")
+		}
+
+		c, err := NewMysqlBinlogConnector(logger, t.GetID(), tasks[0].Details.AccountID,
+			details.Connector.TableName, details.Connector.Options, ieFactory())
+		if err != nil {
+			return err
+		}
+		if err := attachToTask(ctx, t.GetID(), c); err != nil {
+			return err
+		}
+		// Start the connector task and hangs here.
+		return c.Start(ctx)
+	}
+}
+
+// mysqlTablePattern is an upstream table to replicate, the table "*" matches
+// all the tables in the database.
+type mysqlTablePattern struct {
+	db    string
+	table string
+}
+
+func parseMysqlTablePatterns(ctx context.Context, s string) ([]mysqlTablePattern, error) {
+	var patterns []mysqlTablePattern
+	for _, item := range strings.Split(s, ",") {
+		ss := strings.Split(strings.TrimSpace(item), ".")
+		if len(ss) != 2 || ss[0] == "" || ss[1] == "" {
+			return nil, moerr.NewErrInvalidValue(ctx, OptConnectorTables, s)
+		}
+		patterns = append(patterns, mysqlTablePattern{db: ss[0], table: ss[1]})
+	}
+	return patterns, nil
+}
+
+// mysqlTargetTable is the MO table which the upstream table is replicated to.
+type mysqlTargetTable struct {
+	// name is the quoted full name of the table.
+	name     string
+	columns  []string
+	unsigned []bool
+	// pks are the indexes of the primary key columns.
+	pks []int
+	// index is the column name in lower case to the column index.
+	index map[string]int
+}
+
+// MysqlBinlogConnector replicates the row based binlog of an upstream MySQL
+// server to the tables of the same names in MO. The rows events of an
+// upstream transaction are applied in one MO transaction, together with the
+// binlog position after the transaction, which is saved in
+// mo_catalog.mo_binlog_positions, so the connector resumes from where it
+// stopped after the restart.
+type MysqlBinlogConnector struct {
+	logger    *zap.Logger
+	taskID    uint64
+	accountID uint32
+	cfg       momysql.Config
+	options   map[string]string
+	patterns  []mysqlTablePattern
+	ie        ie.InternalExecutor
+	targets   map[string]*mysqlTargetTable
+
+	// pos is the position after the last applied transaction, saved is the
+	// position saved in the system table.
+	pos     momysql.Position
+	saved   momysql.Position
+	pending []string
+
+	resumeC chan struct{}
+	cancelC chan struct{}
+	pauseC  chan struct{}
+}
+
+// NewMysqlBinlogConnector creates the connector of the task. tableName is the
+// target table of the connector, which is also the only upstream table to
+// replicate if the tables option is not set.
+func NewMysqlBinlogConnector(
+	logger *zap.Logger,
+	taskID uint64,
+	accountID uint32,
+	tableName string,
+	options map[string]string,
+	ie ie.InternalExecutor,
+) (*MysqlBinlogConnector, error) {
+	ctx := context.Background()
+	if options[OptConnectorType] != SourceMysql {
+		return nil, moerr.NewInternalError(ctx, "Invalid connector type")
+	}
+	for _, field := range []string{OptConnectorAddress, OptConnectorUser} {
+		if options[field] == "" {
+			return nil, moerr.NewErrLackOption(ctx, field)
+		}
+	}
+	tables := options[OptConnectorTables]
+	if tables == "" {
+		tables = tableName
+	}
+	patterns, err := parseMysqlTablePatterns(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	serverID := uint32(defaultMysqlServerIDBase + taskID)
+	if s := options[OptConnectorServerID]; s != "" {
+		id, err := strconv.ParseUint(s, 10, 32)
+		if err != nil || id == 0 {
+			return nil, moerr.NewErrInvalidValue(ctx, OptConnectorServerID, s)
+		}
+		serverID = uint32(id)
+	}
+	return &MysqlBinlogConnector{
+		logger:    logger,
+		taskID:    taskID,
+		accountID: accountID,
+		cfg: momysql.Config{
+			Addr:        options[OptConnectorAddress],
+			User:        options[OptConnectorUser],
+			Password:    options[OptConnectorPassword],
+			ServerID:    serverID,
+			DialTimeout: mysqlDialTimeout,
+			ReadTimeout: mysqlReadTimeout,
+		},
+		options:  options,
+		patterns: patterns,
+		ie:       ie,
+		targets:  make(map[string]*mysqlTargetTable),
+		resumeC:  make(chan struct{}),
+		cancelC:  make(chan struct{}),
+		pauseC:   make(chan struct{}),
+	}, nil
+}
+
+func (c *MysqlBinlogConnector) sessionOpts() ie.SessionOverrideOptions {
+	return ie.NewOptsBuilder().AccountId(c.accountID).Finish()
+}
+
+// Start replicates the binlog until the connector is canceled. The
+// connection errors are retried from the last applied position, the other
+// errors fail the task.
+func (c *MysqlBinlogConnector) Start(ctx context.Context) error {
+	if err := c.loadPosition(ctx); err != nil {
+		return err
+	}
+	backoff := mysqlMinRetryBackoff
+	for {
+		pos := c.pos
+		err := c.replicate(ctx)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(*moerr.Error); ok {
+			return err
+		}
+		if c.pos != pos {
+			backoff = mysqlMinRetryBackoff
+		}
+		c.logger.Warn("mysql binlog connection failed, retrying",
+			zap.Uint64("task", c.taskID),
+			zap.String("upstream", c.cfg.Addr),
+			zap.String("file", c.pos.File),
+			zap.Uint32("pos", c.pos.Pos),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-c.cancelC:
+			timer.Stop()
+			return nil
+		case <-c.pauseC:
+			timer.Stop()
+			if !c.waitResume(ctx) {
+				return nil
+			}
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, mysqlMaxRetryBackoff)
+	}
+}
+
+// waitResume waits for the resume after the pause, it returns false if the
+// connector is canceled.
+func (c *MysqlBinlogConnector) waitResume(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-c.cancelC:
+		return false
+	case <-c.resumeC:
+		return true
+	}
+}
+
+type mysqlRawEvent struct {
+	data []byte
+	err  error
+}
+
+// replicate dumps the binlog from c.pos and applies the events. It returns
+// nil if the connector is canceled.
+func (c *MysqlBinlogConnector) replicate(ctx context.Context) error {
+	conn, err := momysql.Dial(ctx, c.cfg)
+	if err != nil {
+		return err
+	}
+	checksum, err := conn.StartDump(ctx, c.pos, mysqlHeartbeatPeriod)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	parser := momysql.NewParser(checksum)
+	c.pending = c.pending[:0]
+
+	readCtx, cancel := context.WithCancel(ctx)
+	events := make(chan mysqlRawEvent, 128)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			data, err := conn.ReadEvent(readCtx)
+			select {
+			case events <- mysqlRawEvent{data: data, err: err}:
+			case <-readCtx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	defer func() {
+		cancel()
+		<-done
+		_ = conn.Close()
+	}()
+
+	ticker := time.NewTicker(mysqlPositionSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.cancelC:
+			return nil
+		case <-c.pauseC:
+			if !c.waitResume(ctx) {
+				return nil
+			}
+		case <-ticker.C:
+			if err := c.savePosition(ctx); err != nil {
+				return err
+			}
+		case ev := <-events:
+			if ev.err != nil {
+				return ev.err
+			}
+			e, err := parser.Parse(ctx, ev.data)
+			if err != nil {
+				return err
+			}
+			if err := c.handleEvent(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *MysqlBinlogConnector) handleEvent(ctx context.Context, e *momysql.Event) error {
+	switch ev := e.Body.(type) {
+	case *momysql.RotateEvent:
+		c.pos = momysql.Position{File: ev.File, Pos: uint32(ev.Pos)}
+	case *momysql.QueryEvent:
+		switch strings.ToUpper(strings.TrimSpace(ev.Query)) {
+		case "BEGIN":
+			c.pending = c.pending[:0]
+			return nil
+		case "COMMIT":
+			return c.commit(ctx, e.Header.LogPos)
+		}
+		// The DDLs are not replicated, the table schemas are reloaded in
+		// case the tables are altered.
+		c.logger.Info("skip the query event of mysql binlog",
+			zap.Uint64("task", c.taskID),
+			zap.String("schema", ev.Schema),
+			zap.String("query", ev.Query))
+		c.targets = make(map[string]*mysqlTargetTable)
+		c.advance(e.Header.LogPos)
+	case *momysql.XidEvent:
+		return c.commit(ctx, e.Header.LogPos)
+	case *momysql.RowsEvent:
+		return c.handleRows(ctx, ev)
+	}
+	return nil
+}
+
+func (c *MysqlBinlogConnector) advance(logPos uint32) {
+	// The log position of the artificial events is 0.
+	if logPos != 0 {
+		c.pos.Pos = logPos
+	}
+}
+
+func (c *MysqlBinlogConnector) positionSQL() string {
+	return fmt.Sprintf("delete from %s.%s where task_id = %d; "+
+		"insert into %s.%s (task_id, upstream, binlog_file, binlog_pos, update_time) "+
+		"values (%d, %s, %s, %d, utc_timestamp())",
+		catalog.MO_CATALOG, catalog.MO_BINLOG_POSITIONS, c.taskID,
+		catalog.MO_CATALOG, catalog.MO_BINLOG_POSITIONS,
+		c.taskID, quoteMysqlString(c.cfg.Addr), quoteMysqlString(c.pos.File), c.pos.Pos)
+}
+
+// exec runs the statements in one transaction.
+func (c *MysqlBinlogConnector) exec(ctx context.Context, stmts []string) error {
+	sql := "set time_zone = '+00:00'; begin; " + strings.Join(stmts, "; ") + "; commit;"
+	if err := c.ie.Exec(ctx, sql, c.sessionOpts()); err != nil {
+		_ = c.ie.Exec(ctx, "rollback", c.sessionOpts())
+		return err
+	}
+	return nil
+}
+
+// commit applies the pending statements of the upstream transaction with the
+// position after it.
+func (c *MysqlBinlogConnector) commit(ctx context.Context, logPos uint32) error {
+	c.advance(logPos)
+	if len(c.pending) == 0 {
+		// The position is saved later in savePosition.
+		return nil
+	}
+	if err := c.exec(ctx, append(c.pending, c.positionSQL())); err != nil {
+		return err
+	}
+	c.pending = c.pending[:0]
+	c.saved = c.pos
+	return nil
+}
+
+// savePosition saves the position advanced by the events which are not
+// replicated.
+func (c *MysqlBinlogConnector) savePosition(ctx context.Context) error {
+	if c.pos == c.saved || len(c.pending) > 0 {
+		return nil
+	}
+	if err := c.exec(ctx, []string{c.positionSQL()}); err != nil {
+		return err
+	}
+	c.saved = c.pos
+	return nil
+}
+
+// loadPosition loads the saved position of the task. The connector starts
+// from the binlog_file and binlog_pos options for the first time, or the
+// current position of the upstream if the options are not set.
+func (c *MysqlBinlogConnector) loadPosition(ctx context.Context) error {
+	sql := fmt.Sprintf("select binlog_file, binlog_pos from %s.%s where task_id = %d",
+		catalog.MO_CATALOG, catalog.MO_BINLOG_POSITIONS, c.taskID)
+	res := c.ie.Query(ctx, sql, c.sessionOpts())
+	if err := res.Error(); err != nil {
+		return err
+	}
+	if res.RowCount() > 0 {
+		file, err := res.StringValueByName(ctx, 0, "binlog_file")
+		if err != nil {
+			return err
+		}
+		s, err := res.StringValueByName(ctx, 0, "binlog_pos")
+		if err != nil {
+			return err
+		}
+		pos, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return moerr.NewInternalError(ctx, "invalid binlog position %s", s)
+		}
+		c.pos = momysql.Position{File: file, Pos: uint32(pos)}
+		c.saved = c.pos
+		return nil
+	}
+
+	if file := c.options[OptConnectorBinlogFile]; file != "" {
+		c.pos = momysql.Position{File: file, Pos: mysqlFirstBinlogPos}
+		if s := c.options[OptConnectorBinlogPos]; s != "" {
+			pos, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				return moerr.NewErrInvalidValue(ctx, OptConnectorBinlogPos, s)
+			}
+			c.pos.Pos = uint32(pos)
+		}
+		return nil
+	}
+	conn, err := momysql.Dial(ctx, c.cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c.pos, err = conn.MasterStatus(ctx)
+	return err
+}
+
+func (c *MysqlBinlogConnector) match(db, table string) bool {
+	for _, p := range c.patterns {
+		if p.db == db && (p.table == "*" || p.table == table) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *MysqlBinlogConnector) target(ctx context.Context, db, table string) (*mysqlTargetTable, error) {
+	key := db + "." + table
+	if t, ok := c.targets[key]; ok {
+		return t, nil
+	}
+	sql := fmt.Sprintf("select attname, att_constraint_type, att_is_unsigned from %s.%s "+
+		"where att_database = %s and att_relname = %s and att_is_hidden = 0 order by attnum",
+		catalog.MO_CATALOG, catalog.MO_COLUMNS, quoteMysqlString(db), quoteMysqlString(table))
+	res := c.ie.Query(ctx, sql, c.sessionOpts())
+	if err := res.Error(); err != nil {
+		return nil, err
+	}
+	if res.RowCount() == 0 {
+		return nil, moerr.NewNoSuchTable(ctx, db, table)
+	}
+	t := &mysqlTargetTable{
+		name:  quoteMysqlIdent(db) + "." + quoteMysqlIdent(table),
+		index: make(map[string]int),
+	}
+	for i := uint64(0); i < res.RowCount(); i++ {
+		name, err := res.StringValueByName(ctx, i, "attname")
+		if err != nil {
+			return nil, err
+		}
+		constraint, err := res.StringValueByName(ctx, i, "att_constraint_type")
+		if err != nil {
+			return nil, err
+		}
+		unsigned, err := res.StringValueByName(ctx, i, "att_is_unsigned")
+		if err != nil {
+			return nil, err
+		}
+		if constraint == catalog.SystemColPKConstraint {
+			t.pks = append(t.pks, len(t.columns))
+		}
+		t.index[strings.ToLower(name)] = len(t.columns)
+		t.columns = append(t.columns, name)
+		t.unsigned = append(t.unsigned, unsigned == "1")
+	}
+	c.targets[key] = t
+	return t, nil
+}
+
+func (c *MysqlBinlogConnector) handleRows(ctx context.Context, ev *momysql.RowsEvent) error {
+	tm := ev.Table
+	if !c.match(tm.Schema, tm.Table) {
+		return nil
+	}
+	t, err := c.target(ctx, tm.Schema, tm.Table)
+	if err != nil {
+		return err
+	}
+	// columns maps the upstream columns to the target columns, the upstream
+	// columns missing in the target are ignored if the column names are
+	// logged, otherwise the columns are mapped by the ordinal position.
+	columns := make([]int, len(tm.ColumnTypes))
+	for i := range columns {
+		if tm.ColumnNames != nil {
+			j, ok := t.index[strings.ToLower(tm.ColumnNames[i])]
+			if !ok {
+				j = -1
+			}
+			columns[i] = j
+			continue
+		}
+		if i >= len(t.columns) {
+			return moerr.NewInternalError(ctx, "table %s.%s has more columns in the upstream",
+				tm.Schema, tm.Table)
+		}
+		columns[i] = i
+	}
+	literal := func(i int, v any) string {
+		if n, ok := v.(int64); ok && tm.Unsigned == nil && t.unsigned[columns[i]] {
+			v = momysql.UnsignedValue(n, tm.ColumnTypes[i])
+		}
+		return mysqlLiteral(v)
+	}
+
+	switch ev.Action {
+	case momysql.RowsInsert:
+		var names []string
+		for i, ok := range ev.Present {
+			if ok && columns[i] >= 0 {
+				names = append(names, quoteMysqlIdent(t.columns[columns[i]]))
+			}
+		}
+		values := make([]string, 0, len(ev.Rows))
+		for _, row := range ev.Rows {
+			var vs []string
+			for i, ok := range ev.Present {
+				if ok && columns[i] >= 0 {
+					vs = append(vs, literal(i, row[i]))
+				}
+			}
+			values = append(values, "("+strings.Join(vs, ", ")+")")
+		}
+		c.pending = append(c.pending, fmt.Sprintf("insert into %s (%s) values %s",
+			t.name, strings.Join(names, ", "), strings.Join(values, ", ")))
+	case momysql.RowsDelete:
+		for _, row := range ev.Rows {
+			c.pending = append(c.pending, fmt.Sprintf("delete from %s where %s",
+				t.name, c.where(t, columns, ev.Present, row, literal)))
+		}
+	case momysql.RowsUpdate:
+		for k := 0; k+1 < len(ev.Rows); k += 2 {
+			var sets []string
+			for i, ok := range ev.PresentAfter {
+				if ok && columns[i] >= 0 {
+					sets = append(sets, quoteMysqlIdent(t.columns[columns[i]])+" = "+literal(i, ev.Rows[k+1][i]))
+				}
+			}
+			if len(sets) == 0 {
+				continue
+			}
+			c.pending = append(c.pending, fmt.Sprintf("update %s set %s where %s",
+				t.name, strings.Join(sets, ", "), c.where(t, columns, ev.Present, ev.Rows[k], literal)))
+		}
+	}
+	return nil
+}
+
+// where makes the condition of the row image, by the primary key if all the
+// primary key columns are in the image, otherwise by all the columns. Rows
+// without a key may be duplicated, so the condition by all the columns is
+// limited to one of them, as the change of a source row changes only one.
+func (c *MysqlBinlogConnector) where(
+	t *mysqlTargetTable,
+	columns []int,
+	present []bool,
+	row []any,
+	literal func(int, any) string,
+) string {
+	byPK := len(t.pks) > 0
+	if byPK {
+		found := 0
+		for i, ok := range present {
+			if ok && columns[i] >= 0 && isMysqlPK(t, columns[i]) {
+				found++
+			}
+		}
+		byPK = found == len(t.pks)
+	}
+	var conds []string
+	for i, ok := range present {
+		if !ok || columns[i] < 0 || (byPK && !isMysqlPK(t, columns[i])) {
+			continue
+		}
+		name := quoteMysqlIdent(t.columns[columns[i]])
+		if row[i] == nil {
+			conds = append(conds, name+" is null")
+		} else {
+			conds = append(conds, name+" = "+literal(i, row[i]))
+		}
+	}
+	if byPK {
+		return strings.Join(conds, " and ")
+	}
+	return strings.Join(conds, " and ") + " limit 1"
+}
+
+func isMysqlPK(t *mysqlTargetTable, col int) bool {
+	for _, pk := range t.pks {
+		if pk == col {
+			return true
+		}
+	}
+	return false
+}
+
+var mysqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\x00", `\0`)
+
+func quoteMysqlString(s string) string {
+	return "'" + mysqlStringEscaper.Replace(s) + "'"
+}
+
+func quoteMysqlIdent(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// mysqlLiteral formats the value decoded from the binlog as a SQL literal.
+func mysqlLiteral(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return quoteMysqlString(v)
+	case []byte:
+		if utf8.Valid(v) {
+			return quoteMysqlString(string(v))
+		}
+		return "x'" + hex.EncodeToString(v) + "'"
+	}
+	return quoteMysqlString(fmt.Sprint(v))
+}
+
+// Resume implements the taskservice.ActiveRoutine interface.
+func (c *MysqlBinlogConnector) Resume() error {
+	c.resumeC <- struct{}{}
+	return nil
+}
+
+// Pause implements the taskservice.ActiveRoutine interface.
+func (c *MysqlBinlogConnector) Pause() error {
+	c.pauseC <- struct{}{}
+	return nil
+}
+
+// Cancel implements the taskservice.ActiveRoutine interface.
+func (c *MysqlBinlogConnector) Cancel() error {
+	close(c.cancelC)
+	return nil
+}
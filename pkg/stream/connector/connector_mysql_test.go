@@ -0,0 +1,313 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	momysql "github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql"
+	"github.com/matrixorigin/matrixone/pkg/stream/adapter/mysql/mysqltest"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+type mysqlTestResult struct {
+	columns []string
+	rows    [][]string
+	err     error
+}
+
+func (res *mysqlTestResult) Error() error        { return res.err }
+func (res *mysqlTestResult) ColumnCount() uint64 { return uint64(len(res.columns)) }
+func (res *mysqlTestResult) Column(ctx context.Context, i uint64) (string, uint8, bool, error) {
+	return res.columns[i], 0, true, nil
+}
+func (res *mysqlTestResult) RowCount() uint64 { return uint64(len(res.rows)) }
+func (res *mysqlTestResult) Row(ctx context.Context, i uint64) ([]interface{}, error) {
+	return nil, nil
+}
+func (res *mysqlTestResult) Value(ctx context.Context, ridx uint64, cidx uint64) (interface{}, error) {
+	return res.rows[ridx][cidx], nil
+}
+func (res *mysqlTestResult) ValueByName(ctx context.Context, ridx uint64, col string) (interface{}, error) {
+	return res.StringValueByName(ctx, ridx, col)
+}
+func (res *mysqlTestResult) StringValueByName(ctx context.Context, ridx uint64, col string) (string, error) {
+	for i, name := range res.columns {
+		if name == col {
+			return res.rows[ridx][i], nil
+		}
+	}
+	return "", moerr.NewInvalidInputNoCtx("no column %s", col)
+}
+func (res *mysqlTestResult) Float64ValueByName(ctx context.Context, ridx uint64, col string) (float64, error) {
+	return 0, nil
+}
+
+// mysqlTestExecutor records the executed SQLs and keeps the saved position
+// like the system table.
+type mysqlTestExecutor struct {
+	sync.Mutex
+	execs   []string
+	saved   *momysql.Position
+	columns map[string]*mysqlTestResult
+	execC   chan string
+}
+
+func newMysqlTestExecutor() *mysqlTestExecutor {
+	return &mysqlTestExecutor{
+		columns: make(map[string]*mysqlTestResult),
+		execC:   make(chan string, 100),
+	}
+}
+
+func (m *mysqlTestExecutor) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
+	m.Lock()
+	defer m.Unlock()
+	if opts.AccountId == nil || *opts.AccountId != 5 {
+		return moerr.NewInternalErrorNoCtx("invalid account")
+	}
+	m.execs = append(m.execs, sql)
+	// parse the saved position from the position insertion
+	if i := strings.Index(sql, "values (1, "); i >= 0 {
+		ss := strings.Split(sql[i+len("values (1, "):], ", ")
+		pos, _ := strconv.ParseUint(ss[2], 10, 32)
+		m.saved = &momysql.Position{File: strings.Trim(ss[1], "'"), Pos: uint32(pos)}
+	}
+	m.execC <- sql
+	return nil
+}
+
+func (m *mysqlTestExecutor) Query(ctx context.Context, sql string, opts ie.SessionOverrideOptions) ie.InternalExecResult {
+	m.Lock()
+	defer m.Unlock()
+	if strings.Contains(sql, "mo_binlog_positions") {
+		res := &mysqlTestResult{columns: []string{"binlog_file", "binlog_pos"}}
+		if m.saved != nil {
+			res.rows = append(res.rows, []string{m.saved.File, strconv.FormatUint(uint64(m.saved.Pos), 10)})
+		}
+		return res
+	}
+	for table, res := range m.columns {
+		if strings.Contains(sql, "att_relname = '"+table+"'") {
+			return res
+		}
+	}
+	return &mysqlTestResult{}
+}
+
+func (m *mysqlTestExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {}
+
+func (m *mysqlTestExecutor) waitExec(t *testing.T) string {
+	select {
+	case sql := <-m.execC:
+		return sql
+	case <-time.After(time.Second * 10):
+		t.Fatal("timeout waiting for the exec")
+	}
+	return ""
+}
+
+var mysqlTestTable = &mysqltest.Table{
+	ID:     100,
+	Schema: "db1",
+	Name:   "t1",
+	Columns: []mysqltest.Column{
+		{Name: "id", Type: momysql.MysqlTypeLongLong},
+		{Name: "name", Type: momysql.MysqlTypeVarchar, Meta: 1024},
+		{Name: "score", Type: momysql.MysqlTypeTiny, Unsigned: true},
+	},
+}
+
+const mysqlTestPositionSQL = "delete from mo_catalog.mo_binlog_positions where task_id = 1; " +
+	"insert into mo_catalog.mo_binlog_positions (task_id, upstream, binlog_file, binlog_pos, update_time) " +
+	"values (1, '%s', '%s', %d, utc_timestamp())"
+
+func mysqlTestTxn(stmts ...string) string {
+	return "set time_zone = '+00:00'; begin; " + strings.Join(stmts, "; ") + "; commit;"
+}
+
+func TestMysqlBinlogConnector(t *testing.T) {
+	producer, err := mysqltest.NewProducer("repl", "secret", true)
+	require.NoError(t, err)
+	defer producer.Close()
+
+	executor := newMysqlTestExecutor()
+	executor.columns["t1"] = &mysqlTestResult{
+		columns: []string{"attname", "att_constraint_type", "att_is_unsigned"},
+		rows:    [][]string{{"id", "p", "0"}, {"name", "n", "0"}, {"score", "n", "1"}},
+	}
+	other := &mysqltest.Table{ID: 101, Schema: "db1", Name: "t2", Columns: mysqlTestTable.Columns}
+
+	start := producer.Position()
+	producer.Append(
+		mysqltest.QueryEvent("db1", "BEGIN"),
+		mysqltest.TableMapEvent(mysqlTestTable),
+		mysqltest.RowsEvent(mysqlTestTable, momysql.RowsInsert,
+			[]any{int64(1), "a'b", uint8(200)},
+			[]any{int64(2), nil, nil}),
+		mysqltest.TableMapEvent(other),
+		mysqltest.RowsEvent(other, momysql.RowsInsert, []any{int64(1), "x", uint8(1)}),
+		mysqltest.XidEvent(10),
+	)
+	first := producer.Position()
+
+	newConnector := func() *MysqlBinlogConnector {
+		c, err := NewMysqlBinlogConnector(zap.NewNop(), 1, 5, "db1.t1", map[string]string{
+			"type":        "mysql",
+			"address":     producer.Addr(),
+			"user":        "repl",
+			"password":    "secret",
+			"binlog_file": start.File,
+			"binlog_pos":  strconv.FormatUint(uint64(start.Pos), 10),
+		}, executor)
+		require.NoError(t, err)
+		return c
+	}
+	run := func(c *MysqlBinlogConnector) chan error {
+		errC := make(chan error, 1)
+		go func() {
+			errC <- c.Start(context.Background())
+		}()
+		return errC
+	}
+
+	c := newConnector()
+	errC := run(c)
+	// the rows of t2 are not replicated, the unsigned value is decoded
+	// without the full metadata.
+	require.Equal(t, mysqlTestTxn(
+		"insert into `db1`.`t1` (`id`, `name`, `score`) values (1, 'a\\'b', 200), (2, NULL, NULL)",
+		fmtPosition(producer.Addr(), first),
+	), executor.waitExec(t))
+
+	// the connector reconnects from the last position
+	producer.Disconnect()
+	producer.Rotate()
+	producer.Append(
+		mysqltest.QueryEvent("db1", "BEGIN"),
+		mysqltest.TableMapEvent(mysqlTestTable),
+		mysqltest.RowsEvent(mysqlTestTable, momysql.RowsUpdate,
+			[]any{int64(1), "a'b", uint8(200)},
+			[]any{int64(1), "c", uint8(201)}),
+		mysqltest.RowsEvent(mysqlTestTable, momysql.RowsDelete,
+			[]any{int64(2), nil, nil}),
+		mysqltest.XidEvent(11),
+	)
+	second := producer.Position()
+	require.Equal(t, mysqlTestTxn(
+		"update `db1`.`t1` set `id` = 1, `name` = 'c', `score` = 201 where `id` = 1",
+		"delete from `db1`.`t1` where `id` = 2",
+		fmtPosition(producer.Addr(), second),
+	), executor.waitExec(t))
+	require.NoError(t, c.Cancel())
+	require.NoError(t, <-errC)
+
+	// the connector resumes from the saved position after the restart
+	producer.Append(
+		mysqltest.QueryEvent("db1", "BEGIN"),
+		mysqltest.TableMapEvent(mysqlTestTable),
+		mysqltest.RowsEvent(mysqlTestTable, momysql.RowsDelete,
+			[]any{int64(1), "c", uint8(201)}),
+		mysqltest.XidEvent(12),
+	)
+	c = newConnector()
+	errC = run(c)
+	require.Equal(t, mysqlTestTxn(
+		"delete from `db1`.`t1` where `id` = 1",
+		fmtPosition(producer.Addr(), producer.Position()),
+	), executor.waitExec(t))
+	require.NoError(t, c.Pause())
+	require.NoError(t, c.Resume())
+	require.NoError(t, c.Cancel())
+	require.NoError(t, <-errC)
+	require.Equal(t, 3, len(executor.execs))
+}
+
+func fmtPosition(addr string, pos momysql.Position) string {
+	return strings.NewReplacer("'%s', '%s', %d",
+		"'"+addr+"', '"+pos.File+"', "+strconv.FormatUint(uint64(pos.Pos), 10)).Replace(mysqlTestPositionSQL)
+}
+
+func TestMysqlBinlogConnectorNoTable(t *testing.T) {
+	producer, err := mysqltest.NewProducer("repl", "", false)
+	require.NoError(t, err)
+	defer producer.Close()
+
+	producer.Append(
+		mysqltest.QueryEvent("db1", "BEGIN"),
+		mysqltest.TableMapEvent(mysqlTestTable),
+		mysqltest.RowsEvent(mysqlTestTable, momysql.RowsInsert, []any{int64(1), "a", uint8(1)}),
+		mysqltest.XidEvent(10),
+	)
+	c, err := NewMysqlBinlogConnector(zap.NewNop(), 1, 5, "db1.t3", map[string]string{
+		"type":    "mysql",
+		"address": producer.Addr(),
+		"user":    "repl",
+		"tables":  "db1.*",
+	}, newMysqlTestExecutor())
+	require.NoError(t, err)
+	// starts from the current position of the upstream
+	require.NoError(t, c.loadPosition(context.Background()))
+	require.Equal(t, producer.Position(), c.pos)
+
+	c.pos = momysql.Position{File: producer.Position().File, Pos: 4}
+	err = c.replicate(context.Background())
+	require.Error(t, err)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoSuchTable))
+}
+
+func TestMysqlLiteral(t *testing.T) {
+	require.Equal(t, "NULL", mysqlLiteral(nil))
+	require.Equal(t, "-1", mysqlLiteral(int64(-1)))
+	require.Equal(t, "18446744073709551615", mysqlLiteral(uint64(1<<64-1)))
+	require.Equal(t, "1.5", mysqlLiteral(float32(1.5)))
+	require.Equal(t, "'a\\\\b\\0'", mysqlLiteral([]byte("a\\b\x00")))
+	require.Equal(t, "x'00ff'", mysqlLiteral([]byte{0x00, 0xff}))
+	require.Equal(t, "'2024-06-29'", mysqlLiteral("2024-06-29"))
+	require.Equal(t, "`a``b`", quoteMysqlIdent("a`b"))
+
+	_, err := NewMysqlBinlogConnector(zap.NewNop(), 1, 0, "db1.t1",
+		map[string]string{"type": "mysql", "address": "localhost:3306"}, nil)
+	require.Error(t, err)
+	_, err = NewMysqlBinlogConnector(zap.NewNop(), 1, 0, "db1.t1",
+		map[string]string{"type": "mysql", "address": "localhost:3306", "user": "u", "tables": "t1"}, nil)
+	require.Error(t, err)
+}
+
+func TestMysqlBinlogConnectorWhere(t *testing.T) {
+	c := &MysqlBinlogConnector{}
+	tbl := &mysqlTargetTable{columns: []string{"id", "name"}, pks: []int{0}}
+	row := []any{int64(1), "a"}
+	present := []bool{true, true}
+	columns := []int{0, 1}
+	require.Equal(t, "`id` = 1", c.where(tbl, columns, present, row, func(_ int, v any) string {
+		return mysqlLiteral(v)
+	}))
+
+	// a table without a primary key may hold duplicated rows
+	tbl.pks = nil
+	require.Equal(t, "`id` = 1 and `name` = 'a' limit 1", c.where(tbl, columns, present, row, func(_ int, v any) string {
+		return mysqlLiteral(v)
+	}))
+}
@@ -24,6 +24,7 @@ import (
 
 const (
 	SourceKafka string = "kafka"
+	SourceMysql string = "mysql"
 	FormatJson  string = "json"
 )
 
@@ -102,10 +103,20 @@ const (
 
 	OptConnectorBufferLimit = "buffer_limit"
 	OptConnectorTimeWindow  = "time_window"
+
+	// The options of the mysql binlog connector. The tables are the upstream
+	// tables to replicate, separated by commas, like "db1.t1,db2.*".
+	OptConnectorAddress    = "address"
+	OptConnectorUser       = "user"
+	OptConnectorPassword   = "password"
+	OptConnectorServerID   = "server_id"
+	OptConnectorBinlogFile = "binlog_file"
+	OptConnectorBinlogPos  = "binlog_pos"
+	OptConnectorTables     = "tables"
 )
 
 var ConnectorOptConstraint = map[string]OptConstraint{
-	OptConnectorType:        enumOpt(SourceKafka),
+	OptConnectorType:        enumOpt(SourceKafka, SourceMysql),
 	OptConnectorServers:     addressOpt,
 	OptConnectorTopic:       stringOpt,
 	OptConnectorValue:       enumOpt(FormatJson),
@@ -114,6 +125,13 @@ var ConnectorOptConstraint = map[string]OptConstraint{
 	OptConnectorPartition:   integerOpt,
 	OptConnectorBufferLimit: integerOpt,
 	OptConnectorTimeWindow:  integerOpt,
+	OptConnectorAddress:     addressOpt,
+	OptConnectorUser:        stringOpt,
+	OptConnectorPassword:    {Type: OptTypeString},
+	OptConnectorServerID:    integerOpt,
+	OptConnectorBinlogFile:  stringOpt,
+	OptConnectorBinlogPos:   integerOpt,
+	OptConnectorTables:      stringOpt,
 }
 
 var ConnectorEssentialOpts = map[string]struct{}{
@@ -126,6 +144,10 @@ var ConnectorEssentialTypeOpts = map[string]map[string]struct{}{
 		OptConnectorTopic:   {},
 		OptConnectorValue:   {},
 	},
+	"mysql": {
+		OptConnectorAddress: {},
+		OptConnectorUser:    {},
+	},
 }
 
 func MakeStmtOpts(ctx context.Context, opts map[string]string) (StmtOpts, error) {
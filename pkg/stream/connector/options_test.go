@@ -75,4 +75,22 @@ func TestMakeStmtOpts(t *testing.T) {
 	o, err = MakeStmtOpts(context.Background(), okOpts)
 	assert.NoError(t, err)
 	assert.Equal(t, o, StmtOpts(okOpts))
+
+	lackOpts = map[string]string{"type": "mysql", "address": "localhost:3306"}
+	_, err = MakeStmtOpts(context.Background(), lackOpts)
+	assert.Error(t, err)
+
+	mysqlOpts := map[string]string{
+		"type":        "mysql",
+		"address":     "localhost:3306",
+		"user":        "repl",
+		"password":    "",
+		"server_id":   "1001",
+		"binlog_file": "mysql-bin.000001",
+		"binlog_pos":  "4",
+		"tables":      "db1.t1,db2.*",
+	}
+	o, err = MakeStmtOpts(context.Background(), mysqlOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, o, StmtOpts(mysqlOpts))
 }
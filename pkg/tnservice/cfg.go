@@ -124,6 +124,16 @@ type Config struct {
 		DisableGC      bool          `toml:"disable-gc"`
 	}
 
+	Scrub struct {
+		// Interval is the interval between two rounds of verifying all objects, 0 disables scrubbing
+		Interval toml.Duration `toml:"interval"`
+		// BytesPerSecond limits the read rate of scrubbing
+		BytesPerSecond toml.ByteSize `toml:"bytes-per-second"`
+		// RepairFileService is the name of the file service holding replicas of the objects,
+		// corrupted objects are repaired from it if not empty
+		RepairFileService string `toml:"repair-file-service"`
+	}
+
 	Merge struct {
 		CNTakeOverAll    bool          `toml:"offload-all"`
 		CNStandaloneTake bool          `toml:"offload-when-standalone"`
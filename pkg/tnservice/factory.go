@@ -157,6 +157,17 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		CNStandaloneTake:      s.cfg.Merge.CNStandaloneTake,
 	}
 
+	scrubCfg := &options.ScrubCfg{
+		Interval:       s.cfg.Scrub.Interval.Duration,
+		BytesPerSecond: int64(s.cfg.Scrub.BytesPerSecond),
+	}
+	if s.cfg.Scrub.RepairFileService != "" {
+		scrubCfg.RepairFS, err = fileservice.Get[fileservice.FileService](s.fileService, s.cfg.Scrub.RepairFileService)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	logtailServerAddr := s.logtailServiceListenAddr()
 	logtailServerCfg := &options.LogtailServerCfg{
 		RpcMaxMessageSize:      int64(s.cfg.LogtailServer.RpcMaxMessageSize),
@@ -179,6 +190,7 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		CheckpointCfg:     ckpcfg,
 		GCCfg:             gcCfg,
 		MergeCfg:          mergeCfg,
+		ScrubCfg:          scrubCfg,
 		LogStoreT:         options.LogstoreLogservice,
 		IncrementalDedup:  s.cfg.Txn.IncrementalDedup == "true",
 		IsStandalone:      s.cfg.InStandalone,
@@ -43,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/util/status"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/scrub"
 	"go.uber.org/zap"
 )
 
@@ -442,6 +443,7 @@ func (s *store) initQueryService(inStandalone bool) {
 func (s *store) initQueryCommandHandler() {
 	s.queryService.AddHandleFunc(query.CmdMethod_GetCacheInfo, s.handleGetCacheInfo, false)
 	s.queryService.AddHandleFunc(query.CmdMethod_GetLatestBind, s.handleGetLatestBind, false)
+	s.queryService.AddHandleFunc(query.CmdMethod_GetScrubInfo, s.handleGetScrubInfo, false)
 }
 
 func (s *store) handleGetCacheInfo(ctx context.Context, req *query.Request, resp *query.Response) error {
@@ -467,6 +469,23 @@ func (s *store) handleGetLatestBind(ctx context.Context, req *query.Request, res
 	return nil
 }
 
+func (s *store) handleGetScrubInfo(ctx context.Context, req *query.Request, resp *query.Response) error {
+	resp.GetScrubInfoResponse = new(query.GetScrubInfoResponse)
+	for _, c := range scrub.DefaultReport.Records() {
+		resp.GetScrubInfoResponse.ScrubInfoList = append(resp.GetScrubInfoResponse.ScrubInfoList, &query.ScrubInfo{
+			NodeId:      s.cfg.UUID,
+			ObjectName:  c.ObjectName,
+			DbId:        c.DBID,
+			TableId:     c.TableID,
+			Error:       c.Error,
+			DetectedAt:  c.DetectedAt.UnixNano(),
+			Repaired:    c.Repaired,
+			RepairError: c.RepairError,
+		})
+	}
+	return nil
+}
+
 func (s *store) setupStatusServer() {
 	ss, ok := runtime.ProcessLevelRuntime().GetGlobalVariables(runtime.StatusServer)
 	if ok {
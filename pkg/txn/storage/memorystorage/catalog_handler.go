@@ -317,6 +317,18 @@ func (c *CatalogHandler) HandleTruncateRelation(ctx context.Context, meta txn.Tx
 	return c.upstream.HandleTruncateRelation(ctx, meta, req, resp)
 }
 
+func (c *CatalogHandler) HandleRenameRelation(ctx context.Context, meta txn.TxnMeta, req *memoryengine.RenameRelationReq, resp *memoryengine.RenameRelationResp) (err error) {
+	if _, ok := c.sysRelationIDs[req.ID]; ok {
+		defer logReq("catalog", req, meta, resp, &err)()
+		return moerr.NewInternalError(ctx,
+			"read only, db %v, table %v",
+			req.DatabaseName,
+			req.OldName,
+		)
+	}
+	return c.upstream.HandleRenameRelation(ctx, meta, req, resp)
+}
+
 func (c *CatalogHandler) HandleDestroy(ctx context.Context) error {
 	return c.upstream.HandleDestroy(ctx)
 }
@@ -68,6 +68,9 @@ type MemHandler struct {
 	mheap       *mpool.MPool
 	clock       clock.Clock
 	idGenerator memoryengine.IDGenerator
+
+	// spill moves row values to local disk, nil if disabled
+	spill *spillStore
 }
 
 type Iter[
@@ -101,6 +104,13 @@ func NewMemHandler(
 	return h
 }
 
+// EnableSpill makes the handler keep at most memLimit bytes of row values in
+// memory, values of rows written after that are stored in a file under dir.
+// dir is removed when the handler is closed.
+func (m *MemHandler) EnableSpill(dir string, memLimit int64) {
+	m.spill = newSpillStore(dir, memLimit)
+}
+
 var _ Handler = new(MemHandler)
 
 func (m *MemHandler) HandleAddTableDef(ctx context.Context, meta txn.TxnMeta, req *memoryengine.AddTableDefReq, resp *memoryengine.AddTableDefResp) error {
@@ -535,7 +545,7 @@ func (m *MemHandler) HandleDelete(ctx context.Context, meta txn.TxnMeta, req *me
 			if len(entries) != 1 {
 				panic("impossible")
 			}
-			if err := m.data.Delete(tx, entries[0].Key); err != nil {
+			if err := m.deleteData(tx, entries[0].Key); err != nil {
 				return err
 			}
 		}
@@ -564,7 +574,7 @@ func (m *MemHandler) HandleDelete(ctx context.Context, meta txn.TxnMeta, req *me
 					tableID:    req.TableID,
 					primaryKey: Tuple{memorytable.ToOrdered(value.Value)},
 				}
-				if err := m.data.Delete(tx, key); err != nil {
+				if err := m.deleteData(tx, key); err != nil {
 					return err
 				}
 			}
@@ -608,6 +618,12 @@ func (m *MemHandler) HandleDelete(ctx context.Context, meta txn.TxnMeta, req *me
 		if key.tableID != req.TableID {
 			break
 		}
+		if m.spill != nil {
+			dataValue, err = m.spill.load(dataValue)
+			if err != nil {
+				return err
+			}
+		}
 		for i := 0; i < reqVecLen; i++ {
 			value := memorytable.VectorAt(req.Vector, i)
 			if attrIndex >= len(dataValue) {
@@ -616,7 +632,7 @@ func (m *MemHandler) HandleDelete(ctx context.Context, meta txn.TxnMeta, req *me
 			}
 			attrInRow := dataValue[attrIndex]
 			if value.Equal(attrInRow) {
-				if err := m.data.Delete(tx, key); err != nil {
+				if err := m.deleteData(tx, key); err != nil {
 					return err
 				}
 			}
@@ -717,13 +733,25 @@ func (m *MemHandler) deleteRelationData(tx *Transaction, relationID ID) error {
 		if key.tableID != relationID {
 			break
 		}
-		if err := m.data.Delete(tx, key); err != nil {
+		if err := m.deleteData(tx, key); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// deleteData deletes the row of key, its value is released once tx commits
+func (m *MemHandler) deleteData(tx *Transaction, key DataKey) error {
+	if m.spill != nil {
+		if value, err := m.data.Get(tx, key); err == nil {
+			m.spill.release(tx, value)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+	return m.data.Delete(tx, key)
+}
+
 func (m *MemHandler) HandleDeleteRelation(ctx context.Context, meta txn.TxnMeta, req *memoryengine.DeleteRelationReq, resp *memoryengine.DeleteRelationResp) error {
 	tx := m.getTx(meta)
 	entries, err := m.relations.Index(tx, Tuple{
@@ -753,6 +781,9 @@ func (m *MemHandler) HandleDeleteRelation(ctx context.Context, meta txn.TxnMeta,
 	if err := m.deleteAttributesByRelationID(tx, rel.ID); err != nil {
 		return err
 	}
+	if err := m.deleteRelationData(tx, rel.ID); err != nil {
+		return err
+	}
 	resp.ID = rel.ID
 	return nil
 }
@@ -766,6 +797,38 @@ func (m *MemHandler) HandleTruncateRelation(ctx context.Context, meta txn.TxnMet
 	return m.deleteRelationData(tx, req.OldTableID)
 }
 
+func (m *MemHandler) HandleRenameRelation(ctx context.Context, meta txn.TxnMeta, req *memoryengine.RenameRelationReq, resp *memoryengine.RenameRelationResp) error {
+	tx := m.getTx(meta)
+	rel, err := m.relations.Get(tx, req.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return moerr.NewNoSuchTableNoCtx(req.DatabaseName, req.OldName)
+	}
+	if err != nil {
+		return err
+	}
+
+	entries, err := m.relations.Index(tx, Tuple{
+		index_DatabaseID_Name,
+		rel.DatabaseID,
+		Text(req.NewName),
+	})
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return moerr.NewTableAlreadyExistsNoCtx(req.NewName)
+	}
+
+	// the indexes of the row are derived from its name, update a copy
+	row := *rel
+	row.Name = []byte(req.NewName)
+	if err := m.relations.Update(tx, &row); err != nil {
+		return err
+	}
+	resp.ID = rel.ID
+	return nil
+}
+
 func (m *MemHandler) HandleGetDatabases(ctx context.Context, meta txn.TxnMeta, req *memoryengine.GetDatabasesReq, resp *memoryengine.GetDatabasesResp) error {
 	tx := m.getTx(meta)
 
@@ -1114,6 +1177,12 @@ func (m *MemHandler) HandleRead(ctx context.Context, meta txn.TxnMeta, req *memo
 			break
 		}
 
+		if m.spill != nil {
+			value, err = m.spill.load(value)
+			if err != nil {
+				return err
+			}
+		}
 		rows = append(rows, Row{
 			Value: value,
 		})
@@ -1164,6 +1233,11 @@ func (m *MemHandler) HandleUpdate(ctx context.Context, meta txn.TxnMeta, req *me
 			row *DataRow,
 			_ types.Rowid,
 		) error {
+			if old, err := m.data.Get(tx, row.key); err == nil {
+				m.spill.release(tx, old)
+			} else if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
 			if err := m.data.Update(tx, *row); err != nil {
 				return err
 			}
@@ -1288,6 +1362,12 @@ func (m *MemHandler) rangeBatchPhysicalRows(
 			)
 		}
 
+		value, err := m.spill.maybeSpill(tx, physicalRow.value)
+		if err != nil {
+			return err
+		}
+		physicalRow.value = value
+
 		if err := fn(physicalRow, rowID); err != nil {
 			return err
 		}
@@ -1313,14 +1393,17 @@ func (m *MemHandler) getTx(meta txn.TxnMeta) *Transaction {
 	return tx
 }
 
-func (*MemHandler) HandleClose(ctx context.Context) error {
-	return nil
+func (m *MemHandler) HandleClose(ctx context.Context) error {
+	return m.spill.close()
 }
 
 func (m *MemHandler) HandleCommit(ctx context.Context, meta txn.TxnMeta) (timestamp.Timestamp, error) {
 	tx := m.getTx(meta)
 	commitTS := meta.CommitTS
 	if err := tx.Commit(commitTS); err != nil {
+		return timestamp.Timestamp{}, errors.Join(err, m.spill.rollback(tx))
+	}
+	if err := m.spill.commit(tx); err != nil {
 		return timestamp.Timestamp{}, err
 	}
 	return commitTS, nil
@@ -1331,8 +1414,10 @@ func (m *MemHandler) HandleCommitting(ctx context.Context, meta txn.TxnMeta) err
 }
 
 func (m *MemHandler) HandleDestroy(ctx context.Context) error {
+	spill := m.spill
 	*m = *NewMemHandler(m.mheap, m.clock, m.idGenerator)
-	return nil
+	m.spill = spill
+	return spill.reset()
 }
 
 func (m *MemHandler) HandlePrepare(ctx context.Context, meta txn.TxnMeta) (timestamp.Timestamp, error) {
@@ -1343,7 +1428,7 @@ func (m *MemHandler) HandlePrepare(ctx context.Context, meta txn.TxnMeta) (times
 func (m *MemHandler) HandleRollback(ctx context.Context, meta txn.TxnMeta) error {
 	tx := m.getTx(meta)
 	tx.Abort()
-	return nil
+	return m.spill.rollback(tx)
 }
 
 func (m *MemHandler) HandleStartRecovery(ctx context.Context, ch chan txn.TxnMeta) {
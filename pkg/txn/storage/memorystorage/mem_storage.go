@@ -38,3 +38,28 @@ func NewMemoryStorage(
 	return storage, nil
 
 }
+
+// NewSpillableMemoryStorage is like NewMemoryStorage, but keeps row values
+// beyond memLimit bytes in a local file under spillDir. spillDir is removed
+// when the storage is closed.
+func NewSpillableMemoryStorage(
+	mheap *mpool.MPool,
+	clock clock.Clock,
+	idGenerator memoryengine.IDGenerator,
+	spillDir string,
+	memLimit int64,
+) (*Storage, error) {
+
+	memHandler := NewMemHandler(mheap, clock, idGenerator)
+	memHandler.EnableSpill(spillDir, memLimit)
+	catalogHandler, err := NewCatalogHandler(memHandler)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := New(catalogHandler)
+	if err != nil {
+		return nil, err
+	}
+	return storage, nil
+
+}
@@ -0,0 +1,508 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage
+
+import (
+	"encoding/binary"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// spillFileSize is the size a spill file is rotated at
+const spillFileSize = 64 << 20
+
+// spillStore moves row values of a MemHandler to a local file once the
+// values kept in memory exceed limit bytes. Keys and indexes always stay in
+// memory, so lookups and visibility checks are not affected; only the column
+// values are read back from disk when a row is scanned.
+//
+// Values of deleted or updated rows are released when the deleting
+// transaction commits, and values written by an aborted transaction when it
+// rolls back. Values are appended to the current file, which is rotated
+// every fileSize bytes, and a rotated file is removed once none of its
+// values is live.
+type spillStore struct {
+	sync.Mutex
+	// dir is owned by the store and removed on close
+	dir      string
+	limit    int64
+	fileSize int64
+
+	inMemory int64
+	file     *spillFile
+	files    map[*spillFile]struct{}
+	// changes of the transactions not committed or rolled back yet
+	pending map[*Transaction]*spillChanges
+	closed  bool
+}
+
+type spillFile struct {
+	f    *os.File
+	size int64
+	// bytes of the values not released yet
+	live int64
+}
+
+// spillChanges are the values a transaction wrote and released
+type spillChanges struct {
+	written  []DataValue
+	released []DataValue
+}
+
+// spilledValue is the only element of a DataValue whose columns were moved
+// to the spill file.
+type spilledValue struct {
+	file   *spillFile
+	offset int64
+	length int64
+}
+
+func newSpillStore(dir string, limit int64) *spillStore {
+	return &spillStore{
+		dir:      dir,
+		limit:    limit,
+		fileSize: spillFileSize,
+		files:    make(map[*spillFile]struct{}),
+		pending:  make(map[*Transaction]*spillChanges),
+	}
+}
+
+// maybeSpill returns value unchanged while the store is under its memory
+// limit, and a reference to the encoded value in the spill file otherwise.
+// The value is released if tx rolls back.
+func (s *spillStore) maybeSpill(tx *Transaction, value DataValue) (DataValue, error) {
+	if s == nil {
+		return value, nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return nil, moerr.NewInternalErrorNoCtx("temporary table storage is closed")
+	}
+
+	ret, err := s.spill(value)
+	if err != nil {
+		return nil, err
+	}
+	s.changes(tx).written = append(s.changes(tx).written, ret)
+	return ret, nil
+}
+
+func (s *spillStore) spill(value DataValue) (DataValue, error) {
+	size := dataValueSize(value)
+	if s.inMemory+size <= s.limit {
+		s.inMemory += size
+		return value, nil
+	}
+
+	data, ok := encodeDataValue(nil, value)
+	if !ok {
+		// value contains a type we can not encode, keep it in memory
+		s.inMemory += size
+		return value, nil
+	}
+	if s.file == nil || s.file.size >= s.fileSize {
+		if err := s.rotate(); err != nil {
+			return nil, err
+		}
+	}
+	file := s.file
+	if _, err := file.f.WriteAt(data, file.size); err != nil {
+		return nil, err
+	}
+	ref := spilledValue{
+		file:   file,
+		offset: file.size,
+		length: int64(len(data)),
+	}
+	file.size += ref.length
+	file.live += ref.length
+	return DataValue{{Value: ref}}, nil
+}
+
+// rotate starts a new spill file, the current one is removed once its
+// values are released
+func (s *spillStore) rotate() error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(s.dir, "spill-*")
+	if err != nil {
+		return err
+	}
+	old := s.file
+	s.file = &spillFile{f: f}
+	s.files[s.file] = struct{}{}
+	if old != nil && old.live == 0 {
+		return s.remove(old)
+	}
+	return nil
+}
+
+func (s *spillStore) remove(file *spillFile) error {
+	delete(s.files, file)
+	name := file.f.Name()
+	if err := file.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+func (s *spillStore) changes(tx *Transaction) *spillChanges {
+	c, ok := s.pending[tx]
+	if !ok {
+		c = new(spillChanges)
+		s.pending[tx] = c
+	}
+	return c
+}
+
+// release releases value, returned by maybeSpill, once tx commits.
+func (s *spillStore) release(tx *Transaction, value DataValue) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return
+	}
+	s.changes(tx).released = append(s.changes(tx).released, value)
+}
+
+// commit releases the values released by tx
+func (s *spillStore) commit(tx *Transaction) error {
+	return s.done(tx, true)
+}
+
+// rollback releases the values written by tx
+func (s *spillStore) rollback(tx *Transaction) error {
+	return s.done(tx, false)
+}
+
+func (s *spillStore) done(tx *Transaction, committed bool) error {
+	if s == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	c, ok := s.pending[tx]
+	if !ok || s.closed {
+		return nil
+	}
+	delete(s.pending, tx)
+	values := c.released
+	if !committed {
+		values = c.written
+	}
+	for _, value := range values {
+		if err := s.free(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *spillStore) free(value DataValue) error {
+	if len(value) == 1 {
+		if ref, ok := value[0].Value.(spilledValue); ok {
+			file := ref.file
+			file.live -= ref.length
+			if file.live == 0 && file != s.file {
+				if _, ok := s.files[file]; ok {
+					return s.remove(file)
+				}
+			}
+			return nil
+		}
+	}
+	s.inMemory -= dataValueSize(value)
+	return nil
+}
+
+// load resolves a value returned by maybeSpill.
+func (s *spillStore) load(value DataValue) (DataValue, error) {
+	if len(value) != 1 {
+		return value, nil
+	}
+	ref, ok := value[0].Value.(spilledValue)
+	if !ok {
+		return value, nil
+	}
+	s.Lock()
+	_, ok = s.files[ref.file]
+	closed := s.closed
+	s.Unlock()
+	if closed {
+		return nil, moerr.NewInternalErrorNoCtx("temporary table storage is closed")
+	}
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("spilled value is released")
+	}
+	data := make([]byte, ref.length)
+	if _, err := ref.file.f.ReadAt(data, ref.offset); err != nil {
+		return nil, err
+	}
+	return decodeDataValue(data)
+}
+
+// reset drops all values, used when the handler is destroyed.
+func (s *spillStore) reset() error {
+	if s == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.inMemory = 0
+	s.file = nil
+	s.pending = make(map[*Transaction]*spillChanges)
+	return s.removeAll()
+}
+
+func (s *spillStore) removeAll() error {
+	for file := range s.files {
+		if err := s.remove(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *spillStore) close() error {
+	if s == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.file = nil
+	if err := s.removeAll(); err != nil {
+		return err
+	}
+	return os.RemoveAll(s.dir)
+}
+
+const (
+	spillTagNull byte = iota
+	spillTagBool
+	spillTagInt8
+	spillTagInt16
+	spillTagInt32
+	spillTagInt64
+	spillTagUint8
+	spillTagUint16
+	spillTagUint32
+	spillTagUint64
+	spillTagFloat32
+	spillTagFloat64
+	spillTagDate
+	spillTagTime
+	spillTagDatetime
+	spillTagEnum
+	spillTagTimestamp
+	spillTagDecimal64
+	spillTagDecimal128
+	spillTagTS
+	spillTagRowid
+	spillTagBlockid
+	spillTagUuid
+	spillTagBytes
+	spillTagString
+)
+
+func dataValueSize(value DataValue) int64 {
+	size := int64(len(value)) * int64(unsafe.Sizeof(Nullable{}))
+	for _, v := range value {
+		switch v := v.Value.(type) {
+		case []byte:
+			size += int64(len(v))
+		case string:
+			size += int64(len(v))
+		case types.Decimal128, types.TS, types.Rowid, types.Blockid, types.Uuid:
+			size += int64(unsafe.Sizeof(v))
+		}
+	}
+	return size
+}
+
+func encodeDataValue(buf []byte, value DataValue) ([]byte, bool) {
+	buf = binary.AppendUvarint(buf, uint64(len(value)))
+	for _, v := range value {
+		if v.IsNull || v.Value == nil {
+			buf = append(buf, spillTagNull)
+			continue
+		}
+		switch v := v.Value.(type) {
+		case bool:
+			buf = appendFixed(buf, spillTagBool, v)
+		case int8:
+			buf = appendFixed(buf, spillTagInt8, v)
+		case int16:
+			buf = appendFixed(buf, spillTagInt16, v)
+		case int32:
+			buf = appendFixed(buf, spillTagInt32, v)
+		case int64:
+			buf = appendFixed(buf, spillTagInt64, v)
+		case uint8:
+			buf = appendFixed(buf, spillTagUint8, v)
+		case uint16:
+			buf = appendFixed(buf, spillTagUint16, v)
+		case uint32:
+			buf = appendFixed(buf, spillTagUint32, v)
+		case uint64:
+			buf = appendFixed(buf, spillTagUint64, v)
+		case float32:
+			buf = appendFixed(buf, spillTagFloat32, v)
+		case float64:
+			buf = appendFixed(buf, spillTagFloat64, v)
+		case types.Date:
+			buf = appendFixed(buf, spillTagDate, v)
+		case types.Time:
+			buf = appendFixed(buf, spillTagTime, v)
+		case types.Datetime:
+			buf = appendFixed(buf, spillTagDatetime, v)
+		case types.Enum:
+			buf = appendFixed(buf, spillTagEnum, v)
+		case types.Timestamp:
+			buf = appendFixed(buf, spillTagTimestamp, v)
+		case types.Decimal64:
+			buf = appendFixed(buf, spillTagDecimal64, v)
+		case types.Decimal128:
+			buf = appendFixed(buf, spillTagDecimal128, v)
+		case types.TS:
+			buf = appendFixed(buf, spillTagTS, v)
+		case types.Rowid:
+			buf = appendFixed(buf, spillTagRowid, v)
+		case types.Blockid:
+			buf = appendFixed(buf, spillTagBlockid, v)
+		case types.Uuid:
+			buf = appendFixed(buf, spillTagUuid, v)
+		case []byte:
+			buf = append(buf, spillTagBytes)
+			buf = binary.AppendUvarint(buf, uint64(len(v)))
+			buf = append(buf, v...)
+		case string:
+			buf = append(buf, spillTagString)
+			buf = binary.AppendUvarint(buf, uint64(len(v)))
+			buf = append(buf, v...)
+		default:
+			return nil, false
+		}
+	}
+	return buf, true
+}
+
+func decodeDataValue(buf []byte) (DataValue, error) {
+	n, l := binary.Uvarint(buf)
+	if l <= 0 {
+		return nil, moerr.NewInternalErrorNoCtx("bad spilled value")
+	}
+	buf = buf[l:]
+	value := make(DataValue, n)
+	for i := range value {
+		if len(buf) == 0 {
+			return nil, moerr.NewInternalErrorNoCtx("bad spilled value")
+		}
+		tag := buf[0]
+		buf = buf[1:]
+		var v any
+		switch tag {
+		case spillTagNull:
+			value[i] = Nullable{IsNull: true}
+			continue
+		case spillTagBool:
+			v, buf = decodeFixed[bool](buf)
+		case spillTagInt8:
+			v, buf = decodeFixed[int8](buf)
+		case spillTagInt16:
+			v, buf = decodeFixed[int16](buf)
+		case spillTagInt32:
+			v, buf = decodeFixed[int32](buf)
+		case spillTagInt64:
+			v, buf = decodeFixed[int64](buf)
+		case spillTagUint8:
+			v, buf = decodeFixed[uint8](buf)
+		case spillTagUint16:
+			v, buf = decodeFixed[uint16](buf)
+		case spillTagUint32:
+			v, buf = decodeFixed[uint32](buf)
+		case spillTagUint64:
+			v, buf = decodeFixed[uint64](buf)
+		case spillTagFloat32:
+			v, buf = decodeFixed[float32](buf)
+		case spillTagFloat64:
+			v, buf = decodeFixed[float64](buf)
+		case spillTagDate:
+			v, buf = decodeFixed[types.Date](buf)
+		case spillTagTime:
+			v, buf = decodeFixed[types.Time](buf)
+		case spillTagDatetime:
+			v, buf = decodeFixed[types.Datetime](buf)
+		case spillTagEnum:
+			v, buf = decodeFixed[types.Enum](buf)
+		case spillTagTimestamp:
+			v, buf = decodeFixed[types.Timestamp](buf)
+		case spillTagDecimal64:
+			v, buf = decodeFixed[types.Decimal64](buf)
+		case spillTagDecimal128:
+			v, buf = decodeFixed[types.Decimal128](buf)
+		case spillTagTS:
+			v, buf = decodeFixed[types.TS](buf)
+		case spillTagRowid:
+			v, buf = decodeFixed[types.Rowid](buf)
+		case spillTagBlockid:
+			v, buf = decodeFixed[types.Blockid](buf)
+		case spillTagUuid:
+			v, buf = decodeFixed[types.Uuid](buf)
+		case spillTagBytes, spillTagString:
+			size, l := binary.Uvarint(buf)
+			if l <= 0 || uint64(len(buf)-l) < size {
+				return nil, moerr.NewInternalErrorNoCtx("bad spilled value")
+			}
+			data := buf[l : l+int(size)]
+			buf = buf[l+int(size):]
+			if tag == spillTagString {
+				v = string(data)
+			} else {
+				v = data
+			}
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("bad spilled value tag %d", tag)
+		}
+		value[i] = Nullable{Value: v}
+	}
+	return value, nil
+}
+
+func appendFixed[T types.FixedSizeT](buf []byte, tag byte, v T) []byte {
+	buf = append(buf, tag)
+	return append(buf, types.EncodeFixed(v)...)
+}
+
+func decodeFixed[T types.FixedSizeT](buf []byte) (T, []byte) {
+	var v T
+	n := int(unsafe.Sizeof(v))
+	if len(buf) < n {
+		return v, nil
+	}
+	return types.DecodeFixed[T](buf[:n]), buf[n:]
+}
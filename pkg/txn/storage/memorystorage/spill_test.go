@@ -0,0 +1,158 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memoryengine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemHandlerSpill(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spill")
+	testDatabase(t, func() (*Storage, error) {
+		handler := NewMemHandler(
+			mpool.MustNewZero(),
+			clock.NewHLCClock(func() int64 {
+				return time.Now().UnixNano()
+			}, math.MaxInt64),
+			memoryengine.RandomIDGenerator,
+		)
+		// spill every row
+		handler.EnableSpill(dir, 0)
+		return New(handler)
+	})
+	_, err := os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSpillStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spill")
+	s := newSpillStore(dir, 64)
+	tx := memorytable.NewTransaction(memorytable.Time{})
+
+	small := DataValue{{Value: int64(1)}}
+	ret, err := s.maybeSpill(tx, small)
+	assert.Nil(t, err)
+	assert.Equal(t, small, ret)
+
+	value := DataValue{
+		{Value: true},
+		{Value: int8(-1)},
+		{Value: uint32(42)},
+		{Value: 3.5},
+		{Value: types.Date(100)},
+		{Value: types.Decimal128{B0_63: 1, B64_127: 2}},
+		{Value: types.Rowid{1, 2, 3}},
+		{Value: []byte("hello")},
+		{Value: "world"},
+		{IsNull: true},
+	}
+	ret, err = s.maybeSpill(tx, value)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ret))
+	_, ok := ret[0].Value.(spilledValue)
+	assert.True(t, ok)
+
+	loaded, err := s.load(ret)
+	assert.Nil(t, err)
+	assert.Equal(t, value, loaded)
+
+	// in memory values are returned as is
+	loaded, err = s.load(small)
+	assert.Nil(t, err)
+	assert.Equal(t, small, loaded)
+
+	// unsupported types stay in memory
+	tuple := DataValue{{Value: []any{1}}}
+	ret, err = s.maybeSpill(tx, tuple)
+	assert.Nil(t, err)
+	assert.Equal(t, tuple, ret)
+
+	assert.Nil(t, s.close())
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+	_, err = s.maybeSpill(tx, value)
+	assert.NotNil(t, err)
+}
+
+func TestSpillStoreRelease(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spill")
+	s := newSpillStore(dir, 64)
+	s.fileSize = 1
+
+	files := func() int {
+		entries, err := os.ReadDir(dir)
+		assert.Nil(t, err)
+		return len(entries)
+	}
+
+	// a committed value in memory and one in every file
+	tx1 := memorytable.NewTransaction(memorytable.Time{})
+	small := DataValue{{Value: int64(1)}}
+	small, err := s.maybeSpill(tx1, small)
+	assert.Nil(t, err)
+	var spilled []DataValue
+	for i := 0; i < 2; i++ {
+		value, err := s.maybeSpill(tx1, DataValue{{Value: strings.Repeat("a", 100)}})
+		assert.Nil(t, err)
+		spilled = append(spilled, value)
+	}
+	assert.Nil(t, s.commit(tx1))
+	assert.Equal(t, dataValueSize(small), s.inMemory)
+	assert.Equal(t, 2, files())
+
+	// values released by a transaction rolled back are kept
+	tx2 := memorytable.NewTransaction(memorytable.Time{})
+	s.release(tx2, small)
+	s.release(tx2, spilled[0])
+	assert.Nil(t, s.rollback(tx2))
+	assert.Equal(t, dataValueSize(small), s.inMemory)
+	assert.Equal(t, 2, files())
+
+	// the values written by a transaction rolled back are released
+	tx3 := memorytable.NewTransaction(memorytable.Time{})
+	_, err = s.maybeSpill(tx3, DataValue{{Value: strings.Repeat("b", 100)}})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, files())
+	assert.Nil(t, s.rollback(tx3))
+	assert.Equal(t, 3, files())
+
+	// the file of a value released on commit is removed, the current one
+	// is kept
+	tx4 := memorytable.NewTransaction(memorytable.Time{})
+	s.release(tx4, small)
+	s.release(tx4, spilled[0])
+	assert.Nil(t, s.commit(tx4))
+	assert.Equal(t, int64(0), s.inMemory)
+	assert.Equal(t, 2, files())
+	loaded, err := s.load(spilled[1])
+	assert.Nil(t, err)
+	assert.Equal(t, DataValue{{Value: strings.Repeat("a", 100)}}, loaded)
+	_, err = s.load(spilled[0])
+	assert.NotNil(t, err)
+
+	assert.Nil(t, s.close())
+}
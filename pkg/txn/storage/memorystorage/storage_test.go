@@ -485,6 +485,36 @@ func testDatabase(
 		assert.Nil(t, err)
 	}
 
+	// rename relation
+	for _, names := range [][2]string{{"table", "renamed"}, {"renamed", "table"}} {
+		resp := &memoryengine.RenameRelationResp{}
+		err := testWrite(
+			ctx, t, s, txnMeta,
+			memoryengine.OpRenameRelation,
+			&memoryengine.RenameRelationReq{
+				ID:      relID,
+				OldName: names[0],
+				NewName: names[1],
+			},
+			resp,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, relID, resp.ID)
+
+		openResp := &memoryengine.OpenRelationResp{}
+		err = testRead(
+			ctx, t, s, txnMeta,
+			memoryengine.OpOpenRelation,
+			&memoryengine.OpenRelationReq{
+				DatabaseID: dbID,
+				Name:       names[1],
+			},
+			openResp,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, relID, openResp.ID)
+	}
+
 	// delete relation
 	{
 		resp := &memoryengine.DeleteRelationResp{}
@@ -56,6 +56,12 @@ func (s *Storage) Write(ctx context.Context, txnMeta txn.TxnMeta, op uint32, pay
 			s.handler.HandleTruncateRelation,
 		)
 
+	case memoryengine.OpRenameRelation:
+		return handleWrite(
+			ctx, txnMeta, payload,
+			s.handler.HandleRenameRelation,
+		)
+
 	case memoryengine.OpAddTableDef:
 		return handleWrite(
 			ctx, txnMeta, payload,
@@ -75,6 +75,9 @@ func initTaskMetrics() {
 	registry.MustRegister(TaskMergeTransferPageLengthGauge)
 
 	registry.MustRegister(TaskStorageUsageCacheMemUsedGauge)
+
+	registry.MustRegister(taskScrubObjectsCounter)
+	registry.MustRegister(TaskScrubBytesCounter)
 }
 
 func initFileServiceMetrics() {
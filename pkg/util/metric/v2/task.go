@@ -132,3 +132,26 @@ var (
 			Help:      "Size of the storage usage cache used",
 		})
 )
+
+var (
+	taskScrubObjectsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "task",
+			Name:      "scrub_objects_total",
+			Help:      "Total number of objects verified by the scrubber.",
+		}, []string{"result"})
+
+	TaskScrubVerifiedCounter     = taskScrubObjectsCounter.WithLabelValues("verified")
+	TaskScrubCorruptedCounter    = taskScrubObjectsCounter.WithLabelValues("corrupted")
+	TaskScrubRepairedCounter     = taskScrubObjectsCounter.WithLabelValues("repaired")
+	TaskScrubRepairFailedCounter = taskScrubObjectsCounter.WithLabelValues("repair_failed")
+
+	TaskScrubBytesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "task",
+			Name:      "scrub_bytes_total",
+			Help:      "Total bytes read by the scrubber.",
+		})
+)
@@ -221,7 +221,7 @@ var (
 		fmt.Sprintf("CREATE VIEW IF NOT EXISTS %s.`PROCESSLIST` AS "+
 			"select node_id, conn_id, session_id, account, user, host, db, "+
 			"session_start, command, info, txn_id, statement_id, statement_type, "+
-			"query_type, sql_source_type, query_start, client_host, role, proxy_host "+
+			"query_type, sql_source_type, query_start, client_host, role, proxy_host, query_memory "+
 			"from PROCESSLIST() A", InformationDBConst),
 
 		"CREATE TABLE IF NOT EXISTS USER_PRIVILEGES (" +
@@ -35,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
@@ -684,6 +685,48 @@ func (e *Engine) UnsubscribeTable(ctx context.Context, dbID, tbID uint64) error
 	return e.PushClient().UnsubscribeTable(ctx, dbID, tbID)
 }
 
+// AddLogtailListener registers a listener of the incremental logtail and
+// returns the function to remove it.
+func (e *Engine) AddLogtailListener(l LogtailListener) (remove func()) {
+	e.logtailListeners.Lock()
+	defer e.logtailListeners.Unlock()
+	if e.logtailListeners.m == nil {
+		e.logtailListeners.m = make(map[uint64]LogtailListener)
+	}
+	e.logtailListeners.seq++
+	id := e.logtailListeners.seq
+	e.logtailListeners.m[id] = l
+	return func() {
+		e.logtailListeners.Lock()
+		defer e.logtailListeners.Unlock()
+		delete(e.logtailListeners.m, id)
+	}
+}
+
+// LatestLogtailAppliedTime returns the timestamp before which the logtail
+// of all the subscribed tables has been applied.
+func (e *Engine) LatestLogtailAppliedTime() timestamp.Timestamp {
+	return e.pClient.receivedLogTailTime.getTimestamp()
+}
+
+// GetTableItem returns the latest version of the table in the catalog
+// cache, or nil if it is not in the cache.
+func (e *Engine) GetTableItem(databaseId, tableId uint64) *cache.TableItem {
+	return e.catalog.GetTableById(databaseId, tableId)
+}
+
+func (e *Engine) notifyLogtailListeners(tl *logtail.TableLogtail) {
+	e.logtailListeners.RLock()
+	defer e.logtailListeners.RUnlock()
+	if len(e.logtailListeners.m) == 0 {
+		return
+	}
+	table := e.catalog.GetTableById(tl.Table.GetDbId(), tl.Table.GetTbId())
+	for _, l := range e.logtailListeners.m {
+		l(table, tl)
+	}
+}
+
 func (e *Engine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool) *pb.StatsInfo {
 	return e.globalStats.Get(ctx, key, sync)
 }
@@ -106,6 +106,33 @@ func isSortedKey(colDef *plan.ColDef) (isPK, isSorted bool) {
 	return
 }
 
+// hasBloomIndex returns true if a bloom filter index covers the non primary
+// key column, its objects may carry per-block column bloom filters.
+func hasBloomIndex(colDef *plan.ColDef, tableDef *plan.TableDef) bool {
+	for _, col := range catalog.BloomIndexColumns(tableDef.Indexes) {
+		if col == colDef.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// mayContainColumnKeys checks the column bloom filter of the block, it
+// returns true if the block has no bloom filter for the column.
+func mayContainColumnKeys(
+	bf objectio.BloomFilter, blkIdx int, seqNum uint16, check func(index.StaticFilter) (bool, error),
+) (bool, error) {
+	buf := bf.GetColumnBloomFilter(uint32(blkIdx), seqNum)
+	if buf == nil {
+		return true, nil
+	}
+	blkBfIdx := index.NewEmptyBinaryFuseFilter()
+	if err := index.DecodeBloomFilter(blkBfIdx, buf); err != nil {
+		return false, err
+	}
+	return check(blkBfIdx)
+}
+
 func getConstBytesFromExpr(exprs []*plan.Expr, colDef *plan.ColDef, proc *process.Process) ([][]byte, bool) {
 	vals := make([][]byte, len(exprs))
 	for idx := range exprs {
@@ -849,6 +876,7 @@ func CompileFilterExpr(
 			_ = vec.UnmarshalBinary(val)
 			colDef := getColDefByName(colExpr.Col.Name, tableDef)
 			isPK, isSorted := isSortedKey(colDef)
+			hasBF := !isPK && hasBloomIndex(colDef, tableDef)
 			if isSorted {
 				fastFilterOp = func(obj objectio.ObjectStats) (bool, error) {
 					if obj.ZMIsEmpty() {
@@ -857,7 +885,7 @@ func CompileFilterExpr(
 					return obj.SortKeyZoneMap().AnyIn(vec), nil
 				}
 			}
-			if isPK {
+			if isPK || hasBF {
 				loadOp = loadMetadataAndBFOpFactory(fs)
 			} else {
 				loadOp = loadMetadataOnlyOpFactory(fs)
@@ -889,6 +917,14 @@ func CompileFilterExpr(
 					if exist := blkBfIdx.MayContainsAny(vec, lowerBound, upperBound); !exist {
 						return false, false, nil
 					}
+				} else if hasBF {
+					exist, err := mayContainColumnKeys(bf, blkIdx, uint16(seqNum), func(f index.StaticFilter) (bool, error) {
+						lowerBound, upperBound := zm.SubVecIn(vec)
+						return f.MayContainsAny(vec, lowerBound, upperBound), nil
+					})
+					if err != nil || !exist {
+						return false, false, err
+					}
 				}
 				return false, true, nil
 			}
@@ -901,6 +937,7 @@ func CompileFilterExpr(
 			}
 			colDef := getColDefByName(colExpr.Col.Name, tableDef)
 			isPK, isSorted := isSortedKey(colDef)
+			hasBF := !isPK && hasBloomIndex(colDef, tableDef)
 			if isSorted {
 				fastFilterOp = func(obj objectio.ObjectStats) (bool, error) {
 					if obj.ZMIsEmpty() {
@@ -909,7 +946,7 @@ func CompileFilterExpr(
 					return obj.SortKeyZoneMap().ContainsKey(vals[0]), nil
 				}
 			}
-			if isPK {
+			if isPK || hasBF {
 				loadOp = loadMetadataAndBFOpFactory(fs)
 			} else {
 				loadOp = loadMetadataOnlyOpFactory(fs)
@@ -954,6 +991,13 @@ func CompileFilterExpr(
 					if err != nil || !exist {
 						return false, false, err
 					}
+				} else if hasBF {
+					exist, err := mayContainColumnKeys(bf, blkIdx, uint16(seqNum), func(f index.StaticFilter) (bool, error) {
+						return f.MayContainsKey(vals[0])
+					})
+					if err != nil || !exist {
+						return false, false, err
+					}
 				}
 				return false, true, nil
 			}
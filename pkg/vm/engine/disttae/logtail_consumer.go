@@ -1302,7 +1302,11 @@ func (e *Engine) consumeUpdateLogTail(
 	rp logtail.TableLogtail,
 	lazyLoad bool,
 	receiveAt time.Time) error {
-	return updatePartitionOfPush(ctx, e, &rp, lazyLoad, receiveAt)
+	if err := updatePartitionOfPush(ctx, e, &rp, lazyLoad, receiveAt); err != nil {
+		return err
+	}
+	e.notifyLogtailListeners(&rp)
+	return nil
 }
 
 // updatePartitionOfPush is the partition update method of log tail push model.
@@ -16,7 +16,9 @@ package disttae
 
 import (
 	"context"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -48,6 +50,10 @@ type CNMergeTask struct {
 	colattrs    []string     // no rowid column
 	sortkeyPos  int          // (composite) primary key, cluster by etc. -1 meas no sort key
 	sortkeyIsPK bool
+	bfColumns   []uint16  // columns with bloom filter indexes
+	lcColumns   []uint16  // low cardinality columns
+	ttlPos      int       // position of the TTL column, -1 means no TTL
+	ttlCutoff   time.Time // rows of the TTL column before it are expired
 
 	// targets
 	targets []logtailreplay.ObjectInfo
@@ -92,6 +98,18 @@ func NewCNMergeTask(
 		blkIters[i] = NewStatsBlkIter(&objInfo.ObjectStats, meta.MustDataMeta())
 	}
 
+	ttlPos := -1
+	var ttlCutoff time.Time
+	if ttl, ok := catalog.TableTTLFromDefs(tbl.tableDef.Defs); ok {
+		for i, attr := range attrs {
+			if attr == ttl.Column {
+				ttlPos = i
+				ttlCutoff = ttl.Cutoff(time.Unix(0, snapshot.Physical()))
+				break
+			}
+		}
+	}
+
 	return &CNMergeTask{
 		ctx:         ctx,
 		host:        tbl,
@@ -104,6 +122,10 @@ func NewCNMergeTask(
 		colattrs:    attrs,
 		sortkeyPos:  sortkeyPos,
 		sortkeyIsPK: sortkeyIsPK,
+		bfColumns:   catalog.BloomIndexColumnPositions(tbl.tableDef),
+		lcColumns:   catalog.LowCardinalityColumnPositions(tbl.tableDef),
+		ttlPos:      ttlPos,
+		ttlCutoff:   ttlCutoff,
 		targets:     targets,
 		fs:          fs,
 		blkCnts:     blkCnts,
@@ -200,7 +222,10 @@ func (t *CNMergeTask) PrepareCommitEntry() *api.MergeCommitEntry {
 }
 
 func (t *CNMergeTask) PrepareNewWriter() *blockio.BlockWriter {
-	return mergesort.GetNewWriter(t.fs, t.version, t.colseqnums, t.sortkeyPos, t.sortkeyIsPK)
+	writer := mergesort.GetNewWriter(t.fs, t.version, t.colseqnums, t.sortkeyPos, t.sortkeyIsPK)
+	writer.SetBloomFilterColumns(t.bfColumns)
+	writer.SetLowCardinalityColumns(t.lcColumns)
+	return writer
 }
 
 func (t *CNMergeTask) readAllData() ([]*batch.Batch, func(), []*nulls.Nulls, error) {
@@ -297,6 +322,10 @@ func (t *CNMergeTask) readblock(info *objectio.BlockInfo) (bat *batch.Batch, del
 		dels.Add(uint64(offset))
 	}
 	iter.Close()
+	// expired rows are dropped like deleted ones
+	if t.ttlPos >= 0 {
+		dels = catalog.ExpiredRows(bat.Vecs[t.ttlPos], t.ttlCutoff, dels)
+	}
 	if dels.Count() > 0 {
 		logutil.Infof("mergeblocks read block %v, %d deleted(%d from disk)", info.BlockID.ShortStringEx(), dels.Count(), deltalocDel)
 	}
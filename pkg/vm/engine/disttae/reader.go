@@ -31,7 +31,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/txn/trace"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
@@ -50,6 +52,12 @@ import (
 func (mixin *withFilterMixin) reset() {
 	mixin.filterState.evaluated = false
 	mixin.filterState.filter = nil
+	if mixin.lateFilterState.executor != nil {
+		mixin.lateFilterState.executor.Free()
+		mixin.lateFilterState.executor = nil
+	}
+	mixin.lateFilterState.evaluated = false
+	mixin.lateFilterState.filter = nil
 	mixin.columns.pkPos = -1
 	mixin.columns.rowidPos = -1
 	mixin.columns.indexOfFirstSortedColumn = -1
@@ -96,7 +104,13 @@ func (mixin *withFilterMixin) tryUpdateColumns(cols []string) {
 			mixin.columns.seqnums[i] = objectio.SEQNUM_ROWID
 			mixin.columns.colTypes[i] = objectio.RowidType
 		} else {
-			if plan2.GetSortOrderByName(mixin.tableDef, column) == 0 {
+			// the objects reclustered by a composite key are in the Z-order
+			// of the columns of the key, see the recluster merge policy, and
+			// merging them with other objects does not sort their rows again,
+			// none of the columns can be trusted as sorted.
+			if plan2.GetSortOrderByName(mixin.tableDef, column) == 0 &&
+				!(mixin.tableDef.ClusterBy != nil &&
+					util.JudgeIsCompositeClusterByColumn(mixin.tableDef.ClusterBy.Name)) {
 				mixin.columns.indexOfFirstSortedColumn = i
 			}
 			colIdx := mixin.tableDef.Name2ColIndex[column]
@@ -251,6 +265,115 @@ func (mixin *withFilterMixin) getNonCompositPKFilter(proc *process.Process, blkC
 	return searchFunc
 }
 
+// getLateFilter returns the filter to read a block late with: the filter
+// of the scan, given to Read if the planner picked late materialization
+// for it, evaluated on the columns it reads. It returns nil if there is no
+// such filter, or if it reads all the columns.
+func (mixin *withFilterMixin) getLateFilter(proc *process.Process, expr *plan.Expr) blockio.ReadFilter {
+	if mixin.lateFilterState.evaluated {
+		return mixin.lateFilterState.filter
+	}
+	mixin.lateFilterState.evaluated = true
+	if expr == nil {
+		return nil
+	}
+
+	// the filter is evaluated on a batch of its columns only
+	expr = plan2.DeepCopyExpr(expr)
+	positions := make(map[int32]int32)
+	if !mixin.remapLateFilterColumns(expr, positions) {
+		return nil
+	}
+	readCnt := len(mixin.columns.seqnums)
+	if mixin.columns.rowidPos != -1 {
+		readCnt--
+	}
+	if len(positions) == 0 || len(positions) >= readCnt {
+		return nil
+	}
+	executor, err := colexec.NewExpressionExecutor(proc, expr)
+	if err != nil {
+		return nil
+	}
+
+	seqnums := make([]uint16, len(positions))
+	colTypes := make([]types.Type, len(positions))
+	for pos, i := range positions {
+		seqnums[i] = mixin.columns.seqnums[pos]
+		colTypes[i] = mixin.columns.colTypes[pos]
+	}
+	bat := batch.NewWithSize(len(positions))
+	filter := func(vecs []*vector.Vector) []int32 {
+		copy(bat.Vecs, vecs)
+		bat.SetRowCount(vecs[0].Length())
+		mixin.sels = mixin.sels[:0]
+		vec, err := executor.Eval(proc, []*batch.Batch{bat})
+		if err != nil {
+			// all the rows are read, for the filter of the scan to report
+			// the error
+			for i := 0; i < bat.RowCount(); i++ {
+				mixin.sels = append(mixin.sels, int32(i))
+			}
+			return mixin.sels
+		}
+		if vec.IsConst() {
+			if !vec.IsConstNull() && vector.MustFixedCol[bool](vec)[0] {
+				for i := 0; i < bat.RowCount(); i++ {
+					mixin.sels = append(mixin.sels, int32(i))
+				}
+			}
+			return mixin.sels
+		}
+		nsp := vec.GetNulls()
+		for i, b := range vector.MustFixedCol[bool](vec) {
+			if b && !nsp.Contains(uint64(i)) {
+				mixin.sels = append(mixin.sels, int32(i))
+			}
+		}
+		return mixin.sels
+	}
+
+	mixin.lateFilterState.filter = filter
+	mixin.lateFilterState.seqnums = seqnums
+	mixin.lateFilterState.colTypes = colTypes
+	mixin.lateFilterState.executor = executor
+	return filter
+}
+
+// remapLateFilterColumns points the columns of expr, positions in the
+// columns read, to their positions in the columns of the filter, recorded
+// in positions. It returns false if expr reads the rowid, which is built
+// rather than read, or a column which is not read.
+func (mixin *withFilterMixin) remapLateFilterColumns(expr *plan.Expr, positions map[int32]int32) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		pos := e.Col.ColPos
+		if pos < 0 || int(pos) >= len(mixin.columns.seqnums) || int(pos) == mixin.columns.rowidPos {
+			return false
+		}
+		i, ok := positions[pos]
+		if !ok {
+			i = int32(len(positions))
+			positions[pos] = i
+		}
+		e.Col.RelPos = 0
+		e.Col.ColPos = i
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if !mixin.remapLateFilterColumns(arg, positions) {
+				return false
+			}
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			if !mixin.remapLateFilterColumns(item, positions) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // -----------------------------------------------------------------
 // ------------------------ emptyReader ----------------------------
 // -----------------------------------------------------------------
@@ -366,7 +489,14 @@ func (r *blockReader) getBlockZMs() {
 			objDataMeta = objMeta.MustDataMeta()
 		}
 		blkMeta := objDataMeta.GetBlockMeta(uint32(location.ID()))
-		r.blockZMS[i] = blkMeta.ColumnMeta(uint16(orderByColIDX)).ZoneMap()
+		colMeta := blkMeta.ColumnMeta(uint16(orderByColIDX))
+		if r.nullsFirst && colMeta.NullCnt() > 0 {
+			// the nulls of the block beat any top value, the block is read
+			// first as if it had no zonemap
+			r.blockZMS[i] = nil
+			continue
+		}
+		r.blockZMS[i] = colMeta.ZoneMap()
 	}
 }
 
@@ -409,6 +539,15 @@ func (r *blockReader) sortBlockList() {
 	}
 }
 
+// skipBlocksByZM stops the ordered scan once the top value beats the bound
+// of the next block: the blocks are sorted by the bound of their zonemap,
+// so it beats the ones of all the blocks after it as well.
+func (r *blockReader) skipBlocksByZM() {
+	if len(r.blks) > 0 && !r.needReadBlkByZM(0) {
+		r.deleteFirstNBlocks(len(r.blks))
+	}
+}
+
 func (r *blockReader) deleteFirstNBlocks(n int) {
 	r.blks = r.blks[n:]
 	if len(r.OrderBy) > 0 {
@@ -419,7 +558,7 @@ func (r *blockReader) deleteFirstNBlocks(n int) {
 func (r *blockReader) Read(
 	ctx context.Context,
 	cols []string,
-	_ *plan.Expr,
+	expr *plan.Expr,
 	mp *mpool.MPool,
 	vp engine.VectorPool,
 ) (bat *batch.Batch, err error) {
@@ -431,19 +570,20 @@ func (r *blockReader) Read(
 	// for ordered scan, sort blocklist by zonemap info, and then filter by zonemap
 	if len(r.OrderBy) > 0 {
 		if !r.sorted {
-			r.desc = r.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0
+			flag := r.OrderBy[0].Flag
+			r.desc = flag&plan.OrderBySpec_DESC != 0
+			if flag&plan.OrderBySpec_NULLS_FIRST != 0 {
+				r.nullsFirst = true
+			} else if flag&plan.OrderBySpec_NULLS_LAST != 0 {
+				r.nullsFirst = false
+			} else {
+				r.nullsFirst = !r.desc
+			}
 			r.getBlockZMs()
 			r.sortBlockList()
 			r.sorted = true
 		}
-		i := 0
-		for i < len(r.blks) {
-			if r.needReadBlkByZM(i) {
-				break
-			}
-			i++
-		}
-		r.deleteFirstNBlocks(i)
+		r.skipBlocksByZM()
 	}
 	// if the block list is empty, return nil
 	if len(r.blks) == 0 {
@@ -472,12 +612,18 @@ func (r *blockReader) Read(
 		return nil, nil
 	}
 
+	// the search of the sorted primary key beats late materialization
+	lateFilter := r.getLateFilter(r.proc, expr)
+	late := lateFilter != nil && (filter == nil || !blockInfo.Sorted)
+
 	if !r.dontPrefetch {
 		//prefetch some objects
 		for len(r.steps) > 0 && r.steps[0] == r.currentStep {
 			prefetchFile := r.scanType == SMALL || r.scanType == LARGE
 			if filter != nil && blockInfo.Sorted {
 				err = blockio.BlockPrefetch(r.filterState.seqnums, r.fs, [][]*objectio.BlockInfo{r.infos[0]}, prefetchFile)
+			} else if late {
+				err = blockio.BlockPrefetch(r.lateFilterState.seqnums, r.fs, [][]*objectio.BlockInfo{r.infos[0]}, prefetchFile)
 			} else {
 				err = blockio.BlockPrefetch(r.columns.seqnums, r.fs, [][]*objectio.BlockInfo{r.infos[0]}, prefetchFile)
 			}
@@ -490,7 +636,7 @@ func (r *blockReader) Read(
 	}
 
 	statsCtx, numRead, numHit := r.ctx, int64(0), int64(0)
-	if filter != nil {
+	if filter != nil || late {
 		// try to store the blkReadStats CounterSet into ctx, so that
 		// it can record the mem cache hit stats when call MemCache.Read() later soon.
 		statsCtx, numRead, numHit = r.prepareGatherStats()
@@ -501,18 +647,32 @@ func (r *blockReader) Read(
 	if r.scanType == LARGE || r.scanType == NORMAL {
 		policy = fileservice.SkipMemoryCacheWrites
 	}
-	bat, err = blockio.BlockRead(
-		statsCtx, blockInfo, r.buffer, r.columns.seqnums, r.columns.colTypes, r.ts,
-		r.filterState.seqnums,
-		r.filterState.colTypes,
-		filter,
-		r.fs, mp, vp, policy,
-	)
+	if r.scanType == LARGE {
+		// let the disk cache admit by its scan admission
+		policy |= fileservice.LargeScan
+	}
+	if late {
+		bat, err = blockio.BlockReadLate(
+			statsCtx, blockInfo, r.buffer, r.columns.seqnums, r.columns.colTypes, r.ts,
+			r.lateFilterState.seqnums,
+			r.lateFilterState.colTypes,
+			lateFilter,
+			r.fs, mp, vp, policy,
+		)
+	} else {
+		bat, err = blockio.BlockRead(
+			statsCtx, blockInfo, r.buffer, r.columns.seqnums, r.columns.colTypes, r.ts,
+			r.filterState.seqnums,
+			r.filterState.colTypes,
+			filter,
+			r.fs, mp, vp, policy,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if filter != nil {
+	if filter != nil || late {
 		// we collect mem cache hit related statistics info for blk read here
 		r.gatherStats(numRead, numHit)
 	}
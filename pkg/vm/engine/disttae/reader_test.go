@@ -19,8 +19,11 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +58,39 @@ func TestGatherStats(t *testing.T) {
 	require.Equal(t, hit, hitNum)
 	require.Equal(t, total, readNum)
 }
+
+func TestSkipBlocksByZM(t *testing.T) {
+	zm := func(min, max int64) index.ZM {
+		zm := index.NewZM(types.T_int64, 0)
+		index.UpdateZMAny(zm, min)
+		index.UpdateZMAny(zm, max)
+		return zm
+	}
+	r := new(blockReader)
+	r.desc = true
+	r.blks = make([]*objectio.BlockInfo, 4)
+	for i := range r.blks {
+		r.blks[i] = new(objectio.BlockInfo)
+	}
+	// the third block holds nulls which come first
+	r.blockZMS = []index.ZM{zm(0, 10), zm(20, 30), nil, zm(10, 20)}
+	r.OrderBy = make([]*plan.OrderBySpec, 1)
+	r.sortBlockList()
+	require.False(t, r.blockZMS[0].IsInited())
+	require.Equal(t, int64(30), r.blockZMS[1].GetMax())
+	require.Equal(t, int64(20), r.blockZMS[2].GetMax())
+	require.Equal(t, int64(10), r.blockZMS[3].GetMax())
+
+	// no top value yet
+	r.skipBlocksByZM()
+	require.Equal(t, 4, len(r.blks))
+	r.deleteFirstNBlocks(1)
+
+	// the top value beats the first block, and the ones after it
+	r.SetFilterZM(zm(25, 25))
+	r.skipBlocksByZM()
+	require.Equal(t, 3, len(r.blks))
+	r.deleteFirstNBlocks(1)
+	r.skipBlocksByZM()
+	require.Equal(t, 0, len(r.blks))
+}
@@ -529,6 +529,12 @@ func getMinMaxValueByFloat64(typ types.Type, buf []byte) float64 {
 	}
 }
 
+// updateHistogram adds the non-null rows of an object to the histogram of a
+// column, assuming they are spread evenly between the bounds of its zonemap.
+func updateHistogram(hb *plan2.HistogramBuilder, typ types.Type, zm objectio.ZoneMap, rows int64) {
+	hb.Update(plan2.HistogramValue(typ.Oid, zm.GetMinBuf()), plan2.HistogramValue(typ.Oid, zm.GetMaxBuf()), rows)
+}
+
 // get ndv, minval , maxval, datatype from zonemap. Retrieve all columns except for rowid, return accurate number of objects
 func updateInfoFromZoneMap(ctx context.Context, req *updateStatsRequest, info *plan2.InfoFromZoneMap) error {
 	start := time.Now()
@@ -566,6 +572,13 @@ func updateInfoFromZoneMap(ctx context.Context, req *updateStatsRequest, info *p
 				info.ColumnNDVs[idx] = float64(objColMeta.Ndv())
 				info.ColumnSize[idx] = int64(meta.BlockHeader().ZoneMapArea().Length() +
 					meta.BlockHeader().BFExtent().Length() + objColMeta.Location().Length())
+				if plan2.IsHistogramType(info.DataTypes[idx].Oid) {
+					info.Histograms[idx] = plan2.NewHistogramBuilder()
+					if info.ColumnZMs[idx].IsInited() {
+						updateHistogram(info.Histograms[idx], info.DataTypes[idx], info.ColumnZMs[idx],
+							int64(meta.BlockHeader().Rows())-int64(objColMeta.NullCnt()))
+					}
+				}
 				if info.ColumnNDVs[idx] > 100 || info.ColumnNDVs[idx] > 0.1*float64(meta.BlockHeader().Rows()) {
 					switch info.DataTypes[idx].Oid {
 					case types.T_int64, types.T_int32, types.T_int16, types.T_uint64, types.T_uint32, types.T_uint16, types.T_time, types.T_timestamp, types.T_date, types.T_datetime:
@@ -595,6 +608,10 @@ func updateInfoFromZoneMap(ctx context.Context, req *updateStatsRequest, info *p
 				index.UpdateZM(info.ColumnZMs[idx], zm.GetMinBuf())
 				info.ColumnNDVs[idx] += float64(objColMeta.Ndv())
 				info.ColumnSize[idx] += int64(objColMeta.Location().Length())
+				if info.Histograms[idx] != nil {
+					updateHistogram(info.Histograms[idx], info.DataTypes[idx], zm,
+						int64(meta.BlockHeader().Rows())-int64(objColMeta.NullCnt()))
+				}
 				if info.ShuffleRanges[idx] != nil {
 					switch info.DataTypes[idx].Oid {
 					case types.T_int64, types.T_int32, types.T_int16, types.T_uint64, types.T_uint32, types.T_uint16, types.T_time, types.T_timestamp, types.T_date, types.T_datetime:
@@ -35,10 +35,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	qclient "github.com/matrixorigin/matrixone/pkg/queryservice/client"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/trace"
 	"github.com/matrixorigin/matrixone/pkg/udf"
@@ -145,8 +147,23 @@ type Engine struct {
 	// globalStats is the global stats information, which is updated
 	// from logtail updates.
 	globalStats *GlobalStats
+
+	// logtailListeners are notified after the incremental logtail of
+	// a table is applied.
+	logtailListeners struct {
+		sync.RWMutex
+		seq uint64
+		m   map[uint64]LogtailListener
+	}
 }
 
+// LogtailListener is notified with the incremental logtail of a table after
+// it is applied to the partition state. The table is nil if it is not in the
+// catalog cache. The logtail must not be retained or modified after the
+// listener returns. The listener runs on the goroutine which consumes the
+// logtail, so it must not block or do heavy work.
+type LogtailListener func(table *cache.TableItem, tail *logtail.TableLogtail)
+
 // Transaction represents a transaction
 type Transaction struct {
 	sync.Mutex
@@ -746,6 +763,16 @@ type withFilterMixin struct {
 		hasNull  bool
 	}
 
+	// late materialization: the filter of the scan, evaluated on the
+	// filter columns before the other columns are read
+	lateFilterState struct {
+		evaluated bool
+		filter    blockio.ReadFilter
+		seqnums   []uint16 // seqnums of the columns in the filter
+		colTypes  []types.Type
+		executor  colexec.ExpressionExecutor
+	}
+
 	sels []int32
 }
 
@@ -770,11 +797,12 @@ type blockReader struct {
 	buffer []int64
 
 	// for ordered scan
-	desc     bool
-	blockZMS []index.ZM
-	OrderBy  []*plan.OrderBySpec
-	sorted   bool // blks need to be sorted by zonemap
-	filterZM objectio.ZoneMap
+	desc       bool
+	nullsFirst bool // the nulls of the order by column come first
+	blockZMS   []index.ZM
+	OrderBy    []*plan.OrderBySpec
+	sorted     bool // blks need to be sorted by zonemap
+	filterZM   objectio.ZoneMap
 }
 
 type blockMergeReader struct {
@@ -1585,11 +1585,112 @@ func (m *TruncateRelationResp) GetID() ID {
 	return 0
 }
 
+func (m *RenameRelationReq) Reset()         { *m = RenameRelationReq{} }
+func (m *RenameRelationReq) String() string { return proto.CompactTextString(m) }
+func (*RenameRelationReq) ProtoMessage()    {}
+func (*RenameRelationReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{36}
+}
+func (m *RenameRelationReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameRelationReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameRelationReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameRelationReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameRelationReq.Merge(m, src)
+}
+func (m *RenameRelationReq) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RenameRelationReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameRelationReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameRelationReq proto.InternalMessageInfo
+
+func (m *RenameRelationReq) GetID() ID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *RenameRelationReq) GetDatabaseName() string {
+	if m != nil {
+		return m.DatabaseName
+	}
+	return ""
+}
+
+func (m *RenameRelationReq) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *RenameRelationReq) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *RenameRelationResp) Reset()         { *m = RenameRelationResp{} }
+func (m *RenameRelationResp) String() string { return proto.CompactTextString(m) }
+func (*RenameRelationResp) ProtoMessage()    {}
+func (*RenameRelationResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{37}
+}
+func (m *RenameRelationResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameRelationResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameRelationResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameRelationResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameRelationResp.Merge(m, src)
+}
+func (m *RenameRelationResp) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RenameRelationResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameRelationResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameRelationResp proto.InternalMessageInfo
+
+func (m *RenameRelationResp) GetID() ID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
 func (m *AddTableDefReq) Reset()         { *m = AddTableDefReq{} }
 func (m *AddTableDefReq) String() string { return proto.CompactTextString(m) }
 func (*AddTableDefReq) ProtoMessage()    {}
 func (*AddTableDefReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{36}
+	return fileDescriptor_1b4a5877375e491e, []int{38}
 }
 func (m *AddTableDefReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,7 +1744,7 @@ func (m *AddTableDefResp) Reset()         { *m = AddTableDefResp{} }
 func (m *AddTableDefResp) String() string { return proto.CompactTextString(m) }
 func (*AddTableDefResp) ProtoMessage()    {}
 func (*AddTableDefResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{37}
+	return fileDescriptor_1b4a5877375e491e, []int{39}
 }
 func (m *AddTableDefResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1676,7 +1777,7 @@ func (m *DelTableDefReq) Reset()         { *m = DelTableDefReq{} }
 func (m *DelTableDefReq) String() string { return proto.CompactTextString(m) }
 func (*DelTableDefReq) ProtoMessage()    {}
 func (*DelTableDefReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{38}
+	return fileDescriptor_1b4a5877375e491e, []int{40}
 }
 func (m *DelTableDefReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +1831,7 @@ func (m *DelTableDefResp) Reset()         { *m = DelTableDefResp{} }
 func (m *DelTableDefResp) String() string { return proto.CompactTextString(m) }
 func (*DelTableDefResp) ProtoMessage()    {}
 func (*DelTableDefResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{39}
+	return fileDescriptor_1b4a5877375e491e, []int{41}
 }
 func (m *DelTableDefResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1763,7 +1864,7 @@ func (m *UpdateResp) Reset()         { *m = UpdateResp{} }
 func (m *UpdateResp) String() string { return proto.CompactTextString(m) }
 func (*UpdateResp) ProtoMessage()    {}
 func (*UpdateResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{40}
+	return fileDescriptor_1b4a5877375e491e, []int{42}
 }
 func (m *UpdateResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1829,6 +1930,8 @@ func init() {
 	proto.RegisterType((*GetTableColumnsResp)(nil), "memoryengine.GetTableColumnsResp")
 	proto.RegisterType((*TruncateRelationReq)(nil), "memoryengine.TruncateRelationReq")
 	proto.RegisterType((*TruncateRelationResp)(nil), "memoryengine.TruncateRelationResp")
+	proto.RegisterType((*RenameRelationReq)(nil), "memoryengine.RenameRelationReq")
+	proto.RegisterType((*RenameRelationResp)(nil), "memoryengine.RenameRelationResp")
 	proto.RegisterType((*AddTableDefReq)(nil), "memoryengine.AddTableDefReq")
 	proto.RegisterType((*AddTableDefResp)(nil), "memoryengine.AddTableDefResp")
 	proto.RegisterType((*DelTableDefReq)(nil), "memoryengine.DelTableDefReq")
@@ -1839,70 +1942,72 @@ func init() {
 func init() { proto.RegisterFile("operations.proto", fileDescriptor_1b4a5877375e491e) }
 
 var fileDescriptor_1b4a5877375e491e = []byte{
-	// 993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x7a, 0x37, 0x0e, 0x7e, 0x75, 0x1a, 0x7b, 0x5a, 0x15, 0x2b, 0x54, 0x6b, 0x2b, 0x20,
-	0x94, 0x0b, 0xb6, 0x94, 0x22, 0x41, 0x03, 0xb4, 0x8a, 0xb3, 0xa8, 0x58, 0x95, 0xda, 0x6a, 0x9b,
-	0xaa, 0xe2, 0x54, 0xd6, 0xde, 0xb1, 0x59, 0x75, 0xff, 0xb1, 0x33, 0x26, 0xf5, 0x17, 0xe0, 0xc4,
-	0x81, 0x4f, 0x80, 0xe0, 0xdb, 0x54, 0xe2, 0x92, 0x23, 0xea, 0xc1, 0x82, 0xe4, 0x0b, 0x70, 0xce,
-	0x09, 0xed, 0xdb, 0xd9, 0xf5, 0xac, 0x97, 0x24, 0xb6, 0x68, 0x38, 0x70, 0xdb, 0x79, 0x33, 0xbf,
-	0x37, 0xbf, 0xf7, 0x7b, 0x7f, 0xc6, 0x86, 0x7a, 0x10, 0xd2, 0xc8, 0xe2, 0x4e, 0xe0, 0xb3, 0x4e,
-	0x18, 0x05, 0x3c, 0x20, 0x35, 0x8f, 0x7a, 0x41, 0x34, 0xa5, 0xfe, 0xd8, 0xf1, 0xe9, 0xd6, 0x47,
-	0x63, 0x87, 0x7f, 0x3b, 0x19, 0x74, 0x86, 0x81, 0xd7, 0x1d, 0x07, 0xe3, 0xa0, 0x8b, 0x87, 0x06,
-	0x93, 0x11, 0xae, 0x70, 0x81, 0x5f, 0x09, 0x78, 0x0b, 0x42, 0xd7, 0xf2, 0x93, 0xef, 0xed, 0x6f,
-	0x00, 0xf6, 0x87, 0x43, 0xca, 0x58, 0xdf, 0x1f, 0x05, 0xe4, 0x36, 0x54, 0xf7, 0x87, 0xc3, 0x60,
-	0xe2, 0xf3, 0xbe, 0xd1, 0x54, 0xda, 0xca, 0xce, 0x86, 0x39, 0x37, 0x90, 0x5b, 0x50, 0x79, 0xc6,
-	0x68, 0xd4, 0x37, 0x9a, 0x65, 0xdc, 0x12, 0xab, 0xd8, 0x6e, 0x06, 0x2e, 0xed, 0x1b, 0x4d, 0x35,
-	0xb1, 0x27, 0xab, 0x3d, 0xed, 0xaf, 0x5f, 0x5b, 0xa5, 0xed, 0x97, 0xb0, 0xf9, 0x38, 0xa4, 0xbe,
-	0x61, 0x71, 0x6b, 0x60, 0x31, 0x6a, 0xd2, 0xef, 0xc8, 0x3d, 0xf9, 0x52, 0xbc, 0xe7, 0xda, 0x6e,
-	0xb3, 0x23, 0x87, 0xd4, 0x99, 0xef, 0xf7, 0xb4, 0xd7, 0xb3, 0x56, 0xc9, 0x94, 0x69, 0x12, 0xd0,
-	0x1e, 0x59, 0x1e, 0x45, 0x1a, 0x55, 0x13, 0xbf, 0xc5, 0x65, 0x06, 0xd4, 0xf3, 0x97, 0xb1, 0x90,
-	0xdc, 0x82, 0xb2, 0x88, 0x46, 0xeb, 0x55, 0xce, 0x66, 0xad, 0x72, 0xdf, 0x30, 0xcb, 0x7d, 0x23,
-	0xf6, 0xe2, 0x4b, 0x5e, 0xfc, 0xb9, 0x97, 0x1f, 0x14, 0x68, 0x1c, 0x44, 0xd4, 0xe2, 0x54, 0x66,
-	0x7d, 0x9e, 0x9f, 0xfb, 0x70, 0xcd, 0x42, 0x6e, 0x2f, 0x9c, 0x38, 0x9c, 0xf2, 0x72, 0xe1, 0x58,
-	0xb9, 0x70, 0x90, 0x88, 0x5a, 0x20, 0xb2, 0x0b, 0x64, 0x91, 0xc7, 0xf9, 0x01, 0x09, 0xcc, 0x73,
-	0xd8, 0x7c, 0x40, 0x79, 0x0a, 0x60, 0x6f, 0x41, 0x6f, 0xe1, 0xb8, 0x03, 0xf5, 0xbc, 0x63, 0x16,
-	0x92, 0x9b, 0xb0, 0x16, 0xab, 0xcf, 0x9a, 0x4a, 0x5b, 0xdd, 0xa9, 0x9a, 0xc9, 0x42, 0x9c, 0xf7,
-	0xa0, 0x61, 0x50, 0x97, 0xe6, 0x45, 0xbc, 0xba, 0xd4, 0xef, 0x02, 0x59, 0xbc, 0xee, 0x52, 0xad,
-	0x8e, 0x92, 0xda, 0x34, 0xa9, 0x8b, 0xdd, 0x15, 0x13, 0xfc, 0x10, 0x20, 0x75, 0x50, 0x00, 0x4a,
-	0x3b, 0x64, 0x1b, 0x6a, 0xe9, 0x4a, 0x22, 0x94, 0xb3, 0x65, 0x64, 0xd5, 0x02, 0xd9, 0x9f, 0x95,
-	0xa4, 0x50, 0xe7, 0x37, 0x5f, 0x50, 0xa8, 0x1f, 0x80, 0xc6, 0xa7, 0x61, 0x72, 0xc5, 0x46, 0xaf,
-	0x7e, 0x36, 0x6b, 0xd5, 0x52, 0xdc, 0xe1, 0x34, 0xa4, 0x26, 0xee, 0x16, 0x08, 0xa9, 0xff, 0x40,
-	0x68, 0x1b, 0x32, 0x24, 0x9e, 0xd1, 0x92, 0x33, 0xb2, 0x4d, 0x10, 0xfc, 0xb1, 0x9c, 0xb6, 0x80,
-	0x2c, 0xce, 0x79, 0x0c, 0xf3, 0xa2, 0x95, 0xcf, 0x15, 0xed, 0x7d, 0xd8, 0xb0, 0xc5, 0xea, 0x85,
-	0x54, 0xf2, 0x35, 0x7b, 0x41, 0x35, 0x7f, 0x4e, 0x0e, 0xbf, 0x33, 0x09, 0xd6, 0x2e, 0x94, 0xc0,
-	0x04, 0xcd, 0xa0, 0x23, 0xd6, 0xac, 0xb4, 0xd5, 0x9d, 0x5a, 0xef, 0xde, 0x9b, 0x59, 0x6b, 0x4f,
-	0x9a, 0x8c, 0x9e, 0xc5, 0x23, 0xe7, 0x55, 0x10, 0x39, 0x63, 0xc7, 0x4f, 0x17, 0x3e, 0xed, 0x86,
-	0x2f, 0xc7, 0xdd, 0xef, 0xbd, 0xae, 0x28, 0xbe, 0x43, 0x6b, 0xe0, 0x52, 0x83, 0x8e, 0x9e, 0xf4,
-	0x4c, 0xf4, 0xb5, 0xd8, 0x88, 0xcb, 0x24, 0x4c, 0x60, 0xee, 0x63, 0x23, 0xa6, 0x00, 0xb6, 0x42,
-	0x71, 0xe5, 0x1a, 0x4e, 0x72, 0x70, 0x49, 0xc3, 0x4d, 0xd3, 0x86, 0xfb, 0xef, 0xeb, 0x39, 0x6b,
-	0xbe, 0x15, 0xf4, 0xb9, 0x8b, 0xfa, 0xa4, 0x82, 0xa3, 0x3e, 0x6d, 0x58, 0xc7, 0x75, 0x01, 0x95,
-	0x9a, 0x05, 0xd4, 0x45, 0x65, 0x24, 0x28, 0x0b, 0xb3, 0xe4, 0x2b, 0x6f, 0x3d, 0xf9, 0x0d, 0xa8,
-	0x3e, 0x8f, 0x1c, 0x8e, 0x03, 0x45, 0x98, 0xbe, 0x86, 0xcd, 0x47, 0xf4, 0x28, 0x21, 0xc5, 0x69,
-	0xb4, 0x14, 0x77, 0xa2, 0x83, 0xf6, 0xe5, 0xab, 0x30, 0x12, 0x2f, 0x04, 0x74, 0xf0, 0x19, 0x8e,
-	0x2d, 0x26, 0xda, 0x85, 0xeb, 0x4f, 0xa1, 0x9e, 0x77, 0xcd, 0x42, 0xa2, 0x43, 0x25, 0xfe, 0x2e,
-	0xb8, 0x16, 0x56, 0x81, 0x7c, 0x08, 0xeb, 0x26, 0xb5, 0xec, 0x98, 0xcc, 0x25, 0x00, 0xb2, 0x05,
-	0xef, 0x1c, 0x04, 0x6e, 0x52, 0x49, 0x65, 0xac, 0xa4, 0x6c, 0x2d, 0x9c, 0x11, 0x80, 0x34, 0xa3,
-	0x59, 0xd4, 0x9f, 0x41, 0xe3, 0x01, 0xe5, 0x4f, 0x22, 0xc7, 0xb3, 0xa2, 0xe9, 0x43, 0x3a, 0x5d,
-	0x29, 0x67, 0x01, 0x90, 0x45, 0x30, 0x0b, 0xc9, 0x53, 0x58, 0xdb, 0xe7, 0x3c, 0x4a, 0xd3, 0xf6,
-	0xc5, 0x9b, 0x59, 0xeb, 0xee, 0xaa, 0x69, 0x8b, 0x1d, 0x38, 0x83, 0x09, 0xa7, 0x66, 0xe2, 0x2b,
-	0xab, 0xaf, 0xc6, 0x81, 0x1b, 0x30, 0x9a, 0xcb, 0xd2, 0x72, 0x4a, 0x6e, 0x01, 0x59, 0x84, 0x66,
-	0x22, 0xec, 0x61, 0xed, 0x7d, 0xe5, 0xd8, 0x36, 0xf5, 0x57, 0xd5, 0xc0, 0x47, 0x01, 0x65, 0xec,
-	0xd5, 0x4a, 0xf0, 0x09, 0x6c, 0x20, 0x81, 0xa7, 0xdc, 0xe2, 0x2b, 0x11, 0xbd, 0x03, 0xd7, 0x65,
-	0x20, 0x0b, 0xc9, 0x7b, 0xa0, 0x99, 0xc1, 0x11, 0x43, 0x98, 0xda, 0x5b, 0x3f, 0x9b, 0xb5, 0x54,
-	0xc7, 0xe7, 0x26, 0x1a, 0x05, 0xe8, 0x73, 0xcc, 0x30, 0xe2, 0x0e, 0x02, 0x77, 0xe2, 0xf9, 0x2b,
-	0x5d, 0x19, 0xc2, 0x8d, 0x02, 0xfa, 0x6a, 0xd5, 0xf9, 0x4d, 0x81, 0x1b, 0x87, 0xd1, 0xc4, 0x1f,
-	0x5a, 0x85, 0x91, 0x99, 0x75, 0x60, 0x61, 0x64, 0xce, 0x77, 0xe2, 0x73, 0x8f, 0x5d, 0x3b, 0x3d,
-	0xb7, 0xf0, 0xea, 0xcd, 0x77, 0x16, 0x46, 0xb0, 0xba, 0xf4, 0x08, 0xd6, 0x2e, 0x18, 0xc1, 0x6b,
-	0x85, 0x11, 0xfc, 0x31, 0xdc, 0x2c, 0x06, 0x73, 0xe9, 0x10, 0x3e, 0x56, 0xe0, 0xfa, 0xbe, 0x6d,
-	0xa7, 0x93, 0x6f, 0xb9, 0x41, 0xb6, 0xcc, 0x5b, 0x71, 0x1b, 0xaa, 0x78, 0x5c, 0x7a, 0x30, 0xe6,
-	0x06, 0x72, 0x08, 0xaa, 0x41, 0x47, 0x18, 0x61, 0xad, 0xd7, 0x8b, 0x7f, 0xe5, 0xfd, 0xcb, 0x79,
-	0x1d, 0xbb, 0x13, 0x21, 0xbd, 0x0b, 0x9b, 0xb9, 0x88, 0xb2, 0xce, 0x8d, 0x63, 0x35, 0xa8, 0xfb,
-	0x3f, 0x8b, 0x35, 0x17, 0x51, 0x16, 0x2b, 0x01, 0x78, 0x16, 0xda, 0x96, 0x3c, 0xbe, 0x7b, 0xed,
-	0xe3, 0x3f, 0xf5, 0xd2, 0xeb, 0x13, 0x5d, 0x39, 0x3e, 0xd1, 0x95, 0x3f, 0x4e, 0xf4, 0xd2, 0x4f,
-	0xa7, 0x7a, 0xe9, 0x97, 0x53, 0x5d, 0x39, 0x3e, 0xd5, 0x4b, 0xbf, 0x9f, 0xea, 0xa5, 0x41, 0x05,
-	0xff, 0x14, 0xde, 0xf9, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6a, 0x97, 0xdf, 0x71, 0x0e, 0x00,
-	0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x16, 0x45, 0x5a, 0xfe, 0x69, 0x22, 0xc7, 0xd2, 0x26, 0xc8, 0x4f, 0x70, 0x03, 0x4a, 0x70,
+	0x8b, 0xc2, 0x97, 0x4a, 0x80, 0x53, 0xa0, 0x8d, 0xdb, 0x26, 0x30, 0xcd, 0x22, 0x15, 0x02, 0xd8,
+	0x01, 0xe3, 0x20, 0xe8, 0x29, 0xa5, 0xc4, 0x95, 0x4a, 0x84, 0xff, 0xca, 0x5d, 0xd5, 0xd1, 0x0b,
+	0x14, 0x28, 0xd0, 0x43, 0x9f, 0xa0, 0x68, 0xdf, 0x26, 0x40, 0x2f, 0x3e, 0x16, 0x39, 0x08, 0xad,
+	0xfd, 0x02, 0x3d, 0xfb, 0x54, 0x70, 0xb9, 0xa4, 0x96, 0x64, 0x6d, 0x51, 0x68, 0xdc, 0x43, 0x6f,
+	0x9c, 0xd9, 0xfd, 0x66, 0xbf, 0xf9, 0x76, 0x76, 0x46, 0x82, 0xa6, 0x1f, 0xe0, 0xd0, 0xa4, 0xb6,
+	0xef, 0x91, 0x5e, 0x10, 0xfa, 0xd4, 0x47, 0x0d, 0x17, 0xbb, 0x7e, 0x38, 0xc3, 0xde, 0xc4, 0xf6,
+	0xf0, 0xd6, 0x07, 0x13, 0x9b, 0x7e, 0x3d, 0x1d, 0xf6, 0x46, 0xbe, 0xdb, 0x9f, 0xf8, 0x13, 0xbf,
+	0xcf, 0x36, 0x0d, 0xa7, 0x63, 0x66, 0x31, 0x83, 0x7d, 0xc5, 0xe0, 0x2d, 0x08, 0x1c, 0xd3, 0x8b,
+	0xbf, 0xb7, 0xbf, 0x02, 0xd8, 0x1f, 0x8d, 0x30, 0x21, 0x03, 0x6f, 0xec, 0xa3, 0xbb, 0x50, 0xdf,
+	0x1f, 0x8d, 0xfc, 0xa9, 0x47, 0x07, 0x7a, 0x5b, 0xea, 0x4a, 0x3b, 0x1b, 0xc6, 0xc2, 0x81, 0xee,
+	0x40, 0xed, 0x19, 0xc1, 0xe1, 0x40, 0x6f, 0x57, 0xd9, 0x12, 0xb7, 0x22, 0xbf, 0xe1, 0x3b, 0x78,
+	0xa0, 0xb7, 0xe5, 0xd8, 0x1f, 0x5b, 0x7b, 0xca, 0x9f, 0xbf, 0x74, 0x2a, 0xdb, 0x2f, 0x61, 0xf3,
+	0x28, 0xc0, 0x9e, 0x6e, 0x52, 0x73, 0x68, 0x12, 0x6c, 0xe0, 0x6f, 0xd0, 0x03, 0xf1, 0x50, 0x76,
+	0xce, 0x8d, 0xdd, 0x76, 0x4f, 0x4c, 0xa9, 0xb7, 0x58, 0xd7, 0x94, 0xd7, 0xf3, 0x4e, 0xc5, 0x10,
+	0x69, 0x22, 0x50, 0x0e, 0x4d, 0x17, 0x33, 0x1a, 0x75, 0x83, 0x7d, 0xf3, 0xc3, 0x74, 0x68, 0x66,
+	0x0f, 0x23, 0x01, 0xba, 0x03, 0x55, 0x9e, 0x8d, 0xa2, 0xd5, 0x2e, 0xe6, 0x9d, 0xea, 0x40, 0x37,
+	0xaa, 0x03, 0x3d, 0x8a, 0xe2, 0x09, 0x51, 0xbc, 0x45, 0x94, 0xef, 0x24, 0x68, 0x1d, 0x84, 0xd8,
+	0xa4, 0x58, 0x64, 0x7d, 0x59, 0x9c, 0x87, 0x70, 0xc3, 0x64, 0xdc, 0x5e, 0xd8, 0x51, 0x3a, 0xd5,
+	0x72, 0xe9, 0x98, 0x99, 0x74, 0x18, 0x11, 0xb9, 0x40, 0x64, 0x17, 0x50, 0x9e, 0xc7, 0xe5, 0x09,
+	0x71, 0xcc, 0x73, 0xd8, 0x7c, 0x84, 0x69, 0x02, 0x20, 0x6f, 0x41, 0x6f, 0x1e, 0xb8, 0x07, 0xcd,
+	0x6c, 0x60, 0x12, 0xa0, 0xdb, 0xb0, 0x16, 0xa9, 0x4f, 0xda, 0x52, 0x57, 0xde, 0xa9, 0x1b, 0xb1,
+	0xc1, 0xf7, 0xbb, 0xd0, 0xd2, 0xb1, 0x83, 0xb3, 0x22, 0x5e, 0xdf, 0xd5, 0xef, 0x02, 0xca, 0x1f,
+	0xb7, 0x54, 0xab, 0x93, 0xb8, 0x36, 0x0d, 0xec, 0xb0, 0xd7, 0x15, 0x11, 0x7c, 0x1f, 0x20, 0x09,
+	0x50, 0x00, 0x0a, 0x2b, 0x68, 0x1b, 0x1a, 0x89, 0x25, 0x10, 0xca, 0xf8, 0x52, 0xb2, 0x72, 0x81,
+	0xec, 0x4f, 0x12, 0x34, 0xb3, 0x27, 0x5f, 0x51, 0xa8, 0xef, 0x81, 0x42, 0x67, 0x41, 0x7c, 0xc4,
+	0x86, 0xd6, 0xbc, 0x98, 0x77, 0x1a, 0x09, 0xee, 0x78, 0x16, 0x60, 0x83, 0xad, 0x16, 0x08, 0xc9,
+	0x7f, 0x43, 0x68, 0x1b, 0x52, 0x24, 0xdb, 0xa3, 0xc4, 0x7b, 0x44, 0x1f, 0x27, 0xf8, 0x43, 0x35,
+	0x79, 0x02, 0xa2, 0x38, 0x97, 0x31, 0xcc, 0x8a, 0x56, 0xbd, 0x54, 0xb4, 0x77, 0x61, 0xc3, 0xe2,
+	0xd6, 0x0b, 0xa1, 0xe4, 0x1b, 0x56, 0x4e, 0x35, 0x6f, 0x41, 0x8e, 0x7d, 0xa7, 0x12, 0xac, 0x5d,
+	0x29, 0x81, 0x01, 0x8a, 0x8e, 0xc7, 0xa4, 0x5d, 0xeb, 0xca, 0x3b, 0x0d, 0xed, 0xc1, 0x9b, 0x79,
+	0x67, 0x4f, 0xe8, 0x8c, 0xae, 0x49, 0x43, 0xfb, 0x95, 0x1f, 0xda, 0x13, 0xdb, 0x4b, 0x0c, 0x0f,
+	0xf7, 0x83, 0x97, 0x93, 0xfe, 0xb7, 0x6e, 0x9f, 0x17, 0xdf, 0xb1, 0x39, 0x74, 0xb0, 0x8e, 0xc7,
+	0x4f, 0x34, 0x83, 0xc5, 0xca, 0x3f, 0xc4, 0x32, 0x17, 0xc6, 0x31, 0x0f, 0xd9, 0x43, 0x4c, 0x00,
+	0x64, 0x85, 0xe2, 0xca, 0x3c, 0x38, 0x21, 0xc0, 0x92, 0x07, 0x37, 0x4b, 0x1e, 0xdc, 0xbf, 0x5f,
+	0xcf, 0xe9, 0xe3, 0x5b, 0x41, 0x9f, 0xfb, 0x4c, 0x9f, 0x44, 0x70, 0xa6, 0x4f, 0x17, 0xd6, 0x99,
+	0x5d, 0x40, 0x25, 0x6e, 0x0e, 0x75, 0x98, 0x32, 0x02, 0x94, 0x04, 0xe9, 0xe5, 0x4b, 0x6f, 0xfd,
+	0xf2, 0x5b, 0x50, 0x7f, 0x1e, 0xda, 0x94, 0x35, 0x14, 0xee, 0xfa, 0x12, 0x36, 0x0f, 0xf1, 0x49,
+	0x4c, 0x8a, 0xe2, 0xb0, 0x14, 0x77, 0xa4, 0x82, 0xf2, 0xf9, 0xab, 0x20, 0xe4, 0x13, 0x02, 0x7a,
+	0x6c, 0x0c, 0x47, 0x1e, 0x83, 0xf9, 0x79, 0xe8, 0x8f, 0xa1, 0x99, 0x0d, 0x4d, 0x02, 0xa4, 0x42,
+	0x2d, 0xfa, 0x2e, 0x84, 0xe6, 0x5e, 0x8e, 0x7c, 0x0c, 0xeb, 0x06, 0x36, 0xad, 0x88, 0xcc, 0x12,
+	0x00, 0xda, 0x82, 0xff, 0x1d, 0xf8, 0x4e, 0x5c, 0x49, 0x55, 0x56, 0x49, 0xa9, 0xcd, 0x83, 0x21,
+	0x80, 0xe4, 0x46, 0xd3, 0xac, 0x3f, 0x81, 0xd6, 0x23, 0x4c, 0x9f, 0x84, 0xb6, 0x6b, 0x86, 0xb3,
+	0xc7, 0x78, 0xb6, 0xd2, 0x9d, 0xf9, 0x80, 0xf2, 0x60, 0x12, 0xa0, 0xa7, 0xb0, 0xb6, 0x4f, 0x69,
+	0x98, 0x5c, 0xdb, 0x67, 0x6f, 0xe6, 0x9d, 0xfb, 0xab, 0x5e, 0x5b, 0x14, 0xc0, 0x1e, 0x4e, 0x29,
+	0x36, 0xe2, 0x58, 0x69, 0x7d, 0xb5, 0x0e, 0x1c, 0x9f, 0xe0, 0xcc, 0x2d, 0x95, 0x53, 0x72, 0x0b,
+	0x50, 0x1e, 0x9a, 0x8a, 0xb0, 0xc7, 0x6a, 0xef, 0x0b, 0xdb, 0xb2, 0xb0, 0xb7, 0xaa, 0x06, 0x1e,
+	0xb4, 0x72, 0xd8, 0xeb, 0x95, 0xe0, 0x23, 0xd8, 0x60, 0x04, 0x9e, 0x52, 0x93, 0xae, 0x44, 0xf4,
+	0x1e, 0xdc, 0x14, 0x81, 0x24, 0x40, 0xef, 0x80, 0x62, 0xf8, 0x27, 0x84, 0xc1, 0x64, 0x6d, 0xfd,
+	0x62, 0xde, 0x91, 0x6d, 0x8f, 0x1a, 0xcc, 0xc9, 0x41, 0x9f, 0xb2, 0x1b, 0x66, 0xb8, 0x03, 0xdf,
+	0x99, 0xba, 0xde, 0x4a, 0x47, 0x06, 0x70, 0xab, 0x80, 0xbe, 0x5e, 0x75, 0x7e, 0x95, 0xe0, 0xd6,
+	0x71, 0x38, 0xf5, 0x46, 0x66, 0xa1, 0x65, 0xa6, 0x2f, 0xb0, 0xd0, 0x32, 0x17, 0x2b, 0xd1, 0xbe,
+	0x23, 0xc7, 0x4a, 0xf6, 0xe5, 0xa6, 0xde, 0x62, 0x25, 0xd7, 0x82, 0xe5, 0xd2, 0x2d, 0x58, 0xb9,
+	0xa2, 0x05, 0xaf, 0x15, 0x5a, 0xf0, 0x87, 0x70, 0xbb, 0x98, 0xcc, 0xd2, 0x26, 0xfc, 0xbd, 0x04,
+	0x2d, 0x03, 0x47, 0x33, 0xb6, 0xcc, 0x9c, 0x2f, 0x33, 0x24, 0xda, 0xb0, 0x7e, 0xe4, 0x58, 0xc2,
+	0x9c, 0x48, 0xcc, 0x68, 0xe5, 0x10, 0x9f, 0x08, 0xa9, 0x25, 0xe6, 0x62, 0x88, 0xe4, 0xa9, 0x2c,
+	0xe5, 0x7f, 0x2a, 0xc1, 0xcd, 0x7d, 0xcb, 0x4a, 0x3a, 0x77, 0xb9, 0x46, 0x5c, 0x26, 0x8d, 0xbb,
+	0x50, 0x67, 0xdb, 0x85, 0x44, 0x16, 0x0e, 0x74, 0x0c, 0xb2, 0x8e, 0xc7, 0x2c, 0x8d, 0x86, 0xa6,
+	0x45, 0xbf, 0x52, 0xff, 0xe1, 0xbc, 0x89, 0xc2, 0xf1, 0x94, 0xfe, 0x0f, 0x9b, 0x99, 0x8c, 0x48,
+	0x20, 0xe4, 0xaa, 0x63, 0xe7, 0x3f, 0x96, 0x6b, 0x26, 0xa3, 0x34, 0x57, 0x04, 0xf0, 0x2c, 0xb0,
+	0x4c, 0x71, 0xfc, 0x68, 0xdd, 0xd3, 0x3f, 0xd4, 0xca, 0xeb, 0x33, 0x55, 0x3a, 0x3d, 0x53, 0xa5,
+	0xdf, 0xcf, 0xd4, 0xca, 0x8f, 0xe7, 0x6a, 0xe5, 0xe7, 0x73, 0x55, 0x3a, 0x3d, 0x57, 0x2b, 0xbf,
+	0x9d, 0xab, 0x95, 0x61, 0x8d, 0xfd, 0xa9, 0xbd, 0xf7, 0xd7, 0x00, 0x10, 0xfd, 0xad, 0x93, 0x31,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *AccessInfo) Marshal() (dAtA []byte, err error) {
@@ -3135,6 +3240,83 @@ func (m *TruncateRelationResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RenameRelationReq) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameRelationReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameRelationReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DatabaseName) > 0 {
+		i -= len(m.DatabaseName)
+		copy(dAtA[i:], m.DatabaseName)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.DatabaseName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOperations(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameRelationResp) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameRelationResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameRelationResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintOperations(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AddTableDefReq) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3852,6 +4034,42 @@ func (m *TruncateRelationResp) ProtoSize() (n int) {
 	return n
 }
 
+func (m *RenameRelationReq) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOperations(uint64(m.ID))
+	}
+	l = len(m.DatabaseName)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	return n
+}
+
+func (m *RenameRelationResp) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOperations(uint64(m.ID))
+	}
+	return n
+}
+
 func (m *AddTableDefReq) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -7205,6 +7423,240 @@ func (m *TruncateRelationResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RenameRelationReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameRelationReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameRelationReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameRelationResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameRelationResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameRelationResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AddTableDefReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -418,7 +419,31 @@ func (t *Table) AlterTable(ctx context.Context, c *engine.ConstraintDef, constra
 }
 
 func (t *Table) TableRenameInTxn(ctx context.Context, constraint [][]byte) error {
-	// implement me
+	req := &api.AlterTableReq{}
+	if err := req.Unmarshal(constraint[0]); err != nil {
+		return err
+	}
+	rename := req.GetRenameTable()
+	if rename == nil {
+		return moerr.NewNotSupported(ctx, "alter table kind %v", req.Kind)
+	}
+	_, err := DoTxnRequest[RenameRelationResp](
+		ctx,
+		t.txnOperator,
+		false,
+		t.engine.allShards,
+		OpRenameRelation,
+		&RenameRelationReq{
+			ID:           t.id,
+			DatabaseName: t.databaseName,
+			OldName:      rename.OldName,
+			NewName:      rename.NewName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	t.tableName = rename.NewName
 	return nil
 }
 
@@ -125,37 +125,97 @@ func BlockRead(
 		logutil.Debugf("read block %s, columns %v, types %v", info.BlockID.String(), columns, colTypes)
 	}
 
-	var (
-		sels []int32
-		err  error
+	if filter != nil && info.Sorted {
+		return blockReadByFilter(
+			ctx, info, inputDeletes, columns, colTypes, ts,
+			filterSeqnums, filterColTypes, filter, fs, mp, vp, policy,
+		)
+	}
+
+	columnBatch, err := BlockReadInner(
+		ctx, info, inputDeletes, columns, colTypes,
+		types.TimestampToTS(ts), nil, fs, mp, vp, policy,
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	if filter != nil && info.Sorted {
-		if sels, err = ReadByFilter(
-			ctx, info, inputDeletes, filterSeqnums, filterColTypes,
-			types.TimestampToTS(ts), filter, fs, mp,
-		); err != nil {
-			return nil, err
-		}
-		v2.TaskSelReadFilterTotal.Inc()
-		if len(sels) == 0 {
-			RecordReadFilterSelectivity(1, 1)
-			v2.TaskSelReadFilterHit.Inc()
-		} else {
-			RecordReadFilterSelectivity(0, 1)
-		}
+	columnBatch.SetRowCount(columnBatch.Vecs[0].Length())
+	return columnBatch, nil
+}
 
-		if len(sels) == 0 {
-			result := batch.NewWithSize(len(colTypes))
-			for i, typ := range colTypes {
-				if vp == nil {
-					result.Vecs[i] = vector.NewVec(typ)
-				} else {
-					result.Vecs[i] = vp.GetVector(typ)
-				}
+// BlockReadLate reads a block like BlockRead, except that it reads the
+// filter columns first, and the other columns only if some rows pass the
+// filter, taking only those rows: the late materialization of a scan with a
+// selective filter. Unlike the one of BlockRead, filter evaluates the filter
+// of the scan rather than searching the sorted primary key, and so applies
+// to any block.
+func BlockReadLate(
+	ctx context.Context,
+	info *objectio.BlockInfo,
+	inputDeletes []int64,
+	columns []uint16,
+	colTypes []types.Type,
+	ts timestamp.Timestamp,
+	filterSeqnums []uint16,
+	filterColTypes []types.Type,
+	filter ReadFilter,
+	fs fileservice.FileService,
+	mp *mpool.MPool,
+	vp engine.VectorPool,
+	policy fileservice.Policy,
+) (*batch.Batch, error) {
+	if logutil.GetSkip1Logger().Core().Enabled(zap.DebugLevel) {
+		logutil.Debugf("read block %s late, columns %v, filter columns %v", info.BlockID.String(), columns, filterSeqnums)
+	}
+	return blockReadByFilter(
+		ctx, info, inputDeletes, columns, colTypes, ts,
+		filterSeqnums, filterColTypes, filter, fs, mp, vp, policy,
+	)
+}
+
+// blockReadByFilter reads the rows of a block selected by filter, reading
+// no more than the filter columns if it selects none.
+func blockReadByFilter(
+	ctx context.Context,
+	info *objectio.BlockInfo,
+	inputDeletes []int64,
+	columns []uint16,
+	colTypes []types.Type,
+	ts timestamp.Timestamp,
+	filterSeqnums []uint16,
+	filterColTypes []types.Type,
+	filter ReadFilter,
+	fs fileservice.FileService,
+	mp *mpool.MPool,
+	vp engine.VectorPool,
+	policy fileservice.Policy,
+) (*batch.Batch, error) {
+	sels, err := ReadByFilter(
+		ctx, info, inputDeletes, filterSeqnums, filterColTypes,
+		types.TimestampToTS(ts), filter, fs, mp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	v2.TaskSelReadFilterTotal.Inc()
+	if len(sels) == 0 {
+		RecordReadFilterSelectivity(1, 1)
+		v2.TaskSelReadFilterHit.Inc()
+	} else {
+		RecordReadFilterSelectivity(0, 1)
+	}
+
+	if len(sels) == 0 {
+		result := batch.NewWithSize(len(colTypes))
+		for i, typ := range colTypes {
+			if vp == nil {
+				result.Vecs[i] = vector.NewVec(typ)
+			} else {
+				result.Vecs[i] = vp.GetVector(typ)
 			}
-			return result, nil
 		}
+		return result, nil
 	}
 
 	columnBatch, err := BlockReadInner(
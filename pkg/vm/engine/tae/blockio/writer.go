@@ -35,6 +35,7 @@ type BlockWriter struct {
 	isSetPK        bool
 	pk             uint16
 	sortKeyIdx     uint16
+	bfColumns      []uint16
 	nameStr        string
 	name           objectio.ObjectName
 	objectStats    []objectio.ObjectStats
@@ -78,6 +79,30 @@ func (w *BlockWriter) SetSortKey(idx uint16) {
 	w.sortKeyIdx = idx
 }
 
+// SetBloomFilterColumns sets the columns, other than the primary key, whose
+// per-block bloom filters are persisted, used by the bloom filter indexes.
+func (w *BlockWriter) SetBloomFilterColumns(idxes []uint16) {
+	w.bfColumns = idxes
+}
+
+// SetLowCardinalityColumns sets the columns whose blocks are dictionary
+// encoded when they have few distinct values.
+func (w *BlockWriter) SetLowCardinalityColumns(idxes []uint16) {
+	w.writer.SetDictColumns(idxes)
+}
+
+func (w *BlockWriter) isBloomFilterColumn(idx uint16) bool {
+	if w.isSetPK && w.pk == idx {
+		return false
+	}
+	for _, i := range w.bfColumns {
+		if i == idx {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *BlockWriter) SetAppendable() {
 	w.writer.SetAppendable()
 }
@@ -125,6 +150,20 @@ func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, erro
 		// update object zonemap
 		w.objMetaBuilder.UpdateZm(i, zm)
 
+		if w.isBloomFilterColumn(uint16(i)) {
+			bf, err := index.NewBinaryFuseFilter(columnData)
+			if err != nil {
+				return nil, err
+			}
+			buf, err := bf.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err = w.writer.WriteColumnBF(int(block.GetID()), seqnums[i], buf); err != nil {
+				return nil, err
+			}
+		}
+
 		if !w.isSetPK || w.pk != uint16(i) {
 			continue
 		}
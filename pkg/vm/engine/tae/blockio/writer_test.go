@@ -210,3 +210,62 @@ func TestWriter_WriteBlockAfterAlter(t *testing.T) {
 	require.True(t, zm.Contains(int32(79999)))
 	require.False(t, zm.Contains(int32(80000)))
 }
+
+func TestWriter_WriteColumnBloomFilter(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.Nil(t, err)
+	writer, _ := NewBlockWriterNew(service, name, 0, nil)
+
+	schema := catalog.MockSchemaAll(13, 2)
+	bats := catalog.MockBatch(schema, 1000*2).Split(2)
+	writer.SetPrimaryKey(2)
+	// the primary key is skipped
+	writer.SetBloomFilterColumns([]uint16{2, 3})
+
+	_, err = writer.WriteBatch(containers.ToCNBatch(bats[0]))
+	assert.Nil(t, err)
+	_, err = writer.WriteBatch(containers.ToCNBatch(bats[1]))
+	assert.Nil(t, err)
+	blocks, _, err := writer.Sync(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blocks))
+
+	mp := mpool.MustNewZero()
+	metaloc := EncodeLocation(writer.GetName(), blocks[0].GetExtent(), 1000, blocks[0].GetID())
+	reader, err := NewObjectReader(service, metaloc)
+	require.NoError(t, err)
+	meta, err := reader.LoadObjectMeta(context.TODO(), mp)
+	require.NoError(t, err)
+	bf, err := objectio.LoadBFWithMeta(ctx, meta, metaloc, service)
+	require.NoError(t, err)
+
+	mayContain := func(buf []byte, key []byte) bool {
+		require.NotNil(t, buf)
+		filter := index.NewEmptyBinaryFuseFilter()
+		require.NoError(t, index.DecodeBloomFilter(filter, buf))
+		ok, err := filter.MayContainsKey(key)
+		require.NoError(t, err)
+		return ok
+	}
+
+	pk := int32(10)
+	require.True(t, mayContain(bf.GetBloomFilter(0), types.EncodeInt32(&pk)))
+	require.Nil(t, bf.GetColumnBloomFilter(0, 2))
+
+	v := bats[0].Vecs[3].Get(10).(int64)
+	require.True(t, mayContain(bf.GetColumnBloomFilter(0, 3), types.EncodeInt64(&v)))
+	v = bats[1].Vecs[3].Get(500).(int64)
+	require.True(t, mayContain(bf.GetColumnBloomFilter(1, 3), types.EncodeInt64(&v)))
+	require.Nil(t, bf.GetColumnBloomFilter(0, 4))
+}
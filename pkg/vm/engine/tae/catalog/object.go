@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -637,6 +638,62 @@ func (entry *ObjectEntry) GetPKZoneMap(
 	}
 	return stats.SortKeyZoneMap(), nil
 }
+
+// IsTTLExpired reports whether all rows of the object are expired at cutoff,
+// judged by the object zonemap of the TTL column with seqnum.
+func (entry *ObjectEntry) IsTTLExpired(
+	ctx context.Context,
+	fs fileservice.FileService,
+	seqnum uint16,
+	cutoff time.Time,
+) (bool, error) {
+	colMeta, ok, err := entry.LoadColumnMeta(ctx, fs, seqnum)
+	if err != nil || !ok {
+		return false, err
+	}
+	if colMeta.NullCnt() != 0 {
+		return false, nil
+	}
+	return pkgcatalog.ZoneMapExpired(colMeta.ZoneMap(), cutoff), nil
+}
+
+// GetColumnZoneMap returns the object zonemap of the column with seqnum.
+// The zonemap is not initialized if the object was written before the
+// column was added.
+func (entry *ObjectEntry) GetColumnZoneMap(
+	ctx context.Context,
+	fs fileservice.FileService,
+	seqnum uint16,
+) (objectio.ZoneMap, error) {
+	colMeta, ok, err := entry.LoadColumnMeta(ctx, fs, seqnum)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return colMeta.ZoneMap(), nil
+}
+
+// LoadColumnMeta loads the object meta of the column with seqnum, false if
+// the object was written before the column was added.
+func (entry *ObjectEntry) LoadColumnMeta(
+	ctx context.Context,
+	fs fileservice.FileService,
+	seqnum uint16,
+) (objectio.ColumnMeta, bool, error) {
+	stats, err := entry.MustGetObjectStats()
+	if err != nil {
+		return nil, false, err
+	}
+	loc := stats.ObjectLocation()
+	objMeta, err := objectio.FastLoadObjectMeta(ctx, &loc, false, fs)
+	if err != nil {
+		return nil, false, err
+	}
+	dataMeta := objMeta.MustDataMeta()
+	if seqnum >= dataMeta.BlockHeader().MetaColumnCount() {
+		return nil, false, nil
+	}
+	return dataMeta.MustGetColumn(seqnum), true, nil
+}
 func MockObjEntryWithTbl(tbl *TableEntry, size uint64) *ObjectEntry {
 	stats := objectio.NewObjectStats()
 	objectio.SetObjectStatsSize(stats, uint32(size))
@@ -177,6 +177,12 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.MaxObjOnerun = p.GetMaxObjOnerun()
 		s.Extra.MinCnMergeSize = p.GetMinCnMergeSize()
 		s.Extra.Hints = p.GetHints()
+		s.Extra.TargetObjectSize = p.GetTargetObjectSize()
+		s.Extra.MaxLevel = p.GetMaxLevel()
+		s.Extra.Reorganize = p.GetReorganize()
+		s.Extra.TimeColumn = p.GetTimeColumn()
+		s.Extra.TimeWindow = p.GetTimeWindow()
+		s.Extra.SealAfter = p.GetSealAfter()
 	case apipb.AlterKind_UpdateConstraint:
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
@@ -309,6 +315,29 @@ func (s *Schema) GetSingleSortKey() *ColDef        { return s.SortKey.Defs[0] }
 func (s *Schema) GetSingleSortKeyIdx() int         { return s.SortKey.Defs[0].Idx }
 func (s *Schema) GetSingleSortKeyType() types.Type { return s.GetSingleSortKey().Type }
 
+// HasCompositeClusterBy reports whether the table is clustered by more than
+// one column, whose sort key is the hidden column packing the columns.
+func (s *Schema) HasCompositeClusterBy() bool {
+	return s.HasSortKey() && !s.HasPK() &&
+		strings.HasPrefix(s.GetSingleSortKey().Name, pkgcatalog.PrefixCBColName)
+}
+
+// GetClusterByColIdxes returns the indexes of the columns the table is
+// clustered by, the columns packed in the sort key for a composite key.
+func (s *Schema) GetClusterByColIdxes() []int {
+	if !s.HasCompositeClusterBy() {
+		return []int{s.GetSingleSortKeyIdx()}
+	}
+	names := pkgcatalog.SplitCompositeClusterByColumnName(s.GetSingleSortKey().Name)
+	idxes := make([]int, 0, len(names))
+	for _, name := range names {
+		if idx, ok := s.NameMap[name]; ok {
+			idxes = append(idxes, idx)
+		}
+	}
+	return idxes
+}
+
 // Can't identify fake pk with column.flag. Column.flag is not ready in 0.8.0.
 // TODO: Use column.flag instead of column.name to idntify fake pk.
 func (s *Schema) getFakePrimaryKey() *ColDef {
@@ -321,6 +350,79 @@ func (s *Schema) getFakePrimaryKey() *ColDef {
 	return s.ColDefs[idx]
 }
 
+// BloomFilterColIdxes returns the logical idx of the columns covered by the
+// bloom filter indexes of the table.
+func (s *Schema) BloomFilterColIdxes() []uint16 {
+	if len(s.Constraint) == 0 {
+		return nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(s.Constraint); err != nil {
+		return nil
+	}
+	var idxes []uint16
+	for _, ct := range c.Cts {
+		def, ok := ct.(*engine.IndexDef)
+		if !ok {
+			continue
+		}
+		for _, name := range pkgcatalog.BloomIndexColumns(def.Indexes) {
+			if idx, ok := s.NameMap[name]; ok {
+				idxes = append(idxes, uint16(idx))
+			}
+		}
+	}
+	return idxes
+}
+
+// TTL returns the row time-to-live of the table and the index of its column,
+// both decoded from the table properties kept in the constraint.
+func (s *Schema) TTL() (ttl pkgcatalog.TableTTL, colIdx int, ok bool) {
+	if len(s.Constraint) == 0 {
+		return
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(s.Constraint); err != nil {
+		return
+	}
+	for _, ct := range c.Cts {
+		def, isCfg := ct.(*engine.StreamConfigsDef)
+		if !isCfg {
+			continue
+		}
+		if ttl, ok = pkgcatalog.TableTTLFromProperties(def.Configs); ok {
+			colIdx, ok = s.NameMap[ttl.Column]
+			return
+		}
+	}
+	return
+}
+
+// LowCardinalityColIdxes returns the logical idx of the low cardinality
+// columns, decoded from the table properties kept in the constraint.
+func (s *Schema) LowCardinalityColIdxes() []uint16 {
+	if len(s.Constraint) == 0 {
+		return nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(s.Constraint); err != nil {
+		return nil
+	}
+	var idxes []uint16
+	for _, ct := range c.Cts {
+		def, ok := ct.(*engine.StreamConfigsDef)
+		if !ok {
+			continue
+		}
+		for _, name := range pkgcatalog.LowCardinalityColumnsFromProperties(def.Configs) {
+			if idx, ok := s.NameMap[name]; ok {
+				idxes = append(idxes, uint16(idx))
+			}
+		}
+	}
+	return idxes
+}
+
 // GetPrimaryKey gets the primary key, including fake primary key.
 func (s *Schema) GetPrimaryKey() *ColDef {
 	if s.HasPK() {
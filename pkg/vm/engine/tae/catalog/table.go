@@ -705,6 +705,12 @@ func (entry *TableEntry) AlterTable(ctx context.Context, txn txnif.TxnReader, re
 			MaxRowsMergedObj: newSchema.Extra.MaxRowsMergedObj,
 			MinCnMergeSize:   newSchema.Extra.MinCnMergeSize,
 			Hints:            hints,
+			TargetObjectSize: newSchema.Extra.TargetObjectSize,
+			MaxLevel:         newSchema.Extra.MaxLevel,
+			Reorganize:       newSchema.Extra.Reorganize,
+			TimeColumn:       newSchema.Extra.TimeColumn,
+			TimeWindow:       newSchema.Extra.TimeWindow,
+			SealAfter:        newSchema.Extra.SealAfter,
 		}
 
 	}
@@ -33,6 +33,9 @@ const (
 	DefaultMinCNMergeSize        = 80000 // MB
 	DefaultCNMergeMemControlHint = 8192  // MB
 	DefaultMaxMergeObjN          = 4
+	// tables clustered by CLUSTER BY keys are reclustered once the average
+	// clustering depth of their objects exceeds it
+	DefaultReclusterDepth = 2
 
 	Const1GBytes = 1 << 30
 	Const1MBytes = 1 << 20
@@ -45,6 +48,7 @@ var (
 	RuntimeMinCNMergeSize      atomic.Uint64
 	RuntimeCNMergeMemControl   atomic.Uint64
 	RuntimeCNTakeOverAll       atomic.Bool
+	RuntimeReclusterDepth      atomic.Int32
 	IsStandaloneBoost          atomic.Bool
 	ShouldStandaloneCNTakeOver atomic.Bool
 	Epsilon                    float64
@@ -54,6 +58,7 @@ func init() {
 	RuntimeMaxMergeObjN.Store(DefaultMaxMergeObjN)
 	RuntimeMinRowsQualified.Store(DefaultMinRowsQualified)
 	RuntimeMaxRowsObj.Store(DefaultMaxRowsObj)
+	RuntimeReclusterDepth.Store(DefaultReclusterDepth)
 	Epsilon = math.Nextafter(1, 2) - 1
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/dbutils"
 	gc2 "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/merge"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/scrub"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
@@ -66,6 +67,9 @@ type DB struct {
 
 	DiskCleaner *gc2.DiskCleaner
 
+	// Scrubber is nil if scrubbing is disabled
+	Scrubber *scrub.Scrubber
+
 	Runtime *dbutils.Runtime
 
 	DBLocker io.Closer
@@ -210,6 +214,9 @@ func (db *DB) Close() error {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
+	if db.Scrubber != nil {
+		db.Scrubber.Stop()
+	}
 	db.GCManager.Stop()
 	db.BGScanner.Stop()
 	db.BGCheckpointRunner.Stop()
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 type checkpointCleaner struct {
@@ -259,6 +260,30 @@ func (c *checkpointCleaner) GetInputs() *GCTable {
 	return c.inputs.tables[0]
 }
 
+func (c *checkpointCleaner) MoveColdObjects(ctx context.Context) error {
+	if c.fs.Tiered() == nil {
+		return nil
+	}
+	c.inputs.RLock()
+	var candidates []objectio.TieringCandidate
+	for _, table := range c.inputs.tables {
+		candidates = table.collectTieringCandidates(candidates)
+	}
+	c.inputs.RUnlock()
+
+	// objects deleted by delWorker meanwhile are skipped, the tiered file service
+	// serializes moving and deleting of the same object
+	now := time.Now()
+	moved, err := c.fs.MoveColdObjects(ctx, candidates, now)
+	logutil.Info("[DiskCleaner]",
+		common.OperationField("MoveColdObjects"),
+		common.AnyField("candidates", len(candidates)),
+		common.AnyField("moved", moved),
+		common.AnyField("cost", time.Since(now)),
+		common.ErrorField(err))
+	return err
+}
+
 func (c *checkpointCleaner) SetMinMergeCountForTest(count int) {
 	c.minMergeCount.Lock()
 	defer c.minMergeCount.Unlock()
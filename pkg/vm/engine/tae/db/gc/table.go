@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -94,6 +95,23 @@ func (t *GCTable) deleteObject(name string) {
 	delete(t.objects, name)
 }
 
+// collectTieringCandidates appends objects not dropped yet,
+// dropped objects are left in the hot tier since they will be deleted by GC soon
+func (t *GCTable) collectTieringCandidates(candidates []objectio.TieringCandidate) []objectio.TieringCandidate {
+	t.Lock()
+	defer t.Unlock()
+	for name, entry := range t.objects {
+		if !entry.dropTS.IsEmpty() {
+			continue
+		}
+		candidates = append(candidates, objectio.TieringCandidate{
+			Name:      name,
+			CreatedAt: time.Unix(0, entry.createTS.Physical()),
+		})
+	}
+	return candidates
+}
+
 // Merge can merge two GCTables
 func (t *GCTable) Merge(GCTable *GCTable) {
 	for name, entry := range GCTable.objects {
@@ -15,6 +15,8 @@
 package gc
 
 import (
+	"context"
+
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
@@ -111,6 +113,8 @@ type Cleaner interface {
 	TryGC() error
 	AddChecker(checker func(item any) bool)
 	GetMaxConsumed() *checkpoint.CheckpointEntry
+	// MoveColdObjects moves live objects selected by the tiering policy to the cold tier
+	MoveColdObjects(ctx context.Context) error
 	Stop()
 	// for test
 	SetMinMergeCountForTest(count int)
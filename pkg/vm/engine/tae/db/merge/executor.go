@@ -21,6 +21,7 @@ import (
 	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
@@ -162,7 +163,9 @@ func (e *MergeExecutor) ExecuteFor(entry *catalog.TableEntry, policy Policy) {
 	e.tableName = fmt.Sprintf("%v-%v", entry.ID, entry.GetLastestSchema().Name)
 
 	mobjs, kind := policy.Revise(int64(e.cpuPercent), int64(e.MemAvailBytes()))
-	if len(mobjs) < 2 {
+	// a single object is worth merging only when it is dropped by the TTL
+	if len(mobjs) == 0 ||
+		len(mobjs) == 1 && !newTTLChecker(entry.GetLastestSchema(), time.Now()).expired(mobjs[0]) {
 		return
 	}
 
@@ -212,6 +215,9 @@ func (e *MergeExecutor) ExecuteFor(entry *catalog.TableEntry, policy Policy) {
 		}
 
 		factory := func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+			if kind == TaskHostDNRecluster {
+				return jobs.NewReclusterObjectsTask(ctx, txn, mobjs, e.rt)
+			}
 			return jobs.NewMergeObjectsTask(ctx, txn, mobjs, e.rt)
 		}
 		task, err := e.rt.Scheduler.ScheduleMultiScopedTxnTask(nil, tasks.DataCompactionTask, scopes, factory)
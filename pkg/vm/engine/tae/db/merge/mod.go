@@ -83,6 +83,9 @@ type TaskHostKind int
 const (
 	TaskHostCN TaskHostKind = iota
 	TaskHostDN
+	// TaskHostDNRecluster runs on DN and reorders the rows in the Z-order of
+	// a composite cluster key, see jobs.NewReclusterObjectsTask
+	TaskHostDNRecluster
 )
 
 type activeEntry struct {
@@ -196,6 +199,10 @@ const (
 	constMergeExpansionRate = 6
 	constMaxMemCap          = 4 * constMergeExpansionRate * common.Const1GBytes // max orginal memory for a object
 	constSmallMergeGap      = 3 * time.Minute
+
+	constDefaultTargetObjectSize = 110 * common.Const1MBytes
+	// the size ratio of the objects of two adjacent levels
+	constLevelFanout = 4
 )
 
 type Policy interface {
@@ -206,6 +213,68 @@ type Policy interface {
 	GetConfig(*catalog.TableEntry) any
 }
 
+// policyGroup picks the policy of every table by its config, the time window
+// policy for tables with a time window and the basic policy for the others.
+// Tables clustered by CLUSTER BY keys are reclustered when the basic policy
+// finds nothing to merge.
+type policyGroup struct {
+	basic      *Basic
+	timeWindow *TimeWindow
+	recluster  *Recluster
+	current    Policy
+	// recluster the table if the current policy picks nothing
+	reclustering bool
+}
+
+func NewPolicyGroup() Policy {
+	basic := NewBasicPolicy()
+	return &policyGroup{
+		basic:      basic,
+		timeWindow: NewTimeWindowPolicy(),
+		recluster:  NewReclusterPolicy(),
+		current:    basic,
+	}
+}
+
+func (g *policyGroup) OnObject(obj *catalog.ObjectEntry) {
+	g.current.OnObject(obj)
+	if g.reclustering {
+		g.recluster.OnObject(obj)
+	}
+}
+
+func (g *policyGroup) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	objs, kind := g.current.Revise(cpu, mem)
+	if len(objs) < 2 && g.reclustering {
+		if robjs, rkind := g.recluster.Revise(cpu, mem); len(robjs) > 1 {
+			return robjs, rkind
+		}
+	}
+	return objs, kind
+}
+
+func (g *policyGroup) ResetForTable(entry *catalog.TableEntry) {
+	g.basic.ResetForTable(entry)
+	g.current = g.basic
+	g.reclustering = false
+	// a window shorter than a second would divide the time by zero
+	if c := g.basic.config; c.TimeWindow >= time.Second && c.TimeColumn != "" {
+		g.timeWindow.resetForTable(entry, c)
+		g.current = g.timeWindow
+	} else if isClustered(g.basic.schema) {
+		g.recluster.resetForTable(entry, c)
+		g.reclustering = true
+	}
+}
+
+func (g *policyGroup) SetConfig(tbl *catalog.TableEntry, f func() txnif.AsyncTxn, c any) {
+	g.basic.SetConfig(tbl, f, c)
+}
+
+func (g *policyGroup) GetConfig(tbl *catalog.TableEntry) any {
+	return g.basic.GetConfig(tbl)
+}
+
 func NewUpdatePolicyReq(c *BasicPolicyConfig) *api.AlterTableReq {
 	return &api.AlterTableReq{
 		Kind: api.AlterKind_UpdatePolicy,
@@ -216,6 +285,12 @@ func NewUpdatePolicyReq(c *BasicPolicyConfig) *api.AlterTableReq {
 				MaxRowsMergedObj: uint32(c.MaxRowsMergedObj),
 				MinCnMergeSize:   uint64(c.MinCNMergeSize),
 				Hints:            c.MergeHints,
+				TargetObjectSize: c.TargetObjectSize,
+				MaxLevel:         uint32(c.MaxLevel),
+				Reorganize:       c.Reorganize,
+				TimeColumn:       c.TimeColumn,
+				TimeWindow:       int64(c.TimeWindow / time.Second),
+				SealAfter:        int64(c.SealAfter / time.Second),
 			},
 		},
 	}
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -47,15 +49,50 @@ type BasicPolicyConfig struct {
 	MinCNMergeSize   uint64
 	FromUser         bool
 	MergeHints       []api.MergeHint
+
+	// the options below are set by ALTER TABLE ... SET MERGE_POLICY
+
+	// TargetObjectSize is the original size of objects not worth merging,
+	// 0 for the default
+	TargetObjectSize uint64
+	// MaxLevel enables the tiered merge, see objectLevel
+	MaxLevel int
+	// Reorganize merges the objects overlapping on the sort key
+	Reorganize bool
+	// TimeColumn and TimeWindow enable the time window merge, see TimeWindow
+	TimeColumn string
+	TimeWindow time.Duration
+	SealAfter  time.Duration
 }
 
 func (c *BasicPolicyConfig) String() string {
-	return fmt.Sprintf(
+	s := fmt.Sprintf(
 		"minRowsObj:%d, maxOneRun:%d, cnSize:%v, hints: %v",
 		c.ObjectMinRows, c.MergeMaxOneRun,
 		common.HumanReadableBytes(int(c.MinCNMergeSize)),
 		c.MergeHints,
 	)
+	if c.TargetObjectSize != 0 {
+		s += fmt.Sprintf(", targetSize:%v", common.HumanReadableBytes(int(c.TargetObjectSize)))
+	}
+	if c.MaxLevel != 0 {
+		s += fmt.Sprintf(", maxLevel:%d", c.MaxLevel)
+	}
+	if c.Reorganize {
+		s += ", reorganize"
+	}
+	if c.TimeWindow != 0 {
+		s += fmt.Sprintf(", timeWindow:%s/%v, sealAfter:%v", c.TimeColumn, c.TimeWindow, c.SealAfter)
+	}
+	return s
+}
+
+// targetObjectSize returns the original size of objects not worth merging
+func (c *BasicPolicyConfig) targetObjectSize() int {
+	if c.TargetObjectSize != 0 {
+		return int(c.TargetObjectSize)
+	}
+	return constDefaultTargetObjectSize
 }
 
 type customConfigProvider struct {
@@ -75,18 +112,30 @@ func (o *customConfigProvider) GetConfig(tbl *catalog.TableEntry) *BasicPolicyCo
 	p, ok := o.configs[tbl.ID]
 	if !ok {
 		extra := tbl.GetLastestSchemaLocked().Extra
-		if extra.MaxObjOnerun != 0 || extra.MinRowsQuailifed != 0 {
+		if extra.MaxObjOnerun != 0 || extra.MinRowsQuailifed != 0 ||
+			extra.TargetObjectSize != 0 || extra.MaxLevel != 0 ||
+			extra.Reorganize || extra.TimeWindow != 0 {
 			cnSize := extra.MinCnMergeSize
 			if cnSize == 0 {
 				cnSize = common.DefaultMinCNMergeSize * common.Const1MBytes
 			}
+			maxOneRun := int(extra.MaxObjOnerun)
+			if maxOneRun == 0 {
+				maxOneRun = int(common.RuntimeMaxMergeObjN.Load())
+			}
 			p = &BasicPolicyConfig{
 				ObjectMinRows:    int(extra.MinRowsQuailifed),
-				MergeMaxOneRun:   int(extra.MaxObjOnerun),
+				MergeMaxOneRun:   maxOneRun,
 				MaxRowsMergedObj: int(extra.MaxRowsMergedObj),
 				MinCNMergeSize:   cnSize,
 				FromUser:         true,
 				MergeHints:       extra.Hints,
+				TargetObjectSize: extra.TargetObjectSize,
+				MaxLevel:         int(extra.MaxLevel),
+				Reorganize:       extra.Reorganize,
+				TimeColumn:       extra.TimeColumn,
+				TimeWindow:       policySeconds(extra.TimeWindow),
+				SealAfter:        policySeconds(extra.SealAfter),
 			}
 			o.configs[tbl.ID] = p
 		}
@@ -94,6 +143,19 @@ func (o *customConfigProvider) GetConfig(tbl *catalog.TableEntry) *BasicPolicyCo
 	return p
 }
 
+// policySeconds converts the seconds of an interval of the merge policy into a
+// duration, capped to pkgcatalog.MaxMergePolicyInterval so that it does not
+// overflow.
+func policySeconds(secs int64) time.Duration {
+	if secs <= 0 {
+		return 0
+	}
+	if secs > int64(pkgcatalog.MaxMergePolicyInterval/time.Second) {
+		return pkgcatalog.MaxMergePolicyInterval
+	}
+	return time.Duration(secs) * time.Second
+}
+
 func (o *customConfigProvider) InvalidCache(tbl *catalog.TableEntry) {
 	o.Lock()
 	defer o.Unlock()
@@ -138,6 +200,11 @@ type Basic struct {
 	objHeap *heapBuilder[*catalog.ObjectEntry]
 	accBuf  []int
 
+	ttl        ttlChecker
+	ttlExpired []*catalog.ObjectEntry
+	// objects with the sort key zonemap, for the reorganization
+	sortedObjs []*catalog.ObjectEntry
+
 	config         *BasicPolicyConfig
 	configProvider *customConfigProvider
 }
@@ -154,15 +221,23 @@ func NewBasicPolicy() *Basic {
 
 // impl Policy for Basic
 func (o *Basic) OnObject(obj *catalog.ObjectEntry) {
+	if o.ttl.expired(obj) {
+		o.ttlExpired = append(o.ttlExpired, obj)
+		return
+	}
 	rowsLeftOnObj := obj.GetRemainingRows()
 	// it has too few rows, merge it
 	iscandidate := func() bool {
 		if rowsLeftOnObj < obj.GetRows()/2 {
 			return true
 		}
-		if obj.GetOriginSize() > 110*common.Const1MBytes {
+		if obj.GetOriginSize() > o.config.targetObjectSize() {
 			return false
 		}
+		// with a target size set by the user, every smaller object is merged
+		if o.config.TargetObjectSize != 0 {
+			return true
+		}
 		if rowsLeftOnObj < o.config.ObjectMinRows {
 			return true
 		}
@@ -175,6 +250,10 @@ func (o *Basic) OnObject(obj *catalog.ObjectEntry) {
 			entry: obj,
 		}, o.config.MergeMaxOneRun)
 	}
+
+	if o.config.Reorganize && o.schema.HasSortKey() && obj.GetSortKeyZonemap().IsInited() {
+		o.sortedObjs = append(o.sortedObjs, obj)
+	}
 }
 
 func (o *Basic) SetConfig(tbl *catalog.TableEntry, f func() txnif.AsyncTxn, c any) {
@@ -214,7 +293,28 @@ func (o *Basic) GetConfig(tbl *catalog.TableEntry) any {
 }
 
 func (o *Basic) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	// dropping expired objects reads no data, do it before any other merge
+	if len(o.ttlExpired) > 0 {
+		objs := o.ttlExpired
+		if len(objs) > o.config.MergeMaxOneRun {
+			objs = objs[:o.config.MergeMaxOneRun]
+		}
+		// the buffer is reused by the next table
+		return append([]*catalog.ObjectEntry(nil), objs...), TaskHostDN
+	}
+
 	objs := o.objHeap.finish()
+	if o.config.MaxLevel > 0 {
+		objs = pickLevel(objs, o.config.targetObjectSize(), o.config.MaxLevel)
+	}
+
+	// reorganize the table only if there are no small objects to merge
+	if len(objs) < 2 && o.config.Reorganize {
+		if robjs := o.reorganize(mem); len(robjs) > 1 {
+			return robjs, TaskHostDN
+		}
+	}
+
 	sort.Slice(objs, func(i, j int) bool {
 		return objs[i].GetRemainingRows() < objs[j].GetRemainingRows()
 	})
@@ -315,6 +415,9 @@ func (o *Basic) controlMem(objs []*catalog.ObjectEntry, mem int64) []*catalog.Ob
 			return false
 		}
 		// make object averaged size
+		if o.config.TargetObjectSize != 0 {
+			return osize > int(o.config.TargetObjectSize)
+		}
 		return osize > 120*common.Const1MBytes
 	}
 	for needPopout(objs) {
@@ -329,11 +432,20 @@ func (o *Basic) controlMem(objs []*catalog.ObjectEntry, mem int64) []*catalog.Ob
 	return objs
 }
 
+// reorganize returns the largest set of objects overlapping on the sort key,
+// merging them sorts the rows of the set again.
+func (o *Basic) reorganize(mem int64) []*catalog.ObjectEntry {
+	return limitMergeGroup(maxOverlapGroup(o.sortedObjs), o.config.MergeMaxOneRun, mem)
+}
+
 func (o *Basic) ResetForTable(entry *catalog.TableEntry) {
 	o.id = entry.ID
 	o.schema = entry.GetLastestSchemaLocked()
 	o.hist = entry.Stats.GetLastMerge()
 	o.objHeap.reset()
+	o.ttl = newTTLChecker(o.schema, time.Now())
+	o.ttlExpired = o.ttlExpired[:0]
+	o.sortedObjs = o.sortedObjs[:0]
 
 	o.config = o.configProvider.GetConfig(entry)
 
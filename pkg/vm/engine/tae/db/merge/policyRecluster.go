@@ -0,0 +1,182 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+var _ Policy = (*Recluster)(nil)
+
+// Recluster reclusters the tables clustered by CLUSTER BY keys.
+//
+// Rows arriving out of the order of the keys make the objects overlap on the
+// keys, which degrades the zonemap pruning over time. Once the average
+// clustering depth of the objects, see index.ClusteringInfo, exceeds
+// RuntimeReclusterDepth, overlapping objects are merged and sorted by the key
+// again.
+//
+// The objects of a composite key picked by it are sorted in the Z-order of
+// its columns, see mergesort.DoZOrderMergeAndWrite, and they overlap on the packed key
+// even when well clustered, so their depth is measured from the zonemaps of
+// the columns instead, see index.CalculateBoxClusteringInfo.
+type Recluster struct {
+	id        uint64
+	schema    *catalog.Schema
+	config    *BasicPolicyConfig
+	composite bool
+	seqnums   []uint16
+
+	objs []*catalog.ObjectEntry
+	// the zonemaps of the columns of a composite key in objs
+	boxes [][]index.ZM
+
+	// get the zonemap of a column, replaced in tests
+	zonemapFn func(obj *catalog.ObjectEntry, seqnum uint16) (objectio.ZoneMap, error)
+}
+
+func NewReclusterPolicy() *Recluster {
+	return &Recluster{
+		zonemapFn: loadColumnZoneMap,
+	}
+}
+
+// isClustered reports whether the table is clustered by CLUSTER BY keys
+func isClustered(schema *catalog.Schema) bool {
+	return schema.HasSortKey() && !schema.HasPK()
+}
+
+// impl Policy for Recluster
+func (o *Recluster) OnObject(obj *catalog.ObjectEntry) {
+	if !o.composite {
+		if obj.GetSortKeyZonemap().IsInited() {
+			o.objs = append(o.objs, obj)
+		}
+		return
+	}
+	box := make([]index.ZM, 0, len(o.seqnums))
+	for _, seqnum := range o.seqnums {
+		zm, err := o.zonemapFn(obj, seqnum)
+		if err != nil {
+			logutil.Warnf("mergeblocks %d-%s load cluster by zonemap: %v", o.id, o.schema.Name, err)
+			return
+		}
+		box = append(box, zm)
+	}
+	o.objs = append(o.objs, obj)
+	o.boxes = append(o.boxes, box)
+}
+
+// The config is kept by the basic policy
+func (o *Recluster) SetConfig(*catalog.TableEntry, func() txnif.AsyncTxn, any) {}
+func (o *Recluster) GetConfig(*catalog.TableEntry) any                         { return o.config }
+
+func (o *Recluster) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	depth := common.RuntimeReclusterDepth.Load()
+	if depth <= 0 || len(o.objs) < 2 {
+		return nil, TaskHostDN
+	}
+
+	var (
+		info   index.ClusteringInfo
+		picked []*catalog.ObjectEntry
+	)
+	if o.composite {
+		info = index.CalculateBoxClusteringInfo(o.boxes)
+	} else {
+		zms := make([]index.ZM, 0, len(o.objs))
+		for _, obj := range o.objs {
+			zms = append(zms, obj.GetSortKeyZonemap())
+		}
+		info = index.CalculateClusteringInfo(zms)
+	}
+	if info.AverageDepth <= float64(depth) {
+		return nil, TaskHostDN
+	}
+	if o.composite {
+		picked = o.maxOverlapBoxGroup()
+	} else {
+		picked = maxOverlapGroup(o.objs)
+	}
+
+	picked = limitMergeGroup(picked, o.config.MergeMaxOneRun, mem)
+	if len(picked) < 2 {
+		return nil, TaskHostDN
+	}
+	if cpu > 85 {
+		if osize, _, _ := estimateMergeConsume(picked); osize > 25*common.Const1MBytes {
+			logutil.Infof("mergeblocks skip big merge for high level cpu usage, %d", cpu)
+			return nil, TaskHostDN
+		}
+	}
+	logutil.Infof("mergeblocks recluster %d-%s, %s", o.id, o.schema.Name, info)
+	return picked, TaskHostDNRecluster
+}
+
+// maxOverlapBoxGroup returns the object overlapping with the most objects on
+// the columns of the composite key, followed by the objects overlapping with
+// it, those overlapping with more objects first.
+func (o *Recluster) maxOverlapBoxGroup() []*catalog.ObjectEntry {
+	neighbors := make([][]int, len(o.boxes))
+	index.ForEachOverlappingBoxes(o.boxes, func(i, j int) {
+		neighbors[i] = append(neighbors[i], j)
+		neighbors[j] = append(neighbors[j], i)
+	})
+	best := 0
+	for i := range neighbors {
+		if len(neighbors[i]) > len(neighbors[best]) {
+			best = i
+		}
+	}
+	if len(neighbors[best]) == 0 {
+		return nil
+	}
+	group := neighbors[best]
+	sort.SliceStable(group, func(i, j int) bool {
+		return len(neighbors[group[i]]) > len(neighbors[group[j]])
+	})
+	objs := make([]*catalog.ObjectEntry, 0, len(group)+1)
+	objs = append(objs, o.objs[best])
+	for _, i := range group {
+		objs = append(objs, o.objs[i])
+	}
+	return objs
+}
+
+func (o *Recluster) ResetForTable(entry *catalog.TableEntry) {
+	o.id = entry.ID
+	o.schema = entry.GetLastestSchemaLocked()
+	o.objs = o.objs[:0]
+	o.boxes = o.boxes[:0]
+	o.composite = o.schema.HasCompositeClusterBy()
+	o.seqnums = o.seqnums[:0]
+	if o.composite {
+		for _, idx := range o.schema.GetClusterByColIdxes() {
+			o.seqnums = append(o.seqnums, o.schema.ColDefs[idx].SeqNum)
+		}
+	}
+}
+
+func (o *Recluster) resetForTable(entry *catalog.TableEntry, config *BasicPolicyConfig) {
+	o.config = config
+	o.ResetForTable(entry)
+}
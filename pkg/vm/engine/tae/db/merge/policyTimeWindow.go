@@ -0,0 +1,194 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+var _ Policy = (*TimeWindow)(nil)
+
+// windowUnknown holds the objects without any time, e.g. the time column is
+// all null or added after the object was written
+const windowUnknown = math.MinInt64
+
+// TimeWindow merges the objects of append-only time series tables.
+//
+// An object belongs to the window holding the max time of the time column of
+// its rows, and objects are merged only with objects of the same window, so
+// a merge never mixes old and new rows. A window is sealed once its end is
+// older than SealAfter, and the objects of sealed windows are not merged any
+// more, except being dropped by the table TTL.
+type TimeWindow struct {
+	id     uint64
+	schema *catalog.Schema
+	config *BasicPolicyConfig
+	now    time.Time
+
+	// time column
+	colIdx int
+	seqnum uint16
+
+	ttl        ttlChecker
+	ttlExpired []*catalog.ObjectEntry
+	windows    map[int64][]*catalog.ObjectEntry
+
+	// get the zonemap of the time column, replaced in tests
+	zonemapFn func(obj *catalog.ObjectEntry, seqnum uint16) (objectio.ZoneMap, error)
+}
+
+func NewTimeWindowPolicy() *TimeWindow {
+	return &TimeWindow{
+		windows:   make(map[int64][]*catalog.ObjectEntry),
+		zonemapFn: loadColumnZoneMap,
+	}
+}
+
+func loadColumnZoneMap(obj *catalog.ObjectEntry, seqnum uint16) (objectio.ZoneMap, error) {
+	meta, err := loadColumnMeta(obj, seqnum)
+	if err != nil || meta == nil {
+		return nil, err
+	}
+	return meta.ZoneMap(), nil
+}
+
+// impl Policy for TimeWindow
+func (o *TimeWindow) OnObject(obj *catalog.ObjectEntry) {
+	if o.ttl.expired(obj) {
+		o.ttlExpired = append(o.ttlExpired, obj)
+		return
+	}
+	// the object is big enough and has few deletes
+	if obj.GetOriginSize() > o.config.targetObjectSize() &&
+		obj.GetRemainingRows() >= obj.GetRows()/2 {
+		return
+	}
+
+	window := int64(windowUnknown)
+	zm, err := o.timeZonemap(obj)
+	if err != nil {
+		logutil.Warnf("mergeblocks %d-%s load time zonemap: %v", o.id, o.schema.Name, err)
+		return
+	}
+	if max, ok := pkgcatalog.ZoneMapMaxTime(zm); ok {
+		window = max.Unix() / int64(o.config.TimeWindow/time.Second)
+		if o.sealed(window) {
+			return
+		}
+	}
+	o.windows[window] = append(o.windows[window], obj)
+}
+
+func (o *TimeWindow) timeZonemap(obj *catalog.ObjectEntry) (objectio.ZoneMap, error) {
+	// the time column has been dropped
+	if o.colIdx < 0 {
+		return nil, nil
+	}
+	if o.schema.HasSortKey() && o.schema.GetSingleSortKeyIdx() == o.colIdx {
+		return obj.GetSortKeyZonemap(), nil
+	}
+	return o.zonemapFn(obj, o.seqnum)
+}
+
+// sealed reports whether the window ended SealAfter ago
+func (o *TimeWindow) sealed(window int64) bool {
+	if o.config.SealAfter == 0 {
+		return false
+	}
+	end := time.Unix((window+1)*int64(o.config.TimeWindow/time.Second), 0)
+	return end.Add(o.config.SealAfter).Before(o.now)
+}
+
+// The config is kept by the basic policy
+func (o *TimeWindow) SetConfig(*catalog.TableEntry, func() txnif.AsyncTxn, any) {}
+func (o *TimeWindow) GetConfig(*catalog.TableEntry) any                         { return o.config }
+
+func (o *TimeWindow) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	if len(o.ttlExpired) > 0 {
+		objs := o.ttlExpired
+		if len(objs) > o.config.MergeMaxOneRun {
+			objs = objs[:o.config.MergeMaxOneRun]
+		}
+		return objs, TaskHostDN
+	}
+
+	// merge the window with the most objects, the oldest one for a tie
+	var (
+		picked []*catalog.ObjectEntry
+		pw     int64
+	)
+	for w, objs := range o.windows {
+		if len(objs) > len(picked) || len(objs) == len(picked) && w < pw {
+			picked, pw = objs, w
+		}
+	}
+	if len(picked) < 2 {
+		return nil, TaskHostDN
+	}
+
+	sort.Slice(picked, func(i, j int) bool {
+		return picked[i].GetOriginSize() < picked[j].GetOriginSize()
+	})
+	if len(picked) > o.config.MergeMaxOneRun {
+		picked = picked[:o.config.MergeMaxOneRun]
+	}
+	if mem > constMaxMemCap {
+		mem = constMaxMemCap
+	}
+	for len(picked) > 1 {
+		osize, esize, _ := estimateMergeConsume(picked)
+		if esize <= int(2*mem/3) && (len(picked) <= 2 || osize <= o.config.targetObjectSize()) {
+			break
+		}
+		picked = picked[:len(picked)-1]
+	}
+	if cpu > 85 && len(picked) > 1 {
+		if osize, _, _ := estimateMergeConsume(picked); osize > 25*common.Const1MBytes {
+			logutil.Infof("mergeblocks skip big merge for high level cpu usage, %d", cpu)
+			return nil, TaskHostDN
+		}
+	}
+	return picked, TaskHostDN
+}
+
+// ResetForTable resets the policy for a table with the time window config
+func (o *TimeWindow) ResetForTable(entry *catalog.TableEntry) {
+	o.id = entry.ID
+	o.schema = entry.GetLastestSchemaLocked()
+	o.now = time.Now()
+	o.ttl = newTTLChecker(o.schema, o.now)
+	o.ttlExpired = nil
+	o.windows = make(map[int64][]*catalog.ObjectEntry)
+	if idx, ok := o.schema.NameMap[o.config.TimeColumn]; ok {
+		o.colIdx = idx
+		o.seqnum = o.schema.ColDefs[idx].SeqNum
+	} else {
+		o.colIdx = -1
+	}
+}
+
+func (o *TimeWindow) resetForTable(entry *catalog.TableEntry, config *BasicPolicyConfig) {
+	o.config = config
+	o.ResetForTable(entry)
+}
@@ -16,7 +16,14 @@ package merge
 
 import (
 	"container/heap"
+	"context"
+	"sort"
+	"sync"
+	"time"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
 
@@ -107,3 +114,170 @@ func estimateMergeConsume(mobjs []*catalog.ObjectEntry) (origSize, estSize, comp
 	compSize = int(float64(compSize) * factor)
 	return
 }
+
+// objectLevel returns the level of an object in the tiered merge. Objects of
+// the target size are at maxLevel, and the objects of every lower level are
+// constLevelFanout times smaller than the objects of the level above.
+func objectLevel(size, target, maxLevel int) int {
+	level := maxLevel
+	for level > 0 && size < target {
+		target /= constLevelFanout
+		level--
+	}
+	return level
+}
+
+// pickLevel keeps the objects of the lowest level having more than one object,
+// so an object is merged only with objects of similar size, and it is
+// rewritten at most maxLevel times before reaching the target size.
+func pickLevel(objs []*catalog.ObjectEntry, target, maxLevel int) []*catalog.ObjectEntry {
+	levels := make([][]*catalog.ObjectEntry, maxLevel+1)
+	for _, obj := range objs {
+		level := objectLevel(obj.GetOriginSize(), target, maxLevel)
+		levels[level] = append(levels[level], obj)
+	}
+	for _, level := range levels {
+		if len(level) > 1 {
+			return level
+		}
+	}
+	return nil
+}
+
+// maxOverlapGroup returns the largest group of objects overlapping on the
+// sort key, sorted by the min of the sort key. Objects only touching on a
+// boundary value do not overlap, so the objects written by a merge never
+// form a group again.
+func maxOverlapGroup(objs []*catalog.ObjectEntry) []*catalog.ObjectEntry {
+	sort.Slice(objs, func(i, j int) bool {
+		return objs[i].GetSortKeyZonemap().CompareMin(objs[j].GetSortKeyZonemap()) < 0
+	})
+	var best []*catalog.ObjectEntry
+	start := 0
+	// the object with the max sort key of the current group
+	var maxObj *catalog.ObjectEntry
+	for i, obj := range objs {
+		zm := obj.GetSortKeyZonemap()
+		if maxObj != nil {
+			if overlap, ok := zm.AnyLT(maxObj.GetSortKeyZonemap()); !ok || !overlap {
+				if i-start > len(best) && i-start > 1 {
+					best = objs[start:i]
+				}
+				start, maxObj = i, nil
+			}
+		}
+		if maxObj == nil || zm.CompareMax(maxObj.GetSortKeyZonemap()) > 0 {
+			maxObj = obj
+		}
+	}
+	if len(objs)-start > len(best) && len(objs)-start > 1 {
+		best = objs[start:]
+	}
+	return best
+}
+
+// limitMergeGroup keeps at most maxOneRun objects of the group to merge, as
+// many as the memory allows.
+func limitMergeGroup(objs []*catalog.ObjectEntry, maxOneRun int, mem int64) []*catalog.ObjectEntry {
+	if len(objs) > maxOneRun {
+		objs = objs[:maxOneRun]
+	}
+	// the buffer is reused by the next table
+	objs = append([]*catalog.ObjectEntry(nil), objs...)
+	if mem > constMaxMemCap {
+		mem = constMaxMemCap
+	}
+	for len(objs) > 1 {
+		if _, esize, _ := estimateMergeConsume(objs); esize <= int(2*mem/3) {
+			break
+		}
+		objs = objs[:len(objs)-1]
+	}
+	return objs
+}
+
+// ttlChecker finds out objects whose rows are all expired by the table TTL.
+// Such objects are dropped whole by a merge, without rewriting any row.
+type ttlChecker struct {
+	enabled bool
+	seqnum  uint16
+	cutoff  time.Time
+}
+
+func newTTLChecker(schema *catalog.Schema, now time.Time) ttlChecker {
+	ttl, colIdx, ok := schema.TTL()
+	if !ok {
+		return ttlChecker{}
+	}
+	return ttlChecker{
+		enabled: true,
+		seqnum:  schema.ColDefs[colIdx].SeqNum,
+		cutoff:  ttl.Cutoff(now),
+	}
+}
+
+func (c ttlChecker) expired(obj *catalog.ObjectEntry) bool {
+	if !c.enabled {
+		return false
+	}
+	meta, err := loadColumnMeta(obj, c.seqnum)
+	if err != nil || meta == nil || meta.NullCnt() != 0 {
+		return false
+	}
+	return pkgcatalog.ZoneMapExpired(meta.ZoneMap(), c.cutoff)
+}
+
+const (
+	// columnMetaCacheSize bounds the number of column metas cached.
+	columnMetaCacheSize = 1 << 16
+	// columnMetaLoadTimeout bounds the time to load the meta of an object.
+	columnMetaLoadTimeout = 10 * time.Second
+)
+
+type columnMetaKey struct {
+	obj    types.Objectid
+	seqnum uint16
+}
+
+// columnMetas caches the column metas the policies judge the objects by.
+// The policies look at every object of a table on every scheduling pass,
+// and the meta of a persisted object never changes.
+var columnMetas = struct {
+	sync.Mutex
+	m map[columnMetaKey]objectio.ColumnMeta
+}{m: make(map[columnMetaKey]objectio.ColumnMeta)}
+
+// loadColumnMeta returns the object meta of the column with seqnum, nil if the
+// object was written before the column was added.
+func loadColumnMeta(obj *catalog.ObjectEntry, seqnum uint16) (objectio.ColumnMeta, error) {
+	key := columnMetaKey{obj: obj.ID, seqnum: seqnum}
+	columnMetas.Lock()
+	meta, ok := columnMetas.m[key]
+	columnMetas.Unlock()
+	if ok {
+		return meta, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), columnMetaLoadTimeout)
+	defer cancel()
+	meta, ok, err := obj.LoadColumnMeta(ctx, obj.GetObjectData().GetFs().Service, seqnum)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// not to hold the buffer of the whole object meta
+		meta = append(objectio.ColumnMeta(nil), meta...)
+	} else {
+		meta = nil
+	}
+
+	columnMetas.Lock()
+	defer columnMetas.Unlock()
+	if len(columnMetas.m) >= columnMetaCacheSize {
+		// the metas of the dropped objects are never asked for again, start
+		// over rather than tracking the recent ones
+		columnMetas.m = make(map[columnMetaKey]objectio.ColumnMeta)
+	}
+	columnMetas.m[key] = meta
+	return meta, nil
+}
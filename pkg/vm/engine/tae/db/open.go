@@ -33,6 +33,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/dbutils"
 	gc2 "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/merge"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/scrub"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/gc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
@@ -206,9 +207,14 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 		})
 	db.DiskCleaner = gc2.NewDiskCleaner(cleaner)
 	db.DiskCleaner.Start()
+
+	if opts.ScrubCfg.Interval > 0 {
+		db.Scrubber = scrub.NewScrubber(db.Catalog, fs.Service, opts.ScrubCfg, scrub.DefaultReport, scrub.NewTableHistory(db))
+		db.Scrubber.Start()
+	}
 	// Init gc manager at last
 	// TODO: clean-try-gc requires configuration parameters
-	db.GCManager = gc.NewManager(
+	gcOptions := []gc.Option{
 		gc.WithCronJob(
 			"clean-transfer-table",
 			opts.CheckpointCfg.FlushInterval,
@@ -267,7 +273,17 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 				return nil
 			},
 		),
-	)
+	}
+	if tiered := fs.Tiered(); tiered != nil && tiered.Policy().Interval > 0 {
+		gcOptions = append(gcOptions, gc.WithCronJob(
+			"tiering",
+			tiered.Policy().Interval,
+			func(ctx context.Context) error {
+				return db.DiskCleaner.GetCleaner().MoveColdObjects(ctx)
+			}),
+		)
+	}
+	db.GCManager = gc.NewManager(gcOptions...)
 
 	db.GCManager.Start()
 
@@ -52,7 +52,7 @@ func newMergeTaskBuiler(db *DB) *MergeTaskBuilder {
 	op := &MergeTaskBuilder{
 		db:            db,
 		LoopProcessor: new(catalog.LoopProcessor),
-		objPolicy:     merge.NewBasicPolicy(),
+		objPolicy:     merge.NewPolicyGroup(),
 		executor:      merge.NewMergeExecutor(db.Runtime, db.CNMergeSched),
 	}
 
@@ -0,0 +1,114 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"context"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// History keeps the corruptions beyond the Report, which is lost on restart
+// and drops the old records
+type History interface {
+	Save(ctx context.Context, c Corruption) error
+}
+
+type txnStarter interface {
+	StartTxn(info []byte) (txnif.AsyncTxn, error)
+}
+
+// tableHistory saves the corruptions in mo_catalog.mo_scrub_history of the
+// sys account, a row per object
+type tableHistory struct {
+	db txnStarter
+}
+
+// NewTableHistory returns the History saving the corruptions in the
+// mo_scrub_history table by the txns of db
+func NewTableHistory(db txnStarter) History {
+	return &tableHistory{db: db}
+}
+
+// Save saves the corruption, the previous row of the same object is replaced
+func (h *tableHistory) Save(ctx context.Context, c Corruption) (err error) {
+	txn, err := h.db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = txn.Rollback(ctx)
+		}
+	}()
+	database, err := txn.GetDatabase(pkgcatalog.MO_CATALOG)
+	if err != nil {
+		return
+	}
+	rel, err := database.GetRelationByName(pkgcatalog.MO_SCRUB_HISTORY)
+	if err != nil {
+		return
+	}
+
+	id, row, err := rel.GetByFilter(ctx, handle.NewEQFilter([]byte(c.ObjectName)))
+	if err == nil {
+		err = rel.RangeDelete(id, row, row, handle.DT_Normal)
+	} else if moerr.IsMoErrCode(err, moerr.ErrNotFound) {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	schema := rel.Schema().(*catalog.Schema)
+	bat := containers.NewBatch()
+	defer bat.Close()
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		vec := containers.MakeVector(def.Type, common.DefaultAllocator)
+		switch def.Name {
+		case "object_name":
+			vec.Append([]byte(c.ObjectName), false)
+		case "db_id":
+			vec.Append(c.DBID, false)
+		case "table_id":
+			vec.Append(c.TableID, false)
+		case "error":
+			vec.Append([]byte(c.Error), false)
+		case "detected_at":
+			vec.Append(types.UnixNanoToTimestamp(c.DetectedAt.UnixNano()), false)
+		case "repaired":
+			vec.Append(c.Repaired, false)
+		case "repair_error":
+			vec.Append([]byte(c.RepairError), false)
+		default:
+			vec.Append(nil, true)
+		}
+		bat.AddVector(def.Name, vec)
+	}
+	if err = rel.Append(ctx, bat); err != nil {
+		return
+	}
+	return txn.Commit(ctx)
+}
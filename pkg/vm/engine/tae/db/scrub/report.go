@@ -0,0 +1,77 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultMaxRecords = 1024
+
+// DefaultReport collects the corruptions found by the scrubbers of the process,
+// it is exposed by the mo_scrub system view. The corruptions are also saved
+// in mo_catalog.mo_scrub_history, which outlives the process.
+var DefaultReport = NewReport(defaultMaxRecords)
+
+// Corruption is a corrupted object found by the scrubber
+type Corruption struct {
+	ObjectName string
+	DBID       uint64
+	TableID    uint64
+	Error      string
+	DetectedAt time.Time
+	// Repaired is true if the object is restored from the replica
+	Repaired    bool
+	RepairError string
+}
+
+// Report keeps the latest corruptions of at most maxRecords objects
+type Report struct {
+	sync.Mutex
+	maxRecords int
+	records    []Corruption
+}
+
+func NewReport(maxRecords int) *Report {
+	return &Report{
+		maxRecords: maxRecords,
+	}
+}
+
+// Add adds the corruption, the previous record of the same object is replaced
+func (r *Report) Add(c Corruption) {
+	r.Lock()
+	defer r.Unlock()
+	for i := range r.records {
+		if r.records[i].ObjectName == c.ObjectName {
+			r.records = append(r.records[:i], r.records[i+1:]...)
+			break
+		}
+	}
+	if len(r.records) >= r.maxRecords {
+		r.records = r.records[len(r.records)-r.maxRecords+1:]
+	}
+	r.records = append(r.records, c)
+}
+
+// Records returns the corruptions from the oldest to the latest
+func (r *Report) Records() []Corruption {
+	r.Lock()
+	defer r.Unlock()
+	records := make([]Corruption, len(r.records))
+	copy(records, r.records)
+	return records
+}
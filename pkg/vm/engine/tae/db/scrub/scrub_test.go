@@ -0,0 +1,62 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	report := NewReport(3)
+	for i := 0; i < 5; i++ {
+		report.Add(Corruption{ObjectName: fmt.Sprintf("obj-%d", i)})
+	}
+	records := report.Records()
+	require.Equal(t, 3, len(records))
+	require.Equal(t, "obj-2", records[0].ObjectName)
+	require.Equal(t, "obj-4", records[2].ObjectName)
+
+	// the record of the same object is replaced
+	report.Add(Corruption{ObjectName: "obj-2", Repaired: true})
+	records = report.Records()
+	require.Equal(t, 3, len(records))
+	require.Equal(t, "obj-3", records[0].ObjectName)
+	require.Equal(t, "obj-2", records[2].ObjectName)
+	require.True(t, records[2].Repaired)
+}
+
+func TestThrottle(t *testing.T) {
+	ctx := context.Background()
+	th := newThrottle(1000)
+	th.reset()
+	start := time.Now()
+	require.NoError(t, th.wait(ctx, 100))
+	require.GreaterOrEqual(t, time.Since(start), time.Millisecond*90)
+	require.Equal(t, int64(100), th.bytes)
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, th.wait(ctx, 1000), context.Canceled)
+
+	// unlimited
+	th = newThrottle(0)
+	th.reset()
+	require.NoError(t, th.wait(ctx, 1<<30))
+}
@@ -0,0 +1,288 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+// Scrubber walks the catalog periodically and verifies every persisted object,
+// so that bit-rot in cold objects is found before queries read them.
+// Corruptions are reported to the Report, the History and the metrics, and
+// repaired from the replica file service if configured.
+type Scrubber struct {
+	catalog  *catalog.Catalog
+	fs       fileservice.FileService
+	repairFS fileservice.FileService
+	interval time.Duration
+	throttle *throttle
+	report   *Report
+	history  History
+
+	// scrubbing rounds are serialized
+	sync.Mutex
+	stopper   *stopper.Stopper
+	onceStart sync.Once
+	onceStop  sync.Once
+}
+
+// Round is the result of a round of scrubbing
+type Round struct {
+	Verified int
+	// Skipped objects are not verified because of errors other than corruption
+	Skipped   int
+	Corrupted int
+	Repaired  int
+	Bytes     int64
+}
+
+type object struct {
+	stats   objectio.ObjectStats
+	dbID    uint64
+	tableID uint64
+}
+
+func NewScrubber(
+	c *catalog.Catalog,
+	fs fileservice.FileService,
+	cfg *options.ScrubCfg,
+	report *Report,
+	history History,
+) *Scrubber {
+	return &Scrubber{
+		catalog:  c,
+		fs:       fs,
+		repairFS: cfg.RepairFS,
+		interval: cfg.Interval,
+		throttle: newThrottle(cfg.BytesPerSecond),
+		report:   report,
+		history:  history,
+		stopper:  stopper.NewStopper("scrubber"),
+	}
+}
+
+func (s *Scrubber) Start() {
+	s.onceStart.Do(func() {
+		if err := s.stopper.RunNamedTask("scrub-loop", s.loop); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func (s *Scrubber) Stop() {
+	s.onceStop.Do(func() {
+		s.stopper.Stop()
+	})
+}
+
+func (s *Scrubber) loop(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Scrub(ctx); err != nil && ctx.Err() == nil {
+				logutil.Error("[Scrubber]",
+					common.OperationField("Scrub"),
+					common.ErrorField(err))
+			}
+		}
+	}
+}
+
+// Scrub verifies every persisted object not dropped yet
+func (s *Scrubber) Scrub(ctx context.Context) (round Round, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	start := time.Now()
+	objects := s.collect()
+	s.throttle.reset()
+	for _, obj := range objects {
+		if err = s.scrubObject(ctx, obj, &round); err != nil {
+			break
+		}
+	}
+	round.Bytes = s.throttle.bytes
+	v2.TaskScrubBytesCounter.Add(float64(round.Bytes))
+	logutil.Info("[Scrubber]",
+		common.OperationField("Scrub"),
+		common.AnyField("objects", len(objects)),
+		common.AnyField("verified", round.Verified),
+		common.AnyField("skipped", round.Skipped),
+		common.AnyField("corrupted", round.Corrupted),
+		common.AnyField("repaired", round.Repaired),
+		common.AnyField("bytes", round.Bytes),
+		common.AnyField("cost", time.Since(start)),
+		common.ErrorField(err))
+	return
+}
+
+func (s *Scrubber) collect() (objects []object) {
+	processor := new(catalog.LoopProcessor)
+	processor.ObjectFn = func(entry *catalog.ObjectEntry) error {
+		if !entry.HasPersistedData() || entry.HasDropCommitted() {
+			return nil
+		}
+		table := entry.GetTable()
+		objects = append(objects, object{
+			stats:   entry.GetObjectStats(),
+			dbID:    table.GetDB().ID,
+			tableID: table.ID,
+		})
+		return nil
+	}
+	if err := s.catalog.RecurLoop(processor); err != nil {
+		logutil.Error("[Scrubber]",
+			common.OperationField("Collect"),
+			common.ErrorField(err))
+	}
+	return
+}
+
+// scrubObject verifies the object and repairs it if it is corrupted,
+// objects dropped meanwhile are ignored. Only context errors are returned.
+func (s *Scrubber) scrubObject(ctx context.Context, obj object, round *Round) error {
+	if obj.stats.IsZero() {
+		return nil
+	}
+	err := objectio.VerifyObject(ctx, s.fs, &obj.stats, s.throttle.wait)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if err == nil {
+		round.Verified++
+		v2.TaskScrubVerifiedCounter.Inc()
+		return nil
+	}
+	if !moerr.IsMoErrCode(err, moerr.ErrDataCorrupted) {
+		round.Skipped++
+		logutil.Warn("[Scrubber]",
+			common.OperationField("Verify"),
+			common.AnyField("object", obj.stats.ObjectName().String()),
+			common.ErrorField(err))
+		return nil
+	}
+	if s.dropped(obj) {
+		return nil
+	}
+	round.Verified++
+	round.Corrupted++
+	v2.TaskScrubCorruptedCounter.Inc()
+	corruption := Corruption{
+		ObjectName: obj.stats.ObjectName().String(),
+		DBID:       obj.dbID,
+		TableID:    obj.tableID,
+		Error:      err.Error(),
+		DetectedAt: time.Now(),
+	}
+
+	if s.repairFS != nil {
+		err = s.repair(ctx, obj)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			corruption.RepairError = err.Error()
+			v2.TaskScrubRepairFailedCounter.Inc()
+		} else {
+			round.Repaired++
+			corruption.Repaired = true
+			v2.TaskScrubRepairedCounter.Inc()
+		}
+	}
+	logutil.Error("[Scrubber]",
+		common.OperationField("Corrupted"),
+		common.AnyField("object", corruption.ObjectName),
+		common.AnyField("db", corruption.DBID),
+		common.AnyField("table", corruption.TableID),
+		common.AnyField("error", corruption.Error),
+		common.AnyField("repaired", corruption.Repaired),
+		common.AnyField("repair-error", corruption.RepairError))
+	s.report.Add(corruption)
+	if s.history != nil {
+		if err = s.history.Save(ctx, corruption); err != nil {
+			logutil.Error("[Scrubber]",
+				common.OperationField("SaveHistory"),
+				common.AnyField("object", corruption.ObjectName),
+				common.ErrorField(err))
+		}
+	}
+	return nil
+}
+
+// dropped returns true if the object is gone since it was collected,
+// the verification fails for objects deleted by GC concurrently
+func (s *Scrubber) dropped(obj object) bool {
+	db, err := s.catalog.GetDatabaseByID(obj.dbID)
+	if err != nil {
+		return true
+	}
+	table, err := db.GetTableEntryByID(obj.tableID)
+	if err != nil {
+		return true
+	}
+	entry, err := table.GetObjectByID(obj.stats.ObjectName().ObjectId())
+	if err != nil {
+		return true
+	}
+	return entry.HasDropCommitted()
+}
+
+// repair replaces the object with the replica after verifying the replica
+func (s *Scrubber) repair(ctx context.Context, obj object) error {
+	if err := objectio.VerifyObject(ctx, s.repairFS, &obj.stats, s.throttle.wait); err != nil {
+		return err
+	}
+	name := obj.stats.ObjectName().String()
+	vec := &fileservice.IOVector{
+		FilePath: name,
+		Entries:  []fileservice.IOEntry{{Size: -1}},
+		Policy:   fileservice.SkipAllCache,
+	}
+	if err := s.repairFS.Read(ctx, vec); err != nil {
+		return err
+	}
+	data := vec.Entries[0].Data
+
+	// the file service is write-once, and deleting also evicts the cached contents
+	if err := s.fs.Delete(ctx, name); err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
+	if err := s.fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{{
+			Size: int64(len(data)),
+			Data: data,
+		}},
+	}); err != nil {
+		return err
+	}
+	return objectio.VerifyObject(ctx, s.fs, &obj.stats, s.throttle.wait)
+}
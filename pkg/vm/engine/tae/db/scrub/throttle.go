@@ -0,0 +1,60 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"context"
+	"time"
+)
+
+// throttle limits the average read rate of a round of scrubbing,
+// it is not safe for concurrent use
+type throttle struct {
+	bytesPerSecond int64
+	start          time.Time
+	bytes          int64
+}
+
+func newThrottle(bytesPerSecond int64) *throttle {
+	return &throttle{
+		bytesPerSecond: bytesPerSecond,
+	}
+}
+
+func (t *throttle) reset() {
+	t.start = time.Now()
+	t.bytes = 0
+}
+
+// wait blocks until reading n more bytes does not exceed the rate
+func (t *throttle) wait(ctx context.Context, n int64) error {
+	t.bytes += n
+	if t.bytesPerSecond <= 0 {
+		return nil
+	}
+	expected := time.Duration(float64(t.bytes) / float64(t.bytesPerSecond) * float64(time.Second))
+	d := expected - time.Since(t.start)
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
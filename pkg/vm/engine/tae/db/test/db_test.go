@@ -19,6 +19,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
+	"path"
 	"reflect"
 	"strings"
 	"sync"
@@ -26,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 
 	"sort"
@@ -34,15 +37,19 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/dbutils"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/merge"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/scrub"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
@@ -60,6 +67,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
@@ -2596,6 +2604,450 @@ func TestMergeblocks2(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestMergeDropsExpiredRows(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.NewEmptySchema("ttl")
+	require.NoError(t, schema.AppendPKCol("id", types.T_int32.ToType(), 0))
+	require.NoError(t, schema.AppendCol("ts", types.T_datetime.ToType()))
+	constraintDef := &engine.ConstraintDef{Cts: []engine.Constraint{
+		&engine.PrimaryKeyDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: "id", Names: []string{"id"}}},
+		&engine.StreamConfigsDef{Configs: []*plan.Property{{Key: pkgcatalog.PropTTL, Value: "ts,1,day"}}},
+	}}
+	schema.Constraint, _ = constraintDef.MarshalBinary()
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	require.NoError(t, schema.Finalize(false))
+	tae.BindSchema(schema)
+
+	old := types.DatetimeFromUnix(time.UTC, time.Now().AddDate(0, 0, -2).Unix())
+	fresh := types.DatetimeFromUnix(time.UTC, time.Now().Unix())
+	mockBatch := func(start int, expired func(i int) bool) *containers.Batch {
+		bat := containers.BuildBatch(schema.AllNames(), schema.AllTypes(), containers.Options{})
+		for i := 0; i < 10; i++ {
+			bat.Vecs[0].Append(int32(start+i), false)
+			if expired(i) {
+				bat.Vecs[1].Append(old, false)
+			} else {
+				bat.Vecs[1].Append(fresh, false)
+			}
+		}
+		return bat
+	}
+	flush := func() {
+		txn, rel := tae.GetRelation()
+		task, err := jobs.NewFlushTableTailTask(tasks.WaitableCtx, txn, testutil.GetAllBlockMetas(rel), tae.DB.Runtime, types.MaxTs())
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec(ctx))
+		require.NoError(t, txn.Commit(ctx))
+	}
+	merge := func(pick func(id int32) bool) (objCnt int) {
+		txn, rel := tae.GetRelation()
+		var objs []*catalog.ObjectEntry
+		it := rel.MakeObjectIt()
+		for ; it.Valid(); it.Next() {
+			obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+			if !obj.IsAppendable() {
+				objs = append(objs, obj)
+			}
+		}
+		var picked []*catalog.ObjectEntry
+		for _, obj := range objs {
+			if pick(types.DecodeInt32(obj.GetSortKeyZonemap().GetMinBuf())) {
+				picked = append(picked, obj)
+			}
+		}
+		require.Equal(t, 1, len(picked))
+		task, err := jobs.NewMergeObjectsTask(nil, txn, picked, tae.Runtime)
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec(ctx))
+		require.NoError(t, txn.Commit(ctx))
+		return len(objs)
+	}
+
+	// the first object mixes expired and live rows, the second is fully expired
+	bat := mockBatch(0, func(i int) bool { return i%2 == 0 })
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	flush()
+	bat2 := mockBatch(10, func(int) bool { return true })
+	defer bat2.Close()
+	tae.DoAppend(bat2)
+	flush()
+
+	_, rel := tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, 20, true)
+
+	require.Equal(t, 2, merge(func(id int32) bool { return id >= 10 }))
+	_, rel = tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, 10, true)
+
+	// the fully expired object is dropped without creating a new one
+	require.Equal(t, 1, merge(func(id int32) bool { return id < 10 }))
+	_, rel = tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, 5, true)
+}
+
+func TestMergePolicyTimeWindow(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.Name = "metrics"
+	schema.ColDefs[1].Name = "ts"
+	schema.ColDefs[1].Type = types.T_timestamp.ToType()
+	delete(schema.NameMap, "mock_1")
+	schema.NameMap["ts"] = 1
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+
+	now := time.Now()
+	mockBatch := func(start int, at time.Time) *containers.Batch {
+		bat := catalog.MockBatch(schema, 10)
+		for i := 0; i < 10; i++ {
+			bat.Vecs[2].Update(i, int32(start+i), false)
+			bat.Vecs[1].Update(i, types.UnixToTimestamp(at.Unix()), false)
+		}
+		return bat
+	}
+	flush := func() {
+		txn, rel := tae.GetRelation()
+		task, err := jobs.NewFlushTableTailTask(tasks.WaitableCtx, txn, testutil.GetAllBlockMetas(rel), tae.DB.Runtime, types.MaxTs())
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec(ctx))
+		require.NoError(t, txn.Commit(ctx))
+	}
+
+	// two objects of a window sealed long ago, and two objects of today
+	for i, at := range []time.Time{now.AddDate(0, 0, -30), now.AddDate(0, 0, -30), now, now} {
+		bat := mockBatch(i*10, at)
+		if i == 0 {
+			tae.CreateRelAndAppend(bat, true)
+		} else {
+			tae.DoAppend(bat)
+		}
+		bat.Close()
+		flush()
+	}
+
+	txn, rel := tae.GetRelation()
+	require.NoError(t, rel.AlterTable(ctx, merge.NewUpdatePolicyReq(&merge.BasicPolicyConfig{
+		TimeColumn: "ts",
+		TimeWindow: 24 * time.Hour,
+		SealAfter:  7 * 24 * time.Hour,
+	})))
+	require.NoError(t, txn.Commit(ctx))
+
+	revise := func() []*catalog.ObjectEntry {
+		_, rel := tae.GetRelation()
+		policy := merge.NewPolicyGroup()
+		policy.ResetForTable(rel.GetMeta().(*catalog.TableEntry))
+		it := rel.MakeObjectIt()
+		for ; it.Valid(); it.Next() {
+			obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+			if obj.IsAppendable() {
+				continue
+			}
+			rows, err := obj.GetObjectData().Rows()
+			require.NoError(t, err)
+			obj.SetRemainingRows(rows)
+			policy.OnObject(obj)
+		}
+		objs, _ := policy.Revise(0, 1<<32)
+		return objs
+	}
+
+	// only the objects of today are merged, the sealed window is left alone
+	objs := revise()
+	require.Equal(t, 2, len(objs))
+	for _, obj := range objs {
+		require.GreaterOrEqual(t, types.DecodeInt32(obj.GetSortKeyZonemap().GetMinBuf()), int32(20))
+	}
+
+	txn, rel = tae.GetRelation()
+	require.NoError(t, rel.AlterTable(ctx, merge.NewUpdatePolicyReq(&merge.BasicPolicyConfig{
+		TimeColumn: "ts",
+		TimeWindow: 24 * time.Hour,
+		SealAfter:  60 * 24 * time.Hour,
+	})))
+	require.NoError(t, txn.Commit(ctx))
+
+	// the old window is not sealed yet, it is picked as the oldest window
+	objs = revise()
+	require.Equal(t, 2, len(objs))
+	for _, obj := range objs {
+		require.Less(t, types.DecodeInt32(obj.GetSortKeyZonemap().GetMinBuf()), int32(20))
+	}
+}
+
+func TestMergePolicyReorganize(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+
+	flush := func() {
+		txn, rel := tae.GetRelation()
+		task, err := jobs.NewFlushTableTailTask(tasks.WaitableCtx, txn, testutil.GetAllBlockMetas(rel), tae.DB.Runtime, types.MaxTs())
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec(ctx))
+		require.NoError(t, txn.Commit(ctx))
+	}
+	// the sort key of the first two objects overlaps, the third one is apart
+	for i, keys := range [][]int32{
+		{0, 2, 4, 6, 8, 10, 12, 14, 16, 18},
+		{1, 3, 5, 7, 9, 11, 13, 15, 17, 19},
+		{20, 21, 22, 23, 24, 25, 26, 27, 28, 29},
+	} {
+		bat := catalog.MockBatch(schema, 10)
+		for j, key := range keys {
+			bat.Vecs[2].Update(j, key, false)
+		}
+		if i == 0 {
+			tae.CreateRelAndAppend(bat, true)
+		} else {
+			tae.DoAppend(bat)
+		}
+		bat.Close()
+		flush()
+	}
+
+	txn, rel := tae.GetRelation()
+	require.NoError(t, rel.AlterTable(ctx, merge.NewUpdatePolicyReq(&merge.BasicPolicyConfig{
+		Reorganize: true,
+	})))
+	require.NoError(t, txn.Commit(ctx))
+
+	revise := func() []*catalog.ObjectEntry {
+		_, rel := tae.GetRelation()
+		policy := merge.NewPolicyGroup()
+		policy.ResetForTable(rel.GetMeta().(*catalog.TableEntry))
+		it := rel.MakeObjectIt()
+		for ; it.Valid(); it.Next() {
+			obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+			if obj.IsAppendable() {
+				continue
+			}
+			rows, err := obj.GetObjectData().Rows()
+			require.NoError(t, err)
+			obj.SetRemainingRows(rows)
+			policy.OnObject(obj)
+		}
+		objs, _ := policy.Revise(0, 1<<32)
+		return objs
+	}
+
+	objs := revise()
+	require.Equal(t, 2, len(objs))
+
+	// after merging, the sorted objects do not overlap any more
+	txn, _ = tae.GetRelation()
+	task, err := jobs.NewMergeObjectsTask(nil, txn, objs, tae.Runtime)
+	require.NoError(t, err)
+	require.NoError(t, task.OnExec(ctx))
+	require.NoError(t, txn.Commit(ctx))
+	require.Equal(t, 0, len(revise()))
+
+	_, rel = tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, 30, true)
+}
+
+func TestReclusterZOrder(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	// cluster by (a, b)
+	schema := catalog.NewEmptySchema("zorder")
+	require.NoError(t, schema.AppendCol("a", types.T_int32.ToType()))
+	require.NoError(t, schema.AppendCol("b", types.T_int32.ToType()))
+	require.NoError(t, schema.AppendSortKey(pkgcatalog.PrefixCBColName+"001a001b", types.T_varchar.ToType(), 0, false))
+	schema.BlockMaxRows = 16
+	schema.ObjectMaxBlocks = 4
+	require.NoError(t, schema.Finalize(false))
+	require.True(t, schema.HasCompositeClusterBy())
+	require.Equal(t, []int{0, 1}, schema.GetClusterByColIdxes())
+	tae.BindSchema(schema)
+
+	flush := func() {
+		txn, rel := tae.GetRelation()
+		task, err := jobs.NewFlushTableTailTask(tasks.WaitableCtx, txn, testutil.GetAllBlockMetas(rel), tae.DB.Runtime, types.MaxTs())
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec(ctx))
+		require.NoError(t, txn.Commit(ctx))
+	}
+	// every object holds rows spread over the whole 8x8 grid of (a, b)
+	packer := types.NewPacker(common.DefaultAllocator)
+	defer packer.FreeMem()
+	for i := 0; i < 4; i++ {
+		bat := containers.BuildBatch(schema.AllNames(), schema.AllTypes(), containers.Options{})
+		for k := i; k < 64; k += 4 {
+			a, b := int32(k/8), int32(k%8)
+			packer.Reset()
+			packer.EncodeInt32(a)
+			packer.EncodeInt32(b)
+			bat.Vecs[0].Append(a, false)
+			bat.Vecs[1].Append(b, false)
+			bat.Vecs[2].Append(append([]byte(nil), packer.Bytes()...), false)
+		}
+		if i == 0 {
+			tae.CreateRelAndAppend(bat, true)
+		} else {
+			tae.DoAppend(bat)
+		}
+		bat.Close()
+		flush()
+	}
+
+	// objects are big enough for the basic policy
+	txn, rel := tae.GetRelation()
+	require.NoError(t, rel.AlterTable(ctx, merge.NewUpdatePolicyReq(&merge.BasicPolicyConfig{
+		TargetObjectSize: 1,
+	})))
+	require.NoError(t, txn.Commit(ctx))
+
+	objects := func() []*catalog.ObjectEntry {
+		_, rel := tae.GetRelation()
+		var objs []*catalog.ObjectEntry
+		it := rel.MakeObjectIt()
+		for ; it.Valid(); it.Next() {
+			obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+			if obj.IsAppendable() {
+				continue
+			}
+			rows, err := obj.GetObjectData().Rows()
+			require.NoError(t, err)
+			obj.SetRemainingRows(rows)
+			objs = append(objs, obj)
+		}
+		return objs
+	}
+	revise := func() ([]*catalog.ObjectEntry, merge.TaskHostKind) {
+		_, rel := tae.GetRelation()
+		policy := merge.NewPolicyGroup()
+		policy.ResetForTable(rel.GetMeta().(*catalog.TableEntry))
+		for _, obj := range objects() {
+			policy.OnObject(obj)
+		}
+		return policy.Revise(0, 1<<32)
+	}
+	clustering := func() index.ClusteringInfo {
+		var boxes [][]index.ZM
+		for _, obj := range objects() {
+			var box []index.ZM
+			for _, idx := range []int{0, 1} {
+				zm, err := obj.GetColumnZoneMap(ctx, obj.GetObjectData().GetFs().Service, schema.ColDefs[idx].SeqNum)
+				require.NoError(t, err)
+				box = append(box, zm)
+			}
+			boxes = append(boxes, box)
+		}
+		return index.CalculateBoxClusteringInfo(boxes)
+	}
+	require.InDelta(t, 2.5, clustering().AverageDepth, 1e-9)
+
+	objs, kind := revise()
+	require.Equal(t, 4, len(objs))
+	require.Equal(t, merge.TaskHostDNRecluster, kind)
+	txn, _ = tae.GetRelation()
+	task, err := jobs.NewReclusterObjectsTask(nil, txn, objs, tae.Runtime)
+	require.NoError(t, err)
+	require.NoError(t, task.OnExec(ctx))
+	require.NoError(t, txn.Commit(ctx))
+
+	// the rows are in the Z-order of (a, b), every object is a quadrant
+	info := clustering()
+	require.Equal(t, 4, info.TotalObjects)
+	require.Equal(t, 0, info.OverlappingObjects)
+	objs, _ = revise()
+	require.Equal(t, 0, len(objs))
+
+	_, rel = tae.GetRelation()
+	testutil.CheckAllColRowsByScan(t, rel, 64, true)
+}
+
+func TestFlushLowCardinalityColumn(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.NewEmptySchema("lc")
+	require.NoError(t, schema.AppendPKCol("id", types.T_int32.ToType(), 0))
+	require.NoError(t, schema.AppendCol("tag", types.T_varchar.ToType()))
+	constraintDef := &engine.ConstraintDef{Cts: []engine.Constraint{
+		&engine.PrimaryKeyDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: "id", Names: []string{"id"}}},
+		&engine.StreamConfigsDef{Configs: []*plan.Property{
+			{Key: pkgcatalog.PropLowCardinality, Value: pkgcatalog.EncodeLowCardinalityColumns([]string{"tag"})},
+		}},
+	}}
+	schema.Constraint, _ = constraintDef.MarshalBinary()
+	schema.BlockMaxRows = 20
+	schema.ObjectMaxBlocks = 2
+	require.NoError(t, schema.Finalize(false))
+	require.Equal(t, []uint16{1}, schema.LowCardinalityColIdxes())
+	tae.BindSchema(schema)
+
+	bat := containers.BuildBatch(schema.AllNames(), schema.AllTypes(), containers.Options{})
+	defer bat.Close()
+	for i := 0; i < 20; i++ {
+		bat.Vecs[0].Append(int32(i), false)
+		bat.Vecs[1].Append([]byte(fmt.Sprintf("tag%d", i%3)), i == 7)
+	}
+	tae.CreateRelAndAppend(bat, true)
+
+	txn, rel := tae.GetRelation()
+	task, err := jobs.NewFlushTableTailTask(tasks.WaitableCtx, txn, testutil.GetAllBlockMetas(rel), tae.DB.Runtime, types.MaxTs())
+	require.NoError(t, err)
+	require.NoError(t, task.OnExec(ctx))
+	require.NoError(t, txn.Commit(ctx))
+
+	txn, rel = tae.GetRelation()
+	var obj handle.Object
+	it := rel.MakeObjectIt()
+	for ; it.Valid(); it.Next() {
+		if !it.GetObject().IsAppendable() {
+			obj = it.GetObject()
+		}
+	}
+	require.NotNil(t, obj)
+	view, err := obj.GetColumnDataById(ctx, 0, 1, common.DefaultAllocator)
+	require.NoError(t, err)
+	defer view.Close()
+	require.True(t, view.GetData().Equals(bat.Vecs[1]))
+	d := view.GetData().GetDownstreamVector().GetDict()
+	require.NotNil(t, d)
+	require.Equal(t, 3, d.Len())
+	require.NoError(t, txn.Commit(ctx))
+}
+
 func TestMergeEmptyBlocks(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	testutils.EnsureNoLeak(t)
@@ -4432,6 +4884,37 @@ func TestBlockRead(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(b5.Vecs))
 	assert.Equal(t, 16, b5.Vecs[0].Length())
+
+	// read the other columns of the rows passing a filter only
+	filterIdxs := []uint16{uint16(schema.GetSingleSortKeyIdx())}
+	filterTyps := []types.Type{schema.GetSingleSortKeyType()}
+	even := func(vecs []*vector.Vector) []int32 {
+		var sels []int32
+		for i := 0; i < vecs[0].Length(); i += 2 {
+			sels = append(sels, int32(i))
+		}
+		return sels
+	}
+	b6, err := blockio.BlockReadLate(
+		context.Background(), info, nil, colIdxs, colTyps,
+		afterSecondDel.ToTimestamp(), filterIdxs, filterTyps, even,
+		fs, pool, nil, fileservice.Policy(0),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, len(columns), len(b6.Vecs))
+	// rows 0 to 3 are deleted
+	assert.Equal(t, 8, b6.RowCount())
+	assert.Equal(t, 8, b6.Vecs[0].Length())
+
+	none := func([]*vector.Vector) []int32 { return nil }
+	b7, err := blockio.BlockReadLate(
+		context.Background(), info, nil, colIdxs, colTyps,
+		afterSecondDel.ToTimestamp(), filterIdxs, filterTyps, none,
+		fs, pool, nil, fileservice.Policy(0),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, len(columns), len(b7.Vecs))
+	assert.Equal(t, 0, b7.Vecs[0].Length())
 }
 
 func TestCompactDeltaBlk(t *testing.T) {
@@ -8557,3 +9040,203 @@ func TestSplitCommand(t *testing.T) {
 	t.Log(tae.Catalog.SimplePPString(3))
 	tae.CheckRowsByScan(50, false)
 }
+
+func TestMoveColdObjects(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	dir := testutils.InitTestEnv(ModuleName, t)
+	hot, err := fileservice.NewLocalFS(ctx, defines.LocalFileServiceName, path.Join(dir, "data"), fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	cold, err := fileservice.NewLocalFS(ctx, defines.LocalFileServiceName, path.Join(dir, "cold"), fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	// every object is older than the partition boundary
+	tiered, err := fileservice.NewTieredFS(hot, cold, fileservice.TieringPolicy{
+		ColdBefore: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	opts := config.WithQuickScanAndCKPAndGCOpts(nil)
+	opts.Fs = tiered
+	tae := testutil.NewTestEngineWithDir(ctx, dir, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 1)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 21)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	testutils.WaitExpect(10000, func() bool {
+		return tae.Runtime.Scheduler.GetPenddingLSNCnt() == 0
+	})
+	require.Equal(t, uint64(0), tae.Runtime.Scheduler.GetPenddingLSNCnt())
+
+	// wait gc inputs consuming the checkpoints
+	entries := tae.BGCheckpointRunner.GetAllIncrementalCheckpoints()
+	require.Greater(t, len(entries), 0)
+	end := entries[len(entries)-1].GetEnd()
+	testutils.WaitExpect(5000, func() bool {
+		_ = tae.DiskCleaner.GC(ctx)
+		maxConsumed := tae.DiskCleaner.GetCleaner().GetMaxConsumed()
+		if maxConsumed == nil {
+			return false
+		}
+		maxEnd := maxConsumed.GetEnd()
+		return maxEnd.GreaterEq(&end)
+	})
+
+	require.NoError(t, tae.DiskCleaner.GetCleaner().MoveColdObjects(ctx))
+
+	var moved int
+	txn, rel := tae.GetRelation()
+	it := rel.MakeObjectIt()
+	for ; it.Valid(); it.Next() {
+		obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !obj.HasPersistedData() {
+			continue
+		}
+		stats := obj.GetObjectStats()
+		tier, err := tae.Runtime.Fs.ObjectTier(ctx, stats.ObjectName().String())
+		require.NoError(t, err)
+		if tier == fileservice.ColdTier {
+			moved++
+		}
+	}
+	require.NoError(t, txn.Commit(ctx))
+	require.Greater(t, moved, 0)
+
+	// moved objects are read transparently
+	tae.CheckRowsByScan(21, false)
+	tae.Restart(ctx)
+	tae.CheckRowsByScan(21, false)
+}
+
+func TestScrubObjects(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	dir := testutils.InitTestEnv(ModuleName, t)
+	fs, err := fileservice.NewLocalFS(ctx, defines.LocalFileServiceName, path.Join(dir, "data"), fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	replica, err := fileservice.NewLocalFS(ctx, defines.LocalFileServiceName, path.Join(dir, "replica"), fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.Fs = fs
+	tae := testutil.NewTestEngineWithDir(ctx, dir, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 1)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 21)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	tae.CompactBlocks(false)
+
+	// the history table created by the bootstrap of the sys account
+	historySchema := catalog.NewEmptySchema(pkgcatalog.MO_SCRUB_HISTORY)
+	require.NoError(t, historySchema.AppendPKCol("object_name", types.T_varchar.ToType(), 0))
+	require.NoError(t, historySchema.AppendCol("db_id", types.T_uint64.ToType()))
+	require.NoError(t, historySchema.AppendCol("table_id", types.T_uint64.ToType()))
+	require.NoError(t, historySchema.AppendCol("error", types.T_text.ToType()))
+	require.NoError(t, historySchema.AppendCol("detected_at", types.T_timestamp.ToType()))
+	require.NoError(t, historySchema.AppendCol("repaired", types.T_bool.ToType()))
+	require.NoError(t, historySchema.AppendCol("repair_error", types.T_text.ToType()))
+	historySchema.BlockMaxRows = options.DefaultBlockMaxRows
+	historySchema.ObjectMaxBlocks = options.DefaultBlocksPerObject
+	require.NoError(t, historySchema.Finalize(false))
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	sysDB, err := txn.GetDatabase(pkgcatalog.MO_CATALOG)
+	require.NoError(t, err)
+	_, err = sysDB.CreateRelation(historySchema)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit(ctx))
+	history := scrub.NewTableHistory(tae.DB)
+	historyOf := func(name string) (repaired any, rows int) {
+		txn, err := tae.StartTxn(nil)
+		require.NoError(t, err)
+		defer txn.Commit(ctx)
+		sysDB, err := txn.GetDatabase(pkgcatalog.MO_CATALOG)
+		require.NoError(t, err)
+		rel, err := sysDB.GetRelationByName(pkgcatalog.MO_SCRUB_HISTORY)
+		require.NoError(t, err)
+		repaired, _, err = rel.GetValueByFilter(ctx, handle.NewEQFilter([]byte(name)), historySchema.GetColIdx("repaired"))
+		require.NoError(t, err)
+		return repaired, testutil.GetColumnRowsByScan(t, rel, 0, true)
+	}
+
+	report := scrub.NewReport(10)
+	scrubber := scrub.NewScrubber(tae.Catalog, fs, &options.ScrubCfg{}, report, history)
+	round, err := scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Greater(t, round.Verified, 0)
+	require.Greater(t, round.Bytes, int64(0))
+	require.Equal(t, 0, round.Corrupted)
+
+	// copy the objects to the replica and corrupt one of them
+	var name string
+	txn, rel := tae.GetRelation()
+	it := rel.MakeObjectIt()
+	for ; it.Valid(); it.Next() {
+		obj := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !obj.HasPersistedData() || obj.HasDropCommitted() {
+			continue
+		}
+		stats := obj.GetObjectStats()
+		name = stats.ObjectName().String()
+		vec := &fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: -1}},
+		}
+		require.NoError(t, fs.Read(ctx, vec))
+		require.NoError(t, replica.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: int64(len(vec.Entries[0].Data)), Data: vec.Entries[0].Data}},
+		}))
+	}
+	require.NoError(t, txn.Commit(ctx))
+	require.NotEmpty(t, name)
+	file := path.Join(dir, "data", name)
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	content[len(content)/2] ^= 0x1
+	require.NoError(t, os.WriteFile(file, content, 0644))
+
+	round, err = scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, round.Corrupted)
+	require.Equal(t, 0, round.Repaired)
+	records := report.Records()
+	require.Equal(t, 1, len(records))
+	require.Equal(t, name, records[0].ObjectName)
+	require.Equal(t, rel.ID(), records[0].TableID)
+	require.False(t, records[0].Repaired)
+	repaired, rows := historyOf(name)
+	require.Equal(t, false, repaired)
+	require.Equal(t, 1, rows)
+
+	// repair from the replica
+	scrubber = scrub.NewScrubber(tae.Catalog, fs, &options.ScrubCfg{RepairFS: replica}, report, history)
+	round, err = scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, round.Corrupted)
+	require.Equal(t, 1, round.Repaired)
+	records = report.Records()
+	require.Equal(t, 1, len(records))
+	require.True(t, records[0].Repaired)
+	require.Empty(t, records[0].RepairError)
+	// the row of the object is replaced
+	repaired, rows = historyOf(name)
+	require.Equal(t, true, repaired)
+	require.Equal(t, 1, rows)
+
+	round, err = scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, round.Corrupted)
+	tae.CheckRowsByScan(21, false)
+}
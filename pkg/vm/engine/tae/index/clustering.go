@@ -0,0 +1,212 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+)
+
+// ClusteringInfo describes how well the objects of a table are clustered by
+// a key, computed from the zonemaps of the key in the objects.
+//
+// The depth of a value is the number of objects whose zonemap covers it, in
+// other words, the number of objects a point query of the value has to read.
+// The depth of an object is the depth of its min value.
+type ClusteringInfo struct {
+	TotalObjects int
+	// objects without any value of the key, e.g. all nulls
+	EmptyObjects int
+	// objects overlapping with at least one other object
+	OverlappingObjects int
+	// the average number of other objects an object overlaps with
+	AverageOverlaps float64
+	AverageDepth    float64
+	MaxDepth        int
+}
+
+func (info ClusteringInfo) String() string {
+	return fmt.Sprintf(
+		"objects %d, empty %d, overlapping %d, avg overlaps %.2f, avg depth %.2f, max depth %d",
+		info.TotalObjects, info.EmptyObjects, info.OverlappingObjects,
+		info.AverageOverlaps, info.AverageDepth, info.MaxDepth,
+	)
+}
+
+// CalculateClusteringInfo computes the clustering info from the zonemaps of
+// the key in the objects, all of the zonemaps are of the same type.
+func CalculateClusteringInfo(zms []ZM) (info ClusteringInfo) {
+	info.TotalObjects = len(zms)
+	valid := make([]ZM, 0, len(zms))
+	for _, zm := range zms {
+		if zm.IsInited() {
+			valid = append(valid, zm)
+		}
+	}
+	info.EmptyObjects = len(zms) - len(valid)
+	if len(valid) == 0 {
+		return
+	}
+
+	t, scale := valid[0].GetType(), valid[0].GetScale()
+	compare := func(a, b []byte) int {
+		return compute.Compare(a, b, t, scale, scale)
+	}
+	mins := make([][]byte, len(valid))
+	maxs := make([][]byte, len(valid))
+	for i, zm := range valid {
+		mins[i], maxs[i] = zm.GetMinBuf(), zm.GetMaxBuf()
+	}
+	sort.Slice(mins, func(i, j int) bool { return compare(mins[i], mins[j]) < 0 })
+	sort.Slice(maxs, func(i, j int) bool { return compare(maxs[i], maxs[j]) < 0 })
+	// the number of objects whose min is not greater than v
+	minLE := func(v []byte) int {
+		return sort.Search(len(mins), func(i int) bool { return compare(mins[i], v) > 0 })
+	}
+	// the number of objects whose max is less than v
+	maxLT := func(v []byte) int {
+		return sort.Search(len(maxs), func(i int) bool { return compare(maxs[i], v) >= 0 })
+	}
+
+	var overlaps, depths int
+	for _, zm := range valid {
+		before := maxLT(zm.GetMinBuf())
+		depth := minLE(zm.GetMinBuf()) - before
+		overlap := minLE(zm.GetMaxBuf()) - before - 1
+		if overlap > 0 {
+			info.OverlappingObjects++
+		}
+		if depth > info.MaxDepth {
+			info.MaxDepth = depth
+		}
+		overlaps += overlap
+		depths += depth
+	}
+	info.AverageOverlaps = float64(overlaps) / float64(len(valid))
+	info.AverageDepth = float64(depths) / float64(len(valid))
+	return
+}
+
+// CalculateBoxClusteringInfo is CalculateClusteringInfo for a key of more
+// than one column, boxes[i] holds the zonemaps of the columns of the key in
+// the i-th object, the zonemaps of a column are of the same type.
+//
+// Two objects overlap if their zonemaps overlap on every column, and the
+// depth of an object is the number of objects covering the min values of its
+// columns. Unlike the order of the packed key, it does not take the objects
+// sorted in the Z-order of the columns as overlapping.
+func CalculateBoxClusteringInfo(boxes [][]ZM) (info ClusteringInfo) {
+	info.TotalObjects = len(boxes)
+	overlaps := make([]int, len(boxes))
+	depths := make([]int, len(boxes))
+	valid := 0
+	for i, box := range boxes {
+		if boxInited(box) {
+			valid++
+			depths[i] = 1
+		}
+	}
+	info.EmptyObjects = len(boxes) - valid
+	if valid == 0 {
+		return
+	}
+
+	ForEachOverlappingBoxes(boxes, func(i, j int) {
+		overlaps[i]++
+		overlaps[j]++
+		if boxCovers(boxes[i], boxes[j]) {
+			depths[j]++
+		}
+		if boxCovers(boxes[j], boxes[i]) {
+			depths[i]++
+		}
+	})
+	var sumOverlaps, sumDepths int
+	for i := range boxes {
+		if overlaps[i] > 0 {
+			info.OverlappingObjects++
+		}
+		if depths[i] > info.MaxDepth {
+			info.MaxDepth = depths[i]
+		}
+		sumOverlaps += overlaps[i]
+		sumDepths += depths[i]
+	}
+	info.AverageOverlaps = float64(sumOverlaps) / float64(valid)
+	info.AverageDepth = float64(sumDepths) / float64(valid)
+	return
+}
+
+// ForEachOverlappingBoxes calls fn for every pair of overlapping boxes, see
+// CalculateBoxClusteringInfo. The boxes with an empty zonemap overlap with
+// nothing.
+func ForEachOverlappingBoxes(boxes [][]ZM, fn func(i, j int)) {
+	sorted := make([]int, 0, len(boxes))
+	for i, box := range boxes {
+		if boxInited(box) {
+			sorted = append(sorted, i)
+		}
+	}
+	// sweep along the first column
+	sort.Slice(sorted, func(i, j int) bool {
+		return boxes[sorted[i]][0].CompareMin(boxes[sorted[j]][0]) < 0
+	})
+	for x, i := range sorted {
+		for _, j := range sorted[x+1:] {
+			if compareBuf(boxes[j][0], boxes[j][0].GetMinBuf(), boxes[i][0].GetMaxBuf()) > 0 {
+				break
+			}
+			if boxOverlaps(boxes[i], boxes[j]) {
+				fn(i, j)
+			}
+		}
+	}
+}
+
+func boxInited(box []ZM) bool {
+	for _, zm := range box {
+		if !zm.IsInited() {
+			return false
+		}
+	}
+	return len(box) > 0
+}
+
+func boxOverlaps(a, b []ZM) bool {
+	for d := range a {
+		if compareBuf(a[d], a[d].GetMinBuf(), b[d].GetMaxBuf()) > 0 ||
+			compareBuf(a[d], b[d].GetMinBuf(), a[d].GetMaxBuf()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// boxCovers reports whether box a covers the min values of box b
+func boxCovers(a, b []ZM) bool {
+	for d := range a {
+		if compareBuf(a[d], a[d].GetMinBuf(), b[d].GetMinBuf()) > 0 ||
+			compareBuf(a[d], b[d].GetMinBuf(), a[d].GetMaxBuf()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func compareBuf(zm ZM, a, b []byte) int {
+	return compute.Compare(a, b, zm.GetType(), zm.GetScale(), zm.GetScale())
+}
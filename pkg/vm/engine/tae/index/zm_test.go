@@ -489,3 +489,72 @@ func BenchmarkUpdateZMVector(b *testing.B) {
 		}
 	})
 }
+
+func TestClusteringInfo(t *testing.T) {
+	build := func(min, max int32) ZM {
+		zm := NewZM(types.T_int32, 0)
+		UpdateZM(zm, types.EncodeInt32(&min))
+		UpdateZM(zm, types.EncodeInt32(&max))
+		return zm
+	}
+	info := CalculateClusteringInfo([]ZM{
+		build(0, 10),
+		build(5, 15),
+		build(20, 30),
+		NewZM(types.T_int32, 0),
+	})
+	require.Equal(t, 4, info.TotalObjects)
+	require.Equal(t, 1, info.EmptyObjects)
+	require.Equal(t, 2, info.OverlappingObjects)
+	require.Equal(t, 2, info.MaxDepth)
+	require.InDelta(t, 4.0/3, info.AverageDepth, 1e-9)
+	require.InDelta(t, 2.0/3, info.AverageOverlaps, 1e-9)
+
+	// well clustered objects only touch at the boundaries
+	info = CalculateClusteringInfo([]ZM{build(0, 9), build(10, 19), build(20, 29)})
+	require.Equal(t, 0, info.OverlappingObjects)
+	require.Equal(t, 1, info.MaxDepth)
+	require.InDelta(t, 1.0, info.AverageDepth, 1e-9)
+
+	require.Equal(t, ClusteringInfo{}, CalculateClusteringInfo(nil))
+}
+
+func TestBoxClusteringInfo(t *testing.T) {
+	build := func(min, max int32) ZM {
+		zm := NewZM(types.T_int32, 0)
+		UpdateZM(zm, types.EncodeInt32(&min))
+		UpdateZM(zm, types.EncodeInt32(&max))
+		return zm
+	}
+	// the quadrants of a grid, overlapping on the first column in pairs
+	quadrants := [][]ZM{
+		{build(0, 3), build(0, 3)},
+		{build(0, 3), build(4, 7)},
+		{build(4, 7), build(0, 3)},
+		{build(4, 7), build(4, 7)},
+	}
+	info := CalculateBoxClusteringInfo(quadrants)
+	require.Equal(t, 4, info.TotalObjects)
+	require.Equal(t, 0, info.OverlappingObjects)
+	require.Equal(t, 1, info.MaxDepth)
+	require.InDelta(t, 1.0, info.AverageDepth, 1e-9)
+
+	// every object covers the whole grid
+	boxes := [][]ZM{
+		{build(0, 7), build(0, 7)},
+		{build(0, 7), build(1, 7)},
+		{build(1, 7), build(0, 6)},
+		{NewZM(types.T_int32, 0), build(0, 7)},
+	}
+	info = CalculateBoxClusteringInfo(boxes)
+	require.Equal(t, 1, info.EmptyObjects)
+	require.Equal(t, 3, info.OverlappingObjects)
+	require.InDelta(t, 2.0, info.AverageOverlaps, 1e-9)
+	// (0, 0) is covered by 1, (0, 1) by 2, (1, 0) by 2
+	require.Equal(t, 2, info.MaxDepth)
+	require.InDelta(t, 5.0/3, info.AverageDepth, 1e-9)
+
+	var pairs [][2]int
+	ForEachOverlappingBoxes(boxes, func(i, j int) { pairs = append(pairs, [2]int{i, j}) })
+	require.Len(t, pairs, 3)
+}
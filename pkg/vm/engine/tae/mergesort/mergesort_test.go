@@ -19,7 +19,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -198,3 +200,41 @@ func TestReshape2(t *testing.T) {
 		}
 	}
 }
+
+func TestZOrderSort(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	mp := mpool.MustNewZero()
+	packer := types.NewPacker(mp)
+	defer packer.FreeMem()
+
+	// a 4x4 grid of (a, b) in the order of a, split into two vectors
+	column := []*vector.Vector{vector.NewVec(types.T_varchar.ToType()), vector.NewVec(types.T_varchar.ToType())}
+	for a := int64(0); a < 4; a++ {
+		for b := int64(0); b < 4; b++ {
+			packer.Reset()
+			packer.EncodeInt64(a * 100)
+			packer.EncodeInt64(b)
+			require.NoError(t, vector.AppendBytes(column[a/2], packer.Bytes(), false, mp))
+		}
+	}
+	order, err := zorderSort(column)
+	require.NoError(t, err)
+	require.Len(t, order, 16)
+	for k, pos := range order {
+		a, b := int(pos/4), int(pos%4)
+		// every quarter of the sorted rows is a quadrant of the grid
+		require.Equal(t, k/4, a/2*2+b/2, "row %d: (%d, %d)", k, a, b)
+	}
+
+	ret := []*vector.Vector{vector.NewVec(types.T_varchar.ToType()), vector.NewVec(types.T_varchar.ToType())}
+	require.NoError(t, gather(column, ret, order, []uint32{8, 8}, []uint32{10, 6}, mp))
+	require.Equal(t, 10, ret[0].Length())
+	require.Equal(t, 6, ret[1].Length())
+	for k, pos := range order {
+		got := ret[k/10].GetBytesAt(k % 10)
+		require.Equal(t, column[pos/8].GetBytesAt(int(pos%8)), got)
+	}
+	for _, vec := range append(column, ret...) {
+		vec.Free(mp)
+	}
+}
@@ -87,6 +87,32 @@ func DoMergeAndWrite(
 	sortkeyPos int,
 	blkMaxRow int,
 	mergehost MergeTaskHost,
+) (err error) {
+	return doMergeAndWrite(ctx, sortkeyPos, blkMaxRow, mergehost, false)
+}
+
+// DoZOrderMergeAndWrite merges the objects of a table clustered by a
+// composite key, the rows are sorted in the Z-order of the columns of the key
+// rather than in the order of the packed key, so that the zonemaps of every
+// column of the key, not only the first one, become narrow.
+func DoZOrderMergeAndWrite(
+	ctx context.Context,
+	sortkeyPos int,
+	blkMaxRow int,
+	mergehost MergeTaskHost,
+) (err error) {
+	if sortkeyPos < 0 {
+		return moerr.NewInternalError(ctx, "z-order merge without sort key")
+	}
+	return doMergeAndWrite(ctx, sortkeyPos, blkMaxRow, mergehost, true)
+}
+
+func doMergeAndWrite(
+	ctx context.Context,
+	sortkeyPos int,
+	blkMaxRow int,
+	mergehost MergeTaskHost,
+	zorder bool,
 ) (err error) {
 	now := time.Now()
 	/*out args, keep the transfer infomation*/
@@ -119,7 +145,7 @@ func DoMergeAndWrite(
 		sortkeyPos = 0 // no sort key, use the first column to do reshape
 	}
 
-	if hasSortKey {
+	if hasSortKey && !zorder {
 		var merger Merger
 		typ := mergehost.GetSortKeyType()
 		if typ.IsVarlen() {
@@ -253,9 +279,25 @@ func DoMergeAndWrite(
 	sortedVecs, releaseF := getRetVecs(len(toLayout), toSortVecs[0].GetType(), mergehost)
 	defer releaseF()
 
-	// just do reshape, keep sortedIdx nil
-	Reshape(toSortVecs, sortedVecs, fromLayout, toLayout, mpool)
-	UpdateMappingAfterMerge(commitEntry.Booking, nil, fromLayout, toLayout)
+	var order []uint32
+	if zorder {
+		if order, err = zorderSort(toSortVecs); err != nil {
+			return err
+		}
+		if err = gather(toSortVecs, sortedVecs, order, fromLayout, toLayout, mpool); err != nil {
+			return err
+		}
+		// mapping[i] is the sorted position of the i-th row
+		mapping := make([]uint32, len(order))
+		for sortedPos, pos := range order {
+			mapping[pos] = uint32(sortedPos)
+		}
+		UpdateMappingAfterMerge(commitEntry.Booking, mapping, fromLayout, toLayout)
+	} else {
+		// just do reshape, keep sortedIdx nil
+		Reshape(toSortVecs, sortedVecs, fromLayout, toLayout, mpool)
+		UpdateMappingAfterMerge(commitEntry.Booking, nil, fromLayout, toLayout)
+	}
 
 	// -------------------------- phase 2
 	phaseDesc = "merge sort, or reshape, the rest of columns"
@@ -298,7 +340,13 @@ func DoMergeAndWrite(
 			return moerr.NewInternalError(ctx, "written mismatch length %v %v", len(sortedVecs), len(outvecs))
 		}
 
-		Reshape(tempVecs, outvecs, fromLayout, toLayout, mpool)
+		if zorder {
+			if err = gather(tempVecs, outvecs, order, fromLayout, toLayout, mpool); err != nil {
+				return err
+			}
+		} else {
+			Reshape(tempVecs, outvecs, fromLayout, toLayout, mpool)
+		}
 
 		for j, vec := range outvecs {
 			writtenBatches[j].Vecs[i] = vec
@@ -307,32 +355,44 @@ func DoMergeAndWrite(
 
 	// -------------------------- phase 3
 	phaseDesc = "new writer to write down"
-	writer := mergehost.PrepareNewWriter()
-	for _, bat := range writtenBatches {
-		_, err = writer.WriteBatch(bat)
-		if err != nil {
-			return err
+	blkPerObj := len(writtenBatches)
+	if zorder {
+		// a recluster rewrites many objects, split the rows into objects
+		// like the merger does
+		if _, n := mergehost.GetObjLayout(); n > 0 {
+			blkPerObj = int(n)
 		}
+		splitMappingByObject(commitEntry.Booking, blkPerObj)
 	}
+	toObjsDesc := ""
+	for start := 0; start < len(writtenBatches); start += blkPerObj {
+		writer := mergehost.PrepareNewWriter()
+		for _, bat := range writtenBatches[start:min(start+blkPerObj, len(writtenBatches))] {
+			_, err = writer.WriteBatch(bat)
+			if err != nil {
+				return err
+			}
+		}
 
-	if _, _, err = writer.Sync(ctx); err != nil {
-		return err
-	}
+		if _, _, err = writer.Sync(ctx); err != nil {
+			return err
+		}
 
-	// no tomestone actually
-	cobjstats := writer.GetObjectStats()[:objectio.SchemaTombstone]
-	for _, cobj := range cobjstats {
-		commitEntry.CreatedObjs = append(commitEntry.CreatedObjs, cobj.Clone().Marshal())
+		// no tomestone actually
+		cobjstats := writer.GetObjectStats()[:objectio.SchemaTombstone]
+		for _, cobj := range cobjstats {
+			commitEntry.CreatedObjs = append(commitEntry.CreatedObjs, cobj.Clone().Marshal())
+		}
+		toObjsDesc += fmt.Sprintf("%s(%v)Rows(%v),",
+			common.ShortObjId(*cobjstats[0].ObjectName().ObjectId()),
+			cobjstats[0].BlkCnt(),
+			cobjstats[0].Rows())
 	}
-	cobj := fmt.Sprintf("%s(%v)Rows(%v)",
-		common.ShortObjId(*cobjstats[0].ObjectName().ObjectId()),
-		cobjstats[0].BlkCnt(),
-		cobjstats[0].Rows())
 	logutil.Info("[Done] Mergeblocks",
 		zap.String("table", tableDesc),
 		zap.String("on", mergehost.HostHintName()),
 		zap.String("txn-start-ts", commitEntry.StartTs.DebugString()),
-		zap.String("to-objs", cobj),
+		zap.String("to-objs", toObjsDesc),
 		common.DurationField(time.Since(now)))
 
 	return nil
@@ -431,6 +491,21 @@ func AddSortPhaseMapping(b *api.BlkTransferBooking, idx int, originRowCnt int, d
 	}
 }
 
+// splitMappingByObject splits the blocks in the mapping, counted from the
+// first created object, into objects of blkPerObj blocks
+func splitMappingByObject(b *api.BlkTransferBooking, blkPerObj int) {
+	for _, mcontainer := range b.Mappings {
+		m := mcontainer.M
+		for srcRow, pos := range m {
+			m[srcRow] = api.TransDestPos{
+				ObjIdx: pos.BlkIdx / int32(blkPerObj),
+				BlkIdx: pos.BlkIdx % int32(blkPerObj),
+				RowIdx: pos.RowIdx,
+			}
+		}
+	}
+}
+
 func UpdateMappingAfterMerge(b *api.BlkTransferBooking, mapping, fromLayout, toLayout []uint32) {
 	bisectHaystack := make([]uint32, 0, len(toLayout)+1)
 	bisectHaystack = append(bisectHaystack, 0)
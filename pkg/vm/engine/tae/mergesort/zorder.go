@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"bytes"
+	"math/bits"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// zorderSort sorts the rows of the composite cluster by key column in the
+// Z-order of the columns packed in the key, and returns the sorted order,
+// order[k] is the position in the flattened column of the k-th sorted row.
+//
+// The value of every column is replaced by its dense rank among the rows
+// before interleaving the bits, so that the columns with a narrow range of
+// values count as much as the columns with a wide one.
+func zorderSort(column []*vector.Vector) ([]uint32, error) {
+	var elems [][][]byte
+	dims := 0
+	for _, vec := range column {
+		for i := 0; i < vec.Length(); i++ {
+			var row [][]byte
+			if !vec.IsNull(uint64(i)) {
+				var err error
+				if row, err = types.SplitTuple(vec.GetBytesAt(i)); err != nil {
+					return nil, err
+				}
+			}
+			if len(row) > dims {
+				dims = len(row)
+			}
+			elems = append(elems, row)
+		}
+	}
+
+	n := len(elems)
+	elem := func(r, d int) []byte {
+		if d < len(elems[r]) {
+			return elems[r][d]
+		}
+		return nil
+	}
+	ranks := make([][]uint32, dims)
+	idx := make([]int, n)
+	maxRank := uint32(0)
+	for d := 0; d < dims; d++ {
+		for r := range idx {
+			idx[r] = r
+		}
+		slices.SortFunc(idx, func(a, b int) int {
+			return bytes.Compare(elem(a, d), elem(b, d))
+		})
+		ranks[d] = make([]uint32, n)
+		rank := uint32(0)
+		for i, r := range idx {
+			if i > 0 && !bytes.Equal(elem(idx[i-1], d), elem(r, d)) {
+				rank++
+			}
+			ranks[d][r] = rank
+		}
+		if rank > maxRank {
+			maxRank = rank
+		}
+	}
+
+	width := bits.Len32(maxRank)
+	keys := make([][]byte, n)
+	for r := range keys {
+		keys[r] = interleave(ranks, r, width)
+	}
+	order := make([]uint32, n)
+	for r := range order {
+		order[r] = uint32(r)
+	}
+	slices.SortStableFunc(order, func(a, b uint32) int {
+		return bytes.Compare(keys[a], keys[b])
+	})
+	return order, nil
+}
+
+// interleave interleaves the lowest width bits of the ranks of row r, from
+// the most significant bit to the least one.
+func interleave(ranks [][]uint32, r int, width int) []byte {
+	key := make([]byte, (len(ranks)*width+7)/8)
+	pos := 0
+	for b := width - 1; b >= 0; b-- {
+		for d := range ranks {
+			if ranks[d][r]>>b&1 == 1 {
+				key[pos/8] |= 0x80 >> (pos % 8)
+			}
+			pos++
+		}
+	}
+	return key
+}
+
+// gather puts the rows of column into ret in the given order, where order[k]
+// is the position in the flattened column of the k-th row of the flattened ret
+func gather(column, ret []*vector.Vector, order []uint32, fromLayout, toLayout []uint32, m *mpool.MPool) error {
+	offsets := make([]uint32, len(fromLayout)+1)
+	for i, l := range fromLayout {
+		offsets[i+1] = offsets[i] + l
+	}
+	k := 0
+	for i, l := range toLayout {
+		for j := uint32(0); j < l; j++ {
+			pos := order[k]
+			blk, found := slices.BinarySearch(offsets, pos)
+			if !found {
+				blk--
+			}
+			if err := ret[i].UnionOne(column[blk], int64(pos-offsets[blk]), m); err != nil {
+				return err
+			}
+			k++
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
 
 const (
@@ -57,6 +58,15 @@ type GCCfg struct {
 	DisableGC      bool          `toml:"disable-gc"`
 }
 
+type ScrubCfg struct {
+	// Interval between two rounds of scrubbing, scrubbing is disabled if it is 0
+	Interval time.Duration
+	// BytesPerSecond limits the read rate of scrubbing
+	BytesPerSecond int64
+	// RepairFS holds replicas of the objects, corrupted objects are repaired from it if not nil
+	RepairFS fileservice.FileService
+}
+
 type CatalogCfg struct {
 	GCInterval time.Duration
 	DisableGC  bool
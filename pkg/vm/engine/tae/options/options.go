@@ -196,6 +196,13 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		o.GCCfg.ScanGCInterval = DefaultScanGCInterval
 	}
 
+	if o.ScrubCfg == nil {
+		o.ScrubCfg = new(ScrubCfg)
+	}
+	if o.ScrubCfg.BytesPerSecond <= 0 {
+		o.ScrubCfg.BytesPerSecond = DefaultScrubBytesPerSecond
+	}
+
 	if o.SchedulerCfg == nil {
 		ioworkers := DefaultIOWorkers
 		if ioworkers < runtime.NumCPU() {
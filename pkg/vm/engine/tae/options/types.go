@@ -48,6 +48,8 @@ const (
 
 	DefaultCatalogGCInterval = time.Minute * 30
 
+	DefaultScrubBytesPerSecond = 16 * mpool.MB
+
 	DefaultIOWorkers    = int(16)
 	DefaultAsyncWorkers = int(16)
 
@@ -71,6 +73,7 @@ type Options struct {
 	LogtailCfg    *LogtailCfg
 	MergeCfg      *MergeConfig
 	CatalogCfg    *CatalogCfg
+	ScrubCfg      *ScrubCfg
 
 	TransferTableTTL time.Duration
 
@@ -605,6 +605,7 @@ type mergePolicyArg struct {
 	minRowsQualified int32
 	maxRowsObj       int32
 	cnMinMergeSize   int32
+	reclusterDepth   int32
 	hints            []api.MergeHint
 }
 
@@ -619,6 +620,7 @@ func (c *mergePolicyArg) PrepareCommand() *cobra.Command {
 	policyCmd.Flags().Int32P("minRowsQualified", "m", common.DefaultMinRowsQualified, "objects which are less than minRowsQualified will be picked up to merge")
 	policyCmd.Flags().Int32P("minCNMergeSize", "c", common.DefaultMinCNMergeSize, "Merget task whose memory occupation exceeds minCNMergeSize will be moved to CN")
 	policyCmd.Flags().Int32SliceP("mergeHints", "n", []int32{0}, "hints to merge the table")
+	policyCmd.Flags().Int32P("reclusterDepth", "d", common.DefaultReclusterDepth, "clustered tables whose average clustering depth exceeds reclusterDepth will be reclustered, 0 to disable")
 	return policyCmd
 }
 
@@ -634,6 +636,7 @@ func (c *mergePolicyArg) FromCommand(cmd *cobra.Command) (err error) {
 	c.maxRowsObj, _ = cmd.Flags().GetInt32("maxRowsObj")
 	c.minRowsQualified, _ = cmd.Flags().GetInt32("minRowsQualified")
 	c.cnMinMergeSize, _ = cmd.Flags().GetInt32("minCNMergeSize")
+	c.reclusterDepth, _ = cmd.Flags().GetInt32("reclusterDepth")
 	hints, _ := cmd.Flags().GetInt32Slice("mergeHints")
 	for _, h := range hints {
 		if _, ok := api.MergeHint_name[h]; !ok {
@@ -666,6 +669,7 @@ func (c *mergePolicyArg) Run() error {
 			merge.StopMerge.Store(false)
 		}
 		common.RuntimeMaxRowsObj.Store(c.maxRowsObj)
+		common.RuntimeReclusterDepth.Store(c.reclusterDepth)
 	} else {
 		c.ctx.db.MergeHandle.ConfigPolicy(c.tbl, &merge.BasicPolicyConfig{
 			MergeMaxOneRun:   int(c.maxMergeObjN),
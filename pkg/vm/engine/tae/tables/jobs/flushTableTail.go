@@ -589,6 +589,8 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context) (err error) {
 	} else if schema.HasSortKey() {
 		writer.SetSortKey(uint16(schema.GetSingleSortKeyIdx()))
 	}
+	writer.SetBloomFilterColumns(schema.BloomFilterColIdxes())
+	writer.SetLowCardinalityColumns(schema.LowCardinalityColIdxes())
 	for _, bat := range writtenBatches {
 		_, err = writer.WriteBatch(containers.ToCNBatch(bat))
 		if err != nil {
@@ -89,6 +89,8 @@ func (task *flushObjTask) Execute(ctx context.Context) (err error) {
 	} else if task.meta.GetSchema().HasSortKey() {
 		writer.SetSortKey(uint16(task.meta.GetSchema().GetSingleSortKeyIdx()))
 	}
+	writer.SetBloomFilterColumns(task.meta.GetSchema().BloomFilterColIdxes())
+	writer.SetLowCardinalityColumns(task.meta.GetSchema().LowCardinalityColIdxes())
 
 	cnBatch := containers.ToCNBatch(task.data)
 	for _, vec := range cnBatch.Vecs {
@@ -17,7 +17,9 @@ package jobs
 import (
 	"context"
 	"fmt"
+	"time"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -57,6 +59,16 @@ type mergeObjectsTask struct {
 	schema     *catalog.Schema
 	idxs       []int
 	attrs      []string
+
+	// rows whose TTL column, at ttlPos of attrs, is before ttlCutoff are
+	// dropped. ttlPos is -1 if the table has no TTL.
+	ttlPos    int
+	ttlSeqnum uint16
+	ttlCutoff time.Time
+
+	// reorder the rows in the Z-order of the composite cluster key instead
+	// of merging them by the packed key
+	zorder bool
 }
 
 func NewMergeObjectsTask(
@@ -109,10 +121,37 @@ func NewMergeObjectsTask(
 		task.idxs = append(task.idxs, def.Idx)
 		task.attrs = append(task.attrs, def.Name)
 	}
+	task.ttlPos = -1
+	if ttl, colIdx, ok := task.schema.TTL(); ok {
+		for i, idx := range task.idxs {
+			if idx == colIdx {
+				task.ttlPos = i
+				task.ttlSeqnum = task.schema.ColDefs[colIdx].SeqNum
+				task.ttlCutoff = ttl.Cutoff(time.Unix(0, txn.GetStartTS().Physical()))
+				break
+			}
+		}
+	}
 	task.BaseTask = tasks.NewBaseTask(task, tasks.DataCompactionTask, ctx)
 	return
 }
 
+// NewReclusterObjectsTask is NewMergeObjectsTask for the objects picked by
+// the recluster policy. The rows of a table with a composite cluster key are
+// written in the Z-order of its columns, which loads all of them in memory
+// and leaves the output unsorted by the packed key.
+func NewReclusterObjectsTask(
+	ctx *tasks.Context, txn txnif.AsyncTxn,
+	mergedObjs []*catalog.ObjectEntry,
+	rt *dbutils.Runtime,
+) (task *mergeObjectsTask, err error) {
+	if task, err = NewMergeObjectsTask(ctx, txn, mergedObjs, rt); err != nil {
+		return
+	}
+	task.zorder = task.schema.HasCompositeClusterBy()
+	return
+}
+
 func (task *mergeObjectsTask) GetObjectCnt() int {
 	return len(task.mergedObjs)
 }
@@ -125,8 +164,29 @@ func (task *mergeObjectsTask) GetAccBlkCnts() []int {
 	return task.mergedBlkCnt
 }
 
+// GetObjLayout cuts the output objects by the target object size of the
+// merge policy, estimated by the average block size of the merged objects
 func (task *mergeObjectsTask) GetObjLayout() (uint32, uint16) {
-	return task.schema.BlockMaxRows, task.schema.ObjectMaxBlocks
+	maxBlks := task.schema.ObjectMaxBlocks
+	target := task.schema.Extra.GetTargetObjectSize()
+	if target == 0 || task.totalMergedBlkCnt == 0 {
+		return task.schema.BlockMaxRows, maxBlks
+	}
+	var size uint64
+	for _, obj := range task.mergedObjs {
+		size += uint64(obj.GetOriginSize())
+	}
+	if size == 0 {
+		return task.schema.BlockMaxRows, maxBlks
+	}
+	blks := target * uint64(task.totalMergedBlkCnt) / size
+	if blks < 1 {
+		blks = 1
+	}
+	if blks < uint64(maxBlks) {
+		maxBlks = uint16(blks)
+	}
+	return task.schema.BlockMaxRows, maxBlks
 }
 
 func (task *mergeObjectsTask) GetSortKeyPos() int {
@@ -208,7 +268,7 @@ func (task *mergeObjectsTask) PrepareData() ([]*batch.Batch, []*nulls.Nulls, fun
 		}
 		batch.SetRowCount(view.Columns[0].Length())
 		batches = append(batches, batch)
-		dels = append(dels, view.DeleteMask)
+		dels = append(dels, task.maskExpiredRows(batch, view.DeleteMask))
 	}
 
 	return batches, dels, releaseF, nil
@@ -249,7 +309,31 @@ func (task *mergeObjectsTask) LoadNextBatch(objIdx uint32) (*batch.Batch, *nulls
 		bat.Vecs[i] = col.GetData().GetDownstreamVector()
 	}
 	bat.SetRowCount(view.Columns[0].Length())
-	return bat, view.DeleteMask, releaseF, nil
+	return bat, task.maskExpiredRows(bat, view.DeleteMask), releaseF, nil
+}
+
+// maskExpiredRows adds the rows expired by the table TTL to del, so they are
+// dropped like deleted rows when the objects are rewritten.
+func (task *mergeObjectsTask) maskExpiredRows(bat *batch.Batch, del *nulls.Nulls) *nulls.Nulls {
+	if task.ttlPos < 0 {
+		return del
+	}
+	return pkgcatalog.ExpiredRows(bat.Vecs[task.ttlPos], task.ttlCutoff, del)
+}
+
+// allExpired reports whether every merged object is expired by the table TTL
+// as a whole, according to the zonemap of its TTL column.
+func (task *mergeObjectsTask) allExpired(ctx context.Context) bool {
+	if task.ttlPos < 0 {
+		return false
+	}
+	for _, obj := range task.mergedObjs {
+		expired, err := obj.IsTTLExpired(ctx, task.rt.Fs.Service, task.ttlSeqnum, task.ttlCutoff)
+		if err != nil || !expired {
+			return false
+		}
+	}
+	return true
 }
 
 func (task *mergeObjectsTask) GetCommitEntry() *api.MergeCommitEntry {
@@ -294,7 +378,10 @@ func (task *mergeObjectsTask) PrepareNewWriter() *blockio.BlockWriter {
 		sortkeyPos = schema.GetSingleSortKeyIdx()
 	}
 
-	return mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK)
+	writer := mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK)
+	writer.SetBloomFilterColumns(schema.BloomFilterColIdxes())
+	writer.SetLowCardinalityColumns(schema.LowCardinalityColIdxes())
+	return writer
 }
 
 func (task *mergeObjectsTask) Execute(ctx context.Context) (err error) {
@@ -313,9 +400,26 @@ func (task *mergeObjectsTask) Execute(ctx context.Context) (err error) {
 	if schema.HasSortKey() {
 		sortkeyPos = schema.GetSingleSortKeyIdx()
 	}
-	phaseDesc = "1-DoMergeAndWrite"
-	if err = mergesort.DoMergeAndWrite(ctx, sortkeyPos, int(schema.BlockMaxRows), task); err != nil {
-		return err
+	if task.allExpired(ctx) {
+		// drop the objects whole, no rows are left to rewrite
+		phaseDesc = "1-DropExpired"
+		commitEntry := task.PrepareCommitEntry()
+		commitEntry.Booking = mergesort.NewBlkTransferBooking(task.totalMergedBlkCnt)
+		logutil.Info("[Done] Mergeblocks due to all expired",
+			common.OperationField(task.Name()),
+			common.AnyField("table", schema.Name),
+			common.AnyField("objs", len(task.mergedObjs)))
+	} else if task.zorder {
+		// recluster the rows by every column of the cluster key
+		phaseDesc = "1-DoZOrderMergeAndWrite"
+		if err = mergesort.DoZOrderMergeAndWrite(ctx, sortkeyPos, int(schema.BlockMaxRows), task); err != nil {
+			return err
+		}
+	} else {
+		phaseDesc = "1-DoMergeAndWrite"
+		if err = mergesort.DoMergeAndWrite(ctx, sortkeyPos, int(schema.BlockMaxRows), task); err != nil {
+			return err
+		}
 	}
 
 	phaseDesc = "2-HandleMergeEntryInTxn"
@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_scan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/value_scan"
@@ -49,7 +50,7 @@ func (p *Pipeline) String() string {
 	return buf.String()
 }
 
-func (p *Pipeline) Run(r engine.Reader, topValueMsgTag int32, proc *process.Process) (end bool, err error) {
+func (p *Pipeline) Run(r engine.Reader, topValueMsgTag int32, readFilter *plan.Expr, proc *process.Process) (end bool, err error) {
 	// performance counter
 	perfCounterSet := new(perfcounter.CounterSet)
 	proc.Ctx = perfcounter.WithCounterSet(proc.Ctx, perfCounterSet)
@@ -69,6 +70,7 @@ func (p *Pipeline) Run(r engine.Reader, topValueMsgTag int32, proc *process.Proc
 	tableScanOperator := table_scan.Argument{
 		Reader:         r,
 		TopValueMsgTag: topValueMsgTag,
+		ReadFilter:     readFilter,
 		Attrs:          p.attrs,
 		TableID:        p.tableID,
 	}
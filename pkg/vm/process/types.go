@@ -97,6 +97,15 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// QueryMemory, max bytes the query may allocate from its pool, 0 for no
+	// limit.
+	QueryMemory int64
+	// ExecutionDeadline, unix nanoseconds after which the query is cancelled,
+	// 0 for no deadline.
+	ExecutionDeadline int64
+	// QueryMemoryHeld, bytes of QueryMemory the query holds elsewhere when
+	// the pipeline is sent to a remote CN.
+	QueryMemoryHeld int64
 }
 
 // SessionInfo session information